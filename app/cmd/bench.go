@@ -0,0 +1,406 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/client"
+	"github.com/longhorn/longhorn-instance-manager/pkg/process"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	benchOperationCreate  = "create"
+	benchOperationRunning = "create-to-running"
+	benchOperationDelete  = "delete"
+	benchOperationList    = "list"
+
+	benchRunningTimeout = 30 * time.Second
+
+	// benchDefaultBinary is only started by the embedded process manager, which fakes the processes
+	benchDefaultBinary = "/engine-binaries/im-bench/longhorn"
+)
+
+func BenchCmd() cli.Command {
+	return cli.Command{
+		Name:  "bench",
+		Usage: "drive create, delete, list and watch workloads against the process manager of a running instance manager at --url, or of an embedded one with a fake backend, and report the latency percentiles and resource use",
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "embedded",
+				Usage: "run against an embedded process manager, whose processes are faked rather than started",
+			},
+			cli.IntFlag{
+				Name:  "workers",
+				Value: 8,
+				Usage: "specifies the number of workers creating and deleting processes concurrently",
+			},
+			cli.IntFlag{
+				Name:  "processes",
+				Value: 100,
+				Usage: "specifies the number of processes each worker creates and deletes one after another",
+			},
+			cli.StringFlag{
+				Name:  "binary",
+				Value: benchDefaultBinary,
+				Usage: "specifies the engine binary of the processes, e.g. /engine-binaries/<image>/longhorn, which must exist on a running instance manager. The arguments of the command are the ones of the processes",
+			},
+			cli.IntFlag{
+				Name:  "port-count",
+				Usage: "specifies the number of ports allocated to each process. A process with ports is running once its gRPC health check succeeds",
+			},
+			cli.DurationFlag{
+				Name:  "list-interval",
+				Value: 100 * time.Millisecond,
+				Usage: "specifies the interval the processes are listed at during the run. Never listed if 0",
+			},
+			cli.BoolTFlag{
+				Name:  "watch",
+				Usage: "watch the processes during the run, and measure the time from the creation of each process to the event of it running",
+			},
+			cli.IntFlag{
+				Name:  "server-pid",
+				Usage: "specifies the pid of the instance manager to report the resource use of, if on this node. The one of the bench itself is reported with --embedded",
+			},
+			cli.StringFlag{
+				Name:  "name-prefix",
+				Value: "im-bench-",
+				Usage: "specifies the prefix of the names of the processes",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := runBench(c); err != nil {
+				logrus.WithError(err).Fatal("Error running bench command")
+			}
+		},
+	}
+}
+
+type BenchResult struct {
+	Workers     int                            `json:"workers"`
+	Processes   int                            `json:"processes"`
+	Duration    time.Duration                  `json:"duration"`
+	Throughput  float64                        `json:"throughput"`
+	Operations  map[string]util.LatencySummary `json:"operations"`
+	WatchEvents int                            `json:"watchEvents"`
+
+	ResourceUsage *BenchResourceUsage `json:"resourceUsage,omitempty"`
+}
+
+// BenchResourceUsage is the resource use of the instance manager during the run.
+type BenchResourceUsage struct {
+	CPUTime     time.Duration `json:"cpuTime"`
+	RSSBytes    uint64        `json:"rssBytes"`
+	RSSBytesMax uint64        `json:"rssBytesMax"`
+	OpenFDs     int           `json:"openFDs"`
+}
+
+type benchRecorder struct {
+	lock      *sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]int
+}
+
+func newBenchRecorder() *benchRecorder {
+	return &benchRecorder{
+		lock:      &sync.Mutex{},
+		latencies: map[string][]time.Duration{},
+		errors:    map[string]int{},
+	}
+}
+
+func (r *benchRecorder) record(operation string, start time.Time, err error) {
+	latency := time.Since(start)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err != nil {
+		r.errors[operation]++
+		logrus.WithError(err).Debugf("Failed to %v", operation)
+		return
+	}
+	r.latencies[operation] = append(r.latencies[operation], latency)
+}
+
+func (r *benchRecorder) summarize() map[string]util.LatencySummary {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	summaries := map[string]util.LatencySummary{}
+	for _, operation := range []string{benchOperationCreate, benchOperationRunning, benchOperationDelete, benchOperationList} {
+		if len(r.latencies[operation]) == 0 && r.errors[operation] == 0 {
+			continue
+		}
+		summaries[operation] = util.SummarizeLatencies(r.latencies[operation], r.errors[operation])
+	}
+	return summaries
+}
+
+// benchWatcher tells the workers once their processes are running, by the events of the process watch stream.
+type benchWatcher struct {
+	lock    *sync.Mutex
+	waiting map[string]chan struct{}
+	events  int
+}
+
+func (w *benchWatcher) expect(name string) chan struct{} {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	ch := make(chan struct{})
+	w.waiting[name] = ch
+	return ch
+}
+
+func (w *benchWatcher) forget(name string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.waiting, name)
+}
+
+func (w *benchWatcher) run(stream interface {
+	Recv() (*rpc.ProcessResponse, error)
+}) {
+	for {
+		p, err := stream.Recv()
+		if err != nil {
+			return
+		}
+
+		w.lock.Lock()
+		w.events++
+		if ch, ok := w.waiting[p.Spec.Name]; ok && p.Status.State == types.ProcessStateRunning {
+			close(ch)
+			delete(w.waiting, p.Spec.Name)
+		}
+		w.lock.Unlock()
+	}
+}
+
+func runBench(c *cli.Context) error {
+	workers := c.Int("workers")
+	processes := c.Int("processes")
+	if workers <= 0 || processes <= 0 {
+		return fmt.Errorf("invalid workers %v or processes %v", workers, processes)
+	}
+	args := []string(c.Args())
+	if !c.Bool("embedded") && c.String("binary") == benchDefaultBinary {
+		return fmt.Errorf("missing required parameter binary for a running instance manager")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serverPid := c.Int("server-pid")
+	var pmClient *client.ProcessManagerClient
+	var err error
+	if c.Bool("embedded") {
+		// The logs of every operation of the embedded process manager would skew the results
+		if !c.GlobalBool("debug") {
+			logrus.SetLevel(logrus.WarnLevel)
+		}
+		endpoint, cleanup, err := startEmbeddedProcessManager(ctx)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		if pmClient, err = client.NewProcessManagerClient(endpoint, nil); err != nil {
+			return errors.Wrap(err, "failed to initialize client")
+		}
+		serverPid = os.Getpid()
+	} else if pmClient, err = getProcessManagerClient(c); err != nil {
+		return errors.Wrap(err, "failed to initialize client")
+	}
+	defer pmClient.Close()
+
+	recorder := newBenchRecorder()
+	watcher := &benchWatcher{
+		lock:    &sync.Mutex{},
+		waiting: map[string]chan struct{}{},
+	}
+	if c.BoolT("watch") {
+		stream, err := pmClient.ProcessWatch(ctx)
+		if err != nil {
+			return err
+		}
+		go watcher.run(stream)
+	}
+
+	usageStart := getBenchResourceUsage(serverPid)
+	var rssMax uint64
+	stopCh := make(chan struct{})
+	samplerDone := make(chan struct{})
+	go func() {
+		defer close(samplerDone)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+			}
+			if usage := getBenchResourceUsage(serverPid); usage != nil && usage.RSSBytes > rssMax {
+				rssMax = usage.RSSBytes
+			}
+		}
+	}()
+
+	listerDone := make(chan struct{})
+	go func() {
+		defer close(listerDone)
+		interval := c.Duration("list-interval")
+		if interval <= 0 {
+			return
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+			}
+			start := time.Now()
+			_, err := pmClient.ProcessList()
+			recorder.record(benchOperationList, start, err)
+		}
+	}()
+
+	logrus.Infof("Benchmarking with %v workers creating and deleting %v processes each", workers, processes)
+	start := time.Now()
+	wg := &sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < processes; i++ {
+				name := fmt.Sprintf("%v%d-%d", c.String("name-prefix"), w, i)
+				runBenchIteration(pmClient, recorder, watcher, c.BoolT("watch"), &rpc.ProcessSpec{
+					Name:      name,
+					Binary:    c.String("binary"),
+					Args:      args,
+					PortCount: int32(c.Int("port-count")),
+				})
+			}
+		}(w)
+	}
+	wg.Wait()
+	duration := time.Since(start)
+	close(stopCh)
+	<-samplerDone
+	<-listerDone
+
+	result := &BenchResult{
+		Workers:    workers,
+		Processes:  workers * processes,
+		Duration:   duration,
+		Throughput: float64(workers*processes) / duration.Seconds(),
+		Operations: recorder.summarize(),
+	}
+	watcher.lock.Lock()
+	result.WatchEvents = watcher.events
+	watcher.lock.Unlock()
+
+	if usageEnd := getBenchResourceUsage(serverPid); usageStart != nil && usageEnd != nil {
+		if usageEnd.RSSBytes > rssMax {
+			rssMax = usageEnd.RSSBytes
+		}
+		result.ResourceUsage = &BenchResourceUsage{
+			CPUTime:     usageEnd.CPUTime - usageStart.CPUTime,
+			RSSBytes:    usageEnd.RSSBytes,
+			RSSBytesMax: rssMax,
+			OpenFDs:     usageEnd.OpenFDs,
+		}
+	}
+	return util.PrintJSON(result)
+}
+
+// runBenchIteration creates the process, waits for it to be running if watching, and deletes it.
+func runBenchIteration(pmClient *client.ProcessManagerClient, recorder *benchRecorder, watcher *benchWatcher, watch bool, spec *rpc.ProcessSpec) {
+	var runningCh chan struct{}
+	if watch {
+		runningCh = watcher.expect(spec.Name)
+		defer watcher.forget(spec.Name)
+	}
+
+	start := time.Now()
+	_, err := pmClient.ProcessCreateWithSpec(spec)
+	recorder.record(benchOperationCreate, start, err)
+	if err != nil {
+		return
+	}
+
+	if watch {
+		select {
+		case <-runningCh:
+			recorder.record(benchOperationRunning, start, nil)
+		case <-time.After(benchRunningTimeout):
+			recorder.record(benchOperationRunning, start, fmt.Errorf("process %v is not running in %v", spec.Name, benchRunningTimeout))
+		}
+	}
+
+	start = time.Now()
+	_, err = pmClient.ProcessDelete(spec.Name)
+	recorder.record(benchOperationDelete, start, err)
+}
+
+func getBenchResourceUsage(pid int) *util.ProcessResourceUsage {
+	if pid <= 0 {
+		return nil
+	}
+	usage, err := util.GetProcessResourceUsage(pid)
+	if err != nil {
+		logrus.WithError(err).Debugf("Failed to get resource usage of process %v", pid)
+		return nil
+	}
+	return usage
+}
+
+// startEmbeddedProcessManager serves a process manager with a fake backend on a unix socket, so that the overhead
+// of the process manager itself can be measured without starting any process.
+func startEmbeddedProcessManager(ctx context.Context) (string, func(), error) {
+	dir, err := os.MkdirTemp("", "im-bench-")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to create the directory of the embedded process manager")
+	}
+
+	pm, err := process.NewManager(ctx, "10000-30000", dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", nil, err
+	}
+	pm.Executor = &process.MockExecutor{}
+	pm.HealthChecker = &process.MockHealthChecker{}
+
+	endpoint := "unix://" + filepath.Join(dir, "process-manager.sock")
+	grpcServer, listener, err := util.NewServer(endpoint, nil)
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", nil, errors.Wrap(err, "failed to set up the embedded process manager")
+	}
+	rpc.RegisterProcessManagerServiceServer(grpcServer, pm)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			logrus.WithError(err).Debug("Embedded process manager stopped")
+		}
+	}()
+
+	return endpoint, func() {
+		grpcServer.Stop()
+		os.RemoveAll(dir)
+	}, nil
+}
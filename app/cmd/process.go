@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -9,6 +12,8 @@ import (
 
 	"github.com/longhorn/longhorn-instance-manager/pkg/client"
 	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
 
 func ProcessCmd() cli.Command {
@@ -20,6 +25,10 @@ func ProcessCmd() cli.Command {
 			ProcessGetCmd(),
 			ProcessListCmd(),
 			ProcessReplaceCmd(),
+			ProcessBulkDeleteCmd(),
+			ProcessBulkDeleteStatusCmd(),
+			ProcessPortReconcileCmd(),
+			ProcessDebugCmd(),
 		},
 	}
 }
@@ -41,6 +50,38 @@ func ProcessCreateCmd() cli.Command {
 				Name:  "port-args",
 				Usage: "Automatically add additional arguments when starting the process. In case of space, use `,` instead.",
 			},
+			cli.StringSliceFlag{
+				Name:  "env",
+				Usage: "Environment variable of the process in the form of KEY=VALUE, e.g. GODEBUG=madvdontneed=1. Can be specified multiple times.",
+			},
+			cli.Int64Flag{
+				Name:  "cpu-millicores",
+				Usage: "CPU limit of the process along with its children in thousandths of one CPU, e.g. 500. Unlimited if 0",
+			},
+			cli.Int64Flag{
+				Name:  "memory-bytes",
+				Usage: "Memory limit of the process along with its children in bytes. Unlimited if 0",
+			},
+			cli.StringFlag{
+				Name:  "cpu-set",
+				Usage: "CPUs the process along with its children is pinned to in the list format, e.g. 2-3,6. Not pinned if empty",
+			},
+			cli.StringFlag{
+				Name:  "numa-node",
+				Usage: "NUMA nodes the process along with its children is pinned to the CPUs of, e.g. 0. Not pinned if empty",
+			},
+			cli.StringSliceFlag{
+				Name:  "capability",
+				Usage: "Capability the process keeps, e.g. CAP_SYS_ADMIN. All the others are dropped once any of --capability and --no-new-privileges is specified. Can be specified multiple times.",
+			},
+			cli.BoolFlag{
+				Name:  "no-new-privileges",
+				Usage: "Prevents the process and its children from gaining privileges by exec, along with dropping the capabilities not specified by --capability",
+			},
+			cli.DurationFlag{
+				Name:  "stop-timeout",
+				Usage: "Time the process is given to stop gracefully on deletion before it is killed, e.g. 5m. The server default is used if not specified",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := createProcess(c); err != nil {
@@ -57,14 +98,58 @@ func createProcess(c *cli.Context) error {
 	}
 	defer cli.Close()
 
-	process, err := cli.ProcessCreate(c.String("name"), c.String("binary"),
-		c.Int("port-count"), c.Args(), c.StringSlice("port-args"))
+	envs, err := parseProcessEnvs(c.StringSlice("env"))
+	if err != nil {
+		return err
+	}
+
+	process, err := cli.ProcessCreateWithSpec(&rpc.ProcessSpec{
+		Name:      c.String("name"),
+		Binary:    c.String("binary"),
+		Args:      c.Args(),
+		PortCount: int32(c.Int("port-count")),
+		PortArgs:  c.StringSlice("port-args"),
+		Envs:      envs,
+
+		CpuMillicores: c.Int64("cpu-millicores"),
+		MemoryBytes:   c.Int64("memory-bytes"),
+		CpuSet:        c.String("cpu-set"),
+		NumaNode:      c.String("numa-node"),
+		Privileges:    getProcessPrivileges(c),
+
+		StopTimeoutSeconds: int64(c.Duration("stop-timeout") / time.Second),
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to create process")
 	}
 	return util.PrintJSON(process)
 }
 
+// getProcessPrivileges returns the privileges the process is restricted to, or nil for the full privileges of the
+// instance manager if neither --capability nor --no-new-privileges is specified.
+func getProcessPrivileges(c *cli.Context) *rpc.ProcessPrivileges {
+	if len(c.StringSlice("capability")) == 0 && !c.Bool("no-new-privileges") {
+		return nil
+	}
+	return &rpc.ProcessPrivileges{
+		Capabilities:    c.StringSlice("capability"),
+		NoNewPrivileges: c.Bool("no-new-privileges"),
+	}
+}
+
+// parseProcessEnvs parses the environment variables in the form of KEY=VALUE. The value may contain "=".
+func parseProcessEnvs(values []string) (map[string]string, error) {
+	envs := map[string]string{}
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid environment variable %v, expected KEY=VALUE", value)
+		}
+		envs[parts[0]] = parts[1]
+	}
+	return envs, nil
+}
+
 func ProcessDeleteCmd() cli.Command {
 	return cli.Command{
 		Name: "delete",
@@ -72,6 +157,10 @@ func ProcessDeleteCmd() cli.Command {
 			cli.StringFlag{
 				Name: "name",
 			},
+			cli.DurationFlag{
+				Name:  "stop-timeout",
+				Usage: "Time the process is given to stop gracefully before it is killed, overriding the one of the process. The one of the process is used if not specified",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := deleteProcess(c); err != nil {
@@ -88,7 +177,7 @@ func deleteProcess(c *cli.Context) error {
 	}
 	defer cli.Close()
 
-	process, err := cli.ProcessDelete(c.String("name"))
+	process, err := cli.ProcessDeleteWithStopTimeout(c.String("name"), c.Duration("stop-timeout"))
 	if err != nil {
 		return errors.Wrap(err, "failed to delete process")
 	}
@@ -197,6 +286,106 @@ func replaceProcess(c *cli.Context) error {
 	return util.PrintJSON(process)
 }
 
+func ProcessBulkDeleteCmd() cli.Command {
+	return cli.Command{
+		Name: "bulk-delete",
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "name",
+				Usage: "The processes to delete. All processes will be deleted if not specified",
+			},
+			cli.IntFlag{
+				Name:  "concurrency",
+				Usage: "The number of processes stopped in parallel. The server default is used if not specified",
+			},
+			cli.DurationFlag{
+				Name:  "stop-timeout",
+				Usage: "Time the processes are given to stop gracefully before they are killed, overriding the ones of the processes. The ones of the processes are used if not specified",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := bulkDeleteProcess(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process bulk delete command")
+			}
+		},
+	}
+}
+
+func bulkDeleteProcess(c *cli.Context) error {
+	cli, err := getProcessManagerClient(c)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize client")
+	}
+	defer cli.Close()
+
+	operation, err := cli.ProcessBulkDeleteWithStopTimeout(c.StringSlice("name"), c.Int("concurrency"), c.Duration("stop-timeout"))
+	if err != nil {
+		return errors.Wrap(err, "failed to bulk delete processes")
+	}
+	return util.PrintJSON(operation)
+}
+
+func ProcessBulkDeleteStatusCmd() cli.Command {
+	return cli.Command{
+		Name: "bulk-delete-status",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name: "operation-id",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := getProcessBulkDeleteStatus(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process bulk delete status command")
+			}
+		},
+	}
+}
+
+func getProcessBulkDeleteStatus(c *cli.Context) error {
+	cli, err := getProcessManagerClient(c)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize client")
+	}
+	defer cli.Close()
+
+	operation, err := cli.ProcessBulkDeleteStatusGet(c.String("operation-id"))
+	if err != nil {
+		return errors.Wrap(err, "failed to get bulk delete status")
+	}
+	return util.PrintJSON(operation)
+}
+
+func ProcessPortReconcileCmd() cli.Command {
+	return cli.Command{
+		Name: "port-reconcile",
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only report the port allocation discrepancies without repairing them",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := reconcileProcessPorts(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process port reconcile command")
+			}
+		},
+	}
+}
+
+func reconcileProcessPorts(c *cli.Context) error {
+	cli, err := getProcessManagerClient(c)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize client")
+	}
+	defer cli.Close()
+
+	resp, err := cli.PortReconcile(c.Bool("dry-run"))
+	if err != nil {
+		return errors.Wrap(err, "failed to reconcile ports")
+	}
+	return util.PrintJSON(resp.Discrepancies)
+}
+
 func getProcessManagerClient(c *cli.Context) (*client.ProcessManagerClient, error) {
 	url := c.GlobalString("url")
 	tlsDir := c.GlobalString("tls-dir")
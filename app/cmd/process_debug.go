@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func ProcessDebugCmd() cli.Command {
+	return cli.Command{
+		Name:  "debug",
+		Usage: "Show the internal state of the process manager for the triage of a node",
+		Subcommands: []cli.Command{
+			ProcessDebugPortsCmd(),
+			ProcessDebugProbesCmd(),
+			ProcessDebugStopsCmd(),
+		},
+	}
+}
+
+func ProcessDebugPortsCmd() cli.Command {
+	return cli.Command{
+		Name:  "ports",
+		Usage: "Show the port allocation map",
+		Action: func(c *cli.Context) {
+			if err := showProcessDebugPorts(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process debug ports command")
+			}
+		},
+	}
+}
+
+func showProcessDebugPorts(c *cli.Context) error {
+	state, err := getProcessManagerDebugState(c)
+	if err != nil {
+		return err
+	}
+
+	allocated := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PORTS\tCOUNT\tPROCESS\tOWNER\tALLOCATED")
+	for _, a := range state.PortAllocations {
+		count := a.PortEnd - a.PortStart + 1
+		if a.Allocated {
+			allocated += int(count)
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", formatPortRange(a.PortStart, a.PortEnd), count,
+			orNone(a.ProcessName), orNone(a.OwnerType), a.Allocated)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\nPort range %v: %v of %v ports allocated\n", formatPortRange(state.PortRangeStart, state.PortRangeEnd),
+		allocated, state.PortRangeEnd-state.PortRangeStart+1)
+	return nil
+}
+
+func ProcessDebugProbesCmd() cli.Command {
+	return cli.Command{
+		Name:  "probes",
+		Usage: "Probe the gRPC services of the processes and show the results",
+		Action: func(c *cli.Context) {
+			if err := showProcessDebugProbes(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process debug probes command")
+			}
+		},
+	}
+}
+
+func showProcessDebugProbes(c *cli.Context) error {
+	state, err := getProcessManagerDebugState(c)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROCESS\tSTATE\tADDRESS\tHEALTH\tLATENCY")
+	for _, p := range state.Probes {
+		health, latency := "not probed", "-"
+		if p.Probed {
+			health = "unhealthy"
+			if p.Healthy {
+				health = "healthy"
+			}
+			latency = fmt.Sprintf("%vms", p.LatencyMs)
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", p.ProcessName, p.State, orNone(p.Address), health, latency)
+	}
+	return w.Flush()
+}
+
+func ProcessDebugStopsCmd() cli.Command {
+	return cli.Command{
+		Name:  "stops",
+		Usage: "Show the processes being stopped and the bulk deletions in progress",
+		Action: func(c *cli.Context) {
+			if err := showProcessDebugStops(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process debug stops command")
+			}
+		},
+	}
+}
+
+func showProcessDebugStops(c *cli.Context) error {
+	state, err := getProcessManagerDebugState(c)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROCESS\tSTOPPING SINCE")
+	for _, p := range state.StoppingProcesses {
+		fmt.Fprintf(w, "%v\t%v\n", p.ProcessName, p.StoppingSince)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BULK DELETE\tTOTAL\tDELETED\tFAILED\tERRORS")
+	for _, op := range state.BulkDeleteOperations {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", op.OperationId, op.Total, op.Deleted, op.Failed, formatBulkDeleteErrors(op.Errors))
+	}
+	return w.Flush()
+}
+
+func getProcessManagerDebugState(c *cli.Context) (*rpc.ProcessManagerDebugResponse, error) {
+	cli, err := getProcessManagerClient(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize client")
+	}
+	defer cli.Close()
+
+	return cli.ProcessManagerDebugGet()
+}
+
+func formatPortRange(start, end int32) string {
+	if start == end {
+		return fmt.Sprintf("%v", start)
+	}
+	return fmt.Sprintf("%v-%v", start, end)
+}
+
+func formatBulkDeleteErrors(errs map[string]string) string {
+	if len(errs) == 0 {
+		return "-"
+	}
+	names := []string{}
+	for name := range errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/process"
+)
+
+// ProcessSpawnCmd is the command the process manager spawns the processes with restricted privileges by, e.g.
+// process-spawn --capability CAP_SYS_ADMIN --no-new-privileges [--private-mounts] -- BINARY ARGS...
+func ProcessSpawnCmd() cli.Command {
+	return cli.Command{
+		Name:   process.SpawnCommand,
+		Hidden: true,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "capability",
+				Usage: "Capability the binary keeps, with all the others dropped. Can be specified multiple times.",
+			},
+			cli.BoolFlag{
+				Name:  "no-new-privileges",
+				Usage: "Prevents the binary and its children from gaining privileges by exec",
+			},
+			cli.BoolFlag{
+				Name:  "private-mounts",
+				Usage: "Stops the mounts of the binary from propagating out of its mount namespace. The command must be started in a new mount namespace",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := spawnProcess(c); err != nil {
+				logrus.WithError(err).Fatal("Error running process spawn command")
+			}
+		},
+	}
+}
+
+func spawnProcess(c *cli.Context) error {
+	if len(c.Args()) == 0 {
+		return fmt.Errorf("missing required argument binary")
+	}
+	return process.SpawnProcess(c.Args()[0], c.Args()[1:], c.StringSlice("capability"),
+		c.Bool("no-new-privileges"), c.Bool("private-mounts"))
+}
@@ -22,21 +22,22 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 
 	commonTypes "github.com/longhorn/go-common-libs/types"
-	helpernvme "github.com/longhorn/go-spdk-helper/pkg/nvme"
 	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
 	helperutil "github.com/longhorn/go-spdk-helper/pkg/util"
 	spdk "github.com/longhorn/longhorn-spdk-engine/pkg/spdk"
 	spdkutil "github.com/longhorn/longhorn-spdk-engine/pkg/util"
 	spdkrpc "github.com/longhorn/longhorn-spdk-engine/proto/spdkrpc"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
 	"github.com/longhorn/longhorn-instance-manager/pkg/disk"
 	"github.com/longhorn/longhorn-instance-manager/pkg/health"
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 	"github.com/longhorn/longhorn-instance-manager/pkg/instance"
+	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
 	"github.com/longhorn/longhorn-instance-manager/pkg/process"
 	"github.com/longhorn/longhorn-instance-manager/pkg/proxy"
 
@@ -46,6 +47,10 @@ import (
 
 const (
 	spdkTgtStopTimeout = 120 * time.Second
+
+	// processStateDirName is the run directory of the logs directory the processes are persisted under for the
+	// adoption
+	processStateDirName = "run"
 )
 
 func StartCmd() cli.Command {
@@ -73,6 +78,230 @@ func StartCmd() cli.Command {
 				Name:  "spdk-enabled",
 				Usage: "enable SPDK support",
 			},
+			cli.StringFlag{
+				Name:  "spdk-tgt-log-file",
+				Usage: "specifies the log file of spdk_tgt, which the logs of the v2 data engine instances are filtered from. Defaults to spdk_tgt.log in the logs directory",
+			},
+			cli.StringFlag{
+				Name:  "lease-dir",
+				Usage: "specifies the host directory for the lease files claiming disks and replica data directories, preventing another instance manager on the same node from managing them concurrently. Claiming is disabled if empty",
+			},
+			cli.StringSliceFlag{
+				Name:  "allowed-source-cidrs",
+				Usage: "specifies the source CIDRs or IPs allowed to call the instance, proxy and disk services. The loopback addresses are always allowed. All sources are allowed if empty",
+			},
+			cli.BoolFlag{
+				Name:  "process-env-isolation",
+				Usage: "start the processes with a minimal environment, i.e. the whitelisted variables of the instance manager and the ones in the process spec, rather than inheriting the whole environment",
+			},
+			cli.StringSliceFlag{
+				Name:  "process-env-whitelist",
+				Usage: "specifies the additional environment variables of the instance manager passed to the processes when the process environment isolation is enabled",
+			},
+			cli.StringFlag{
+				Name:  "nvme-tcp-interface",
+				Usage: "specifies the NIC the SPDK NVMe-oF TCP targets listen on in addition to the pod IP, isolating the storage traffic from the pod traffic. The storage network interface takes precedence if it exists",
+			},
+			cli.StringSliceFlag{
+				Name:  "nvme-tcp-disk-interface",
+				Usage: "specifies the NIC the SPDK NVMe-oF TCP targets of the replicas on a disk listen on in place of the one of --nvme-tcp-interface, in the form of DISK=INTERFACE, e.g. disk-1=eth1. Can be specified multiple times",
+			},
+			cli.IntFlag{
+				Name:  "nvme-tcp-busy-poll",
+				Usage: "specifies the time in microseconds the node is expected to busy poll the NIC receive queue for the NVMe-oF TCP socket reads. The node-global sysctls net.core.busy_read and net.core.busy_poll are only checked, and must be set on the node. Not checked if 0",
+			},
+			cli.BoolFlag{
+				Name:  "nvme-tcp-napi-placement",
+				Usage: "group the SPDK NVMe-oF TCP connections by the NIC receive queue they arrive on",
+			},
+			cli.StringFlag{
+				Name:  "nvmf-initiator",
+				Value: util.NvmfInitiatorNvmeCLI,
+				Usage: "specifies how the NVMe-oF connections of the node are discovered, listed and disconnected, either nvme-cli or native. The native initiator uses the fabrics device and sysfs of the kernel in process, and falls back to nvme-cli if it fails",
+			},
+			cli.BoolFlag{
+				Name:  "chaos-enabled",
+				Usage: "serve the chaos service on the instance service address for simulating backend failures in tests. Only available if the binary is built with the chaos build tag",
+			},
+			cli.StringFlag{
+				Name:  "metrics-backend",
+				Value: metrics.BackendPrometheus,
+				Usage: "specifies the backend the metrics are emitted to. Supported backends are 'prometheus' (served at /metrics of the debug server on :6060), 'otlp', 'statsd' and 'none'",
+			},
+			cli.StringFlag{
+				Name:  "metrics-otlp-endpoint",
+				Usage: "specifies the OTLP/HTTP metrics endpoint the 'otlp' metrics backend pushes to, e.g. http://otel-collector:4318/v1/metrics",
+			},
+			cli.StringFlag{
+				Name:  "metrics-statsd-address",
+				Usage: "specifies the UDP address of the statsd server the 'statsd' metrics backend sends to, e.g. statsd:8125",
+			},
+			cli.IntFlag{
+				Name:  "backup-max-concurrency-per-target",
+				Usage: "specifies the default maximum number of concurrent backups to the same backup target endpoint, e.g. an S3 endpoint. The excess backups are queued. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "backup-rate-limit-per-target",
+				Usage: "specifies the default maximum number of backups started per minute to the same backup target endpoint. The excess backups are queued. Unlimited if 0. This is not a bandwidth limit, which is not supported",
+			},
+			cli.StringSliceFlag{
+				Name:  "command-timeout",
+				Usage: "specifies the timeout of the commands run by a binary in the form of BINARY=DURATION, e.g. nvme=30s, overriding the default timeout of the binary. The commands are killed once the timeout or the deadline of the request expires",
+			},
+			cli.IntFlag{
+				Name:  "max-instances",
+				Usage: "specifies the maximum number of instances on the node. The instance creations beyond it are rejected with ResourceExhausted. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "max-engines",
+				Usage: "specifies the maximum number of engines on the node. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "max-replicas",
+				Usage: "specifies the maximum number of replicas on the node. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "max-concurrent-v1-operations",
+				Usage: "specifies the maximum number of the v1 instance creations and deletions running at once. The ones beyond it wait until the others finish. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "max-concurrent-v2-operations",
+				Usage: "specifies the maximum number of the v2 instance creations and deletions running at once. The ones beyond it wait until the others finish. Unlimited if 0",
+			},
+			cli.DurationFlag{
+				Name:  "disk-scrub-interval",
+				Usage: "specifies the default interval between the scrubs of each block disk, which read the whole device to find the latent sector errors. Scrubbing is disabled if 0",
+			},
+			cli.IntFlag{
+				Name:  "disk-scrub-bandwidth",
+				Value: 50,
+				Usage: "specifies the default cap of the read rate of a disk scrub in MiB per second. Unlimited if 0",
+			},
+			cli.DurationFlag{
+				Name:  "instance-watch-coalescing-window",
+				Value: instance.DefaultWatchCoalescingWindow,
+				Usage: "specifies the minimum interval between the notifications sent to an instance watch client. The instance changes within the interval are coalesced into one notification. Not rate-limited if 0",
+			},
+			cli.DurationFlag{
+				Name:  "instance-stuck-timeout",
+				Value: instance.DefaultStuckInstanceTimeout,
+				Usage: "specifies the time an instance stays starting or stopping for before it is reported in the error state. Never reported if 0",
+			},
+			cli.DurationFlag{
+				Name:  "instance-operation-timeout",
+				Value: types.GRPCServiceTimeout,
+				Usage: "specifies the time a call of the instance service, e.g. an instance creation, is failed with DeadlineExceeded after if the backends do not respond. Not bounded if 0",
+			},
+			cli.DurationFlag{
+				Name:  "instance-drain-timeout",
+				Value: instance.DefaultDrainTimeout,
+				Usage: "specifies the time to wait on SIGTERM for the instance operations and log streams in flight to finish before exiting. The new instance creations are rejected in the meantime. Exits right away if 0",
+			},
+			cli.DurationFlag{
+				Name:  "spdk-startup-gate-timeout",
+				Value: instance.DefaultSPDKStartupGateTimeout,
+				Usage: "specifies the time to wait at startup for spdk_tgt and the SPDK service to be ready, during which the v2 data engine calls are rejected as unavailable. The calls are served anyway after the timeout. Not waited for if 0",
+			},
+			cli.StringFlag{
+				Name:  "task-queue-dir",
+				Usage: "specifies the host directory keeping the deferred cleanup tasks, e.g. the expiry of the spare replicas, across restarts. The tasks are only kept in memory if empty",
+			},
+			cli.StringFlag{
+				Name:  "node-zone",
+				Usage: "specifies the zone of the node, returned along with the instances and the version",
+			},
+			cli.StringFlag{
+				Name:  "node-rack",
+				Usage: "specifies the rack of the node, returned along with the instances and the version",
+			},
+			cli.StringSliceFlag{
+				Name:  "node-topology-label",
+				Usage: "specifies an additional topology label of the node in the format of <key>=<value>, returned along with the instances and the version",
+			},
+			cli.DurationFlag{
+				Name:  "process-log-retention",
+				Value: process.DefaultProcessLogRetention,
+				Usage: "specifies how long the logs of a deleted process are kept before being removed. Never removed if 0",
+			},
+			cli.IntFlag{
+				Name:  "process-log-flood-rate",
+				Value: util.DefaultLogFloodMaxLinesPerSecond,
+				Usage: "specifies the lines per second above which a process logging for the sustained period is flooding the log. The detection is disabled if 0",
+			},
+			cli.DurationFlag{
+				Name:  "process-log-flood-period",
+				Value: util.DefaultLogFloodSustainedPeriod,
+				Usage: "specifies how long a process logs above the rate before it is flooding the log",
+			},
+			cli.BoolFlag{
+				Name:  "process-log-flood-throttle",
+				Usage: "enables dropping the lines of a process flooding the log except for a sample of each second",
+			},
+			cli.IntFlag{
+				Name:  "process-log-flood-sample",
+				Value: util.DefaultLogFloodSampleLinesPerSecond,
+				Usage: "specifies the lines per second kept as a sample of a throttled log flood",
+			},
+			cli.IntFlag{
+				Name:  "process-log-max-size",
+				Value: util.DefaultLogRotationMaxSize >> 20,
+				Usage: "specifies the size in MiB a process log file is rotated at. The rotation is disabled if 0",
+			},
+			cli.IntFlag{
+				Name:  "process-log-max-files",
+				Value: util.DefaultLogRotationMaxFiles,
+				Usage: "specifies the number of the rotated files kept per process log. All are kept if 0",
+			},
+			cli.StringFlag{
+				Name:  "process-log-compression",
+				Value: util.DefaultLogRotationCompression,
+				Usage: "specifies the method the rotated process log files are compressed with: gzip, lz4 or none",
+			},
+			cli.BoolFlag{
+				Name:  "process-adoption",
+				Usage: "persist the processes under the run directory of the logs directory, and adopt the ones still running after the instance manager restarts rather than losing them. The processes are left running once the instance manager exits",
+			},
+			cli.IntFlag{
+				Name:  "rebuild-cpu-max-percent",
+				Usage: "specifies the CPU time in percent of one CPU the v1 replicas on the node can use while taking part in a rebuild, e.g. 50. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "rebuild-io-read-bandwidth",
+				Usage: "specifies the disk read bandwidth in MiB/s the v1 replicas on the node can use while taking part in a rebuild. Unlimited if 0",
+			},
+			cli.IntFlag{
+				Name:  "rebuild-io-write-bandwidth",
+				Usage: "specifies the disk write bandwidth in MiB/s the v1 replicas on the node can use while taking part in a rebuild. Unlimited if 0",
+			},
+			cli.DurationFlag{
+				Name:  "metrics-push-interval",
+				Value: metrics.DefaultPushInterval,
+				Usage: "specifies the interval the 'otlp' metrics backend pushes the metrics at",
+			},
+			cli.DurationFlag{
+				Name:  "grpc-keepalive-min-time",
+				Value: util.DefaultGRPCKeepaliveMinTime,
+				Usage: "specifies the minimum interval the clients of the gRPC services may send keepalive pings at. The connection of a client pinging more often is closed",
+			},
+			cli.DurationFlag{
+				Name:  "grpc-keepalive-time",
+				Value: util.DefaultGRPCKeepaliveTime,
+				Usage: "specifies the idle time after which the gRPC services ping the client to detect a dead peer, e.g. a crashed client leaving a half-open connection. The gRPC default of 2h is used if 0",
+			},
+			cli.DurationFlag{
+				Name:  "grpc-keepalive-timeout",
+				Value: util.DefaultGRPCKeepaliveTimeout,
+				Usage: "specifies the time the client has to respond to a keepalive ping in before its connection to the gRPC services is closed",
+			},
+			cli.DurationFlag{
+				Name:  "grpc-max-connection-age",
+				Usage: "specifies the age after which a connection to the gRPC services is closed gracefully with GOAWAY, so that the long-lived connections, e.g. of the instance watch streams, are recycled. The clients must retry the calls cancelled by it. Never closed for the age if 0",
+			},
+			cli.DurationFlag{
+				Name:  "grpc-max-connection-age-grace",
+				Value: util.DefaultGRPCMaxConnectionAgeGrace,
+				Usage: "specifies the time the calls in flight on a connection closed for its age are given to finish before they are cancelled. Never cancelled if 0",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := start(c); err != nil {
@@ -85,16 +314,12 @@ func StartCmd() cli.Command {
 func cleanup(pm *process.Manager) {
 	logrus.Infof("Trying to gracefully shut down %v", types.ProcessManagerGrpcService)
 
-	pmResp, err := pm.ProcessList(nil, &rpc.ProcessListRequest{})
+	bulkDeleteResp, err := pm.ProcessBulkDelete(nil, &rpc.ProcessBulkDeleteRequest{})
 	if err != nil {
-		logrus.WithError(err).Errorf("Failed to list processes before shutting down %v", types.ProcessManagerGrpcService)
+		logrus.WithError(err).Errorf("Failed to delete processes before shutting down %v", types.ProcessManagerGrpcService)
 		return
 	}
-	for _, p := range pmResp.Processes {
-		pm.ProcessDelete(nil, &rpc.ProcessDeleteRequest{
-			Name: p.Spec.Name,
-		})
-	}
+	logrus.Infof("Deleting %v processes with bulk delete operation %v", bulkDeleteResp.Total, bulkDeleteResp.OperationId)
 
 	for i := 0; i < types.WaitCount; i++ {
 		pmResp, err := pm.ProcessList(nil, &rpc.ProcessListRequest{})
@@ -133,7 +358,7 @@ func cleanupStaledNvmeAndDmDevices() error {
 		return errors.Wrapf(err, "failed to create executor for cleaning up staled NVMe and dm devices")
 	}
 
-	subsystems, err := helpernvme.GetSubsystems(executor)
+	subsystems, err := util.NvmfGetSubsystems(executor)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get NVMe subsystems")
 	}
@@ -150,7 +375,7 @@ func cleanupStaledNvmeAndDmDevices() error {
 			}
 
 			logrus.Infof("Cleaning up NVMe subsystem %v: NQN %v", sys.Name, sys.NQN)
-			if err := helpernvme.DisconnectTarget(sys.NQN, executor); err != nil {
+			if err := util.NvmfDisconnectTarget(sys.NQN, executor); err != nil {
 				logrus.WithError(err).Warnf("Failed to disconnect NVMe subsystem %v: NQN %v, will continue the cleanup", sys.Name, sys.NQN)
 			}
 		}
@@ -164,6 +389,92 @@ func start(c *cli.Context) (err error) {
 	processPortRange := c.String("port-range")
 	spdkPortRange := c.String("spdk-port-range")
 	spdkEnabled := c.Bool("spdk-enabled")
+	spdkTgtLogPath := c.String("spdk-tgt-log-file")
+	if spdkTgtLogPath == "" {
+		spdkTgtLogPath = filepath.Join(logsDir, util.SPDKTgtLogName+".log")
+	}
+	leaseDir := c.String("lease-dir")
+	allowedSourceCIDRs := c.StringSlice("allowed-source-cidrs")
+	processEnvIsolation := c.Bool("process-env-isolation")
+	processEnvWhitelist := c.StringSlice("process-env-whitelist")
+	chaosEnabled := c.Bool("chaos-enabled")
+	instanceWatchCoalescingWindow := c.Duration("instance-watch-coalescing-window")
+	instanceStuckTimeout := c.Duration("instance-stuck-timeout")
+	instanceOperationTimeout := c.Duration("instance-operation-timeout")
+	instanceDrainTimeout := c.Duration("instance-drain-timeout")
+	spdkStartupGateTimeout := c.Duration("spdk-startup-gate-timeout")
+	instanceLimits := &instance.InstanceLimits{
+		MaxInstances: c.Int("max-instances"),
+		MaxEngines:   c.Int("max-engines"),
+		MaxReplicas:  c.Int("max-replicas"),
+		MaxConcurrentOperations: map[rpc.DataEngine]int{
+			rpc.DataEngine_DATA_ENGINE_V1: c.Int("max-concurrent-v1-operations"),
+			rpc.DataEngine_DATA_ENGINE_V2: c.Int("max-concurrent-v2-operations"),
+		},
+	}
+	scrubConfig := &disk.ScrubConfig{
+		Interval:                c.Duration("disk-scrub-interval"),
+		BandwidthBytesPerSecond: int64(c.Int("disk-scrub-bandwidth")) << 20,
+	}
+	taskQueueDir := c.String("task-queue-dir")
+	processLogRetention := c.Duration("process-log-retention")
+	processLogFlood := &util.LogFloodConfig{
+		MaxLinesPerSecond:    c.Int("process-log-flood-rate"),
+		SustainedPeriod:      c.Duration("process-log-flood-period"),
+		Throttle:             c.Bool("process-log-flood-throttle"),
+		SampleLinesPerSecond: c.Int("process-log-flood-sample"),
+	}
+	processLogRotation := &util.LogRotationConfig{
+		MaxSize:     int64(c.Int("process-log-max-size")) << 20,
+		MaxFiles:    c.Int("process-log-max-files"),
+		Compression: c.String("process-log-compression"),
+	}
+	if err := util.ValidateLogCompression(processLogRotation.Compression); err != nil {
+		return err
+	}
+	processStateDir := ""
+	if c.Bool("process-adoption") {
+		processStateDir = filepath.Join(logsDir, processStateDirName)
+	}
+	rebuildLimits := &util.CgroupLimits{
+		CPUMaxMillicores: int64(c.Int("rebuild-cpu-max-percent")) * 10,
+		IOReadBPS:        int64(c.Int("rebuild-io-read-bandwidth")) << 20,
+		IOWriteBPS:       int64(c.Int("rebuild-io-write-bandwidth")) << 20,
+	}
+	nvmeTCPDiskInterfaces, err := util.ParseNvmeTCPDiskInterfaces(c.StringSlice("nvme-tcp-disk-interface"))
+	if err != nil {
+		return err
+	}
+	nvmeTCPSocketConfig := &util.NvmeTCPSocketConfig{
+		Interface:      c.String("nvme-tcp-interface"),
+		DiskInterfaces: nvmeTCPDiskInterfaces,
+		BusyPollUsec:   c.Int("nvme-tcp-busy-poll"),
+		NAPIPlacement:  c.Bool("nvme-tcp-napi-placement"),
+	}
+	nvmfInitiator := c.String("nvmf-initiator")
+	if err := util.ValidateNvmfInitiator(nvmfInitiator); err != nil {
+		return err
+	}
+	backupTargetLimit := proxy.BackupTargetLimit{
+		MaxConcurrentBackups: c.Int("backup-max-concurrency-per-target"),
+		MaxBackupsPerMinute:  c.Int("backup-rate-limit-per-target"),
+	}
+	grpcKeepalive := &util.GRPCKeepaliveConfig{
+		MinTime:               c.Duration("grpc-keepalive-min-time"),
+		Time:                  c.Duration("grpc-keepalive-time"),
+		Timeout:               c.Duration("grpc-keepalive-timeout"),
+		MaxConnectionAge:      c.Duration("grpc-max-connection-age"),
+		MaxConnectionAgeGrace: c.Duration("grpc-max-connection-age-grace"),
+	}
+	if err := grpcKeepalive.Validate(); err != nil {
+		return err
+	}
+	metricsConfig := &metrics.Config{
+		Backend:       c.String("metrics-backend"),
+		OTLPEndpoint:  c.String("metrics-otlp-endpoint"),
+		StatsdAddress: c.String("metrics-statsd-address"),
+		PushInterval:  c.Duration("metrics-push-interval"),
+	}
 
 	defer func() {
 		if spdkEnabled {
@@ -178,10 +489,51 @@ func start(c *cli.Context) (err error) {
 		return err
 	}
 
+	topologyLabels, err := meta.ParseTopologyLabels(c.StringSlice("node-topology-label"))
+	if err != nil {
+		return err
+	}
+	meta.SetTopology(meta.Topology{
+		Zone:   c.String("node-zone"),
+		Rack:   c.String("node-rack"),
+		Labels: topologyLabels,
+	})
+
+	sourceFilter, err := util.NewSourceFilter(allowedSourceCIDRs)
+	if err != nil {
+		return err
+	}
+
+	commandTimeouts, err := util.ParseCommandTimeouts(c.StringSlice("command-timeout"))
+	if err != nil {
+		return err
+	}
+	util.SetCommandTimeouts(commandTimeouts)
+	util.SetNvmfInitiator(nvmfInitiator)
+
+	var leaseManager *util.LeaseManager
+	if leaseDir != "" {
+		if leaseManager, err = util.NewLeaseManager(leaseDir); err != nil {
+			return err
+		}
+	}
+
+	safeModeDisks := disk.NewSafeModeTracker()
+	taskQueue, err := util.NewTaskQueue(taskQueueDir)
+	if err != nil {
+		return err
+	}
+	instanceOperations := util.NewOperationHistory(types.MaxInstanceOperations)
+
 	if spdkEnabled {
 		if err := cleanupStaledNvmeAndDmDevices(); err != nil {
 			return err
 		}
+		// spdk_tgt must take the socket options before any v2 instance is created, so they are applied before the
+		// servers start
+		if err := util.ApplyNvmeTCPSocketConfig(context.Background(), nvmeTCPSocketConfig); err != nil {
+			return err
+		}
 	}
 
 	// setup tls config
@@ -204,6 +556,20 @@ func start(c *cli.Context) (err error) {
 		logrus.Info("Creating gRPC server with no auth")
 	}
 
+	metricsBackend, err := metrics.NewBackend(metricsConfig)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create metrics backend %v", metricsConfig.Backend)
+	}
+	defer func() {
+		if err := metricsBackend.Close(); err != nil {
+			logrus.WithError(err).Warnf("Failed to close metrics backend %v", metricsConfig.Backend)
+		}
+	}()
+	metrics.SetBackend(metricsBackend)
+	if prometheusBackend, ok := metricsBackend.(*metrics.PrometheusBackend); ok {
+		http.Handle("/metrics", prometheusBackend.Handler())
+	}
+
 	go func() {
 		debugAddress := ":6060"
 		debugHandler := http.DefaultServeMux
@@ -227,7 +593,7 @@ func start(c *cli.Context) (err error) {
 	listeners := map[string]net.Listener{}
 
 	// Start disk server
-	diskGRPCServer, diskGRPCListener, err := setupDiskGRPCServer(ctx, addresses[types.DiskGrpcService], addresses[types.SpdkGrpcService], spdkEnabled)
+	diskServer, diskGRPCServer, diskGRPCListener, err := setupDiskGRPCServer(ctx, addresses[types.DiskGrpcService], addresses[types.SpdkGrpcService], spdkEnabled, leaseManager, safeModeDisks, scrubConfig, sourceFilter, grpcKeepalive)
 	if err != nil {
 		logrus.WithError(err).Errorf("Failed to setup %s", types.DiskGrpcService)
 		return err
@@ -236,9 +602,9 @@ func start(c *cli.Context) (err error) {
 	listeners[types.DiskGrpcService] = diskGRPCListener
 
 	// Start instance server
-	instanceGRPCServer, instanceRPCListener, err := setupInstanceGRPCServer(ctx, logsDir,
+	instanceServer, instanceGRPCServer, instanceRPCListener, err := setupInstanceGRPCServer(ctx, logsDir,
 		addresses[types.InstanceGrpcService], addresses[types.ProcessManagerGrpcService],
-		addresses[types.SpdkGrpcService], tlsConfig, spdkEnabled)
+		addresses[types.SpdkGrpcService], tlsConfig, spdkEnabled, chaosEnabled, safeModeDisks, instanceOperations, taskQueue, spdkTgtLogPath, instanceWatchCoalescingWindow, instanceStuckTimeout, instanceOperationTimeout, instanceLimits, sourceFilter, diskServer, spdkPortRange, spdkStartupGateTimeout, nvmeTCPSocketConfig, grpcKeepalive)
 	if err != nil {
 		logrus.WithError(err).Errorf("Failed to set up %s", types.InstanceGrpcService)
 		return err
@@ -248,7 +614,8 @@ func start(c *cli.Context) (err error) {
 
 	// Start proxy server
 	proxyGRPCServer, proxyGRPCListener, err := setupProxyGRPCServer(ctx, logsDir,
-		addresses[types.ProxyGRPCService], addresses[types.DiskGrpcService], addresses[types.SpdkGrpcService], tlsConfig)
+		addresses[types.ProxyGRPCService], addresses[types.DiskGrpcService], addresses[types.SpdkGrpcService], tlsConfig, instanceOperations, backupTargetLimit, sourceFilter,
+		addresses[types.ProcessManagerGrpcService], rebuildLimits, grpcKeepalive)
 	if err != nil {
 		logrus.WithError(err).Errorf("Failed to set up %s", types.ProxyGRPCService)
 		return err
@@ -257,7 +624,8 @@ func start(c *cli.Context) (err error) {
 	listeners[types.ProxyGRPCService] = proxyGRPCListener
 
 	// Start process-manager server
-	pm, pmGRPCServer, pmGRPCListener, err := setupProcessManagerGRPCServer(ctx, processPortRange, logsDir, addresses[types.ProcessManagerGrpcService])
+	pm, pmGRPCServer, pmGRPCListener, err := setupProcessManagerGRPCServer(ctx, processPortRange, logsDir, addresses[types.ProcessManagerGrpcService], leaseManager,
+		processEnvIsolation, processEnvWhitelist, taskQueue, processLogRetention, processLogFlood, processLogRotation, rebuildLimits, processStateDir, grpcKeepalive)
 	if err != nil {
 		logrus.WithError(err).Errorf("Failed to set up %s", types.ProcessManagerGrpcService)
 		return err
@@ -265,9 +633,13 @@ func start(c *cli.Context) (err error) {
 	servers[types.ProcessManagerGrpcService] = pmGRPCServer
 	listeners[types.ProcessManagerGrpcService] = pmGRPCListener
 
+	if chaosEnabled {
+		chaos.SetProcessCrashHandler(pm.ProcessCrash)
+	}
+
 	// Start spdk server
 	if spdkEnabled {
-		spdkGRPCServer, spdkGRPCListener, err := setupSPDKGRPCServer(ctx, spdkPortRange, addresses[types.SpdkGrpcService])
+		spdkGRPCServer, spdkGRPCListener, err := setupSPDKGRPCServer(ctx, spdkPortRange, addresses[types.SpdkGrpcService], grpcKeepalive)
 		if err != nil {
 			logrus.WithError(err).Errorf("Failed to set up %s", types.SpdkGrpcService)
 			return err
@@ -276,15 +648,34 @@ func start(c *cli.Context) (err error) {
 		listeners[types.SpdkGrpcService] = spdkGRPCListener
 	}
 
+	// Run the deferred tasks after all handlers are registered by the servers
+	taskQueue.Start(ctx)
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	// Register signal handler
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	// The instance service asks to exit once drained by InstanceServiceDrain, which needs no drain again
+	exitCh := make(chan struct{}, 1)
+	instanceServer.Exit = func() {
+		select {
+		case exitCh <- struct{}{}:
+		default:
+		}
+	}
 	g.Go(func() error {
-		sig := <-sigs
-		logrus.Infof("Instance Manager received %v to exit", sig)
+		select {
+		case sig := <-sigs:
+			logrus.Infof("Instance Manager received %v to exit", sig)
+			if instanceDrainTimeout > 0 {
+				instanceServer.Drain(instanceDrainTimeout)
+			}
+		case <-exitCh:
+			logrus.Info("Instance Manager is exiting since the instance service is drained")
+		}
 
+		metrics.SetServersStopping()
 		for _, server := range servers {
 			server.Stop()
 		}
@@ -314,8 +705,13 @@ func start(c *cli.Context) (err error) {
 				logrus.WithError(err).Errorf("%s failed to serve", name)
 			}
 
+			// The processes are left running for the next instance manager to adopt, along with their logs
 			if name == types.ProcessManagerGrpcService {
-				cleanup(pm)
+				if processStateDir == "" {
+					cleanup(pm)
+				} else {
+					pm.HandOffLogs()
+				}
 			}
 
 			logrus.Infof("Stopped %s", name)
@@ -350,31 +746,29 @@ func getServiceAddresses(listen string) (addresses map[string]string, err error)
 	}, nil
 }
 
-func setupDiskGRPCServer(ctx context.Context, listen, spdkServiceAddress string, spdkEnabled bool) (*grpc.Server, net.Listener, error) {
-	srv, err := disk.NewServer(ctx, spdkEnabled, spdkServiceAddress)
+func setupDiskGRPCServer(ctx context.Context, listen, spdkServiceAddress string, spdkEnabled bool, leaseManager *util.LeaseManager, safeModeDisks *disk.SafeModeTracker,
+	scrubConfig *disk.ScrubConfig, sourceFilter *util.SourceFilter, grpcKeepalive *util.GRPCKeepaliveConfig) (*disk.Server, *grpc.Server, net.Listener, error) {
+	srv, err := disk.NewServer(ctx, spdkEnabled, spdkServiceAddress, leaseManager, safeModeDisks, scrubConfig)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	hc := health.NewDiskHealthCheckServer(srv)
 
-	grpcServer, rpcListener, err := util.NewServer(listen, nil,
-		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
-			MinTime:             10 * time.Second,
-			PermitWithoutStream: true,
-		}),
-	)
+	opts := grpcKeepalive.ServerOptions()
+	opts = append(opts, sourceFilter.ServerOptions()...)
+	grpcServer, rpcListener, err := util.NewServer(listen, nil, opts...)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "failed to setup %s", types.DiskGrpcService)
+		return nil, nil, nil, errors.Wrapf(err, "failed to setup %s", types.DiskGrpcService)
 	}
 
 	rpc.RegisterDiskServiceServer(grpcServer, srv)
 	healthpb.RegisterHealthServer(grpcServer, hc)
 	reflection.Register(grpcServer)
 
-	return grpcServer, rpcListener, nil
+	return srv, grpcServer, rpcListener, nil
 }
 
-func setupSPDKGRPCServer(ctx context.Context, portRange, listen string) (*grpc.Server, net.Listener, error) {
+func setupSPDKGRPCServer(ctx context.Context, portRange, listen string, grpcKeepalive *util.GRPCKeepaliveConfig) (*grpc.Server, net.Listener, error) {
 	portStart, portEnd, err := util.ParsePortRange(portRange)
 
 	srv, err := spdk.NewServer(ctx, portStart, portEnd)
@@ -383,12 +777,7 @@ func setupSPDKGRPCServer(ctx context.Context, portRange, listen string) (*grpc.S
 	}
 	hc := health.NewSPDKHealthCheckServer(srv)
 
-	grpcServer, grpcListener, err := util.NewServer(listen, nil,
-		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
-			MinTime:             10 * time.Second,
-			PermitWithoutStream: true,
-		}),
-	)
+	grpcServer, grpcListener, err := util.NewServer(listen, nil, grpcKeepalive.ServerOptions()...)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to setup %s", types.SpdkGrpcService)
 	}
@@ -400,20 +789,22 @@ func setupSPDKGRPCServer(ctx context.Context, portRange, listen string) (*grpc.S
 	return grpcServer, grpcListener, nil
 }
 
-func setupProxyGRPCServer(ctx context.Context, logsDir, listen, diskServiceAddress, spdkServiceAddress string, tlsConfig *tls.Config) (*grpc.Server, net.Listener, error) {
+func setupProxyGRPCServer(ctx context.Context, logsDir, listen, diskServiceAddress, spdkServiceAddress string, tlsConfig *tls.Config, instanceOperations *util.OperationHistory,
+	backupTargetLimit proxy.BackupTargetLimit, sourceFilter *util.SourceFilter, processManagerServiceAddress string, rebuildLimits *util.CgroupLimits,
+	grpcKeepalive *util.GRPCKeepaliveConfig) (*grpc.Server, net.Listener, error) {
 	// TODO: skip proxy for replica instance manager pod
-	srv, err := proxy.NewProxy(ctx, logsDir, diskServiceAddress, spdkServiceAddress)
+	srv, err := proxy.NewProxy(ctx, logsDir, diskServiceAddress, spdkServiceAddress, instanceOperations, backupTargetLimit)
 	if err != nil {
 		return nil, nil, err
 	}
+	if rebuildLimits.Enabled() {
+		srv.ProcessManagerServiceAddress = processManagerServiceAddress
+	}
 	hc := health.NewProxyHealthCheckServer(srv)
 
-	grpcProxyServer, grpcProxyListener, err := util.NewServer(listen, tlsConfig,
-		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
-			MinTime:             10 * time.Second,
-			PermitWithoutStream: true,
-		}),
-	)
+	opts := grpcKeepalive.ServerOptions()
+	opts = append(opts, sourceFilter.ServerOptions()...)
+	grpcProxyServer, grpcProxyListener, err := util.NewServer(listen, tlsConfig, opts...)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to setup %s", types.ProxyGRPCService)
 	}
@@ -425,19 +816,37 @@ func setupProxyGRPCServer(ctx context.Context, logsDir, listen, diskServiceAddre
 	return grpcProxyServer, grpcProxyListener, nil
 }
 
-func setupProcessManagerGRPCServer(ctx context.Context, portRange, logsDir, listen string) (*process.Manager, *grpc.Server, net.Listener, error) {
+func setupProcessManagerGRPCServer(ctx context.Context, portRange, logsDir, listen string, leaseManager *util.LeaseManager,
+	envIsolation bool, envWhitelist []string, taskQueue *util.TaskQueue, logRetention time.Duration, logFlood *util.LogFloodConfig, logRotation *util.LogRotationConfig,
+	rebuildLimits *util.CgroupLimits, stateDir string, grpcKeepalive *util.GRPCKeepaliveConfig) (*process.Manager, *grpc.Server, net.Listener, error) {
 	srv, err := process.NewManager(ctx, portRange, logsDir)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	srv.LeaseManager = leaseManager
+	srv.EnvIsolation = envIsolation
+	srv.EnvWhitelist = envWhitelist
+	srv.EnableLogGC(taskQueue, logRetention)
+	if logFlood.MaxLinesPerSecond > 0 {
+		srv.LogFlood = logFlood
+	}
+	if logRotation.MaxSize > 0 {
+		srv.LogRotation = logRotation
+	}
+	if rebuildLimits.Enabled() {
+		srv.RebuildLimits = rebuildLimits
+	}
+	if stateDir != "" {
+		adopted, err := srv.AdoptProcesses(stateDir)
+		if err != nil {
+			logrus.WithError(err).Warn("Failed to adopt the processes left running by the previous instance manager")
+		} else {
+			logrus.Infof("Adopted %v processes left running by the previous instance manager", adopted)
+		}
+	}
 	hc := health.NewHealthCheckServer(srv)
 
-	grpcServer, grpcListener, err := util.NewServer(listen, nil,
-		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
-			MinTime:             10 * time.Second,
-			PermitWithoutStream: true,
-		}),
-	)
+	grpcServer, grpcListener, err := util.NewServer(listen, nil, grpcKeepalive.ServerOptions()...)
 	if err != nil {
 		return nil, nil, nil, errors.Wrapf(err, "failed to setup %s", types.ProcessManagerGrpcService)
 	}
@@ -449,26 +858,49 @@ func setupProcessManagerGRPCServer(ctx context.Context, portRange, logsDir, list
 	return srv, grpcServer, grpcListener, nil
 }
 
-func setupInstanceGRPCServer(ctx context.Context, logsDir, listen, processManagerServiceAddress, spdkServiceAddress string, tlsConfig *tls.Config, spdkEnabled bool) (*grpc.Server, net.Listener, error) {
-	srv, err := instance.NewServer(ctx, logsDir, processManagerServiceAddress, spdkServiceAddress, spdkEnabled)
+func setupInstanceGRPCServer(ctx context.Context, logsDir, listen, processManagerServiceAddress, spdkServiceAddress string, tlsConfig *tls.Config, spdkEnabled, chaosEnabled bool, safeModeDisks *disk.SafeModeTracker, instanceOperations *util.OperationHistory,
+	taskQueue *util.TaskQueue, spdkTgtLogPath string, watchCoalescingWindow, stuckTimeout, operationTimeout time.Duration, instanceLimits *instance.InstanceLimits, sourceFilter *util.SourceFilter,
+	diskServer *disk.Server, spdkPortRange string, spdkStartupGateTimeout time.Duration, nvmeTCPSocketConfig *util.NvmeTCPSocketConfig,
+	grpcKeepalive *util.GRPCKeepaliveConfig) (*instance.Server, *grpc.Server, net.Listener, error) {
+	srv, err := instance.NewServer(ctx, logsDir, processManagerServiceAddress, spdkServiceAddress, spdkEnabled, safeModeDisks, instanceOperations, taskQueue, spdkTgtLogPath, instanceLimits)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
+	srv.WatchCoalescingWindow = watchCoalescingWindow
+	srv.StuckInstanceTimeout = stuckTimeout
+	srv.OperationTimeout = operationTimeout
+	srv.Disks = diskServer
+	if spdkEnabled {
+		spdkPortStart, spdkPortEnd, err := util.ParsePortRange(spdkPortRange)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "failed to parse SPDK port range %v", spdkPortRange)
+		}
+		srv.SPDKPortRangeStart = spdkPortStart
+		srv.SPDKPortRangeEnd = spdkPortEnd
+	}
+	srv.EnableSPDKStartupGate(spdkServiceAddress, spdkStartupGateTimeout)
+	srv.EnableNvmeTCPListeners(nvmeTCPSocketConfig)
 	hc := health.NewInstanceHealthCheckServer(srv)
 
-	grpcServer, grpcListener, err := util.NewServer(listen, tlsConfig,
-		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
-			MinTime:             10 * time.Second,
-			PermitWithoutStream: true,
-		}),
-	)
+	opts := grpcKeepalive.ServerOptions()
+	opts = append(opts, sourceFilter.ServerOptions()...)
+	opts = append(opts, grpc.ChainUnaryInterceptor(srv.UnaryServerInterceptor()))
+	grpcServer, grpcListener, err := util.NewServer(listen, tlsConfig, opts...)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "failed to setup %s", types.InstanceGrpcService)
+		return nil, nil, nil, errors.Wrapf(err, "failed to setup %s", types.InstanceGrpcService)
 	}
 
 	rpc.RegisterInstanceServiceServer(grpcServer, srv)
+	if chaosEnabled {
+		chaosSrv, err := chaos.NewServer()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		logrus.Warn("Serving the chaos service, faults can be injected into this instance manager")
+		rpc.RegisterChaosServiceServer(grpcServer, chaosSrv)
+	}
 	healthpb.RegisterHealthServer(grpcServer, hc)
 	reflection.Register(grpcServer)
 
-	return grpcServer, grpcListener, nil
+	return srv, grpcServer, grpcListener, nil
 }
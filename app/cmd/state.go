@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+func StateCmd() cli.Command {
+	return cli.Command{
+		Name: "state",
+		Subcommands: []cli.Command{
+			StateVerifyCmd(),
+		},
+	}
+}
+
+func StateVerifyCmd() cli.Command {
+	return cli.Command{
+		Name:  "verify",
+		Usage: "verify the persistent state files can be read by the instance manager of the target state version, and migrate them with --migrate, e.g. before rolling back to an older instance manager",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "lease-dir",
+				Usage: "specifies the host directory of the lease files",
+			},
+			cli.IntFlag{
+				Name:  "target-version",
+				Value: util.LeaseStateSchema.CurrentVersion,
+				Usage: "specifies the state version to verify against",
+			},
+			cli.BoolFlag{
+				Name:  "migrate",
+				Usage: "migrate the state files not in use to the target version",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := verifyState(c); err != nil {
+				logrus.WithError(err).Fatal("Error running state verify command")
+			}
+		},
+	}
+}
+
+func verifyState(c *cli.Context) error {
+	leaseDir := c.String("lease-dir")
+	if leaseDir == "" {
+		return fmt.Errorf("missing required parameter lease-dir")
+	}
+
+	statuses, err := util.VerifyLeaseFiles(leaseDir, c.Int("target-version"), c.Bool("migrate"))
+	if err != nil {
+		return errors.Wrap(err, "failed to verify lease files")
+	}
+	if err := util.PrintJSON(statuses); err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		if status.Error != "" {
+			return fmt.Errorf("failed to verify lease file %v: %v", status.Path, status.Error)
+		}
+	}
+	return nil
+}
@@ -24,7 +24,7 @@ TMP_DIR_BASE=".protobuild"
 TMP_DIR="${TMP_DIR_BASE}/github.com/longhorn/longhorn-instance-manager/pkg/imrpc/"
 mkdir -p "${TMP_DIR}"
 cp -a "${PKG_DIR}"/*.proto "${TMP_DIR}"
-for PROTO in common imrpc proxy disk instance; do
+for PROTO in common imrpc proxy disk instance chaos; do
     mkdir -p "integration/rpc/${PROTO}"
     python3 -m grpc_tools.protoc -I "${TMP_DIR_BASE}" -I "proto/vendor/" -I "proto/vendor/protobuf/src/" --python_out=integration/rpc/${PROTO} --grpc_python_out=integration/rpc/${PROTO} "${TMP_DIR}/${PROTO}.proto"
     protoc -I ${TMP_DIR_BASE}/ -I proto/vendor/ -I proto/vendor/protobuf/src/ "${TMP_DIR}/${PROTO}.proto" --go_out=plugins=grpc:"${TMP_DIR_BASE}"
@@ -39,4 +39,11 @@ for PROTO in common imrpc proxy disk instance; do
     fi
 done
 
+# The proxy messages embed the longhorn-engine ones, which the Python proxy client needs to import
+python3 -m grpc_tools.protoc -I "proto/vendor/" -I "proto/vendor/protobuf/src/" --python_out=integration/rpc/proxy \
+    proto/vendor/github.com/longhorn/longhorn-engine/proto/ptypes/common.proto \
+    proto/vendor/github.com/longhorn/longhorn-engine/proto/ptypes/controller.proto \
+    proto/vendor/github.com/longhorn/longhorn-engine/proto/ptypes/syncagent.proto
+rm -rf integration/rpc/proxy/github.com
+
 rm -rf "${TMP_DIR_BASE}"
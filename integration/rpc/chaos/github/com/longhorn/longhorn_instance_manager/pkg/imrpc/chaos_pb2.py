@@ -0,0 +1,38 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: github.com/longhorn/longhorn-instance-manager/pkg/imrpc/chaos.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nCgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/chaos.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\"^\n\x12\x46\x61ultInjectRequest\x12\x1e\n\x04type\x18\x01 \x01(\x0e\x32\x10.imrpc.FaultType\x12\x0e\n\x06target\x18\x02 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x03 \x01(\x03\"K\n\x05\x46\x61ult\x12\x1e\n\x04type\x18\x01 \x01(\x0e\x32\x10.imrpc.FaultType\x12\x0e\n\x06target\x18\x02 \x01(\t\x12\x12\n\nexpires_at\x18\x03 \x01(\x03\"3\n\x11\x46\x61ultClearRequest\x12\x1e\n\x04type\x18\x01 \x01(\x0e\x32\x10.imrpc.FaultType\"1\n\x11\x46\x61ultListResponse\x12\x1c\n\x06\x66\x61ults\x18\x01 \x03(\x0b\x32\x0c.imrpc.Fault*K\n\tFaultType\x12\x14\n\x10spdk_unavailable\x10\x00\x12\x11\n\rprocess_crash\x10\x01\x12\x15\n\x11watch_stream_drop\x10\x02\x32\xc5\x01\n\x0c\x43haosService\x12\x36\n\x0b\x46\x61ultInject\x12\x19.imrpc.FaultInjectRequest\x1a\x0c.imrpc.Fault\x12>\n\nFaultClear\x12\x18.imrpc.FaultClearRequest\x1a\x16.google.protobuf.Empty\x12=\n\tFaultList\x12\x16.google.protobuf.Empty\x1a\x18.imrpc.FaultListResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'github.com.longhorn.longhorn_instance_manager.pkg.imrpc.chaos_pb2', _globals)
+if _descriptor._USE_C_DESCRIPTORS == False:
+
+  DESCRIPTOR._options = None
+  DESCRIPTOR._serialized_options = b'Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpc'
+  _globals['_FAULTTYPE']._serialized_start=384
+  _globals['_FAULTTYPE']._serialized_end=459
+  _globals['_FAULTINJECTREQUEST']._serialized_start=107
+  _globals['_FAULTINJECTREQUEST']._serialized_end=201
+  _globals['_FAULT']._serialized_start=203
+  _globals['_FAULT']._serialized_end=278
+  _globals['_FAULTCLEARREQUEST']._serialized_start=280
+  _globals['_FAULTCLEARREQUEST']._serialized_end=331
+  _globals['_FAULTLISTRESPONSE']._serialized_start=333
+  _globals['_FAULTLISTRESPONSE']._serialized_end=382
+  _globals['_CHAOSSERVICE']._serialized_start=462
+  _globals['_CHAOSSERVICE']._serialized_end=659
+# @@protoc_insertion_point(module_scope)
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nBgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/disk.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\"\xb8\x01\n\x04\x44isk\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\x12\x0c\n\x04path\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x12\n\ntotal_size\x18\x05 \x01(\x03\x12\x11\n\tfree_size\x18\x06 \x01(\x03\x12\x14\n\x0ctotal_blocks\x18\x07 \x01(\x03\x12\x13\n\x0b\x66ree_blocks\x18\x08 \x01(\x03\x12\x12\n\nblock_size\x18\t \x01(\x03\x12\x14\n\x0c\x63luster_size\x18\n \x01(\x03\"{\n\x0fReplicaInstance\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\x12\x11\n\tdisk_name\x18\x03 \x01(\t\x12\x11\n\tdisk_uuid\x18\x04 \x01(\t\x12\x11\n\tspec_size\x18\x05 \x01(\x04\x12\x13\n\x0b\x61\x63tual_size\x18\x06 \x01(\x04\"\x84\x01\n\x11\x44iskCreateRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x11\n\tdisk_path\x18\x04 \x01(\t\x12\x12\n\nblock_size\x18\x05 \x01(\x03\"Z\n\x0e\x44iskGetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\"]\n\x11\x44iskDeleteRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\"W\n\x1e\x44iskReplicaInstanceListRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\"\xcb\x01\n\x1f\x44iskReplicaInstanceListResponse\x12W\n\x11replica_instances\x18\x01 \x03(\x0b\x32<.imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry\x1aO\n\x15ReplicaInstancesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.imrpc.ReplicaInstance:\x02\x38\x01\"\x8b\x01\n DiskReplicaInstanceDeleteRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x1d\n\x15replcia_instance_name\x18\x04 \x01(\t\"\xab\x01\n\x13\x44iskVersionResponse\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x11\n\tgitCommit\x18\x02 \x01(\t\x12\x11\n\tbuildDate\x18\x03 \x01(\t\x12,\n$instanceManagerDiskServiceAPIVersion\x18\x04 \x01(\x03\x12/\n\'instanceManagerDiskServiceAPIMinVersion\x18\x05 \x01(\x03*%\n\x08\x44iskType\x12\x0e\n\nfilesystem\x10\x00\x12\t\n\x05\x62lock\x10\x01\x32\xbb\x03\n\x0b\x44iskService\x12\x33\n\nDiskCreate\x12\x18.imrpc.DiskCreateRequest\x1a\x0b.imrpc.Disk\x12>\n\nDiskDelete\x12\x18.imrpc.DiskDeleteRequest\x1a\x16.google.protobuf.Empty\x12-\n\x07\x44iskGet\x12\x15.imrpc.DiskGetRequest\x1a\x0b.imrpc.Disk\x12h\n\x17\x44iskReplicaInstanceList\x12%.imrpc.DiskReplicaInstanceListRequest\x1a&.imrpc.DiskReplicaInstanceListResponse\x12\\\n\x19\x44iskReplicaInstanceDelete\x12\'.imrpc.DiskReplicaInstanceDeleteRequest\x1a\x16.google.protobuf.Empty\x12@\n\nVersionGet\x12\x16.google.protobuf.Empty\x1a\x1a.imrpc.DiskVersionResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nBgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/disk.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\"\x8d\x02\n\x04\x44isk\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\x12\x0c\n\x04path\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x12\n\ntotal_size\x18\x05 \x01(\x03\x12\x11\n\tfree_size\x18\x06 \x01(\x03\x12\x14\n\x0ctotal_blocks\x18\x07 \x01(\x03\x12\x13\n\x0b\x66ree_blocks\x18\x08 \x01(\x03\x12\x12\n\nblock_size\x18\t \x01(\x03\x12\x14\n\x0c\x63luster_size\x18\n \x01(\x03\x12\x11\n\tsafe_mode\x18\x0b \x01(\x08\x12\x19\n\x11safe_mode_reasons\x18\x0c \x03(\t\x12%\n\x05scrub\x18\r \x01(\x0b\x32\x16.imrpc.DiskScrubStatus\"{\n\x0fReplicaInstance\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\x12\x11\n\tdisk_name\x18\x03 \x01(\t\x12\x11\n\tdisk_uuid\x18\x04 \x01(\t\x12\x11\n\tspec_size\x18\x05 \x01(\x04\x12\x13\n\x0b\x61\x63tual_size\x18\x06 \x01(\x04\"\x84\x01\n\x11\x44iskCreateRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x11\n\tdisk_path\x18\x04 \x01(\t\x12\x12\n\nblock_size\x18\x05 \x01(\x03\"Z\n\x0e\x44iskGetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\"]\n\x11\x44iskDeleteRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\"W\n\x1e\x44iskReplicaInstanceListRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\"\xcb\x01\n\x1f\x44iskReplicaInstanceListResponse\x12W\n\x11replica_instances\x18\x01 \x03(\x0b\x32<.imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry\x1aO\n\x15ReplicaInstancesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.imrpc.ReplicaInstance:\x02\x38\x01\"\x8b\x01\n DiskReplicaInstanceDeleteRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x1d\n\x15replcia_instance_name\x18\x04 \x01(\t\"~\n\x0f\x44iskWipeRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\x12!\n\x04mode\x18\x04 \x01(\x0e\x32\x13.imrpc.DiskWipeMode\"\xb9\x01\n\x10\x44iskWipeProgress\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x11\n\tdisk_path\x18\x02 \x01(\t\x12!\n\x04mode\x18\x03 \x01(\x0e\x32\x13.imrpc.DiskWipeMode\x12\r\n\x05state\x18\x04 \x01(\t\x12\x13\n\x0btotal_bytes\x18\x05 \x01(\x03\x12\x13\n\x0bwiped_bytes\x18\x06 \x01(\x03\x12\x10\n\x08progress\x18\x07 \x01(\x05\x12\x11\n\terror_msg\x18\x08 \x01(\t\"\x8f\x01\n\x11\x44iskRepairRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x11\n\tdisk_path\x18\x04 \x01(\t\x12\x1d\n\x15remove_degraded_lvols\x18\x05 \x01(\x08\"q\n\x0e\x44iskWriteCache\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x11\n\tdisk_path\x18\x02 \x01(\t\x12\x0e\n\x06\x64\x65vice\x18\x03 \x01(\t\x12\x1c\n\x14volatile_write_cache\x18\x04 \x01(\x08\x12\x0b\n\x03\x66ua\x18\x05 \x01(\x08\"d\n\x18\x44iskWriteCacheGetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\"\x82\x01\n\x18\x44iskWriteCacheSetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\x12\x1c\n\x14volatile_write_cache\x18\x04 \x01(\x08\"\\\n\x10\x44iskFlushRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\"A\n\x10\x44iskHotplugEvent\x12\x0c\n\x04time\x18\x01 \x01(\t\x12\x0e\n\x06reason\x18\x02 \x01(\t\x12\x0f\n\x07message\x18\x03 \x01(\t\"\xf1\x01\n\x11\x44iskHotplugStatus\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x11\n\tdisk_uuid\x18\x02 \x01(\t\x12\x11\n\tdisk_path\x18\x03 \x01(\t\x12\x0e\n\x06\x64\x65vice\x18\x04 \x01(\t\x12\x11\n\tdevice_id\x18\x05 \x01(\t\x12\r\n\x05state\x18\x06 \x01(\t\x12\x0f\n\x07message\x18\x07 \x01(\t\x12\x1c\n\x14last_transition_time\x18\x08 \x01(\t\x12\x19\n\x11\x61\x66\x66\x65\x63ted_replicas\x18\t \x03(\t\x12\'\n\x06\x65vents\x18\n \x03(\x0b\x32\x17.imrpc.DiskHotplugEvent\"T\n\x1b\x44iskHotplugStatusGetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\"A\n\x0e\x44iskScrubError\x12\x0e\n\x06offset\x18\x01 \x01(\x03\x12\x0e\n\x06length\x18\x02 \x01(\x03\x12\x0f\n\x07message\x18\x03 \x01(\t\"\xaa\x01\n\x0f\x44iskScrubResult\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x02 \x01(\t\x12\r\n\x05state\x18\x03 \x01(\t\x12\x15\n\rscanned_bytes\x18\x04 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x05 \x01(\x03\x12%\n\x06\x65rrors\x18\x06 \x03(\x0b\x32\x15.imrpc.DiskScrubError\x12\x0f\n\x07message\x18\x07 \x01(\t\"?\n\x0e\x44iskScrubEvent\x12\x0c\n\x04time\x18\x01 \x01(\t\x12\x0e\n\x06reason\x18\x02 \x01(\t\x12\x0f\n\x07message\x18\x03 \x01(\t\"\xe7\x02\n\x0f\x44iskScrubStatus\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x11\n\tdisk_path\x18\x02 \x01(\t\x12\x0e\n\x06\x64\x65vice\x18\x03 \x01(\t\x12\x18\n\x10interval_seconds\x18\x04 \x01(\x03\x12\"\n\x1a\x62\x61ndwidth_bytes_per_second\x18\x05 \x01(\x03\x12\r\n\x05state\x18\x06 \x01(\t\x12\x15\n\rnext_run_time\x18\x07 \x01(\t\x12\x15\n\rscanned_bytes\x18\x08 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\t \x01(\x03\x12\x17\n\x0f\x65rrors_detected\x18\n \x01(\x08\x12%\n\x06\x65rrors\x18\x0b \x03(\x0b\x32\x15.imrpc.DiskScrubError\x12\'\n\x07history\x18\x0c \x03(\x0b\x32\x16.imrpc.DiskScrubResult\x12%\n\x06\x65vents\x18\r \x03(\x0b\x32\x15.imrpc.DiskScrubEvent\"R\n\x19\x44iskScrubStatusGetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\"\x9d\x01\n\x13\x44iskScrubSetRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x18\n\x10interval_seconds\x18\x03 \x01(\x03\x12\"\n\x1a\x62\x61ndwidth_bytes_per_second\x18\x04 \x01(\x03\x12\x11\n\tstart_now\x18\x05 \x01(\x08\"\xc0\x01\n\x0eSpdkMemoryHeap\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\theap_size\x18\x03 \x01(\x04\x12\x11\n\tfree_size\x18\x04 \x01(\x04\x12\x12\n\nalloc_size\x18\x05 \x01(\x04\x12\x1a\n\x12greatest_free_size\x18\x06 \x01(\x04\x12\x13\n\x0b\x61lloc_count\x18\x07 \x01(\x04\x12\x12\n\nfree_count\x18\x08 \x01(\x04\x12\x15\n\rfragmentation\x18\t \x01(\x01\"b\n\x0bSpdkMempool\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\x04\x12\x14\n\x0c\x65lement_size\x18\x03 \x01(\x04\x12\x11\n\tavailable\x18\x04 \x01(\x04\x12\x0e\n\x06in_use\x18\x05 \x01(\x04\"@\n\x12SpdkIobufPoolStats\x12\r\n\x05\x63\x61\x63he\x18\x01 \x01(\x04\x12\x0c\n\x04main\x18\x02 \x01(\x04\x12\r\n\x05retry\x18\x03 \x01(\x04\"~\n\x0eSpdkIobufStats\x12\x0e\n\x06module\x18\x01 \x01(\t\x12-\n\nsmall_pool\x18\x02 \x01(\x0b\x32\x19.imrpc.SpdkIobufPoolStats\x12-\n\nlarge_pool\x18\x03 \x01(\x0b\x32\x19.imrpc.SpdkIobufPoolStats\"c\n\x0eHugepagesStats\x12\x11\n\tpage_size\x18\x01 \x01(\x04\x12\r\n\x05total\x18\x02 \x01(\x04\x12\x0c\n\x04\x66ree\x18\x03 \x01(\x04\x12\x10\n\x08reserved\x18\x04 \x01(\x04\x12\x0f\n\x07surplus\x18\x05 \x01(\x04\"\xb3\x01\n\x0fSpdkMemoryStats\x12$\n\x05heaps\x18\x01 \x03(\x0b\x32\x15.imrpc.SpdkMemoryHeap\x12$\n\x08mempools\x18\x02 \x03(\x0b\x32\x12.imrpc.SpdkMempool\x12*\n\x0biobuf_stats\x18\x03 \x03(\x0b\x32\x15.imrpc.SpdkIobufStats\x12(\n\thugepages\x18\x04 \x03(\x0b\x32\x15.imrpc.HugepagesStats\"\xab\x01\n\x13\x44iskVersionResponse\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x11\n\tgitCommit\x18\x02 \x01(\t\x12\x11\n\tbuildDate\x18\x03 \x01(\t\x12,\n$instanceManagerDiskServiceAPIVersion\x18\x04 \x01(\x03\x12/\n\'instanceManagerDiskServiceAPIMinVersion\x18\x05 \x01(\x03\"I\n\x10\x44iskWatchRequest\x12\"\n\tdisk_type\x18\x01 \x01(\x0e\x32\x0f.imrpc.DiskType\x12\x11\n\tdisk_name\x18\x02 \x01(\t\"\xfa\x01\n\x0e\x44iskWatchEvent\x12\x12\n\nevent_type\x18\x01 \x01(\t\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x11\n\tdisk_path\x18\x04 \x01(\t\x12\x12\n\nold_device\x18\x05 \x01(\t\x12\x12\n\nnew_device\x18\x06 \x01(\t\x12\x15\n\rold_io_errors\x18\x07 \x01(\x04\x12\x15\n\rnew_io_errors\x18\x08 \x01(\x04\x12\x18\n\x10old_health_score\x18\t \x01(\x05\x12\x18\n\x10new_health_score\x18\n \x01(\x05\x12\x11\n\ttimestamp\x18\x0b \x01(\t*%\n\x08\x44iskType\x12\x0e\n\nfilesystem\x10\x00\x12\t\n\x05\x62lock\x10\x01*h\n\x0c\x44iskWipeMode\x12\x19\n\x15wipe_mode_unspecified\x10\x00\x12\x0b\n\x07\x64iscard\x10\x01\x12\x08\n\x04zero\x10\x02\x12\x0f\n\x0bnvme_format\x10\x03\x12\x15\n\x11nvme_secure_erase\x10\x04\x32\xf6\x08\n\x0b\x44iskService\x12\x33\n\nDiskCreate\x12\x18.imrpc.DiskCreateRequest\x1a\x0b.imrpc.Disk\x12>\n\nDiskDelete\x12\x18.imrpc.DiskDeleteRequest\x1a\x16.google.protobuf.Empty\x12-\n\x07\x44iskGet\x12\x15.imrpc.DiskGetRequest\x1a\x0b.imrpc.Disk\x12h\n\x17\x44iskReplicaInstanceList\x12%.imrpc.DiskReplicaInstanceListRequest\x1a&.imrpc.DiskReplicaInstanceListResponse\x12\\\n\x19\x44iskReplicaInstanceDelete\x12\'.imrpc.DiskReplicaInstanceDeleteRequest\x1a\x16.google.protobuf.Empty\x12=\n\x08\x44iskWipe\x12\x16.imrpc.DiskWipeRequest\x1a\x17.imrpc.DiskWipeProgress0\x01\x12\x33\n\nDiskRepair\x12\x18.imrpc.DiskRepairRequest\x1a\x0b.imrpc.Disk\x12\x44\n\x12SpdkMemoryStatsGet\x12\x16.google.protobuf.Empty\x1a\x16.imrpc.SpdkMemoryStats\x12K\n\x11\x44iskWriteCacheGet\x12\x1f.imrpc.DiskWriteCacheGetRequest\x1a\x15.imrpc.DiskWriteCache\x12K\n\x11\x44iskWriteCacheSet\x12\x1f.imrpc.DiskWriteCacheSetRequest\x1a\x15.imrpc.DiskWriteCache\x12<\n\tDiskFlush\x12\x17.imrpc.DiskFlushRequest\x1a\x16.google.protobuf.Empty\x12T\n\x14\x44iskHotplugStatusGet\x12\".imrpc.DiskHotplugStatusGetRequest\x1a\x18.imrpc.DiskHotplugStatus\x12N\n\x12\x44iskScrubStatusGet\x12 .imrpc.DiskScrubStatusGetRequest\x1a\x16.imrpc.DiskScrubStatus\x12\x42\n\x0c\x44iskScrubSet\x12\x1a.imrpc.DiskScrubSetRequest\x1a\x16.imrpc.DiskScrubStatus\x12=\n\tDiskWatch\x12\x17.imrpc.DiskWatchRequest\x1a\x15.imrpc.DiskWatchEvent0\x01\x12@\n\nVersionGet\x12\x16.google.protobuf.Empty\x1a\x1a.imrpc.DiskVersionResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -25,28 +25,78 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._serialized_options = b'Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpc'
   _DISKREPLICAINSTANCELISTRESPONSE_REPLICAINSTANCESENTRY._options = None
   _DISKREPLICAINSTANCELISTRESPONSE_REPLICAINSTANCESENTRY._serialized_options = b'8\001'
-  _globals['_DISKTYPE']._serialized_start=1351
-  _globals['_DISKTYPE']._serialized_end=1388
+  _globals['_DISKTYPE']._serialized_start=4750
+  _globals['_DISKTYPE']._serialized_end=4787
+  _globals['_DISKWIPEMODE']._serialized_start=4789
+  _globals['_DISKWIPEMODE']._serialized_end=4893
   _globals['_DISK']._serialized_start=107
-  _globals['_DISK']._serialized_end=291
-  _globals['_REPLICAINSTANCE']._serialized_start=293
-  _globals['_REPLICAINSTANCE']._serialized_end=416
-  _globals['_DISKCREATEREQUEST']._serialized_start=419
-  _globals['_DISKCREATEREQUEST']._serialized_end=551
-  _globals['_DISKGETREQUEST']._serialized_start=553
-  _globals['_DISKGETREQUEST']._serialized_end=643
-  _globals['_DISKDELETEREQUEST']._serialized_start=645
-  _globals['_DISKDELETEREQUEST']._serialized_end=738
-  _globals['_DISKREPLICAINSTANCELISTREQUEST']._serialized_start=740
-  _globals['_DISKREPLICAINSTANCELISTREQUEST']._serialized_end=827
-  _globals['_DISKREPLICAINSTANCELISTRESPONSE']._serialized_start=830
-  _globals['_DISKREPLICAINSTANCELISTRESPONSE']._serialized_end=1033
-  _globals['_DISKREPLICAINSTANCELISTRESPONSE_REPLICAINSTANCESENTRY']._serialized_start=954
-  _globals['_DISKREPLICAINSTANCELISTRESPONSE_REPLICAINSTANCESENTRY']._serialized_end=1033
-  _globals['_DISKREPLICAINSTANCEDELETEREQUEST']._serialized_start=1036
-  _globals['_DISKREPLICAINSTANCEDELETEREQUEST']._serialized_end=1175
-  _globals['_DISKVERSIONRESPONSE']._serialized_start=1178
-  _globals['_DISKVERSIONRESPONSE']._serialized_end=1349
-  _globals['_DISKSERVICE']._serialized_start=1391
-  _globals['_DISKSERVICE']._serialized_end=1834
+  _globals['_DISK']._serialized_end=376
+  _globals['_REPLICAINSTANCE']._serialized_start=378
+  _globals['_REPLICAINSTANCE']._serialized_end=501
+  _globals['_DISKCREATEREQUEST']._serialized_start=504
+  _globals['_DISKCREATEREQUEST']._serialized_end=636
+  _globals['_DISKGETREQUEST']._serialized_start=638
+  _globals['_DISKGETREQUEST']._serialized_end=728
+  _globals['_DISKDELETEREQUEST']._serialized_start=730
+  _globals['_DISKDELETEREQUEST']._serialized_end=823
+  _globals['_DISKREPLICAINSTANCELISTREQUEST']._serialized_start=825
+  _globals['_DISKREPLICAINSTANCELISTREQUEST']._serialized_end=912
+  _globals['_DISKREPLICAINSTANCELISTRESPONSE']._serialized_start=915
+  _globals['_DISKREPLICAINSTANCELISTRESPONSE']._serialized_end=1118
+  _globals['_DISKREPLICAINSTANCELISTRESPONSE_REPLICAINSTANCESENTRY']._serialized_start=1039
+  _globals['_DISKREPLICAINSTANCELISTRESPONSE_REPLICAINSTANCESENTRY']._serialized_end=1118
+  _globals['_DISKREPLICAINSTANCEDELETEREQUEST']._serialized_start=1121
+  _globals['_DISKREPLICAINSTANCEDELETEREQUEST']._serialized_end=1260
+  _globals['_DISKWIPEREQUEST']._serialized_start=1262
+  _globals['_DISKWIPEREQUEST']._serialized_end=1388
+  _globals['_DISKWIPEPROGRESS']._serialized_start=1391
+  _globals['_DISKWIPEPROGRESS']._serialized_end=1576
+  _globals['_DISKREPAIRREQUEST']._serialized_start=1579
+  _globals['_DISKREPAIRREQUEST']._serialized_end=1722
+  _globals['_DISKWRITECACHE']._serialized_start=1724
+  _globals['_DISKWRITECACHE']._serialized_end=1837
+  _globals['_DISKWRITECACHEGETREQUEST']._serialized_start=1839
+  _globals['_DISKWRITECACHEGETREQUEST']._serialized_end=1939
+  _globals['_DISKWRITECACHESETREQUEST']._serialized_start=1942
+  _globals['_DISKWRITECACHESETREQUEST']._serialized_end=2072
+  _globals['_DISKFLUSHREQUEST']._serialized_start=2074
+  _globals['_DISKFLUSHREQUEST']._serialized_end=2166
+  _globals['_DISKHOTPLUGEVENT']._serialized_start=2168
+  _globals['_DISKHOTPLUGEVENT']._serialized_end=2233
+  _globals['_DISKHOTPLUGSTATUS']._serialized_start=2236
+  _globals['_DISKHOTPLUGSTATUS']._serialized_end=2477
+  _globals['_DISKHOTPLUGSTATUSGETREQUEST']._serialized_start=2479
+  _globals['_DISKHOTPLUGSTATUSGETREQUEST']._serialized_end=2563
+  _globals['_DISKSCRUBERROR']._serialized_start=2565
+  _globals['_DISKSCRUBERROR']._serialized_end=2630
+  _globals['_DISKSCRUBRESULT']._serialized_start=2633
+  _globals['_DISKSCRUBRESULT']._serialized_end=2803
+  _globals['_DISKSCRUBEVENT']._serialized_start=2805
+  _globals['_DISKSCRUBEVENT']._serialized_end=2868
+  _globals['_DISKSCRUBSTATUS']._serialized_start=2871
+  _globals['_DISKSCRUBSTATUS']._serialized_end=3230
+  _globals['_DISKSCRUBSTATUSGETREQUEST']._serialized_start=3232
+  _globals['_DISKSCRUBSTATUSGETREQUEST']._serialized_end=3314
+  _globals['_DISKSCRUBSETREQUEST']._serialized_start=3317
+  _globals['_DISKSCRUBSETREQUEST']._serialized_end=3474
+  _globals['_SPDKMEMORYHEAP']._serialized_start=3477
+  _globals['_SPDKMEMORYHEAP']._serialized_end=3669
+  _globals['_SPDKMEMPOOL']._serialized_start=3671
+  _globals['_SPDKMEMPOOL']._serialized_end=3769
+  _globals['_SPDKIOBUFPOOLSTATS']._serialized_start=3771
+  _globals['_SPDKIOBUFPOOLSTATS']._serialized_end=3835
+  _globals['_SPDKIOBUFSTATS']._serialized_start=3837
+  _globals['_SPDKIOBUFSTATS']._serialized_end=3963
+  _globals['_HUGEPAGESSTATS']._serialized_start=3965
+  _globals['_HUGEPAGESSTATS']._serialized_end=4064
+  _globals['_SPDKMEMORYSTATS']._serialized_start=4067
+  _globals['_SPDKMEMORYSTATS']._serialized_end=4246
+  _globals['_DISKVERSIONRESPONSE']._serialized_start=4249
+  _globals['_DISKVERSIONRESPONSE']._serialized_end=4420
+  _globals['_DISKWATCHREQUEST']._serialized_start=4422
+  _globals['_DISKWATCHREQUEST']._serialized_end=4495
+  _globals['_DISKWATCHEVENT']._serialized_start=4498
+  _globals['_DISKWATCHEVENT']._serialized_end=4748
+  _globals['_DISKSERVICE']._serialized_start=4896
+  _globals['_DISKSERVICE']._serialized_end=6038
 # @@protoc_insertion_point(module_scope)
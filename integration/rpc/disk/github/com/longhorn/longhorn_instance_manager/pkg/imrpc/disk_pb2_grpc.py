@@ -40,6 +40,56 @@ class DiskServiceStub(object):
                 request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskReplicaInstanceDeleteRequest.SerializeToString,
                 response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
                 )
+        self.DiskWipe = channel.unary_stream(
+                '/imrpc.DiskService/DiskWipe',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWipeRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWipeProgress.FromString,
+                )
+        self.DiskRepair = channel.unary_unary(
+                '/imrpc.DiskService/DiskRepair',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskRepairRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.Disk.FromString,
+                )
+        self.SpdkMemoryStatsGet = channel.unary_unary(
+                '/imrpc.DiskService/SpdkMemoryStatsGet',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.SpdkMemoryStats.FromString,
+                )
+        self.DiskWriteCacheGet = channel.unary_unary(
+                '/imrpc.DiskService/DiskWriteCacheGet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCacheGetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCache.FromString,
+                )
+        self.DiskWriteCacheSet = channel.unary_unary(
+                '/imrpc.DiskService/DiskWriteCacheSet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCacheSetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCache.FromString,
+                )
+        self.DiskFlush = channel.unary_unary(
+                '/imrpc.DiskService/DiskFlush',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskFlushRequest.SerializeToString,
+                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                )
+        self.DiskHotplugStatusGet = channel.unary_unary(
+                '/imrpc.DiskService/DiskHotplugStatusGet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskHotplugStatusGetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskHotplugStatus.FromString,
+                )
+        self.DiskScrubStatusGet = channel.unary_unary(
+                '/imrpc.DiskService/DiskScrubStatusGet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatusGetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatus.FromString,
+                )
+        self.DiskScrubSet = channel.unary_unary(
+                '/imrpc.DiskService/DiskScrubSet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubSetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatus.FromString,
+                )
+        self.DiskWatch = channel.unary_stream(
+                '/imrpc.DiskService/DiskWatch',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWatchRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWatchEvent.FromString,
+                )
         self.VersionGet = channel.unary_unary(
                 '/imrpc.DiskService/VersionGet',
                 request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -80,6 +130,66 @@ class DiskServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def DiskWipe(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskRepair(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SpdkMemoryStatsGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskWriteCacheGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskWriteCacheSet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskFlush(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskHotplugStatusGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskScrubStatusGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskScrubSet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DiskWatch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def VersionGet(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -114,6 +224,56 @@ def add_DiskServiceServicer_to_server(servicer, server):
                     request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskReplicaInstanceDeleteRequest.FromString,
                     response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
             ),
+            'DiskWipe': grpc.unary_stream_rpc_method_handler(
+                    servicer.DiskWipe,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWipeRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWipeProgress.SerializeToString,
+            ),
+            'DiskRepair': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskRepair,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskRepairRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.Disk.SerializeToString,
+            ),
+            'SpdkMemoryStatsGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.SpdkMemoryStatsGet,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.SpdkMemoryStats.SerializeToString,
+            ),
+            'DiskWriteCacheGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskWriteCacheGet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCacheGetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCache.SerializeToString,
+            ),
+            'DiskWriteCacheSet': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskWriteCacheSet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCacheSetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCache.SerializeToString,
+            ),
+            'DiskFlush': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskFlush,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskFlushRequest.FromString,
+                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            ),
+            'DiskHotplugStatusGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskHotplugStatusGet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskHotplugStatusGetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskHotplugStatus.SerializeToString,
+            ),
+            'DiskScrubStatusGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskScrubStatusGet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatusGetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatus.SerializeToString,
+            ),
+            'DiskScrubSet': grpc.unary_unary_rpc_method_handler(
+                    servicer.DiskScrubSet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubSetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatus.SerializeToString,
+            ),
+            'DiskWatch': grpc.unary_stream_rpc_method_handler(
+                    servicer.DiskWatch,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWatchRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWatchEvent.SerializeToString,
+            ),
             'VersionGet': grpc.unary_unary_rpc_method_handler(
                     servicer.VersionGet,
                     request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
@@ -214,6 +374,176 @@ class DiskService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def DiskWipe(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/imrpc.DiskService/DiskWipe',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWipeRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWipeProgress.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskRepair(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskRepair',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskRepairRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.Disk.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SpdkMemoryStatsGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/SpdkMemoryStatsGet',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.SpdkMemoryStats.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskWriteCacheGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskWriteCacheGet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCacheGetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCache.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskWriteCacheSet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskWriteCacheSet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCacheSetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWriteCache.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskFlush(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskFlush',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskFlushRequest.SerializeToString,
+            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskHotplugStatusGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskHotplugStatusGet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskHotplugStatusGetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskHotplugStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskScrubStatusGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskScrubStatusGet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatusGetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskScrubSet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/imrpc.DiskService/DiskScrubSet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubSetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskScrubStatus.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DiskWatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/imrpc.DiskService/DiskWatch',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWatchRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_disk__pb2.DiskWatchEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def VersionGet(request,
             target,
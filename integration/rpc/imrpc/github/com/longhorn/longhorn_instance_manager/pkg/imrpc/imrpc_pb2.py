@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nCgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/imrpc.proto\x1a\x1bgoogle/protobuf/empty.proto\"`\n\x0bProcessSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06\x62inary\x18\x02 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x03 \x03(\t\x12\x12\n\nport_count\x18\x04 \x01(\x05\x12\x11\n\tport_args\x18\x05 \x03(\t\"\xbe\x01\n\rProcessStatus\x12\r\n\x05state\x18\x01 \x01(\t\x12\x11\n\terror_msg\x18\x02 \x01(\t\x12\x12\n\nport_start\x18\x03 \x01(\x05\x12\x10\n\x08port_end\x18\x04 \x01(\x05\x12\x32\n\nconditions\x18\x05 \x03(\x0b\x32\x1e.ProcessStatus.ConditionsEntry\x1a\x31\n\x0f\x43onditionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"2\n\x14ProcessCreateRequest\x12\x1a\n\x04spec\x18\x01 \x01(\x0b\x32\x0c.ProcessSpec\"$\n\x14ProcessDeleteRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"!\n\x11ProcessGetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"^\n\x0fProcessResponse\x12\x1a\n\x04spec\x18\x01 \x01(\x0b\x32\x0c.ProcessSpec\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.ProcessStatus\x12\x0f\n\x07\x64\x65leted\x18\x03 \x01(\x08\"\x14\n\x12ProcessListRequest\"\x91\x01\n\x13ProcessListResponse\x12\x36\n\tprocesses\x18\x01 \x03(\x0b\x32#.ProcessListResponse.ProcessesEntry\x1a\x42\n\x0eProcessesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x1f\n\x05value\x18\x02 \x01(\x0b\x32\x10.ProcessResponse:\x02\x38\x01\"\x1a\n\nLogRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"M\n\x15ProcessReplaceRequest\x12\x1a\n\x04spec\x18\x01 \x01(\x0b\x32\x0c.ProcessSpec\x12\x18\n\x10terminate_signal\x18\x02 \x01(\t\"\x1b\n\x0bLogResponse\x12\x0c\n\x04line\x18\x02 \x01(\t\"\xe4\x01\n\x0fVersionResponse\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x11\n\tgitCommit\x18\x02 \x01(\t\x12\x11\n\tbuildDate\x18\x03 \x01(\t\x12!\n\x19instanceManagerAPIVersion\x18\x04 \x01(\x03\x12$\n\x1cinstanceManagerAPIMinVersion\x18\x05 \x01(\x03\x12&\n\x1einstanceManagerProxyAPIVersion\x18\x06 \x01(\x03\x12)\n!instanceManagerProxyAPIMinVersion\x18\x07 \x01(\x03\x32\xe2\x03\n\x15ProcessManagerService\x12:\n\rProcessCreate\x12\x15.ProcessCreateRequest\x1a\x10.ProcessResponse\"\x00\x12:\n\rProcessDelete\x12\x15.ProcessDeleteRequest\x1a\x10.ProcessResponse\"\x00\x12\x34\n\nProcessGet\x12\x12.ProcessGetRequest\x1a\x10.ProcessResponse\"\x00\x12:\n\x0bProcessList\x12\x13.ProcessListRequest\x1a\x14.ProcessListResponse\"\x00\x12+\n\nProcessLog\x12\x0b.LogRequest\x1a\x0c.LogResponse\"\x00\x30\x01\x12<\n\x0cProcessWatch\x12\x16.google.protobuf.Empty\x1a\x10.ProcessResponse\"\x00\x30\x01\x12<\n\x0eProcessReplace\x12\x16.ProcessReplaceRequest\x1a\x10.ProcessResponse\"\x00\x12\x36\n\nVersionGet\x12\x16.google.protobuf.Empty\x1a\x10.VersionResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nCgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/imrpc.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe3\x03\n\x0bProcessSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06\x62inary\x18\x02 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x03 \x03(\t\x12\x12\n\nport_count\x18\x04 \x01(\x05\x12\x11\n\tport_args\x18\x05 \x03(\t\x12%\n\x08sidecars\x18\x06 \x03(\x0b\x32\x13.ProcessSidecarSpec\x12$\n\x04\x65nvs\x18\x07 \x03(\x0b\x32\x16.ProcessSpec.EnvsEntry\x12(\n\x06labels\x18\x08 \x03(\x0b\x32\x18.ProcessSpec.LabelsEntry\x12\x16\n\x0epriority_class\x18\t \x01(\t\x12\x16\n\x0e\x63pu_millicores\x18\n \x01(\x03\x12\x14\n\x0cmemory_bytes\x18\x0b \x01(\x03\x12\x0f\n\x07\x63pu_set\x18\x0c \x01(\t\x12\x11\n\tnuma_node\x18\r \x01(\t\x12&\n\nprivileges\x18\x0e \x01(\x0b\x32\x12.ProcessPrivileges\x12\x1c\n\x14stop_timeout_seconds\x18\x0f \x01(\x03\x1a+\n\tEnvsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"D\n\x11ProcessPrivileges\x12\x14\n\x0c\x63\x61pabilities\x18\x01 \x03(\t\x12\x19\n\x11no_new_privileges\x18\x02 \x01(\x08\"@\n\x12ProcessSidecarSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06\x62inary\x18\x02 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x03 \x03(\t\"\x9d\x02\n\rProcessStatus\x12\r\n\x05state\x18\x01 \x01(\t\x12\x11\n\terror_msg\x18\x02 \x01(\t\x12\x12\n\nport_start\x18\x03 \x01(\x05\x12\x10\n\x08port_end\x18\x04 \x01(\x05\x12\x32\n\nconditions\x18\x05 \x03(\x0b\x32\x1e.ProcessStatus.ConditionsEntry\x12\'\n\x08sidecars\x18\x06 \x03(\x0b\x32\x15.ProcessSidecarStatus\x12&\n\x0eresource_usage\x18\x07 \x01(\x0b\x32\x0e.ResourceUsage\x12\x0c\n\x04uuid\x18\x08 \x01(\t\x1a\x31\n\x0f\x43onditionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"q\n\rResourceUsage\x12\x13\n\x0b\x63pu_time_ns\x18\x01 \x01(\x04\x12\x11\n\trss_bytes\x18\x02 \x01(\x04\x12\x10\n\x08open_fds\x18\x03 \x01(\x05\x12\x16\n\x0euptime_seconds\x18\x04 \x01(\x03\x12\x0e\n\x06shared\x18\x05 \x01(\x08\"F\n\x14ProcessSidecarStatus\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05state\x18\x02 \x01(\t\x12\x11\n\terror_msg\x18\x03 \x01(\t\"I\n\x14ProcessCreateRequest\x12\x1a\n\x04spec\x18\x01 \x01(\x0b\x32\x0c.ProcessSpec\x12\x15\n\rvalidate_only\x18\x02 \x01(\x08\"B\n\x14ProcessDeleteRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x1c\n\x14stop_timeout_seconds\x18\x02 \x01(\x03\"!\n\x11ProcessGetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"%\n\x15ProcessRefreshRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"%\n\x15ProcessSuspendRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"$\n\x14ProcessResumeRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"=\n\x1cProcessRebuildModeSetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07\x65nabled\x18\x02 \x01(\x08\"^\n\x0fProcessResponse\x12\x1a\n\x04spec\x18\x01 \x01(\x0b\x32\x0c.ProcessSpec\x12\x1e\n\x06status\x18\x02 \x01(\x0b\x32\x0e.ProcessStatus\x12\x0f\n\x07\x64\x65leted\x18\x03 \x01(\x08\"\x14\n\x12ProcessListRequest\"\x91\x01\n\x13ProcessListResponse\x12\x36\n\tprocesses\x18\x01 \x03(\x0b\x32#.ProcessListResponse.ProcessesEntry\x1a\x42\n\x0eProcessesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x1f\n\x05value\x18\x02 \x01(\x0b\x32\x10.ProcessResponse:\x02\x38\x01\"W\n\nLogRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x16\n\x0esince_sequence\x18\x02 \x01(\x04\x12\x0f\n\x07\x62\x61tched\x18\x03 \x01(\x08\x12\x12\n\ncompressed\x18\x04 \x01(\x08\"M\n\x15ProcessReplaceRequest\x12\x1a\n\x04spec\x18\x01 \x01(\x0b\x32\x0c.ProcessSpec\x12\x18\n\x10terminate_signal\x18\x02 \x01(\t\"\\\n\x18ProcessBulkDeleteRequest\x12\r\n\x05names\x18\x01 \x03(\t\x12\x13\n\x0b\x63oncurrency\x18\x02 \x01(\x05\x12\x1c\n\x14stop_timeout_seconds\x18\x03 \x01(\x03\"9\n!ProcessBulkDeleteStatusGetRequest\x12\x14\n\x0coperation_id\x18\x01 \x01(\t\"\xd7\x01\n\x19ProcessBulkDeleteResponse\x12\x14\n\x0coperation_id\x18\x01 \x01(\t\x12\r\n\x05state\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x05\x12\x0f\n\x07\x64\x65leted\x18\x04 \x01(\x05\x12\x0e\n\x06\x66\x61iled\x18\x05 \x01(\x05\x12\x36\n\x06\x65rrors\x18\x06 \x03(\x0b\x32&.ProcessBulkDeleteResponse.ErrorsEntry\x1a-\n\x0b\x45rrorsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\'\n\x14PortReconcileRequest\x12\x0f\n\x07\x64ry_run\x18\x01 \x01(\x08\"~\n\x0fPortDiscrepancy\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x14\n\x0cprocess_name\x18\x02 \x01(\t\x12\x12\n\nport_start\x18\x03 \x01(\x05\x12\x10\n\x08port_end\x18\x04 \x01(\x05\x12\x0f\n\x07message\x18\x05 \x01(\t\x12\x10\n\x08repaired\x18\x06 \x01(\x08\"@\n\x15PortReconcileResponse\x12\'\n\rdiscrepancies\x18\x01 \x03(\x0b\x32\x10.PortDiscrepancy\"\x82\x01\n\x10ProcessPortEvent\x12\x0e\n\x06\x61\x63tion\x18\x01 \x01(\t\x12\x14\n\x0cprocess_name\x18\x02 \x01(\t\x12\x14\n\x0cprocess_uuid\x18\x03 \x01(\t\x12\x12\n\nport_start\x18\x04 \x01(\x05\x12\x10\n\x08port_end\x18\x05 \x01(\x05\x12\x0c\n\x04time\x18\x06 \x01(\t\"z\n\x15ProcessPortAllocation\x12\x12\n\nport_start\x18\x01 \x01(\x05\x12\x10\n\x08port_end\x18\x02 \x01(\x05\x12\x14\n\x0cprocess_name\x18\x03 \x01(\t\x12\x12\n\nowner_type\x18\x04 \x01(\t\x12\x11\n\tallocated\x18\x05 \x01(\x08\"\x7f\n\x12ProcessProbeStatus\x12\x14\n\x0cprocess_name\x18\x01 \x01(\t\x12\r\n\x05state\x18\x02 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x03 \x01(\t\x12\x0e\n\x06probed\x18\x04 \x01(\x08\x12\x0f\n\x07healthy\x18\x05 \x01(\x08\x12\x12\n\nlatency_ms\x18\x06 \x01(\x03\"D\n\x14ProcessStopOperation\x12\x14\n\x0cprocess_name\x18\x01 \x01(\t\x12\x16\n\x0estopping_since\x18\x02 \x01(\t\"\x95\x02\n\x1bProcessManagerDebugResponse\x12\x18\n\x10port_range_start\x18\x01 \x01(\x05\x12\x16\n\x0eport_range_end\x18\x02 \x01(\x05\x12\x30\n\x10port_allocations\x18\x03 \x03(\x0b\x32\x16.ProcessPortAllocation\x12#\n\x06probes\x18\x04 \x03(\x0b\x32\x13.ProcessProbeStatus\x12\x31\n\x12stopping_processes\x18\x05 \x03(\x0b\x32\x15.ProcessStopOperation\x12:\n\x16\x62ulk_delete_operations\x18\x06 \x03(\x0b\x32\x1a.ProcessBulkDeleteResponse\"A\n\x1cProcessPortEventListResponse\x12!\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x11.ProcessPortEvent\"l\n\x1b\x45ngineBinaryValidateRequest\x12\x0e\n\x06\x62inary\x18\x01 \x01(\t\x12\'\n\x0c\x64ry_run_args\x18\x02 \x03(\x0b\x32\x11.EngineBinaryArgs\x12\x14\n\x0cio_self_test\x18\x03 \x01(\x08\" \n\x10\x45ngineBinaryArgs\x12\x0c\n\x04\x61rgs\x18\x01 \x03(\t\"B\n\x11\x45ngineBinaryCheck\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06passed\x18\x02 \x01(\x08\x12\x0f\n\x07message\x18\x03 \x01(\t\"\xc7\x02\n\x1c\x45ngineBinaryValidateResponse\x12\x12\n\ncompatible\x18\x01 \x01(\x08\x12\"\n\x06\x63hecks\x18\x02 \x03(\x0b\x32\x12.EngineBinaryCheck\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x12\n\ngit_commit\x18\x04 \x01(\t\x12\x12\n\nbuild_date\x18\x05 \x01(\t\x12\x17\n\x0f\x63li_api_version\x18\x06 \x01(\x03\x12\x1b\n\x13\x63li_api_min_version\x18\x07 \x01(\x03\x12\x1e\n\x16\x63ontroller_api_version\x18\x08 \x01(\x03\x12\"\n\x1a\x63ontroller_api_min_version\x18\t \x01(\x03\x12\x1b\n\x13\x64\x61ta_format_version\x18\n \x01(\x03\x12\x1f\n\x17\x64\x61ta_format_min_version\x18\x0b \x01(\x03\"\xa8\x01\n\x0c\x45ngineBinary\x12\x0e\n\x06\x62inary\x18\x01 \x01(\t\x12\r\n\x05image\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\x03\x12\x13\n\x0bmodified_at\x18\x04 \x01(\t\x12\x10\n\x08\x63hecksum\x18\x05 \x01(\t\x12\x31\n\nvalidation\x18\x06 \x01(\x0b\x32\x1d.EngineBinaryValidateResponse\x12\x11\n\terror_msg\x18\x07 \x01(\t\";\n\x18\x45ngineBinaryListResponse\x12\x1f\n\x08\x62inaries\x18\x01 \x03(\x0b\x32\r.EngineBinary\"c\n\x0bLogResponse\x12\x0c\n\x04line\x18\x02 \x01(\t\x12\x10\n\x08sequence\x18\x03 \x01(\x04\x12\x11\n\ttimestamp\x18\x04 \x01(\x03\x12\r\n\x05\x66rame\x18\x05 \x01(\x0c\x12\x12\n\ncompressed\x18\x06 \x01(\x08\"\x85\x02\n\x0fVersionResponse\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x11\n\tgitCommit\x18\x02 \x01(\t\x12\x11\n\tbuildDate\x18\x03 \x01(\t\x12!\n\x19instanceManagerAPIVersion\x18\x04 \x01(\x03\x12$\n\x1cinstanceManagerAPIMinVersion\x18\x05 \x01(\x03\x12&\n\x1einstanceManagerProxyAPIVersion\x18\x06 \x01(\x03\x12)\n!instanceManagerProxyAPIMinVersion\x18\x07 \x01(\x03\x12\x1f\n\x08topology\x18\x08 \x01(\x0b\x32\r.NodeTopology\"\x84\x01\n\x0cNodeTopology\x12\x0c\n\x04zone\x18\x01 \x01(\t\x12\x0c\n\x04rack\x18\x02 \x01(\t\x12)\n\x06labels\x18\x03 \x03(\x0b\x32\x19.NodeTopology.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x32\xe1\n\n\x15ProcessManagerService\x12:\n\rProcessCreate\x12\x15.ProcessCreateRequest\x1a\x10.ProcessResponse\"\x00\x12:\n\rProcessDelete\x12\x15.ProcessDeleteRequest\x1a\x10.ProcessResponse\"\x00\x12\x34\n\nProcessGet\x12\x12.ProcessGetRequest\x1a\x10.ProcessResponse\"\x00\x12<\n\x0eProcessRefresh\x12\x16.ProcessRefreshRequest\x1a\x10.ProcessResponse\"\x00\x12:\n\x0bProcessList\x12\x13.ProcessListRequest\x1a\x14.ProcessListResponse\"\x00\x12+\n\nProcessLog\x12\x0b.LogRequest\x1a\x0c.LogResponse\"\x00\x30\x01\x12<\n\x0cProcessWatch\x12\x16.google.protobuf.Empty\x1a\x10.ProcessResponse\"\x00\x30\x01\x12<\n\x0eProcessReplace\x12\x16.ProcessReplaceRequest\x1a\x10.ProcessResponse\"\x00\x12<\n\x0eProcessSuspend\x12\x16.ProcessSuspendRequest\x1a\x10.ProcessResponse\"\x00\x12:\n\rProcessResume\x12\x15.ProcessResumeRequest\x1a\x10.ProcessResponse\"\x00\x12J\n\x15ProcessRebuildModeSet\x12\x1d.ProcessRebuildModeSetRequest\x1a\x10.ProcessResponse\"\x00\x12L\n\x11ProcessBulkDelete\x12\x19.ProcessBulkDeleteRequest\x1a\x1a.ProcessBulkDeleteResponse\"\x00\x12^\n\x1aProcessBulkDeleteStatusGet\x12\".ProcessBulkDeleteStatusGetRequest\x1a\x1a.ProcessBulkDeleteResponse\"\x00\x12@\n\rPortReconcile\x12\x15.PortReconcileRequest\x1a\x16.PortReconcileResponse\"\x00\x12O\n\x14ProcessPortEventList\x12\x16.google.protobuf.Empty\x1a\x1d.ProcessPortEventListResponse\"\x00\x12\x46\n\x15ProcessPortEventWatch\x12\x16.google.protobuf.Empty\x1a\x11.ProcessPortEvent\"\x00\x30\x01\x12U\n\x14\x45ngineBinaryValidate\x12\x1c.EngineBinaryValidateRequest\x1a\x1d.EngineBinaryValidateResponse\"\x00\x12G\n\x10\x45ngineBinaryList\x12\x16.google.protobuf.Empty\x1a\x19.EngineBinaryListResponse\"\x00\x12P\n\x16ProcessManagerDebugGet\x12\x16.google.protobuf.Empty\x1a\x1c.ProcessManagerDebugResponse\"\x00\x12\x36\n\nVersionGet\x12\x16.google.protobuf.Empty\x1a\x10.VersionResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -23,38 +23,108 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpc'
+  _PROCESSSPEC_ENVSENTRY._options = None
+  _PROCESSSPEC_ENVSENTRY._serialized_options = b'8\001'
+  _PROCESSSPEC_LABELSENTRY._options = None
+  _PROCESSSPEC_LABELSENTRY._serialized_options = b'8\001'
   _PROCESSSTATUS_CONDITIONSENTRY._options = None
   _PROCESSSTATUS_CONDITIONSENTRY._serialized_options = b'8\001'
   _PROCESSLISTRESPONSE_PROCESSESENTRY._options = None
   _PROCESSLISTRESPONSE_PROCESSESENTRY._serialized_options = b'8\001'
-  _globals['_PROCESSSPEC']._serialized_start=100
-  _globals['_PROCESSSPEC']._serialized_end=196
-  _globals['_PROCESSSTATUS']._serialized_start=199
-  _globals['_PROCESSSTATUS']._serialized_end=389
-  _globals['_PROCESSSTATUS_CONDITIONSENTRY']._serialized_start=340
-  _globals['_PROCESSSTATUS_CONDITIONSENTRY']._serialized_end=389
-  _globals['_PROCESSCREATEREQUEST']._serialized_start=391
-  _globals['_PROCESSCREATEREQUEST']._serialized_end=441
-  _globals['_PROCESSDELETEREQUEST']._serialized_start=443
-  _globals['_PROCESSDELETEREQUEST']._serialized_end=479
-  _globals['_PROCESSGETREQUEST']._serialized_start=481
-  _globals['_PROCESSGETREQUEST']._serialized_end=514
-  _globals['_PROCESSRESPONSE']._serialized_start=516
-  _globals['_PROCESSRESPONSE']._serialized_end=610
-  _globals['_PROCESSLISTREQUEST']._serialized_start=612
-  _globals['_PROCESSLISTREQUEST']._serialized_end=632
-  _globals['_PROCESSLISTRESPONSE']._serialized_start=635
-  _globals['_PROCESSLISTRESPONSE']._serialized_end=780
-  _globals['_PROCESSLISTRESPONSE_PROCESSESENTRY']._serialized_start=714
-  _globals['_PROCESSLISTRESPONSE_PROCESSESENTRY']._serialized_end=780
-  _globals['_LOGREQUEST']._serialized_start=782
-  _globals['_LOGREQUEST']._serialized_end=808
-  _globals['_PROCESSREPLACEREQUEST']._serialized_start=810
-  _globals['_PROCESSREPLACEREQUEST']._serialized_end=887
-  _globals['_LOGRESPONSE']._serialized_start=889
-  _globals['_LOGRESPONSE']._serialized_end=916
-  _globals['_VERSIONRESPONSE']._serialized_start=919
-  _globals['_VERSIONRESPONSE']._serialized_end=1147
-  _globals['_PROCESSMANAGERSERVICE']._serialized_start=1150
-  _globals['_PROCESSMANAGERSERVICE']._serialized_end=1632
+  _PROCESSBULKDELETERESPONSE_ERRORSENTRY._options = None
+  _PROCESSBULKDELETERESPONSE_ERRORSENTRY._serialized_options = b'8\001'
+  _NODETOPOLOGY_LABELSENTRY._options = None
+  _NODETOPOLOGY_LABELSENTRY._serialized_options = b'8\001'
+  _globals['_PROCESSSPEC']._serialized_start=101
+  _globals['_PROCESSSPEC']._serialized_end=584
+  _globals['_PROCESSSPEC_ENVSENTRY']._serialized_start=494
+  _globals['_PROCESSSPEC_ENVSENTRY']._serialized_end=537
+  _globals['_PROCESSSPEC_LABELSENTRY']._serialized_start=539
+  _globals['_PROCESSSPEC_LABELSENTRY']._serialized_end=584
+  _globals['_PROCESSPRIVILEGES']._serialized_start=586
+  _globals['_PROCESSPRIVILEGES']._serialized_end=654
+  _globals['_PROCESSSIDECARSPEC']._serialized_start=656
+  _globals['_PROCESSSIDECARSPEC']._serialized_end=720
+  _globals['_PROCESSSTATUS']._serialized_start=723
+  _globals['_PROCESSSTATUS']._serialized_end=1008
+  _globals['_PROCESSSTATUS_CONDITIONSENTRY']._serialized_start=959
+  _globals['_PROCESSSTATUS_CONDITIONSENTRY']._serialized_end=1008
+  _globals['_RESOURCEUSAGE']._serialized_start=1010
+  _globals['_RESOURCEUSAGE']._serialized_end=1123
+  _globals['_PROCESSSIDECARSTATUS']._serialized_start=1125
+  _globals['_PROCESSSIDECARSTATUS']._serialized_end=1195
+  _globals['_PROCESSCREATEREQUEST']._serialized_start=1197
+  _globals['_PROCESSCREATEREQUEST']._serialized_end=1270
+  _globals['_PROCESSDELETEREQUEST']._serialized_start=1272
+  _globals['_PROCESSDELETEREQUEST']._serialized_end=1338
+  _globals['_PROCESSGETREQUEST']._serialized_start=1340
+  _globals['_PROCESSGETREQUEST']._serialized_end=1373
+  _globals['_PROCESSREFRESHREQUEST']._serialized_start=1375
+  _globals['_PROCESSREFRESHREQUEST']._serialized_end=1412
+  _globals['_PROCESSSUSPENDREQUEST']._serialized_start=1414
+  _globals['_PROCESSSUSPENDREQUEST']._serialized_end=1451
+  _globals['_PROCESSRESUMEREQUEST']._serialized_start=1453
+  _globals['_PROCESSRESUMEREQUEST']._serialized_end=1489
+  _globals['_PROCESSREBUILDMODESETREQUEST']._serialized_start=1491
+  _globals['_PROCESSREBUILDMODESETREQUEST']._serialized_end=1552
+  _globals['_PROCESSRESPONSE']._serialized_start=1554
+  _globals['_PROCESSRESPONSE']._serialized_end=1648
+  _globals['_PROCESSLISTREQUEST']._serialized_start=1650
+  _globals['_PROCESSLISTREQUEST']._serialized_end=1670
+  _globals['_PROCESSLISTRESPONSE']._serialized_start=1673
+  _globals['_PROCESSLISTRESPONSE']._serialized_end=1818
+  _globals['_PROCESSLISTRESPONSE_PROCESSESENTRY']._serialized_start=1752
+  _globals['_PROCESSLISTRESPONSE_PROCESSESENTRY']._serialized_end=1818
+  _globals['_LOGREQUEST']._serialized_start=1820
+  _globals['_LOGREQUEST']._serialized_end=1907
+  _globals['_PROCESSREPLACEREQUEST']._serialized_start=1909
+  _globals['_PROCESSREPLACEREQUEST']._serialized_end=1986
+  _globals['_PROCESSBULKDELETEREQUEST']._serialized_start=1988
+  _globals['_PROCESSBULKDELETEREQUEST']._serialized_end=2080
+  _globals['_PROCESSBULKDELETESTATUSGETREQUEST']._serialized_start=2082
+  _globals['_PROCESSBULKDELETESTATUSGETREQUEST']._serialized_end=2139
+  _globals['_PROCESSBULKDELETERESPONSE']._serialized_start=2142
+  _globals['_PROCESSBULKDELETERESPONSE']._serialized_end=2357
+  _globals['_PROCESSBULKDELETERESPONSE_ERRORSENTRY']._serialized_start=2312
+  _globals['_PROCESSBULKDELETERESPONSE_ERRORSENTRY']._serialized_end=2357
+  _globals['_PORTRECONCILEREQUEST']._serialized_start=2359
+  _globals['_PORTRECONCILEREQUEST']._serialized_end=2398
+  _globals['_PORTDISCREPANCY']._serialized_start=2400
+  _globals['_PORTDISCREPANCY']._serialized_end=2526
+  _globals['_PORTRECONCILERESPONSE']._serialized_start=2528
+  _globals['_PORTRECONCILERESPONSE']._serialized_end=2592
+  _globals['_PROCESSPORTEVENT']._serialized_start=2595
+  _globals['_PROCESSPORTEVENT']._serialized_end=2725
+  _globals['_PROCESSPORTALLOCATION']._serialized_start=2727
+  _globals['_PROCESSPORTALLOCATION']._serialized_end=2849
+  _globals['_PROCESSPROBESTATUS']._serialized_start=2851
+  _globals['_PROCESSPROBESTATUS']._serialized_end=2978
+  _globals['_PROCESSSTOPOPERATION']._serialized_start=2980
+  _globals['_PROCESSSTOPOPERATION']._serialized_end=3048
+  _globals['_PROCESSMANAGERDEBUGRESPONSE']._serialized_start=3051
+  _globals['_PROCESSMANAGERDEBUGRESPONSE']._serialized_end=3328
+  _globals['_PROCESSPORTEVENTLISTRESPONSE']._serialized_start=3330
+  _globals['_PROCESSPORTEVENTLISTRESPONSE']._serialized_end=3395
+  _globals['_ENGINEBINARYVALIDATEREQUEST']._serialized_start=3397
+  _globals['_ENGINEBINARYVALIDATEREQUEST']._serialized_end=3505
+  _globals['_ENGINEBINARYARGS']._serialized_start=3507
+  _globals['_ENGINEBINARYARGS']._serialized_end=3539
+  _globals['_ENGINEBINARYCHECK']._serialized_start=3541
+  _globals['_ENGINEBINARYCHECK']._serialized_end=3607
+  _globals['_ENGINEBINARYVALIDATERESPONSE']._serialized_start=3610
+  _globals['_ENGINEBINARYVALIDATERESPONSE']._serialized_end=3937
+  _globals['_ENGINEBINARY']._serialized_start=3940
+  _globals['_ENGINEBINARY']._serialized_end=4108
+  _globals['_ENGINEBINARYLISTRESPONSE']._serialized_start=4110
+  _globals['_ENGINEBINARYLISTRESPONSE']._serialized_end=4169
+  _globals['_LOGRESPONSE']._serialized_start=4171
+  _globals['_LOGRESPONSE']._serialized_end=4270
+  _globals['_VERSIONRESPONSE']._serialized_start=4273
+  _globals['_VERSIONRESPONSE']._serialized_end=4534
+  _globals['_NODETOPOLOGY']._serialized_start=4537
+  _globals['_NODETOPOLOGY']._serialized_end=4669
+  _globals['_NODETOPOLOGY_LABELSENTRY']._serialized_start=539
+  _globals['_NODETOPOLOGY_LABELSENTRY']._serialized_end=584
+  _globals['_PROCESSMANAGERSERVICE']._serialized_start=4672
+  _globals['_PROCESSMANAGERSERVICE']._serialized_end=6049
 # @@protoc_insertion_point(module_scope)
@@ -30,6 +30,11 @@ class ProcessManagerServiceStub(object):
                 request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessGetRequest.SerializeToString,
                 response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
                 )
+        self.ProcessRefresh = channel.unary_unary(
+                '/ProcessManagerService/ProcessRefresh',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessRefreshRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+                )
         self.ProcessList = channel.unary_unary(
                 '/ProcessManagerService/ProcessList',
                 request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessListRequest.SerializeToString,
@@ -50,6 +55,61 @@ class ProcessManagerServiceStub(object):
                 request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessReplaceRequest.SerializeToString,
                 response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
                 )
+        self.ProcessSuspend = channel.unary_unary(
+                '/ProcessManagerService/ProcessSuspend',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessSuspendRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+                )
+        self.ProcessResume = channel.unary_unary(
+                '/ProcessManagerService/ProcessResume',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResumeRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+                )
+        self.ProcessRebuildModeSet = channel.unary_unary(
+                '/ProcessManagerService/ProcessRebuildModeSet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessRebuildModeSetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+                )
+        self.ProcessBulkDelete = channel.unary_unary(
+                '/ProcessManagerService/ProcessBulkDelete',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteResponse.FromString,
+                )
+        self.ProcessBulkDeleteStatusGet = channel.unary_unary(
+                '/ProcessManagerService/ProcessBulkDeleteStatusGet',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteStatusGetRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteResponse.FromString,
+                )
+        self.PortReconcile = channel.unary_unary(
+                '/ProcessManagerService/PortReconcile',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.PortReconcileRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.PortReconcileResponse.FromString,
+                )
+        self.ProcessPortEventList = channel.unary_unary(
+                '/ProcessManagerService/ProcessPortEventList',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessPortEventListResponse.FromString,
+                )
+        self.ProcessPortEventWatch = channel.unary_stream(
+                '/ProcessManagerService/ProcessPortEventWatch',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessPortEvent.FromString,
+                )
+        self.EngineBinaryValidate = channel.unary_unary(
+                '/ProcessManagerService/EngineBinaryValidate',
+                request_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryValidateRequest.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryValidateResponse.FromString,
+                )
+        self.EngineBinaryList = channel.unary_unary(
+                '/ProcessManagerService/EngineBinaryList',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryListResponse.FromString,
+                )
+        self.ProcessManagerDebugGet = channel.unary_unary(
+                '/ProcessManagerService/ProcessManagerDebugGet',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessManagerDebugResponse.FromString,
+                )
         self.VersionGet = channel.unary_unary(
                 '/ProcessManagerService/VersionGet',
                 request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -78,6 +138,12 @@ class ProcessManagerServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ProcessRefresh(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def ProcessList(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -102,6 +168,72 @@ class ProcessManagerServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ProcessSuspend(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessResume(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessRebuildModeSet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessBulkDelete(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessBulkDeleteStatusGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PortReconcile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessPortEventList(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessPortEventWatch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def EngineBinaryValidate(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def EngineBinaryList(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProcessManagerDebugGet(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def VersionGet(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -126,6 +258,11 @@ def add_ProcessManagerServiceServicer_to_server(servicer, server):
                     request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessGetRequest.FromString,
                     response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.SerializeToString,
             ),
+            'ProcessRefresh': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessRefresh,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessRefreshRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.SerializeToString,
+            ),
             'ProcessList': grpc.unary_unary_rpc_method_handler(
                     servicer.ProcessList,
                     request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessListRequest.FromString,
@@ -146,6 +283,61 @@ def add_ProcessManagerServiceServicer_to_server(servicer, server):
                     request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessReplaceRequest.FromString,
                     response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.SerializeToString,
             ),
+            'ProcessSuspend': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessSuspend,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessSuspendRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.SerializeToString,
+            ),
+            'ProcessResume': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessResume,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResumeRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.SerializeToString,
+            ),
+            'ProcessRebuildModeSet': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessRebuildModeSet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessRebuildModeSetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.SerializeToString,
+            ),
+            'ProcessBulkDelete': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessBulkDelete,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteResponse.SerializeToString,
+            ),
+            'ProcessBulkDeleteStatusGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessBulkDeleteStatusGet,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteStatusGetRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteResponse.SerializeToString,
+            ),
+            'PortReconcile': grpc.unary_unary_rpc_method_handler(
+                    servicer.PortReconcile,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.PortReconcileRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.PortReconcileResponse.SerializeToString,
+            ),
+            'ProcessPortEventList': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessPortEventList,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessPortEventListResponse.SerializeToString,
+            ),
+            'ProcessPortEventWatch': grpc.unary_stream_rpc_method_handler(
+                    servicer.ProcessPortEventWatch,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessPortEvent.SerializeToString,
+            ),
+            'EngineBinaryValidate': grpc.unary_unary_rpc_method_handler(
+                    servicer.EngineBinaryValidate,
+                    request_deserializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryValidateRequest.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryValidateResponse.SerializeToString,
+            ),
+            'EngineBinaryList': grpc.unary_unary_rpc_method_handler(
+                    servicer.EngineBinaryList,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryListResponse.SerializeToString,
+            ),
+            'ProcessManagerDebugGet': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProcessManagerDebugGet,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessManagerDebugResponse.SerializeToString,
+            ),
             'VersionGet': grpc.unary_unary_rpc_method_handler(
                     servicer.VersionGet,
                     request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
@@ -212,6 +404,23 @@ class ProcessManagerService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ProcessRefresh(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessRefresh',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessRefreshRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def ProcessList(request,
             target,
@@ -280,6 +489,193 @@ class ProcessManagerService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ProcessSuspend(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessSuspend',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessSuspendRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessResume(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessResume',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResumeRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessRebuildModeSet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessRebuildModeSet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessRebuildModeSetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessBulkDelete(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessBulkDelete',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessBulkDeleteStatusGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessBulkDeleteStatusGet',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteStatusGetRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessBulkDeleteResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PortReconcile(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/PortReconcile',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.PortReconcileRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.PortReconcileResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessPortEventList(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessPortEventList',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessPortEventListResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessPortEventWatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/ProcessManagerService/ProcessPortEventWatch',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessPortEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def EngineBinaryValidate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/EngineBinaryValidate',
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryValidateRequest.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryValidateResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def EngineBinaryList(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/EngineBinaryList',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.EngineBinaryListResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProcessManagerDebugGet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/ProcessManagerService/ProcessManagerDebugGet',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2.ProcessManagerDebugResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def VersionGet(request,
             target,
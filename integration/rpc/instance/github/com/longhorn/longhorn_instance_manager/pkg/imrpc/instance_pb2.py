@@ -16,7 +16,7 @@ from github.com.longhorn.longhorn_instance_manager.pkg.imrpc import common_pb2 a
 from github.com.longhorn.longhorn_instance_manager.pkg.imrpc import imrpc_pb2 as github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_imrpc__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nFgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/instance.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\x1a\x44github.com/longhorn/longhorn-instance-manager/pkg/imrpc/common.proto\x1a\x43github.com/longhorn/longhorn-instance-manager/pkg/imrpc/imrpc.proto\"3\n\x13ProcessInstanceSpec\x12\x0e\n\x06\x62inary\x18\x01 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x02 \x03(\t\"\xf8\x01\n\x10SpdkInstanceSpec\x12K\n\x13replica_address_map\x18\x01 \x03(\x0b\x32..imrpc.SpdkInstanceSpec.ReplicaAddressMapEntry\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x04\x12\x17\n\x0f\x65xpose_required\x18\x05 \x01(\x08\x12\x10\n\x08\x66rontend\x18\x06 \x01(\t\x1a\x38\n\x16ReplicaAddressMapEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xbb\x02\n\x0cInstanceSpec\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x13\n\x0bvolume_name\x18\x04 \x01(\t\x12\x12\n\nport_count\x18\x05 \x01(\x05\x12\x11\n\tport_args\x18\x06 \x03(\t\x12\x39\n\x15process_instance_spec\x18\x07 \x01(\x0b\x32\x1a.imrpc.ProcessInstanceSpec\x12\x33\n\x12spdk_instance_spec\x18\x08 \x01(\x0b\x32\x17.imrpc.SpdkInstanceSpec\x12&\n\x0b\x64\x61ta_engine\x18\t \x01(\x0e\x32\x11.imrpc.DataEngine\"\xc6\x01\n\x0eInstanceStatus\x12\r\n\x05state\x18\x01 \x01(\t\x12\x11\n\terror_msg\x18\x02 \x01(\t\x12\x12\n\nport_start\x18\x03 \x01(\x05\x12\x10\n\x08port_end\x18\x04 \x01(\x05\x12\x39\n\nconditions\x18\x05 \x03(\x0b\x32%.imrpc.InstanceStatus.ConditionsEntry\x1a\x31\n\x0f\x43onditionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\":\n\x15InstanceCreateRequest\x12!\n\x04spec\x18\x01 \x01(\x0b\x32\x13.imrpc.InstanceSpec\"\xc5\x01\n\x15InstanceDeleteRequest\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x11\n\tdisk_uuid\x18\x04 \x01(\t\x12\x18\n\x10\x63leanup_required\x18\x05 \x01(\x08\x12&\n\x0b\x64\x61ta_engine\x18\x06 \x01(\x0e\x32\x11.imrpc.DataEngine\"\x95\x01\n\x12InstanceGetRequest\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x04 \x01(\x0e\x32\x11.imrpc.DataEngine\"m\n\x10InstanceResponse\x12!\n\x04spec\x18\x01 \x01(\x0b\x32\x13.imrpc.InstanceSpec\x12%\n\x06status\x18\x02 \x01(\x0b\x32\x15.imrpc.InstanceStatus\x12\x0f\n\x07\x64\x65leted\x18\x03 \x01(\x08\"\xa0\x01\n\x14InstanceListResponse\x12=\n\tinstances\x18\x01 \x03(\x0b\x32*.imrpc.InstanceListResponse.InstancesEntry\x1aI\n\x0eInstancesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.imrpc.InstanceResponse:\x02\x38\x01\"\x95\x01\n\x12InstanceLogRequest\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x04 \x01(\x0e\x32\x11.imrpc.DataEngine\"U\n\x16InstanceReplaceRequest\x12!\n\x04spec\x18\x01 \x01(\x0b\x32\x13.imrpc.InstanceSpec\x12\x18\n\x10terminate_signal\x18\x02 \x01(\t2\xb9\x04\n\x0fInstanceService\x12I\n\x0eInstanceCreate\x12\x1c.imrpc.InstanceCreateRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12I\n\x0eInstanceDelete\x12\x1c.imrpc.InstanceDeleteRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12\x43\n\x0bInstanceGet\x12\x19.imrpc.InstanceGetRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12\x45\n\x0cInstanceList\x12\x16.google.protobuf.Empty\x1a\x1b.imrpc.InstanceListResponse\"\x00\x12:\n\x0bInstanceLog\x12\x19.imrpc.InstanceLogRequest\x1a\x0c.LogResponse\"\x00\x30\x01\x12\x43\n\rInstanceWatch\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x30\x01\x12K\n\x0fInstanceReplace\x12\x1d.imrpc.InstanceReplaceRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12\x36\n\nVersionGet\x12\x16.google.protobuf.Empty\x1a\x10.VersionResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nFgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/instance.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\x1a\x44github.com/longhorn/longhorn-instance-manager/pkg/imrpc/common.proto\x1a\x43github.com/longhorn/longhorn-instance-manager/pkg/imrpc/imrpc.proto\"\xd3\x02\n\x13ProcessInstanceSpec\x12\x0e\n\x06\x62inary\x18\x01 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x02 \x03(\t\x12%\n\x08sidecars\x18\x03 \x03(\x0b\x32\x13.ProcessSidecarSpec\x12\x32\n\x04\x65nvs\x18\x04 \x03(\x0b\x32$.imrpc.ProcessInstanceSpec.EnvsEntry\x12\x16\n\x0e\x63pu_millicores\x18\x05 \x01(\x03\x12\x14\n\x0cmemory_bytes\x18\x06 \x01(\x03\x12\x0f\n\x07\x63pu_set\x18\x07 \x01(\t\x12\x11\n\tnuma_node\x18\x08 \x01(\t\x12&\n\nprivileges\x18\t \x01(\x0b\x32\x12.ProcessPrivileges\x12\x1c\n\x14stop_timeout_seconds\x18\n \x01(\x03\x1a+\n\tEnvsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa7\x02\n\x10SpdkInstanceSpec\x12K\n\x13replica_address_map\x18\x01 \x03(\x0b\x32..imrpc.SpdkInstanceSpec.ReplicaAddressMapEntry\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x04\x12\x17\n\x0f\x65xpose_required\x18\x05 \x01(\x08\x12\x10\n\x08\x66rontend\x18\x06 \x01(\t\x12\r\n\x05\x61\x64opt\x18\x07 \x01(\x08\x12\x1e\n\x16preferred_read_replica\x18\x08 \x01(\t\x1a\x38\n\x16ReplicaAddressMapEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xe9\x03\n\x0cInstanceSpec\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x13\n\x0bvolume_name\x18\x04 \x01(\t\x12\x12\n\nport_count\x18\x05 \x01(\x05\x12\x11\n\tport_args\x18\x06 \x03(\t\x12\x39\n\x15process_instance_spec\x18\x07 \x01(\x0b\x32\x1a.imrpc.ProcessInstanceSpec\x12\x33\n\x12spdk_instance_spec\x18\x08 \x01(\x0b\x32\x17.imrpc.SpdkInstanceSpec\x12&\n\x0b\x64\x61ta_engine\x18\t \x01(\x0e\x32\x11.imrpc.DataEngine\x12/\n\x06labels\x18\n \x03(\x0b\x32\x1f.imrpc.InstanceSpec.LabelsEntry\x12\x34\n\x0erestart_policy\x18\x0b \x01(\x0b\x32\x1c.imrpc.InstanceRestartPolicy\x12\x16\n\x0epriority_class\x18\x0c \x01(\t\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\x15InstanceRestartPolicy\x12\x0e\n\x06policy\x18\x01 \x01(\t\x12\x13\n\x0bmax_retries\x18\x02 \x01(\x05\x12\x12\n\nbackoff_ms\x18\x03 \x01(\x03\"\xc0\x03\n\x0eInstanceStatus\x12\r\n\x05state\x18\x01 \x01(\t\x12\x11\n\terror_msg\x18\x02 \x01(\t\x12\x12\n\nport_start\x18\x03 \x01(\x05\x12\x10\n\x08port_end\x18\x04 \x01(\x05\x12\x39\n\nconditions\x18\x05 \x03(\x0b\x32%.imrpc.InstanceStatus.ConditionsEntry\x12\'\n\x08sidecars\x18\x06 \x03(\x0b\x32\x15.ProcessSidecarStatus\x12&\n\x0eresource_usage\x18\x07 \x01(\x0b\x32\x0e.ResourceUsage\x12*\n\tread_path\x18\x08 \x01(\x0b\x32\x17.imrpc.InstanceReadPath\x12\x15\n\rrestart_count\x18\t \x01(\x05\x12\"\n\x05ports\x18\n \x03(\x0b\x32\x13.imrpc.InstancePort\x12\x0c\n\x04uuid\x18\x0b \x01(\t\x12\x32\n\rnvme_identity\x18\x0c \x01(\x0b\x32\x1b.imrpc.InstanceNvmeIdentity\x1a\x31\n\x0f\x43onditionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\"\xa9\x01\n\x14InstanceNvmeIdentity\x12\x15\n\rsubsystem_nqn\x18\x01 \x01(\t\x12\x19\n\x11\x63ontroller_serial\x18\x02 \x01(\t\x12\x18\n\x10\x63ontroller_model\x18\x03 \x01(\t\x12\x14\n\x0cnamespace_id\x18\x04 \x01(\r\x12\x16\n\x0enamespace_uuid\x18\x05 \x01(\t\x12\x17\n\x0fnamespace_nguid\x18\x06 \x01(\t\"h\n\x0cInstancePort\x12\x12\n\nport_start\x18\x01 \x01(\x05\x12\x10\n\x08port_end\x18\x02 \x01(\x05\x12\x10\n\x08protocol\x18\x03 \x01(\t\x12\x0f\n\x07purpose\x18\x04 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x05 \x01(\t\"y\n\x10InstanceReadPath\x12\x19\n\x11preferred_replica\x18\x01 \x01(\t\x12\x19\n\x11\x65\x66\x66\x65\x63tive_replica\x18\x02 \x01(\t\x12\r\n\x05local\x18\x03 \x01(\x08\x12\x10\n\x08replicas\x18\x04 \x03(\t\x12\x0e\n\x06reason\x18\x05 \x01(\t\"l\n\x15InstanceCreateRequest\x12!\n\x04spec\x18\x01 \x01(\x0b\x32\x13.imrpc.InstanceSpec\x12\x19\n\x11idempotency_token\x18\x02 \x01(\t\x12\x15\n\rvalidate_only\x18\x03 \x01(\x08\"\xe3\x01\n\x15InstanceDeleteRequest\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x11\n\tdisk_uuid\x18\x04 \x01(\t\x12\x18\n\x10\x63leanup_required\x18\x05 \x01(\x08\x12&\n\x0b\x64\x61ta_engine\x18\x06 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x1c\n\x14stop_timeout_seconds\x18\x07 \x01(\x03\"a\n\x1aInstanceBatchCreateRequest\x12.\n\x08requests\x18\x01 \x03(\x0b\x32\x1c.imrpc.InstanceCreateRequest\x12\x13\n\x0b\x63oncurrency\x18\x02 \x01(\x05\"a\n\x1aInstanceBatchDeleteRequest\x12.\n\x08requests\x18\x01 \x03(\x0b\x32\x1c.imrpc.InstanceDeleteRequest\x12\x13\n\x0b\x63oncurrency\x18\x02 \x01(\x05\"u\n\x13InstanceBatchResult\x12\x0c\n\x04name\x18\x01 \x01(\t\x12)\n\x08instance\x18\x02 \x01(\x0b\x32\x17.imrpc.InstanceResponse\x12\x12\n\nerror_code\x18\x03 \x01(\x05\x12\x11\n\terror_msg\x18\x04 \x01(\t\"D\n\x15InstanceBatchResponse\x12+\n\x07results\x18\x01 \x03(\x0b\x32\x1a.imrpc.InstanceBatchResult\"\xb4\x01\n\x12InstanceGetRequest\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x04 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x0f\n\x07verbose\x18\x05 \x01(\x08\x12\x0c\n\x04uuid\x18\x06 \x01(\t\"\\\n\x16InstanceRefreshRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\"\\\n\x16InstanceSuspendRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\"[\n\x15InstanceResumeRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\"o\n\x1fInstanceSwitchOverTargetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x16\n\x0etarget_address\x18\x03 \x01(\t\"S\n\x1bInstanceDeleteTargetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\"]\n\x11InstanceOperation\x12\x11\n\toperation\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\t\x12\x0f\n\x07\x64\x65tails\x18\x03 \x01(\t\x12\x11\n\terror_msg\x18\x04 \x01(\t\"\xbc\x01\n\x10InstanceResponse\x12!\n\x04spec\x18\x01 \x01(\x0b\x32\x13.imrpc.InstanceSpec\x12%\n\x06status\x18\x02 \x01(\x0b\x32\x15.imrpc.InstanceStatus\x12\x0f\n\x07\x64\x65leted\x18\x03 \x01(\x08\x12,\n\noperations\x18\x04 \x03(\x0b\x32\x18.imrpc.InstanceOperation\x12\x1f\n\x08topology\x18\x05 \x01(\x0b\x32\r.NodeTopology\"\xd1\x01\n\x13InstanceListRequest\x12\'\n\x0c\x64\x61ta_engines\x18\x01 \x03(\x0e\x32\x11.imrpc.DataEngine\x12\r\n\x05types\x18\x02 \x03(\t\x12\x0e\n\x06states\x18\x03 \x03(\t\x12\x13\n\x0bname_prefix\x18\x04 \x01(\t\x12\x11\n\tpage_size\x18\x05 \x01(\x05\x12\x12\n\npage_token\x18\x06 \x01(\t\x12\x1e\n\x16since_resource_version\x18\x07 \x01(\t\x12\x16\n\x0elabel_selector\x18\x08 \x01(\t\"\xf9\x01\n\x14InstanceListResponse\x12=\n\tinstances\x18\x01 \x03(\x0b\x32*.imrpc.InstanceListResponse.InstancesEntry\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\x12\x18\n\x10resource_version\x18\x03 \x01(\t\x12\r\n\x05\x64\x65lta\x18\x04 \x01(\x08\x12\x15\n\rdeleted_names\x18\x05 \x03(\t\x1aI\n\x0eInstancesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.imrpc.InstanceResponse:\x02\x38\x01\"D\n\x14InstanceWatchRequest\x12\x16\n\x0elabel_selector\x18\x01 \x01(\t\x12\x14\n\x0cresume_token\x18\x02 \x01(\t\"\xbb\x01\n\x12InstanceWatchEvent\x12\x12\n\nevent_type\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x04 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x11\n\told_state\x18\x05 \x01(\t\x12\x11\n\tnew_state\x18\x06 \x01(\t\x12\x11\n\ttimestamp\x18\x07 \x01(\t\x12\x14\n\x0cresume_token\x18\x08 \x01(\t\"A\n\x1aInstanceWatchFreezeRequest\x12\x13\n\x0bttl_seconds\x18\x01 \x01(\x03\x12\x0e\n\x06reason\x18\x02 \x01(\t\"b\n\x19InstanceWatchFreezeStatus\x12\x0e\n\x06\x66rozen\x18\x01 \x01(\x08\x12\x0e\n\x06reason\x18\x02 \x01(\t\x12\x11\n\tfrozen_at\x18\x03 \x01(\t\x12\x12\n\nexpires_at\x18\x04 \x01(\t\"\xd2\x01\n\x12InstanceLogRequest\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x01 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x04 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x16\n\x0esince_sequence\x18\x05 \x01(\x04\x12\x0f\n\x07\x62\x61tched\x18\x06 \x01(\x08\x12\x12\n\ncompressed\x18\x07 \x01(\x08\"\'\n\x10VolumeLogRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\"\x85\x01\n\x11VolumeLogResponse\x12\x15\n\rinstance_name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x0c\n\x04line\x18\x03 \x01(\t\x12\x10\n\x08sequence\x18\x04 \x01(\x04\x12\x11\n\ttimestamp\x18\x05 \x01(\x03\"U\n\x16InstanceReplaceRequest\x12!\n\x04spec\x18\x01 \x01(\x0b\x32\x13.imrpc.InstanceSpec\x12\x18\n\x10terminate_signal\x18\x02 \x01(\t\"$\n\x14InstanceStatsRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"}\n\x14InstanceNetworkStats\x12\x12\n\nport_start\x18\x01 \x01(\x05\x12\x10\n\x08port_end\x18\x02 \x01(\x05\x12\x12\n\nbytes_sent\x18\x03 \x01(\x04\x12\x16\n\x0e\x62ytes_received\x18\x04 \x01(\x04\x12\x13\n\x0b\x63onnections\x18\x05 \x01(\x05\"\x9a\x01\n\x15InstanceStatsResponse\x12\x36\n\x05stats\x18\x01 \x03(\x0b\x32\'.imrpc.InstanceStatsResponse.StatsEntry\x1aI\n\nStatsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12*\n\x05value\x18\x02 \x01(\x0b\x32\x1b.imrpc.InstanceNetworkStats:\x02\x38\x01\"\x9e\x01\n\x1bInstanceLatencyProbeRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x13\n\x0bvolume_name\x18\x03 \x01(\t\x12\r\n\x05\x63ount\x18\x04 \x01(\x05\x12\r\n\x05write\x18\x05 \x01(\x08\x12\x16\n\x0escratch_offset\x18\x06 \x01(\x04\"M\n\x0cLatencyStats\x12\r\n\x05\x63ount\x18\x01 \x01(\x05\x12\x0e\n\x06min_ns\x18\x02 \x01(\x03\x12\x0e\n\x06\x61vg_ns\x18\x03 \x01(\x03\x12\x0e\n\x06max_ns\x18\x04 \x01(\x03\"\x9a\x03\n\x1cInstanceLatencyProbeResponse\x12\x0e\n\x06\x64\x65vice\x18\x01 \x01(\t\x12!\n\x04read\x18\x02 \x01(\x0b\x32\x13.imrpc.LatencyStats\x12\"\n\x05write\x18\x03 \x01(\x0b\x32\x13.imrpc.LatencyStats\x12J\n\x0creplica_hops\x18\x04 \x03(\x0b\x32\x34.imrpc.InstanceLatencyProbeResponse.ReplicaHopsEntry\x12U\n\x12replica_hop_errors\x18\x05 \x03(\x0b\x32\x39.imrpc.InstanceLatencyProbeResponse.ReplicaHopErrorsEntry\x1aG\n\x10ReplicaHopsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\"\n\x05value\x18\x02 \x01(\x0b\x32\x13.imrpc.LatencyStats:\x02\x38\x01\x1a\x37\n\x15ReplicaHopErrorsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"r\n\x1aInstanceHealthCheckRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x10\n\x08io_probe\x18\x04 \x01(\x08\"\x84\x01\n\x1bInstanceHealthCheckResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\treachable\x18\x02 \x01(\x08\x12\x12\n\nlatency_ns\x18\x03 \x01(\x03\x12\x0e\n\x06probes\x18\x04 \x03(\t\x12\x11\n\terror_msg\x18\x05 \x01(\t\x12\r\n\x05state\x18\x06 \x01(\t\"\x89\x01\n\x12InstanceIOTimeouts\x12\x15\n\rio_timeout_ms\x18\x01 \x01(\x05\x12\x1d\n\x15\x63trl_loss_timeout_sec\x18\x02 \x01(\x05\x12\x1b\n\x13reconnect_delay_sec\x18\x03 \x01(\x05\x12 \n\x18\x66\x61st_io_fail_timeout_sec\x18\x04 \x01(\x05\"\x80\x01\n\x1bInstanceIOTimeoutSetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12+\n\x08timeouts\x18\x03 \x01(\x0b\x32\x19.imrpc.InstanceIOTimeouts\"S\n\x1bInstanceIOTimeoutGetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\"\x99\x01\n\x19InstanceIOTimeoutResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12-\n\nconfigured\x18\x02 \x01(\x0b\x32\x19.imrpc.InstanceIOTimeouts\x12*\n\x07\x63urrent\x18\x03 \x01(\x0b\x32\x19.imrpc.InstanceIOTimeouts\x12\x13\n\x0b\x63ontrollers\x18\x04 \x03(\t\"n\n InstanceReadPreferenceSetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x14\n\x0creplica_name\x18\x03 \x01(\t\"T\n\x1aNetworkPathValidateRequest\x12\x11\n\taddresses\x18\x01 \x03(\t\x12\x0b\n\x03mtu\x18\x02 \x01(\x05\x12\x16\n\x0envmf_discovery\x18\x03 \x01(\x08\"\xee\x01\n\x11NetworkPathResult\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\t\x12\x17\n\x0flocal_interface\x18\x02 \x01(\t\x12\x11\n\tlocal_mtu\x18\x03 \x01(\x05\x12\x0b\n\x03mtu\x18\x04 \x01(\x05\x12\x11\n\treachable\x18\x05 \x01(\x08\x12\x11\n\tmtu_valid\x18\x06 \x01(\x08\x12\x0e\n\x06rtt_ns\x18\x07 \x01(\x03\x12\x15\n\rtcp_connected\x18\x08 \x01(\x08\x12\x16\n\x0etcp_connect_ns\x18\t \x01(\x03\x12\x1a\n\x12nvmf_subsystem_nqn\x18\n \x01(\t\x12\x0e\n\x06\x65rrors\x18\x0b \x03(\t\"H\n\x1bNetworkPathValidateResponse\x12)\n\x07results\x18\x01 \x03(\x0b\x32\x18.imrpc.NetworkPathResult\"\xb0\x02\n\x0f\x45ngineMigration\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\x12-\n\x12source_data_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12-\n\x12target_data_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\r\n\x05phase\x18\x04 \x01(\t\x12\x14\n\x0c\x62ytes_copied\x18\x05 \x01(\x04\x12\x13\n\x0b\x62ytes_total\x18\x06 \x01(\x04\x12\x19\n\x11validation_result\x18\x07 \x01(\t\x12\x1a\n\x12validation_message\x18\x08 \x01(\t\x12\x11\n\terror_msg\x18\t \x01(\t\x12\x12\n\ncreated_at\x18\n \x01(\t\x12\x12\n\nupdated_at\x18\x0b \x01(\t\"\xa8\x01\n\x1e\x45ngineMigrationRegisterRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\x12-\n\x12source_data_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12-\n\x12target_data_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x13\n\x0b\x62ytes_total\x18\x04 \x01(\x04\"\xb7\x01\n\x1c\x45ngineMigrationUpdateRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\x12\r\n\x05phase\x18\x02 \x01(\t\x12\x14\n\x0c\x62ytes_copied\x18\x03 \x01(\x04\x12\x13\n\x0b\x62ytes_total\x18\x04 \x01(\x04\x12\x19\n\x11validation_result\x18\x05 \x01(\t\x12\x1a\n\x12validation_message\x18\x06 \x01(\t\x12\x11\n\terror_msg\x18\x07 \x01(\t\"0\n\x19\x45ngineMigrationGetRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\"3\n\x1c\x45ngineMigrationDeleteRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\"\xb0\x01\n\x1b\x45ngineMigrationListResponse\x12\x46\n\nmigrations\x18\x01 \x03(\x0b\x32\x32.imrpc.EngineMigrationListResponse.MigrationsEntry\x1aI\n\x0fMigrationsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.imrpc.EngineMigration:\x02\x38\x01\"\xaa\x01\n\x0cReplicaSpare\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tdisk_name\x18\x02 \x01(\t\x12\x11\n\tdisk_uuid\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x04\x12\n\n\x02ip\x18\x05 \x01(\t\x12\x12\n\nport_start\x18\x06 \x01(\x05\x12\x10\n\x08port_end\x18\x07 \x01(\x05\x12\x12\n\ncreated_at\x18\x08 \x01(\t\x12\x12\n\nexpires_at\x18\t \x01(\t\"x\n\x19ReplicaSpareCreateRequest\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x11\n\tdisk_uuid\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\x04\x12\x12\n\nport_count\x18\x04 \x01(\x05\x12\x13\n\x0bttl_seconds\x18\x05 \x01(\x03\"N\n\x18ReplicaSpareClaimRequest\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x11\n\tdisk_uuid\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\x04\"\x9b\x01\n\x18ReplicaSpareListResponse\x12;\n\x06spares\x18\x01 \x03(\x0b\x32+.imrpc.ReplicaSpareListResponse.SparesEntry\x1a\x42\n\x0bSparesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\"\n\x05value\x18\x02 \x01(\x0b\x32\x13.imrpc.ReplicaSpare:\x02\x38\x01\")\n\x19ReplicaSpareDeleteRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x9c\x01\n\x19ReplicaReadOnlyAttachment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x11\n\tdisk_name\x18\x03 \x01(\t\x12\x0e\n\x06\x64\x65vice\x18\x04 \x01(\t\x12\x12\n\ncreated_at\x18\x05 \x01(\t\x12\x12\n\nexpires_at\x18\x06 \x01(\t\"|\n\x1cReplicaReadOnlyAttachRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x02 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x11\n\tdisk_name\x18\x03 \x01(\t\x12\x13\n\x0bttl_seconds\x18\x04 \x01(\x03\"\xd1\x01\n%ReplicaReadOnlyAttachmentListResponse\x12R\n\x0b\x61ttachments\x18\x01 \x03(\x0b\x32=.imrpc.ReplicaReadOnlyAttachmentListResponse.AttachmentsEntry\x1aT\n\x10\x41ttachmentsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12/\n\x05value\x18\x02 \x01(\x0b\x32 .imrpc.ReplicaReadOnlyAttachment:\x02\x38\x01\",\n\x1cReplicaReadOnlyDetachRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"h\n\x1aSpdkOrphanReconcileRequest\x12\x0e\n\x06\x61\x63tion\x18\x01 \x01(\t\x12\x0f\n\x07\x64ry_run\x18\x02 \x01(\x08\x12\x15\n\rcleanup_lvols\x18\x03 \x01(\x08\x12\x12\n\nport_count\x18\x04 \x01(\x05\"w\n\x12SpdkOrphanResource\x12\x0c\n\x04kind\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tdisk_name\x18\x03 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x04 \x01(\t\x12\x0f\n\x07message\x18\x05 \x01(\t\x12\x11\n\terror_msg\x18\x06 \x01(\t\"K\n\x1bSpdkOrphanReconcileResponse\x12,\n\tresources\x18\x01 \x03(\x0b\x32\x19.imrpc.SpdkOrphanResource\"5\n\x13StateExportResponse\x12\r\n\x05state\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\x05\":\n\x12StateImportRequest\x12\r\n\x05state\x18\x01 \x01(\t\x12\x15\n\rvalidate_only\x18\x02 \x01(\x08\"z\n\x11StateImportResult\x12\x0c\n\x04kind\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x0e\n\x06result\x18\x04 \x01(\t\x12\x11\n\terror_msg\x18\x05 \x01(\t\"@\n\x13StateImportResponse\x12)\n\x07results\x18\x01 \x03(\x0b\x32\x18.imrpc.StateImportResult\"G\n\x1bInstanceServiceDrainRequest\x12\x17\n\x0ftimeout_seconds\x18\x01 \x01(\x03\x12\x0f\n\x07no_exit\x18\x02 \x01(\x08\"p\n\x1cInstanceServiceDrainResponse\x12\x0f\n\x07\x64rained\x18\x01 \x01(\x08\x12\x12\n\noperations\x18\x02 \x01(\x05\x12\x13\n\x0blog_streams\x18\x03 \x01(\x05\x12\x16\n\x0e\x64raining_since\x18\x04 \x01(\t\"\'\n\tPortRange\x12\r\n\x05start\x18\x01 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x02 \x01(\x05\"s\n\x16InstancePortAllocation\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nport_start\x18\x02 \x01(\x05\x12\x10\n\x08port_end\x18\x03 \x01(\x05\x12\x12\n\nowner_type\x18\x04 \x01(\t\x12\x11\n\tallocated\x18\x05 \x01(\x08\"\xc2\x01\n\x0f\x44\x61taEnginePorts\x12&\n\x0b\x64\x61ta_engine\x18\x01 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x1f\n\x05range\x18\x02 \x01(\x0b\x32\x10.imrpc.PortRange\x12\x32\n\x0b\x61llocations\x18\x03 \x03(\x0b\x32\x1d.imrpc.InstancePortAllocation\x12\x1e\n\x04\x66ree\x18\x04 \x03(\x0b\x32\x10.imrpc.PortRange\x12\x12\n\nfree_count\x18\x05 \x01(\x05\"@\n\x10PortsGetResponse\x12,\n\x0c\x64\x61ta_engines\x18\x01 \x03(\x0b\x32\x16.imrpc.DataEnginePorts\"\x9b\x02\n\x16InstanceConvertRequest\x12\x13\n\x0bsource_path\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0bvolume_name\x18\x03 \x01(\t\x12\x11\n\tdisk_name\x18\x04 \x01(\t\x12\x11\n\tdisk_uuid\x18\x05 \x01(\t\x12\x0c\n\x04size\x18\x06 \x01(\x04\x12\x12\n\nport_count\x18\x07 \x01(\x05\x12\x39\n\x06labels\x18\x08 \x03(\x0b\x32).imrpc.InstanceConvertRequest.LabelsEntry\x12\x17\n\x0f\x65xpose_required\x18\t \x01(\x08\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xb1\x01\n\x17InstanceConvertProgress\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05state\x18\x02 \x01(\t\x12\x13\n\x0btotal_bytes\x18\x03 \x01(\x03\x12\x14\n\x0c\x63opied_bytes\x18\x04 \x01(\x03\x12\x10\n\x08progress\x18\x05 \x01(\x05\x12\x11\n\terror_msg\x18\x06 \x01(\t\x12)\n\x08instance\x18\x07 \x01(\x0b\x32\x17.imrpc.InstanceResponse\"\xb3\x01\n\x0bStreamEvent\x12\n\n\x02id\x18\x01 \x01(\x04\x12\x0f\n\x07service\x18\x02 \x01(\t\x12\x0e\n\x06method\x18\x03 \x01(\t\x12\x0c\n\x04peer\x18\x04 \x01(\t\x12\x12\n\nstart_time\x18\x05 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x06 \x01(\t\x12\x13\n\x0b\x64uration_ms\x18\x07 \x01(\x03\x12\r\n\x05\x63\x61use\x18\x08 \x01(\t\x12\x0c\n\x04\x63ode\x18\t \x01(\t\x12\x11\n\terror_msg\x18\n \x01(\t\"`\n\x17StreamEventListResponse\x12\"\n\x06\x61\x63tive\x18\x01 \x03(\x0b\x32\x12.imrpc.StreamEvent\x12!\n\x05\x65nded\x18\x02 \x03(\x0b\x32\x12.imrpc.StreamEvent\"\xd5\x01\n\x0c\x44\x65\x66\x65rredTask\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12+\n\x04\x61rgs\x18\x03 \x03(\x0b\x32\x1d.imrpc.DeferredTask.ArgsEntry\x12\x12\n\ncreated_at\x18\x04 \x01(\t\x12\x17\n\x0fnext_attempt_at\x18\x05 \x01(\t\x12\x10\n\x08\x61ttempts\x18\x06 \x01(\x05\x12\x12\n\nlast_error\x18\x07 \x01(\t\x1a+\n\tArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\">\n\x18\x44\x65\x66\x65rredTaskListResponse\x12\"\n\x05tasks\x18\x01 \x03(\x0b\x32\x13.imrpc.DeferredTask2\xad\x1d\n\x0fInstanceService\x12I\n\x0eInstanceCreate\x12\x1c.imrpc.InstanceCreateRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12I\n\x0eInstanceDelete\x12\x1c.imrpc.InstanceDeleteRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12X\n\x13InstanceBatchCreate\x12!.imrpc.InstanceBatchCreateRequest\x1a\x1c.imrpc.InstanceBatchResponse\"\x00\x12X\n\x13InstanceBatchDelete\x12!.imrpc.InstanceBatchDeleteRequest\x1a\x1c.imrpc.InstanceBatchResponse\"\x00\x12\x43\n\x0bInstanceGet\x12\x19.imrpc.InstanceGetRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12K\n\x0fInstanceRefresh\x12\x1d.imrpc.InstanceRefreshRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12I\n\x0cInstanceList\x12\x1a.imrpc.InstanceListRequest\x1a\x1b.imrpc.InstanceListResponse\"\x00\x12:\n\x0bInstanceLog\x12\x19.imrpc.InstanceLogRequest\x1a\x0c.LogResponse\"\x00\x30\x01\x12\x42\n\tVolumeLog\x12\x17.imrpc.VolumeLogRequest\x1a\x18.imrpc.VolumeLogResponse\"\x00\x30\x01\x12K\n\rInstanceWatch\x12\x1b.imrpc.InstanceWatchRequest\x1a\x19.imrpc.InstanceWatchEvent\"\x00\x30\x01\x12\\\n\x13InstanceWatchFreeze\x12!.imrpc.InstanceWatchFreezeRequest\x1a .imrpc.InstanceWatchFreezeStatus\"\x00\x12O\n\x11InstanceWatchThaw\x12\x16.google.protobuf.Empty\x1a .imrpc.InstanceWatchFreezeStatus\"\x00\x12K\n\x0fInstanceReplace\x12\x1d.imrpc.InstanceReplaceRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12K\n\x0fInstanceSuspend\x12\x1d.imrpc.InstanceSuspendRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12I\n\x0eInstanceResume\x12\x1c.imrpc.InstanceResumeRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12]\n\x18InstanceSwitchOverTarget\x12&.imrpc.InstanceSwitchOverTargetRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12U\n\x14InstanceDeleteTarget\x12\".imrpc.InstanceDeleteTargetRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12L\n\rInstanceStats\x12\x1b.imrpc.InstanceStatsRequest\x1a\x1c.imrpc.InstanceStatsResponse\"\x00\x12\x61\n\x14InstanceLatencyProbe\x12\".imrpc.InstanceLatencyProbeRequest\x1a#.imrpc.InstanceLatencyProbeResponse\"\x00\x12^\n\x13InstanceHealthCheck\x12!.imrpc.InstanceHealthCheckRequest\x1a\".imrpc.InstanceHealthCheckResponse\"\x00\x12^\n\x13NetworkPathValidate\x12!.imrpc.NetworkPathValidateRequest\x1a\".imrpc.NetworkPathValidateResponse\"\x00\x12^\n\x14InstanceIOTimeoutSet\x12\".imrpc.InstanceIOTimeoutSetRequest\x1a .imrpc.InstanceIOTimeoutResponse\"\x00\x12^\n\x14InstanceIOTimeoutGet\x12\".imrpc.InstanceIOTimeoutGetRequest\x1a .imrpc.InstanceIOTimeoutResponse\"\x00\x12_\n\x19InstanceReadPreferenceSet\x12\'.imrpc.InstanceReadPreferenceSetRequest\x1a\x17.imrpc.InstanceResponse\"\x00\x12T\n\x0fInstanceConvert\x12\x1d.imrpc.InstanceConvertRequest\x1a\x1e.imrpc.InstanceConvertProgress\"\x00\x30\x01\x12Z\n\x17\x45ngineMigrationRegister\x12%.imrpc.EngineMigrationRegisterRequest\x1a\x16.imrpc.EngineMigration\"\x00\x12V\n\x15\x45ngineMigrationUpdate\x12#.imrpc.EngineMigrationUpdateRequest\x1a\x16.imrpc.EngineMigration\"\x00\x12P\n\x12\x45ngineMigrationGet\x12 .imrpc.EngineMigrationGetRequest\x1a\x16.imrpc.EngineMigration\"\x00\x12S\n\x13\x45ngineMigrationList\x12\x16.google.protobuf.Empty\x1a\".imrpc.EngineMigrationListResponse\"\x00\x12V\n\x15\x45ngineMigrationDelete\x12#.imrpc.EngineMigrationDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12M\n\x12ReplicaSpareCreate\x12 .imrpc.ReplicaSpareCreateRequest\x1a\x13.imrpc.ReplicaSpare\"\x00\x12K\n\x11ReplicaSpareClaim\x12\x1f.imrpc.ReplicaSpareClaimRequest\x1a\x13.imrpc.ReplicaSpare\"\x00\x12M\n\x10ReplicaSpareList\x12\x16.google.protobuf.Empty\x1a\x1f.imrpc.ReplicaSpareListResponse\"\x00\x12P\n\x12ReplicaSpareDelete\x12 .imrpc.ReplicaSpareDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12`\n\x15ReplicaReadOnlyAttach\x12#.imrpc.ReplicaReadOnlyAttachRequest\x1a .imrpc.ReplicaReadOnlyAttachment\"\x00\x12g\n\x1dReplicaReadOnlyAttachmentList\x12\x16.google.protobuf.Empty\x1a,.imrpc.ReplicaReadOnlyAttachmentListResponse\"\x00\x12V\n\x15ReplicaReadOnlyDetach\x12#.imrpc.ReplicaReadOnlyDetachRequest\x1a\x16.google.protobuf.Empty\"\x00\x12M\n\x10\x44\x65\x66\x65rredTaskList\x12\x16.google.protobuf.Empty\x1a\x1f.imrpc.DeferredTaskListResponse\"\x00\x12^\n\x13SpdkOrphanReconcile\x12!.imrpc.SpdkOrphanReconcileRequest\x1a\".imrpc.SpdkOrphanReconcileResponse\"\x00\x12\x43\n\x0bStateExport\x12\x16.google.protobuf.Empty\x1a\x1a.imrpc.StateExportResponse\"\x00\x12\x46\n\x0bStateImport\x12\x19.imrpc.StateImportRequest\x1a\x1a.imrpc.StateImportResponse\"\x00\x12\x61\n\x14InstanceServiceDrain\x12\".imrpc.InstanceServiceDrainRequest\x1a#.imrpc.InstanceServiceDrainResponse\"\x00\x12=\n\x08PortsGet\x12\x16.google.protobuf.Empty\x1a\x17.imrpc.PortsGetResponse\"\x00\x12K\n\x0fStreamEventList\x12\x16.google.protobuf.Empty\x1a\x1e.imrpc.StreamEventListResponse\"\x00\x12\x36\n\nVersionGet\x12\x16.google.protobuf.Empty\x1a\x10.VersionResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -25,8 +25,12 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpc'
+  _PROCESSINSTANCESPEC_ENVSENTRY._options = None
+  _PROCESSINSTANCESPEC_ENVSENTRY._serialized_options = b'8\001'
   _SPDKINSTANCESPEC_REPLICAADDRESSMAPENTRY._options = None
   _SPDKINSTANCESPEC_REPLICAADDRESSMAPENTRY._serialized_options = b'8\001'
+  _INSTANCESPEC_LABELSENTRY._options = None
+  _INSTANCESPEC_LABELSENTRY._serialized_options = b'8\001'
   _INSTANCESPEC.fields_by_name['backend_store_driver']._options = None
   _INSTANCESPEC.fields_by_name['backend_store_driver']._serialized_options = b'\030\001'
   _INSTANCESTATUS_CONDITIONSENTRY._options = None
@@ -39,34 +43,212 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _INSTANCELISTRESPONSE_INSTANCESENTRY._serialized_options = b'8\001'
   _INSTANCELOGREQUEST.fields_by_name['backend_store_driver']._options = None
   _INSTANCELOGREQUEST.fields_by_name['backend_store_driver']._serialized_options = b'\030\001'
-  _globals['_PROCESSINSTANCESPEC']._serialized_start=249
-  _globals['_PROCESSINSTANCESPEC']._serialized_end=300
-  _globals['_SPDKINSTANCESPEC']._serialized_start=303
-  _globals['_SPDKINSTANCESPEC']._serialized_end=551
-  _globals['_SPDKINSTANCESPEC_REPLICAADDRESSMAPENTRY']._serialized_start=495
-  _globals['_SPDKINSTANCESPEC_REPLICAADDRESSMAPENTRY']._serialized_end=551
-  _globals['_INSTANCESPEC']._serialized_start=554
-  _globals['_INSTANCESPEC']._serialized_end=869
-  _globals['_INSTANCESTATUS']._serialized_start=872
-  _globals['_INSTANCESTATUS']._serialized_end=1070
-  _globals['_INSTANCESTATUS_CONDITIONSENTRY']._serialized_start=1021
-  _globals['_INSTANCESTATUS_CONDITIONSENTRY']._serialized_end=1070
-  _globals['_INSTANCECREATEREQUEST']._serialized_start=1072
-  _globals['_INSTANCECREATEREQUEST']._serialized_end=1130
-  _globals['_INSTANCEDELETEREQUEST']._serialized_start=1133
-  _globals['_INSTANCEDELETEREQUEST']._serialized_end=1330
-  _globals['_INSTANCEGETREQUEST']._serialized_start=1333
-  _globals['_INSTANCEGETREQUEST']._serialized_end=1482
-  _globals['_INSTANCERESPONSE']._serialized_start=1484
-  _globals['_INSTANCERESPONSE']._serialized_end=1593
-  _globals['_INSTANCELISTRESPONSE']._serialized_start=1596
-  _globals['_INSTANCELISTRESPONSE']._serialized_end=1756
-  _globals['_INSTANCELISTRESPONSE_INSTANCESENTRY']._serialized_start=1683
-  _globals['_INSTANCELISTRESPONSE_INSTANCESENTRY']._serialized_end=1756
-  _globals['_INSTANCELOGREQUEST']._serialized_start=1759
-  _globals['_INSTANCELOGREQUEST']._serialized_end=1908
-  _globals['_INSTANCEREPLACEREQUEST']._serialized_start=1910
-  _globals['_INSTANCEREPLACEREQUEST']._serialized_end=1995
-  _globals['_INSTANCESERVICE']._serialized_start=1998
-  _globals['_INSTANCESERVICE']._serialized_end=2567
+  _INSTANCESTATSRESPONSE_STATSENTRY._options = None
+  _INSTANCESTATSRESPONSE_STATSENTRY._serialized_options = b'8\001'
+  _INSTANCELATENCYPROBERESPONSE_REPLICAHOPSENTRY._options = None
+  _INSTANCELATENCYPROBERESPONSE_REPLICAHOPSENTRY._serialized_options = b'8\001'
+  _INSTANCELATENCYPROBERESPONSE_REPLICAHOPERRORSENTRY._options = None
+  _INSTANCELATENCYPROBERESPONSE_REPLICAHOPERRORSENTRY._serialized_options = b'8\001'
+  _ENGINEMIGRATIONLISTRESPONSE_MIGRATIONSENTRY._options = None
+  _ENGINEMIGRATIONLISTRESPONSE_MIGRATIONSENTRY._serialized_options = b'8\001'
+  _REPLICASPARELISTRESPONSE_SPARESENTRY._options = None
+  _REPLICASPARELISTRESPONSE_SPARESENTRY._serialized_options = b'8\001'
+  _REPLICAREADONLYATTACHMENTLISTRESPONSE_ATTACHMENTSENTRY._options = None
+  _REPLICAREADONLYATTACHMENTLISTRESPONSE_ATTACHMENTSENTRY._serialized_options = b'8\001'
+  _INSTANCECONVERTREQUEST_LABELSENTRY._options = None
+  _INSTANCECONVERTREQUEST_LABELSENTRY._serialized_options = b'8\001'
+  _DEFERREDTASK_ARGSENTRY._options = None
+  _DEFERREDTASK_ARGSENTRY._serialized_options = b'8\001'
+  _globals['_PROCESSINSTANCESPEC']._serialized_start=250
+  _globals['_PROCESSINSTANCESPEC']._serialized_end=589
+  _globals['_PROCESSINSTANCESPEC_ENVSENTRY']._serialized_start=546
+  _globals['_PROCESSINSTANCESPEC_ENVSENTRY']._serialized_end=589
+  _globals['_SPDKINSTANCESPEC']._serialized_start=592
+  _globals['_SPDKINSTANCESPEC']._serialized_end=887
+  _globals['_SPDKINSTANCESPEC_REPLICAADDRESSMAPENTRY']._serialized_start=831
+  _globals['_SPDKINSTANCESPEC_REPLICAADDRESSMAPENTRY']._serialized_end=887
+  _globals['_INSTANCESPEC']._serialized_start=890
+  _globals['_INSTANCESPEC']._serialized_end=1379
+  _globals['_INSTANCESPEC_LABELSENTRY']._serialized_start=1334
+  _globals['_INSTANCESPEC_LABELSENTRY']._serialized_end=1379
+  _globals['_INSTANCERESTARTPOLICY']._serialized_start=1381
+  _globals['_INSTANCERESTARTPOLICY']._serialized_end=1461
+  _globals['_INSTANCESTATUS']._serialized_start=1464
+  _globals['_INSTANCESTATUS']._serialized_end=1912
+  _globals['_INSTANCESTATUS_CONDITIONSENTRY']._serialized_start=1863
+  _globals['_INSTANCESTATUS_CONDITIONSENTRY']._serialized_end=1912
+  _globals['_INSTANCENVMEIDENTITY']._serialized_start=1915
+  _globals['_INSTANCENVMEIDENTITY']._serialized_end=2084
+  _globals['_INSTANCEPORT']._serialized_start=2086
+  _globals['_INSTANCEPORT']._serialized_end=2190
+  _globals['_INSTANCEREADPATH']._serialized_start=2192
+  _globals['_INSTANCEREADPATH']._serialized_end=2313
+  _globals['_INSTANCECREATEREQUEST']._serialized_start=2315
+  _globals['_INSTANCECREATEREQUEST']._serialized_end=2423
+  _globals['_INSTANCEDELETEREQUEST']._serialized_start=2426
+  _globals['_INSTANCEDELETEREQUEST']._serialized_end=2653
+  _globals['_INSTANCEBATCHCREATEREQUEST']._serialized_start=2655
+  _globals['_INSTANCEBATCHCREATEREQUEST']._serialized_end=2752
+  _globals['_INSTANCEBATCHDELETEREQUEST']._serialized_start=2754
+  _globals['_INSTANCEBATCHDELETEREQUEST']._serialized_end=2851
+  _globals['_INSTANCEBATCHRESULT']._serialized_start=2853
+  _globals['_INSTANCEBATCHRESULT']._serialized_end=2970
+  _globals['_INSTANCEBATCHRESPONSE']._serialized_start=2972
+  _globals['_INSTANCEBATCHRESPONSE']._serialized_end=3040
+  _globals['_INSTANCEGETREQUEST']._serialized_start=3043
+  _globals['_INSTANCEGETREQUEST']._serialized_end=3223
+  _globals['_INSTANCEREFRESHREQUEST']._serialized_start=3225
+  _globals['_INSTANCEREFRESHREQUEST']._serialized_end=3317
+  _globals['_INSTANCESUSPENDREQUEST']._serialized_start=3319
+  _globals['_INSTANCESUSPENDREQUEST']._serialized_end=3411
+  _globals['_INSTANCERESUMEREQUEST']._serialized_start=3413
+  _globals['_INSTANCERESUMEREQUEST']._serialized_end=3504
+  _globals['_INSTANCESWITCHOVERTARGETREQUEST']._serialized_start=3506
+  _globals['_INSTANCESWITCHOVERTARGETREQUEST']._serialized_end=3617
+  _globals['_INSTANCEDELETETARGETREQUEST']._serialized_start=3619
+  _globals['_INSTANCEDELETETARGETREQUEST']._serialized_end=3702
+  _globals['_INSTANCEOPERATION']._serialized_start=3704
+  _globals['_INSTANCEOPERATION']._serialized_end=3797
+  _globals['_INSTANCERESPONSE']._serialized_start=3800
+  _globals['_INSTANCERESPONSE']._serialized_end=3988
+  _globals['_INSTANCELISTREQUEST']._serialized_start=3991
+  _globals['_INSTANCELISTREQUEST']._serialized_end=4200
+  _globals['_INSTANCELISTRESPONSE']._serialized_start=4203
+  _globals['_INSTANCELISTRESPONSE']._serialized_end=4452
+  _globals['_INSTANCELISTRESPONSE_INSTANCESENTRY']._serialized_start=4379
+  _globals['_INSTANCELISTRESPONSE_INSTANCESENTRY']._serialized_end=4452
+  _globals['_INSTANCEWATCHREQUEST']._serialized_start=4454
+  _globals['_INSTANCEWATCHREQUEST']._serialized_end=4522
+  _globals['_INSTANCEWATCHEVENT']._serialized_start=4525
+  _globals['_INSTANCEWATCHEVENT']._serialized_end=4712
+  _globals['_INSTANCEWATCHFREEZEREQUEST']._serialized_start=4714
+  _globals['_INSTANCEWATCHFREEZEREQUEST']._serialized_end=4779
+  _globals['_INSTANCEWATCHFREEZESTATUS']._serialized_start=4781
+  _globals['_INSTANCEWATCHFREEZESTATUS']._serialized_end=4879
+  _globals['_INSTANCELOGREQUEST']._serialized_start=4882
+  _globals['_INSTANCELOGREQUEST']._serialized_end=5092
+  _globals['_VOLUMELOGREQUEST']._serialized_start=5094
+  _globals['_VOLUMELOGREQUEST']._serialized_end=5133
+  _globals['_VOLUMELOGRESPONSE']._serialized_start=5136
+  _globals['_VOLUMELOGRESPONSE']._serialized_end=5269
+  _globals['_INSTANCEREPLACEREQUEST']._serialized_start=5271
+  _globals['_INSTANCEREPLACEREQUEST']._serialized_end=5356
+  _globals['_INSTANCESTATSREQUEST']._serialized_start=5358
+  _globals['_INSTANCESTATSREQUEST']._serialized_end=5394
+  _globals['_INSTANCENETWORKSTATS']._serialized_start=5396
+  _globals['_INSTANCENETWORKSTATS']._serialized_end=5521
+  _globals['_INSTANCESTATSRESPONSE']._serialized_start=5524
+  _globals['_INSTANCESTATSRESPONSE']._serialized_end=5678
+  _globals['_INSTANCESTATSRESPONSE_STATSENTRY']._serialized_start=5605
+  _globals['_INSTANCESTATSRESPONSE_STATSENTRY']._serialized_end=5678
+  _globals['_INSTANCELATENCYPROBEREQUEST']._serialized_start=5681
+  _globals['_INSTANCELATENCYPROBEREQUEST']._serialized_end=5839
+  _globals['_LATENCYSTATS']._serialized_start=5841
+  _globals['_LATENCYSTATS']._serialized_end=5918
+  _globals['_INSTANCELATENCYPROBERESPONSE']._serialized_start=5921
+  _globals['_INSTANCELATENCYPROBERESPONSE']._serialized_end=6331
+  _globals['_INSTANCELATENCYPROBERESPONSE_REPLICAHOPSENTRY']._serialized_start=6203
+  _globals['_INSTANCELATENCYPROBERESPONSE_REPLICAHOPSENTRY']._serialized_end=6274
+  _globals['_INSTANCELATENCYPROBERESPONSE_REPLICAHOPERRORSENTRY']._serialized_start=6276
+  _globals['_INSTANCELATENCYPROBERESPONSE_REPLICAHOPERRORSENTRY']._serialized_end=6331
+  _globals['_INSTANCEHEALTHCHECKREQUEST']._serialized_start=6333
+  _globals['_INSTANCEHEALTHCHECKREQUEST']._serialized_end=6447
+  _globals['_INSTANCEHEALTHCHECKRESPONSE']._serialized_start=6450
+  _globals['_INSTANCEHEALTHCHECKRESPONSE']._serialized_end=6582
+  _globals['_INSTANCEIOTIMEOUTS']._serialized_start=6585
+  _globals['_INSTANCEIOTIMEOUTS']._serialized_end=6722
+  _globals['_INSTANCEIOTIMEOUTSETREQUEST']._serialized_start=6725
+  _globals['_INSTANCEIOTIMEOUTSETREQUEST']._serialized_end=6853
+  _globals['_INSTANCEIOTIMEOUTGETREQUEST']._serialized_start=6855
+  _globals['_INSTANCEIOTIMEOUTGETREQUEST']._serialized_end=6938
+  _globals['_INSTANCEIOTIMEOUTRESPONSE']._serialized_start=6941
+  _globals['_INSTANCEIOTIMEOUTRESPONSE']._serialized_end=7094
+  _globals['_INSTANCEREADPREFERENCESETREQUEST']._serialized_start=7096
+  _globals['_INSTANCEREADPREFERENCESETREQUEST']._serialized_end=7206
+  _globals['_NETWORKPATHVALIDATEREQUEST']._serialized_start=7208
+  _globals['_NETWORKPATHVALIDATEREQUEST']._serialized_end=7292
+  _globals['_NETWORKPATHRESULT']._serialized_start=7295
+  _globals['_NETWORKPATHRESULT']._serialized_end=7533
+  _globals['_NETWORKPATHVALIDATERESPONSE']._serialized_start=7535
+  _globals['_NETWORKPATHVALIDATERESPONSE']._serialized_end=7607
+  _globals['_ENGINEMIGRATION']._serialized_start=7610
+  _globals['_ENGINEMIGRATION']._serialized_end=7914
+  _globals['_ENGINEMIGRATIONREGISTERREQUEST']._serialized_start=7917
+  _globals['_ENGINEMIGRATIONREGISTERREQUEST']._serialized_end=8085
+  _globals['_ENGINEMIGRATIONUPDATEREQUEST']._serialized_start=8088
+  _globals['_ENGINEMIGRATIONUPDATEREQUEST']._serialized_end=8271
+  _globals['_ENGINEMIGRATIONGETREQUEST']._serialized_start=8273
+  _globals['_ENGINEMIGRATIONGETREQUEST']._serialized_end=8321
+  _globals['_ENGINEMIGRATIONDELETEREQUEST']._serialized_start=8323
+  _globals['_ENGINEMIGRATIONDELETEREQUEST']._serialized_end=8374
+  _globals['_ENGINEMIGRATIONLISTRESPONSE']._serialized_start=8377
+  _globals['_ENGINEMIGRATIONLISTRESPONSE']._serialized_end=8553
+  _globals['_ENGINEMIGRATIONLISTRESPONSE_MIGRATIONSENTRY']._serialized_start=8480
+  _globals['_ENGINEMIGRATIONLISTRESPONSE_MIGRATIONSENTRY']._serialized_end=8553
+  _globals['_REPLICASPARE']._serialized_start=8556
+  _globals['_REPLICASPARE']._serialized_end=8726
+  _globals['_REPLICASPARECREATEREQUEST']._serialized_start=8728
+  _globals['_REPLICASPARECREATEREQUEST']._serialized_end=8848
+  _globals['_REPLICASPARECLAIMREQUEST']._serialized_start=8850
+  _globals['_REPLICASPARECLAIMREQUEST']._serialized_end=8928
+  _globals['_REPLICASPARELISTRESPONSE']._serialized_start=8931
+  _globals['_REPLICASPARELISTRESPONSE']._serialized_end=9086
+  _globals['_REPLICASPARELISTRESPONSE_SPARESENTRY']._serialized_start=9020
+  _globals['_REPLICASPARELISTRESPONSE_SPARESENTRY']._serialized_end=9086
+  _globals['_REPLICASPAREDELETEREQUEST']._serialized_start=9088
+  _globals['_REPLICASPAREDELETEREQUEST']._serialized_end=9129
+  _globals['_REPLICAREADONLYATTACHMENT']._serialized_start=9132
+  _globals['_REPLICAREADONLYATTACHMENT']._serialized_end=9288
+  _globals['_REPLICAREADONLYATTACHREQUEST']._serialized_start=9290
+  _globals['_REPLICAREADONLYATTACHREQUEST']._serialized_end=9414
+  _globals['_REPLICAREADONLYATTACHMENTLISTRESPONSE']._serialized_start=9417
+  _globals['_REPLICAREADONLYATTACHMENTLISTRESPONSE']._serialized_end=9626
+  _globals['_REPLICAREADONLYATTACHMENTLISTRESPONSE_ATTACHMENTSENTRY']._serialized_start=9542
+  _globals['_REPLICAREADONLYATTACHMENTLISTRESPONSE_ATTACHMENTSENTRY']._serialized_end=9626
+  _globals['_REPLICAREADONLYDETACHREQUEST']._serialized_start=9628
+  _globals['_REPLICAREADONLYDETACHREQUEST']._serialized_end=9672
+  _globals['_SPDKORPHANRECONCILEREQUEST']._serialized_start=9674
+  _globals['_SPDKORPHANRECONCILEREQUEST']._serialized_end=9778
+  _globals['_SPDKORPHANRESOURCE']._serialized_start=9780
+  _globals['_SPDKORPHANRESOURCE']._serialized_end=9899
+  _globals['_SPDKORPHANRECONCILERESPONSE']._serialized_start=9901
+  _globals['_SPDKORPHANRECONCILERESPONSE']._serialized_end=9976
+  _globals['_STATEEXPORTRESPONSE']._serialized_start=9978
+  _globals['_STATEEXPORTRESPONSE']._serialized_end=10031
+  _globals['_STATEIMPORTREQUEST']._serialized_start=10033
+  _globals['_STATEIMPORTREQUEST']._serialized_end=10091
+  _globals['_STATEIMPORTRESULT']._serialized_start=10093
+  _globals['_STATEIMPORTRESULT']._serialized_end=10215
+  _globals['_STATEIMPORTRESPONSE']._serialized_start=10217
+  _globals['_STATEIMPORTRESPONSE']._serialized_end=10281
+  _globals['_INSTANCESERVICEDRAINREQUEST']._serialized_start=10283
+  _globals['_INSTANCESERVICEDRAINREQUEST']._serialized_end=10354
+  _globals['_INSTANCESERVICEDRAINRESPONSE']._serialized_start=10356
+  _globals['_INSTANCESERVICEDRAINRESPONSE']._serialized_end=10468
+  _globals['_PORTRANGE']._serialized_start=10470
+  _globals['_PORTRANGE']._serialized_end=10509
+  _globals['_INSTANCEPORTALLOCATION']._serialized_start=10511
+  _globals['_INSTANCEPORTALLOCATION']._serialized_end=10626
+  _globals['_DATAENGINEPORTS']._serialized_start=10629
+  _globals['_DATAENGINEPORTS']._serialized_end=10823
+  _globals['_PORTSGETRESPONSE']._serialized_start=10825
+  _globals['_PORTSGETRESPONSE']._serialized_end=10889
+  _globals['_INSTANCECONVERTREQUEST']._serialized_start=10892
+  _globals['_INSTANCECONVERTREQUEST']._serialized_end=11175
+  _globals['_INSTANCECONVERTREQUEST_LABELSENTRY']._serialized_start=1334
+  _globals['_INSTANCECONVERTREQUEST_LABELSENTRY']._serialized_end=1379
+  _globals['_INSTANCECONVERTPROGRESS']._serialized_start=11178
+  _globals['_INSTANCECONVERTPROGRESS']._serialized_end=11355
+  _globals['_STREAMEVENT']._serialized_start=11358
+  _globals['_STREAMEVENT']._serialized_end=11537
+  _globals['_STREAMEVENTLISTRESPONSE']._serialized_start=11539
+  _globals['_STREAMEVENTLISTRESPONSE']._serialized_end=11635
+  _globals['_DEFERREDTASK']._serialized_start=11638
+  _globals['_DEFERREDTASK']._serialized_end=11851
+  _globals['_DEFERREDTASK_ARGSENTRY']._serialized_start=11808
+  _globals['_DEFERREDTASK_ARGSENTRY']._serialized_end=11851
+  _globals['_DEFERREDTASKLISTRESPONSE']._serialized_start=11853
+  _globals['_DEFERREDTASKLISTRESPONSE']._serialized_end=11915
+  _globals['_INSTANCESERVICE']._serialized_start=11918
+  _globals['_INSTANCESERVICE']._serialized_end=15675
 # @@protoc_insertion_point(module_scope)
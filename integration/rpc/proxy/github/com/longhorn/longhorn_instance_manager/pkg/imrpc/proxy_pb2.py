@@ -17,7 +17,7 @@ from github.com.longhorn.longhorn_engine.proto.ptypes import syncagent_pb2 as gi
 from github.com.longhorn.longhorn_instance_manager.pkg.imrpc import common_pb2 as github_dot_com_dot_longhorn_dot_longhorn__instance__manager_dot_pkg_dot_imrpc_dot_common__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nCgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/proxy.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\x1a\x41github.com/longhorn/longhorn-engine/proto/ptypes/controller.proto\x1a@github.com/longhorn/longhorn-engine/proto/ptypes/syncagent.proto\x1a\x44github.com/longhorn/longhorn-instance-manager/pkg/imrpc/common.proto\"\xb4\x01\n\x12ProxyEngineRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\t\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x02 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x13\n\x0b\x65ngine_name\x18\x03 \x01(\t\x12\x13\n\x0bvolume_name\x18\x04 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x05 \x01(\x0e\x32\x11.imrpc.DataEngine\"D\n\x1a\x45ngineVersionProxyResponse\x12&\n\x07version\x18\x01 \x01(\x0b\x32\x15.ptypes.VersionOutput\">\n\x1c\x45ngineVolumeGetProxyResponse\x12\x1e\n\x06volume\x18\x01 \x01(\x0b\x32\x0e.ptypes.Volume\"\x81\x01\n\x19\x45ngineVolumeExpandRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12+\n\x06\x65xpand\x18\x02 \x01(\x0b\x32\x1b.ptypes.VolumeExpandRequest\"\x97\x01\n EngineVolumeFrontendStartRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12:\n\x0e\x66rontend_start\x18\x02 \x01(\x0b\x32\".ptypes.VolumeFrontendStartRequest\"\x8e\x01\n\x1b\x45ngineVolumeSnapshotRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x36\n\x0fsnapshot_volume\x18\x02 \x01(\x0b\x32\x1d.ptypes.VolumeSnapshotRequest\"R\n!EngineVolumeSnapshotProxyResponse\x12-\n\x08snapshot\x18\x01 \x01(\x0b\x32\x1b.ptypes.VolumeSnapshotReply\"\xb6\x01\n/EngineVolumeUnmapMarkSnapChainRemovedSetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12J\n\x0funmap_mark_snap\x18\x02 \x01(\x0b\x32\x31.ptypes.VolumeUnmapMarkSnapChainRemovedSetRequest\"\x9a\x01\n&EngineVolumeSnapshotMaxCountSetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x37\n\x05\x63ount\x18\x02 \x01(\x0b\x32(.ptypes.VolumeSnapshotMaxCountSetRequest\"\x97\x01\n%EngineVolumeSnapshotMaxSizeSetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x35\n\x04size\x18\x02 \x01(\x0b\x32\'.ptypes.VolumeSnapshotMaxSizeSetRequest\"\xb0\x01\n\x1f\x45ngineSnapshotListProxyResponse\x12@\n\x05\x64isks\x18\x01 \x03(\x0b\x32\x31.imrpc.EngineSnapshotListProxyResponse.DisksEntry\x1aK\n\nDisksEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x1d.imrpc.EngineSnapshotDiskInfo:\x02\x38\x01\"\xd6\x02\n\x16\x45ngineSnapshotDiskInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06parent\x18\x02 \x01(\t\x12=\n\x08\x63hildren\x18\x03 \x03(\x0b\x32+.imrpc.EngineSnapshotDiskInfo.ChildrenEntry\x12\x0f\n\x07removed\x18\x04 \x01(\x08\x12\x14\n\x0cuser_created\x18\x05 \x01(\x08\x12\x0f\n\x07\x63reated\x18\x06 \x01(\t\x12\x0c\n\x04size\x18\x07 \x01(\t\x12\x39\n\x06labels\x18\x08 \x03(\x0b\x32).imrpc.EngineSnapshotDiskInfo.LabelsEntry\x1a/\n\rChildrenEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"d\n\x1b\x45ngineSnapshotRevertRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\"r\n\x1a\x45ngineSnapshotPurgeRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x1b\n\x13skip_if_in_progress\x18\x02 \x01(\x08\"\xc7\x01\n&EngineSnapshotPurgeStatusProxyResponse\x12I\n\x06status\x18\x01 \x03(\x0b\x32\x39.imrpc.EngineSnapshotPurgeStatusProxyResponse.StatusEntry\x1aR\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.ptypes.SnapshotPurgeStatusResponse:\x02\x38\x01\"\x8b\x02\n\x1a\x45ngineSnapshotCloneRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x1b\n\x13\x66rom_engine_address\x18\x02 \x01(\t\x12\x15\n\rsnapshot_name\x18\x03 \x01(\t\x12%\n\x1d\x65xport_backing_image_if_exist\x18\x04 \x01(\x08\x12%\n\x1d\x66ile_sync_http_client_timeout\x18\x05 \x01(\x05\x12\x18\n\x10\x66rom_engine_name\x18\x06 \x01(\t\x12\x18\n\x10\x66rom_volume_name\x18\x07 \x01(\t\"\xc7\x01\n&EngineSnapshotCloneStatusProxyResponse\x12I\n\x06status\x18\x01 \x03(\x0b\x32\x39.imrpc.EngineSnapshotCloneStatusProxyResponse.StatusEntry\x1aR\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.ptypes.SnapshotCloneStatusResponse:\x02\x38\x01\"e\n\x1b\x45ngineSnapshotRemoveRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\r\n\x05names\x18\x02 \x03(\t\"\xa4\x03\n\x1b\x45ngineSnapshotBackupRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x0c\n\x04\x65nvs\x18\x08 \x03(\t\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x02 \x01(\t\x12\x15\n\rsnapshot_name\x18\x03 \x01(\t\x12\x15\n\rbackup_target\x18\x04 \x01(\t\x12\x1a\n\x12\x62\x61\x63king_image_name\x18\x05 \x01(\t\x12\x1e\n\x16\x62\x61\x63king_image_checksum\x18\x06 \x01(\t\x12>\n\x06labels\x18\x07 \x03(\x0b\x32..imrpc.EngineSnapshotBackupRequest.LabelsEntry\x12\x1a\n\x12\x63ompression_method\x18\t \x01(\t\x12\x18\n\x10\x63oncurrent_limit\x18\n \x01(\x05\x12\x1a\n\x12storage_class_name\x18\x0b \x01(\t\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"_\n!EngineSnapshotBackupProxyResponse\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x0f\n\x07replica\x18\x02 \x01(\t\x12\x16\n\x0eis_incremental\x18\x03 \x01(\x08\"\xa0\x01\n!EngineSnapshotBackupStatusRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x02 \x01(\t\x12\x17\n\x0freplica_address\x18\x03 \x01(\t\x12\x14\n\x0creplica_name\x18\x04 \x01(\t\"\x9d\x01\n\'EngineSnapshotBackupStatusProxyResponse\x12\x12\n\nbackup_url\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x10\n\x08progress\x18\x03 \x01(\x05\x12\x15\n\rsnapshot_name\x18\x04 \x01(\t\x12\r\n\x05state\x18\x05 \x01(\t\x12\x17\n\x0freplica_address\x18\x06 \x01(\t\"\xaf\x01\n\x1a\x45ngineBackupRestoreRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x0c\n\x04\x65nvs\x18\x02 \x03(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0e\n\x06target\x18\x04 \x01(\t\x12\x13\n\x0bvolume_name\x18\x05 \x01(\t\x12\x18\n\x10\x63oncurrent_limit\x18\x06 \x01(\x05\"5\n EngineBackupRestoreProxyResponse\x12\x11\n\ttaskError\x18\x01 \x01(\x0c\"\xc4\x01\n&EngineBackupRestoreStatusProxyResponse\x12I\n\x06status\x18\x01 \x03(\x0b\x32\x39.imrpc.EngineBackupRestoreStatusProxyResponse.StatusEntry\x1aO\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12/\n\x05value\x18\x02 \x01(\x0b\x32 .imrpc.EngineBackupRestoreStatus:\x02\x38\x01\"\xc0\x01\n\x19\x45ngineBackupRestoreStatus\x12\x14\n\x0cis_restoring\x18\x01 \x01(\x08\x12\x15\n\rlast_restored\x18\x02 \x01(\t\x12 \n\x18\x63urrent_restoring_backup\x18\x03 \x01(\t\x12\x10\n\x08progress\x18\x04 \x01(\x05\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x10\n\x08\x66ilename\x18\x06 \x01(\t\x12\r\n\x05state\x18\x07 \x01(\t\x12\x12\n\nbackup_url\x18\x08 \x01(\t\"[\n EngineBackupRestoreFinishRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\"\xf0\x01\n\x17\x45ngineReplicaAddRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12\x0f\n\x07restore\x18\x03 \x01(\x08\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x14\n\x0c\x63urrent_size\x18\x05 \x01(\x03\x12\x11\n\tfast_sync\x18\x06 \x01(\x08\x12%\n\x1d\x66ile_sync_http_client_timeout\x18\x07 \x01(\x05\x12\x14\n\x0creplica_name\x18\x08 \x01(\t\"P\n\x1e\x45ngineReplicaListProxyResponse\x12.\n\x0creplica_list\x18\x01 \x01(\x0b\x32\x18.ptypes.ReplicaListReply\"\x8b\x01\n!EngineReplicaVerifyRebuildRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12\x14\n\x0creplica_name\x18\x03 \x01(\t\"\xca\x01\n\'EngineReplicaRebuildStatusProxyResponse\x12J\n\x06status\x18\x01 \x03(\x0b\x32:.imrpc.EngineReplicaRebuildStatusProxyResponse.StatusEntry\x1aS\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.ptypes.ReplicaRebuildStatusResponse:\x02\x38\x01\"\x84\x01\n\x1a\x45ngineReplicaRemoveRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12\x14\n\x0creplica_name\x18\x03 \x01(\t\"\x95\x01\n\x1e\x45ngineReplicaModeUpdateRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12!\n\x04mode\x18\x03 \x01(\x0e\x32\x13.ptypes.ReplicaMode\"{\n\x19\x45ngineSnapshotHashRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x15\n\rsnapshot_name\x18\x02 \x01(\t\x12\x0e\n\x06rehash\x18\x03 \x01(\x08\"q\n\x1f\x45ngineSnapshotHashStatusRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x15\n\rsnapshot_name\x18\x02 \x01(\t\"\xc4\x01\n%EngineSnapshotHashStatusProxyResponse\x12H\n\x06status\x18\x01 \x03(\x0b\x32\x38.imrpc.EngineSnapshotHashStatusProxyResponse.StatusEntry\x1aQ\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\".ptypes.SnapshotHashStatusResponse:\x02\x38\x01\"A\n\x1d\x45ngineMetricsGetProxyResponse\x12 \n\x07metrics\x18\x01 \x01(\x0b\x32\x0f.ptypes.Metrics2\xb7\x15\n\x12ProxyEngineService\x12P\n\x10ServerVersionGet\x12\x19.imrpc.ProxyEngineRequest\x1a!.imrpc.EngineVersionProxyResponse\x12K\n\tVolumeGet\x12\x19.imrpc.ProxyEngineRequest\x1a#.imrpc.EngineVolumeGetProxyResponse\x12H\n\x0cVolumeExpand\x12 .imrpc.EngineVolumeExpandRequest\x1a\x16.google.protobuf.Empty\x12V\n\x13VolumeFrontendStart\x12\'.imrpc.EngineVolumeFrontendStartRequest\x1a\x16.google.protobuf.Empty\x12K\n\x16VolumeFrontendShutdown\x12\x19.imrpc.ProxyEngineRequest\x1a\x16.google.protobuf.Empty\x12t\n\"VolumeUnmapMarkSnapChainRemovedSet\x12\x36.imrpc.EngineVolumeUnmapMarkSnapChainRemovedSetRequest\x1a\x16.google.protobuf.Empty\x12\x62\n\x19VolumeSnapshotMaxCountSet\x12-.imrpc.EngineVolumeSnapshotMaxCountSetRequest\x1a\x16.google.protobuf.Empty\x12`\n\x18VolumeSnapshotMaxSizeSet\x12,.imrpc.EngineVolumeSnapshotMaxSizeSetRequest\x1a\x16.google.protobuf.Empty\x12^\n\x0eVolumeSnapshot\x12\".imrpc.EngineVolumeSnapshotRequest\x1a(.imrpc.EngineVolumeSnapshotProxyResponse\x12Q\n\x0cSnapshotList\x12\x19.imrpc.ProxyEngineRequest\x1a&.imrpc.EngineSnapshotListProxyResponse\x12L\n\x0eSnapshotRevert\x12\".imrpc.EngineSnapshotRevertRequest\x1a\x16.google.protobuf.Empty\x12J\n\rSnapshotPurge\x12!.imrpc.EngineSnapshotPurgeRequest\x1a\x16.google.protobuf.Empty\x12_\n\x13SnapshotPurgeStatus\x12\x19.imrpc.ProxyEngineRequest\x1a-.imrpc.EngineSnapshotPurgeStatusProxyResponse\x12J\n\rSnapshotClone\x12!.imrpc.EngineSnapshotCloneRequest\x1a\x16.google.protobuf.Empty\x12_\n\x13SnapshotCloneStatus\x12\x19.imrpc.ProxyEngineRequest\x1a-.imrpc.EngineSnapshotCloneStatusProxyResponse\x12L\n\x0eSnapshotRemove\x12\".imrpc.EngineSnapshotRemoveRequest\x1a\x16.google.protobuf.Empty\x12H\n\x0cSnapshotHash\x12 .imrpc.EngineSnapshotHashRequest\x1a\x16.google.protobuf.Empty\x12j\n\x12SnapshotHashStatus\x12&.imrpc.EngineSnapshotHashStatusRequest\x1a,.imrpc.EngineSnapshotHashStatusProxyResponse\x12^\n\x0eSnapshotBackup\x12\".imrpc.EngineSnapshotBackupRequest\x1a(.imrpc.EngineSnapshotBackupProxyResponse\x12p\n\x14SnapshotBackupStatus\x12(.imrpc.EngineSnapshotBackupStatusRequest\x1a..imrpc.EngineSnapshotBackupStatusProxyResponse\x12[\n\rBackupRestore\x12!.imrpc.EngineBackupRestoreRequest\x1a\'.imrpc.EngineBackupRestoreProxyResponse\x12_\n\x13\x42\x61\x63kupRestoreStatus\x12\x19.imrpc.ProxyEngineRequest\x1a-.imrpc.EngineBackupRestoreStatusProxyResponse\x12V\n\x13\x42\x61\x63kupRestoreFinish\x12\'.imrpc.EngineBackupRestoreFinishRequest\x1a\x16.google.protobuf.Empty\x12J\n\x18\x43leanupBackupMountPoints\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12\x44\n\nReplicaAdd\x12\x1e.imrpc.EngineReplicaAddRequest\x1a\x16.google.protobuf.Empty\x12O\n\x0bReplicaList\x12\x19.imrpc.ProxyEngineRequest\x1a%.imrpc.EngineReplicaListProxyResponse\x12\x64\n\x17ReplicaRebuildingStatus\x12\x19.imrpc.ProxyEngineRequest\x1a..imrpc.EngineReplicaRebuildStatusProxyResponse\x12X\n\x14ReplicaVerifyRebuild\x12(.imrpc.EngineReplicaVerifyRebuildRequest\x1a\x16.google.protobuf.Empty\x12J\n\rReplicaRemove\x12!.imrpc.EngineReplicaRemoveRequest\x1a\x16.google.protobuf.Empty\x12R\n\x11ReplicaModeUpdate\x12%.imrpc.EngineReplicaModeUpdateRequest\x1a\x16.google.protobuf.Empty\x12M\n\nMetricsGet\x12\x19.imrpc.ProxyEngineRequest\x1a$.imrpc.EngineMetricsGetProxyResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\nCgithub.com/longhorn/longhorn-instance-manager/pkg/imrpc/proxy.proto\x12\x05imrpc\x1a\x1bgoogle/protobuf/empty.proto\x1a\x41github.com/longhorn/longhorn-engine/proto/ptypes/controller.proto\x1a@github.com/longhorn/longhorn-engine/proto/ptypes/syncagent.proto\x1a\x44github.com/longhorn/longhorn-instance-manager/pkg/imrpc/common.proto\"\xb4\x01\n\x12ProxyEngineRequest\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\t\x12;\n\x14\x62\x61\x63kend_store_driver\x18\x02 \x01(\x0e\x32\x19.imrpc.BackendStoreDriverB\x02\x18\x01\x12\x13\n\x0b\x65ngine_name\x18\x03 \x01(\t\x12\x13\n\x0bvolume_name\x18\x04 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x05 \x01(\x0e\x32\x11.imrpc.DataEngine\"D\n\x1a\x45ngineVersionProxyResponse\x12&\n\x07version\x18\x01 \x01(\x0b\x32\x15.ptypes.VersionOutput\">\n\x1c\x45ngineVolumeGetProxyResponse\x12\x1e\n\x06volume\x18\x01 \x01(\x0b\x32\x0e.ptypes.Volume\"\x81\x01\n\x19\x45ngineVolumeExpandRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12+\n\x06\x65xpand\x18\x02 \x01(\x0b\x32\x1b.ptypes.VolumeExpandRequest\"\x97\x01\n EngineVolumeFrontendStartRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12:\n\x0e\x66rontend_start\x18\x02 \x01(\x0b\x32\".ptypes.VolumeFrontendStartRequest\"\x8e\x01\n\x1b\x45ngineVolumeSnapshotRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x36\n\x0fsnapshot_volume\x18\x02 \x01(\x0b\x32\x1d.ptypes.VolumeSnapshotRequest\"R\n!EngineVolumeSnapshotProxyResponse\x12-\n\x08snapshot\x18\x01 \x01(\x0b\x32\x1b.ptypes.VolumeSnapshotReply\"\xb6\x01\n/EngineVolumeUnmapMarkSnapChainRemovedSetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12J\n\x0funmap_mark_snap\x18\x02 \x01(\x0b\x32\x31.ptypes.VolumeUnmapMarkSnapChainRemovedSetRequest\"\x9a\x01\n&EngineVolumeSnapshotMaxCountSetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x37\n\x05\x63ount\x18\x02 \x01(\x0b\x32(.ptypes.VolumeSnapshotMaxCountSetRequest\"\x97\x01\n%EngineVolumeSnapshotMaxSizeSetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x35\n\x04size\x18\x02 \x01(\x0b\x32\'.ptypes.VolumeSnapshotMaxSizeSetRequest\"\xb0\x01\n\x1f\x45ngineSnapshotListProxyResponse\x12@\n\x05\x64isks\x18\x01 \x03(\x0b\x32\x31.imrpc.EngineSnapshotListProxyResponse.DisksEntry\x1aK\n\nDisksEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x1d.imrpc.EngineSnapshotDiskInfo:\x02\x38\x01\"\xd6\x02\n\x16\x45ngineSnapshotDiskInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06parent\x18\x02 \x01(\t\x12=\n\x08\x63hildren\x18\x03 \x03(\x0b\x32+.imrpc.EngineSnapshotDiskInfo.ChildrenEntry\x12\x0f\n\x07removed\x18\x04 \x01(\x08\x12\x14\n\x0cuser_created\x18\x05 \x01(\x08\x12\x0f\n\x07\x63reated\x18\x06 \x01(\t\x12\x0c\n\x04size\x18\x07 \x01(\t\x12\x39\n\x06labels\x18\x08 \x03(\x0b\x32).imrpc.EngineSnapshotDiskInfo.LabelsEntry\x1a/\n\rChildrenEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x08:\x02\x38\x01\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x7f\n\x1b\x45ngineSnapshotRevertRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x19\n\x11\x66rontend_quiesced\x18\x03 \x01(\x08\"r\n\x1a\x45ngineSnapshotPurgeRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x1b\n\x13skip_if_in_progress\x18\x02 \x01(\x08\"\xc7\x01\n&EngineSnapshotPurgeStatusProxyResponse\x12I\n\x06status\x18\x01 \x03(\x0b\x32\x39.imrpc.EngineSnapshotPurgeStatusProxyResponse.StatusEntry\x1aR\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.ptypes.SnapshotPurgeStatusResponse:\x02\x38\x01\"\x8b\x02\n\x1a\x45ngineSnapshotCloneRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x1b\n\x13\x66rom_engine_address\x18\x02 \x01(\t\x12\x15\n\rsnapshot_name\x18\x03 \x01(\t\x12%\n\x1d\x65xport_backing_image_if_exist\x18\x04 \x01(\x08\x12%\n\x1d\x66ile_sync_http_client_timeout\x18\x05 \x01(\x05\x12\x18\n\x10\x66rom_engine_name\x18\x06 \x01(\t\x12\x18\n\x10\x66rom_volume_name\x18\x07 \x01(\t\"\xc7\x01\n&EngineSnapshotCloneStatusProxyResponse\x12I\n\x06status\x18\x01 \x03(\x0b\x32\x39.imrpc.EngineSnapshotCloneStatusProxyResponse.StatusEntry\x1aR\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.ptypes.SnapshotCloneStatusResponse:\x02\x38\x01\"e\n\x1b\x45ngineSnapshotRemoveRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\r\n\x05names\x18\x02 \x03(\t\"\xa4\x03\n\x1b\x45ngineSnapshotBackupRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x0c\n\x04\x65nvs\x18\x08 \x03(\t\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x02 \x01(\t\x12\x15\n\rsnapshot_name\x18\x03 \x01(\t\x12\x15\n\rbackup_target\x18\x04 \x01(\t\x12\x1a\n\x12\x62\x61\x63king_image_name\x18\x05 \x01(\t\x12\x1e\n\x16\x62\x61\x63king_image_checksum\x18\x06 \x01(\t\x12>\n\x06labels\x18\x07 \x03(\x0b\x32..imrpc.EngineSnapshotBackupRequest.LabelsEntry\x12\x1a\n\x12\x63ompression_method\x18\t \x01(\t\x12\x18\n\x10\x63oncurrent_limit\x18\n \x01(\x05\x12\x1a\n\x12storage_class_name\x18\x0b \x01(\t\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x87\x01\n!EngineSnapshotBackupProxyResponse\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x0f\n\x07replica\x18\x02 \x01(\t\x12\x16\n\x0eis_incremental\x18\x03 \x01(\x08\x12\x0e\n\x06queued\x18\x04 \x01(\x08\x12\x16\n\x0equeue_position\x18\x05 \x01(\x05\"\xa0\x01\n!EngineSnapshotBackupStatusRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x02 \x01(\t\x12\x17\n\x0freplica_address\x18\x03 \x01(\t\x12\x14\n\x0creplica_name\x18\x04 \x01(\t\"\x9d\x01\n\'EngineSnapshotBackupStatusProxyResponse\x12\x12\n\nbackup_url\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x12\x10\n\x08progress\x18\x03 \x01(\x05\x12\x15\n\rsnapshot_name\x18\x04 \x01(\t\x12\r\n\x05state\x18\x05 \x01(\t\x12\x17\n\x0freplica_address\x18\x06 \x01(\t\"\xaf\x01\n\x1a\x45ngineBackupRestoreRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x0c\n\x04\x65nvs\x18\x02 \x03(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0e\n\x06target\x18\x04 \x01(\t\x12\x13\n\x0bvolume_name\x18\x05 \x01(\t\x12\x18\n\x10\x63oncurrent_limit\x18\x06 \x01(\x05\"5\n EngineBackupRestoreProxyResponse\x12\x11\n\ttaskError\x18\x01 \x01(\x0c\"\xc4\x01\n&EngineBackupRestoreStatusProxyResponse\x12I\n\x06status\x18\x01 \x03(\x0b\x32\x39.imrpc.EngineBackupRestoreStatusProxyResponse.StatusEntry\x1aO\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12/\n\x05value\x18\x02 \x01(\x0b\x32 .imrpc.EngineBackupRestoreStatus:\x02\x38\x01\"\xc0\x01\n\x19\x45ngineBackupRestoreStatus\x12\x14\n\x0cis_restoring\x18\x01 \x01(\x08\x12\x15\n\rlast_restored\x18\x02 \x01(\t\x12 \n\x18\x63urrent_restoring_backup\x18\x03 \x01(\t\x12\x10\n\x08progress\x18\x04 \x01(\x05\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x10\n\x08\x66ilename\x18\x06 \x01(\t\x12\r\n\x05state\x18\x07 \x01(\t\x12\x12\n\nbackup_url\x18\x08 \x01(\t\"[\n EngineBackupRestoreFinishRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\"\xf0\x01\n\x17\x45ngineReplicaAddRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12\x0f\n\x07restore\x18\x03 \x01(\x08\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x14\n\x0c\x63urrent_size\x18\x05 \x01(\x03\x12\x11\n\tfast_sync\x18\x06 \x01(\x08\x12%\n\x1d\x66ile_sync_http_client_timeout\x18\x07 \x01(\x05\x12\x14\n\x0creplica_name\x18\x08 \x01(\t\"P\n\x1e\x45ngineReplicaListProxyResponse\x12.\n\x0creplica_list\x18\x01 \x01(\x0b\x32\x18.ptypes.ReplicaListReply\"\x8b\x01\n!EngineReplicaVerifyRebuildRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12\x14\n\x0creplica_name\x18\x03 \x01(\t\"\xca\x01\n\'EngineReplicaRebuildStatusProxyResponse\x12J\n\x06status\x18\x01 \x03(\x0b\x32:.imrpc.EngineReplicaRebuildStatusProxyResponse.StatusEntry\x1aS\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.ptypes.ReplicaRebuildStatusResponse:\x02\x38\x01\"\x84\x01\n\x1a\x45ngineReplicaRemoveRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12\x14\n\x0creplica_name\x18\x03 \x01(\t\"\x95\x01\n\x1e\x45ngineReplicaModeUpdateRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x17\n\x0freplica_address\x18\x02 \x01(\t\x12!\n\x04mode\x18\x03 \x01(\x0e\x32\x13.ptypes.ReplicaMode\"\xbb\x01\n\x15ReplicaModeTransition\x12\x13\n\x0b\x65ngine_name\x18\x01 \x01(\t\x12\x13\n\x0bvolume_name\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x17\n\x0freplica_address\x18\x04 \x01(\t\x12\x10\n\x08old_mode\x18\x05 \x01(\t\x12\x10\n\x08new_mode\x18\x06 \x01(\t\x12\x13\n\x0bobserved_at\x18\x07 \x01(\x03\"{\n\x19\x45ngineSnapshotHashRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x15\n\rsnapshot_name\x18\x02 \x01(\t\x12\x0e\n\x06rehash\x18\x03 \x01(\x08\"q\n\x1f\x45ngineSnapshotHashStatusRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12\x15\n\rsnapshot_name\x18\x02 \x01(\t\"\xc4\x01\n%EngineSnapshotHashStatusProxyResponse\x12H\n\x06status\x18\x01 \x03(\x0b\x32\x38.imrpc.EngineSnapshotHashStatusProxyResponse.StatusEntry\x1aQ\n\x0bStatusEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\".ptypes.SnapshotHashStatusResponse:\x02\x38\x01\"A\n\x1d\x45ngineMetricsGetProxyResponse\x12 \n\x07metrics\x18\x01 \x01(\x0b\x32\x0f.ptypes.Metrics\"\xf1\x02\n\x11ISCSISessionStats\x12\x12\n\nsession_id\x18\x01 \x01(\x05\x12\x13\n\x0btarget_name\x18\x02 \x01(\t\x12\x0e\n\x06portal\x18\x03 \x01(\t\x12\x16\n\x0etx_data_octets\x18\x04 \x01(\x03\x12\x16\n\x0erx_data_octets\x18\x05 \x01(\x03\x12\x19\n\x11scsi_command_pdus\x18\x06 \x01(\x03\x12\x1a\n\x12scsi_response_pdus\x18\x07 \x01(\x03\x12\x15\n\rdata_out_pdus\x18\x08 \x01(\x03\x12\x14\n\x0c\x64\x61ta_in_pdus\x18\t \x01(\x03\x12$\n\x1ctask_management_command_pdus\x18\n \x01(\x03\x12%\n\x1dtask_management_response_pdus\x18\x0b \x01(\x03\x12\x15\n\rdigest_errors\x18\x0c \x01(\x03\x12\x16\n\x0etimeout_errors\x18\r \x01(\x03\x12\x13\n\x0b\x61\x62ort_count\x18\x0e \x01(\x03\"\xac\x01\n)EngineVolumeFrontendStatsGetProxyResponse\x12\x10\n\x08\x66rontend\x18\x01 \x01(\t\x12\x10\n\x08\x65ndpoint\x18\x02 \x01(\t\x12\x13\n\x0btarget_name\x18\x03 \x01(\t\x12\x1a\n\x12target_connections\x18\x04 \x01(\x05\x12*\n\x08sessions\x18\x05 \x03(\x0b\x32\x18.imrpc.ISCSISessionStats\"\xbb\x02\n\x0b\x41uditRecord\x12\x11\n\ttimestamp\x18\x01 \x01(\t\x12\x11\n\toperation\x18\x02 \x01(\t\x12\x13\n\x0bvolume_name\x18\x03 \x01(\t\x12\x13\n\x0b\x65ngine_name\x18\x04 \x01(\t\x12\x16\n\x0e\x65ngine_address\x18\x05 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x06 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x10\n\x08identity\x18\x07 \x01(\t\x12\x15\n\rauthenticated\x18\x08 \x01(\x08\x12\x30\n\x07\x64\x65tails\x18\t \x03(\x0b\x32\x1f.imrpc.AuditRecord.DetailsEntry\x12\x11\n\terror_msg\x18\n \x01(\t\x1a.\n\x0c\x44\x65tailsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"-\n\x16\x41uditRecordListRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\">\n\x17\x41uditRecordListResponse\x12#\n\x07records\x18\x01 \x03(\x0b\x32\x12.imrpc.AuditRecord\"S\n\x11\x42\x61\x63kupTargetLimit\x12\x1e\n\x16max_concurrent_backups\x18\x01 \x01(\x05\x12\x1e\n\x16max_backups_per_minute\x18\x02 \x01(\x05\"X\n\x1b\x42\x61\x63kupTargetLimitSetRequest\x12\x10\n\x08\x65ndpoint\x18\x01 \x01(\t\x12\'\n\x05limit\x18\x02 \x01(\x0b\x32\x18.imrpc.BackupTargetLimit\"3\n\x1f\x42\x61\x63kupSchedulerStatusGetRequest\x12\x10\n\x08\x65ndpoint\x18\x01 \x01(\t\"J\n\x0fScheduledBackup\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\x12\x13\n\x0bvolume_name\x18\x02 \x01(\t\x12\r\n\x05since\x18\x03 \x01(\t\"\xb8\x01\n\x1b\x42\x61\x63kupTargetSchedulerStatus\x12\x10\n\x08\x65ndpoint\x18\x01 \x01(\t\x12\'\n\x05limit\x18\x02 \x01(\x0b\x32\x18.imrpc.BackupTargetLimit\x12.\n\x0e\x61\x63tive_backups\x18\x03 \x03(\x0b\x32\x16.imrpc.ScheduledBackup\x12.\n\x0equeued_backups\x18\x04 \x03(\x0b\x32\x16.imrpc.ScheduledBackup\"W\n BackupSchedulerStatusGetResponse\x12\x33\n\x07targets\x18\x01 \x03(\x0b\x32\".imrpc.BackupTargetSchedulerStatus\"Z\n\x17SnapshotRetentionPolicy\x12\x11\n\tmax_count\x18\x01 \x01(\x05\x12\x17\n\x0fmax_age_seconds\x18\x02 \x01(\x03\x12\x13\n\x0bname_prefix\x18\x03 \x01(\t\"\x98\x01\n-EngineVolumeSnapshotRetentionPolicySetRequest\x12\x37\n\x14proxy_engine_request\x18\x01 \x01(\x0b\x32\x19.imrpc.ProxyEngineRequest\x12.\n\x06policy\x18\x02 \x01(\x0b\x32\x1e.imrpc.SnapshotRetentionPolicy\"\xb9\x01\n\x16SnapshotRetentionEvent\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\x12\x13\n\x0b\x65ngine_name\x18\x02 \x01(\t\x12&\n\x0b\x64\x61ta_engine\x18\x03 \x01(\x0e\x32\x11.imrpc.DataEngine\x12\x15\n\rsnapshot_name\x18\x04 \x01(\t\x12\x0e\n\x06reason\x18\x05 \x01(\t\x12\x11\n\terror_msg\x18\x06 \x01(\t\x12\x13\n\x0bobserved_at\x18\x07 \x01(\x03\x32\xc7\x1a\n\x12ProxyEngineService\x12P\n\x10ServerVersionGet\x12\x19.imrpc.ProxyEngineRequest\x1a!.imrpc.EngineVersionProxyResponse\x12K\n\tVolumeGet\x12\x19.imrpc.ProxyEngineRequest\x1a#.imrpc.EngineVolumeGetProxyResponse\x12H\n\x0cVolumeExpand\x12 .imrpc.EngineVolumeExpandRequest\x1a\x16.google.protobuf.Empty\x12V\n\x13VolumeFrontendStart\x12\'.imrpc.EngineVolumeFrontendStartRequest\x1a\x16.google.protobuf.Empty\x12K\n\x16VolumeFrontendShutdown\x12\x19.imrpc.ProxyEngineRequest\x1a\x16.google.protobuf.Empty\x12t\n\"VolumeUnmapMarkSnapChainRemovedSet\x12\x36.imrpc.EngineVolumeUnmapMarkSnapChainRemovedSetRequest\x1a\x16.google.protobuf.Empty\x12\x62\n\x19VolumeSnapshotMaxCountSet\x12-.imrpc.EngineVolumeSnapshotMaxCountSetRequest\x1a\x16.google.protobuf.Empty\x12`\n\x18VolumeSnapshotMaxSizeSet\x12,.imrpc.EngineVolumeSnapshotMaxSizeSetRequest\x1a\x16.google.protobuf.Empty\x12p\n VolumeSnapshotRetentionPolicySet\x12\x34.imrpc.EngineVolumeSnapshotRetentionPolicySetRequest\x1a\x16.google.protobuf.Empty\x12^\n\x0eVolumeSnapshot\x12\".imrpc.EngineVolumeSnapshotRequest\x1a(.imrpc.EngineVolumeSnapshotProxyResponse\x12Q\n\x0cSnapshotList\x12\x19.imrpc.ProxyEngineRequest\x1a&.imrpc.EngineSnapshotListProxyResponse\x12L\n\x0eSnapshotRevert\x12\".imrpc.EngineSnapshotRevertRequest\x1a\x16.google.protobuf.Empty\x12J\n\rSnapshotPurge\x12!.imrpc.EngineSnapshotPurgeRequest\x1a\x16.google.protobuf.Empty\x12_\n\x13SnapshotPurgeStatus\x12\x19.imrpc.ProxyEngineRequest\x1a-.imrpc.EngineSnapshotPurgeStatusProxyResponse\x12J\n\rSnapshotClone\x12!.imrpc.EngineSnapshotCloneRequest\x1a\x16.google.protobuf.Empty\x12_\n\x13SnapshotCloneStatus\x12\x19.imrpc.ProxyEngineRequest\x1a-.imrpc.EngineSnapshotCloneStatusProxyResponse\x12L\n\x0eSnapshotRemove\x12\".imrpc.EngineSnapshotRemoveRequest\x1a\x16.google.protobuf.Empty\x12H\n\x0cSnapshotHash\x12 .imrpc.EngineSnapshotHashRequest\x1a\x16.google.protobuf.Empty\x12j\n\x12SnapshotHashStatus\x12&.imrpc.EngineSnapshotHashStatusRequest\x1a,.imrpc.EngineSnapshotHashStatusProxyResponse\x12V\n\x1bSnapshotRetentionEventWatch\x12\x16.google.protobuf.Empty\x1a\x1d.imrpc.SnapshotRetentionEvent0\x01\x12^\n\x0eSnapshotBackup\x12\".imrpc.EngineSnapshotBackupRequest\x1a(.imrpc.EngineSnapshotBackupProxyResponse\x12p\n\x14SnapshotBackupStatus\x12(.imrpc.EngineSnapshotBackupStatusRequest\x1a..imrpc.EngineSnapshotBackupStatusProxyResponse\x12[\n\rBackupRestore\x12!.imrpc.EngineBackupRestoreRequest\x1a\'.imrpc.EngineBackupRestoreProxyResponse\x12_\n\x13\x42\x61\x63kupRestoreStatus\x12\x19.imrpc.ProxyEngineRequest\x1a-.imrpc.EngineBackupRestoreStatusProxyResponse\x12V\n\x13\x42\x61\x63kupRestoreFinish\x12\'.imrpc.EngineBackupRestoreFinishRequest\x1a\x16.google.protobuf.Empty\x12J\n\x18\x43leanupBackupMountPoints\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12k\n\x18\x42\x61\x63kupSchedulerStatusGet\x12&.imrpc.BackupSchedulerStatusGetRequest\x1a\'.imrpc.BackupSchedulerStatusGetResponse\x12R\n\x14\x42\x61\x63kupTargetLimitSet\x12\".imrpc.BackupTargetLimitSetRequest\x1a\x16.google.protobuf.Empty\x12\x44\n\nReplicaAdd\x12\x1e.imrpc.EngineReplicaAddRequest\x1a\x16.google.protobuf.Empty\x12O\n\x0bReplicaList\x12\x19.imrpc.ProxyEngineRequest\x1a%.imrpc.EngineReplicaListProxyResponse\x12\x64\n\x17ReplicaRebuildingStatus\x12\x19.imrpc.ProxyEngineRequest\x1a..imrpc.EngineReplicaRebuildStatusProxyResponse\x12X\n\x14ReplicaVerifyRebuild\x12(.imrpc.EngineReplicaVerifyRebuildRequest\x1a\x16.google.protobuf.Empty\x12J\n\rReplicaRemove\x12!.imrpc.EngineReplicaRemoveRequest\x1a\x16.google.protobuf.Empty\x12R\n\x11ReplicaModeUpdate\x12%.imrpc.EngineReplicaModeUpdateRequest\x1a\x16.google.protobuf.Empty\x12J\n\x10ReplicaModeWatch\x12\x16.google.protobuf.Empty\x1a\x1c.imrpc.ReplicaModeTransition0\x01\x12M\n\nMetricsGet\x12\x19.imrpc.ProxyEngineRequest\x1a$.imrpc.EngineMetricsGetProxyResponse\x12\x65\n\x16VolumeFrontendStatsGet\x12\x19.imrpc.ProxyEngineRequest\x1a\x30.imrpc.EngineVolumeFrontendStatsGetProxyResponse\x12P\n\x0f\x41uditRecordList\x12\x1d.imrpc.AuditRecordListRequest\x1a\x1e.imrpc.AuditRecordListResponseB9Z7github.com/longhorn/longhorn-instance-manager/pkg/imrpcb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -46,6 +46,8 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE_STATUSENTRY._serialized_options = b'8\001'
   _ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE_STATUSENTRY._options = None
   _ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE_STATUSENTRY._serialized_options = b'8\001'
+  _AUDITRECORD_DETAILSENTRY._options = None
+  _AUDITRECORD_DETAILSENTRY._serialized_options = b'8\001'
   _globals['_PROXYENGINEREQUEST']._serialized_start=311
   _globals['_PROXYENGINEREQUEST']._serialized_end=491
   _globals['_ENGINEVERSIONPROXYRESPONSE']._serialized_start=493
@@ -77,67 +79,99 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['_ENGINESNAPSHOTDISKINFO_LABELSENTRY']._serialized_start=2115
   _globals['_ENGINESNAPSHOTDISKINFO_LABELSENTRY']._serialized_end=2160
   _globals['_ENGINESNAPSHOTREVERTREQUEST']._serialized_start=2162
-  _globals['_ENGINESNAPSHOTREVERTREQUEST']._serialized_end=2262
-  _globals['_ENGINESNAPSHOTPURGEREQUEST']._serialized_start=2264
-  _globals['_ENGINESNAPSHOTPURGEREQUEST']._serialized_end=2378
-  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE']._serialized_start=2381
-  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE']._serialized_end=2580
-  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=2498
-  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=2580
-  _globals['_ENGINESNAPSHOTCLONEREQUEST']._serialized_start=2583
-  _globals['_ENGINESNAPSHOTCLONEREQUEST']._serialized_end=2850
-  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE']._serialized_start=2853
-  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE']._serialized_end=3052
-  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=2970
-  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=3052
-  _globals['_ENGINESNAPSHOTREMOVEREQUEST']._serialized_start=3054
-  _globals['_ENGINESNAPSHOTREMOVEREQUEST']._serialized_end=3155
-  _globals['_ENGINESNAPSHOTBACKUPREQUEST']._serialized_start=3158
-  _globals['_ENGINESNAPSHOTBACKUPREQUEST']._serialized_end=3578
+  _globals['_ENGINESNAPSHOTREVERTREQUEST']._serialized_end=2289
+  _globals['_ENGINESNAPSHOTPURGEREQUEST']._serialized_start=2291
+  _globals['_ENGINESNAPSHOTPURGEREQUEST']._serialized_end=2405
+  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE']._serialized_start=2408
+  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE']._serialized_end=2607
+  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=2525
+  _globals['_ENGINESNAPSHOTPURGESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=2607
+  _globals['_ENGINESNAPSHOTCLONEREQUEST']._serialized_start=2610
+  _globals['_ENGINESNAPSHOTCLONEREQUEST']._serialized_end=2877
+  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE']._serialized_start=2880
+  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE']._serialized_end=3079
+  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=2997
+  _globals['_ENGINESNAPSHOTCLONESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=3079
+  _globals['_ENGINESNAPSHOTREMOVEREQUEST']._serialized_start=3081
+  _globals['_ENGINESNAPSHOTREMOVEREQUEST']._serialized_end=3182
+  _globals['_ENGINESNAPSHOTBACKUPREQUEST']._serialized_start=3185
+  _globals['_ENGINESNAPSHOTBACKUPREQUEST']._serialized_end=3605
   _globals['_ENGINESNAPSHOTBACKUPREQUEST_LABELSENTRY']._serialized_start=2115
   _globals['_ENGINESNAPSHOTBACKUPREQUEST_LABELSENTRY']._serialized_end=2160
-  _globals['_ENGINESNAPSHOTBACKUPPROXYRESPONSE']._serialized_start=3580
-  _globals['_ENGINESNAPSHOTBACKUPPROXYRESPONSE']._serialized_end=3675
-  _globals['_ENGINESNAPSHOTBACKUPSTATUSREQUEST']._serialized_start=3678
-  _globals['_ENGINESNAPSHOTBACKUPSTATUSREQUEST']._serialized_end=3838
-  _globals['_ENGINESNAPSHOTBACKUPSTATUSPROXYRESPONSE']._serialized_start=3841
-  _globals['_ENGINESNAPSHOTBACKUPSTATUSPROXYRESPONSE']._serialized_end=3998
-  _globals['_ENGINEBACKUPRESTOREREQUEST']._serialized_start=4001
-  _globals['_ENGINEBACKUPRESTOREREQUEST']._serialized_end=4176
-  _globals['_ENGINEBACKUPRESTOREPROXYRESPONSE']._serialized_start=4178
-  _globals['_ENGINEBACKUPRESTOREPROXYRESPONSE']._serialized_end=4231
-  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE']._serialized_start=4234
-  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE']._serialized_end=4430
-  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=4351
-  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=4430
-  _globals['_ENGINEBACKUPRESTORESTATUS']._serialized_start=4433
-  _globals['_ENGINEBACKUPRESTORESTATUS']._serialized_end=4625
-  _globals['_ENGINEBACKUPRESTOREFINISHREQUEST']._serialized_start=4627
-  _globals['_ENGINEBACKUPRESTOREFINISHREQUEST']._serialized_end=4718
-  _globals['_ENGINEREPLICAADDREQUEST']._serialized_start=4721
-  _globals['_ENGINEREPLICAADDREQUEST']._serialized_end=4961
-  _globals['_ENGINEREPLICALISTPROXYRESPONSE']._serialized_start=4963
-  _globals['_ENGINEREPLICALISTPROXYRESPONSE']._serialized_end=5043
-  _globals['_ENGINEREPLICAVERIFYREBUILDREQUEST']._serialized_start=5046
-  _globals['_ENGINEREPLICAVERIFYREBUILDREQUEST']._serialized_end=5185
-  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE']._serialized_start=5188
-  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE']._serialized_end=5390
-  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=5307
-  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=5390
-  _globals['_ENGINEREPLICAREMOVEREQUEST']._serialized_start=5393
-  _globals['_ENGINEREPLICAREMOVEREQUEST']._serialized_end=5525
-  _globals['_ENGINEREPLICAMODEUPDATEREQUEST']._serialized_start=5528
-  _globals['_ENGINEREPLICAMODEUPDATEREQUEST']._serialized_end=5677
-  _globals['_ENGINESNAPSHOTHASHREQUEST']._serialized_start=5679
-  _globals['_ENGINESNAPSHOTHASHREQUEST']._serialized_end=5802
-  _globals['_ENGINESNAPSHOTHASHSTATUSREQUEST']._serialized_start=5804
-  _globals['_ENGINESNAPSHOTHASHSTATUSREQUEST']._serialized_end=5917
-  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE']._serialized_start=5920
-  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE']._serialized_end=6116
-  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=6035
-  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=6116
-  _globals['_ENGINEMETRICSGETPROXYRESPONSE']._serialized_start=6118
-  _globals['_ENGINEMETRICSGETPROXYRESPONSE']._serialized_end=6183
-  _globals['_PROXYENGINESERVICE']._serialized_start=6186
-  _globals['_PROXYENGINESERVICE']._serialized_end=8929
+  _globals['_ENGINESNAPSHOTBACKUPPROXYRESPONSE']._serialized_start=3608
+  _globals['_ENGINESNAPSHOTBACKUPPROXYRESPONSE']._serialized_end=3743
+  _globals['_ENGINESNAPSHOTBACKUPSTATUSREQUEST']._serialized_start=3746
+  _globals['_ENGINESNAPSHOTBACKUPSTATUSREQUEST']._serialized_end=3906
+  _globals['_ENGINESNAPSHOTBACKUPSTATUSPROXYRESPONSE']._serialized_start=3909
+  _globals['_ENGINESNAPSHOTBACKUPSTATUSPROXYRESPONSE']._serialized_end=4066
+  _globals['_ENGINEBACKUPRESTOREREQUEST']._serialized_start=4069
+  _globals['_ENGINEBACKUPRESTOREREQUEST']._serialized_end=4244
+  _globals['_ENGINEBACKUPRESTOREPROXYRESPONSE']._serialized_start=4246
+  _globals['_ENGINEBACKUPRESTOREPROXYRESPONSE']._serialized_end=4299
+  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE']._serialized_start=4302
+  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE']._serialized_end=4498
+  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=4419
+  _globals['_ENGINEBACKUPRESTORESTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=4498
+  _globals['_ENGINEBACKUPRESTORESTATUS']._serialized_start=4501
+  _globals['_ENGINEBACKUPRESTORESTATUS']._serialized_end=4693
+  _globals['_ENGINEBACKUPRESTOREFINISHREQUEST']._serialized_start=4695
+  _globals['_ENGINEBACKUPRESTOREFINISHREQUEST']._serialized_end=4786
+  _globals['_ENGINEREPLICAADDREQUEST']._serialized_start=4789
+  _globals['_ENGINEREPLICAADDREQUEST']._serialized_end=5029
+  _globals['_ENGINEREPLICALISTPROXYRESPONSE']._serialized_start=5031
+  _globals['_ENGINEREPLICALISTPROXYRESPONSE']._serialized_end=5111
+  _globals['_ENGINEREPLICAVERIFYREBUILDREQUEST']._serialized_start=5114
+  _globals['_ENGINEREPLICAVERIFYREBUILDREQUEST']._serialized_end=5253
+  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE']._serialized_start=5256
+  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE']._serialized_end=5458
+  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=5375
+  _globals['_ENGINEREPLICAREBUILDSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=5458
+  _globals['_ENGINEREPLICAREMOVEREQUEST']._serialized_start=5461
+  _globals['_ENGINEREPLICAREMOVEREQUEST']._serialized_end=5593
+  _globals['_ENGINEREPLICAMODEUPDATEREQUEST']._serialized_start=5596
+  _globals['_ENGINEREPLICAMODEUPDATEREQUEST']._serialized_end=5745
+  _globals['_REPLICAMODETRANSITION']._serialized_start=5748
+  _globals['_REPLICAMODETRANSITION']._serialized_end=5935
+  _globals['_ENGINESNAPSHOTHASHREQUEST']._serialized_start=5937
+  _globals['_ENGINESNAPSHOTHASHREQUEST']._serialized_end=6060
+  _globals['_ENGINESNAPSHOTHASHSTATUSREQUEST']._serialized_start=6062
+  _globals['_ENGINESNAPSHOTHASHSTATUSREQUEST']._serialized_end=6175
+  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE']._serialized_start=6178
+  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE']._serialized_end=6374
+  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_start=6293
+  _globals['_ENGINESNAPSHOTHASHSTATUSPROXYRESPONSE_STATUSENTRY']._serialized_end=6374
+  _globals['_ENGINEMETRICSGETPROXYRESPONSE']._serialized_start=6376
+  _globals['_ENGINEMETRICSGETPROXYRESPONSE']._serialized_end=6441
+  _globals['_ISCSISESSIONSTATS']._serialized_start=6444
+  _globals['_ISCSISESSIONSTATS']._serialized_end=6813
+  _globals['_ENGINEVOLUMEFRONTENDSTATSGETPROXYRESPONSE']._serialized_start=6816
+  _globals['_ENGINEVOLUMEFRONTENDSTATSGETPROXYRESPONSE']._serialized_end=6988
+  _globals['_AUDITRECORD']._serialized_start=6991
+  _globals['_AUDITRECORD']._serialized_end=7306
+  _globals['_AUDITRECORD_DETAILSENTRY']._serialized_start=7260
+  _globals['_AUDITRECORD_DETAILSENTRY']._serialized_end=7306
+  _globals['_AUDITRECORDLISTREQUEST']._serialized_start=7308
+  _globals['_AUDITRECORDLISTREQUEST']._serialized_end=7353
+  _globals['_AUDITRECORDLISTRESPONSE']._serialized_start=7355
+  _globals['_AUDITRECORDLISTRESPONSE']._serialized_end=7417
+  _globals['_BACKUPTARGETLIMIT']._serialized_start=7419
+  _globals['_BACKUPTARGETLIMIT']._serialized_end=7502
+  _globals['_BACKUPTARGETLIMITSETREQUEST']._serialized_start=7504
+  _globals['_BACKUPTARGETLIMITSETREQUEST']._serialized_end=7592
+  _globals['_BACKUPSCHEDULERSTATUSGETREQUEST']._serialized_start=7594
+  _globals['_BACKUPSCHEDULERSTATUSGETREQUEST']._serialized_end=7645
+  _globals['_SCHEDULEDBACKUP']._serialized_start=7647
+  _globals['_SCHEDULEDBACKUP']._serialized_end=7721
+  _globals['_BACKUPTARGETSCHEDULERSTATUS']._serialized_start=7724
+  _globals['_BACKUPTARGETSCHEDULERSTATUS']._serialized_end=7908
+  _globals['_BACKUPSCHEDULERSTATUSGETRESPONSE']._serialized_start=7910
+  _globals['_BACKUPSCHEDULERSTATUSGETRESPONSE']._serialized_end=7997
+  _globals['_SNAPSHOTRETENTIONPOLICY']._serialized_start=7999
+  _globals['_SNAPSHOTRETENTIONPOLICY']._serialized_end=8089
+  _globals['_ENGINEVOLUMESNAPSHOTRETENTIONPOLICYSETREQUEST']._serialized_start=8092
+  _globals['_ENGINEVOLUMESNAPSHOTRETENTIONPOLICYSETREQUEST']._serialized_end=8244
+  _globals['_SNAPSHOTRETENTIONEVENT']._serialized_start=8247
+  _globals['_SNAPSHOTRETENTIONEVENT']._serialized_end=8432
+  _globals['_PROXYENGINESERVICE']._serialized_start=8435
+  _globals['_PROXYENGINESERVICE']._serialized_end=11834
 # @@protoc_insertion_point(module_scope)
@@ -0,0 +1,175 @@
+import grpc
+
+from github.com.longhorn.longhorn_instance_manager.pkg.imrpc import proxy_pb2 # NOQA
+from github.com.longhorn.longhorn_instance_manager.pkg.imrpc import proxy_pb2_grpc # NOQA
+from github.com.longhorn.longhorn_engine.proto.ptypes import controller_pb2 # NOQA
+
+from google.protobuf import empty_pb2
+
+
+# The proxy API version this client is written against, which is
+# InstanceManagerProxyAPIVersion of pkg/meta. The client works with an
+# instance manager supporting this version, i.e. whose proxy API version is
+# not older than it and whose proxy API min version is not newer than it.
+PROXY_API_VERSION = 5
+
+
+class ProxyAPIVersionError(Exception):
+    pass
+
+
+def check_proxy_api_version(version):
+    """Checks the VersionGet response of the process manager or instance
+    service of an instance manager against PROXY_API_VERSION."""
+    if version.instanceManagerProxyAPIVersion < PROXY_API_VERSION or \
+            version.instanceManagerProxyAPIMinVersion > PROXY_API_VERSION:
+        raise ProxyAPIVersionError(
+            "proxy API version %d is not supported by the instance "
+            "manager, which supports versions from %d to %d" % (
+                PROXY_API_VERSION,
+                version.instanceManagerProxyAPIMinVersion,
+                version.instanceManagerProxyAPIVersion))
+
+
+class ProxyClient(object):
+    def __init__(self, url):
+        self.address = url
+        self.channel = grpc.insecure_channel(url)
+        self.stub = proxy_pb2_grpc.ProxyEngineServiceStub(self.channel)
+
+    def _request(self, engine_address, engine_name, volume_name,
+                 data_engine):
+        return proxy_pb2.ProxyEngineRequest(
+            address=engine_address,
+            engine_name=engine_name,
+            volume_name=volume_name,
+            data_engine=data_engine)
+
+    def server_version_get(self, engine_address):
+        return self.stub.ServerVersionGet(proxy_pb2.ProxyEngineRequest(
+            address=engine_address)).version
+
+    def volume_get(self, engine_address, engine_name, volume_name,
+                   data_engine=0):
+        return self.stub.VolumeGet(self._request(
+            engine_address, engine_name, volume_name, data_engine)).volume
+
+    def volume_snapshot(self, engine_address, engine_name, volume_name,
+                        snapshot_name, labels={}, data_engine=0):
+        return self.stub.VolumeSnapshot(proxy_pb2.EngineVolumeSnapshotRequest(
+            proxy_engine_request=self._request(
+                engine_address, engine_name, volume_name, data_engine),
+            snapshot_volume=controller_pb2.VolumeSnapshotRequest(
+                name=snapshot_name,
+                labels=labels))).snapshot.name
+
+    def snapshot_list(self, engine_address, engine_name, volume_name,
+                      data_engine=0):
+        return self.stub.SnapshotList(self._request(
+            engine_address, engine_name, volume_name, data_engine)).disks
+
+    def snapshot_remove(self, engine_address, engine_name, volume_name,
+                        names, data_engine=0):
+        return self.stub.SnapshotRemove(proxy_pb2.EngineSnapshotRemoveRequest(
+            proxy_engine_request=self._request(
+                engine_address, engine_name, volume_name, data_engine),
+            names=names))
+
+    def snapshot_purge(self, engine_address, engine_name, volume_name,
+                       skip_if_in_progress=False, data_engine=0):
+        return self.stub.SnapshotPurge(proxy_pb2.EngineSnapshotPurgeRequest(
+            proxy_engine_request=self._request(
+                engine_address, engine_name, volume_name, data_engine),
+            skip_if_in_progress=skip_if_in_progress))
+
+    def snapshot_purge_status(self, engine_address, engine_name, volume_name,
+                              data_engine=0):
+        return self.stub.SnapshotPurgeStatus(self._request(
+            engine_address, engine_name, volume_name, data_engine)).status
+
+    def snapshot_backup(self, engine_address, engine_name, volume_name,
+                        backup_name, snapshot_name, backup_target,
+                        envs=[], labels={}, backing_image_name="",
+                        backing_image_checksum="", compression_method="",
+                        concurrent_limit=0, storage_class_name="",
+                        data_engine=0):
+        return self.stub.SnapshotBackup(proxy_pb2.EngineSnapshotBackupRequest(
+            proxy_engine_request=self._request(
+                engine_address, engine_name, volume_name, data_engine),
+            envs=envs,
+            backup_name=backup_name,
+            snapshot_name=snapshot_name,
+            backup_target=backup_target,
+            backing_image_name=backing_image_name,
+            backing_image_checksum=backing_image_checksum,
+            labels=labels,
+            compression_method=compression_method,
+            concurrent_limit=concurrent_limit,
+            storage_class_name=storage_class_name))
+
+    def snapshot_backup_status(self, engine_address, engine_name,
+                               volume_name, backup_name,
+                               replica_address="", replica_name="",
+                               data_engine=0):
+        return self.stub.SnapshotBackupStatus(
+            proxy_pb2.EngineSnapshotBackupStatusRequest(
+                proxy_engine_request=self._request(
+                    engine_address, engine_name, volume_name, data_engine),
+                backup_name=backup_name,
+                replica_address=replica_address,
+                replica_name=replica_name))
+
+    def backup_restore(self, engine_address, engine_name, volume_name,
+                       url, target, envs=[], concurrent_limit=0,
+                       data_engine=0):
+        return self.stub.BackupRestore(proxy_pb2.EngineBackupRestoreRequest(
+            proxy_engine_request=self._request(
+                engine_address, engine_name, volume_name, data_engine),
+            envs=envs,
+            url=url,
+            target=target,
+            volume_name=volume_name,
+            concurrent_limit=concurrent_limit))
+
+    def backup_restore_status(self, engine_address, engine_name, volume_name,
+                              data_engine=0):
+        return self.stub.BackupRestoreStatus(self._request(
+            engine_address, engine_name, volume_name, data_engine)).status
+
+    def backup_restore_finish(self, engine_address, engine_name, volume_name,
+                              data_engine=0):
+        return self.stub.BackupRestoreFinish(
+            proxy_pb2.EngineBackupRestoreFinishRequest(
+                proxy_engine_request=self._request(
+                    engine_address, engine_name, volume_name, data_engine)))
+
+    def backup_scheduler_status_get(self, endpoint=""):
+        return self.stub.BackupSchedulerStatusGet(
+            proxy_pb2.BackupSchedulerStatusGetRequest(endpoint=endpoint))
+
+    def backup_target_limit_set(self, endpoint, max_concurrent_backups=0,
+                                max_backups_per_minute=0):
+        return self.stub.BackupTargetLimitSet(
+            proxy_pb2.BackupTargetLimitSetRequest(
+                endpoint=endpoint,
+                limit=proxy_pb2.BackupTargetLimit(
+                    max_concurrent_backups=max_concurrent_backups,
+                    max_backups_per_minute=max_backups_per_minute)))
+
+    def replica_list(self, engine_address, engine_name, volume_name,
+                     data_engine=0):
+        return self.stub.ReplicaList(self._request(
+            engine_address, engine_name, volume_name,
+            data_engine)).replica_list.replicas
+
+    def replica_mode_watch(self):
+        return self.stub.ReplicaModeWatch(empty_pb2.Empty())
+
+    def metrics_get(self, engine_address, engine_name, volume_name,
+                    data_engine=0):
+        return self.stub.MetricsGet(self._request(
+            engine_address, engine_name, volume_name, data_engine)).metrics
+
+    def audit_record_list(self, volume_name=""):
+        return self.stub.AuditRecordList(proxy_pb2.AuditRecordListRequest(
+            volume_name=volume_name)).records
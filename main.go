@@ -64,6 +64,9 @@ func main() {
 		cmd.StartCmd(),
 		cmd.ProcessCmd(),
 		cmd.VersionCmd(),
+		cmd.StateCmd(),
+		cmd.BenchCmd(),
+		cmd.ProcessSpawnCmd(),
 	}
 	if err := a.Run(os.Args); err != nil {
 		logrus.WithError(err).Fatal("Error when executing command")
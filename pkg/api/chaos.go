@@ -0,0 +1,27 @@
+package api
+
+import (
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+type Fault struct {
+	Type      string `json:"type"`
+	Target    string `json:"target"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+func RPCToFault(obj *rpc.Fault) *Fault {
+	return &Fault{
+		Type:      obj.Type.String(),
+		Target:    obj.Target,
+		ExpiresAt: obj.ExpiresAt,
+	}
+}
+
+func RPCToFaultList(obj *rpc.FaultListResponse) []*Fault {
+	ret := []*Fault{}
+	for _, f := range obj.Faults {
+		ret = append(ret, RPCToFault(f))
+	}
+	return ret
+}
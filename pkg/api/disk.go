@@ -1,5 +1,11 @@
 package api
 
+import (
+	"fmt"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
 type DiskInfo struct {
 	ID          string
 	UUID        string
@@ -11,6 +17,42 @@ type DiskInfo struct {
 	FreeBlocks  int64
 	BlockSize   int64
 	ClusterSize int64
+
+	SafeMode        bool
+	SafeModeReasons []string
+
+	// ScrubErrors are the unreadable ranges found by the latest completed scrub of the disk
+	ScrubErrorsDetected bool
+	ScrubErrors         []string
+}
+
+func RPCToDiskInfo(obj *rpc.Disk) *DiskInfo {
+	return &DiskInfo{
+		ID:          obj.GetId(),
+		UUID:        obj.GetUuid(),
+		Path:        obj.GetPath(),
+		Type:        obj.GetType(),
+		TotalSize:   obj.GetTotalSize(),
+		FreeSize:    obj.GetFreeSize(),
+		TotalBlocks: obj.GetTotalBlocks(),
+		FreeBlocks:  obj.GetFreeBlocks(),
+		BlockSize:   obj.GetBlockSize(),
+		ClusterSize: obj.GetClusterSize(),
+
+		SafeMode:        obj.GetSafeMode(),
+		SafeModeReasons: obj.GetSafeModeReasons(),
+
+		ScrubErrorsDetected: obj.GetScrub().GetErrorsDetected(),
+		ScrubErrors:         scrubErrorsToStrings(obj.GetScrub().GetErrors()),
+	}
+}
+
+func scrubErrorsToStrings(errs []*rpc.DiskScrubError) []string {
+	ret := []string{}
+	for _, e := range errs {
+		ret = append(ret, fmt.Sprintf("offset %v length %v: %v", e.Offset, e.Length, e.Message))
+	}
+	return ret
 }
 
 // ReplicaStorageInstance is utilized to represent a replica directory of a legacy volume and
@@ -23,3 +65,93 @@ type ReplicaStorageInstance struct {
 	SpecSize   uint64
 	ActualSize uint64
 }
+
+type DiskWipeProgress struct {
+	DiskName   string `json:"diskName"`
+	DiskPath   string `json:"diskPath"`
+	Mode       string `json:"mode"`
+	State      string `json:"state"`
+	TotalBytes int64  `json:"totalBytes"`
+	WipedBytes int64  `json:"wipedBytes"`
+	Progress   int32  `json:"progress"`
+	ErrorMsg   string `json:"errorMsg"`
+}
+
+func RPCToDiskWipeProgress(obj *rpc.DiskWipeProgress) *DiskWipeProgress {
+	return &DiskWipeProgress{
+		DiskName:   obj.DiskName,
+		DiskPath:   obj.DiskPath,
+		Mode:       obj.Mode.String(),
+		State:      obj.State,
+		TotalBytes: obj.TotalBytes,
+		WipedBytes: obj.WipedBytes,
+		Progress:   obj.Progress,
+		ErrorMsg:   obj.ErrorMsg,
+	}
+}
+
+type DiskWipeStream struct {
+	stream rpc.DiskService_DiskWipeClient
+}
+
+func NewDiskWipeStream(stream rpc.DiskService_DiskWipeClient) *DiskWipeStream {
+	return &DiskWipeStream{
+		stream,
+	}
+}
+
+func (s *DiskWipeStream) Recv() (*DiskWipeProgress, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return RPCToDiskWipeProgress(resp), nil
+}
+
+type DiskWatchEvent struct {
+	EventType      string `json:"eventType"`
+	DiskName       string `json:"diskName"`
+	DiskUUID       string `json:"diskUUID"`
+	DiskPath       string `json:"diskPath"`
+	OldDevice      string `json:"oldDevice"`
+	NewDevice      string `json:"newDevice"`
+	OldIOErrors    uint64 `json:"oldIOErrors"`
+	NewIOErrors    uint64 `json:"newIOErrors"`
+	OldHealthScore int32  `json:"oldHealthScore"`
+	NewHealthScore int32  `json:"newHealthScore"`
+	Timestamp      string `json:"timestamp"`
+}
+
+func RPCToDiskWatchEvent(obj *rpc.DiskWatchEvent) *DiskWatchEvent {
+	return &DiskWatchEvent{
+		EventType:      obj.EventType,
+		DiskName:       obj.DiskName,
+		DiskUUID:       obj.DiskUuid,
+		DiskPath:       obj.DiskPath,
+		OldDevice:      obj.OldDevice,
+		NewDevice:      obj.NewDevice,
+		OldIOErrors:    obj.OldIoErrors,
+		NewIOErrors:    obj.NewIoErrors,
+		OldHealthScore: obj.OldHealthScore,
+		NewHealthScore: obj.NewHealthScore,
+		Timestamp:      obj.Timestamp,
+	}
+}
+
+type DiskWatchStream struct {
+	stream rpc.DiskService_DiskWatchClient
+}
+
+func NewDiskWatchStream(stream rpc.DiskService_DiskWatchClient) *DiskWatchStream {
+	return &DiskWatchStream{
+		stream,
+	}
+}
+
+func (s *DiskWatchStream) Recv() (*DiskWatchEvent, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return RPCToDiskWatchEvent(resp), nil
+}
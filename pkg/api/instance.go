@@ -1,9 +1,14 @@
 package api
 
 import (
+	"time"
+
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
 	"github.com/longhorn/longhorn-spdk-engine/proto/spdkrpc"
 )
 
@@ -15,21 +20,44 @@ var (
 )
 
 type InstanceProcessSpec struct {
-	Binary string   `json:"binary"`
-	Args   []string `json:"args"`
+	Binary   string            `json:"binary"`
+	Args     []string          `json:"args"`
+	Sidecars []*ProcessSidecar `json:"sidecars"`
+	Envs     map[string]string `json:"envs,omitempty"`
+
+	CPUMillicores int64  `json:"cpuMillicores,omitempty"`
+	MemoryBytes   int64  `json:"memoryBytes,omitempty"`
+	CPUSet        string `json:"cpuSet,omitempty"`
+	NUMANode      string `json:"numaNode,omitempty"`
+
+	Privileges *ProcessPrivileges `json:"privileges,omitempty"`
+
+	StopTimeoutSeconds int64 `json:"stopTimeoutSeconds,omitempty"`
 }
 
 type Instance struct {
-	Name           string         `json:"name"`
-	Type           string         `json:"type"`
-	DataEngine     string         `json:"dataEngine"`
-	PortCount      int32          `json:"portCount"`
-	PortArgs       []string       `json:"portArgs"`
-	InstanceStatus InstanceStatus `json:"instanceStatus"`
-	Deleted        bool           `json:"deleted"`
+	Name           string            `json:"name"`
+	Type           string            `json:"type"`
+	DataEngine     string            `json:"dataEngine"`
+	PortCount      int32             `json:"portCount"`
+	PortArgs       []string          `json:"portArgs"`
+	Labels         map[string]string `json:"labels"`
+	InstanceStatus InstanceStatus    `json:"instanceStatus"`
+	Deleted        bool              `json:"deleted"`
 
 	InstanceProccessSpec *InstanceProcessSpec
 
+	// RestartPolicy has the instance recreated by the instance manager once it fails
+	RestartPolicy *InstanceRestartPolicy `json:"restartPolicy,omitempty"`
+	// PriorityClass is critical, high or normal, which the OOM score adjustment and nice value follow
+	PriorityClass string `json:"priorityClass,omitempty"`
+
+	// Operations are the recent operations applied to the instance, only returned by a verbose get
+	Operations []*InstanceOperation `json:"operations"`
+
+	// Topology is the location of the node of the instance manager
+	Topology meta.Topology `json:"topology"`
+
 	// Deprecated: replaced by DataEngine.
 	BackendStoreDriver string `json:"backendStoreDriver"`
 }
@@ -43,19 +71,75 @@ func RPCToInstance(obj *rpc.InstanceResponse) *Instance {
 		DataEngine:         dataEngines[obj.Spec.DataEngine.String()],
 		PortCount:          obj.Spec.PortCount,
 		PortArgs:           obj.Spec.PortArgs,
+		Labels:             obj.Spec.Labels,
+		PriorityClass:      obj.Spec.PriorityClass,
 		InstanceStatus:     RPCToInstanceStatus(obj.Status),
+		Operations:         RPCToInstanceOperations(obj.Operations),
+		Topology:           RPCToTopology(obj.Topology),
 	}
 
 	if obj.Spec.ProcessInstanceSpec != nil {
 		instance.InstanceProccessSpec = &InstanceProcessSpec{
-			Binary: obj.Spec.ProcessInstanceSpec.Binary,
-			Args:   obj.Spec.ProcessInstanceSpec.Args,
+			Binary:   obj.Spec.ProcessInstanceSpec.Binary,
+			Args:     obj.Spec.ProcessInstanceSpec.Args,
+			Sidecars: RPCToProcessSidecars(obj.Spec.ProcessInstanceSpec.Sidecars),
+			Envs:     obj.Spec.ProcessInstanceSpec.Envs,
+
+			CPUMillicores: obj.Spec.ProcessInstanceSpec.CpuMillicores,
+			MemoryBytes:   obj.Spec.ProcessInstanceSpec.MemoryBytes,
+			CPUSet:        obj.Spec.ProcessInstanceSpec.CpuSet,
+			NUMANode:      obj.Spec.ProcessInstanceSpec.NumaNode,
+			Privileges:    RPCToProcessPrivileges(obj.Spec.ProcessInstanceSpec.Privileges),
+
+			StopTimeoutSeconds: obj.Spec.ProcessInstanceSpec.StopTimeoutSeconds,
 		}
 	}
 
+	instance.RestartPolicy = RPCToInstanceRestartPolicy(obj.Spec.RestartPolicy)
+
 	return instance
 }
 
+type InstanceRestartPolicy struct {
+	Policy     string `json:"policy"`
+	MaxRetries int32  `json:"maxRetries"`
+	BackoffMs  int64  `json:"backoffMs"`
+}
+
+func RPCToInstanceRestartPolicy(obj *rpc.InstanceRestartPolicy) *InstanceRestartPolicy {
+	if obj == nil {
+		return nil
+	}
+	return &InstanceRestartPolicy{
+		Policy:     obj.Policy,
+		MaxRetries: obj.MaxRetries,
+		BackoffMs:  obj.BackoffMs,
+	}
+}
+
+func RPCToTopology(obj *rpc.NodeTopology) meta.Topology {
+	ret := meta.Topology{
+		Labels: map[string]string{},
+	}
+	if obj == nil {
+		return ret
+	}
+	ret.Zone = obj.Zone
+	ret.Rack = obj.Rack
+	for k, v := range obj.Labels {
+		ret.Labels[k] = v
+	}
+	return ret
+}
+
+func TopologyToRPC(t meta.Topology) *rpc.NodeTopology {
+	return &rpc.NodeTopology{
+		Zone:   t.Zone,
+		Rack:   t.Rack,
+		Labels: t.Labels,
+	}
+}
+
 func RPCToInstanceList(obj *rpc.InstanceListResponse) map[string]*Instance {
 	ret := map[string]*Instance{}
 	for name, p := range obj.Instances {
@@ -64,21 +148,528 @@ func RPCToInstanceList(obj *rpc.InstanceListResponse) map[string]*Instance {
 	return ret
 }
 
+// InstanceListDelta is the instances changed since a resource version, or all the instances if not Delta.
+type InstanceListDelta struct {
+	Instances       map[string]*Instance `json:"instances"`
+	DeletedNames    []string             `json:"deletedNames"`
+	ResourceVersion string               `json:"resourceVersion"`
+	Delta           bool                 `json:"delta"`
+}
+
+func RPCToInstanceListDelta(obj *rpc.InstanceListResponse) *InstanceListDelta {
+	return &InstanceListDelta{
+		Instances:       RPCToInstanceList(obj),
+		DeletedNames:    obj.DeletedNames,
+		ResourceVersion: obj.ResourceVersion,
+		Delta:           obj.Delta,
+	}
+}
+
+type InstanceOperation struct {
+	Operation string `json:"operation"`
+	Timestamp string `json:"timestamp"`
+	Details   string `json:"details"`
+	ErrorMsg  string `json:"errorMsg"`
+}
+
+func RPCToInstanceOperations(obj []*rpc.InstanceOperation) []*InstanceOperation {
+	operations := []*InstanceOperation{}
+	for _, o := range obj {
+		operations = append(operations, &InstanceOperation{
+			Operation: o.Operation,
+			Timestamp: o.Timestamp,
+			Details:   o.Details,
+			ErrorMsg:  o.ErrorMsg,
+		})
+	}
+	return operations
+}
+
 type InstanceStatus struct {
 	State      string          `json:"state"`
 	ErrorMsg   string          `json:"errorMsg"`
 	Conditions map[string]bool `json:"conditions"`
 	PortStart  int32           `json:"portStart"`
 	PortEnd    int32           `json:"portEnd"`
+
+	Sidecars []*ProcessSidecarStatus `json:"sidecars"`
+
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+
+	// ReadPath is the read path of a v2 engine
+	ReadPath *InstanceReadPath `json:"readPath,omitempty"`
+
+	// RestartCount is the times the instance was recreated by its restart policy
+	RestartCount int32 `json:"restartCount"`
+
+	// Ports is what each allocated port serves
+	Ports []*InstancePort `json:"ports,omitempty"`
+
+	// UUID tells apart the instances recreated by the same name
+	UUID string `json:"uuid"`
+
+	// NvmeIdentity is the NVMe identifiers of the NVMe-oF target of a v2 engine
+	NvmeIdentity *InstanceNvmeIdentity `json:"nvmeIdentity,omitempty"`
+}
+
+type InstanceNvmeIdentity struct {
+	SubsystemNQN     string `json:"subsystemNQN"`
+	ControllerSerial string `json:"controllerSerial"`
+	ControllerModel  string `json:"controllerModel"`
+	NamespaceID      uint32 `json:"namespaceID"`
+	NamespaceUUID    string `json:"namespaceUUID"`
+	NamespaceNGUID   string `json:"namespaceNGUID"`
+}
+
+func RPCToInstanceNvmeIdentity(obj *rpc.InstanceNvmeIdentity) *InstanceNvmeIdentity {
+	if obj == nil {
+		return nil
+	}
+	return &InstanceNvmeIdentity{
+		SubsystemNQN:     obj.SubsystemNqn,
+		ControllerSerial: obj.ControllerSerial,
+		ControllerModel:  obj.ControllerModel,
+		NamespaceID:      obj.NamespaceId,
+		NamespaceUUID:    obj.NamespaceUuid,
+		NamespaceNGUID:   obj.NamespaceNguid,
+	}
+}
+
+type InstancePort struct {
+	PortStart int32  `json:"portStart"`
+	PortEnd   int32  `json:"portEnd"`
+	Protocol  string `json:"protocol"`
+	Purpose   string `json:"purpose"`
+	Address   string `json:"address,omitempty"`
+}
+
+func RPCToInstancePorts(list []*rpc.InstancePort) []*InstancePort {
+	if len(list) == 0 {
+		return nil
+	}
+	ret := []*InstancePort{}
+	for _, p := range list {
+		ret = append(ret, &InstancePort{
+			PortStart: p.PortStart,
+			PortEnd:   p.PortEnd,
+			Protocol:  p.Protocol,
+			Purpose:   p.Purpose,
+			Address:   p.Address,
+		})
+	}
+	return ret
+}
+
+type InstanceReadPath struct {
+	PreferredReplica string   `json:"preferredReplica"`
+	EffectiveReplica string   `json:"effectiveReplica"`
+	Local            bool     `json:"local"`
+	Replicas         []string `json:"replicas"`
+	Reason           string   `json:"reason"`
+}
+
+func RPCToInstanceReadPath(obj *rpc.InstanceReadPath) *InstanceReadPath {
+	if obj == nil {
+		return nil
+	}
+	return &InstanceReadPath{
+		PreferredReplica: obj.PreferredReplica,
+		EffectiveReplica: obj.EffectiveReplica,
+		Local:            obj.Local,
+		Replicas:         obj.Replicas,
+		Reason:           obj.Reason,
+	}
 }
 
 func RPCToInstanceStatus(obj *rpc.InstanceStatus) InstanceStatus {
 	return InstanceStatus{
-		State:      obj.State,
-		ErrorMsg:   obj.ErrorMsg,
-		Conditions: obj.Conditions,
-		PortStart:  obj.PortStart,
-		PortEnd:    obj.PortEnd,
+		State:         obj.State,
+		ErrorMsg:      obj.ErrorMsg,
+		Conditions:    obj.Conditions,
+		PortStart:     obj.PortStart,
+		PortEnd:       obj.PortEnd,
+		Sidecars:      RPCToProcessSidecarStatuses(obj.Sidecars),
+		ResourceUsage: RPCToResourceUsage(obj.ResourceUsage),
+		ReadPath:      RPCToInstanceReadPath(obj.ReadPath),
+		RestartCount:  obj.RestartCount,
+		Ports:         RPCToInstancePorts(obj.Ports),
+		UUID:          obj.Uuid,
+		NvmeIdentity:  RPCToInstanceNvmeIdentity(obj.NvmeIdentity),
+	}
+}
+
+type InstanceNetworkStats struct {
+	PortStart     int32  `json:"portStart"`
+	PortEnd       int32  `json:"portEnd"`
+	BytesSent     uint64 `json:"bytesSent"`
+	BytesReceived uint64 `json:"bytesReceived"`
+	Connections   int32  `json:"connections"`
+}
+
+func RPCToInstanceNetworkStats(obj *rpc.InstanceStatsResponse) map[string]*InstanceNetworkStats {
+	ret := map[string]*InstanceNetworkStats{}
+	for name, s := range obj.Stats {
+		ret[name] = &InstanceNetworkStats{
+			PortStart:     s.PortStart,
+			PortEnd:       s.PortEnd,
+			BytesSent:     s.BytesSent,
+			BytesReceived: s.BytesReceived,
+			Connections:   s.Connections,
+		}
+	}
+	return ret
+}
+
+type LatencyStats struct {
+	Count int32         `json:"count"`
+	Min   time.Duration `json:"min"`
+	Avg   time.Duration `json:"avg"`
+	Max   time.Duration `json:"max"`
+}
+
+func RPCToLatencyStats(obj *rpc.LatencyStats) LatencyStats {
+	if obj == nil {
+		return LatencyStats{}
+	}
+	return LatencyStats{
+		Count: obj.Count,
+		Min:   time.Duration(obj.MinNs),
+		Avg:   time.Duration(obj.AvgNs),
+		Max:   time.Duration(obj.MaxNs),
+	}
+}
+
+type InstanceLatencyProbe struct {
+	Device           string                  `json:"device"`
+	Read             LatencyStats            `json:"read"`
+	Write            LatencyStats            `json:"write"`
+	ReplicaHops      map[string]LatencyStats `json:"replicaHops"`
+	ReplicaHopErrors map[string]string       `json:"replicaHopErrors"`
+}
+
+func RPCToInstanceLatencyProbe(obj *rpc.InstanceLatencyProbeResponse) *InstanceLatencyProbe {
+	ret := &InstanceLatencyProbe{
+		Device:           obj.Device,
+		Read:             RPCToLatencyStats(obj.Read),
+		Write:            RPCToLatencyStats(obj.Write),
+		ReplicaHops:      map[string]LatencyStats{},
+		ReplicaHopErrors: obj.ReplicaHopErrors,
+	}
+	for name, hop := range obj.ReplicaHops {
+		ret.ReplicaHops[name] = RPCToLatencyStats(hop)
+	}
+	return ret
+}
+
+type InstanceHealthCheck struct {
+	Name      string        `json:"name"`
+	Reachable bool          `json:"reachable"`
+	Latency   time.Duration `json:"latency"`
+	Probes    []string      `json:"probes"`
+	ErrorMsg  string        `json:"errorMsg"`
+	State     string        `json:"state"`
+}
+
+func RPCToInstanceHealthCheck(obj *rpc.InstanceHealthCheckResponse) *InstanceHealthCheck {
+	return &InstanceHealthCheck{
+		Name:      obj.Name,
+		Reachable: obj.Reachable,
+		Latency:   time.Duration(obj.LatencyNs),
+		Probes:    obj.Probes,
+		ErrorMsg:  obj.ErrorMsg,
+		State:     obj.State,
+	}
+}
+
+type InstanceIOTimeouts struct {
+	IOTimeoutMs          int32 `json:"ioTimeoutMs"`
+	CtrlLossTimeoutSec   int32 `json:"ctrlLossTimeoutSec"`
+	ReconnectDelaySec    int32 `json:"reconnectDelaySec"`
+	FastIOFailTimeoutSec int32 `json:"fastIOFailTimeoutSec"`
+}
+
+func RPCToInstanceIOTimeouts(obj *rpc.InstanceIOTimeouts) InstanceIOTimeouts {
+	if obj == nil {
+		return InstanceIOTimeouts{}
+	}
+	return InstanceIOTimeouts{
+		IOTimeoutMs:          obj.IoTimeoutMs,
+		CtrlLossTimeoutSec:   obj.CtrlLossTimeoutSec,
+		ReconnectDelaySec:    obj.ReconnectDelaySec,
+		FastIOFailTimeoutSec: obj.FastIoFailTimeoutSec,
+	}
+}
+
+func InstanceIOTimeoutsToRPC(obj InstanceIOTimeouts) *rpc.InstanceIOTimeouts {
+	return &rpc.InstanceIOTimeouts{
+		IoTimeoutMs:          obj.IOTimeoutMs,
+		CtrlLossTimeoutSec:   obj.CtrlLossTimeoutSec,
+		ReconnectDelaySec:    obj.ReconnectDelaySec,
+		FastIoFailTimeoutSec: obj.FastIOFailTimeoutSec,
+	}
+}
+
+type InstanceIOTimeoutStatus struct {
+	Name        string             `json:"name"`
+	Configured  InstanceIOTimeouts `json:"configured"`
+	Current     InstanceIOTimeouts `json:"current"`
+	Controllers []string           `json:"controllers"`
+}
+
+func RPCToInstanceIOTimeoutStatus(obj *rpc.InstanceIOTimeoutResponse) *InstanceIOTimeoutStatus {
+	return &InstanceIOTimeoutStatus{
+		Name:        obj.Name,
+		Configured:  RPCToInstanceIOTimeouts(obj.Configured),
+		Current:     RPCToInstanceIOTimeouts(obj.Current),
+		Controllers: obj.Controllers,
+	}
+}
+
+type InstanceBatchResult struct {
+	Name string `json:"name"`
+	// Instance is nil if the operation failed
+	Instance  *Instance  `json:"instance"`
+	ErrorCode codes.Code `json:"errorCode"`
+	ErrorMsg  string     `json:"errorMsg"`
+}
+
+func RPCToInstanceBatchResults(obj *rpc.InstanceBatchResponse) []*InstanceBatchResult {
+	ret := []*InstanceBatchResult{}
+	for _, r := range obj.Results {
+		result := &InstanceBatchResult{
+			Name:      r.Name,
+			ErrorCode: codes.Code(r.ErrorCode),
+			ErrorMsg:  r.ErrorMsg,
+		}
+		if r.Instance != nil {
+			result.Instance = RPCToInstance(r.Instance)
+		}
+		ret = append(ret, result)
+	}
+	return ret
+}
+
+type NetworkPathResult struct {
+	Address          string        `json:"address"`
+	LocalInterface   string        `json:"localInterface"`
+	LocalMTU         int32         `json:"localMTU"`
+	MTU              int32         `json:"mtu"`
+	Reachable        bool          `json:"reachable"`
+	MTUValid         bool          `json:"mtuValid"`
+	RTT              time.Duration `json:"rtt"`
+	TCPConnected     bool          `json:"tcpConnected"`
+	TCPConnect       time.Duration `json:"tcpConnect"`
+	NvmfSubsystemNQN string        `json:"nvmfSubsystemNQN"`
+	Errors           []string      `json:"errors"`
+}
+
+func RPCToNetworkPathResults(obj *rpc.NetworkPathValidateResponse) []*NetworkPathResult {
+	ret := []*NetworkPathResult{}
+	for _, r := range obj.Results {
+		ret = append(ret, &NetworkPathResult{
+			Address:          r.Address,
+			LocalInterface:   r.LocalInterface,
+			LocalMTU:         r.LocalMtu,
+			MTU:              r.Mtu,
+			Reachable:        r.Reachable,
+			MTUValid:         r.MtuValid,
+			RTT:              time.Duration(r.RttNs),
+			TCPConnected:     r.TcpConnected,
+			TCPConnect:       time.Duration(r.TcpConnectNs),
+			NvmfSubsystemNQN: r.NvmfSubsystemNqn,
+			Errors:           r.Errors,
+		})
+	}
+	return ret
+}
+
+type EngineMigration struct {
+	VolumeName        string `json:"volumeName"`
+	SourceDataEngine  string `json:"sourceDataEngine"`
+	TargetDataEngine  string `json:"targetDataEngine"`
+	Phase             string `json:"phase"`
+	BytesCopied       uint64 `json:"bytesCopied"`
+	BytesTotal        uint64 `json:"bytesTotal"`
+	ValidationResult  string `json:"validationResult"`
+	ValidationMessage string `json:"validationMessage"`
+	ErrorMsg          string `json:"errorMsg"`
+	CreatedAt         string `json:"createdAt"`
+	UpdatedAt         string `json:"updatedAt"`
+}
+
+func RPCToEngineMigration(obj *rpc.EngineMigration) *EngineMigration {
+	return &EngineMigration{
+		VolumeName:        obj.VolumeName,
+		SourceDataEngine:  dataEngines[obj.SourceDataEngine.String()],
+		TargetDataEngine:  dataEngines[obj.TargetDataEngine.String()],
+		Phase:             obj.Phase,
+		BytesCopied:       obj.BytesCopied,
+		BytesTotal:        obj.BytesTotal,
+		ValidationResult:  obj.ValidationResult,
+		ValidationMessage: obj.ValidationMessage,
+		ErrorMsg:          obj.ErrorMsg,
+		CreatedAt:         obj.CreatedAt,
+		UpdatedAt:         obj.UpdatedAt,
+	}
+}
+
+func RPCToEngineMigrationList(obj *rpc.EngineMigrationListResponse) map[string]*EngineMigration {
+	ret := map[string]*EngineMigration{}
+	for name, m := range obj.Migrations {
+		ret[name] = RPCToEngineMigration(m)
+	}
+	return ret
+}
+
+type ReplicaSpare struct {
+	Name      string `json:"name"`
+	DiskName  string `json:"diskName"`
+	DiskUUID  string `json:"diskUUID"`
+	Size      uint64 `json:"size"`
+	IP        string `json:"ip"`
+	PortStart int32  `json:"portStart"`
+	PortEnd   int32  `json:"portEnd"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+func RPCToReplicaSpare(obj *rpc.ReplicaSpare) *ReplicaSpare {
+	return &ReplicaSpare{
+		Name:      obj.Name,
+		DiskName:  obj.DiskName,
+		DiskUUID:  obj.DiskUuid,
+		Size:      obj.Size,
+		IP:        obj.Ip,
+		PortStart: obj.PortStart,
+		PortEnd:   obj.PortEnd,
+		CreatedAt: obj.CreatedAt,
+		ExpiresAt: obj.ExpiresAt,
+	}
+}
+
+func RPCToReplicaSpareList(obj *rpc.ReplicaSpareListResponse) map[string]*ReplicaSpare {
+	ret := map[string]*ReplicaSpare{}
+	for name, s := range obj.Spares {
+		ret[name] = RPCToReplicaSpare(s)
+	}
+	return ret
+}
+
+type ReplicaReadOnlyAttachment struct {
+	Name       string `json:"name"`
+	DataEngine string `json:"dataEngine"`
+	DiskName   string `json:"diskName"`
+	Device     string `json:"device"`
+	CreatedAt  string `json:"createdAt"`
+	ExpiresAt  string `json:"expiresAt"`
+}
+
+func RPCToReplicaReadOnlyAttachment(obj *rpc.ReplicaReadOnlyAttachment) *ReplicaReadOnlyAttachment {
+	return &ReplicaReadOnlyAttachment{
+		Name:       obj.Name,
+		DataEngine: obj.DataEngine.String(),
+		DiskName:   obj.DiskName,
+		Device:     obj.Device,
+		CreatedAt:  obj.CreatedAt,
+		ExpiresAt:  obj.ExpiresAt,
+	}
+}
+
+func RPCToReplicaReadOnlyAttachmentList(obj *rpc.ReplicaReadOnlyAttachmentListResponse) map[string]*ReplicaReadOnlyAttachment {
+	ret := map[string]*ReplicaReadOnlyAttachment{}
+	for name, a := range obj.Attachments {
+		ret[name] = RPCToReplicaReadOnlyAttachment(a)
+	}
+	return ret
+}
+
+type DeferredTask struct {
+	ID            string            `json:"id"`
+	Type          string            `json:"type"`
+	Args          map[string]string `json:"args"`
+	CreatedAt     string            `json:"createdAt"`
+	NextAttemptAt string            `json:"nextAttemptAt"`
+	Attempts      int32             `json:"attempts"`
+	LastError     string            `json:"lastError"`
+}
+
+func RPCToDeferredTaskList(obj *rpc.DeferredTaskListResponse) []*DeferredTask {
+	ret := []*DeferredTask{}
+	for _, t := range obj.Tasks {
+		ret = append(ret, &DeferredTask{
+			ID:            t.Id,
+			Type:          t.Type,
+			Args:          t.Args,
+			CreatedAt:     t.CreatedAt,
+			NextAttemptAt: t.NextAttemptAt,
+			Attempts:      t.Attempts,
+			LastError:     t.LastError,
+		})
+	}
+	return ret
+}
+
+type SpdkOrphanResource struct {
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	DiskName string `json:"diskName"`
+	Action   string `json:"action"`
+	Message  string `json:"message"`
+	ErrorMsg string `json:"errorMsg"`
+}
+
+func RPCToSpdkOrphanResourceList(obj *rpc.SpdkOrphanReconcileResponse) []*SpdkOrphanResource {
+	ret := []*SpdkOrphanResource{}
+	for _, r := range obj.Resources {
+		ret = append(ret, &SpdkOrphanResource{
+			Kind:     r.Kind,
+			Name:     r.Name,
+			DiskName: r.DiskName,
+			Action:   r.Action,
+			Message:  r.Message,
+			ErrorMsg: r.ErrorMsg,
+		})
+	}
+	return ret
+}
+
+type InstanceWatchEvent struct {
+	EventType   string `json:"eventType"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	DataEngine  string `json:"dataEngine"`
+	OldState    string `json:"oldState"`
+	NewState    string `json:"newState"`
+	Timestamp   string `json:"timestamp"`
+	ResumeToken string `json:"resumeToken"`
+}
+
+func RPCToInstanceWatchEvent(obj *rpc.InstanceWatchEvent) *InstanceWatchEvent {
+	return &InstanceWatchEvent{
+		EventType:   obj.EventType,
+		Name:        obj.Name,
+		Type:        obj.Type,
+		DataEngine:  dataEngines[obj.DataEngine.String()],
+		OldState:    obj.OldState,
+		NewState:    obj.NewState,
+		Timestamp:   obj.Timestamp,
+		ResumeToken: obj.ResumeToken,
+	}
+}
+
+type InstanceWatchFreezeStatus struct {
+	Frozen    bool   `json:"frozen"`
+	Reason    string `json:"reason"`
+	FrozenAt  string `json:"frozenAt"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+func RPCToInstanceWatchFreezeStatus(obj *rpc.InstanceWatchFreezeStatus) *InstanceWatchFreezeStatus {
+	return &InstanceWatchFreezeStatus{
+		Frozen:    obj.Frozen,
+		Reason:    obj.Reason,
+		FrozenAt:  obj.FrozenAt,
+		ExpiresAt: obj.ExpiresAt,
 	}
 }
 
@@ -92,8 +683,22 @@ func NewInstanceStream(stream rpc.InstanceService_InstanceWatchClient) *Instance
 	}
 }
 
+// Recv returns once an instance changes. It is kept for the clients listing the instances on every change.
 func (s *InstanceStream) Recv() (*emptypb.Empty, error) {
-	return s.stream.Recv()
+	if _, err := s.stream.Recv(); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RecvEvent returns the next change of an instance. The client should list the instances if the event type is
+// resync or empty.
+func (s *InstanceStream) RecvEvent() (*InstanceWatchEvent, error) {
+	event, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return RPCToInstanceWatchEvent(event), nil
 }
 
 type ReplicaStream struct {
@@ -126,18 +731,243 @@ func (s *EngineStream) Recv() (*emptypb.Empty, error) {
 
 func NewLogStream(stream rpc.ProcessManagerService_ProcessLogClient) *LogStream {
 	return &LogStream{
-		stream,
+		stream: stream,
 	}
 }
 
+// LogStream receives the log lines one by one, whether the server sends them one per response or in batched
+// frames.
 type LogStream struct {
 	stream rpc.ProcessManagerService_ProcessLogClient
+
+	pending []*util.LogLine
 }
 
 func (s *LogStream) Recv() (string, error) {
-	resp, err := s.stream.Recv()
+	entry, err := s.RecvEntry()
 	if err != nil {
 		return "", err
 	}
-	return resp.Line, nil
+	return entry.Line, nil
+}
+
+// RecvResponse returns the next response as it is, e.g. to forward the batched frames without decoding them. It
+// must not be mixed with Recv and RecvEntry.
+func (s *LogStream) RecvResponse() (*rpc.LogResponse, error) {
+	return s.stream.Recv()
+}
+
+// LogEntry is a log line with the sequence and the unix nano timestamp it was stored with. Both are 0 for the
+// lines stored without them.
+type LogEntry struct {
+	Sequence  uint64 `json:"sequence"`
+	Timestamp int64  `json:"timestamp"`
+	Line      string `json:"line"`
+}
+
+func (s *LogStream) RecvEntry() (*LogEntry, error) {
+	for len(s.pending) == 0 {
+		resp, err := s.stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if resp.Frame == nil {
+			return &LogEntry{
+				Sequence:  resp.Sequence,
+				Timestamp: resp.Timestamp,
+				Line:      resp.Line,
+			}, nil
+		}
+		if s.pending, err = util.DecodeLogFrame(resp.Frame, resp.Compressed); err != nil {
+			return nil, err
+		}
+	}
+
+	line := s.pending[0]
+	s.pending = s.pending[1:]
+	entry := &LogEntry{
+		Sequence: line.Sequence,
+		Line:     line.Line,
+	}
+	if !line.Time.IsZero() {
+		entry.Timestamp = line.Time.UnixNano()
+	}
+	return entry, nil
+}
+
+func NewVolumeLogStream(stream rpc.InstanceService_VolumeLogClient) *VolumeLogStream {
+	return &VolumeLogStream{
+		stream: stream,
+	}
+}
+
+// VolumeLogStream receives the log lines of the instances of a volume, interleaved by time.
+type VolumeLogStream struct {
+	stream rpc.InstanceService_VolumeLogClient
+}
+
+// VolumeLogEntry is a log line of an instance of a volume.
+type VolumeLogEntry struct {
+	InstanceName string `json:"instanceName"`
+	DataEngine   string `json:"dataEngine"`
+	LogEntry
+}
+
+func (s *VolumeLogStream) RecvEntry() (*VolumeLogEntry, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return &VolumeLogEntry{
+		InstanceName: resp.InstanceName,
+		DataEngine:   dataEngines[resp.DataEngine.String()],
+		LogEntry: LogEntry{
+			Sequence:  resp.Sequence,
+			Timestamp: resp.Timestamp,
+			Line:      resp.Line,
+		},
+	}, nil
+}
+
+type PortRange struct {
+	Start int32 `json:"start"`
+	End   int32 `json:"end"`
+}
+
+type InstancePortAllocation struct {
+	Name      string `json:"name"`
+	PortStart int32  `json:"portStart"`
+	PortEnd   int32  `json:"portEnd"`
+	OwnerType string `json:"ownerType"`
+	// Allocated is false for the ports of an instance released in the allocator while the instance is still known
+	Allocated bool `json:"allocated"`
+}
+
+type DataEnginePorts struct {
+	DataEngine string `json:"dataEngine"`
+	// Range is nil if the port range of the data engine is unknown
+	Range       *PortRange                `json:"range"`
+	Allocations []*InstancePortAllocation `json:"allocations"`
+	Free        []*PortRange              `json:"free"`
+	FreeCount   int32                     `json:"freeCount"`
+}
+
+func RPCToDataEnginePortsList(obj *rpc.PortsGetResponse) []*DataEnginePorts {
+	ret := []*DataEnginePorts{}
+	for _, p := range obj.DataEngines {
+		ports := &DataEnginePorts{
+			DataEngine:  dataEngines[p.DataEngine.String()],
+			Allocations: []*InstancePortAllocation{},
+			Free:        []*PortRange{},
+			FreeCount:   p.FreeCount,
+		}
+		if p.Range != nil {
+			ports.Range = &PortRange{Start: p.Range.Start, End: p.Range.End}
+		}
+		for _, a := range p.Allocations {
+			ports.Allocations = append(ports.Allocations, &InstancePortAllocation{
+				Name:      a.Name,
+				PortStart: a.PortStart,
+				PortEnd:   a.PortEnd,
+				OwnerType: a.OwnerType,
+				Allocated: a.Allocated,
+			})
+		}
+		for _, r := range p.Free {
+			ports.Free = append(ports.Free, &PortRange{Start: r.Start, End: r.End})
+		}
+		ret = append(ret, ports)
+	}
+	return ret
+}
+
+type StreamEvent struct {
+	ID         uint64 `json:"id"`
+	Service    string `json:"service"`
+	Method     string `json:"method"`
+	Peer       string `json:"peer"`
+	StartTime  string `json:"startTime"`
+	EndTime    string `json:"endTime"`
+	DurationMs int64  `json:"durationMs"`
+	Cause      string `json:"cause"`
+	Code       string `json:"code"`
+	ErrorMsg   string `json:"errorMsg"`
+}
+
+type StreamEventList struct {
+	Active []*StreamEvent `json:"active"`
+	Ended  []*StreamEvent `json:"ended"`
+}
+
+func RPCToStreamEvent(obj *rpc.StreamEvent) *StreamEvent {
+	return &StreamEvent{
+		ID:         obj.Id,
+		Service:    obj.Service,
+		Method:     obj.Method,
+		Peer:       obj.Peer,
+		StartTime:  obj.StartTime,
+		EndTime:    obj.EndTime,
+		DurationMs: obj.DurationMs,
+		Cause:      obj.Cause,
+		Code:       obj.Code,
+		ErrorMsg:   obj.ErrorMsg,
+	}
+}
+
+func RPCToStreamEventList(obj *rpc.StreamEventListResponse) *StreamEventList {
+	ret := &StreamEventList{
+		Active: []*StreamEvent{},
+		Ended:  []*StreamEvent{},
+	}
+	for _, e := range obj.Active {
+		ret.Active = append(ret.Active, RPCToStreamEvent(e))
+	}
+	for _, e := range obj.Ended {
+		ret.Ended = append(ret.Ended, RPCToStreamEvent(e))
+	}
+	return ret
+}
+
+type InstanceConvertProgress struct {
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	TotalBytes  int64  `json:"totalBytes"`
+	CopiedBytes int64  `json:"copiedBytes"`
+	Progress    int32  `json:"progress"`
+	ErrorMsg    string `json:"errorMsg"`
+	// Instance is the v2 replica once complete
+	Instance *Instance `json:"instance"`
+}
+
+func RPCToInstanceConvertProgress(obj *rpc.InstanceConvertProgress) *InstanceConvertProgress {
+	progress := &InstanceConvertProgress{
+		Name:        obj.Name,
+		State:       obj.State,
+		TotalBytes:  obj.TotalBytes,
+		CopiedBytes: obj.CopiedBytes,
+		Progress:    obj.Progress,
+		ErrorMsg:    obj.ErrorMsg,
+	}
+	if obj.Instance != nil {
+		progress.Instance = RPCToInstance(obj.Instance)
+	}
+	return progress
+}
+
+type InstanceConvertStream struct {
+	stream rpc.InstanceService_InstanceConvertClient
+}
+
+func NewInstanceConvertStream(stream rpc.InstanceService_InstanceConvertClient) *InstanceConvertStream {
+	return &InstanceConvertStream{
+		stream,
+	}
+}
+
+func (s *InstanceConvertStream) Recv() (*InstanceConvertProgress, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return RPCToInstanceConvertProgress(resp), nil
 }
@@ -1,6 +1,10 @@
 package api
 
 import (
+	"time"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
 
@@ -11,6 +15,19 @@ type Process struct {
 	PortCount int32    `json:"portCount"`
 	PortArgs  []string `json:"portArgs"`
 
+	Sidecars []*ProcessSidecar `json:"sidecars"`
+
+	Envs map[string]string `json:"envs,omitempty"`
+
+	CPUMillicores int64  `json:"cpuMillicores,omitempty"`
+	MemoryBytes   int64  `json:"memoryBytes,omitempty"`
+	CPUSet        string `json:"cpuSet,omitempty"`
+	NUMANode      string `json:"numaNode,omitempty"`
+
+	Privileges *ProcessPrivileges `json:"privileges,omitempty"`
+
+	StopTimeoutSeconds int64 `json:"stopTimeoutSeconds,omitempty"`
+
 	ProcessStatus ProcessStatus `json:"processStatus"`
 
 	Deleted bool `json:"deleted"`
@@ -18,13 +35,72 @@ type Process struct {
 
 func RPCToProcess(obj *rpc.ProcessResponse) *Process {
 	return &Process{
-		Name:          obj.Spec.Name,
-		Binary:        obj.Spec.Binary,
-		Args:          obj.Spec.Args,
-		PortCount:     obj.Spec.PortCount,
-		PortArgs:      obj.Spec.PortArgs,
-		ProcessStatus: RPCToProcessStatus(obj.Status),
+		Name:               obj.Spec.Name,
+		Binary:             obj.Spec.Binary,
+		Args:               obj.Spec.Args,
+		PortCount:          obj.Spec.PortCount,
+		PortArgs:           obj.Spec.PortArgs,
+		Sidecars:           RPCToProcessSidecars(obj.Spec.Sidecars),
+		Envs:               obj.Spec.Envs,
+		CPUMillicores:      obj.Spec.CpuMillicores,
+		MemoryBytes:        obj.Spec.MemoryBytes,
+		CPUSet:             obj.Spec.CpuSet,
+		NUMANode:           obj.Spec.NumaNode,
+		Privileges:         RPCToProcessPrivileges(obj.Spec.Privileges),
+		StopTimeoutSeconds: obj.Spec.StopTimeoutSeconds,
+		ProcessStatus:      RPCToProcessStatus(obj.Status),
+	}
+}
+
+type ProcessPrivileges struct {
+	Capabilities    []string `json:"capabilities"`
+	NoNewPrivileges bool     `json:"noNewPrivileges"`
+}
+
+func RPCToProcessPrivileges(obj *rpc.ProcessPrivileges) *ProcessPrivileges {
+	if obj == nil {
+		return nil
 	}
+	return &ProcessPrivileges{
+		Capabilities:    obj.Capabilities,
+		NoNewPrivileges: obj.NoNewPrivileges,
+	}
+}
+
+type ProcessSidecar struct {
+	Name   string   `json:"name"`
+	Binary string   `json:"binary"`
+	Args   []string `json:"args"`
+}
+
+func RPCToProcessSidecars(obj []*rpc.ProcessSidecarSpec) []*ProcessSidecar {
+	ret := []*ProcessSidecar{}
+	for _, s := range obj {
+		ret = append(ret, &ProcessSidecar{
+			Name:   s.Name,
+			Binary: s.Binary,
+			Args:   s.Args,
+		})
+	}
+	return ret
+}
+
+type ProcessSidecarStatus struct {
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	ErrorMsg string `json:"errorMsg"`
+}
+
+func RPCToProcessSidecarStatuses(obj []*rpc.ProcessSidecarStatus) []*ProcessSidecarStatus {
+	ret := []*ProcessSidecarStatus{}
+	for _, s := range obj {
+		ret = append(ret, &ProcessSidecarStatus{
+			Name:     s.Name,
+			State:    s.State,
+			ErrorMsg: s.ErrorMsg,
+		})
+	}
+	return ret
 }
 
 func RPCToProcessList(obj *rpc.ProcessListResponse) map[string]*Process {
@@ -41,15 +117,60 @@ type ProcessStatus struct {
 	Conditions map[string]bool `json:"conditions"`
 	PortStart  int32           `json:"portStart"`
 	PortEnd    int32           `json:"portEnd"`
+
+	Sidecars []*ProcessSidecarStatus `json:"sidecars"`
+
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+
+	UUID string `json:"uuid"`
 }
 
 func RPCToProcessStatus(obj *rpc.ProcessStatus) ProcessStatus {
 	return ProcessStatus{
-		State:      obj.State,
-		ErrorMsg:   obj.ErrorMsg,
-		Conditions: obj.Conditions,
-		PortStart:  obj.PortStart,
-		PortEnd:    obj.PortEnd,
+		State:         obj.State,
+		ErrorMsg:      obj.ErrorMsg,
+		Conditions:    obj.Conditions,
+		PortStart:     obj.PortStart,
+		PortEnd:       obj.PortEnd,
+		Sidecars:      RPCToProcessSidecarStatuses(obj.Sidecars),
+		ResourceUsage: RPCToResourceUsage(obj.ResourceUsage),
+		UUID:          obj.Uuid,
+	}
+}
+
+type ResourceUsage struct {
+	CPUTimeNs     uint64 `json:"cpuTimeNs"`
+	RSSBytes      uint64 `json:"rssBytes"`
+	OpenFDs       int32  `json:"openFDs"`
+	UptimeSeconds int64  `json:"uptimeSeconds"`
+	Shared        bool   `json:"shared"`
+}
+
+func RPCToResourceUsage(obj *rpc.ResourceUsage) *ResourceUsage {
+	if obj == nil {
+		return nil
+	}
+	return &ResourceUsage{
+		CPUTimeNs:     obj.CpuTimeNs,
+		RSSBytes:      obj.RssBytes,
+		OpenFDs:       obj.OpenFds,
+		UptimeSeconds: obj.UptimeSeconds,
+		Shared:        obj.Shared,
+	}
+}
+
+// ProcessResourceUsageToRPC converts the usage gathered from /proc. shared tells if the process is shared by the
+// instances.
+func ProcessResourceUsageToRPC(usage *util.ProcessResourceUsage, shared bool) *rpc.ResourceUsage {
+	if usage == nil {
+		return nil
+	}
+	return &rpc.ResourceUsage{
+		CpuTimeNs:     uint64(usage.CPUTime),
+		RssBytes:      usage.RSSBytes,
+		OpenFds:       int32(usage.OpenFDs),
+		UptimeSeconds: int64(usage.Uptime / time.Second),
+		Shared:        shared,
 	}
 }
 
@@ -66,3 +187,17 @@ func NewProcessStream(stream rpc.ProcessManagerService_ProcessWatchClient) *Proc
 func (s *ProcessStream) Recv() (*rpc.ProcessResponse, error) {
 	return s.stream.Recv()
 }
+
+type ProcessPortEventStream struct {
+	stream rpc.ProcessManagerService_ProcessPortEventWatchClient
+}
+
+func NewProcessPortEventStream(stream rpc.ProcessManagerService_ProcessPortEventWatchClient) *ProcessPortEventStream {
+	return &ProcessPortEventStream{
+		stream,
+	}
+}
+
+func (s *ProcessPortEventStream) Recv() (*rpc.ProcessPortEvent, error) {
+	return s.stream.Recv()
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+type ReplicaModeTransition struct {
+	EngineName     string `json:"engineName"`
+	VolumeName     string `json:"volumeName"`
+	DataEngine     string `json:"dataEngine"`
+	ReplicaAddress string `json:"replicaAddress"`
+	OldMode        string `json:"oldMode"`
+	NewMode        string `json:"newMode"`
+	ObservedAt     int64  `json:"observedAt"`
+}
+
+func RPCToReplicaModeTransition(obj *rpc.ReplicaModeTransition) *ReplicaModeTransition {
+	return &ReplicaModeTransition{
+		EngineName:     obj.EngineName,
+		VolumeName:     obj.VolumeName,
+		DataEngine:     obj.DataEngine.String(),
+		ReplicaAddress: obj.ReplicaAddress,
+		OldMode:        obj.OldMode,
+		NewMode:        obj.NewMode,
+		ObservedAt:     obj.ObservedAt,
+	}
+}
+
+type ReplicaModeStream struct {
+	stream rpc.ProxyEngineService_ReplicaModeWatchClient
+}
+
+func NewReplicaModeStream(stream rpc.ProxyEngineService_ReplicaModeWatchClient) *ReplicaModeStream {
+	return &ReplicaModeStream{
+		stream,
+	}
+}
+
+func (s *ReplicaModeStream) Recv() (*ReplicaModeTransition, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return RPCToReplicaModeTransition(resp), nil
+}
+
+type SnapshotRetentionEvent struct {
+	VolumeName   string `json:"volumeName"`
+	EngineName   string `json:"engineName"`
+	DataEngine   string `json:"dataEngine"`
+	SnapshotName string `json:"snapshotName"`
+	Reason       string `json:"reason"`
+	ErrorMsg     string `json:"errorMsg"`
+	ObservedAt   int64  `json:"observedAt"`
+}
+
+func RPCToSnapshotRetentionEvent(obj *rpc.SnapshotRetentionEvent) *SnapshotRetentionEvent {
+	return &SnapshotRetentionEvent{
+		VolumeName:   obj.VolumeName,
+		EngineName:   obj.EngineName,
+		DataEngine:   obj.DataEngine.String(),
+		SnapshotName: obj.SnapshotName,
+		Reason:       obj.Reason,
+		ErrorMsg:     obj.ErrorMsg,
+		ObservedAt:   obj.ObservedAt,
+	}
+}
+
+type SnapshotRetentionEventStream struct {
+	stream rpc.ProxyEngineService_SnapshotRetentionEventWatchClient
+}
+
+func NewSnapshotRetentionEventStream(stream rpc.ProxyEngineService_SnapshotRetentionEventWatchClient) *SnapshotRetentionEventStream {
+	return &SnapshotRetentionEventStream{
+		stream,
+	}
+}
+
+func (s *SnapshotRetentionEventStream) Recv() (*SnapshotRetentionEvent, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return RPCToSnapshotRetentionEvent(resp), nil
+}
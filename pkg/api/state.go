@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
+)
+
+// NodeStateVersion is the version of the NodeState format. The fields of a version are only ever added, never
+// renamed, removed or given another meaning, so a state stays readable by the later instance managers. A change
+// breaking that bumps the version, and the states of a version newer than the reader's are rejected.
+const NodeStateVersion = 1
+
+// NodeState is the configuration of the disks and instances of an instance manager, exported for the external backup
+// of the node, or for re-seeding the instance manager of a replacement node. It holds what the disks and instances
+// are created with, not their runtime status or data.
+type NodeState struct {
+	// Version is the version of the format, see NodeStateVersion
+	Version int `json:"version"`
+	// ExportedAt is the time of the export in RFC 3339
+	ExportedAt string `json:"exportedAt"`
+	// InstanceManagerVersion is the version of the exporting instance manager, for reference only
+	InstanceManagerVersion string `json:"instanceManagerVersion"`
+	// Topology is the location of the exporting node, for reference only
+	Topology meta.Topology `json:"topology"`
+
+	// Disks are imported before the instances, since the v2 replicas live on them
+	Disks []*NodeStateDisk `json:"disks"`
+	// Instances are imported in the order of the v2 replicas, the v2 engines and the v1 instances
+	Instances []*NodeStateInstance `json:"instances"`
+}
+
+// NodeStateDisk is a block disk of the v2 data engine.
+type NodeStateDisk struct {
+	Name string `json:"name"`
+	// Type is block, the only type of the disks managed by the instance manager
+	Type string `json:"type"`
+	Path string `json:"path"`
+	// UUID is the UUID of the lvstore on the disk. Importing the disk with it loads the existing lvstore, so the
+	// import fails if the device has another one. Clear it to create a new lvstore on an empty device.
+	UUID      string `json:"uuid,omitempty"`
+	BlockSize int64  `json:"blockSize"`
+}
+
+// NodeStateInstance is an instance of either data engine.
+type NodeStateInstance struct {
+	Name string `json:"name"`
+	// Type is engine or replica. It is empty for a v1 instance, whose type is only known to longhorn-manager.
+	Type string `json:"type"`
+	// DataEngine is v1 or v2
+	DataEngine string `json:"dataEngine"`
+	// Spec is the InstanceSpec of instance.proto in the proto3 JSON mapping, which the instance is created with. The
+	// v2 replicas are created exposed, and the ports of the v2 instances are the ones they are running with.
+	Spec json.RawMessage `json:"spec"`
+}
+
+type StateImportResult struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	DataEngine string `json:"dataEngine"`
+	Result     string `json:"result"`
+	ErrorMsg   string `json:"errorMsg"`
+}
+
+func RPCToStateImportResultList(obj *rpc.StateImportResponse) []*StateImportResult {
+	ret := []*StateImportResult{}
+	for _, r := range obj.Results {
+		ret = append(ret, &StateImportResult{
+			Kind:       r.Kind,
+			Name:       r.Name,
+			DataEngine: dataEngines[r.DataEngine.String()],
+			Result:     r.Result,
+			ErrorMsg:   r.ErrorMsg,
+		})
+	}
+	return ret
+}
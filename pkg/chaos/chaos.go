@@ -0,0 +1,141 @@
+// Package chaos simulates backend failures so that integration tests can exercise the failure handling of the
+// callers deterministically. Faults can only be injected via the ChaosService, which is available in binaries
+// built with the "chaos" build tag and started with --chaos-enabled.
+package chaos
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+type registry struct {
+	lock *sync.RWMutex
+
+	spdkUnavailable *rpc.Fault
+	watchDropCh     chan struct{}
+	crashHandler    func(name string) error
+}
+
+var faults = &registry{
+	lock:        &sync.RWMutex{},
+	watchDropCh: make(chan struct{}),
+}
+
+// SetProcessCrashHandler registers the function used to crash a process for the process_crash fault.
+func SetProcessCrashHandler(handler func(name string) error) {
+	faults.lock.Lock()
+	defer faults.lock.Unlock()
+
+	faults.crashHandler = handler
+}
+
+// CheckSPDKAvailable returns an Unavailable error while the spdk_unavailable fault is active.
+func CheckSPDKAvailable() error {
+	if !Enabled {
+		return nil
+	}
+
+	faults.lock.RLock()
+	defer faults.lock.RUnlock()
+
+	if !isActive(faults.spdkUnavailable) {
+		return nil
+	}
+	return grpcstatus.Error(grpccodes.Unavailable, "chaos: SPDK service is unavailable")
+}
+
+// WatchStreamDropped returns a channel that is closed once the watch_stream_drop fault is injected. Watch
+// streams should return an error as soon as the channel is closed.
+func WatchStreamDropped() <-chan struct{} {
+	if !Enabled {
+		return nil
+	}
+
+	faults.lock.RLock()
+	defer faults.lock.RUnlock()
+
+	return faults.watchDropCh
+}
+
+func isActive(fault *rpc.Fault) bool {
+	return fault != nil && (fault.ExpiresAt == 0 || time.Now().Unix() < fault.ExpiresAt)
+}
+
+func injectFault(req *rpc.FaultInjectRequest) (*rpc.Fault, error) {
+	fault := &rpc.Fault{
+		Type:   req.Type,
+		Target: req.Target,
+	}
+
+	switch req.Type {
+	case rpc.FaultType_spdk_unavailable:
+		if req.DurationSeconds < 0 {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid duration %v", req.DurationSeconds)
+		}
+		if req.DurationSeconds > 0 {
+			fault.ExpiresAt = time.Now().Unix() + req.DurationSeconds
+		}
+		faults.lock.Lock()
+		faults.spdkUnavailable = fault
+		faults.lock.Unlock()
+	case rpc.FaultType_process_crash:
+		if req.Target == "" {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument target")
+		}
+		faults.lock.RLock()
+		handler := faults.crashHandler
+		faults.lock.RUnlock()
+		if handler == nil {
+			return nil, grpcstatus.Error(grpccodes.FailedPrecondition, "no process crash handler is registered")
+		}
+		if err := handler(req.Target); err != nil {
+			return nil, err
+		}
+	case rpc.FaultType_watch_stream_drop:
+		faults.lock.Lock()
+		close(faults.watchDropCh)
+		faults.watchDropCh = make(chan struct{})
+		faults.lock.Unlock()
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "unknown fault type %v", req.Type)
+	}
+
+	logrus.Warnf("Chaos: injected fault %v", faultString(fault))
+	return fault, nil
+}
+
+func clearFault(faultType rpc.FaultType) {
+	faults.lock.Lock()
+	defer faults.lock.Unlock()
+
+	// The other faults are applied at once, so there is nothing to clear
+	if faultType == rpc.FaultType_spdk_unavailable {
+		faults.spdkUnavailable = nil
+	}
+	logrus.Warnf("Chaos: cleared fault %v", faultType)
+}
+
+func listFaults() []*rpc.Fault {
+	faults.lock.RLock()
+	defer faults.lock.RUnlock()
+
+	active := []*rpc.Fault{}
+	if isActive(faults.spdkUnavailable) {
+		active = append(active, faults.spdkUnavailable)
+	}
+	return active
+}
+
+func faultString(fault *rpc.Fault) string {
+	if fault.Target != "" {
+		return fmt.Sprintf("%v for %v", fault.Type, fault.Target)
+	}
+	return fault.Type.String()
+}
@@ -0,0 +1,6 @@
+//go:build !chaos
+
+package chaos
+
+// Enabled is false for binaries built without the "chaos" build tag, which turns all the hooks into no-ops.
+const Enabled = false
@@ -0,0 +1,6 @@
+//go:build chaos
+
+package chaos
+
+// Enabled is true for binaries built with the "chaos" build tag.
+const Enabled = true
@@ -0,0 +1,34 @@
+package chaos
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+type Server struct{}
+
+func NewServer() (*Server, error) {
+	if !Enabled {
+		return nil, fmt.Errorf("instance manager is not built with chaos support, rebuild it with the chaos build tag")
+	}
+	return &Server{}, nil
+}
+
+func (s *Server) FaultInject(ctx context.Context, req *rpc.FaultInjectRequest) (*rpc.Fault, error) {
+	return injectFault(req)
+}
+
+func (s *Server) FaultClear(ctx context.Context, req *rpc.FaultClearRequest) (*emptypb.Empty, error) {
+	clearFault(req.Type)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) FaultList(ctx context.Context, req *emptypb.Empty) (*rpc.FaultListResponse, error) {
+	return &rpc.FaultListResponse{
+		Faults: listFaults(),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+type ChaosServiceContext struct {
+	cc      *grpc.ClientConn
+	service rpc.ChaosServiceClient
+}
+
+func (c ChaosServiceContext) Close() error {
+	if c.cc == nil {
+		return nil
+	}
+	return c.cc.Close()
+}
+
+func (c *ChaosServiceClient) getChaosServiceClient() rpc.ChaosServiceClient {
+	return c.service
+}
+
+// ChaosServiceClient injects simulated failures into an instance manager built with the chaos build tag. The
+// ChaosService is served on the same address as the InstanceService.
+type ChaosServiceClient struct {
+	serviceURL string
+	tlsConfig  *tls.Config
+	ChaosServiceContext
+}
+
+func NewChaosServiceClient(serviceURL string, tlsConfig *tls.Config) (*ChaosServiceClient, error) {
+	getChaosServiceContext := func(serviceUrl string, tlsConfig *tls.Config) (ChaosServiceContext, error) {
+		connection, err := util.Connect(serviceUrl, tlsConfig)
+		if err != nil {
+			return ChaosServiceContext{}, errors.Wrapf(err, "cannot connect to Chaos Service %v", serviceUrl)
+		}
+
+		return ChaosServiceContext{
+			cc:      connection,
+			service: rpc.NewChaosServiceClient(connection),
+		}, nil
+	}
+
+	serviceContext, err := getChaosServiceContext(serviceURL, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChaosServiceClient{
+		serviceURL:          serviceURL,
+		tlsConfig:           tlsConfig,
+		ChaosServiceContext: serviceContext,
+	}, nil
+}
+
+// FaultInject injects the fault. target is the process name for the process_crash fault, and duration is the
+// number of seconds the spdk_unavailable fault stays active, 0 means until it is cleared.
+func (c *ChaosServiceClient) FaultInject(faultType, target string, duration int64) (*api.Fault, error) {
+	t, ok := rpc.FaultType_value[faultType]
+	if !ok {
+		return nil, fmt.Errorf("failed to inject fault: invalid fault type %v", faultType)
+	}
+
+	client := c.getChaosServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	fault, err := client.FaultInject(ctx, &rpc.FaultInjectRequest{
+		Type:            rpc.FaultType(t),
+		Target:          target,
+		DurationSeconds: duration,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to inject fault %v", faultType)
+	}
+	return api.RPCToFault(fault), nil
+}
+
+func (c *ChaosServiceClient) FaultClear(faultType string) error {
+	t, ok := rpc.FaultType_value[faultType]
+	if !ok {
+		return fmt.Errorf("failed to clear fault: invalid fault type %v", faultType)
+	}
+
+	client := c.getChaosServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err := client.FaultClear(ctx, &rpc.FaultClearRequest{
+		Type: rpc.FaultType(t),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to clear fault %v", faultType)
+	}
+	return nil
+}
+
+func (c *ChaosServiceClient) FaultList() ([]*api.Fault, error) {
+	client := c.getChaosServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	faults, err := client.FaultList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list faults")
+	}
+	return api.RPCToFaultList(faults), nil
+}
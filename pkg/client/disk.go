@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -101,18 +102,7 @@ func (c *DiskServiceClient) DiskCreate(diskType, diskName, diskUUID, diskPath st
 		return nil, err
 	}
 
-	return &api.DiskInfo{
-		ID:          resp.GetId(),
-		UUID:        resp.GetUuid(),
-		Path:        resp.GetPath(),
-		Type:        resp.GetType(),
-		TotalSize:   resp.GetTotalSize(),
-		FreeSize:    resp.GetFreeSize(),
-		TotalBlocks: resp.GetTotalBlocks(),
-		FreeBlocks:  resp.GetFreeBlocks(),
-		BlockSize:   resp.GetBlockSize(),
-		ClusterSize: resp.GetClusterSize(),
-	}, nil
+	return api.RPCToDiskInfo(resp), nil
 }
 
 // DiskGet returns the disk info with the given name and path.
@@ -139,18 +129,117 @@ func (c *DiskServiceClient) DiskGet(diskType, diskName, diskPath string) (*api.D
 		return nil, err
 	}
 
-	return &api.DiskInfo{
-		ID:          resp.GetId(),
-		UUID:        resp.GetUuid(),
-		Path:        resp.GetPath(),
-		Type:        resp.GetType(),
-		TotalSize:   resp.GetTotalSize(),
-		FreeSize:    resp.GetFreeSize(),
-		TotalBlocks: resp.GetTotalBlocks(),
-		FreeBlocks:  resp.GetFreeBlocks(),
-		BlockSize:   resp.GetBlockSize(),
-		ClusterSize: resp.GetClusterSize(),
-	}, nil
+	return api.RPCToDiskInfo(resp), nil
+}
+
+// DiskRepair reloads the lvstore of the disk in safe mode and checks it again. The degraded lvols are deleted
+// before the reload if removeDegradedLvols is true.
+func (c *DiskServiceClient) DiskRepair(diskType, diskName, diskUUID, diskPath string, removeDegradedLvols bool) (*api.DiskInfo, error) {
+	if diskName == "" || diskUUID == "" || diskPath == "" {
+		return nil, fmt.Errorf("failed to repair disk: missing required parameters")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to repair disk: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.DiskRepair(ctx, &rpc.DiskRepairRequest{
+		DiskType:            rpc.DiskType(t),
+		DiskName:            diskName,
+		DiskUuid:            diskUUID,
+		DiskPath:            diskPath,
+		RemoveDegradedLvols: removeDegradedLvols,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.RPCToDiskInfo(resp), nil
+}
+
+// DiskWriteCacheGet returns whether the kernel sends flushes to the device of the disk, and whether the device
+// supports the forced unit access writes.
+func (c *DiskServiceClient) DiskWriteCacheGet(diskType, diskName, diskPath string) (*rpc.DiskWriteCache, error) {
+	if diskName == "" || diskPath == "" {
+		return nil, fmt.Errorf("failed to get disk write cache: missing required parameter")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to get disk write cache: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.DiskWriteCacheGet(ctx, &rpc.DiskWriteCacheGetRequest{
+		DiskType: rpc.DiskType(t),
+		DiskName: diskName,
+		DiskPath: diskPath,
+	})
+}
+
+// DiskWriteCacheSet sets whether the kernel considers the write cache of the device of the disk volatile. The
+// kernel stops sending flushes to the device if not, which is only safe if the write cache is battery-backed.
+func (c *DiskServiceClient) DiskWriteCacheSet(diskType, diskName, diskPath string, volatileWriteCache bool) (*rpc.DiskWriteCache, error) {
+	if diskName == "" || diskPath == "" {
+		return nil, fmt.Errorf("failed to set disk write cache: missing required parameter")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to set disk write cache: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.DiskWriteCacheSet(ctx, &rpc.DiskWriteCacheSetRequest{
+		DiskType:           rpc.DiskType(t),
+		DiskName:           diskName,
+		DiskPath:           diskPath,
+		VolatileWriteCache: volatileWriteCache,
+	})
+}
+
+// DiskFlush flushes the write cache of the device of the disk.
+func (c *DiskServiceClient) DiskFlush(diskType, diskName, diskPath string) error {
+	if diskName == "" || diskPath == "" {
+		return fmt.Errorf("failed to flush disk: missing required parameter")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return fmt.Errorf("failed to flush disk: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err := client.DiskFlush(ctx, &rpc.DiskFlushRequest{
+		DiskType: rpc.DiskType(t),
+		DiskName: diskName,
+		DiskPath: diskPath,
+	})
+	return err
+}
+
+// SpdkMemoryStatsGet returns the DPDK memory heap and mempool usage of spdk_tgt, along with the iobuf allocation
+// stats and the hugepages of the node.
+func (c *DiskServiceClient) SpdkMemoryStatsGet() (*rpc.SpdkMemoryStats, error) {
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.SpdkMemoryStatsGet(ctx, &emptypb.Empty{})
 }
 
 // DiskDelete deletes the disk with the given name and uuid.
@@ -223,6 +312,55 @@ func (c *DiskServiceClient) DiskReplicaInstanceDelete(diskType, diskName, diskUU
 	return err
 }
 
+// DiskWipe wipes the block device of the disk with the given mode and returns a stream of the wipe progress.
+// The disk must not be in use by an lvstore.
+func (c *DiskServiceClient) DiskWipe(ctx context.Context, diskType, diskName, diskPath, mode string) (*api.DiskWipeStream, error) {
+	if diskName == "" || diskPath == "" {
+		return nil, fmt.Errorf("failed to wipe disk: missing required parameters")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to wipe disk: invalid disk type %v", diskType)
+	}
+
+	m, ok := rpc.DiskWipeMode_value[mode]
+	if !ok || rpc.DiskWipeMode(m) == rpc.DiskWipeMode_wipe_mode_unspecified {
+		return nil, fmt.Errorf("failed to wipe disk: invalid wipe mode %v", mode)
+	}
+
+	client := c.getDiskServiceClient()
+	stream, err := client.DiskWipe(ctx, &rpc.DiskWipeRequest{
+		DiskType: rpc.DiskType(t),
+		DiskName: diskName,
+		DiskPath: diskPath,
+		Mode:     rpc.DiskWipeMode(m),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to wipe disk %v", diskName)
+	}
+	return api.NewDiskWipeStream(stream), nil
+}
+
+// DiskWatch returns a stream of the changes of the disks, starting with a created event for each existing disk. All
+// the disks are watched if diskName is empty. The stream is closed once the context is done.
+func (c *DiskServiceClient) DiskWatch(ctx context.Context, diskType, diskName string) (*api.DiskWatchStream, error) {
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to watch disks: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	stream, err := client.DiskWatch(ctx, &rpc.DiskWatchRequest{
+		DiskType: rpc.DiskType(t),
+		DiskName: diskName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to watch disks")
+	}
+	return api.NewDiskWatchStream(stream), nil
+}
+
 // VersionGet returns the disk service version.
 func (c *DiskServiceClient) VersionGet() (*meta.DiskServiceVersionOutput, error) {
 	client := c.getDiskServiceClient()
@@ -243,3 +381,72 @@ func (c *DiskServiceClient) VersionGet() (*meta.DiskServiceVersionOutput, error)
 		InstanceManagerDiskServiceAPIMinVersion: int(resp.InstanceManagerDiskServiceAPIMinVersion),
 	}, nil
 }
+
+// DiskHotplugStatusGet returns the state of the device of the disk, along with the latest events of its removal
+// and reattachment.
+func (c *DiskServiceClient) DiskHotplugStatusGet(diskType, diskName string) (*rpc.DiskHotplugStatus, error) {
+	if diskName == "" {
+		return nil, fmt.Errorf("failed to get disk hotplug status: missing required parameter")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to get disk hotplug status: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.DiskHotplugStatusGet(ctx, &rpc.DiskHotplugStatusGetRequest{
+		DiskType: rpc.DiskType(t),
+		DiskName: diskName,
+	})
+}
+
+// DiskScrubStatusGet returns the schedule and the progress of the scrub of the disk, along with the unreadable
+// ranges found by the latest completed scrub.
+func (c *DiskServiceClient) DiskScrubStatusGet(diskType, diskName string) (*rpc.DiskScrubStatus, error) {
+	if diskName == "" {
+		return nil, fmt.Errorf("failed to get disk scrub status: missing required parameter")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to get disk scrub status: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.DiskScrubStatusGet(ctx, &rpc.DiskScrubStatusGetRequest{
+		DiskType: rpc.DiskType(t),
+		DiskName: diskName,
+	})
+}
+
+// DiskScrubSet sets the interval between the scrubs of the disk and the cap of their read rate in bytes per
+// second. Scrubbing is disabled if the interval is 0. A scrub is started within a minute if startNow is true.
+func (c *DiskServiceClient) DiskScrubSet(diskType, diskName string, interval time.Duration, bandwidth int64, startNow bool) (*rpc.DiskScrubStatus, error) {
+	if diskName == "" {
+		return nil, fmt.Errorf("failed to set disk scrub: missing required parameter")
+	}
+
+	t, ok := rpc.DiskType_value[diskType]
+	if !ok {
+		return nil, fmt.Errorf("failed to set disk scrub: invalid disk type %v", diskType)
+	}
+
+	client := c.getDiskServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.DiskScrubSet(ctx, &rpc.DiskScrubSetRequest{
+		DiskType:                rpc.DiskType(t),
+		DiskName:                diskName,
+		IntervalSeconds:         int64(interval / time.Second),
+		BandwidthBytesPerSecond: bandwidth,
+		StartNow:                startNow,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -75,6 +76,8 @@ func NewInstanceServiceClientWithTLS(serviceURL, caFile, certFile, keyFile, peer
 type EngineCreateRequest struct {
 	ReplicaAddressMap map[string]string
 	Frontend          string
+	// PreferredReadReplica is the replica the engine prefers to read from, e.g. the local one. Optional.
+	PreferredReadReplica string
 }
 
 type ReplicaCreateRequest struct {
@@ -94,146 +97,1106 @@ type InstanceCreateRequest struct {
 
 	Binary     string
 	BinaryArgs []string
+	// Sidecars are the helper processes sharing the lifecycle of a v1 instance
+	Sidecars []*rpc.ProcessSidecarSpec
+	// Envs are the environment variables of a v1 instance
+	Envs map[string]string
+	// CPUMillicores and MemoryBytes limit the process of a v1 instance along with its children. Optional.
+	CPUMillicores int64
+	MemoryBytes   int64
+	// CPUSet and NUMANode pin the process of a v1 instance to the CPUs in the list format, e.g. "2-3". Optional.
+	CPUSet   string
+	NUMANode string
+	// Privileges restricts the capabilities of the process of a v1 instance at spawn. Optional.
+	Privileges *rpc.ProcessPrivileges
+	// StopTimeout is the time the process of a v1 instance is given to stop gracefully on deletion. Optional.
+	StopTimeout time.Duration
 
 	Engine  EngineCreateRequest
 	Replica ReplicaCreateRequest
 
+	// Adopt binds a v2 instance to the existing lvol or engine of the same name instead of failing.
+	Adopt bool
+
+	// Labels group the instances for the label selectors, e.g. by volume, tier or tenant.
+	Labels map[string]string
+
+	// RestartPolicy has the instance recreated by the instance manager once it fails. Only the v1 instances and the
+	// v2 engines can be restarted. Optional.
+	RestartPolicy *api.InstanceRestartPolicy
+
+	// PriorityClass is critical, high or normal. The processes of a higher class are less likely to be killed on
+	// memory pressure and get more CPU time, e.g. for the engines over the replicas. Optional.
+	PriorityClass string
+
+	// IdempotencyToken makes the retries of the creation with the same token return the original response rather
+	// than failing since the instance exists. Optional.
+	IdempotencyToken string
+
 	// Deprecated: replaced by DataEngine.
 	BackendStoreDriver string
 }
 
-func (c *InstanceServiceClient) InstanceCreate(req *InstanceCreateRequest) (*api.Instance, error) {
-	if req.Name == "" || req.InstanceType == "" {
-		return nil, fmt.Errorf("failed to create instance: missing required parameter")
+func (c *InstanceServiceClient) InstanceCreate(req *InstanceCreateRequest) (*api.Instance, error) {
+	if req.Name == "" || req.InstanceType == "" {
+		return nil, fmt.Errorf("failed to create instance: missing required parameter")
+	}
+
+	spec, err := getInstanceSpec(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create instance")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceCreate(ctx, &rpc.InstanceCreateRequest{
+		Spec:             spec,
+		IdempotencyToken: req.IdempotencyToken,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create instance")
+	}
+
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceCreateValidate validates the creation of the instance without creating it. It returns the descriptions of
+// the invalid fields by field, or an empty map if the spec is valid.
+func (c *InstanceServiceClient) InstanceCreateValidate(req *InstanceCreateRequest) (map[string]string, error) {
+	spec, err := getInstanceSpec(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to validate instance")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err = client.InstanceCreate(ctx, &rpc.InstanceCreateRequest{
+		Spec:         spec,
+		ValidateOnly: true,
+	})
+	violations := map[string]string{}
+	for _, violation := range util.GetFieldViolations(err) {
+		if description, exists := violations[violation.Field]; exists {
+			violations[violation.Field] = description + "; " + violation.Description
+			continue
+		}
+		violations[violation.Field] = violation.Description
+	}
+	if err != nil && len(violations) == 0 {
+		return nil, errors.Wrap(err, "failed to validate instance")
+	}
+	return violations, nil
+}
+
+func getInstanceSpec(req *InstanceCreateRequest) (*rpc.InstanceSpec, error) {
+	driver, ok := rpc.DataEngine_value[getDataEngine(req.DataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("invalid data engine %v", req.DataEngine)
+	}
+
+	var processInstanceSpec *rpc.ProcessInstanceSpec
+	var spdkInstanceSpec *rpc.SpdkInstanceSpec
+	if rpc.DataEngine(driver) == rpc.DataEngine_DATA_ENGINE_V1 {
+		processInstanceSpec = &rpc.ProcessInstanceSpec{
+			Binary:   req.Binary,
+			Args:     req.BinaryArgs,
+			Sidecars: req.Sidecars,
+			Envs:     req.Envs,
+
+			CpuMillicores:      req.CPUMillicores,
+			MemoryBytes:        req.MemoryBytes,
+			CpuSet:             req.CPUSet,
+			NumaNode:           req.NUMANode,
+			Privileges:         req.Privileges,
+			StopTimeoutSeconds: int64(req.StopTimeout / time.Second),
+		}
+	} else {
+		switch req.InstanceType {
+		case types.InstanceTypeEngine:
+			spdkInstanceSpec = &rpc.SpdkInstanceSpec{
+				Size:                 req.Size,
+				ReplicaAddressMap:    req.Engine.ReplicaAddressMap,
+				Frontend:             req.Engine.Frontend,
+				Adopt:                req.Adopt,
+				PreferredReadReplica: req.Engine.PreferredReadReplica,
+			}
+		case types.InstanceTypeReplica:
+			spdkInstanceSpec = &rpc.SpdkInstanceSpec{
+				Size:           req.Size,
+				DiskName:       req.Replica.DiskName,
+				DiskUuid:       req.Replica.DiskUUID,
+				ExposeRequired: req.Replica.ExposeRequired,
+				Adopt:          req.Adopt,
+			}
+		default:
+			return nil, fmt.Errorf("invalid instance type %v", req.InstanceType)
+		}
+	}
+
+	return &rpc.InstanceSpec{
+		// nolint:all replaced with DataEngine
+		BackendStoreDriver: rpc.BackendStoreDriver(driver),
+		DataEngine:         rpc.DataEngine(driver),
+		Name:               req.Name,
+		Type:               req.InstanceType,
+		VolumeName:         req.VolumeName,
+		PortCount:          int32(req.PortCount),
+		PortArgs:           req.PortArgs,
+		Labels:             req.Labels,
+		RestartPolicy:      getInstanceRestartPolicy(req.RestartPolicy),
+		PriorityClass:      req.PriorityClass,
+
+		ProcessInstanceSpec: processInstanceSpec,
+		SpdkInstanceSpec:    spdkInstanceSpec,
+	}, nil
+}
+
+func getInstanceRestartPolicy(policy *api.InstanceRestartPolicy) *rpc.InstanceRestartPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &rpc.InstanceRestartPolicy{
+		Policy:     policy.Policy,
+		MaxRetries: policy.MaxRetries,
+		BackoffMs:  policy.BackoffMs,
+	}
+}
+
+func (c *InstanceServiceClient) InstanceDelete(dataEngine, name, instanceType, diskUUID string, cleanupRequired bool) (*api.Instance, error) {
+	return c.InstanceDeleteWithStopTimeout(dataEngine, name, instanceType, diskUUID, cleanupRequired, 0)
+}
+
+// InstanceDeleteWithStopTimeout deletes the instance, giving the process of a v1 instance the stop timeout rather
+// than its own to stop gracefully before it is killed. The stop timeout of the process applies if 0.
+func (c *InstanceServiceClient) InstanceDeleteWithStopTimeout(dataEngine, name, instanceType, diskUUID string, cleanupRequired bool, stopTimeout time.Duration) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to delete instance: missing required parameter name")
+	}
+	if stopTimeout < 0 {
+		return nil, fmt.Errorf("failed to delete instance: invalid stop timeout %v", stopTimeout)
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to delete instance: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceDelete(ctx, &rpc.InstanceDeleteRequest{
+		Name: name,
+		Type: instanceType,
+		// nolint:all replaced with DataEngine
+		BackendStoreDriver: rpc.BackendStoreDriver(driver),
+		DataEngine:         rpc.DataEngine(driver),
+		DiskUuid:           diskUUID,
+		CleanupRequired:    cleanupRequired,
+		StopTimeoutSeconds: int64(stopTimeout / time.Second),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to delete instance %v", name)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceBatchCreate creates the instances in parallel on the server, with at most concurrency creations in flight
+// or the server default if 0. The result of each creation is returned in the order of the requests.
+func (c *InstanceServiceClient) InstanceBatchCreate(reqs []*InstanceCreateRequest, concurrency int) ([]*api.InstanceBatchResult, error) {
+	batch := &rpc.InstanceBatchCreateRequest{
+		Requests:    []*rpc.InstanceCreateRequest{},
+		Concurrency: int32(concurrency),
+	}
+	for _, req := range reqs {
+		if req.Name == "" || req.InstanceType == "" {
+			return nil, fmt.Errorf("failed to create instances: missing required parameter")
+		}
+		spec, err := getInstanceSpec(req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create instance %v", req.Name)
+		}
+		batch.Requests = append(batch.Requests, &rpc.InstanceCreateRequest{
+			Spec:             spec,
+			IdempotencyToken: req.IdempotencyToken,
+		})
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceBatchCreate(ctx, batch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create instances")
+	}
+	return api.RPCToInstanceBatchResults(resp), nil
+}
+
+// InstanceBatchDeleteRequest is a deletion of InstanceBatchDelete.
+type InstanceBatchDeleteRequest struct {
+	DataEngine      string
+	Name            string
+	InstanceType    string
+	DiskUUID        string
+	CleanupRequired bool
+}
+
+// InstanceBatchDelete deletes the instances in parallel on the server, with at most concurrency deletions in flight
+// or the server default if 0. The result of each deletion is returned in the order of the requests.
+func (c *InstanceServiceClient) InstanceBatchDelete(reqs []*InstanceBatchDeleteRequest, concurrency int) ([]*api.InstanceBatchResult, error) {
+	batch := &rpc.InstanceBatchDeleteRequest{
+		Requests:    []*rpc.InstanceDeleteRequest{},
+		Concurrency: int32(concurrency),
+	}
+	for _, req := range reqs {
+		if req.Name == "" {
+			return nil, fmt.Errorf("failed to delete instances: missing required parameter name")
+		}
+		driver, ok := rpc.DataEngine_value[getDataEngine(req.DataEngine)]
+		if !ok {
+			return nil, fmt.Errorf("failed to delete instance %v: invalid data engine %v", req.Name, req.DataEngine)
+		}
+		batch.Requests = append(batch.Requests, &rpc.InstanceDeleteRequest{
+			Name: req.Name,
+			Type: req.InstanceType,
+			// nolint:all replaced with DataEngine
+			BackendStoreDriver: rpc.BackendStoreDriver(driver),
+			DataEngine:         rpc.DataEngine(driver),
+			DiskUuid:           req.DiskUUID,
+			CleanupRequired:    req.CleanupRequired,
+		})
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceBatchDelete(ctx, batch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete instances")
+	}
+	return api.RPCToInstanceBatchResults(resp), nil
+}
+
+func (c *InstanceServiceClient) InstanceGet(dataEngine, name, instanceType string) (*api.Instance, error) {
+	return c.InstanceGetVerbose(dataEngine, name, instanceType, false)
+}
+
+// InstanceGetVerbose gets the instance along with the recent operations applied to it if verbose is true.
+func (c *InstanceServiceClient) InstanceGetVerbose(dataEngine, name, instanceType string, verbose bool) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to get instance: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to get instance: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceGet(ctx, &rpc.InstanceGetRequest{
+		Name: name,
+		Type: instanceType,
+		// nolint:all replaced with DataEngine
+		BackendStoreDriver: rpc.BackendStoreDriver(driver),
+		DataEngine:         rpc.DataEngine(driver),
+		Verbose:            verbose,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %v", name)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceGetByUUID gets the instance of the UUID, which tells apart the instances recreated by the same name. The
+// instance of the name is returned only if it has the UUID, unless the name is empty.
+func (c *InstanceServiceClient) InstanceGetByUUID(name, uuid string) (*api.Instance, error) {
+	if uuid == "" {
+		return nil, fmt.Errorf("failed to get instance: missing required parameter uuid")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceGet(ctx, &rpc.InstanceGetRequest{
+		Name:       name,
+		Uuid:       uuid,
+		DataEngine: rpc.DataEngine_DATA_ENGINE_ANY,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance of UUID %v", uuid)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceRefresh re-queries the backend for the state of the instance and notifies the instance watchers.
+func (c *InstanceServiceClient) InstanceRefresh(dataEngine, name, instanceType string) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to refresh instance: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to refresh instance: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceRefresh(ctx, &rpc.InstanceRefreshRequest{
+		Name:       name,
+		Type:       instanceType,
+		DataEngine: rpc.DataEngine(driver),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to refresh instance %v", name)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+func (c *InstanceServiceClient) InstanceSuspend(dataEngine, name, instanceType string) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to suspend instance: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to suspend instance: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceSuspend(ctx, &rpc.InstanceSuspendRequest{
+		Name:       name,
+		Type:       instanceType,
+		DataEngine: rpc.DataEngine(driver),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to suspend instance %v", name)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+func (c *InstanceServiceClient) InstanceResume(dataEngine, name, instanceType string) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to resume instance: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to resume instance: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceResume(ctx, &rpc.InstanceResumeRequest{
+		Name:       name,
+		Type:       instanceType,
+		DataEngine: rpc.DataEngine(driver),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resume instance %v", name)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceSwitchOverTarget switches the frontend of the v2 engine over to the NVMe-oF target at the address, e.g. of
+// the engine taking over the volume during a live upgrade.
+func (c *InstanceServiceClient) InstanceSwitchOverTarget(dataEngine, name, targetAddress string) (*api.Instance, error) {
+	if name == "" || targetAddress == "" {
+		return nil, fmt.Errorf("failed to switch over instance target: missing required parameter")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to switch over instance target: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceSwitchOverTarget(ctx, &rpc.InstanceSwitchOverTargetRequest{
+		Name:          name,
+		DataEngine:    rpc.DataEngine(driver),
+		TargetAddress: targetAddress,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to switch over target of instance %v to %v", name, targetAddress)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceDeleteTarget deletes the NVMe-oF target of the v2 engine whose frontend is switched over to another target.
+func (c *InstanceServiceClient) InstanceDeleteTarget(dataEngine, name string) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to delete instance target: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to delete instance target: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceDeleteTarget(ctx, &rpc.InstanceDeleteTargetRequest{
+		Name:       name,
+		DataEngine: rpc.DataEngine(driver),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to delete target of instance %v", name)
+	}
+	return api.RPCToInstance(p), nil
+}
+
+func (c *InstanceServiceClient) InstanceList() (map[string]*api.Instance, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	instances, err := client.InstanceList(ctx, &rpc.InstanceListRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list instances")
+	}
+	return api.RPCToInstanceList(instances), nil
+}
+
+// InstanceListPage returns a page of the instances matching all the filters, along with the token of the next
+// page, which is empty for the last page. An empty filter matches all the instances. All the matching instances
+// are returned if pageSize is 0.
+func (c *InstanceServiceClient) InstanceListPage(dataEngines, instanceTypes, states []string, namePrefix string, pageSize int, pageToken string) (map[string]*api.Instance, string, error) {
+	drivers := []rpc.DataEngine{}
+	for _, dataEngine := range dataEngines {
+		driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+		if !ok {
+			return nil, "", fmt.Errorf("failed to list instances: invalid data engine %v", dataEngine)
+		}
+		drivers = append(drivers, rpc.DataEngine(driver))
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	instances, err := client.InstanceList(ctx, &rpc.InstanceListRequest{
+		DataEngines: drivers,
+		Types:       instanceTypes,
+		States:      states,
+		NamePrefix:  namePrefix,
+		PageSize:    int32(pageSize),
+		PageToken:   pageToken,
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to list instances")
+	}
+	return api.RPCToInstanceList(instances), instances.NextPageToken, nil
+}
+
+// InstanceListBySelector returns the instances selected by the label selector, e.g. "longhornvolume=vol-1,tier!=cold".
+func (c *InstanceServiceClient) InstanceListBySelector(labelSelector string) (map[string]*api.Instance, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	instances, err := client.InstanceList(ctx, &rpc.InstanceListRequest{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list instances")
+	}
+	return api.RPCToInstanceList(instances), nil
+}
+
+// InstanceListSince returns the instances added or modified and the names of the ones deleted since the resource
+// version of a previous list. All the instances are returned instead, with Delta false, if sinceResourceVersion is
+// empty or the instance manager no longer knows the changes since then. The ResourceVersion of the result is for
+// the next call.
+func (c *InstanceServiceClient) InstanceListSince(sinceResourceVersion string) (*api.InstanceListDelta, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	instances, err := client.InstanceList(ctx, &rpc.InstanceListRequest{
+		SinceResourceVersion: sinceResourceVersion,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list instances")
+	}
+	return api.RPCToInstanceListDelta(instances), nil
+}
+
+// InstanceLatencyProbe measures the latency of the I/Os to the frontend device of the engine. It only reads unless
+// write is true, in which case the block at scratchOffset is rewritten with its own content.
+func (c *InstanceServiceClient) InstanceLatencyProbe(dataEngine, name, volumeName string, count int, write bool, scratchOffset uint64) (*api.InstanceLatencyProbe, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to probe instance latency: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to probe instance latency: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceLatencyProbe(ctx, &rpc.InstanceLatencyProbeRequest{
+		Name:          name,
+		DataEngine:    rpc.DataEngine(driver),
+		VolumeName:    volumeName,
+		Count:         int32(count),
+		Write:         write,
+		ScratchOffset: scratchOffset,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to probe latency of instance %v", name)
+	}
+	return api.RPCToInstanceLatencyProbe(resp), nil
+}
+
+// InstanceHealthCheck probes the instance right away. The frontend device of a v2 engine is read as well if ioProbe
+// is true. An unreachable instance is not an error, but is told by the result.
+func (c *InstanceServiceClient) InstanceHealthCheck(dataEngine, name, instanceType string, ioProbe bool) (*api.InstanceHealthCheck, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to check instance health: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to check instance health: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceHealthCheck(ctx, &rpc.InstanceHealthCheckRequest{
+		Name:       name,
+		Type:       instanceType,
+		DataEngine: rpc.DataEngine(driver),
+		IoProbe:    ioProbe,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check health of instance %v", name)
+	}
+	return api.RPCToInstanceHealthCheck(resp), nil
+}
+
+// InstanceIOTimeoutSet sets the I/O timeouts of the frontend of the v2 engine. The timeouts of 0 are left as is.
+func (c *InstanceServiceClient) InstanceIOTimeoutSet(dataEngine, name string, timeouts api.InstanceIOTimeouts) (*api.InstanceIOTimeoutStatus, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to set instance I/O timeouts: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to set instance I/O timeouts: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceIOTimeoutSet(ctx, &rpc.InstanceIOTimeoutSetRequest{
+		Name:       name,
+		DataEngine: rpc.DataEngine(driver),
+		Timeouts:   api.InstanceIOTimeoutsToRPC(timeouts),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to set I/O timeouts of instance %v", name)
+	}
+	return api.RPCToInstanceIOTimeoutStatus(resp), nil
+}
+
+// InstanceIOTimeoutGet returns the I/O timeouts set for the frontend of the v2 engine along with the ones in effect.
+func (c *InstanceServiceClient) InstanceIOTimeoutGet(dataEngine, name string) (*api.InstanceIOTimeoutStatus, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to get instance I/O timeouts: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to get instance I/O timeouts: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceIOTimeoutGet(ctx, &rpc.InstanceIOTimeoutGetRequest{
+		Name:       name,
+		DataEngine: rpc.DataEngine(driver),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get I/O timeouts of instance %v", name)
+	}
+	return api.RPCToInstanceIOTimeoutStatus(resp), nil
+}
+
+// InstanceReadPreferenceSet sets the replica the v2 engine prefers to read from. The preference is cleared if the
+// replica name is empty.
+func (c *InstanceServiceClient) InstanceReadPreferenceSet(dataEngine, name, replicaName string) (*api.Instance, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to set instance read preference: missing required parameter name")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to set instance read preference: invalid data engine %v", dataEngine)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceReadPreferenceSet(ctx, &rpc.InstanceReadPreferenceSetRequest{
+		Name:        name,
+		DataEngine:  rpc.DataEngine(driver),
+		ReplicaName: replicaName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to set read preference of instance %v", name)
+	}
+	return api.RPCToInstance(resp), nil
+}
+
+// NetworkPathValidate validates the reachability and the MTU of the paths from the node to the addresses. The MTU
+// of the local interface of each path is validated if mtu is 0.
+func (c *InstanceServiceClient) NetworkPathValidate(addresses []string, mtu int, nvmfDiscovery bool) ([]*api.NetworkPathResult, error) {
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("failed to validate network paths: missing required parameter addresses")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.NetworkPathValidate(ctx, &rpc.NetworkPathValidateRequest{
+		Addresses:     addresses,
+		Mtu:           int32(mtu),
+		NvmfDiscovery: nvmfDiscovery,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to validate network paths")
+	}
+	return api.RPCToNetworkPathResults(resp), nil
+}
+
+// InstanceStats returns the network stats of the instance, or of all instances if name is empty.
+func (c *InstanceServiceClient) InstanceStats(name string) (map[string]*api.InstanceNetworkStats, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceStats(ctx, &rpc.InstanceStatsRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get stats of instance %v", name)
+	}
+	return api.RPCToInstanceNetworkStats(resp), nil
+}
+
+// EngineMigrationRegister starts tracking the migration of the volume from the source data engine to the target
+// data engine. A migration can be registered again only after the previous one is completed or failed.
+func (c *InstanceServiceClient) EngineMigrationRegister(volumeName, sourceDataEngine, targetDataEngine string, bytesTotal uint64) (*api.EngineMigration, error) {
+	if volumeName == "" {
+		return nil, fmt.Errorf("failed to register engine migration: missing required parameter volume name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.EngineMigrationRegister(ctx, &rpc.EngineMigrationRegisterRequest{
+		VolumeName:       volumeName,
+		SourceDataEngine: rpc.DataEngine(rpc.DataEngine_value[getDataEngine(sourceDataEngine)]),
+		TargetDataEngine: rpc.DataEngine(rpc.DataEngine_value[getDataEngine(targetDataEngine)]),
+		BytesTotal:       bytesTotal,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to register engine migration of volume %v", volumeName)
+	}
+	return api.RPCToEngineMigration(resp), nil
+}
+
+// EngineMigrationUpdate reports the progress of the migration of the volume. The empty phase and validation
+// result and the zero total bytes are left unchanged.
+func (c *InstanceServiceClient) EngineMigrationUpdate(volumeName, phase string, bytesCopied, bytesTotal uint64, validationResult, validationMessage, errorMsg string) (*api.EngineMigration, error) {
+	if volumeName == "" {
+		return nil, fmt.Errorf("failed to update engine migration: missing required parameter volume name")
 	}
 
-	driver, ok := rpc.DataEngine_value[getDataEngine(req.DataEngine)]
-	if !ok {
-		return nil, fmt.Errorf("failed to delete instance: invalid data engine %v", req.DataEngine)
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.EngineMigrationUpdate(ctx, &rpc.EngineMigrationUpdateRequest{
+		VolumeName:        volumeName,
+		Phase:             phase,
+		BytesCopied:       bytesCopied,
+		BytesTotal:        bytesTotal,
+		ValidationResult:  validationResult,
+		ValidationMessage: validationMessage,
+		ErrorMsg:          errorMsg,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update engine migration of volume %v", volumeName)
+	}
+	return api.RPCToEngineMigration(resp), nil
+}
+
+func (c *InstanceServiceClient) EngineMigrationGet(volumeName string) (*api.EngineMigration, error) {
+	if volumeName == "" {
+		return nil, fmt.Errorf("failed to get engine migration: missing required parameter volume name")
 	}
 
 	client := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
 	defer cancel()
 
-	var processInstanceSpec *rpc.ProcessInstanceSpec
-	var spdkInstanceSpec *rpc.SpdkInstanceSpec
-	if rpc.DataEngine(driver) == rpc.DataEngine_DATA_ENGINE_V1 {
-		processInstanceSpec = &rpc.ProcessInstanceSpec{
-			Binary: req.Binary,
-			Args:   req.BinaryArgs,
-		}
-	} else {
-		switch req.InstanceType {
-		case types.InstanceTypeEngine:
-			spdkInstanceSpec = &rpc.SpdkInstanceSpec{
-				Size:              req.Size,
-				ReplicaAddressMap: req.Engine.ReplicaAddressMap,
-				Frontend:          req.Engine.Frontend,
-			}
-		case types.InstanceTypeReplica:
-			spdkInstanceSpec = &rpc.SpdkInstanceSpec{
-				Size:           req.Size,
-				DiskName:       req.Replica.DiskName,
-				DiskUuid:       req.Replica.DiskUUID,
-				ExposeRequired: req.Replica.ExposeRequired,
-			}
-		default:
-			return nil, fmt.Errorf("failed to create instance: invalid instance type %v", req.InstanceType)
-		}
+	resp, err := client.EngineMigrationGet(ctx, &rpc.EngineMigrationGetRequest{
+		VolumeName: volumeName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get engine migration of volume %v", volumeName)
 	}
+	return api.RPCToEngineMigration(resp), nil
+}
 
-	p, err := client.InstanceCreate(ctx, &rpc.InstanceCreateRequest{
-		Spec: &rpc.InstanceSpec{
-			// nolint:all replaced with DataEngine
-			BackendStoreDriver: rpc.BackendStoreDriver(driver),
-			DataEngine:         rpc.DataEngine(driver),
-			Name:               req.Name,
-			Type:               req.InstanceType,
-			VolumeName:         req.VolumeName,
-			PortCount:          int32(req.PortCount),
-			PortArgs:           req.PortArgs,
-
-			ProcessInstanceSpec: processInstanceSpec,
-			SpdkInstanceSpec:    spdkInstanceSpec,
-		},
+func (c *InstanceServiceClient) EngineMigrationList() (map[string]*api.EngineMigration, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.EngineMigrationList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list engine migrations")
+	}
+	return api.RPCToEngineMigrationList(resp), nil
+}
+
+func (c *InstanceServiceClient) EngineMigrationDelete(volumeName string) error {
+	if volumeName == "" {
+		return fmt.Errorf("failed to delete engine migration: missing required parameter volume name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err := client.EngineMigrationDelete(ctx, &rpc.EngineMigrationDeleteRequest{
+		VolumeName: volumeName,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create instance")
+		return errors.Wrapf(err, "failed to delete engine migration of volume %v", volumeName)
 	}
+	return nil
+}
 
-	return api.RPCToInstance(p), nil
+// ReplicaSpareCreate pre-provisions an empty v2 replica on the disk, which is deleted if not claimed within the
+// TTL. The default TTL is used if ttl is 0.
+func (c *InstanceServiceClient) ReplicaSpareCreate(diskName, diskUUID string, size uint64, portCount int, ttl time.Duration) (*api.ReplicaSpare, error) {
+	if diskName == "" || size == 0 {
+		return nil, fmt.Errorf("failed to create replica spare: missing required parameter")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.ReplicaSpareCreate(ctx, &rpc.ReplicaSpareCreateRequest{
+		DiskName:   diskName,
+		DiskUuid:   diskUUID,
+		Size:       size,
+		PortCount:  int32(portCount),
+		TtlSeconds: int64(ttl.Seconds()),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create replica spare on disk %v", diskName)
+	}
+	return api.RPCToReplicaSpare(resp), nil
 }
 
-func (c *InstanceServiceClient) InstanceDelete(dataEngine, name, instanceType, diskUUID string, cleanupRequired bool) (*api.Instance, error) {
-	if name == "" {
-		return nil, fmt.Errorf("failed to delete instance: missing required parameter name")
+// ReplicaSpareClaim takes a spare of the size on the disk. The spare then becomes a replica instance of the
+// caller named after the spare.
+func (c *InstanceServiceClient) ReplicaSpareClaim(diskName, diskUUID string, size uint64) (*api.ReplicaSpare, error) {
+	if (diskName == "" && diskUUID == "") || size == 0 {
+		return nil, fmt.Errorf("failed to claim replica spare: missing required parameter")
 	}
 
-	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
-	if !ok {
-		return nil, fmt.Errorf("failed to delete instance: invalid data engine %v", dataEngine)
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.ReplicaSpareClaim(ctx, &rpc.ReplicaSpareClaimRequest{
+		DiskName: diskName,
+		DiskUuid: diskUUID,
+		Size:     size,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim replica spare on disk %v", diskName)
 	}
+	return api.RPCToReplicaSpare(resp), nil
+}
 
+func (c *InstanceServiceClient) ReplicaSpareList() (map[string]*api.ReplicaSpare, error) {
 	client := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
 	defer cancel()
 
-	p, err := client.InstanceDelete(ctx, &rpc.InstanceDeleteRequest{
+	resp, err := client.ReplicaSpareList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list replica spares")
+	}
+	return api.RPCToReplicaSpareList(resp), nil
+}
+
+func (c *InstanceServiceClient) ReplicaSpareDelete(name string) error {
+	if name == "" {
+		return fmt.Errorf("failed to delete replica spare: missing required parameter name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err := client.ReplicaSpareDelete(ctx, &rpc.ReplicaSpareDeleteRequest{
 		Name: name,
-		Type: instanceType,
-		// nolint:all replaced with DataEngine
-		BackendStoreDriver: rpc.BackendStoreDriver(driver),
-		DataEngine:         rpc.DataEngine(driver),
-		DiskUuid:           diskUUID,
-		CleanupRequired:    cleanupRequired,
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to delete instance %v", name)
+		return errors.Wrapf(err, "failed to delete replica spare %v", name)
 	}
-	return api.RPCToInstance(p), nil
+	return nil
 }
 
-func (c *InstanceServiceClient) InstanceGet(dataEngine, name, instanceType string) (*api.Instance, error) {
+// ReplicaReadOnlyAttach exposes the replica read-only as a local block device, without attaching the volume or
+// joining the replica to an engine. The device is torn down after the TTL, or the default TTL if ttl is 0.
+func (c *InstanceServiceClient) ReplicaReadOnlyAttach(dataEngine, name, diskName string, ttl time.Duration) (*api.ReplicaReadOnlyAttachment, error) {
 	if name == "" {
-		return nil, fmt.Errorf("failed to get instance: missing required parameter name")
+		return nil, fmt.Errorf("failed to attach replica read-only: missing required parameter name")
 	}
 
 	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
 	if !ok {
-		return nil, fmt.Errorf("failed to get instance: invalid data engine %v", dataEngine)
+		return nil, fmt.Errorf("failed to attach replica read-only: invalid data engine %v", dataEngine)
 	}
 
 	client := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
 	defer cancel()
 
-	p, err := client.InstanceGet(ctx, &rpc.InstanceGetRequest{
+	resp, err := client.ReplicaReadOnlyAttach(ctx, &rpc.ReplicaReadOnlyAttachRequest{
+		Name:       name,
+		DataEngine: rpc.DataEngine(driver),
+		DiskName:   diskName,
+		TtlSeconds: int64(ttl.Seconds()),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to attach replica %v read-only", name)
+	}
+	return api.RPCToReplicaReadOnlyAttachment(resp), nil
+}
+
+func (c *InstanceServiceClient) ReplicaReadOnlyAttachmentList() (map[string]*api.ReplicaReadOnlyAttachment, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.ReplicaReadOnlyAttachmentList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list read-only replica attachments")
+	}
+	return api.RPCToReplicaReadOnlyAttachmentList(resp), nil
+}
+
+func (c *InstanceServiceClient) ReplicaReadOnlyDetach(name string) error {
+	if name == "" {
+		return fmt.Errorf("failed to detach read-only replica: missing required parameter name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err := client.ReplicaReadOnlyDetach(ctx, &rpc.ReplicaReadOnlyDetachRequest{
 		Name: name,
-		Type: instanceType,
-		// nolint:all replaced with DataEngine
-		BackendStoreDriver: rpc.BackendStoreDriver(driver),
-		DataEngine:         rpc.DataEngine(driver),
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get instance %v", name)
+		return errors.Wrapf(err, "failed to detach read-only replica %v", name)
 	}
-	return api.RPCToInstance(p), nil
+	return nil
 }
 
-func (c *InstanceServiceClient) InstanceList() (map[string]*api.Instance, error) {
+func (c *InstanceServiceClient) DeferredTaskList() ([]*api.DeferredTask, error) {
 	client := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
 	defer cancel()
 
-	instances, err := client.InstanceList(ctx, &emptypb.Empty{})
+	resp, err := client.DeferredTaskList(ctx, &emptypb.Empty{})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to list instances")
+		return nil, errors.Wrap(err, "failed to list deferred tasks")
 	}
-	return api.RPCToInstanceList(instances), nil
+	return api.RPCToDeferredTaskList(resp), nil
+}
+
+// SpdkOrphanReconcile adopts or cleans up the spdk_tgt resources owned by no v2 engine or replica, depending on
+// the action, which is adopt or cleanup. Nothing is changed on dry run. The orphan lvols are deleted on cleanup
+// only if cleanupLvols is true.
+func (c *InstanceServiceClient) SpdkOrphanReconcile(action string, dryRun, cleanupLvols bool, portCount int) ([]*api.SpdkOrphanResource, error) {
+	if action != types.SpdkOrphanActionAdopt && action != types.SpdkOrphanActionCleanup {
+		return nil, fmt.Errorf("failed to reconcile orphan SPDK resources: invalid action %v", action)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.SpdkOrphanReconcile(ctx, &rpc.SpdkOrphanReconcileRequest{
+		Action:       action,
+		DryRun:       dryRun,
+		CleanupLvols: cleanupLvols,
+		PortCount:    int32(portCount),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconcile orphan SPDK resources")
+	}
+	return api.RPCToSpdkOrphanResourceList(resp), nil
+}
+
+// StateExport returns the disks and instances of the instance manager in JSON, in the format of api.NodeState.
+func (c *InstanceServiceClient) StateExport() (string, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.StateExport(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to export node state")
+	}
+	return resp.State, nil
+}
+
+// StateImport creates the disks and instances of the state exported by StateExport. Nothing is created but the
+// results are listed if validateOnly is set.
+func (c *InstanceServiceClient) StateImport(state string, validateOnly bool) ([]*api.StateImportResult, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.StateImport(ctx, &rpc.StateImportRequest{
+		State:        state,
+		ValidateOnly: validateOnly,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import node state")
+	}
+	return api.RPCToStateImportResultList(resp), nil
+}
+
+type InstanceConvertRequest struct {
+	// SourcePath is the data directory of the v1 replica, which must not be running
+	SourcePath string
+	// Name is the name of the v2 replica to create
+	Name       string
+	VolumeName string
+	DiskName   string
+	DiskUUID   string
+	// Size defaults to the size of the v1 replica. Optional.
+	Size           uint64
+	PortCount      int
+	Labels         map[string]string
+	ExposeRequired bool
+}
+
+// InstanceConvert copies a v1 replica into a new v2 replica, and streams the progress until the complete or the
+// error state. The v1 replica is left for the caller to delete. The conversion is aborted once the context is done.
+func (c *InstanceServiceClient) InstanceConvert(ctx context.Context, req *InstanceConvertRequest) (*api.InstanceConvertStream, error) {
+	if req.SourcePath == "" || req.Name == "" || req.DiskName == "" {
+		return nil, fmt.Errorf("failed to convert instance: missing required parameters")
+	}
+
+	client := c.getControllerServiceClient()
+	stream, err := client.InstanceConvert(ctx, &rpc.InstanceConvertRequest{
+		SourcePath:     req.SourcePath,
+		Name:           req.Name,
+		VolumeName:     req.VolumeName,
+		DiskName:       req.DiskName,
+		DiskUuid:       req.DiskUUID,
+		Size:           req.Size,
+		PortCount:      int32(req.PortCount),
+		Labels:         req.Labels,
+		ExposeRequired: req.ExposeRequired,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert instance %v", req.Name)
+	}
+	return api.NewInstanceConvertStream(stream), nil
+}
+
+// PortsGet returns the ports allocated to the instances of each data engine and the free ones.
+func (c *InstanceServiceClient) PortsGet() ([]*api.DataEnginePorts, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.PortsGet(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ports")
+	}
+	return api.RPCToDataEnginePortsList(resp), nil
+}
+
+// StreamEventList returns the active and the latest ended streams of the gRPC servers of the instance manager, e.g.
+// to tell why a watch was lost.
+func (c *InstanceServiceClient) StreamEventList() (*api.StreamEventList, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.StreamEventList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list stream events")
+	}
+	return api.RPCToStreamEventList(resp), nil
 }
 
 func (c *InstanceServiceClient) InstanceLog(ctx context.Context, dataEngine, name, instanceType string) (*api.LogStream, error) {
+	return c.InstanceLogSince(ctx, dataEngine, name, instanceType, 0)
+}
+
+// InstanceLogSince streams the log lines of the instance with a sequence larger than sinceSequence. All lines
+// are streamed if sinceSequence is 0.
+func (c *InstanceServiceClient) InstanceLogSince(ctx context.Context, dataEngine, name, instanceType string, sinceSequence uint64) (*api.LogStream, error) {
+	return c.instanceLog(ctx, dataEngine, name, instanceType, sinceSequence, false, false)
+}
+
+// InstanceLogBatched is the same as InstanceLogSince, except that the server sends the lines in batched frames,
+// which are optionally compressed. It costs much less CPU on both ends than a message per line when tailing a
+// chatty instance.
+func (c *InstanceServiceClient) InstanceLogBatched(ctx context.Context, dataEngine, name, instanceType string, sinceSequence uint64, compressed bool) (*api.LogStream, error) {
+	return c.instanceLog(ctx, dataEngine, name, instanceType, sinceSequence, true, compressed)
+}
+
+func (c *InstanceServiceClient) instanceLog(ctx context.Context, dataEngine, name, instanceType string, sinceSequence uint64, batched, compressed bool) (*api.LogStream, error) {
 	if name == "" {
 		return nil, fmt.Errorf("failed to get instance: missing required parameter name")
 	}
@@ -250,6 +1213,9 @@ func (c *InstanceServiceClient) InstanceLog(ctx context.Context, dataEngine, nam
 		// nolint:all replaced with DataEngine
 		BackendStoreDriver: rpc.BackendStoreDriver(driver),
 		DataEngine:         rpc.DataEngine(driver),
+		SinceSequence:      sinceSequence,
+		Batched:            batched,
+		Compressed:         compressed,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get instance log of %v", name)
@@ -257,9 +1223,41 @@ func (c *InstanceServiceClient) InstanceLog(ctx context.Context, dataEngine, nam
 	return api.NewLogStream(stream), nil
 }
 
+// VolumeLog streams the logs of the engine and replicas of the volume on the node, interleaved by time.
+func (c *InstanceServiceClient) VolumeLog(ctx context.Context, volumeName string) (*api.VolumeLogStream, error) {
+	if volumeName == "" {
+		return nil, fmt.Errorf("failed to get volume log: missing required parameter volume name")
+	}
+
+	client := c.getControllerServiceClient()
+	stream, err := client.VolumeLog(ctx, &rpc.VolumeLogRequest{
+		VolumeName: volumeName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get volume log of %v", volumeName)
+	}
+	return api.NewVolumeLogStream(stream), nil
+}
+
 func (c *InstanceServiceClient) InstanceWatch(ctx context.Context) (*api.InstanceStream, error) {
+	return c.InstanceWatchWithSelector(ctx, "")
+}
+
+// InstanceWatchWithSelector is the same as InstanceWatch, but only watches the instances selected by the label
+// selector, e.g. "longhornvolume=vol-1,tier!=cold".
+func (c *InstanceServiceClient) InstanceWatchWithSelector(ctx context.Context, labelSelector string) (*api.InstanceStream, error) {
+	return c.InstanceWatchWithResumeToken(ctx, labelSelector, "")
+}
+
+// InstanceWatchWithResumeToken is the same as InstanceWatchWithSelector, but first replays the events missed since
+// the event of the resume token, which is the last one received by a previous watch. The events are delivered at
+// least once, so a replayed event may have been received before.
+func (c *InstanceServiceClient) InstanceWatchWithResumeToken(ctx context.Context, labelSelector, resumeToken string) (*api.InstanceStream, error) {
 	client := c.getControllerServiceClient()
-	stream, err := client.InstanceWatch(ctx, &emptypb.Empty{})
+	stream, err := client.InstanceWatch(ctx, &rpc.InstanceWatchRequest{
+		LabelSelector: labelSelector,
+		ResumeToken:   resumeToken,
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to open instance update stream")
 	}
@@ -267,6 +1265,37 @@ func (c *InstanceServiceClient) InstanceWatch(ctx context.Context) (*api.Instanc
 	return api.NewInstanceStream(stream), nil
 }
 
+// InstanceWatchFreeze holds the notifications of the instance watches during a planned bulk operation, e.g. a node
+// drain, until InstanceWatchThaw is called or the TTL passes. The watches notified in the meantime then receive a
+// single resync event. The default TTL is used if it is 0.
+func (c *InstanceServiceClient) InstanceWatchFreeze(ttl time.Duration, reason string) (*api.InstanceWatchFreezeStatus, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceWatchFreeze(ctx, &rpc.InstanceWatchFreezeRequest{
+		TtlSeconds: int64(ttl.Seconds()),
+		Reason:     reason,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to freeze instance watches")
+	}
+	return api.RPCToInstanceWatchFreezeStatus(resp), nil
+}
+
+// InstanceWatchThaw ends the freeze of the instance watches.
+func (c *InstanceServiceClient) InstanceWatchThaw() (*api.InstanceWatchFreezeStatus, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceWatchThaw(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to thaw instance watches")
+	}
+	return api.RPCToInstanceWatchFreezeStatus(resp), nil
+}
+
 func (c *InstanceServiceClient) InstanceReplace(dataEngine, name, instanceType, binary string, portCount int, args, portArgs []string, terminateSignal string) (*api.Instance, error) {
 	if name == "" || binary == "" {
 		return nil, fmt.Errorf("failed to replace instance: missing required parameter")
@@ -307,6 +1336,51 @@ func (c *InstanceServiceClient) InstanceReplace(dataEngine, name, instanceType,
 	return api.RPCToInstance(p), nil
 }
 
+// InstanceReplaceWithSpec replaces the instance with the one of the full spec, which is required for replacing a v2
+// engine. A v2 engine is replaced by the new one of another name taking over its frontend, and the terminate signal is
+// ignored.
+func (c *InstanceServiceClient) InstanceReplaceWithSpec(req *InstanceCreateRequest, terminateSignal string) (*api.Instance, error) {
+	if req.Name == "" || req.InstanceType == "" {
+		return nil, fmt.Errorf("failed to replace instance: missing required parameter")
+	}
+
+	spec, err := getInstanceSpec(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to replace instance")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	p, err := client.InstanceReplace(ctx, &rpc.InstanceReplaceRequest{
+		Spec:            spec,
+		TerminateSignal: terminateSignal,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to replace instance")
+	}
+	return api.RPCToInstance(p), nil
+}
+
+// InstanceServiceDrain has the instance manager reject the new instance creations, wait up to the timeout for the
+// operations and log streams in flight to finish, and then exit unless noExit is set. The default timeout is used if
+// it is 0. Returns whether everything finished in time.
+func (c *InstanceServiceClient) InstanceServiceDrain(timeout time.Duration, noExit bool) (bool, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout+types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.InstanceServiceDrain(ctx, &rpc.InstanceServiceDrainRequest{
+		TimeoutSeconds: int64(timeout.Seconds()),
+		NoExit:         noExit,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to drain instance service")
+	}
+	return resp.Drained, nil
+}
+
 func (c *InstanceServiceClient) VersionGet() (*meta.VersionOutput, error) {
 	client := c.getControllerServiceClient()
 	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
@@ -327,5 +1401,7 @@ func (c *InstanceServiceClient) VersionGet() (*meta.VersionOutput, error) {
 
 		InstanceManagerProxyAPIVersion:    int(resp.InstanceManagerProxyAPIVersion),
 		InstanceManagerProxyAPIMinVersion: int(resp.InstanceManagerProxyAPIMinVersion),
+
+		Topology: api.RPCToTopology(resp.Topology),
 	}, nil
 }
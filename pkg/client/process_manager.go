@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -37,6 +38,10 @@ type ProcessManagerClient struct {
 	serviceURL string
 	tlsConfig  *tls.Config
 	ProcessManagerServiceContext
+
+	// ctx bounds the calls in addition to the timeout of each call, e.g. by the deadline of the request being
+	// served. The calls are only bounded by the timeout if nil
+	ctx context.Context
 }
 
 func NewProcessManagerClient(serviceURL string, tlsConfig *tls.Config) (*ProcessManagerClient, error) {
@@ -64,6 +69,21 @@ func NewProcessManagerClient(serviceURL string, tlsConfig *tls.Config) (*Process
 	}, nil
 }
 
+// WithContext returns a copy of the client sharing the connection, whose calls are bound to the context as well.
+// Closing either closes the connection of both.
+func (c *ProcessManagerClient) WithContext(ctx context.Context) *ProcessManagerClient {
+	copied := *c
+	copied.ctx = ctx
+	return &copied
+}
+
+func (c *ProcessManagerClient) getContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 func NewProcessManagerClientWithTLS(serviceURL, caFile, certFile, keyFile, peerName string) (*ProcessManagerClient, error) {
 	tlsConfig, err := util.LoadClientTLS(caFile, certFile, keyFile, peerName)
 	if err != nil {
@@ -74,44 +94,88 @@ func NewProcessManagerClientWithTLS(serviceURL, caFile, certFile, keyFile, peerN
 }
 
 func (c *ProcessManagerClient) ProcessCreate(name, binary string, portCount int, args, portArgs []string) (*rpc.ProcessResponse, error) {
+	return c.ProcessCreateWithSidecars(name, binary, portCount, args, portArgs, nil)
+}
+
+// ProcessCreateWithSidecars creates a process along with the sidecar helper processes sharing its lifecycle.
+func (c *ProcessManagerClient) ProcessCreateWithSidecars(name, binary string, portCount int, args, portArgs []string, sidecars []*rpc.ProcessSidecarSpec) (*rpc.ProcessResponse, error) {
 	logrus.WithFields(logrus.Fields{
 		"name":      name,
 		"binary":    binary,
 		"args":      args,
 		"portCount": portCount,
 		"portArgs":  portArgs,
+		"sidecars":  len(sidecars),
 	}).Info("Creating process")
 
 	if name == "" || binary == "" {
 		return nil, fmt.Errorf("failed to start process: missing required parameter")
 	}
 
+	return c.ProcessCreateWithSpec(&rpc.ProcessSpec{
+		Name:      name,
+		Binary:    binary,
+		Args:      args,
+		PortCount: int32(portCount),
+		PortArgs:  portArgs,
+		Sidecars:  sidecars,
+	})
+}
+
+// ProcessCreateWithSpec creates a process with the full spec, e.g. including the environment variables.
+func (c *ProcessManagerClient) ProcessCreateWithSpec(spec *rpc.ProcessSpec) (*rpc.ProcessResponse, error) {
+	if spec == nil || spec.Name == "" || spec.Binary == "" {
+		return nil, fmt.Errorf("failed to start process: missing required parameter")
+	}
+
 	client := c.getControllerServiceClient()
-	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
 	defer cancel()
 
 	return client.ProcessCreate(ctx, &rpc.ProcessCreateRequest{
-		Spec: &rpc.ProcessSpec{
-			Name:      name,
-			Binary:    binary,
-			Args:      args,
-			PortCount: int32(portCount),
-			PortArgs:  portArgs,
-		},
+		Spec: spec,
 	})
 }
 
+// ProcessCreateValidate validates the spec without creating the process. The invalid fields are returned as the
+// field violations of the error, see util.GetFieldViolations.
+func (c *ProcessManagerClient) ProcessCreateValidate(spec *rpc.ProcessSpec) error {
+	if spec == nil {
+		return fmt.Errorf("failed to validate process: missing required parameter")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	_, err := client.ProcessCreate(ctx, &rpc.ProcessCreateRequest{
+		Spec:         spec,
+		ValidateOnly: true,
+	})
+	return err
+}
+
 func (c *ProcessManagerClient) ProcessDelete(name string) (*rpc.ProcessResponse, error) {
+	return c.ProcessDeleteWithStopTimeout(name, 0)
+}
+
+// ProcessDeleteWithStopTimeout deletes the process, giving it the stop timeout rather than its own to stop gracefully
+// before it is killed. The stop timeout of the process applies if 0.
+func (c *ProcessManagerClient) ProcessDeleteWithStopTimeout(name string, stopTimeout time.Duration) (*rpc.ProcessResponse, error) {
 	if name == "" {
 		return nil, fmt.Errorf("failed to delete process: missing required parameter name")
 	}
+	if stopTimeout < 0 {
+		return nil, fmt.Errorf("failed to delete process: invalid stop timeout %v", stopTimeout)
+	}
 
 	client := c.getControllerServiceClient()
-	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
 	defer cancel()
 
 	return client.ProcessDelete(ctx, &rpc.ProcessDeleteRequest{
-		Name: name,
+		Name:               name,
+		StopTimeoutSeconds: int64(stopTimeout / time.Second),
 	})
 }
 
@@ -121,7 +185,7 @@ func (c *ProcessManagerClient) ProcessGet(name string) (*rpc.ProcessResponse, er
 	}
 
 	client := c.getControllerServiceClient()
-	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
 	defer cancel()
 
 	return client.ProcessGet(ctx, &rpc.ProcessGetRequest{
@@ -129,9 +193,69 @@ func (c *ProcessManagerClient) ProcessGet(name string) (*rpc.ProcessResponse, er
 	})
 }
 
+// ProcessRefresh makes the process manager re-check the process instead of returning its last known state.
+func (c *ProcessManagerClient) ProcessRefresh(name string) (*rpc.ProcessResponse, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to refresh process: missing required parameter name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.ProcessRefresh(ctx, &rpc.ProcessRefreshRequest{
+		Name: name,
+	})
+}
+
+func (c *ProcessManagerClient) ProcessSuspend(name string) (*rpc.ProcessResponse, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to suspend process: missing required parameter name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.ProcessSuspend(ctx, &rpc.ProcessSuspendRequest{
+		Name: name,
+	})
+}
+
+// ProcessRebuildModeSet enters or leaves the rebuild mode of the process, in which it is limited by the rebuild
+// resource limits of the node.
+func (c *ProcessManagerClient) ProcessRebuildModeSet(name string, enabled bool) (*rpc.ProcessResponse, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to set rebuild mode of process: missing required parameter name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.ProcessRebuildModeSet(ctx, &rpc.ProcessRebuildModeSetRequest{
+		Name:    name,
+		Enabled: enabled,
+	})
+}
+
+func (c *ProcessManagerClient) ProcessResume(name string) (*rpc.ProcessResponse, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to resume process: missing required parameter name")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.ProcessResume(ctx, &rpc.ProcessResumeRequest{
+		Name: name,
+	})
+}
+
 func (c *ProcessManagerClient) ProcessList() (map[string]*rpc.ProcessResponse, error) {
 	client := c.getControllerServiceClient()
-	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
 	defer cancel()
 
 	resp, err := client.ProcessList(ctx, &rpc.ProcessListRequest{})
@@ -142,16 +266,43 @@ func (c *ProcessManagerClient) ProcessList() (map[string]*rpc.ProcessResponse, e
 }
 
 func (c *ProcessManagerClient) ProcessLog(ctx context.Context, name string) (*api.LogStream, error) {
+	return c.ProcessLogSince(ctx, name, 0)
+}
+
+// ProcessLogSince streams the log lines of the process with a sequence larger than sinceSequence, which allows
+// resuming a log stream from the last received line. All lines are streamed if sinceSequence is 0.
+func (c *ProcessManagerClient) ProcessLogSince(ctx context.Context, name string, sinceSequence uint64) (*api.LogStream, error) {
 	if name == "" {
 		return nil, fmt.Errorf("failed to get process: missing required parameter name")
 	}
 
-	client := c.getControllerServiceClient()
-	stream, err := client.ProcessLog(ctx, &rpc.LogRequest{
-		Name: name,
+	return c.processLog(ctx, &rpc.LogRequest{
+		Name:          name,
+		SinceSequence: sinceSequence,
+	})
+}
+
+// ProcessLogBatched is the same as ProcessLogSince, except that the server sends the lines in batched frames, which
+// are optionally compressed. It costs much less CPU on both ends than a message per line when tailing a chatty
+// process.
+func (c *ProcessManagerClient) ProcessLogBatched(ctx context.Context, name string, sinceSequence uint64, compressed bool) (*api.LogStream, error) {
+	if name == "" {
+		return nil, fmt.Errorf("failed to get process: missing required parameter name")
+	}
+
+	return c.processLog(ctx, &rpc.LogRequest{
+		Name:          name,
+		SinceSequence: sinceSequence,
+		Batched:       true,
+		Compressed:    compressed,
 	})
+}
+
+func (c *ProcessManagerClient) processLog(ctx context.Context, req *rpc.LogRequest) (*api.LogStream, error) {
+	client := c.getControllerServiceClient()
+	stream, err := client.ProcessLog(ctx, req)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get process log of %v", name)
+		return nil, errors.Wrapf(err, "failed to get process log of %v", req.Name)
 	}
 	return api.NewLogStream(stream), nil
 }
@@ -167,7 +318,26 @@ func (c *ProcessManagerClient) ProcessWatch(ctx context.Context) (*api.ProcessSt
 }
 
 func (c *ProcessManagerClient) ProcessReplace(name, binary string, portCount int, args, portArgs []string, terminateSignal string) (*rpc.ProcessResponse, error) {
-	if name == "" || binary == "" {
+	return c.ProcessReplaceWithSidecars(name, binary, portCount, args, portArgs, terminateSignal, nil)
+}
+
+// ProcessReplaceWithSidecars replaces a process along with its sidecars. The sidecars of the existing process are
+// kept if no sidecar is specified.
+func (c *ProcessManagerClient) ProcessReplaceWithSidecars(name, binary string, portCount int, args, portArgs []string, terminateSignal string, sidecars []*rpc.ProcessSidecarSpec) (*rpc.ProcessResponse, error) {
+	return c.ProcessReplaceWithSpec(&rpc.ProcessSpec{
+		Name:      name,
+		Binary:    binary,
+		Args:      args,
+		PortCount: int32(portCount),
+		PortArgs:  portArgs,
+		Sidecars:  sidecars,
+	}, terminateSignal)
+}
+
+// ProcessReplaceWithSpec replaces a process with the full spec. The sidecars and the environment variables of the
+// existing process are kept if not specified.
+func (c *ProcessManagerClient) ProcessReplaceWithSpec(spec *rpc.ProcessSpec, terminateSignal string) (*rpc.ProcessResponse, error) {
+	if spec == nil || spec.Name == "" || spec.Binary == "" {
 		return nil, fmt.Errorf("failed to start process: missing required parameter")
 	}
 	if terminateSignal != "SIGHUP" {
@@ -175,25 +345,145 @@ func (c *ProcessManagerClient) ProcessReplace(name, binary string, portCount int
 	}
 
 	client := c.getControllerServiceClient()
-	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
 	defer cancel()
 
 	return client.ProcessReplace(ctx, &rpc.ProcessReplaceRequest{
-		Spec: &rpc.ProcessSpec{
-			Name:      name,
-			Binary:    binary,
-			Args:      args,
-			PortCount: int32(portCount),
-			PortArgs:  portArgs,
-		},
+		Spec:            spec,
 		TerminateSignal: terminateSignal,
 	})
 }
 
+func (c *ProcessManagerClient) ProcessBulkDelete(names []string, concurrency int) (*rpc.ProcessBulkDeleteResponse, error) {
+	return c.ProcessBulkDeleteWithStopTimeout(names, concurrency, 0)
+}
+
+// ProcessBulkDeleteWithStopTimeout deletes the processes, giving them the stop timeout rather than their own to stop
+// gracefully before they are killed. The stop timeouts of the processes apply if 0.
+func (c *ProcessManagerClient) ProcessBulkDeleteWithStopTimeout(names []string, concurrency int, stopTimeout time.Duration) (*rpc.ProcessBulkDeleteResponse, error) {
+	if concurrency < 0 {
+		return nil, fmt.Errorf("failed to bulk delete processes: invalid concurrency %v", concurrency)
+	}
+	if stopTimeout < 0 {
+		return nil, fmt.Errorf("failed to bulk delete processes: invalid stop timeout %v", stopTimeout)
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.ProcessBulkDelete(ctx, &rpc.ProcessBulkDeleteRequest{
+		Names:              names,
+		Concurrency:        int32(concurrency),
+		StopTimeoutSeconds: int64(stopTimeout / time.Second),
+	})
+}
+
+func (c *ProcessManagerClient) ProcessBulkDeleteStatusGet(operationID string) (*rpc.ProcessBulkDeleteResponse, error) {
+	if operationID == "" {
+		return nil, fmt.Errorf("failed to get bulk delete status: missing required parameter operation ID")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.ProcessBulkDeleteStatusGet(ctx, &rpc.ProcessBulkDeleteStatusGetRequest{
+		OperationId: operationID,
+	})
+}
+
+// PortReconcile repairs the port allocation of the process manager from the managed processes and the listening
+// sockets of the node, and returns the discrepancies found. Nothing is repaired if dryRun is set.
+func (c *ProcessManagerClient) PortReconcile(dryRun bool) (*rpc.PortReconcileResponse, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	return client.PortReconcile(ctx, &rpc.PortReconcileRequest{
+		DryRun: dryRun,
+	})
+}
+
+// ProcessPortEventList returns the latest port allocations and releases of the processes.
+func (c *ProcessManagerClient) ProcessPortEventList() ([]*rpc.ProcessPortEvent, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.ProcessPortEventList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list process port events")
+	}
+	return resp.Events, nil
+}
+
+// ProcessPortEventWatch streams the port allocations and releases of the processes as they happen.
+func (c *ProcessManagerClient) ProcessPortEventWatch(ctx context.Context) (*api.ProcessPortEventStream, error) {
+	client := c.getControllerServiceClient()
+	stream, err := client.ProcessPortEventWatch(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open process port event stream")
+	}
+
+	return api.NewProcessPortEventStream(stream), nil
+}
+
+// ProcessManagerDebugGet returns the port allocation map, the health probes of the processes and the pending stops of
+// the processes, for the triage of a node.
+func (c *ProcessManagerClient) ProcessManagerDebugGet() (*rpc.ProcessManagerDebugResponse, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.ProcessManagerDebugGet(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get process manager debug state")
+	}
+	return resp, nil
+}
+
+// EngineBinaryValidate launches the engine binary in a sandbox on the node, i.e. with all the capabilities dropped
+// and in a private mount namespace, and reports whether it is compatible.
+// Each of dryRunArgs is parsed by the binary without running, and a throwaway replica is launched with the binary
+// if ioSelfTest is set.
+func (c *ProcessManagerClient) EngineBinaryValidate(binary string, dryRunArgs [][]string, ioSelfTest bool) (*rpc.EngineBinaryValidateResponse, error) {
+	if binary == "" {
+		return nil, fmt.Errorf("failed to validate engine binary: missing required parameter")
+	}
+
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	req := &rpc.EngineBinaryValidateRequest{
+		Binary:     binary,
+		IoSelfTest: ioSelfTest,
+	}
+	for _, args := range dryRunArgs {
+		req.DryRunArgs = append(req.DryRunArgs, &rpc.EngineBinaryArgs{Args: args})
+	}
+	return client.EngineBinaryValidate(ctx, req)
+}
+
+// EngineBinaryList returns the engine binaries deployed on the node, along with the checksum, the version and the
+// compatibility of each one.
+func (c *ProcessManagerClient) EngineBinaryList() (*rpc.EngineBinaryListResponse, error) {
+	client := c.getControllerServiceClient()
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
+	defer cancel()
+
+	resp, err := client.EngineBinaryList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list engine binaries")
+	}
+	return resp, nil
+}
+
 func (c *ProcessManagerClient) VersionGet() (*meta.VersionOutput, error) {
 
 	client := c.getControllerServiceClient()
-	ctx, cancel := context.WithTimeout(context.Background(), types.GRPCServiceTimeout)
+	ctx, cancel := context.WithTimeout(c.getContext(), types.GRPCServiceTimeout)
 	defer cancel()
 
 	resp, err := client.VersionGet(ctx, &emptypb.Empty{})
@@ -211,5 +501,7 @@ func (c *ProcessManagerClient) VersionGet() (*meta.VersionOutput, error) {
 
 		InstanceManagerProxyAPIVersion:    int(resp.InstanceManagerProxyAPIVersion),
 		InstanceManagerProxyAPIMinVersion: int(resp.InstanceManagerProxyAPIMinVersion),
+
+		Topology: api.RPCToTopology(resp.Topology),
 	}, nil
 }
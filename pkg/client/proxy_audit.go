@@ -0,0 +1,40 @@
+package client
+
+import (
+	"github.com/pkg/errors"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// AuditRecordList returns the audit records of the data operations on the volume in chronological order, or of
+// all volumes if volumeName is empty.
+func (c *ProxyClient) AuditRecordList(volumeName string) (records []*AuditRecord, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "%v failed to list audit records for volume %v", c.getProxyErrorPrefix(c.ServiceURL), volumeName)
+	}()
+
+	req := &rpc.AuditRecordListRequest{
+		VolumeName: volumeName,
+	}
+	resp, err := c.service.AuditRecordList(getContextWithGRPCTimeout(c.ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	records = []*AuditRecord{}
+	for _, record := range resp.Records {
+		records = append(records, &AuditRecord{
+			Timestamp:     record.Timestamp,
+			Operation:     record.Operation,
+			VolumeName:    record.VolumeName,
+			EngineName:    record.EngineName,
+			EngineAddress: record.EngineAddress,
+			DataEngine:    record.DataEngine.String(),
+			Identity:      record.Identity,
+			Authenticated: record.Authenticated,
+			Details:       record.Details,
+			ErrorMsg:      record.ErrorMsg,
+		})
+	}
+	return records, nil
+}
@@ -18,6 +18,8 @@ func (c *ProxyClient) CleanupBackupMountPoints() (err error) {
 	return nil
 }
 
+// SnapshotBackup creates the backup of the snapshot, or fails with BackupQueuedError if the backup is queued for the
+// limit of the backup target endpoint, in which case it must be called again with the same backup name.
 func (c *ProxyClient) SnapshotBackup(dataEngine, engineName, volumeName, serviceAddress, backupName,
 	snapshotName, backupTarget, backingImageName, backingImageChecksum, compressionMethod string, concurrentLimit int,
 	storageClassName string, labels map[string]string, envs []string) (backupID, replicaAddress string, err error) {
@@ -63,6 +65,9 @@ func (c *ProxyClient) SnapshotBackup(dataEngine, engineName, volumeName, service
 	if err != nil {
 		return "", "", err
 	}
+	if recv.Queued {
+		return "", "", BackupQueuedError{BackupName: backupName, Position: int(recv.QueuePosition)}
+	}
 
 	return recv.BackupId, recv.Replica, nil
 }
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"github.com/pkg/errors"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// BackupSchedulerStatusGet returns the limit, the active backups and the queued backups of the backup target
+// endpoint, or of all endpoints with backups or limits if endpoint is empty.
+func (c *ProxyClient) BackupSchedulerStatusGet(endpoint string) (statuses []*BackupTargetSchedulerStatus, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "%v failed to get backup scheduler status for endpoint %v", c.getProxyErrorPrefix(c.ServiceURL), endpoint)
+	}()
+
+	req := &rpc.BackupSchedulerStatusGetRequest{
+		Endpoint: endpoint,
+	}
+	resp, err := c.service.BackupSchedulerStatusGet(getContextWithGRPCTimeout(c.ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses = []*BackupTargetSchedulerStatus{}
+	for _, target := range resp.Targets {
+		status := &BackupTargetSchedulerStatus{
+			Endpoint:      target.Endpoint,
+			ActiveBackups: parseScheduledBackups(target.ActiveBackups),
+			QueuedBackups: parseScheduledBackups(target.QueuedBackups),
+		}
+		if target.Limit != nil {
+			status.MaxConcurrentBackups = int(target.Limit.MaxConcurrentBackups)
+			status.MaxBackupsPerMinute = int(target.Limit.MaxBackupsPerMinute)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func parseScheduledBackups(backups []*rpc.ScheduledBackup) []*ScheduledBackup {
+	result := []*ScheduledBackup{}
+	for _, backup := range backups {
+		result = append(result, &ScheduledBackup{
+			BackupName: backup.BackupName,
+			VolumeName: backup.VolumeName,
+			Since:      backup.Since,
+		})
+	}
+	return result
+}
+
+// BackupTargetLimitSet sets the limit of the backups to the backup target endpoint. Zero means unlimited. The bandwidth
+// of the backups cannot be limited, since the backup data does not go through the proxy.
+func (c *ProxyClient) BackupTargetLimitSet(endpoint string, maxConcurrentBackups, maxBackupsPerMinute int) (err error) {
+	input := map[string]string{
+		"endpoint": endpoint,
+	}
+	if err := validateProxyMethodParameters(input); err != nil {
+		return errors.Wrap(err, "failed to set backup target limit")
+	}
+
+	defer func() {
+		err = errors.Wrapf(err, "%v failed to set backup target limit for endpoint %v", c.getProxyErrorPrefix(c.ServiceURL), endpoint)
+	}()
+
+	req := &rpc.BackupTargetLimitSetRequest{
+		Endpoint: endpoint,
+		Limit: &rpc.BackupTargetLimit{
+			MaxConcurrentBackups: int32(maxConcurrentBackups),
+			MaxBackupsPerMinute:  int32(maxBackupsPerMinute),
+		},
+	}
+	_, err = c.service.BackupTargetLimitSet(getContextWithGRPCTimeout(c.ctx), req)
+	return err
+}
+
+// BackupTargetLimitReset applies the default limit to the backup target endpoint.
+func (c *ProxyClient) BackupTargetLimitReset(endpoint string) (err error) {
+	input := map[string]string{
+		"endpoint": endpoint,
+	}
+	if err := validateProxyMethodParameters(input); err != nil {
+		return errors.Wrap(err, "failed to reset backup target limit")
+	}
+
+	defer func() {
+		err = errors.Wrapf(err, "%v failed to reset backup target limit for endpoint %v", c.getProxyErrorPrefix(c.ServiceURL), endpoint)
+	}()
+
+	req := &rpc.BackupTargetLimitSetRequest{
+		Endpoint: endpoint,
+	}
+	_, err = c.service.BackupTargetLimitSet(getContextWithGRPCTimeout(c.ctx), req)
+	return err
+}
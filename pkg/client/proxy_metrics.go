@@ -1,6 +1,8 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
@@ -39,3 +41,63 @@ func (c *ProxyClient) MetricsGet(engineName, volumeName, serviceAddress string)
 		WriteLatency:    resp.Metrics.WriteLatency,
 	}, nil
 }
+
+func (c *ProxyClient) VolumeFrontendStatsGet(dataEngine, engineName, volumeName, serviceAddress string) (stats *FrontendStats, err error) {
+	input := map[string]string{
+		"engineName":     engineName,
+		"volumeName":     volumeName,
+		"serviceAddress": serviceAddress,
+	}
+	if err := validateProxyMethodParameters(input); err != nil {
+		return nil, errors.Wrap(err, "failed to get frontend stats for volume")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return nil, fmt.Errorf("failed to get frontend stats for volume: invalid data engine %v", dataEngine)
+	}
+
+	defer func() {
+		err = errors.Wrapf(err, "%v failed to get frontend stats for volume", c.getProxyErrorPrefix(serviceAddress))
+	}()
+
+	req := &rpc.ProxyEngineRequest{
+		Address:    serviceAddress,
+		EngineName: engineName,
+		// nolint:all replaced with DataEngine
+		BackendStoreDriver: rpc.BackendStoreDriver(driver),
+		DataEngine:         rpc.DataEngine(driver),
+		VolumeName:         volumeName,
+	}
+	resp, err := c.service.VolumeFrontendStatsGet(getContextWithGRPCTimeout(c.ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	stats = &FrontendStats{
+		Frontend:          resp.Frontend,
+		Endpoint:          resp.Endpoint,
+		TargetName:        resp.TargetName,
+		TargetConnections: int(resp.TargetConnections),
+		Sessions:          []*ISCSISessionStats{},
+	}
+	for _, session := range resp.Sessions {
+		stats.Sessions = append(stats.Sessions, &ISCSISessionStats{
+			SessionID:                  int(session.SessionId),
+			TargetName:                 session.TargetName,
+			Portal:                     session.Portal,
+			TxDataOctets:               session.TxDataOctets,
+			RxDataOctets:               session.RxDataOctets,
+			SCSICommandPDUs:            session.ScsiCommandPdus,
+			SCSIResponsePDUs:           session.ScsiResponsePdus,
+			DataOutPDUs:                session.DataOutPdus,
+			DataInPDUs:                 session.DataInPdus,
+			TaskManagementCommandPDUs:  session.TaskManagementCommandPdus,
+			TaskManagementResponsePDUs: session.TaskManagementResponsePdus,
+			DigestErrors:               session.DigestErrors,
+			TimeoutErrors:              session.TimeoutErrors,
+			AbortCount:                 session.AbortCount,
+		})
+	}
+	return stats, nil
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	etypes "github.com/longhorn/longhorn-engine/pkg/types"
 	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
 
@@ -267,3 +270,13 @@ func (c *ProxyClient) ReplicaModeUpdate(dataEngine, serviceAddress, replicaAddre
 
 	return nil
 }
+
+// ReplicaModeWatch streams the replica mode transitions observed by the proxy. The currently cached replica
+// modes are received first, with an empty OldMode.
+func (c *ProxyClient) ReplicaModeWatch(ctx context.Context) (*api.ReplicaModeStream, error) {
+	stream, err := c.service.ReplicaModeWatch(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v failed to open replica mode watch stream", c.getProxyErrorPrefix(c.ServiceURL))
+	}
+	return api.NewReplicaModeStream(stream), nil
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"fmt"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
+)
+
+// ProxyBackupService is the subset of the proxy API used by the backup and disaster recovery tools, which
+// ProxyClient implements. The tools can depend on it rather than on ProxyClient so that it can be faked in their
+// tests. The methods are only added to it, never changed or removed, within the same proxy API version.
+type ProxyBackupService interface {
+	VolumeGet(dataEngine, engineName, volumeName, serviceAddress string) (*etypes.VolumeInfo, error)
+
+	VolumeSnapshot(dataEngine, engineName, volumeName, serviceAddress, volumeSnapshotName string, labels map[string]string) (string, error)
+	SnapshotList(dataEngine, engineName, volumeName, serviceAddress string) (map[string]*etypes.DiskInfo, error)
+	SnapshotRemove(dataEngine, engineName, volumeName, serviceAddress string, names []string) error
+	SnapshotPurge(dataEngine, engineName, volumeName, serviceAddress string, skipIfInProgress bool) error
+	SnapshotPurgeStatus(dataEngine, engineName, volumeName, serviceAddress string) (map[string]*SnapshotPurgeStatus, error)
+
+	SnapshotBackup(dataEngine, engineName, volumeName, serviceAddress, backupName, snapshotName, backupTarget,
+		backingImageName, backingImageChecksum, compressionMethod string, concurrentLimit int, storageClassName string,
+		labels map[string]string, envs []string) (string, string, error)
+	SnapshotBackupStatus(dataEngine, engineName, volumeName, serviceAddress, backupName, replicaAddress,
+		replicaName string) (*SnapshotBackupStatus, error)
+	BackupRestore(dataEngine, engineName, volumeName, serviceAddress, url, target, backupVolumeName string,
+		envs []string, concurrentLimit int) error
+	BackupRestoreStatus(dataEngine, engineName, volumeName, serviceAddress string) (map[string]*BackupRestoreStatus, error)
+	BackupRestoreFinish(dataEngine, engineName, volumeName, serviceAddress string) error
+
+	BackupSchedulerStatusGet(endpoint string) ([]*BackupTargetSchedulerStatus, error)
+	BackupTargetLimitSet(endpoint string, maxConcurrentBackups, maxBackupsPerMinute int) error
+	BackupTargetLimitReset(endpoint string) error
+
+	AuditRecordList(volumeName string) ([]*AuditRecord, error)
+
+	Close() error
+}
+
+var _ ProxyBackupService = &ProxyClient{}
+
+// CheckProxyAPIVersion checks whether the instance manager of the version, as returned by VersionGet of the process
+// manager or instance service, supports the proxy API version this client is built with.
+func CheckProxyAPIVersion(version *meta.VersionOutput) error {
+	if meta.InstanceManagerProxyAPIVersion > version.InstanceManagerProxyAPIVersion ||
+		meta.InstanceManagerProxyAPIVersion < version.InstanceManagerProxyAPIMinVersion {
+		return fmt.Errorf("proxy API version %v is not supported by instance manager %v, which supports versions from %v to %v",
+			meta.InstanceManagerProxyAPIVersion, version.Version,
+			version.InstanceManagerProxyAPIMinVersion, version.InstanceManagerProxyAPIVersion)
+	}
+	return nil
+}
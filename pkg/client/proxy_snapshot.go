@@ -4,11 +4,15 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	etypes "github.com/longhorn/longhorn-engine/pkg/types"
 	eutil "github.com/longhorn/longhorn-engine/pkg/util"
 	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
+
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
 
@@ -220,6 +224,13 @@ func (c *ProxyClient) SnapshotCloneStatus(dataEngine, engineName, volumeName, se
 
 func (c *ProxyClient) SnapshotRevert(dataEngine, engineName, volumeName, serviceAddress string,
 	name string) (err error) {
+	return c.SnapshotRevertWithFrontendQuiesced(dataEngine, engineName, volumeName, serviceAddress, name, false)
+}
+
+// SnapshotRevertWithFrontendQuiesced is the same as SnapshotRevert, but allows the revert while the frontend is up
+// if frontendQuiesced confirms the I/O to the frontend is quiesced by the caller.
+func (c *ProxyClient) SnapshotRevertWithFrontendQuiesced(dataEngine, engineName, volumeName, serviceAddress string,
+	name string, frontendQuiesced bool) (err error) {
 	input := map[string]string{
 		"engineName":     engineName,
 		"volumeName":     volumeName,
@@ -253,7 +264,8 @@ func (c *ProxyClient) SnapshotRevert(dataEngine, engineName, volumeName, service
 			DataEngine:         rpc.DataEngine(driver),
 			VolumeName:         volumeName,
 		},
-		Name: name,
+		Name:             name,
+		FrontendQuiesced: frontendQuiesced,
 	}
 	_, err = c.service.SnapshotRevert(getContextWithGRPCTimeout(c.ctx), req)
 	if err != nil {
@@ -475,3 +487,54 @@ func (c *ProxyClient) SnapshotHashStatus(dataEngine, engineName, volumeName, ser
 
 	return status, nil
 }
+
+// VolumeSnapshotRetentionPolicySet attaches the retention policy to the volume, which the proxy enforces after each
+// snapshot of the volume created through it. A nil policy detaches the policy of the volume. The policy is kept in
+// the memory of the instance manager only, so it must be set again after the instance manager restarts.
+func (c *ProxyClient) VolumeSnapshotRetentionPolicySet(dataEngine, engineName, volumeName, serviceAddress string,
+	policy *SnapshotRetentionPolicy) (err error) {
+	input := map[string]string{
+		"volumeName": volumeName,
+	}
+	if err := validateProxyMethodParameters(input); err != nil {
+		return errors.Wrap(err, "failed to set snapshot retention policy")
+	}
+
+	driver, ok := rpc.DataEngine_value[getDataEngine(dataEngine)]
+	if !ok {
+		return fmt.Errorf("failed to set snapshot retention policy: invalid data engine %v", dataEngine)
+	}
+
+	defer func() {
+		err = errors.Wrapf(err, "%v failed to set snapshot retention policy", c.getProxyErrorPrefix(serviceAddress))
+	}()
+
+	req := &rpc.EngineVolumeSnapshotRetentionPolicySetRequest{
+		ProxyEngineRequest: &rpc.ProxyEngineRequest{
+			Address:    serviceAddress,
+			EngineName: engineName,
+			// nolint:all replaced with DataEngine
+			BackendStoreDriver: rpc.BackendStoreDriver(driver),
+			DataEngine:         rpc.DataEngine(driver),
+			VolumeName:         volumeName,
+		},
+	}
+	if policy != nil {
+		req.Policy = &rpc.SnapshotRetentionPolicy{
+			MaxCount:      int32(policy.MaxCount),
+			MaxAgeSeconds: int64(policy.MaxAge.Seconds()),
+			NamePrefix:    policy.NamePrefix,
+		}
+	}
+	_, err = c.service.VolumeSnapshotRetentionPolicySet(getContextWithGRPCTimeout(c.ctx), req)
+	return err
+}
+
+// SnapshotRetentionEventWatch streams the removals of the snapshots by the retention policies of the volumes.
+func (c *ProxyClient) SnapshotRetentionEventWatch(ctx context.Context) (*api.SnapshotRetentionEventStream, error) {
+	stream, err := c.service.SnapshotRetentionEventWatch(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v failed to open snapshot retention event watch stream", c.getProxyErrorPrefix(c.ServiceURL))
+	}
+	return api.NewSnapshotRetentionEventStream(stream), nil
+}
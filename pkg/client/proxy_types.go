@@ -1,5 +1,7 @@
 package client
 
+import "time"
+
 type SnapshotCloneStatus struct {
 	IsCloning          bool
 	Error              string
@@ -9,6 +11,18 @@ type SnapshotCloneStatus struct {
 	SnapshotName       string
 }
 
+// SnapshotRetentionPolicy has the proxy remove the oldest snapshots of a volume after each snapshot created through
+// the proxy. A limit is disabled if 0.
+type SnapshotRetentionPolicy struct {
+	// MaxCount is the snapshots kept at most, including the one just created
+	MaxCount int
+	// MaxAge is the age the snapshots are removed at, in seconds
+	MaxAge time.Duration
+	// NamePrefix limits the policy to the snapshots with names of the prefix, and names the snapshots created
+	// without a name by the prefix followed by the creation time. Required if any limit is set
+	NamePrefix string
+}
+
 type SnapshotPurgeStatus struct {
 	Error     string
 	IsPurging bool
@@ -89,3 +103,55 @@ type Metrics struct {
 	ReadIOPS        uint64
 	WriteIOPS       uint64
 }
+
+type FrontendStats struct {
+	Frontend          string
+	Endpoint          string
+	TargetName        string
+	TargetConnections int
+	Sessions          []*ISCSISessionStats
+}
+
+type ISCSISessionStats struct {
+	SessionID                  int
+	TargetName                 string
+	Portal                     string
+	TxDataOctets               int64
+	RxDataOctets               int64
+	SCSICommandPDUs            int64
+	SCSIResponsePDUs           int64
+	DataOutPDUs                int64
+	DataInPDUs                 int64
+	TaskManagementCommandPDUs  int64
+	TaskManagementResponsePDUs int64
+	DigestErrors               int64
+	TimeoutErrors              int64
+	AbortCount                 int64
+}
+
+type AuditRecord struct {
+	Timestamp     string
+	Operation     string
+	VolumeName    string
+	EngineName    string
+	EngineAddress string
+	DataEngine    string
+	Identity      string
+	Authenticated bool
+	Details       map[string]string
+	ErrorMsg      string
+}
+
+type BackupTargetSchedulerStatus struct {
+	Endpoint             string
+	MaxConcurrentBackups int
+	MaxBackupsPerMinute  int
+	ActiveBackups        []*ScheduledBackup
+	QueuedBackups        []*ScheduledBackup
+}
+
+type ScheduledBackup struct {
+	BackupName string
+	VolumeName string
+	Since      string
+}
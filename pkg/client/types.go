@@ -10,6 +10,8 @@ import (
 const (
 	dataEngineV1 = "v1"
 	dataEngineV2 = "v2"
+	// dataEngineAny looks up the instance in both data engines
+	dataEngineAny = "any"
 )
 
 type TaskError struct {
@@ -38,7 +40,22 @@ func (e ReplicaError) Error() string {
 	return fmt.Sprintf("%v: %v", e.Address, e.Message)
 }
 
+// BackupQueuedError is returned by SnapshotBackup if the backup is queued for the limit of the backup target endpoint
+// rather than created. The backup is created by calling SnapshotBackup with the same backup name again once it is
+// the turn of the backup.
+type BackupQueuedError struct {
+	BackupName string
+	Position   int
+}
+
+func (e BackupQueuedError) Error() string {
+	return fmt.Sprintf("backup %v is queued at position %v for the backup target limit", e.BackupName, e.Position)
+}
+
 func getDataEngine(dataEngine string) string {
+	if strings.ToLower(dataEngine) == dataEngineAny {
+		return rpc.DataEngine_name[int32(rpc.DataEngine_DATA_ENGINE_ANY)]
+	}
 	if strings.HasSuffix(strings.ToLower(dataEngine), dataEngineV2) {
 		return rpc.DataEngine_name[int32(rpc.DataEngine_DATA_ENGINE_V2)]
 	}
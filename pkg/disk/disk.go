@@ -12,6 +12,8 @@ import (
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
 	"github.com/longhorn/longhorn-instance-manager/pkg/types"
@@ -31,11 +33,24 @@ type DiskOps interface {
 	DiskGet(req *rpc.DiskGetRequest) (*rpc.Disk, error)
 	DiskReplicaInstanceList(*rpc.DiskReplicaInstanceListRequest) (*rpc.DiskReplicaInstanceListResponse, error)
 	DiskReplicaInstanceDelete(*rpc.DiskReplicaInstanceDeleteRequest) (*emptypb.Empty, error)
+	DiskWipe(*rpc.DiskWipeRequest, string, rpc.DiskService_DiskWipeServer) error
+	DiskRepair(*rpc.DiskRepairRequest) (*rpc.Disk, error)
+	DiskWriteCacheGet(*rpc.DiskWriteCacheGetRequest) (*rpc.DiskWriteCache, error)
+	DiskWriteCacheSet(*rpc.DiskWriteCacheSetRequest) (*rpc.DiskWriteCache, error)
+	DiskFlush(*rpc.DiskFlushRequest) (*emptypb.Empty, error)
+	DiskHotplugStatusGet(*rpc.DiskHotplugStatusGetRequest) (*rpc.DiskHotplugStatus, error)
+	DiskScrubStatusGet(*rpc.DiskScrubStatusGetRequest) (*rpc.DiskScrubStatus, error)
+	DiskScrubSet(*rpc.DiskScrubSetRequest) (*rpc.DiskScrubStatus, error)
 }
 
 type FilesystemDiskOps struct{}
 type BlockDiskOps struct {
-	spdkClient *spdkclient.SPDKClient
+	spdkClient    *spdkclient.SPDKClient
+	diskLeases    *diskLeaseTracker
+	wipingDisks   *wipingDiskTracker
+	safeModeDisks *SafeModeTracker
+	hotplugDisks  *hotplugTracker
+	scrubDisks    *scrubTracker
 }
 
 type Server struct {
@@ -44,11 +59,17 @@ type Server struct {
 	ctx           context.Context
 	HealthChecker HealthChecker
 
+	spdkEnabled        bool
 	spdkServiceAddress string
 	ops                map[rpc.DiskType]DiskOps
+
+	wipingDisks   *wipingDiskTracker
+	safeModeDisks *SafeModeTracker
+	watcher       *diskWatcher
 }
 
-func NewServer(ctx context.Context, spdkEnabled bool, spdkServiceAddress string) (srv *Server, err error) {
+func NewServer(ctx context.Context, spdkEnabled bool, spdkServiceAddress string, leaseManager *util.LeaseManager, safeModeDisks *SafeModeTracker,
+	scrubConfig *ScrubConfig) (srv *Server, err error) {
 	var spdkClient *spdkclient.SPDKClient
 
 	if spdkEnabled {
@@ -64,32 +85,80 @@ func NewServer(ctx context.Context, spdkEnabled bool, spdkServiceAddress string)
 		}
 	}
 
+	wipingDisks := newWipingDiskTracker()
+	blockDiskOps := BlockDiskOps{
+		spdkClient:    spdkClient,
+		diskLeases:    newDiskLeaseTracker(leaseManager),
+		wipingDisks:   wipingDisks,
+		safeModeDisks: safeModeDisks,
+		hotplugDisks:  newHotplugTracker(),
+		scrubDisks:    newScrubTracker(scrubConfig),
+	}
 	ops := map[rpc.DiskType]DiskOps{
 		rpc.DiskType_filesystem: FilesystemDiskOps{},
-		rpc.DiskType_block: BlockDiskOps{
-			spdkClient: spdkClient,
-		},
+		rpc.DiskType_block:      blockDiskOps,
 	}
 
 	s := &Server{
 		ctx:                ctx,
+		spdkEnabled:        spdkEnabled,
 		spdkServiceAddress: spdkServiceAddress,
 		HealthChecker:      &GRPCHealthChecker{},
 		ops:                ops,
+
+		wipingDisks:   wipingDisks,
+		safeModeDisks: safeModeDisks,
+		watcher:       newDiskWatcher(),
+	}
+	// help to kickstart the broadcaster
+	c, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := s.watcher.broadcaster.Subscribe(c, s.watcher.broadcastConnector); err != nil {
+		return nil, err
 	}
 
+	if spdkEnabled {
+		go blockDiskOps.checkLoadedLvstores()
+	}
 	go s.startMonitoring()
 
 	return s, nil
 }
 
 func (s *Server) startMonitoring() {
+	ticker := time.NewTicker(spdkMemoryStatsUpdateInterval)
+	defer ticker.Stop()
+	hotplugTicker := time.NewTicker(diskHotplugCheckInterval)
+	defer hotplugTicker.Stop()
+	scrubTicker := time.NewTicker(diskScrubCheckInterval)
+	defer scrubTicker.Stop()
+	watchTicker := time.NewTicker(diskWatchCheckInterval)
+	defer watchTicker.Stop()
+
 	done := false
 	for {
 		select {
 		case <-s.ctx.Done():
 			logrus.Infof("%s: stopped monitoring replicas due to the context done", types.DiskGrpcService)
 			done = true
+		case <-ticker.C:
+			if s.spdkEnabled {
+				s.recordSpdkMemoryMetrics()
+			}
+		case <-hotplugTicker.C:
+			if s.spdkEnabled {
+				if ops, ok := s.ops[rpc.DiskType_block].(BlockDiskOps); ok {
+					ops.checkHotplug()
+				}
+			}
+		case <-scrubTicker.C:
+			if s.spdkEnabled {
+				if ops, ok := s.ops[rpc.DiskType_block].(BlockDiskOps); ok {
+					ops.checkScrubs(s.ctx)
+				}
+			}
+		case <-watchTicker.C:
+			s.checkDiskWatches()
 		}
 		if done {
 			break
@@ -127,7 +196,12 @@ func (s *Server) DiskCreate(ctx context.Context, req *rpc.DiskCreateRequest) (*r
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
 	}
-	return ops.DiskCreate(ctx, req)
+	disk, err := ops.DiskCreate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.checkDiskWatches()
+	return disk, nil
 }
 
 func (ops FilesystemDiskOps) DiskCreate(ctx context.Context, req *rpc.DiskCreateRequest) (*rpc.Disk, error) {
@@ -135,11 +209,39 @@ func (ops FilesystemDiskOps) DiskCreate(ctx context.Context, req *rpc.DiskCreate
 }
 
 func (ops BlockDiskOps) DiskCreate(ctx context.Context, req *rpc.DiskCreateRequest) (*rpc.Disk, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := ops.wipingDisks.checkNotWiping(req.DiskPath); err != nil {
+		return nil, err
+	}
+
+	// Claim the disk before touching the lvstore, so that a second instance manager on the same node
+	// cannot manage the same disk concurrently.
+	if err := ops.diskLeases.acquire(req.DiskName, req.DiskPath); err != nil {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot claim disk %v: %v", req.DiskName, err)
+	}
+
 	ret, err := ops.spdkClient.DiskCreate(req.DiskName, req.DiskUuid, req.DiskPath, req.BlockSize)
 	if err != nil {
+		// Register an existing disk whose lvstore cannot be loaded in safe mode instead of failing, so that the
+		// corruption is visible via DiskGet and can be repaired
+		if reason := ops.getLvstoreLoadFailure(req.DiskName, req.DiskUuid); reason != "" {
+			logrus.WithError(err).Warnf("Disk Server: Registering disk %v in safe mode since %v", req.DiskName, reason)
+			ops.safeModeDisks.Set(req.DiskName, []string{reason})
+			ops.hotplugDisks.register(req.DiskName, req.DiskUuid, req.DiskPath, req.BlockSize)
+			ops.scrubDisks.register(req.DiskName, req.DiskPath)
+			return ops.getSafeModeDisk(req.DiskName, req.DiskUuid, req.DiskPath), nil
+		}
+		ops.diskLeases.release(req.DiskName)
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
 	}
-	return spdkDiskToDisk(ret), nil
+
+	ops.checkLvstore(req.DiskName)
+	ops.hotplugDisks.register(req.DiskName, ret.Uuid, req.DiskPath, ret.BlockSize)
+	ops.scrubDisks.register(req.DiskName, req.DiskPath)
+	return ops.spdkDiskToDisk(req.DiskName, ret), nil
 }
 
 func (s *Server) DiskDelete(ctx context.Context, req *rpc.DiskDeleteRequest) (*emptypb.Empty, error) {
@@ -158,7 +260,12 @@ func (s *Server) DiskDelete(ctx context.Context, req *rpc.DiskDeleteRequest) (*e
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
 	}
-	return ops.DiskDelete(req)
+	resp, err := ops.DiskDelete(req)
+	if err != nil {
+		return resp, err
+	}
+	s.checkDiskWatches()
+	return resp, nil
 }
 
 func (ops FilesystemDiskOps) DiskDelete(req *rpc.DiskDeleteRequest) (*emptypb.Empty, error) {
@@ -166,7 +273,18 @@ func (ops FilesystemDiskOps) DiskDelete(req *rpc.DiskDeleteRequest) (*emptypb.Em
 }
 
 func (ops BlockDiskOps) DiskDelete(req *rpc.DiskDeleteRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, ops.spdkClient.DiskDelete(req.DiskName, req.DiskUuid)
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	if err := ops.spdkClient.DiskDelete(req.DiskName, req.DiskUuid); err != nil {
+		return &emptypb.Empty{}, err
+	}
+	ops.safeModeDisks.Delete(req.DiskName)
+	ops.hotplugDisks.unregister(req.DiskName)
+	ops.scrubDisks.unregister(req.DiskName)
+	ops.diskLeases.release(req.DiskName)
+	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) DiskGet(ctx context.Context, req *rpc.DiskGetRequest) (*rpc.Disk, error) {
@@ -194,11 +312,18 @@ func (ops FilesystemDiskOps) DiskGet(req *rpc.DiskGetRequest) (*rpc.Disk, error)
 }
 
 func (ops BlockDiskOps) DiskGet(req *rpc.DiskGetRequest) (*rpc.Disk, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
 	ret, err := ops.spdkClient.DiskGet(req.DiskName)
 	if err != nil {
+		if len(ops.safeModeDisks.Get(req.DiskName)) != 0 {
+			return ops.getSafeModeDisk(req.DiskName, "", req.DiskPath), nil
+		}
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
 	}
-	return spdkDiskToDisk(ret), nil
+	return ops.spdkDiskToDisk(req.DiskName, ret), nil
 }
 
 func (s *Server) DiskReplicaInstanceList(ctx context.Context, req *rpc.DiskReplicaInstanceListRequest) (*rpc.DiskReplicaInstanceListResponse, error) {
@@ -225,6 +350,10 @@ func (ops FilesystemDiskOps) DiskReplicaInstanceList(req *rpc.DiskReplicaInstanc
 }
 
 func (ops BlockDiskOps) DiskReplicaInstanceList(req *rpc.DiskReplicaInstanceListRequest) (*rpc.DiskReplicaInstanceListResponse, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
 	replicas, err := ops.spdkClient.ReplicaList()
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
@@ -264,6 +393,10 @@ func (ops FilesystemDiskOps) DiskReplicaInstanceDelete(req *rpc.DiskReplicaInsta
 }
 
 func (ops BlockDiskOps) DiskReplicaInstanceDelete(req *rpc.DiskReplicaInstanceDeleteRequest) (*emptypb.Empty, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
 	err := ops.spdkClient.ReplicaDelete(req.ReplciaInstanceName, true)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
@@ -271,7 +404,116 @@ func (ops BlockDiskOps) DiskReplicaInstanceDelete(req *rpc.DiskReplicaInstanceDe
 	return &emptypb.Empty{}, nil
 }
 
-func spdkDiskToDisk(disk *spdkrpc.Disk) *rpc.Disk {
+func (s *Server) DiskWipe(req *rpc.DiskWipeRequest, srv rpc.DiskService_DiskWipeServer) error {
+	log := logrus.WithFields(logrus.Fields{
+		"diskType": req.DiskType,
+		"diskName": req.DiskName,
+		"diskPath": req.DiskPath,
+		"mode":     req.Mode,
+	})
+
+	log.Info("Disk Server: Wiping disk")
+
+	if req.DiskName == "" || req.DiskPath == "" {
+		return grpcstatus.Error(grpccodes.InvalidArgument, "disk name and disk path are required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+
+	if err := validateDiskWipeMode(req.Mode); err != nil {
+		return grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	// The device is keyed on the resolved path, so that the same device requested by different symlinks, e.g. by
+	// /dev/disk/by-id and by /dev/sdX, is not wiped twice at the same time
+	devicePath, err := resolveBlockDevice(req.DiskPath)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	if !s.wipingDisks.start(devicePath) {
+		return grpcstatus.Errorf(grpccodes.AlreadyExists, "disk %v is already being wiped", devicePath)
+	}
+	defer s.wipingDisks.finish(devicePath)
+
+	if err := ops.DiskWipe(req, devicePath, srv); err != nil {
+		log.WithError(err).Error("Disk Server: Failed to wipe disk")
+		return err
+	}
+
+	log.Info("Disk Server: Wiped disk")
+	return nil
+}
+
+func (ops FilesystemDiskOps) DiskWipe(req *rpc.DiskWipeRequest, devicePath string, srv rpc.DiskService_DiskWipeServer) error {
+	return grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+func (ops BlockDiskOps) DiskWipe(req *rpc.DiskWipeRequest, devicePath string, srv rpc.DiskService_DiskWipeServer) error {
+	release, err := ops.diskLeases.acquireForWipe(req.DiskName, devicePath)
+	if err != nil {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot claim disk %v for the wipe: %v", req.DiskName, err)
+	}
+	defer release()
+
+	if err := ops.checkDiskNotInUse(req.DiskName, devicePath); err != nil {
+		return err
+	}
+
+	wiper, err := newDiskWiper(srv.Context(), req, devicePath, srv.Send)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if err := wiper.Run(); err != nil {
+		return grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return nil
+}
+
+// checkDiskNotInUse makes sure the device is neither held by the kernel, e.g. mounted or a member of a dm or md
+// device, nor registered as a disk or backing an aio bdev in spdk_tgt, since wiping a device of an active lvstore
+// destroys all replicas on it.
+func (ops BlockDiskOps) checkDiskNotInUse(diskName, devicePath string) error {
+	if err := checkBlockDeviceNotBusy(devicePath); err != nil {
+		return err
+	}
+
+	if ops.spdkClient == nil {
+		return nil
+	}
+
+	if _, err := ops.spdkClient.DiskGet(diskName); err == nil {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "disk %v is still in use by an lvstore", diskName)
+	} else if grpcstatus.Code(err) != grpccodes.NotFound {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to check if disk %v is in use", diskName).Error())
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to create SPDK helper client").Error())
+	}
+	defer spdkHelperClient.Close()
+
+	bdevs, err := spdkHelperClient.BdevAioGet("", 0)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to list aio bdevs").Error())
+	}
+	for _, bdev := range bdevs {
+		if bdev.DriverSpecific == nil || bdev.DriverSpecific.Aio == nil {
+			continue
+		}
+		if isSameDevicePath(bdev.DriverSpecific.Aio.FileName, devicePath) {
+			return grpcstatus.Errorf(grpccodes.FailedPrecondition, "disk %v is still in use by aio bdev %v", devicePath, bdev.Name)
+		}
+	}
+	return nil
+}
+
+func (ops BlockDiskOps) spdkDiskToDisk(diskName string, disk *spdkrpc.Disk) *rpc.Disk {
+	reasons := ops.safeModeDisks.Get(diskName)
 	return &rpc.Disk{
 		Id:          disk.Id,
 		Uuid:        disk.Uuid,
@@ -283,6 +525,11 @@ func spdkDiskToDisk(disk *spdkrpc.Disk) *rpc.Disk {
 		FreeBlocks:  disk.FreeBlocks,
 		BlockSize:   disk.BlockSize,
 		ClusterSize: disk.ClusterSize,
+
+		SafeMode:        len(reasons) != 0,
+		SafeModeReasons: reasons,
+
+		Scrub: ops.scrubDisks.get(diskName),
 	}
 }
 
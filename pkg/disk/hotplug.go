@@ -0,0 +1,365 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	diskHotplugCheckInterval = 5 * time.Second
+	maxDiskHotplugEvents     = 20
+
+	DiskHotplugStateAttached       = "attached"
+	DiskHotplugStateDetached       = "detached"
+	DiskHotplugStateMismatched     = "mismatched"
+	DiskHotplugStateReattachFailed = "reattach_failed"
+
+	DiskHotplugReasonDeviceRemoved    = "DeviceRemoved"
+	DiskHotplugReasonDeviceMismatched = "DeviceMismatched"
+	DiskHotplugReasonDeviceReturned   = "DeviceReturned"
+	DiskHotplugReasonReattached       = "Reattached"
+	DiskHotplugReasonReattachFailed   = "ReattachFailed"
+)
+
+// deviceIDFiles are the sysfs files identifying the device, relative to the sysfs directory of the block device.
+var deviceIDFiles = []string{"wwid", "device/wwid", "serial", "device/serial"}
+
+type hotplugDisk struct {
+	name      string
+	uuid      string
+	path      string
+	blockSize int64
+
+	device   string
+	deviceID string
+
+	state            string
+	message          string
+	lastTransition   time.Time
+	affectedReplicas []string
+	events           []*rpc.DiskHotplugEvent
+}
+
+// hotplugTracker tracks the devices of the registered block disks, so that a disk whose device disappears is put
+// in safe mode, and its aio bdev and lvstore are reattached once the same device comes back.
+type hotplugTracker struct {
+	lock  *sync.Mutex
+	disks map[string]*hotplugDisk
+}
+
+func newHotplugTracker() *hotplugTracker {
+	return &hotplugTracker{
+		lock:  &sync.Mutex{},
+		disks: map[string]*hotplugDisk{},
+	}
+}
+
+// register starts tracking the disk. A disk registered again with the same path keeps its state and history, so
+// that a detached disk is still reattached by the check.
+func (t *hotplugTracker) register(name, uuid, path string, blockSize int64) {
+	device, deviceID := "", ""
+	if resolved, err := resolveBlockDevice(path); err == nil {
+		device = resolved
+		deviceID = getBlockDeviceID(resolved)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if d, exists := t.disks[name]; exists && d.path == path {
+		d.uuid = uuid
+		d.blockSize = blockSize
+		return
+	}
+
+	t.disks[name] = &hotplugDisk{
+		name:           name,
+		uuid:           uuid,
+		path:           path,
+		blockSize:      blockSize,
+		device:         device,
+		deviceID:       deviceID,
+		state:          DiskHotplugStateAttached,
+		lastTransition: time.Now(),
+	}
+}
+
+func (t *hotplugTracker) unregister(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.disks, name)
+}
+
+func (t *hotplugTracker) list() []hotplugDisk {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	disks := []hotplugDisk{}
+	for _, d := range t.disks {
+		disks = append(disks, *d)
+	}
+	sort.Slice(disks, func(i, j int) bool { return disks[i].name < disks[j].name })
+	return disks
+}
+
+// update applies the change to the disk if it is still tracked with the same path.
+func (t *hotplugTracker) update(name, path string, change func(d *hotplugDisk)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	d, exists := t.disks[name]
+	if !exists || d.path != path {
+		return
+	}
+	change(d)
+}
+
+func (t *hotplugTracker) get(name string) *rpc.DiskHotplugStatus {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	d, exists := t.disks[name]
+	if !exists {
+		return nil
+	}
+	events := []*rpc.DiskHotplugEvent{}
+	for _, e := range d.events {
+		events = append(events, &rpc.DiskHotplugEvent{Time: e.Time, Reason: e.Reason, Message: e.Message})
+	}
+	return &rpc.DiskHotplugStatus{
+		DiskName:           d.name,
+		DiskUuid:           d.uuid,
+		DiskPath:           d.path,
+		Device:             d.device,
+		DeviceId:           d.deviceID,
+		State:              d.state,
+		Message:            d.message,
+		LastTransitionTime: d.lastTransition.UTC().Format(time.RFC3339),
+		AffectedReplicas:   append([]string{}, d.affectedReplicas...),
+		Events:             events,
+	}
+}
+
+func (d *hotplugDisk) setState(state, reason, message string) {
+	if d.state != state {
+		d.lastTransition = time.Now()
+	}
+	d.state = state
+	d.message = message
+	d.addEvent(reason, message)
+}
+
+func (d *hotplugDisk) addEvent(reason, message string) {
+	d.events = append(d.events, &rpc.DiskHotplugEvent{
+		Time:    time.Now().UTC().Format(time.RFC3339),
+		Reason:  reason,
+		Message: message,
+	})
+	if len(d.events) > maxDiskHotplugEvents {
+		d.events = d.events[len(d.events)-maxDiskHotplugEvents:]
+	}
+}
+
+// getBlockDeviceID returns the WWID or the serial number of the block device, or an empty string if the device
+// reports neither.
+func getBlockDeviceID(path string) string {
+	sysDir, err := getBlockDeviceSysDir(path)
+	if err != nil {
+		return ""
+	}
+	for _, file := range deviceIDFiles {
+		content, err := os.ReadFile(filepath.Join(sysDir, file))
+		if err != nil {
+			continue
+		}
+		if id := strings.TrimSpace(string(content)); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// checkHotplug checks the devices of the tracked disks. A disk whose device disappears or is replaced by another
+// device is put in safe mode, so that no replica is created on it. The replicas already on the disk fail their
+// I/O until the device comes back, since spdk_tgt cannot suspend the I/O of the lvols. Once the same device comes
+// back, the aio bdev is recreated to reload the lvstore, and the disk leaves safe mode if the lvstore passes the
+// consistency check. Reattaching by the device ID only works with a disk path that follows the device, e.g.
+// /dev/disk/by-id/..., since the kernel may name the returned device differently.
+func (ops BlockDiskOps) checkHotplug() {
+	for _, d := range ops.hotplugDisks.list() {
+		device, err := resolveBlockDevice(d.path)
+		if err != nil {
+			if d.state == DiskHotplugStateAttached {
+				ops.detachHotplugDisk(d, err)
+			}
+			continue
+		}
+
+		deviceID := getBlockDeviceID(device)
+		if d.deviceID != "" && deviceID != "" && deviceID != d.deviceID {
+			if d.state != DiskHotplugStateMismatched {
+				message := fmt.Sprintf("device %v of disk path %v is %v rather than %v", device, d.path, deviceID, d.deviceID)
+				logrus.Warnf("Disk Server: Putting disk %v in safe mode since %v", d.name, message)
+				ops.safeModeDisks.Set(d.name, []string{message})
+				replicas := d.affectedReplicas
+				if d.state == DiskHotplugStateAttached {
+					replicas = ops.getDiskReplicas(d.name)
+				}
+				ops.hotplugDisks.update(d.name, d.path, func(d *hotplugDisk) {
+					d.affectedReplicas = replicas
+					d.setState(DiskHotplugStateMismatched, DiskHotplugReasonDeviceMismatched, message)
+				})
+			}
+			continue
+		}
+
+		if d.state == DiskHotplugStateAttached {
+			ops.hotplugDisks.update(d.name, d.path, func(d *hotplugDisk) {
+				d.device = device
+				if d.deviceID == "" {
+					d.deviceID = deviceID
+				}
+			})
+			continue
+		}
+		ops.reattachHotplugDisk(d, device, deviceID)
+	}
+}
+
+func (ops BlockDiskOps) detachHotplugDisk(d hotplugDisk, err error) {
+	replicas := ops.getDiskReplicas(d.name)
+	message := fmt.Sprintf("device %v of disk path %v is missing", d.device, d.path)
+	logrus.WithError(err).Warnf("Disk Server: Putting disk %v with replicas %v in safe mode since %v", d.name, replicas, message)
+
+	ops.safeModeDisks.Set(d.name, []string{message})
+	ops.hotplugDisks.update(d.name, d.path, func(d *hotplugDisk) {
+		d.affectedReplicas = replicas
+		d.setState(DiskHotplugStateDetached, DiskHotplugReasonDeviceRemoved, message)
+	})
+}
+
+func (ops BlockDiskOps) reattachHotplugDisk(d hotplugDisk, device, deviceID string) {
+	if d.state != DiskHotplugStateReattachFailed {
+		message := fmt.Sprintf("device %v of disk path %v is back", device, d.path)
+		logrus.Infof("Disk Server: Reattaching disk %v since %v", d.name, message)
+		ops.hotplugDisks.update(d.name, d.path, func(d *hotplugDisk) {
+			d.addEvent(DiskHotplugReasonDeviceReturned, message)
+		})
+	}
+
+	if err := ops.reattachDisk(d.name, d.uuid, d.path, d.blockSize); err != nil {
+		message := fmt.Sprintf("failed to reattach device %v: %v", device, err)
+		logrus.WithError(err).Warnf("Disk Server: Failed to reattach disk %v, will retry", d.name)
+		ops.hotplugDisks.update(d.name, d.path, func(d *hotplugDisk) {
+			// Only the first failure is recorded, since it is retried on every check
+			if d.state != DiskHotplugStateReattachFailed {
+				d.setState(DiskHotplugStateReattachFailed, DiskHotplugReasonReattachFailed, message)
+			}
+			d.message = message
+		})
+		return
+	}
+
+	ops.checkLvstore(d.name)
+	message := fmt.Sprintf("reattached device %v, replicas %v on the disk can be restarted", device, d.affectedReplicas)
+	logrus.Infof("Disk Server: Reattached disk %v", d.name)
+	ops.hotplugDisks.update(d.name, d.path, func(d *hotplugDisk) {
+		d.device = device
+		if d.deviceID == "" {
+			d.deviceID = deviceID
+		}
+		d.setState(DiskHotplugStateAttached, DiskHotplugReasonReattached, message)
+		d.affectedReplicas = nil
+	})
+}
+
+// reattachDisk deletes the aio bdev, which still holds the removed device, and creates the disk again to reload
+// the lvstore. The disk UUID makes sure that the same lvstore is loaded.
+func (ops BlockDiskOps) reattachDisk(diskName, diskUUID, diskPath string, blockSize int64) error {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return err
+	}
+	if err := ops.wipingDisks.checkNotWiping(diskPath); err != nil {
+		return err
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "failed to create SPDK helper client")
+	}
+	defer spdkHelperClient.Close()
+
+	bdevs, err := spdkHelperClient.BdevAioGet(diskName, 0)
+	if err == nil && len(bdevs) != 0 {
+		if _, err := spdkHelperClient.BdevAioDelete(diskName); err != nil {
+			return errors.Wrapf(err, "failed to delete aio bdev %v", diskName)
+		}
+	}
+
+	if _, err := ops.spdkClient.DiskCreate(diskName, diskUUID, diskPath, blockSize); err != nil {
+		return errors.Wrapf(err, "failed to create disk %v", diskName)
+	}
+	return nil
+}
+
+func (ops BlockDiskOps) getDiskReplicas(diskName string) []string {
+	replicas, err := ops.spdkClient.ReplicaList()
+	if err != nil {
+		logrus.WithError(err).Warnf("Disk Server: Failed to list replicas of disk %v", diskName)
+		return nil
+	}
+	names := []string{}
+	for _, replica := range replicas {
+		if replica.LvsName == diskName {
+			names = append(names, replica.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Server) DiskHotplugStatusGet(ctx context.Context, req *rpc.DiskHotplugStatusGetRequest) (*rpc.DiskHotplugStatus, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskType": req.DiskType,
+		"diskName": req.DiskName,
+	}).Trace("Disk Server: Getting disk hotplug status")
+
+	if req.DiskName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name is required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskHotplugStatusGet(req)
+}
+
+func (ops FilesystemDiskOps) DiskHotplugStatusGet(req *rpc.DiskHotplugStatusGetRequest) (*rpc.DiskHotplugStatus, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+func (ops BlockDiskOps) DiskHotplugStatusGet(req *rpc.DiskHotplugStatusGetRequest) (*rpc.DiskHotplugStatus, error) {
+	status := ops.hotplugDisks.get(req.DiskName)
+	if status == nil {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "disk %v is not registered", req.DiskName)
+	}
+	return status, nil
+}
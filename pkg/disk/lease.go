@@ -0,0 +1,100 @@
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+// diskLeaseTracker claims the disks by the devices rather than the names, so that the same device registered under
+// different names, e.g. by /dev/disk/by-id and by /dev/sdX, by two instance managers is only claimed by one of them.
+// The claimed resource of each disk is kept since a disk is deleted by the name only.
+type diskLeaseTracker struct {
+	leaseManager *util.LeaseManager
+
+	lock      *sync.Mutex
+	resources map[string]string
+}
+
+func newDiskLeaseTracker(leaseManager *util.LeaseManager) *diskLeaseTracker {
+	return &diskLeaseTracker{
+		leaseManager: leaseManager,
+
+		lock:      &sync.Mutex{},
+		resources: map[string]string{},
+	}
+}
+
+// getDiskLeaseResource returns the lease resource of the disk path, which is keyed on the path with the symlinks
+// resolved. A path not in the filesystem, e.g. the PCI address of an NVMe disk, is already canonical and used as is.
+func getDiskLeaseResource(diskPath string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(diskPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "disk:" + diskPath, nil
+		}
+		return "", errors.Wrapf(err, "failed to resolve disk path %v", diskPath)
+	}
+	resolved, err = filepath.Abs(resolved)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve disk path %v", diskPath)
+	}
+	return "disk:" + resolved, nil
+}
+
+func (t *diskLeaseTracker) acquire(diskName, diskPath string) error {
+	resource, err := getDiskLeaseResource(diskPath)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if existing, exists := t.resources[diskName]; exists && existing != resource {
+		return errors.Errorf("disk %v is already claimed with another device %v", diskName, existing)
+	}
+	if err := t.leaseManager.Acquire(resource, diskName); err != nil {
+		return err
+	}
+	t.resources[diskName] = resource
+	return nil
+}
+
+func (t *diskLeaseTracker) release(diskName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	resource, exists := t.resources[diskName]
+	if !exists {
+		return
+	}
+	if err := t.leaseManager.Release(resource, diskName); err != nil {
+		logrus.WithError(err).Warnf("Disk Server: Failed to release the claim of disk %v", diskName)
+	}
+	delete(t.resources, diskName)
+}
+
+// acquireForWipe claims the device for the wipe of the disk, so that the device can be neither registered as a disk
+// nor wiped by another instance manager until the returned function releases the claim. The claim is held apart from
+// the claims of the registered disks, so the wipe of a device registered as a disk is refused.
+func (t *diskLeaseTracker) acquireForWipe(diskName, devicePath string) (func(), error) {
+	resource, err := getDiskLeaseResource(devicePath)
+	if err != nil {
+		return nil, err
+	}
+	owner := "wipe:" + diskName
+	if err := t.leaseManager.Acquire(resource, owner); err != nil {
+		return nil, err
+	}
+	return func() {
+		if err := t.leaseManager.Release(resource, owner); err != nil {
+			logrus.WithError(err).Warnf("Disk Server: Failed to release the claim of disk %v for the wipe", diskName)
+		}
+	}, nil
+}
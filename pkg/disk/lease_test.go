@@ -0,0 +1,67 @@
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct{}
+
+var _ = Suite(&TestSuite{})
+
+func (s *TestSuite) TestDiskLeaseTracker(c *C) {
+	dir := c.MkDir()
+	device := filepath.Join(dir, "sdx")
+	c.Assert(os.WriteFile(device, nil, 0644), IsNil)
+	link := filepath.Join(dir, "by-id-disk")
+	c.Assert(os.Symlink(device, link), IsNil)
+
+	resource, err := getDiskLeaseResource(link)
+	c.Assert(err, IsNil)
+	c.Assert(resource, Equals, "disk:"+device)
+	resource, err = getDiskLeaseResource("0000:00:1e.0")
+	c.Assert(err, IsNil)
+	c.Assert(resource, Equals, "disk:0000:00:1e.0")
+
+	leaseDir := c.MkDir()
+	lm, err := util.NewLeaseManager(leaseDir)
+	c.Assert(err, IsNil)
+	anotherLM, err := util.NewLeaseManager(leaseDir)
+	c.Assert(err, IsNil)
+	leases := newDiskLeaseTracker(lm)
+	anotherLeases := newDiskLeaseTracker(anotherLM)
+
+	// The same device registered under another name by another instance manager is refused
+	c.Assert(leases.acquire("disk-1", link), IsNil)
+	c.Assert(leases.acquire("disk-1", link), IsNil)
+	c.Assert(anotherLeases.acquire("disk-2", device), ErrorMatches, ".*already claimed by disk-1.*")
+	c.Assert(leases.acquire("disk-1", filepath.Join(dir, "missing")), ErrorMatches, ".*already claimed with another device.*")
+
+	leases.release("disk-1")
+	c.Assert(anotherLeases.acquire("disk-2", device), IsNil)
+	anotherLeases.release("disk-2")
+	anotherLeases.release("disk-2")
+
+	// The wipe claims the device apart from the disks, so a registered device cannot be wiped and vice versa
+	c.Assert(leases.acquire("disk-1", link), IsNil)
+	_, err = anotherLeases.acquireForWipe("disk-1", device)
+	c.Assert(err, ErrorMatches, ".*already claimed by disk-1.*")
+	_, err = leases.acquireForWipe("disk-1", device)
+	c.Assert(err, ErrorMatches, ".*already claimed by disk-1 of this instance manager.*")
+	leases.release("disk-1")
+
+	release, err := leases.acquireForWipe("disk-1", device)
+	c.Assert(err, IsNil)
+	c.Assert(leases.acquire("disk-1", link), ErrorMatches, ".*already claimed by wipe:disk-1.*")
+	c.Assert(anotherLeases.acquire("disk-2", device), ErrorMatches, ".*already claimed by wipe:disk-1.*")
+	release()
+	c.Assert(leases.acquire("disk-1", link), IsNil)
+	leases.release("disk-1")
+}
@@ -0,0 +1,133 @@
+package disk
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	spdkMemoryStatsUpdateInterval = 30 * time.Second
+)
+
+func (s *Server) SpdkMemoryStatsGet(ctx context.Context, req *emptypb.Empty) (*rpc.SpdkMemoryStats, error) {
+	logrus.Trace("Disk Server: Getting SPDK memory stats")
+
+	if !s.spdkEnabled {
+		return nil, grpcstatus.Error(grpccodes.FailedPrecondition, "SPDK is not enabled")
+	}
+
+	stats, err := getSpdkMemoryStats(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to get SPDK memory stats").Error())
+	}
+	return stats, nil
+}
+
+// getSpdkMemoryStats collects the DPDK memory stats of spdk_tgt along with the iobuf and hugepages stats. The
+// iobuf and hugepages stats are left empty if they are not available, e.g. with an SPDK version without iobuf.
+func getSpdkMemoryStats(ctx context.Context) (*rpc.SpdkMemoryStats, error) {
+	memoryStats, err := util.GetDPDKMemoryStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &rpc.SpdkMemoryStats{}
+	for _, heap := range memoryStats.Heaps {
+		stats.Heaps = append(stats.Heaps, &rpc.SpdkMemoryHeap{
+			Id:               int32(heap.ID),
+			Name:             heap.Name,
+			HeapSize:         heap.HeapSize,
+			FreeSize:         heap.FreeSize,
+			AllocSize:        heap.AllocSize,
+			GreatestFreeSize: heap.GreatestFreeSize,
+			AllocCount:       heap.AllocCount,
+			FreeCount:        heap.FreeCount,
+			Fragmentation:    heap.Fragmentation(),
+		})
+	}
+	for _, mempool := range memoryStats.Mempools {
+		stats.Mempools = append(stats.Mempools, &rpc.SpdkMempool{
+			Name:        mempool.Name,
+			Size:        mempool.Size,
+			ElementSize: mempool.ElementSize,
+			Available:   mempool.Available,
+			InUse:       mempool.InUse(),
+		})
+	}
+
+	iobufStats, err := util.GetSPDKIobufStats(ctx)
+	if err != nil {
+		logrus.WithError(err).Debug("Disk Server: Failed to get SPDK iobuf stats")
+	}
+	for _, s := range iobufStats {
+		stats.IobufStats = append(stats.IobufStats, &rpc.SpdkIobufStats{
+			Module: s.Module,
+			SmallPool: &rpc.SpdkIobufPoolStats{
+				Cache: s.SmallPool.Cache,
+				Main:  s.SmallPool.Main,
+				Retry: s.SmallPool.Retry,
+			},
+			LargePool: &rpc.SpdkIobufPoolStats{
+				Cache: s.LargePool.Cache,
+				Main:  s.LargePool.Main,
+				Retry: s.LargePool.Retry,
+			},
+		})
+	}
+
+	hugepagesStats, err := util.GetHugepagesStats()
+	if err != nil {
+		logrus.WithError(err).Debug("Disk Server: Failed to get hugepages stats")
+	}
+	for _, s := range hugepagesStats {
+		stats.Hugepages = append(stats.Hugepages, &rpc.HugepagesStats{
+			PageSize: s.PageSize,
+			Total:    s.Total,
+			Free:     s.Free,
+			Reserved: s.Reserved,
+			Surplus:  s.Surplus,
+		})
+	}
+
+	return stats, nil
+}
+
+func (s *Server) recordSpdkMemoryMetrics() {
+	stats, err := getSpdkMemoryStats(s.ctx)
+	if err != nil {
+		logrus.WithError(err).Warn("Disk Server: Failed to get SPDK memory stats for metrics")
+		return
+	}
+
+	for _, heap := range stats.Heaps {
+		labels := map[string]string{"heap": heap.Name}
+		metrics.SetGauge(metrics.MetricSPDKMemoryHeapFreeBytes, labels, float64(heap.FreeSize))
+		metrics.SetGauge(metrics.MetricSPDKMemoryHeapFragmentation, labels, heap.Fragmentation)
+	}
+	for _, mempool := range stats.Mempools {
+		labels := map[string]string{"mempool": mempool.Name}
+		metrics.SetGauge(metrics.MetricSPDKMempoolAvailable, labels, float64(mempool.Available))
+		metrics.SetGauge(metrics.MetricSPDKMempoolInUse, labels, float64(mempool.InUse))
+	}
+	for _, iobuf := range stats.IobufStats {
+		metrics.SetGauge(metrics.MetricSPDKIobufRetries, map[string]string{"module": iobuf.Module, "pool": "small"}, float64(iobuf.SmallPool.Retry))
+		metrics.SetGauge(metrics.MetricSPDKIobufRetries, map[string]string{"module": iobuf.Module, "pool": "large"}, float64(iobuf.LargePool.Retry))
+	}
+	for _, hugepages := range stats.Hugepages {
+		labels := map[string]string{"page_size": strconv.FormatUint(hugepages.PageSize, 10)}
+		metrics.SetGauge(metrics.MetricHugepagesTotal, labels, float64(hugepages.Total))
+		metrics.SetGauge(metrics.MetricHugepagesFree, labels, float64(hugepages.Free))
+	}
+}
@@ -0,0 +1,122 @@
+package disk
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *Server) DiskRepair(ctx context.Context, req *rpc.DiskRepairRequest) (*rpc.Disk, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"diskType":            req.DiskType,
+		"diskName":            req.DiskName,
+		"diskUUID":            req.DiskUuid,
+		"diskPath":            req.DiskPath,
+		"removeDegradedLvols": req.RemoveDegradedLvols,
+	})
+
+	log.Info("Disk Server: Repairing disk")
+
+	if req.DiskName == "" || req.DiskUuid == "" || req.DiskPath == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name, disk UUID and disk path are required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskRepair(req)
+}
+
+func (ops FilesystemDiskOps) DiskRepair(req *rpc.DiskRepairRequest) (*rpc.Disk, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+// DiskRepair reloads the lvstore of the disk, which makes SPDK recover the blobstore metadata, optionally after
+// deleting the degraded lvols, and checks it again. The disk leaves safe mode only if the check passes.
+func (ops BlockDiskOps) DiskRepair(req *rpc.DiskRepairRequest) (*rpc.Disk, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	replicas, err := ops.spdkClient.ReplicaList()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to list replicas").Error())
+	}
+	for _, replica := range replicas {
+		if replica.LvsName == req.DiskName && replica.State == spdktypes.InstanceStateRunning {
+			return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot repair disk %v with running replica %v", req.DiskName, replica.Name)
+		}
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to create SPDK helper client").Error())
+	}
+	defer spdkHelperClient.Close()
+
+	blockSize := int64(0)
+	bdevs, err := spdkHelperClient.BdevAioGet(req.DiskName, 0)
+	if err == nil && len(bdevs) != 0 {
+		blockSize = int64(bdevs[0].BlockSize)
+
+		if req.RemoveDegradedLvols {
+			if err := removeDegradedLvols(spdkHelperClient, req.DiskName); err != nil {
+				return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+			}
+		}
+
+		// Deleting the aio bdev unloads the lvstore, which is loaded again by the disk creation below
+		if _, err := spdkHelperClient.BdevAioDelete(req.DiskName); err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to delete aio bdev %v", req.DiskName).Error())
+		}
+	}
+
+	ret, err := ops.spdkClient.DiskCreate(req.DiskName, req.DiskUuid, req.DiskPath, blockSize)
+	if err != nil {
+		if reason := ops.getLvstoreLoadFailure(req.DiskName, req.DiskUuid); reason != "" {
+			logrus.WithError(err).Warnf("Disk Server: Disk %v is still in safe mode since %v", req.DiskName, reason)
+			ops.safeModeDisks.Set(req.DiskName, []string{reason})
+			return ops.getSafeModeDisk(req.DiskName, req.DiskUuid, req.DiskPath), nil
+		}
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to reload disk %v", req.DiskName).Error())
+	}
+
+	problems, err := checkLvstore(spdkHelperClient, req.DiskName)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to check lvstore of disk %v", req.DiskName).Error())
+	}
+	ops.safeModeDisks.Set(req.DiskName, problems)
+	if len(problems) != 0 {
+		logrus.Warnf("Disk Server: Disk %v is still in safe mode after repair", req.DiskName)
+	} else {
+		logrus.Infof("Disk Server: Repaired disk %v", req.DiskName)
+	}
+	return ops.spdkDiskToDisk(req.DiskName, ret), nil
+}
+
+func removeDegradedLvols(spdkHelperClient *spdkhelperclient.Client, lvsName string) error {
+	lvols, err := spdkHelperClient.BdevLvolGetLvols(lvsName, "")
+	if err != nil {
+		return errors.Wrapf(err, "failed to list lvols of lvstore %v", lvsName)
+	}
+	for _, lvol := range lvols {
+		if !lvol.IsDegraded {
+			continue
+		}
+		logrus.Warnf("Disk Server: Deleting degraded lvol %v of lvstore %v", lvol.Name, lvsName)
+		if _, err := spdkHelperClient.BdevLvolDelete(lvol.UUID); err != nil {
+			return errors.Wrapf(err, "failed to delete degraded lvol %v", lvol.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	spdktypes "github.com/longhorn/go-spdk-helper/pkg/spdk/types"
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// SafeModeTracker tracks the disks whose lvstore failed the consistency check or whose device is missing. A disk
+// in safe mode stays registered so that its replicas can be inspected and salvaged, but no replica can be created
+// on it until it is repaired or its device is reattached.
+type SafeModeTracker struct {
+	lock  *sync.RWMutex
+	disks map[string][]string
+}
+
+func NewSafeModeTracker() *SafeModeTracker {
+	return &SafeModeTracker{
+		lock:  &sync.RWMutex{},
+		disks: map[string][]string{},
+	}
+}
+
+// Set puts the disk in safe mode with the problems found by the consistency check, or takes it out of safe mode
+// if there is no problem.
+func (t *SafeModeTracker) Set(diskName string, problems []string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(problems) == 0 {
+		delete(t.disks, diskName)
+		return
+	}
+	t.disks[diskName] = problems
+}
+
+func (t *SafeModeTracker) Delete(diskName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.disks, diskName)
+}
+
+// Get returns the problems of the disk, which is in safe mode if there is any.
+func (t *SafeModeTracker) Get(diskName string) []string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return append([]string{}, t.disks[diskName]...)
+}
+
+// CheckWritable returns a FailedPrecondition error if the disk is in safe mode.
+func (t *SafeModeTracker) CheckWritable(diskName string) error {
+	if t == nil {
+		return nil
+	}
+	if problems := t.Get(diskName); len(problems) != 0 {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "disk %v is in safe mode: %v",
+			diskName, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkLvstore checks the consistency of the blobstore metadata of the lvstore as reported by spdk_tgt, and
+// returns the problems found.
+func checkLvstore(c *spdkhelperclient.Client, lvsName string) ([]string, error) {
+	lvstores, err := c.BdevLvolGetLvstore(lvsName, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get lvstore %v", lvsName)
+	}
+	if len(lvstores) == 0 {
+		return nil, fmt.Errorf("cannot find lvstore %v", lvsName)
+	}
+	lvstore := lvstores[0]
+
+	problems := []string{}
+	if lvstore.FreeClusters > lvstore.TotalDataClusters {
+		problems = append(problems, fmt.Sprintf("free clusters %v exceed total data clusters %v",
+			lvstore.FreeClusters, lvstore.TotalDataClusters))
+	}
+
+	lvols, err := c.BdevLvolGetLvols(lvsName, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list lvols of lvstore %v", lvsName)
+	}
+	for _, lvol := range lvols {
+		if lvol.IsDegraded {
+			problems = append(problems, fmt.Sprintf("lvol %v is degraded", lvol.Name))
+		}
+	}
+
+	bdevs, err := c.BdevLvolGet("", 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list lvol bdevs")
+	}
+	lvolBdevs := map[string]*spdktypes.BdevDriverSpecificLvol{}
+	for _, bdev := range bdevs {
+		if bdev.DriverSpecific == nil || bdev.DriverSpecific.Lvol == nil || len(bdev.Aliases) == 0 {
+			continue
+		}
+		if bdev.DriverSpecific.Lvol.LvolStoreUUID == lvstore.UUID {
+			lvolBdevs[getLvolName(bdev.Aliases[0])] = bdev.DriverSpecific.Lvol
+		}
+	}
+
+	allocatedClusters := uint64(0)
+	for name, lvol := range lvolBdevs {
+		allocatedClusters += lvol.NumAllocatedClusters
+
+		if lvol.BaseSnapshot != "" {
+			if _, exists := lvolBdevs[lvol.BaseSnapshot]; !exists {
+				problems = append(problems, fmt.Sprintf("lvol %v references missing snapshot %v", name, lvol.BaseSnapshot))
+			}
+		}
+		for _, clone := range lvol.Clones {
+			if _, exists := lvolBdevs[clone]; !exists {
+				problems = append(problems, fmt.Sprintf("snapshot %v references missing clone %v", name, clone))
+			}
+		}
+	}
+	if lvstore.FreeClusters <= lvstore.TotalDataClusters && allocatedClusters > lvstore.TotalDataClusters-lvstore.FreeClusters {
+		// The same cluster is claimed by multiple blobs or the used cluster mask is stale
+		problems = append(problems, fmt.Sprintf("clusters allocated to lvols %v exceed used clusters %v",
+			allocatedClusters, lvstore.TotalDataClusters-lvstore.FreeClusters))
+	}
+
+	sort.Strings(problems)
+	return problems, nil
+}
+
+// getLvolName returns the lvol name of the alias <lvstore name>/<lvol name>.
+func getLvolName(alias string) string {
+	if i := strings.Index(alias, "/"); i >= 0 {
+		return alias[i+1:]
+	}
+	return alias
+}
+
+// checkLoadedLvstores checks the lvstores already loaded by spdk_tgt, e.g. after the instance manager restarts,
+// and puts the disks of the inconsistent ones in safe mode.
+func (ops BlockDiskOps) checkLoadedLvstores() {
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		logrus.WithError(err).Warn("Disk Server: Failed to create SPDK helper client to check lvstores")
+		return
+	}
+	defer spdkHelperClient.Close()
+
+	lvstores, err := spdkHelperClient.BdevLvolGetLvstore("", "")
+	if err != nil {
+		logrus.WithError(err).Warn("Disk Server: Failed to list lvstores to check")
+		return
+	}
+	for _, lvstore := range lvstores {
+		// The name of the lvstore is the same as the disk name
+		ops.checkLvstoreWithClient(spdkHelperClient, lvstore.Name)
+	}
+}
+
+// checkLvstore checks the lvstore of the disk and updates the safe mode of the disk accordingly. The disk is left
+// as it is if the check cannot be done.
+func (ops BlockDiskOps) checkLvstore(diskName string) {
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		logrus.WithError(err).Warnf("Disk Server: Failed to create SPDK helper client to check lvstore of disk %v", diskName)
+		return
+	}
+	defer spdkHelperClient.Close()
+
+	ops.checkLvstoreWithClient(spdkHelperClient, diskName)
+}
+
+func (ops BlockDiskOps) checkLvstoreWithClient(spdkHelperClient *spdkhelperclient.Client, diskName string) {
+	problems, err := checkLvstore(spdkHelperClient, diskName)
+	if err != nil {
+		logrus.WithError(err).Warnf("Disk Server: Failed to check lvstore of disk %v", diskName)
+		return
+	}
+	if len(problems) != 0 {
+		logrus.Warnf("Disk Server: Putting disk %v in safe mode since its lvstore is inconsistent: %v", diskName, strings.Join(problems, "; "))
+	}
+	ops.safeModeDisks.Set(diskName, problems)
+}
+
+// getLvstoreLoadFailure returns the reason if the aio bdev of the existing disk is created but its lvstore cannot
+// be loaded, which means the blobstore metadata is corrupted. It returns an empty string otherwise.
+func (ops BlockDiskOps) getLvstoreLoadFailure(diskName, diskUUID string) string {
+	if diskUUID == "" {
+		return ""
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		return ""
+	}
+	defer spdkHelperClient.Close()
+
+	bdevs, err := spdkHelperClient.BdevAioGet(diskName, 0)
+	if err != nil || len(bdevs) == 0 {
+		return ""
+	}
+	lvstores, err := spdkHelperClient.BdevLvolGetLvstore("", "")
+	if err != nil {
+		return ""
+	}
+	for _, lvstore := range lvstores {
+		if lvstore.BaseBdev == diskName {
+			return ""
+		}
+	}
+	return fmt.Sprintf("cannot load lvstore %v from aio bdev %v", diskUUID, diskName)
+}
+
+// getSafeModeDisk returns the disk whose lvstore cannot be loaded, so there is no info other than the safe mode.
+func (ops BlockDiskOps) getSafeModeDisk(diskName, diskUUID, diskPath string) *rpc.Disk {
+	reasons := ops.safeModeDisks.Get(diskName)
+	return &rpc.Disk{
+		Uuid: diskUUID,
+		Path: diskPath,
+		Type: rpc.DiskType_block.String(),
+
+		SafeMode:        len(reasons) != 0,
+		SafeModeReasons: reasons,
+
+		Scrub: ops.scrubDisks.get(diskName),
+	}
+}
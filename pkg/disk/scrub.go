@@ -0,0 +1,499 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	diskScrubCheckInterval = time.Minute
+	diskScrubChunkSize     = 4 << 20
+	maxDiskScrubHistory    = 10
+	maxDiskScrubEvents     = 20
+	maxDiskScrubErrors     = 64
+
+	DiskScrubStateDisabled = "disabled"
+	DiskScrubStateIdle     = "idle"
+	DiskScrubStateRunning  = "running"
+
+	DiskScrubResultCompleted = "completed"
+	DiskScrubResultFailed    = "failed"
+	DiskScrubResultAborted   = "aborted"
+
+	DiskScrubReasonStarted        = "ScrubStarted"
+	DiskScrubReasonCompleted      = "ScrubCompleted"
+	DiskScrubReasonErrorsDetected = "LatentErrorsDetected"
+	DiskScrubReasonFailed         = "ScrubFailed"
+	DiskScrubReasonAborted        = "ScrubAborted"
+	DiskScrubReasonConfigured     = "ScrubConfigured"
+)
+
+// ScrubConfig is the default schedule of the scrubs of the block disks, which can be changed per disk via
+// DiskScrubSet.
+type ScrubConfig struct {
+	// Interval between the starts of the scrubs of a disk. Scrubbing is disabled if 0
+	Interval time.Duration
+	// BandwidthBytesPerSecond caps the read rate of a scrub. Unlimited if 0
+	BandwidthBytesPerSecond int64
+}
+
+type scrubDisk struct {
+	name   string
+	path   string
+	device string
+
+	interval  time.Duration
+	bandwidth int64
+	nextRun   time.Time
+
+	// cancel stops the running scrub, and is nil if no scrub is running
+	cancel       context.CancelFunc
+	scannedBytes int64
+	totalBytes   int64
+
+	history []*rpc.DiskScrubResult
+	events  []*rpc.DiskScrubEvent
+}
+
+// scrubTracker schedules the scrubs of the registered block disks. A scrub reads the whole device with direct
+// I/O, so that the latent sector errors are found before a rebuild or a restore needs the data on them.
+type scrubTracker struct {
+	lock   *sync.Mutex
+	config ScrubConfig
+	disks  map[string]*scrubDisk
+}
+
+func newScrubTracker(config *ScrubConfig) *scrubTracker {
+	t := &scrubTracker{
+		lock:  &sync.Mutex{},
+		disks: map[string]*scrubDisk{},
+	}
+	if config != nil {
+		t.config = *config
+	}
+	return t
+}
+
+// register starts tracking the disk with the default schedule. A disk registered again with the same path keeps
+// its schedule and history.
+func (t *scrubTracker) register(name, path string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if d, exists := t.disks[name]; exists && d.path == path {
+		return
+	}
+	if d, exists := t.disks[name]; exists && d.cancel != nil {
+		d.cancel()
+	}
+
+	d := &scrubDisk{
+		name:      name,
+		path:      path,
+		interval:  t.config.Interval,
+		bandwidth: t.config.BandwidthBytesPerSecond,
+	}
+	d.schedule(time.Now())
+	t.disks[name] = d
+}
+
+func (t *scrubTracker) unregister(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if d, exists := t.disks[name]; exists && d.cancel != nil {
+		d.cancel()
+	}
+	delete(t.disks, name)
+}
+
+// set changes the schedule of the disk. The running scrub keeps its bandwidth cap.
+func (t *scrubTracker) set(name string, interval time.Duration, bandwidth int64, startNow bool) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	d, exists := t.disks[name]
+	if !exists {
+		return grpcstatus.Errorf(grpccodes.NotFound, "disk %v is not registered", name)
+	}
+	d.interval = interval
+	d.bandwidth = bandwidth
+	d.schedule(time.Now())
+	if startNow {
+		d.nextRun = time.Now()
+	}
+	d.addEvent(DiskScrubReasonConfigured, fmt.Sprintf("scrub interval is set to %v and bandwidth to %v bytes per second", interval, bandwidth))
+	return nil
+}
+
+// start marks the due disks as running and returns them. A disk whose scrub is still running when it is due again
+// is skipped until the scrub finishes.
+func (t *scrubTracker) start(ctx context.Context, now time.Time) []scrubJob {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	jobs := []scrubJob{}
+	for _, d := range t.disks {
+		if d.cancel != nil || d.nextRun.IsZero() || now.Before(d.nextRun) {
+			continue
+		}
+		jobCtx, cancel := context.WithCancel(ctx)
+		d.cancel = cancel
+		d.scannedBytes = 0
+		d.totalBytes = 0
+		jobs = append(jobs, scrubJob{
+			ctx:       jobCtx,
+			name:      d.name,
+			path:      d.path,
+			bandwidth: d.bandwidth,
+		})
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].name < jobs[j].name })
+	return jobs
+}
+
+// update applies the change to the disk if it is still tracked with the same path.
+func (t *scrubTracker) update(name, path string, change func(d *scrubDisk)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	d, exists := t.disks[name]
+	if !exists || d.path != path {
+		return
+	}
+	change(d)
+}
+
+func (t *scrubTracker) get(name string) *rpc.DiskScrubStatus {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	d, exists := t.disks[name]
+	if !exists {
+		return nil
+	}
+
+	status := &rpc.DiskScrubStatus{
+		DiskName:                d.name,
+		DiskPath:                d.path,
+		Device:                  d.device,
+		IntervalSeconds:         int64(d.interval / time.Second),
+		BandwidthBytesPerSecond: d.bandwidth,
+		State:                   DiskScrubStateIdle,
+		ScannedBytes:            d.scannedBytes,
+		TotalBytes:              d.totalBytes,
+		Errors:                  []*rpc.DiskScrubError{},
+		History:                 []*rpc.DiskScrubResult{},
+		Events:                  []*rpc.DiskScrubEvent{},
+	}
+	switch {
+	case d.cancel != nil:
+		status.State = DiskScrubStateRunning
+	case d.nextRun.IsZero():
+		status.State = DiskScrubStateDisabled
+	}
+	if !d.nextRun.IsZero() {
+		status.NextRunTime = d.nextRun.UTC().Format(time.RFC3339)
+	}
+
+	for i := len(d.history) - 1; i >= 0; i-- {
+		if d.history[i].State == DiskScrubResultCompleted {
+			status.Errors = copyDiskScrubErrors(d.history[i].Errors)
+			status.ErrorsDetected = len(status.Errors) != 0
+			break
+		}
+	}
+	for _, r := range d.history {
+		status.History = append(status.History, &rpc.DiskScrubResult{
+			StartTime:    r.StartTime,
+			EndTime:      r.EndTime,
+			State:        r.State,
+			ScannedBytes: r.ScannedBytes,
+			TotalBytes:   r.TotalBytes,
+			Errors:       copyDiskScrubErrors(r.Errors),
+			Message:      r.Message,
+		})
+	}
+	for _, e := range d.events {
+		status.Events = append(status.Events, &rpc.DiskScrubEvent{Time: e.Time, Reason: e.Reason, Message: e.Message})
+	}
+	return status
+}
+
+func copyDiskScrubErrors(errs []*rpc.DiskScrubError) []*rpc.DiskScrubError {
+	copied := []*rpc.DiskScrubError{}
+	for _, e := range errs {
+		copied = append(copied, &rpc.DiskScrubError{Offset: e.Offset, Length: e.Length, Message: e.Message})
+	}
+	return copied
+}
+
+// schedule sets the next run an interval after now, or disables the scrubs if the interval is 0.
+func (d *scrubDisk) schedule(now time.Time) {
+	if d.interval <= 0 {
+		d.nextRun = time.Time{}
+		return
+	}
+	d.nextRun = now.Add(d.interval)
+}
+
+func (d *scrubDisk) finish(result *rpc.DiskScrubResult, reason string) {
+	d.cancel = nil
+	d.schedule(time.Now())
+
+	d.history = append(d.history, result)
+	if len(d.history) > maxDiskScrubHistory {
+		d.history = d.history[len(d.history)-maxDiskScrubHistory:]
+	}
+	d.addEvent(reason, result.Message)
+}
+
+func (d *scrubDisk) addEvent(reason, message string) {
+	d.events = append(d.events, &rpc.DiskScrubEvent{
+		Time:    time.Now().UTC().Format(time.RFC3339),
+		Reason:  reason,
+		Message: message,
+	})
+	if len(d.events) > maxDiskScrubEvents {
+		d.events = d.events[len(d.events)-maxDiskScrubEvents:]
+	}
+}
+
+type scrubJob struct {
+	ctx       context.Context
+	name      string
+	path      string
+	bandwidth int64
+}
+
+// checkScrubs starts the scrubs of the due disks. The disks whose device is missing are skipped, and retried on
+// the next schedule.
+func (ops BlockDiskOps) checkScrubs(ctx context.Context) {
+	for _, job := range ops.scrubDisks.start(ctx, time.Now()) {
+		if status := ops.hotplugDisks.get(job.name); status != nil && status.State != DiskHotplugStateAttached {
+			ops.finishScrub(job, "", &rpc.DiskScrubResult{
+				StartTime: time.Now().UTC().Format(time.RFC3339),
+				EndTime:   time.Now().UTC().Format(time.RFC3339),
+				State:     DiskScrubResultAborted,
+				Errors:    []*rpc.DiskScrubError{},
+				Message:   fmt.Sprintf("skipped scrubbing disk %v since its device is %v", job.name, status.State),
+			}, DiskScrubReasonAborted)
+			continue
+		}
+		go ops.scrub(job)
+	}
+}
+
+func (ops BlockDiskOps) scrub(job scrubJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"diskName":  job.name,
+		"diskPath":  job.path,
+		"bandwidth": job.bandwidth,
+	})
+
+	result := &rpc.DiskScrubResult{
+		StartTime: time.Now().UTC().Format(time.RFC3339),
+		Errors:    []*rpc.DiskScrubError{},
+	}
+
+	device, err := resolveBlockDevice(job.path)
+	if err != nil {
+		result.EndTime = time.Now().UTC().Format(time.RFC3339)
+		result.State = DiskScrubResultFailed
+		result.Message = err.Error()
+		log.WithError(err).Warn("Disk Server: Failed to scrub disk")
+		ops.finishScrub(job, "", result, DiskScrubReasonFailed)
+		return
+	}
+
+	log.Infof("Disk Server: Scrubbing device %v of disk", device)
+	ops.scrubDisks.update(job.name, job.path, func(d *scrubDisk) {
+		d.device = device
+		d.addEvent(DiskScrubReasonStarted, fmt.Sprintf("started scrubbing device %v", device))
+	})
+
+	progress := func(scanned, total int64) {
+		ops.scrubDisks.update(job.name, job.path, func(d *scrubDisk) {
+			d.scannedBytes = scanned
+			d.totalBytes = total
+		})
+	}
+	result.ScannedBytes, result.TotalBytes, result.Errors, err = scrubDevice(job.ctx, device, job.bandwidth, progress)
+	result.EndTime = time.Now().UTC().Format(time.RFC3339)
+
+	reason := DiskScrubReasonCompleted
+	switch {
+	case errors.Is(err, context.Canceled):
+		result.State = DiskScrubResultAborted
+		result.Message = fmt.Sprintf("aborted scrubbing device %v after %v of %v bytes", device, result.ScannedBytes, result.TotalBytes)
+		reason = DiskScrubReasonAborted
+		log.Info("Disk Server: Aborted scrubbing disk")
+	case err != nil:
+		result.State = DiskScrubResultFailed
+		result.Message = err.Error()
+		reason = DiskScrubReasonFailed
+		log.WithError(err).Warn("Disk Server: Failed to scrub disk")
+	case len(result.Errors) != 0:
+		result.State = DiskScrubResultCompleted
+		result.Message = fmt.Sprintf("found %v unreadable ranges on device %v", len(result.Errors), device)
+		reason = DiskScrubReasonErrorsDetected
+		log.Warnf("Disk Server: Scrubbing disk %v found latent errors: %v", job.name, result.Errors)
+		metrics.AddCounter(metrics.MetricDiskScrubErrors, map[string]string{"disk": job.name}, float64(len(result.Errors)))
+	default:
+		result.State = DiskScrubResultCompleted
+		result.Message = fmt.Sprintf("scrubbed %v bytes of device %v without errors", result.ScannedBytes, device)
+		log.Info("Disk Server: Scrubbed disk")
+	}
+	ops.finishScrub(job, device, result, reason)
+}
+
+func (ops BlockDiskOps) finishScrub(job scrubJob, device string, result *rpc.DiskScrubResult, reason string) {
+	ops.scrubDisks.update(job.name, job.path, func(d *scrubDisk) {
+		if device != "" {
+			d.device = device
+		}
+		d.finish(result, reason)
+	})
+}
+
+// scrubDevice reads the whole device with direct I/O at most at the bandwidth, and returns the unreadable ranges.
+// A chunk failing to be read is read again by the logical block to locate the bad blocks. The unallocated
+// clusters of the lvstore are read as well, since spdk_tgt does not expose where the clusters of the lvols are on
+// the device.
+func scrubDevice(ctx context.Context, device string, bandwidth int64, progress func(scanned, total int64)) (int64, int64, []*rpc.DiskScrubError, error) {
+	scrubErrors := []*rpc.DiskScrubError{}
+
+	f, err := os.OpenFile(device, unix.O_RDONLY|unix.O_DIRECT, 0)
+	if err != nil {
+		return 0, 0, scrubErrors, errors.Wrapf(err, "failed to open device %v", device)
+	}
+	defer f.Close()
+	fd := int(f.Fd())
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, 0, scrubErrors, errors.Wrapf(err, "failed to get the size of device %v", device)
+	}
+	blockSize, err := unix.IoctlGetInt(fd, unix.BLKSSZGET)
+	if err != nil {
+		return 0, size, scrubErrors, errors.Wrapf(err, "failed to get the logical block size of device %v", device)
+	}
+
+	// The direct I/O requires an aligned buffer, which an anonymous mapping is
+	buf, err := unix.Mmap(-1, 0, diskScrubChunkSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return 0, size, scrubErrors, errors.Wrap(err, "failed to allocate the I/O buffer")
+	}
+	defer unix.Munmap(buf)
+
+	addError := func(offset, length int64, err error) {
+		if n := len(scrubErrors); n != 0 && scrubErrors[n-1].Offset+scrubErrors[n-1].Length == offset {
+			scrubErrors[n-1].Length += length
+			return
+		}
+		if len(scrubErrors) < maxDiskScrubErrors {
+			scrubErrors = append(scrubErrors, &rpc.DiskScrubError{Offset: offset, Length: length, Message: err.Error()})
+		}
+	}
+
+	throttle := util.NewBandwidthThrottle(bandwidth)
+	offset := int64(0)
+	for offset < size {
+		length := int64(diskScrubChunkSize)
+		if size-offset < length {
+			length = size - offset
+		}
+		if _, err := unix.Pread(fd, buf[:length], offset); err != nil {
+			for block := offset; block < offset+length; block += int64(blockSize) {
+				if _, err := unix.Pread(fd, buf[:blockSize], block); err != nil {
+					addError(block, int64(blockSize), err)
+				}
+			}
+		}
+		offset += length
+		progress(offset, size)
+
+		if err := throttle.Wait(ctx, length); err != nil {
+			return offset, size, scrubErrors, err
+		}
+	}
+	return offset, size, scrubErrors, nil
+}
+
+func (s *Server) DiskScrubStatusGet(ctx context.Context, req *rpc.DiskScrubStatusGetRequest) (*rpc.DiskScrubStatus, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskType": req.DiskType,
+		"diskName": req.DiskName,
+	}).Trace("Disk Server: Getting disk scrub status")
+
+	if req.DiskName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name is required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskScrubStatusGet(req)
+}
+
+func (ops FilesystemDiskOps) DiskScrubStatusGet(req *rpc.DiskScrubStatusGetRequest) (*rpc.DiskScrubStatus, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+func (ops BlockDiskOps) DiskScrubStatusGet(req *rpc.DiskScrubStatusGetRequest) (*rpc.DiskScrubStatus, error) {
+	status := ops.scrubDisks.get(req.DiskName)
+	if status == nil {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "disk %v is not registered", req.DiskName)
+	}
+	return status, nil
+}
+
+func (s *Server) DiskScrubSet(ctx context.Context, req *rpc.DiskScrubSetRequest) (*rpc.DiskScrubStatus, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskType":                req.DiskType,
+		"diskName":                req.DiskName,
+		"intervalSeconds":         req.IntervalSeconds,
+		"bandwidthBytesPerSecond": req.BandwidthBytesPerSecond,
+		"startNow":                req.StartNow,
+	}).Info("Disk Server: Setting disk scrub schedule")
+
+	if req.DiskName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name is required")
+	}
+	if req.IntervalSeconds < 0 || req.BandwidthBytesPerSecond < 0 {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "scrub interval and bandwidth cannot be negative")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskScrubSet(req)
+}
+
+func (ops FilesystemDiskOps) DiskScrubSet(req *rpc.DiskScrubSetRequest) (*rpc.DiskScrubStatus, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+func (ops BlockDiskOps) DiskScrubSet(req *rpc.DiskScrubSetRequest) (*rpc.DiskScrubStatus, error) {
+	if err := ops.scrubDisks.set(req.DiskName, time.Duration(req.IntervalSeconds)*time.Second, req.BandwidthBytesPerSecond, req.StartNow); err != nil {
+		return nil, err
+	}
+	return ops.scrubDisks.get(req.DiskName), nil
+}
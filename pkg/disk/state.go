@@ -0,0 +1,26 @@
+package disk
+
+import (
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// DiskStateList returns the requests creating the registered block disks as they are, for the export of the node
+// state. The disks registered in safe mode are included, since they are created again the same way.
+func (s *Server) DiskStateList() []*rpc.DiskCreateRequest {
+	blockDiskOps, ok := s.ops[rpc.DiskType_block].(BlockDiskOps)
+	if !ok || !s.spdkEnabled {
+		return []*rpc.DiskCreateRequest{}
+	}
+
+	disks := []*rpc.DiskCreateRequest{}
+	for _, d := range blockDiskOps.hotplugDisks.list() {
+		disks = append(disks, &rpc.DiskCreateRequest{
+			DiskType:  rpc.DiskType_block,
+			DiskName:  d.name,
+			DiskUuid:  d.uuid,
+			DiskPath:  d.path,
+			BlockSize: d.blockSize,
+		})
+	}
+	return disks
+}
@@ -0,0 +1,275 @@
+package disk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util/broadcaster"
+)
+
+const (
+	diskWatchCheckInterval = 5 * time.Second
+
+	DiskWatchEventTypeCreated            = "created"
+	DiskWatchEventTypeDeleted            = "deleted"
+	DiskWatchEventTypeIOErrorsIncreased  = "io_errors_increased"
+	DiskWatchEventTypeHealthScoreChanged = "health_score_changed"
+	DiskWatchEventTypeDeviceChanged      = "device_changed"
+
+	diskHealthScoreMax             = 100
+	diskHealthScoreSafeModePenalty = 50
+	diskHealthScoreScrubPenalty    = 25
+	diskHealthScoreIOErrorPenalty  = 25
+)
+
+type diskWatchState struct {
+	uuid        string
+	path        string
+	device      string
+	ioErrors    uint64
+	healthScore int32
+}
+
+// diskWatcher turns the periodic checks of the disks into the events of the disk watches, by diffing the states of
+// the disks against the ones of the previous check.
+type diskWatcher struct {
+	lock  *sync.Mutex
+	disks map[string]diskWatchState
+
+	broadcaster *broadcaster.Broadcaster
+	broadcastCh chan interface{}
+}
+
+func newDiskWatcher() *diskWatcher {
+	return &diskWatcher{
+		lock:        &sync.Mutex{},
+		disks:       map[string]diskWatchState{},
+		broadcaster: &broadcaster.Broadcaster{},
+		broadcastCh: make(chan interface{}),
+	}
+}
+
+func (w *diskWatcher) broadcastConnector() (chan interface{}, error) {
+	return w.broadcastCh, nil
+}
+
+// update broadcasts the changes from the previous states of the disks to the new ones.
+func (w *diskWatcher) update(disks map[string]diskWatchState) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	events := diffDiskWatchStates(w.disks, disks, time.Now().UTC().Format(time.RFC3339))
+	w.disks = disks
+	for _, event := range events {
+		w.broadcastCh <- interface{}(event)
+	}
+}
+
+// subscribe returns the created events of the current disks along with the channel of the later events, so that no
+// change is lost or reported twice in between.
+func (w *diskWatcher) subscribe(srv rpc.DiskService_DiskWatchServer) ([]*rpc.DiskWatchEvent, <-chan interface{}, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	events, err := w.broadcaster.Subscribe(srv.Context(), w.broadcastConnector)
+	if err != nil {
+		return nil, nil, err
+	}
+	return diffDiskWatchStates(map[string]diskWatchState{}, w.disks, time.Now().UTC().Format(time.RFC3339)), events, nil
+}
+
+// diffDiskWatchStates returns the events turning the old states of the disks into the new ones, sorted by disk.
+func diffDiskWatchStates(oldDisks, newDisks map[string]diskWatchState, timestamp string) []*rpc.DiskWatchEvent {
+	events := []*rpc.DiskWatchEvent{}
+	for name, d := range newDisks {
+		old, exists := oldDisks[name]
+		if !exists || old.uuid != d.uuid || old.path != d.path {
+			if exists {
+				events = append(events, newDiskWatchEvent(DiskWatchEventTypeDeleted, name, old, old, timestamp))
+			}
+			events = append(events, newDiskWatchEvent(DiskWatchEventTypeCreated, name, d, d, timestamp))
+			continue
+		}
+		if old.device != d.device {
+			events = append(events, newDiskWatchEvent(DiskWatchEventTypeDeviceChanged, name, old, d, timestamp))
+		}
+		if d.ioErrors > old.ioErrors {
+			events = append(events, newDiskWatchEvent(DiskWatchEventTypeIOErrorsIncreased, name, old, d, timestamp))
+		}
+		if old.healthScore != d.healthScore {
+			events = append(events, newDiskWatchEvent(DiskWatchEventTypeHealthScoreChanged, name, old, d, timestamp))
+		}
+	}
+	for name, old := range oldDisks {
+		if _, exists := newDisks[name]; !exists {
+			events = append(events, newDiskWatchEvent(DiskWatchEventTypeDeleted, name, old, old, timestamp))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].DiskName < events[j].DiskName })
+	return events
+}
+
+func newDiskWatchEvent(eventType, name string, old, d diskWatchState, timestamp string) *rpc.DiskWatchEvent {
+	return &rpc.DiskWatchEvent{
+		EventType:      eventType,
+		DiskName:       name,
+		DiskUuid:       d.uuid,
+		DiskPath:       d.path,
+		OldDevice:      old.device,
+		NewDevice:      d.device,
+		OldIoErrors:    old.ioErrors,
+		NewIoErrors:    d.ioErrors,
+		OldHealthScore: old.healthScore,
+		NewHealthScore: d.healthScore,
+		Timestamp:      timestamp,
+	}
+}
+
+// getDiskWatchStates returns the states of the registered block disks.
+func (ops BlockDiskOps) getDiskWatchStates() map[string]diskWatchState {
+	disks := map[string]diskWatchState{}
+	for _, d := range ops.hotplugDisks.list() {
+		state := diskWatchState{
+			uuid:   d.uuid,
+			path:   d.path,
+			device: d.device,
+		}
+		if d.device != "" {
+			ioErrors, err := getBlockDeviceIOErrors(d.device)
+			if err != nil {
+				logrus.WithError(err).Debugf("Disk Server: Failed to get the I/O errors of disk %v", d.name)
+			}
+			state.ioErrors = ioErrors
+		}
+
+		scrubErrorsDetected := false
+		if scrub := ops.scrubDisks.get(d.name); scrub != nil {
+			scrubErrorsDetected = scrub.ErrorsDetected
+		}
+		state.healthScore = getDiskHealthScore(d.state, len(ops.safeModeDisks.Get(d.name)) != 0, scrubErrorsDetected, state.ioErrors)
+		disks[d.name] = state
+	}
+	return disks
+}
+
+func getDiskHealthScore(hotplugState string, safeMode, scrubErrorsDetected bool, ioErrors uint64) int32 {
+	if hotplugState != DiskHotplugStateAttached {
+		return 0
+	}
+	score := int32(diskHealthScoreMax)
+	if safeMode {
+		score -= diskHealthScoreSafeModePenalty
+	}
+	if scrubErrorsDetected {
+		score -= diskHealthScoreScrubPenalty
+	}
+	if ioErrors > 0 {
+		score -= diskHealthScoreIOErrorPenalty
+	}
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
+
+// getBlockDeviceIOErrors returns the I/O errors counted by the SCSI layer for the device, or 0 if the driver of the
+// device does not count them, e.g. NVMe.
+func getBlockDeviceIOErrors(device string) (uint64, error) {
+	sysDir, err := getBlockDeviceSysDir(device)
+	if err != nil {
+		return 0, err
+	}
+	content, err := os.ReadFile(filepath.Join(sysDir, "device", "ioerr_cnt"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	// The count is in hexadecimal, e.g. 0x1f
+	value := strings.TrimPrefix(strings.TrimSpace(string(content)), "0x")
+	count, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid I/O error count %q of device %v", content, device)
+	}
+	return count, nil
+}
+
+// checkDiskWatches reports the changes of the disks to the watches.
+func (s *Server) checkDiskWatches() {
+	if !s.spdkEnabled {
+		return
+	}
+	ops, ok := s.ops[rpc.DiskType_block].(BlockDiskOps)
+	if !ok {
+		return
+	}
+	s.watcher.update(ops.getDiskWatchStates())
+}
+
+// DiskWatch streams the changes of the block disks, so that the clients do not need to poll DiskGet. A stream too
+// slow to keep up with the events is closed with Unavailable, after which the client should watch again.
+func (s *Server) DiskWatch(req *rpc.DiskWatchRequest, srv rpc.DiskService_DiskWatchServer) error {
+	log := logrus.WithFields(logrus.Fields{
+		"diskType": req.DiskType,
+		"diskName": req.DiskName,
+	})
+	log.Info("Disk Server: Start watching disks")
+	defer log.Info("Disk Server: Stopped watching disks")
+
+	if req.DiskType != rpc.DiskType_block {
+		return grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	if !s.spdkEnabled {
+		return grpcstatus.Error(grpccodes.FailedPrecondition, "SPDK is not enabled")
+	}
+
+	initialEvents, events, err := s.watcher.subscribe(srv)
+	if err != nil {
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to subscribe the disk events: %v", err)
+	}
+
+	send := func(event *rpc.DiskWatchEvent) error {
+		if req.DiskName != "" && event.DiskName != req.DiskName {
+			return nil
+		}
+		return srv.Send(event)
+	}
+
+	for _, event := range initialEvents {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case item, ok := <-events:
+			if !ok {
+				if srv.Context().Err() != nil {
+					return nil
+				}
+				return grpcstatus.Error(grpccodes.Unavailable, "disk watch is closed since it cannot keep up with the events")
+			}
+			event, ok := item.(*rpc.DiskWatchEvent)
+			if !ok {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
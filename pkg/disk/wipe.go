@@ -0,0 +1,244 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	DiskWipeStateInProgress = "in-progress"
+	DiskWipeStateComplete   = "complete"
+	DiskWipeStateError      = "error"
+
+	diskWipeCommandTimeout   = 24 * time.Hour
+	diskWipeChunkSize        = 4 << 20
+	diskWipeProgressInterval = 1 << 30
+
+	binaryBlkdiscard = "blkdiscard"
+	binaryNvme       = "nvme"
+)
+
+type diskWiper struct {
+	ctx  context.Context
+	req  *rpc.DiskWipeRequest
+	path string
+	size int64
+	send func(*rpc.DiskWipeProgress) error
+}
+
+// wipingDiskTracker tracks the devices being wiped by their resolved paths, so that a device is neither wiped twice
+// at the same time nor registered as a disk, by a create or a hotplug reattach, while it is being wiped.
+type wipingDiskTracker struct {
+	lock        *sync.Mutex
+	devicePaths map[string]struct{}
+}
+
+func newWipingDiskTracker() *wipingDiskTracker {
+	return &wipingDiskTracker{
+		lock:        &sync.Mutex{},
+		devicePaths: map[string]struct{}{},
+	}
+}
+
+// start marks the device as being wiped, and returns false if it already is.
+func (t *wipingDiskTracker) start(devicePath string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, exists := t.devicePaths[devicePath]; exists {
+		return false
+	}
+	t.devicePaths[devicePath] = struct{}{}
+	return true
+}
+
+func (t *wipingDiskTracker) finish(devicePath string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.devicePaths, devicePath)
+}
+
+// checkNotWiping returns a FailedPrecondition error if the device of the disk path is being wiped. A disk path that
+// is not a block device, e.g. the PCI address of an NVMe disk, is never wiped.
+func (t *wipingDiskTracker) checkNotWiping(diskPath string) error {
+	devicePath, err := resolveBlockDevice(diskPath)
+	if err != nil {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, exists := t.devicePaths[devicePath]; exists {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "disk %v is being wiped", devicePath)
+	}
+	return nil
+}
+
+// validateDiskWipeMode rejects the unspecified mode, so that a request missing the mode is not served by the wipe
+// mode that happens to be the zero value.
+func validateDiskWipeMode(mode rpc.DiskWipeMode) error {
+	if _, ok := rpc.DiskWipeMode_name[int32(mode)]; !ok || mode == rpc.DiskWipeMode_wipe_mode_unspecified {
+		return fmt.Errorf("invalid wipe mode %v", mode)
+	}
+	return nil
+}
+
+// checkBlockDeviceNotBusy opens the device exclusively, which fails with EBUSY if the device is mounted, held by a
+// dm or md device, or opened exclusively by another process. The device is closed right away since the wipe tools
+// open the device exclusively by themselves.
+func checkBlockDeviceNotBusy(path string) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_EXCL, 0)
+	if err != nil {
+		if errors.Is(err, unix.EBUSY) {
+			return grpcstatus.Errorf(grpccodes.FailedPrecondition, "disk %v is still in use", path)
+		}
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to open device %v", path).Error())
+	}
+	return f.Close()
+}
+
+// isSameDevicePath returns true if the path resolves to the resolved device path.
+func isSameDevicePath(path, devicePath string) bool {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return path == devicePath
+	}
+	return resolved == devicePath
+}
+
+// newDiskWiper prepares to wipe the device, whose path is already resolved to a block device.
+func newDiskWiper(ctx context.Context, req *rpc.DiskWipeRequest, path string, send func(*rpc.DiskWipeProgress) error) (*diskWiper, error) {
+	if req.Mode == rpc.DiskWipeMode_nvme_format || req.Mode == rpc.DiskWipeMode_nvme_secure_erase {
+		if !strings.HasPrefix(filepath.Base(path), "nvme") {
+			return nil, fmt.Errorf("wipe mode %v is only supported for NVMe devices rather than %v", req.Mode, path)
+		}
+	}
+
+	size, err := getBlockDeviceSize(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diskWiper{
+		ctx:  ctx,
+		req:  req,
+		path: path,
+		size: size,
+		send: send,
+	}, nil
+}
+
+func (w *diskWiper) progress(state string, wipedBytes int64, errMsg string) *rpc.DiskWipeProgress {
+	progress := int32(0)
+	if w.size > 0 {
+		progress = int32(wipedBytes * 100 / w.size)
+	}
+	return &rpc.DiskWipeProgress{
+		DiskName:   w.req.DiskName,
+		DiskPath:   w.path,
+		Mode:       w.req.Mode,
+		State:      state,
+		TotalBytes: w.size,
+		WipedBytes: wipedBytes,
+		Progress:   progress,
+		ErrorMsg:   errMsg,
+	}
+}
+
+// Run wipes the device and streams the progress. The final message always carries either the complete or the
+// error state.
+func (w *diskWiper) Run() error {
+	if err := w.send(w.progress(DiskWipeStateInProgress, 0, "")); err != nil {
+		return err
+	}
+
+	var err error
+	switch w.req.Mode {
+	case rpc.DiskWipeMode_discard:
+		_, err = util.ExecuteWithContext(w.ctx, diskWipeCommandTimeout, binaryBlkdiscard, w.path)
+	case rpc.DiskWipeMode_zero:
+		err = w.zeroFill()
+	case rpc.DiskWipeMode_nvme_format:
+		_, err = util.ExecuteWithContext(w.ctx, diskWipeCommandTimeout, binaryNvme, "format", w.path, "--ses=0", "--force")
+	case rpc.DiskWipeMode_nvme_secure_erase:
+		_, err = util.ExecuteWithContext(w.ctx, diskWipeCommandTimeout, binaryNvme, "format", w.path, "--ses=1", "--force")
+	default:
+		err = fmt.Errorf("unsupported wipe mode %v", w.req.Mode)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to wipe disk %v with mode %v", w.path, w.req.Mode)
+		if sendErr := w.send(w.progress(DiskWipeStateError, 0, err.Error())); sendErr != nil {
+			return errors.Wrapf(err, "failed to send wipe progress: %v", sendErr)
+		}
+		return err
+	}
+
+	return w.send(w.progress(DiskWipeStateComplete, w.size, ""))
+}
+
+func (w *diskWiper) zeroFill() error {
+	f, err := os.OpenFile(w.path, os.O_WRONLY|unix.O_EXCL, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, diskWipeChunkSize)
+	var written, lastReported int64
+	for written < w.size {
+		select {
+		case <-w.ctx.Done():
+			return errors.Wrapf(w.ctx.Err(), "zero-fill interrupted after %v bytes", written)
+		default:
+		}
+
+		chunk := int64(len(buf))
+		if w.size-written < chunk {
+			chunk = w.size - written
+		}
+		n, err := f.Write(buf[:chunk])
+		written += int64(n)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write zeroes at offset %v", written)
+		}
+
+		if written-lastReported >= diskWipeProgressInterval {
+			lastReported = written
+			if err := w.send(w.progress(DiskWipeStateInProgress, written, "")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return f.Sync()
+}
+
+func getBlockDeviceSize(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to open device %v", path)
+	}
+	defer f.Close()
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get size of device %v", path)
+	}
+	return size, nil
+}
@@ -0,0 +1,62 @@
+package disk
+
+import (
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestValidateDiskWipeMode(c *C) {
+	testCases := []struct {
+		mode  rpc.DiskWipeMode
+		valid bool
+	}{
+		{rpc.DiskWipeMode_wipe_mode_unspecified, false},
+		{rpc.DiskWipeMode(100), false},
+		{rpc.DiskWipeMode_discard, true},
+		{rpc.DiskWipeMode_zero, true},
+		{rpc.DiskWipeMode_nvme_format, true},
+		{rpc.DiskWipeMode_nvme_secure_erase, true},
+	}
+	for i, testCase := range testCases {
+		comment := Commentf("test case %v: mode %v", i, testCase.mode)
+		err := validateDiskWipeMode(testCase.mode)
+		if testCase.valid {
+			c.Assert(err, IsNil, comment)
+		} else {
+			c.Assert(err, ErrorMatches, "invalid wipe mode.*", comment)
+		}
+	}
+
+	// A missing mode is never treated as any of the wipe modes
+	c.Assert(validateDiskWipeMode((&rpc.DiskWipeRequest{}).Mode), NotNil)
+}
+
+func (s *TestSuite) TestIsSameDevicePath(c *C) {
+	dir := c.MkDir()
+	device := filepath.Join(dir, "sdx")
+	c.Assert(os.WriteFile(device, nil, 0644), IsNil)
+	link := filepath.Join(dir, "by-id-disk")
+	c.Assert(os.Symlink(device, link), IsNil)
+
+	c.Assert(isSameDevicePath(device, device), Equals, true)
+	c.Assert(isSameDevicePath(link, device), Equals, true)
+	c.Assert(isSameDevicePath(filepath.Join(dir, "sdy"), device), Equals, false)
+	c.Assert(checkBlockDeviceNotBusy(device), IsNil)
+}
+
+func (s *TestSuite) TestWipingDiskTracker(c *C) {
+	t := newWipingDiskTracker()
+	c.Assert(t.start("/dev/sdx"), Equals, true)
+	c.Assert(t.start("/dev/sdx"), Equals, false)
+	c.Assert(t.start("/dev/sdy"), Equals, true)
+	t.finish("/dev/sdx")
+	c.Assert(t.start("/dev/sdx"), Equals, true)
+
+	// A disk path that is not a block device is never being wiped
+	c.Assert(t.checkNotWiping("0000:00:1e.0"), IsNil)
+	c.Assert(t.checkNotWiping(c.MkDir()), IsNil)
+}
@@ -0,0 +1,224 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	sysDevBlockDirectory = "/sys/dev/block"
+
+	writeCacheWriteBack    = "write back"
+	writeCacheWriteThrough = "write through"
+)
+
+// resolveBlockDevice resolves the disk path and makes sure it points to a block device.
+func resolveBlockDevice(diskPath string) (string, error) {
+	path, err := filepath.EvalSymlinks(diskPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve disk path %v", diskPath)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to stat disk path %v", path)
+	}
+	if info.Mode()&os.ModeDevice == 0 || info.Mode()&os.ModeCharDevice != 0 {
+		return "", fmt.Errorf("disk path %v is not a block device", path)
+	}
+	return path, nil
+}
+
+// getBlockDeviceSysDir returns the sysfs directory of the block device. A partition is resolved to its parent
+// device, whose queue and identity it shares.
+func getBlockDeviceSysDir(path string) (string, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(path, &stat); err != nil {
+		return "", errors.Wrapf(err, "failed to stat device %v", path)
+	}
+
+	sysDir, err := filepath.EvalSymlinks(filepath.Join(sysDevBlockDirectory, fmt.Sprintf("%d:%d", unix.Major(stat.Rdev), unix.Minor(stat.Rdev))))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find device %v in sysfs", path)
+	}
+	if _, err := os.Stat(filepath.Join(sysDir, "partition")); err == nil {
+		sysDir = filepath.Dir(sysDir)
+	}
+	return sysDir, nil
+}
+
+// getBlockDeviceQueueDir returns the sysfs queue directory of the block device.
+func getBlockDeviceQueueDir(path string) (string, error) {
+	sysDir, err := getBlockDeviceSysDir(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(sysDir, "queue"), nil
+}
+
+func getDiskWriteCache(diskName, diskPath string) (*rpc.DiskWriteCache, error) {
+	path, err := resolveBlockDevice(diskPath)
+	if err != nil {
+		return nil, err
+	}
+	queueDir, err := getBlockDeviceQueueDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache, err := os.ReadFile(filepath.Join(queueDir, "write_cache"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get write cache mode of device %v", path)
+	}
+	fua, err := os.ReadFile(filepath.Join(queueDir, "fua"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get FUA support of device %v", path)
+	}
+
+	return &rpc.DiskWriteCache{
+		DiskName:           diskName,
+		DiskPath:           diskPath,
+		Device:             path,
+		VolatileWriteCache: strings.TrimSpace(string(writeCache)) == writeCacheWriteBack,
+		Fua:                strings.TrimSpace(string(fua)) == "1",
+	}, nil
+}
+
+func (s *Server) DiskWriteCacheGet(ctx context.Context, req *rpc.DiskWriteCacheGetRequest) (*rpc.DiskWriteCache, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskType": req.DiskType,
+		"diskName": req.DiskName,
+		"diskPath": req.DiskPath,
+	}).Trace("Disk Server: Getting disk write cache")
+
+	if req.DiskName == "" || req.DiskPath == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name and disk path are required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskWriteCacheGet(req)
+}
+
+func (ops FilesystemDiskOps) DiskWriteCacheGet(req *rpc.DiskWriteCacheGetRequest) (*rpc.DiskWriteCache, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+func (ops BlockDiskOps) DiskWriteCacheGet(req *rpc.DiskWriteCacheGetRequest) (*rpc.DiskWriteCache, error) {
+	ret, err := getDiskWriteCache(req.DiskName, req.DiskPath)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return ret, nil
+}
+
+func (s *Server) DiskWriteCacheSet(ctx context.Context, req *rpc.DiskWriteCacheSetRequest) (*rpc.DiskWriteCache, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"diskType":           req.DiskType,
+		"diskName":           req.DiskName,
+		"diskPath":           req.DiskPath,
+		"volatileWriteCache": req.VolatileWriteCache,
+	})
+
+	log.Info("Disk Server: Setting disk write cache")
+
+	if req.DiskName == "" || req.DiskPath == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name and disk path are required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskWriteCacheSet(req)
+}
+
+func (ops FilesystemDiskOps) DiskWriteCacheSet(req *rpc.DiskWriteCacheSetRequest) (*rpc.DiskWriteCache, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+// DiskWriteCacheSet sets whether the kernel considers the write cache of the device of the aio bdev volatile.
+// It applies to all replicas on the disk, since the flushes of all lvols end up as the flushes of the device. The
+// write cache of the device itself is left as it is.
+func (ops BlockDiskOps) DiskWriteCacheSet(req *rpc.DiskWriteCacheSetRequest) (*rpc.DiskWriteCache, error) {
+	path, err := resolveBlockDevice(req.DiskPath)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	queueDir, err := getBlockDeviceQueueDir(path)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	mode := writeCacheWriteBack
+	if !req.VolatileWriteCache {
+		mode = writeCacheWriteThrough
+		logrus.Warnf("Disk Server: Disabling the flushes to device %v of disk %v, the acknowledged writes are lost on power failure unless its write cache is non-volatile",
+			path, req.DiskName)
+	}
+	if err := os.WriteFile(filepath.Join(queueDir, "write_cache"), []byte(mode), 0644); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to set write cache mode of device %v to %v", path, mode).Error())
+	}
+
+	ret, err := getDiskWriteCache(req.DiskName, req.DiskPath)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return ret, nil
+}
+
+func (s *Server) DiskFlush(ctx context.Context, req *rpc.DiskFlushRequest) (*emptypb.Empty, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskType": req.DiskType,
+		"diskName": req.DiskName,
+		"diskPath": req.DiskPath,
+	}).Info("Disk Server: Flushing disk")
+
+	if req.DiskName == "" || req.DiskPath == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name and disk path are required")
+	}
+
+	ops, ok := s.ops[req.DiskType]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+	}
+	return ops.DiskFlush(req)
+}
+
+func (ops FilesystemDiskOps) DiskFlush(req *rpc.DiskFlushRequest) (*emptypb.Empty, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported disk type %v", req.DiskType)
+}
+
+// DiskFlush flushes the write cache of the device. The aio bdev writes with O_DIRECT, so syncing the device only
+// issues a cache flush to it, which is skipped by the kernel if the write cache is not considered volatile.
+func (ops BlockDiskOps) DiskFlush(req *rpc.DiskFlushRequest) (*emptypb.Empty, error) {
+	path, err := resolveBlockDevice(req.DiskPath)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to open device %v", path).Error())
+	}
+	defer f.Close()
+
+	if err := f.Sync(); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to flush device %v", path).Error())
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,621 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.24.3
+// source: github.com/longhorn/longhorn-instance-manager/pkg/imrpc/chaos.proto
+
+package imrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FaultType int32
+
+const (
+	FaultType_spdk_unavailable  FaultType = 0
+	FaultType_process_crash     FaultType = 1
+	FaultType_watch_stream_drop FaultType = 2
+)
+
+// Enum value maps for FaultType.
+var (
+	FaultType_name = map[int32]string{
+		0: "spdk_unavailable",
+		1: "process_crash",
+		2: "watch_stream_drop",
+	}
+	FaultType_value = map[string]int32{
+		"spdk_unavailable":  0,
+		"process_crash":     1,
+		"watch_stream_drop": 2,
+	}
+)
+
+func (x FaultType) Enum() *FaultType {
+	p := new(FaultType)
+	*p = x
+	return p
+}
+
+func (x FaultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FaultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_enumTypes[0].Descriptor()
+}
+
+func (FaultType) Type() protoreflect.EnumType {
+	return &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_enumTypes[0]
+}
+
+func (x FaultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FaultType.Descriptor instead.
+func (FaultType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescGZIP(), []int{0}
+}
+
+type FaultInjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FaultType `protobuf:"varint,1,opt,name=type,proto3,enum=imrpc.FaultType" json:"type,omitempty"`
+	// The name of the process to crash. Only used by the process_crash fault.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// How long the spdk_unavailable fault stays active. Zero means until it is cleared.
+	DurationSeconds int64 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+}
+
+func (x *FaultInjectRequest) Reset() {
+	*x = FaultInjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultInjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultInjectRequest) ProtoMessage() {}
+
+func (x *FaultInjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultInjectRequest.ProtoReflect.Descriptor instead.
+func (*FaultInjectRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FaultInjectRequest) GetType() FaultType {
+	if x != nil {
+		return x.Type
+	}
+	return FaultType_spdk_unavailable
+}
+
+func (x *FaultInjectRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *FaultInjectRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type Fault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   FaultType `protobuf:"varint,1,opt,name=type,proto3,enum=imrpc.FaultType" json:"type,omitempty"`
+	Target string    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Unix timestamp in seconds. Zero means the fault never expires or has been applied at once.
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Fault) Reset() {
+	*x = Fault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fault) ProtoMessage() {}
+
+func (x *Fault) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fault.ProtoReflect.Descriptor instead.
+func (*Fault) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Fault) GetType() FaultType {
+	if x != nil {
+		return x.Type
+	}
+	return FaultType_spdk_unavailable
+}
+
+func (x *Fault) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Fault) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type FaultClearRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FaultType `protobuf:"varint,1,opt,name=type,proto3,enum=imrpc.FaultType" json:"type,omitempty"`
+}
+
+func (x *FaultClearRequest) Reset() {
+	*x = FaultClearRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultClearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultClearRequest) ProtoMessage() {}
+
+func (x *FaultClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultClearRequest.ProtoReflect.Descriptor instead.
+func (*FaultClearRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FaultClearRequest) GetType() FaultType {
+	if x != nil {
+		return x.Type
+	}
+	return FaultType_spdk_unavailable
+}
+
+type FaultListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Faults []*Fault `protobuf:"bytes,1,rep,name=faults,proto3" json:"faults,omitempty"`
+}
+
+func (x *FaultListResponse) Reset() {
+	*x = FaultListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultListResponse) ProtoMessage() {}
+
+func (x *FaultListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultListResponse.ProtoReflect.Descriptor instead.
+func (*FaultListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FaultListResponse) GetFaults() []*Fault {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto protoreflect.FileDescriptor
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDesc = []byte{
+	0x0a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7d, 0x0a, 0x12, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x64, 0x0a, 0x05, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39,
+	0x0a, 0x11, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x2a, 0x4b, 0x0a, 0x09, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x73, 0x70, 0x64, 0x6b, 0x5f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x72, 0x61, 0x73, 0x68, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x10,
+	0x02, 0x32, 0xc5, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e,
+	0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescOnce sync.Once
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDesc
+)
+
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescGZIP() []byte {
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescOnce.Do(func() {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescData)
+	})
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDescData
+}
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_goTypes = []interface{}{
+	(FaultType)(0),             // 0: imrpc.FaultType
+	(*FaultInjectRequest)(nil), // 1: imrpc.FaultInjectRequest
+	(*Fault)(nil),              // 2: imrpc.Fault
+	(*FaultClearRequest)(nil),  // 3: imrpc.FaultClearRequest
+	(*FaultListResponse)(nil),  // 4: imrpc.FaultListResponse
+	(*emptypb.Empty)(nil),      // 5: google.protobuf.Empty
+}
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_depIdxs = []int32{
+	0, // 0: imrpc.FaultInjectRequest.type:type_name -> imrpc.FaultType
+	0, // 1: imrpc.Fault.type:type_name -> imrpc.FaultType
+	0, // 2: imrpc.FaultClearRequest.type:type_name -> imrpc.FaultType
+	2, // 3: imrpc.FaultListResponse.faults:type_name -> imrpc.Fault
+	1, // 4: imrpc.ChaosService.FaultInject:input_type -> imrpc.FaultInjectRequest
+	3, // 5: imrpc.ChaosService.FaultClear:input_type -> imrpc.FaultClearRequest
+	5, // 6: imrpc.ChaosService.FaultList:input_type -> google.protobuf.Empty
+	2, // 7: imrpc.ChaosService.FaultInject:output_type -> imrpc.Fault
+	5, // 8: imrpc.ChaosService.FaultClear:output_type -> google.protobuf.Empty
+	4, // 9: imrpc.ChaosService.FaultList:output_type -> imrpc.FaultListResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_init() }
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_init() {
+	if File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultInjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultClearRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_goTypes,
+		DependencyIndexes: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_depIdxs,
+		EnumInfos:         file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_enumTypes,
+		MessageInfos:      file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_msgTypes,
+	}.Build()
+	File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto = out.File
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_rawDesc = nil
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_goTypes = nil
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_chaos_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ChaosServiceClient is the client API for ChaosService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChaosServiceClient interface {
+	FaultInject(ctx context.Context, in *FaultInjectRequest, opts ...grpc.CallOption) (*Fault, error)
+	FaultClear(ctx context.Context, in *FaultClearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FaultList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FaultListResponse, error)
+}
+
+type chaosServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChaosServiceClient(cc grpc.ClientConnInterface) ChaosServiceClient {
+	return &chaosServiceClient{cc}
+}
+
+func (c *chaosServiceClient) FaultInject(ctx context.Context, in *FaultInjectRequest, opts ...grpc.CallOption) (*Fault, error) {
+	out := new(Fault)
+	err := c.cc.Invoke(ctx, "/imrpc.ChaosService/FaultInject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosServiceClient) FaultClear(ctx context.Context, in *FaultClearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/imrpc.ChaosService/FaultClear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosServiceClient) FaultList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FaultListResponse, error) {
+	out := new(FaultListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.ChaosService/FaultList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChaosServiceServer is the server API for ChaosService service.
+type ChaosServiceServer interface {
+	FaultInject(context.Context, *FaultInjectRequest) (*Fault, error)
+	FaultClear(context.Context, *FaultClearRequest) (*emptypb.Empty, error)
+	FaultList(context.Context, *emptypb.Empty) (*FaultListResponse, error)
+}
+
+// UnimplementedChaosServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedChaosServiceServer struct {
+}
+
+func (*UnimplementedChaosServiceServer) FaultInject(context.Context, *FaultInjectRequest) (*Fault, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FaultInject not implemented")
+}
+func (*UnimplementedChaosServiceServer) FaultClear(context.Context, *FaultClearRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FaultClear not implemented")
+}
+func (*UnimplementedChaosServiceServer) FaultList(context.Context, *emptypb.Empty) (*FaultListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FaultList not implemented")
+}
+
+func RegisterChaosServiceServer(s *grpc.Server, srv ChaosServiceServer) {
+	s.RegisterService(&_ChaosService_serviceDesc, srv)
+}
+
+func _ChaosService_FaultInject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultInjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServiceServer).FaultInject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.ChaosService/FaultInject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServiceServer).FaultInject(ctx, req.(*FaultInjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosService_FaultClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServiceServer).FaultClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.ChaosService/FaultClear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServiceServer).FaultClear(ctx, req.(*FaultClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosService_FaultList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServiceServer).FaultList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.ChaosService/FaultList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServiceServer).FaultList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ChaosService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "imrpc.ChaosService",
+	HandlerType: (*ChaosServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FaultInject",
+			Handler:    _ChaosService_FaultInject_Handler,
+		},
+		{
+			MethodName: "FaultClear",
+			Handler:    _ChaosService_FaultClear_Handler,
+		},
+		{
+			MethodName: "FaultList",
+			Handler:    _ChaosService_FaultList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/longhorn/longhorn-instance-manager/pkg/imrpc/chaos.proto",
+}
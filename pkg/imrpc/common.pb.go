@@ -72,6 +72,8 @@ type DataEngine int32
 const (
 	DataEngine_DATA_ENGINE_V1 DataEngine = 0
 	DataEngine_DATA_ENGINE_V2 DataEngine = 1
+	// Only supported by InstanceGet and InstanceDelete, which look up the instance in both data engines
+	DataEngine_DATA_ENGINE_ANY DataEngine = 2
 )
 
 // Enum value maps for DataEngine.
@@ -79,10 +81,12 @@ var (
 	DataEngine_name = map[int32]string{
 		0: "DATA_ENGINE_V1",
 		1: "DATA_ENGINE_V2",
+		2: "DATA_ENGINE_ANY",
 	}
 	DataEngine_value = map[string]int32{
-		"DATA_ENGINE_V1": 0,
-		"DATA_ENGINE_V2": 1,
+		"DATA_ENGINE_V1":  0,
+		"DATA_ENGINE_V2":  1,
+		"DATA_ENGINE_ANY": 2,
 	}
 )
 
@@ -123,14 +127,16 @@ var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_common_proto_ra
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2a, 0x24, 0x0a,
 	0x12, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69,
 	0x76, 0x65, 0x72, 0x12, 0x06, 0x0a, 0x02, 0x76, 0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x76,
-	0x32, 0x10, 0x01, 0x2a, 0x34, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x32, 0x10, 0x01, 0x2a, 0x49, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e,
 	0x65, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45,
 	0x5f, 0x56, 0x31, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x4e,
-	0x47, 0x49, 0x4e, 0x45, 0x5f, 0x56, 0x32, 0x10, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e,
-	0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69,
-	0x6d, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x49, 0x4e, 0x45, 0x5f, 0x56, 0x32, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x39,
+	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
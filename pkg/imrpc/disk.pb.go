@@ -71,6 +71,62 @@ func (DiskType) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{0}
 }
 
+// The zero value is rejected, so that a request missing the mode never wipes the disk with a default mode.
+type DiskWipeMode int32
+
+const (
+	DiskWipeMode_wipe_mode_unspecified DiskWipeMode = 0
+	DiskWipeMode_discard               DiskWipeMode = 1
+	DiskWipeMode_zero                  DiskWipeMode = 2
+	DiskWipeMode_nvme_format           DiskWipeMode = 3
+	DiskWipeMode_nvme_secure_erase     DiskWipeMode = 4
+)
+
+// Enum value maps for DiskWipeMode.
+var (
+	DiskWipeMode_name = map[int32]string{
+		0: "wipe_mode_unspecified",
+		1: "discard",
+		2: "zero",
+		3: "nvme_format",
+		4: "nvme_secure_erase",
+	}
+	DiskWipeMode_value = map[string]int32{
+		"wipe_mode_unspecified": 0,
+		"discard":               1,
+		"zero":                  2,
+		"nvme_format":           3,
+		"nvme_secure_erase":     4,
+	}
+)
+
+func (x DiskWipeMode) Enum() *DiskWipeMode {
+	p := new(DiskWipeMode)
+	*p = x
+	return p
+}
+
+func (x DiskWipeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiskWipeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_enumTypes[1].Descriptor()
+}
+
+func (DiskWipeMode) Type() protoreflect.EnumType {
+	return &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_enumTypes[1]
+}
+
+func (x DiskWipeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiskWipeMode.Descriptor instead.
+func (DiskWipeMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{1}
+}
+
 type Disk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -86,6 +142,12 @@ type Disk struct {
 	FreeBlocks  int64  `protobuf:"varint,8,opt,name=free_blocks,json=freeBlocks,proto3" json:"free_blocks,omitempty"`
 	BlockSize   int64  `protobuf:"varint,9,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 	ClusterSize int64  `protobuf:"varint,10,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"`
+	// The disk is registered in safe mode if its lvstore fails the consistency check or cannot be loaded.
+	// No replica can be created on a disk in safe mode until it is repaired.
+	SafeMode        bool     `protobuf:"varint,11,opt,name=safe_mode,json=safeMode,proto3" json:"safe_mode,omitempty"`
+	SafeModeReasons []string `protobuf:"bytes,12,rep,name=safe_mode_reasons,json=safeModeReasons,proto3" json:"safe_mode_reasons,omitempty"`
+	// The background scrub of the disk, whose latest completed run reports the latent errors of the device
+	Scrub *DiskScrubStatus `protobuf:"bytes,13,opt,name=scrub,proto3" json:"scrub,omitempty"`
 }
 
 func (x *Disk) Reset() {
@@ -190,6 +252,27 @@ func (x *Disk) GetClusterSize() int64 {
 	return 0
 }
 
+func (x *Disk) GetSafeMode() bool {
+	if x != nil {
+		return x.SafeMode
+	}
+	return false
+}
+
+func (x *Disk) GetSafeModeReasons() []string {
+	if x != nil {
+		return x.SafeModeReasons
+	}
+	return nil
+}
+
+func (x *Disk) GetScrub() *DiskScrubStatus {
+	if x != nil {
+		return x.Scrub
+	}
+	return nil
+}
+
 type ReplicaInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -655,20 +738,19 @@ func (x *DiskReplicaInstanceDeleteRequest) GetReplciaInstanceName() string {
 	return ""
 }
 
-type DiskVersionResponse struct {
+type DiskWipeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version                                 string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	GitCommit                               string `protobuf:"bytes,2,opt,name=gitCommit,proto3" json:"gitCommit,omitempty"`
-	BuildDate                               string `protobuf:"bytes,3,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
-	InstanceManagerDiskServiceAPIVersion    int64  `protobuf:"varint,4,opt,name=instanceManagerDiskServiceAPIVersion,proto3" json:"instanceManagerDiskServiceAPIVersion,omitempty"`
-	InstanceManagerDiskServiceAPIMinVersion int64  `protobuf:"varint,5,opt,name=instanceManagerDiskServiceAPIMinVersion,proto3" json:"instanceManagerDiskServiceAPIMinVersion,omitempty"`
+	DiskType DiskType     `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string       `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath string       `protobuf:"bytes,3,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	Mode     DiskWipeMode `protobuf:"varint,4,opt,name=mode,proto3,enum=imrpc.DiskWipeMode" json:"mode,omitempty"`
 }
 
-func (x *DiskVersionResponse) Reset() {
-	*x = DiskVersionResponse{}
+func (x *DiskWipeRequest) Reset() {
+	*x = DiskWipeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,13 +758,13 @@ func (x *DiskVersionResponse) Reset() {
 	}
 }
 
-func (x *DiskVersionResponse) String() string {
+func (x *DiskWipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskVersionResponse) ProtoMessage() {}
+func (*DiskWipeRequest) ProtoMessage() {}
 
-func (x *DiskVersionResponse) ProtoReflect() protoreflect.Message {
+func (x *DiskWipeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,299 +776,2945 @@ func (x *DiskVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskVersionResponse.ProtoReflect.Descriptor instead.
-func (*DiskVersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskWipeRequest.ProtoReflect.Descriptor instead.
+func (*DiskWipeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DiskVersionResponse) GetVersion() string {
+func (x *DiskWipeRequest) GetDiskType() DiskType {
 	if x != nil {
-		return x.Version
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskWipeRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
 	}
 	return ""
 }
 
-func (x *DiskVersionResponse) GetGitCommit() string {
+func (x *DiskWipeRequest) GetDiskPath() string {
 	if x != nil {
-		return x.GitCommit
+		return x.DiskPath
 	}
 	return ""
 }
 
-func (x *DiskVersionResponse) GetBuildDate() string {
+func (x *DiskWipeRequest) GetMode() DiskWipeMode {
 	if x != nil {
-		return x.BuildDate
+		return x.Mode
+	}
+	return DiskWipeMode_wipe_mode_unspecified
+}
+
+type DiskWipeProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName   string       `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath   string       `protobuf:"bytes,2,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	Mode       DiskWipeMode `protobuf:"varint,3,opt,name=mode,proto3,enum=imrpc.DiskWipeMode" json:"mode,omitempty"`
+	State      string       `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	TotalBytes int64        `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	WipedBytes int64        `protobuf:"varint,6,opt,name=wiped_bytes,json=wipedBytes,proto3" json:"wiped_bytes,omitempty"`
+	Progress   int32        `protobuf:"varint,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	ErrorMsg   string       `protobuf:"bytes,8,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *DiskWipeProgress) Reset() {
+	*x = DiskWipeProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskWipeProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskWipeProgress) ProtoMessage() {}
+
+func (x *DiskWipeProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskWipeProgress.ProtoReflect.Descriptor instead.
+func (*DiskWipeProgress) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DiskWipeProgress) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
 	}
 	return ""
 }
 
-func (x *DiskVersionResponse) GetInstanceManagerDiskServiceAPIVersion() int64 {
+func (x *DiskWipeProgress) GetDiskPath() string {
 	if x != nil {
-		return x.InstanceManagerDiskServiceAPIVersion
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskWipeProgress) GetMode() DiskWipeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DiskWipeMode_wipe_mode_unspecified
+}
+
+func (x *DiskWipeProgress) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DiskWipeProgress) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
 	}
 	return 0
 }
 
-func (x *DiskVersionResponse) GetInstanceManagerDiskServiceAPIMinVersion() int64 {
+func (x *DiskWipeProgress) GetWipedBytes() int64 {
 	if x != nil {
-		return x.InstanceManagerDiskServiceAPIMinVersion
+		return x.WipedBytes
 	}
 	return 0
 }
 
-var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto protoreflect.FileDescriptor
+func (x *DiskWipeProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
 
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDesc = []byte{
-	0x0a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
-	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x02, 0x0a, 0x04, 0x44, 0x69, 0x73,
-	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x66, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x66, 0x72, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x72, 0x65, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0xb1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
-	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69,
-	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x78, 0x0a,
-	0x0e, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
-	0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x22, 0x7b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09,
-	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
-	0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
-	0x55, 0x75, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x1e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70,
-	0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0xe9, 0x01, 0x0a, 0x1f, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x1a, 0x5b, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6d, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe, 0x01,
-	0x0a, 0x20, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69,
-	0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65,
-	0x70, 0x6c, 0x63, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x63,
-	0x69, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x99,
-	0x02, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x24,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x44,
-	0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x24, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x58, 0x0a, 0x27, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50,
-	0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x27, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x49,
-	0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x25, 0x0a, 0x08, 0x44, 0x69,
-	0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x10,
-	0x01, 0x32, 0xbb, 0x03, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x33, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x69, 0x6d, 0x72, 0x70,
-	0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73,
-	0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x65,
-	0x74, 0x12, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x68, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x25, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
-	0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5c, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x69,
-	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a,
-	0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f,
-	0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *DiskWipeProgress) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
 }
 
-var (
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescOnce sync.Once
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDesc
-)
+type DiskRepairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP() []byte {
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescOnce.Do(func() {
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData)
-	})
-	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid string   `protobuf:"bytes,3,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	DiskPath string   `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// Delete the degraded lvols, e.g. whose snapshot is missing, before reloading the lvstore
+	RemoveDegradedLvols bool `protobuf:"varint,5,opt,name=remove_degraded_lvols,json=removeDegradedLvols,proto3" json:"remove_degraded_lvols,omitempty"`
 }
 
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_goTypes = []interface{}{
-	(DiskType)(0),                            // 0: imrpc.DiskType
-	(*Disk)(nil),                             // 1: imrpc.Disk
-	(*ReplicaInstance)(nil),                  // 2: imrpc.ReplicaInstance
-	(*DiskCreateRequest)(nil),                // 3: imrpc.DiskCreateRequest
-	(*DiskGetRequest)(nil),                   // 4: imrpc.DiskGetRequest
-	(*DiskDeleteRequest)(nil),                // 5: imrpc.DiskDeleteRequest
-	(*DiskReplicaInstanceListRequest)(nil),   // 6: imrpc.DiskReplicaInstanceListRequest
-	(*DiskReplicaInstanceListResponse)(nil),  // 7: imrpc.DiskReplicaInstanceListResponse
-	(*DiskReplicaInstanceDeleteRequest)(nil), // 8: imrpc.DiskReplicaInstanceDeleteRequest
-	(*DiskVersionResponse)(nil),              // 9: imrpc.DiskVersionResponse
-	nil,                                      // 10: imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry
-	(*emptypb.Empty)(nil),                    // 11: google.protobuf.Empty
+func (x *DiskRepairRequest) Reset() {
+	*x = DiskRepairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_depIdxs = []int32{
-	0,  // 0: imrpc.DiskCreateRequest.disk_type:type_name -> imrpc.DiskType
-	0,  // 1: imrpc.DiskGetRequest.disk_type:type_name -> imrpc.DiskType
-	0,  // 2: imrpc.DiskDeleteRequest.disk_type:type_name -> imrpc.DiskType
-	0,  // 3: imrpc.DiskReplicaInstanceListRequest.disk_type:type_name -> imrpc.DiskType
-	10, // 4: imrpc.DiskReplicaInstanceListResponse.replica_instances:type_name -> imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry
-	0,  // 5: imrpc.DiskReplicaInstanceDeleteRequest.disk_type:type_name -> imrpc.DiskType
-	2,  // 6: imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry.value:type_name -> imrpc.ReplicaInstance
-	3,  // 7: imrpc.DiskService.DiskCreate:input_type -> imrpc.DiskCreateRequest
-	5,  // 8: imrpc.DiskService.DiskDelete:input_type -> imrpc.DiskDeleteRequest
-	4,  // 9: imrpc.DiskService.DiskGet:input_type -> imrpc.DiskGetRequest
-	6,  // 10: imrpc.DiskService.DiskReplicaInstanceList:input_type -> imrpc.DiskReplicaInstanceListRequest
-	8,  // 11: imrpc.DiskService.DiskReplicaInstanceDelete:input_type -> imrpc.DiskReplicaInstanceDeleteRequest
-	11, // 12: imrpc.DiskService.VersionGet:input_type -> google.protobuf.Empty
-	1,  // 13: imrpc.DiskService.DiskCreate:output_type -> imrpc.Disk
-	11, // 14: imrpc.DiskService.DiskDelete:output_type -> google.protobuf.Empty
-	1,  // 15: imrpc.DiskService.DiskGet:output_type -> imrpc.Disk
-	7,  // 16: imrpc.DiskService.DiskReplicaInstanceList:output_type -> imrpc.DiskReplicaInstanceListResponse
-	11, // 17: imrpc.DiskService.DiskReplicaInstanceDelete:output_type -> google.protobuf.Empty
-	9,  // 18: imrpc.DiskService.VersionGet:output_type -> imrpc.DiskVersionResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (x *DiskRepairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_init() }
-func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_init() {
-	if File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Disk); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplicaInstance); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskCreateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*DiskRepairRequest) ProtoMessage() {}
+
+func (x *DiskRepairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskGetRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskRepairRequest.ProtoReflect.Descriptor instead.
+func (*DiskRepairRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiskRepairRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskRepairRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskRepairRequest) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *DiskRepairRequest) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskRepairRequest) GetRemoveDegradedLvols() bool {
+	if x != nil {
+		return x.RemoveDegradedLvols
+	}
+	return false
+}
+
+type DiskWriteCache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath string `protobuf:"bytes,2,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// The block device the disk path resolves to
+	Device string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	// The kernel sends flushes to the device only if it is considered to have a volatile write cache
+	VolatileWriteCache bool `protobuf:"varint,4,opt,name=volatile_write_cache,json=volatileWriteCache,proto3" json:"volatile_write_cache,omitempty"`
+	// Whether the device supports the forced unit access writes
+	Fua bool `protobuf:"varint,5,opt,name=fua,proto3" json:"fua,omitempty"`
+}
+
+func (x *DiskWriteCache) Reset() {
+	*x = DiskWriteCache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskWriteCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskWriteCache) ProtoMessage() {}
+
+func (x *DiskWriteCache) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskWriteCache.ProtoReflect.Descriptor instead.
+func (*DiskWriteCache) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiskWriteCache) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskWriteCache) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskWriteCache) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskWriteCache) GetVolatileWriteCache() bool {
+	if x != nil {
+		return x.VolatileWriteCache
+	}
+	return false
+}
+
+func (x *DiskWriteCache) GetFua() bool {
+	if x != nil {
+		return x.Fua
+	}
+	return false
+}
+
+type DiskWriteCacheGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath string   `protobuf:"bytes,3,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+}
+
+func (x *DiskWriteCacheGetRequest) Reset() {
+	*x = DiskWriteCacheGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskWriteCacheGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskWriteCacheGetRequest) ProtoMessage() {}
+
+func (x *DiskWriteCacheGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskWriteCacheGetRequest.ProtoReflect.Descriptor instead.
+func (*DiskWriteCacheGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DiskWriteCacheGetRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskWriteCacheGetRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskWriteCacheGetRequest) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+type DiskWriteCacheSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath string   `protobuf:"bytes,3,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// Disabling it stops the flushes to the device, which loses the acknowledged writes on power failure unless
+	// the write cache of the device is non-volatile, e.g. battery-backed
+	VolatileWriteCache bool `protobuf:"varint,4,opt,name=volatile_write_cache,json=volatileWriteCache,proto3" json:"volatile_write_cache,omitempty"`
+}
+
+func (x *DiskWriteCacheSetRequest) Reset() {
+	*x = DiskWriteCacheSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskWriteCacheSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskWriteCacheSetRequest) ProtoMessage() {}
+
+func (x *DiskWriteCacheSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskWriteCacheSetRequest.ProtoReflect.Descriptor instead.
+func (*DiskWriteCacheSetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DiskWriteCacheSetRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskWriteCacheSetRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskWriteCacheSetRequest) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskWriteCacheSetRequest) GetVolatileWriteCache() bool {
+	if x != nil {
+		return x.VolatileWriteCache
+	}
+	return false
+}
+
+type DiskFlushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath string   `protobuf:"bytes,3,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+}
+
+func (x *DiskFlushRequest) Reset() {
+	*x = DiskFlushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskFlushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskFlushRequest) ProtoMessage() {}
+
+func (x *DiskFlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskFlushRequest.ProtoReflect.Descriptor instead.
+func (*DiskFlushRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiskFlushRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskFlushRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskFlushRequest) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+type DiskHotplugEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time    string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DiskHotplugEvent) Reset() {
+	*x = DiskHotplugEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskHotplugEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskHotplugEvent) ProtoMessage() {}
+
+func (x *DiskHotplugEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskHotplugEvent.ProtoReflect.Descriptor instead.
+func (*DiskHotplugEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiskHotplugEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DiskHotplugEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DiskHotplugEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DiskHotplugStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid string `protobuf:"bytes,2,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	DiskPath string `protobuf:"bytes,3,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// The block device the disk path resolves to when it is last seen
+	Device string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	// The WWID or serial number of the device, which must be the same for the disk to be reattached
+	DeviceId string `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// One of attached, detached, mismatched and reattach_failed
+	State              string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Message            string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	LastTransitionTime string `protobuf:"bytes,8,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+	// The replicas on the disk when the device is found missing
+	AffectedReplicas []string `protobuf:"bytes,9,rep,name=affected_replicas,json=affectedReplicas,proto3" json:"affected_replicas,omitempty"`
+	// The latest events, the oldest first
+	Events []*DiskHotplugEvent `protobuf:"bytes,10,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *DiskHotplugStatus) Reset() {
+	*x = DiskHotplugStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskHotplugStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskHotplugStatus) ProtoMessage() {}
+
+func (x *DiskHotplugStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskHotplugStatus.ProtoReflect.Descriptor instead.
+func (*DiskHotplugStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiskHotplugStatus) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetLastTransitionTime() string {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return ""
+}
+
+func (x *DiskHotplugStatus) GetAffectedReplicas() []string {
+	if x != nil {
+		return x.AffectedReplicas
+	}
+	return nil
+}
+
+func (x *DiskHotplugStatus) GetEvents() []*DiskHotplugEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DiskHotplugStatusGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+}
+
+func (x *DiskHotplugStatusGetRequest) Reset() {
+	*x = DiskHotplugStatusGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskHotplugStatusGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskHotplugStatusGetRequest) ProtoMessage() {}
+
+func (x *DiskHotplugStatusGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskHotplugStatusGetRequest.ProtoReflect.Descriptor instead.
+func (*DiskHotplugStatusGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiskHotplugStatusGetRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskHotplugStatusGetRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+type DiskScrubError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unreadable range of the device in bytes
+	Offset  int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length  int64  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DiskScrubError) Reset() {
+	*x = DiskScrubError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskScrubError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskScrubError) ProtoMessage() {}
+
+func (x *DiskScrubError) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskScrubError.ProtoReflect.Descriptor instead.
+func (*DiskScrubError) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DiskScrubError) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DiskScrubError) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *DiskScrubError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DiskScrubResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime string `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// One of completed, failed and aborted
+	State        string            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ScannedBytes int64             `protobuf:"varint,4,opt,name=scanned_bytes,json=scannedBytes,proto3" json:"scanned_bytes,omitempty"`
+	TotalBytes   int64             `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	Errors       []*DiskScrubError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	Message      string            `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DiskScrubResult) Reset() {
+	*x = DiskScrubResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskScrubResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskScrubResult) ProtoMessage() {}
+
+func (x *DiskScrubResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskScrubResult.ProtoReflect.Descriptor instead.
+func (*DiskScrubResult) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DiskScrubResult) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *DiskScrubResult) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *DiskScrubResult) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DiskScrubResult) GetScannedBytes() int64 {
+	if x != nil {
+		return x.ScannedBytes
+	}
+	return 0
+}
+
+func (x *DiskScrubResult) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *DiskScrubResult) GetErrors() []*DiskScrubError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *DiskScrubResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DiskScrubEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time    string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DiskScrubEvent) Reset() {
+	*x = DiskScrubEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskScrubEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskScrubEvent) ProtoMessage() {}
+
+func (x *DiskScrubEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskScrubEvent.ProtoReflect.Descriptor instead.
+func (*DiskScrubEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DiskScrubEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DiskScrubEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DiskScrubEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DiskScrubStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskPath string `protobuf:"bytes,2,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// The block device the disk path resolves to when it is last scrubbed
+	Device string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	// The interval between the starts of the scrubs. Scrubbing is disabled if 0
+	IntervalSeconds int64 `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// The cap of the read rate of the scrub. Unlimited if 0
+	BandwidthBytesPerSecond int64 `protobuf:"varint,5,opt,name=bandwidth_bytes_per_second,json=bandwidthBytesPerSecond,proto3" json:"bandwidth_bytes_per_second,omitempty"`
+	// One of disabled, idle and running
+	State       string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	NextRunTime string `protobuf:"bytes,7,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	// The progress of the running scrub
+	ScannedBytes int64 `protobuf:"varint,8,opt,name=scanned_bytes,json=scannedBytes,proto3" json:"scanned_bytes,omitempty"`
+	TotalBytes   int64 `protobuf:"varint,9,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// Whether the latest completed scrub found unreadable ranges, which are listed in errors
+	ErrorsDetected bool              `protobuf:"varint,10,opt,name=errors_detected,json=errorsDetected,proto3" json:"errors_detected,omitempty"`
+	Errors         []*DiskScrubError `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"`
+	// The results of the latest scrubs, the oldest first
+	History []*DiskScrubResult `protobuf:"bytes,12,rep,name=history,proto3" json:"history,omitempty"`
+	// The latest events, the oldest first
+	Events []*DiskScrubEvent `protobuf:"bytes,13,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *DiskScrubStatus) Reset() {
+	*x = DiskScrubStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskScrubStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskScrubStatus) ProtoMessage() {}
+
+func (x *DiskScrubStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskScrubStatus.ProtoReflect.Descriptor instead.
+func (*DiskScrubStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DiskScrubStatus) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskScrubStatus) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskScrubStatus) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskScrubStatus) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *DiskScrubStatus) GetBandwidthBytesPerSecond() int64 {
+	if x != nil {
+		return x.BandwidthBytesPerSecond
+	}
+	return 0
+}
+
+func (x *DiskScrubStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DiskScrubStatus) GetNextRunTime() string {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return ""
+}
+
+func (x *DiskScrubStatus) GetScannedBytes() int64 {
+	if x != nil {
+		return x.ScannedBytes
+	}
+	return 0
+}
+
+func (x *DiskScrubStatus) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *DiskScrubStatus) GetErrorsDetected() bool {
+	if x != nil {
+		return x.ErrorsDetected
+	}
+	return false
+}
+
+func (x *DiskScrubStatus) GetErrors() []*DiskScrubError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *DiskScrubStatus) GetHistory() []*DiskScrubResult {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *DiskScrubStatus) GetEvents() []*DiskScrubEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DiskScrubStatusGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+}
+
+func (x *DiskScrubStatusGetRequest) Reset() {
+	*x = DiskScrubStatusGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskScrubStatusGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskScrubStatusGetRequest) ProtoMessage() {}
+
+func (x *DiskScrubStatusGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskScrubStatusGetRequest.ProtoReflect.Descriptor instead.
+func (*DiskScrubStatusGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DiskScrubStatusGetRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskScrubStatusGetRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+type DiskScrubSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType                DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	DiskName                string   `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	IntervalSeconds         int64    `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	BandwidthBytesPerSecond int64    `protobuf:"varint,4,opt,name=bandwidth_bytes_per_second,json=bandwidthBytesPerSecond,proto3" json:"bandwidth_bytes_per_second,omitempty"`
+	// Start a scrub on the next check, which is within a minute, rather than after the interval
+	StartNow bool `protobuf:"varint,5,opt,name=start_now,json=startNow,proto3" json:"start_now,omitempty"`
+}
+
+func (x *DiskScrubSetRequest) Reset() {
+	*x = DiskScrubSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskScrubSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskScrubSetRequest) ProtoMessage() {}
+
+func (x *DiskScrubSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskScrubSetRequest.ProtoReflect.Descriptor instead.
+func (*DiskScrubSetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DiskScrubSetRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskScrubSetRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskScrubSetRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *DiskScrubSetRequest) GetBandwidthBytesPerSecond() int64 {
+	if x != nil {
+		return x.BandwidthBytesPerSecond
+	}
+	return 0
+}
+
+func (x *DiskScrubSetRequest) GetStartNow() bool {
+	if x != nil {
+		return x.StartNow
+	}
+	return false
+}
+
+type SpdkMemoryHeap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	HeapSize         uint64 `protobuf:"varint,3,opt,name=heap_size,json=heapSize,proto3" json:"heap_size,omitempty"`
+	FreeSize         uint64 `protobuf:"varint,4,opt,name=free_size,json=freeSize,proto3" json:"free_size,omitempty"`
+	AllocSize        uint64 `protobuf:"varint,5,opt,name=alloc_size,json=allocSize,proto3" json:"alloc_size,omitempty"`
+	GreatestFreeSize uint64 `protobuf:"varint,6,opt,name=greatest_free_size,json=greatestFreeSize,proto3" json:"greatest_free_size,omitempty"`
+	AllocCount       uint64 `protobuf:"varint,7,opt,name=alloc_count,json=allocCount,proto3" json:"alloc_count,omitempty"`
+	FreeCount        uint64 `protobuf:"varint,8,opt,name=free_count,json=freeCount,proto3" json:"free_count,omitempty"`
+	// Ratio of the free memory that cannot be allocated in one piece
+	Fragmentation float64 `protobuf:"fixed64,9,opt,name=fragmentation,proto3" json:"fragmentation,omitempty"`
+}
+
+func (x *SpdkMemoryHeap) Reset() {
+	*x = SpdkMemoryHeap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkMemoryHeap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkMemoryHeap) ProtoMessage() {}
+
+func (x *SpdkMemoryHeap) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkMemoryHeap.ProtoReflect.Descriptor instead.
+func (*SpdkMemoryHeap) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SpdkMemoryHeap) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpdkMemoryHeap) GetHeapSize() uint64 {
+	if x != nil {
+		return x.HeapSize
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetFreeSize() uint64 {
+	if x != nil {
+		return x.FreeSize
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetAllocSize() uint64 {
+	if x != nil {
+		return x.AllocSize
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetGreatestFreeSize() uint64 {
+	if x != nil {
+		return x.GreatestFreeSize
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetAllocCount() uint64 {
+	if x != nil {
+		return x.AllocCount
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetFreeCount() uint64 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *SpdkMemoryHeap) GetFragmentation() float64 {
+	if x != nil {
+		return x.Fragmentation
+	}
+	return 0
+}
+
+type SpdkMempool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size        uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ElementSize uint64 `protobuf:"varint,3,opt,name=element_size,json=elementSize,proto3" json:"element_size,omitempty"`
+	Available   uint64 `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	InUse       uint64 `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+}
+
+func (x *SpdkMempool) Reset() {
+	*x = SpdkMempool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkMempool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkMempool) ProtoMessage() {}
+
+func (x *SpdkMempool) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkMempool.ProtoReflect.Descriptor instead.
+func (*SpdkMempool) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SpdkMempool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpdkMempool) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SpdkMempool) GetElementSize() uint64 {
+	if x != nil {
+		return x.ElementSize
+	}
+	return 0
+}
+
+func (x *SpdkMempool) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *SpdkMempool) GetInUse() uint64 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+type SpdkIobufPoolStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cache uint64 `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	Main  uint64 `protobuf:"varint,2,opt,name=main,proto3" json:"main,omitempty"`
+	// Allocations that had to wait since the pool was exhausted
+	Retry uint64 `protobuf:"varint,3,opt,name=retry,proto3" json:"retry,omitempty"`
+}
+
+func (x *SpdkIobufPoolStats) Reset() {
+	*x = SpdkIobufPoolStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkIobufPoolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkIobufPoolStats) ProtoMessage() {}
+
+func (x *SpdkIobufPoolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkIobufPoolStats.ProtoReflect.Descriptor instead.
+func (*SpdkIobufPoolStats) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SpdkIobufPoolStats) GetCache() uint64 {
+	if x != nil {
+		return x.Cache
+	}
+	return 0
+}
+
+func (x *SpdkIobufPoolStats) GetMain() uint64 {
+	if x != nil {
+		return x.Main
+	}
+	return 0
+}
+
+func (x *SpdkIobufPoolStats) GetRetry() uint64 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+type SpdkIobufStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module    string              `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	SmallPool *SpdkIobufPoolStats `protobuf:"bytes,2,opt,name=small_pool,json=smallPool,proto3" json:"small_pool,omitempty"`
+	LargePool *SpdkIobufPoolStats `protobuf:"bytes,3,opt,name=large_pool,json=largePool,proto3" json:"large_pool,omitempty"`
+}
+
+func (x *SpdkIobufStats) Reset() {
+	*x = SpdkIobufStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkIobufStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkIobufStats) ProtoMessage() {}
+
+func (x *SpdkIobufStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkIobufStats.ProtoReflect.Descriptor instead.
+func (*SpdkIobufStats) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SpdkIobufStats) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *SpdkIobufStats) GetSmallPool() *SpdkIobufPoolStats {
+	if x != nil {
+		return x.SmallPool
+	}
+	return nil
+}
+
+func (x *SpdkIobufStats) GetLargePool() *SpdkIobufPoolStats {
+	if x != nil {
+		return x.LargePool
+	}
+	return nil
+}
+
+type HugepagesStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize uint64 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Total    uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Free     uint64 `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	Reserved uint64 `protobuf:"varint,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Surplus  uint64 `protobuf:"varint,5,opt,name=surplus,proto3" json:"surplus,omitempty"`
+}
+
+func (x *HugepagesStats) Reset() {
+	*x = HugepagesStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HugepagesStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HugepagesStats) ProtoMessage() {}
+
+func (x *HugepagesStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HugepagesStats.ProtoReflect.Descriptor instead.
+func (*HugepagesStats) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HugepagesStats) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *HugepagesStats) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HugepagesStats) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *HugepagesStats) GetReserved() uint64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *HugepagesStats) GetSurplus() uint64 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
+type SpdkMemoryStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Heaps      []*SpdkMemoryHeap `protobuf:"bytes,1,rep,name=heaps,proto3" json:"heaps,omitempty"`
+	Mempools   []*SpdkMempool    `protobuf:"bytes,2,rep,name=mempools,proto3" json:"mempools,omitempty"`
+	IobufStats []*SpdkIobufStats `protobuf:"bytes,3,rep,name=iobuf_stats,json=iobufStats,proto3" json:"iobuf_stats,omitempty"`
+	Hugepages  []*HugepagesStats `protobuf:"bytes,4,rep,name=hugepages,proto3" json:"hugepages,omitempty"`
+}
+
+func (x *SpdkMemoryStats) Reset() {
+	*x = SpdkMemoryStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkMemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkMemoryStats) ProtoMessage() {}
+
+func (x *SpdkMemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkMemoryStats.ProtoReflect.Descriptor instead.
+func (*SpdkMemoryStats) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SpdkMemoryStats) GetHeaps() []*SpdkMemoryHeap {
+	if x != nil {
+		return x.Heaps
+	}
+	return nil
+}
+
+func (x *SpdkMemoryStats) GetMempools() []*SpdkMempool {
+	if x != nil {
+		return x.Mempools
+	}
+	return nil
+}
+
+func (x *SpdkMemoryStats) GetIobufStats() []*SpdkIobufStats {
+	if x != nil {
+		return x.IobufStats
+	}
+	return nil
+}
+
+func (x *SpdkMemoryStats) GetHugepages() []*HugepagesStats {
+	if x != nil {
+		return x.Hugepages
+	}
+	return nil
+}
+
+type DiskVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version                                 string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit                               string `protobuf:"bytes,2,opt,name=gitCommit,proto3" json:"gitCommit,omitempty"`
+	BuildDate                               string `protobuf:"bytes,3,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
+	InstanceManagerDiskServiceAPIVersion    int64  `protobuf:"varint,4,opt,name=instanceManagerDiskServiceAPIVersion,proto3" json:"instanceManagerDiskServiceAPIVersion,omitempty"`
+	InstanceManagerDiskServiceAPIMinVersion int64  `protobuf:"varint,5,opt,name=instanceManagerDiskServiceAPIMinVersion,proto3" json:"instanceManagerDiskServiceAPIMinVersion,omitempty"`
+}
+
+func (x *DiskVersionResponse) Reset() {
+	*x = DiskVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskVersionResponse) ProtoMessage() {}
+
+func (x *DiskVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskVersionResponse.ProtoReflect.Descriptor instead.
+func (*DiskVersionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DiskVersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DiskVersionResponse) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *DiskVersionResponse) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *DiskVersionResponse) GetInstanceManagerDiskServiceAPIVersion() int64 {
+	if x != nil {
+		return x.InstanceManagerDiskServiceAPIVersion
+	}
+	return 0
+}
+
+func (x *DiskVersionResponse) GetInstanceManagerDiskServiceAPIMinVersion() int64 {
+	if x != nil {
+		return x.InstanceManagerDiskServiceAPIMinVersion
+	}
+	return 0
+}
+
+type DiskWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskType DiskType `protobuf:"varint,1,opt,name=disk_type,json=diskType,proto3,enum=imrpc.DiskType" json:"disk_type,omitempty"`
+	// Empty for all the disks
+	DiskName string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+}
+
+func (x *DiskWatchRequest) Reset() {
+	*x = DiskWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskWatchRequest) ProtoMessage() {}
+
+func (x *DiskWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskWatchRequest.ProtoReflect.Descriptor instead.
+func (*DiskWatchRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DiskWatchRequest) GetDiskType() DiskType {
+	if x != nil {
+		return x.DiskType
+	}
+	return DiskType_filesystem
+}
+
+func (x *DiskWatchRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+// DiskWatchEvent is a change of a disk. A created event is sent for each existing disk when the watch starts.
+type DiskWatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of created, deleted, io_errors_increased, health_score_changed and device_changed
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	DiskName  string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid  string `protobuf:"bytes,3,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	DiskPath  string `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// The block devices the disk path resolves to before and after the change
+	OldDevice string `protobuf:"bytes,5,opt,name=old_device,json=oldDevice,proto3" json:"old_device,omitempty"`
+	NewDevice string `protobuf:"bytes,6,opt,name=new_device,json=newDevice,proto3" json:"new_device,omitempty"`
+	// The I/O errors counted by the kernel for the device, which are always 0 if the driver does not count them
+	OldIoErrors uint64 `protobuf:"varint,7,opt,name=old_io_errors,json=oldIoErrors,proto3" json:"old_io_errors,omitempty"`
+	NewIoErrors uint64 `protobuf:"varint,8,opt,name=new_io_errors,json=newIoErrors,proto3" json:"new_io_errors,omitempty"`
+	// The health score from 0 to 100. It is 0 while the device is missing or replaced, and is lowered by the safe
+	// mode, the errors found by the latest scrub and the I/O errors
+	OldHealthScore int32 `protobuf:"varint,9,opt,name=old_health_score,json=oldHealthScore,proto3" json:"old_health_score,omitempty"`
+	NewHealthScore int32 `protobuf:"varint,10,opt,name=new_health_score,json=newHealthScore,proto3" json:"new_health_score,omitempty"`
+	// RFC 3339 timestamp
+	Timestamp string `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *DiskWatchEvent) Reset() {
+	*x = DiskWatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskWatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskWatchEvent) ProtoMessage() {}
+
+func (x *DiskWatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskWatchEvent.ProtoReflect.Descriptor instead.
+func (*DiskWatchEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DiskWatchEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *DiskWatchEvent) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *DiskWatchEvent) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *DiskWatchEvent) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DiskWatchEvent) GetOldDevice() string {
+	if x != nil {
+		return x.OldDevice
+	}
+	return ""
+}
+
+func (x *DiskWatchEvent) GetNewDevice() string {
+	if x != nil {
+		return x.NewDevice
+	}
+	return ""
+}
+
+func (x *DiskWatchEvent) GetOldIoErrors() uint64 {
+	if x != nil {
+		return x.OldIoErrors
+	}
+	return 0
+}
+
+func (x *DiskWatchEvent) GetNewIoErrors() uint64 {
+	if x != nil {
+		return x.NewIoErrors
+	}
+	return 0
+}
+
+func (x *DiskWatchEvent) GetOldHealthScore() int32 {
+	if x != nil {
+		return x.OldHealthScore
+	}
+	return 0
+}
+
+func (x *DiskWatchEvent) GetNewHealthScore() int32 {
+	if x != nil {
+		return x.NewHealthScore
+	}
+	return 0
+}
+
+func (x *DiskWatchEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto protoreflect.FileDescriptor
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDesc = []byte{
+	0x0a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x03, 0x0a, 0x04, 0x44, 0x69, 0x73,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x66, 0x72, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x72, 0x65, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x61, 0x66, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x63, 0x72, 0x75,
+	0x62, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x73, 0x63, 0x72, 0x75, 0x62, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x70, 0x65, 0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x70, 0x65, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x44,
+	0x69, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x78, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x22, 0x7b,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x1e, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x1f, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x11,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe, 0x01, 0x0a, 0x20, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x63, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x63, 0x69, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x69,
+	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x69, 0x70, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x44,
+	0x69, 0x73, 0x6b, 0x57, 0x69, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x57, 0x69, 0x70, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x70,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x77, 0x69, 0x70, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x73, 0x67, 0x22, 0xcc, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x32,
+	0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x5f, 0x6c, 0x76, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x4c, 0x76, 0x6f,
+	0x6c, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6c, 0x65, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x75, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x66, 0x75, 0x61, 0x22, 0x82, 0x01, 0x0a, 0x18,
+	0x44, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0xb4, 0x01, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
+	0x65, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0x7a, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73,
+	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x48, 0x6f, 0x74, 0x70, 0x6c,
+	0x75, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdf, 0x02,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x48, 0x6f, 0x74, 0x70, 0x6c, 0x75, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x2f,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x48, 0x6f, 0x74, 0x70, 0x6c,
+	0x75, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x68, 0x0a, 0x1b, 0x44, 0x69, 0x73, 0x6b, 0x48, 0x6f, 0x74, 0x70, 0x6c, 0x75, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x0e, 0x44, 0x69, 0x73,
+	0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63,
+	0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75,
+	0x62, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b,
+	0x53, 0x63, 0x72, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x84, 0x04, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xe5, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3b, 0x0a,
+	0x1a, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x17, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x77, 0x22, 0xa1, 0x02, 0x0a, 0x0e, 0x53, 0x70, 0x64, 0x6b,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x48, 0x65, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x68, 0x65, 0x61, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x66, 0x72, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x66, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0b,
+	0x53, 0x70, 0x64, 0x6b, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x12, 0x53,
+	0x70, 0x64, 0x6b, 0x49, 0x6f, 0x62, 0x75, 0x66, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x53, 0x70, 0x64, 0x6b, 0x49, 0x6f, 0x62, 0x75, 0x66, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x0a,
+	0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x49, 0x6f, 0x62,
+	0x75, 0x66, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x73, 0x6d, 0x61,
+	0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x49, 0x6f, 0x62, 0x75, 0x66, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
+	0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x48, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73,
+	0x22, 0xdb, 0x01, 0x0a, 0x0f, 0x53, 0x70, 0x64, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x68, 0x65, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x48, 0x65, 0x61, 0x70, 0x52, 0x05, 0x68, 0x65, 0x61, 0x70,
+	0x73, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x73, 0x12, 0x36, 0x0a, 0x0b, 0x69, 0x6f, 0x62, 0x75, 0x66, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x70, 0x64, 0x6b, 0x49, 0x6f, 0x62, 0x75, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x69,
+	0x6f, 0x62, 0x75, 0x66, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x68, 0x75, 0x67,
+	0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0x99,
+	0x02, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x24,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x24, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x27, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50,
+	0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x27, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x50, 0x49,
+	0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x10, 0x44, 0x69,
+	0x73, 0x6b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x0e, 0x44, 0x69,
+	0x73, 0x6b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x49, 0x6f, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x6f, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x65, 0x77,
+	0x49, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x65,
+	0x77, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x25, 0x0a, 0x08, 0x44, 0x69,
+	0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x10,
+	0x01, 0x2a, 0x68, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x69, 0x70, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x19, 0x0a, 0x15, 0x77, 0x69, 0x70, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x75,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x7a, 0x65, 0x72,
+	0x6f, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x6e, 0x76, 0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x6e, 0x76, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x5f, 0x65, 0x72, 0x61, 0x73, 0x65, 0x10, 0x04, 0x32, 0xf6, 0x08, 0x0a, 0x0b,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x44,
+	0x69, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x12, 0x3e, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x2d, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x12,
+	0x68, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x19, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x57,
+	0x69, 0x70, 0x65, 0x12, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x57, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x69, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x12, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x44, 0x0a, 0x12, 0x53,
+	0x70, 0x64, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x4b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x4b,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x44,
+	0x69, 0x73, 0x6b, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x6b, 0x48, 0x6f, 0x74, 0x70, 0x6c, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x65,
+	0x74, 0x12, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x48, 0x6f,
+	0x74, 0x70, 0x6c, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x48, 0x6f, 0x74, 0x70, 0x6c, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x4e, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x47, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x42, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x65, 0x74, 0x12,
+	0x1a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75,
+	0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x40, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67,
+	0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescOnce sync.Once
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDesc
+)
+
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescGZIP() []byte {
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescOnce.Do(func() {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData)
+	})
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDescData
+}
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_goTypes = []interface{}{
+	(DiskType)(0),                            // 0: imrpc.DiskType
+	(DiskWipeMode)(0),                        // 1: imrpc.DiskWipeMode
+	(*Disk)(nil),                             // 2: imrpc.Disk
+	(*ReplicaInstance)(nil),                  // 3: imrpc.ReplicaInstance
+	(*DiskCreateRequest)(nil),                // 4: imrpc.DiskCreateRequest
+	(*DiskGetRequest)(nil),                   // 5: imrpc.DiskGetRequest
+	(*DiskDeleteRequest)(nil),                // 6: imrpc.DiskDeleteRequest
+	(*DiskReplicaInstanceListRequest)(nil),   // 7: imrpc.DiskReplicaInstanceListRequest
+	(*DiskReplicaInstanceListResponse)(nil),  // 8: imrpc.DiskReplicaInstanceListResponse
+	(*DiskReplicaInstanceDeleteRequest)(nil), // 9: imrpc.DiskReplicaInstanceDeleteRequest
+	(*DiskWipeRequest)(nil),                  // 10: imrpc.DiskWipeRequest
+	(*DiskWipeProgress)(nil),                 // 11: imrpc.DiskWipeProgress
+	(*DiskRepairRequest)(nil),                // 12: imrpc.DiskRepairRequest
+	(*DiskWriteCache)(nil),                   // 13: imrpc.DiskWriteCache
+	(*DiskWriteCacheGetRequest)(nil),         // 14: imrpc.DiskWriteCacheGetRequest
+	(*DiskWriteCacheSetRequest)(nil),         // 15: imrpc.DiskWriteCacheSetRequest
+	(*DiskFlushRequest)(nil),                 // 16: imrpc.DiskFlushRequest
+	(*DiskHotplugEvent)(nil),                 // 17: imrpc.DiskHotplugEvent
+	(*DiskHotplugStatus)(nil),                // 18: imrpc.DiskHotplugStatus
+	(*DiskHotplugStatusGetRequest)(nil),      // 19: imrpc.DiskHotplugStatusGetRequest
+	(*DiskScrubError)(nil),                   // 20: imrpc.DiskScrubError
+	(*DiskScrubResult)(nil),                  // 21: imrpc.DiskScrubResult
+	(*DiskScrubEvent)(nil),                   // 22: imrpc.DiskScrubEvent
+	(*DiskScrubStatus)(nil),                  // 23: imrpc.DiskScrubStatus
+	(*DiskScrubStatusGetRequest)(nil),        // 24: imrpc.DiskScrubStatusGetRequest
+	(*DiskScrubSetRequest)(nil),              // 25: imrpc.DiskScrubSetRequest
+	(*SpdkMemoryHeap)(nil),                   // 26: imrpc.SpdkMemoryHeap
+	(*SpdkMempool)(nil),                      // 27: imrpc.SpdkMempool
+	(*SpdkIobufPoolStats)(nil),               // 28: imrpc.SpdkIobufPoolStats
+	(*SpdkIobufStats)(nil),                   // 29: imrpc.SpdkIobufStats
+	(*HugepagesStats)(nil),                   // 30: imrpc.HugepagesStats
+	(*SpdkMemoryStats)(nil),                  // 31: imrpc.SpdkMemoryStats
+	(*DiskVersionResponse)(nil),              // 32: imrpc.DiskVersionResponse
+	(*DiskWatchRequest)(nil),                 // 33: imrpc.DiskWatchRequest
+	(*DiskWatchEvent)(nil),                   // 34: imrpc.DiskWatchEvent
+	nil,                                      // 35: imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry
+	(*emptypb.Empty)(nil),                    // 36: google.protobuf.Empty
+}
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_depIdxs = []int32{
+	23, // 0: imrpc.Disk.scrub:type_name -> imrpc.DiskScrubStatus
+	0,  // 1: imrpc.DiskCreateRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 2: imrpc.DiskGetRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 3: imrpc.DiskDeleteRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 4: imrpc.DiskReplicaInstanceListRequest.disk_type:type_name -> imrpc.DiskType
+	35, // 5: imrpc.DiskReplicaInstanceListResponse.replica_instances:type_name -> imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry
+	0,  // 6: imrpc.DiskReplicaInstanceDeleteRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 7: imrpc.DiskWipeRequest.disk_type:type_name -> imrpc.DiskType
+	1,  // 8: imrpc.DiskWipeRequest.mode:type_name -> imrpc.DiskWipeMode
+	1,  // 9: imrpc.DiskWipeProgress.mode:type_name -> imrpc.DiskWipeMode
+	0,  // 10: imrpc.DiskRepairRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 11: imrpc.DiskWriteCacheGetRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 12: imrpc.DiskWriteCacheSetRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 13: imrpc.DiskFlushRequest.disk_type:type_name -> imrpc.DiskType
+	17, // 14: imrpc.DiskHotplugStatus.events:type_name -> imrpc.DiskHotplugEvent
+	0,  // 15: imrpc.DiskHotplugStatusGetRequest.disk_type:type_name -> imrpc.DiskType
+	20, // 16: imrpc.DiskScrubResult.errors:type_name -> imrpc.DiskScrubError
+	20, // 17: imrpc.DiskScrubStatus.errors:type_name -> imrpc.DiskScrubError
+	21, // 18: imrpc.DiskScrubStatus.history:type_name -> imrpc.DiskScrubResult
+	22, // 19: imrpc.DiskScrubStatus.events:type_name -> imrpc.DiskScrubEvent
+	0,  // 20: imrpc.DiskScrubStatusGetRequest.disk_type:type_name -> imrpc.DiskType
+	0,  // 21: imrpc.DiskScrubSetRequest.disk_type:type_name -> imrpc.DiskType
+	28, // 22: imrpc.SpdkIobufStats.small_pool:type_name -> imrpc.SpdkIobufPoolStats
+	28, // 23: imrpc.SpdkIobufStats.large_pool:type_name -> imrpc.SpdkIobufPoolStats
+	26, // 24: imrpc.SpdkMemoryStats.heaps:type_name -> imrpc.SpdkMemoryHeap
+	27, // 25: imrpc.SpdkMemoryStats.mempools:type_name -> imrpc.SpdkMempool
+	29, // 26: imrpc.SpdkMemoryStats.iobuf_stats:type_name -> imrpc.SpdkIobufStats
+	30, // 27: imrpc.SpdkMemoryStats.hugepages:type_name -> imrpc.HugepagesStats
+	0,  // 28: imrpc.DiskWatchRequest.disk_type:type_name -> imrpc.DiskType
+	3,  // 29: imrpc.DiskReplicaInstanceListResponse.ReplicaInstancesEntry.value:type_name -> imrpc.ReplicaInstance
+	4,  // 30: imrpc.DiskService.DiskCreate:input_type -> imrpc.DiskCreateRequest
+	6,  // 31: imrpc.DiskService.DiskDelete:input_type -> imrpc.DiskDeleteRequest
+	5,  // 32: imrpc.DiskService.DiskGet:input_type -> imrpc.DiskGetRequest
+	7,  // 33: imrpc.DiskService.DiskReplicaInstanceList:input_type -> imrpc.DiskReplicaInstanceListRequest
+	9,  // 34: imrpc.DiskService.DiskReplicaInstanceDelete:input_type -> imrpc.DiskReplicaInstanceDeleteRequest
+	10, // 35: imrpc.DiskService.DiskWipe:input_type -> imrpc.DiskWipeRequest
+	12, // 36: imrpc.DiskService.DiskRepair:input_type -> imrpc.DiskRepairRequest
+	36, // 37: imrpc.DiskService.SpdkMemoryStatsGet:input_type -> google.protobuf.Empty
+	14, // 38: imrpc.DiskService.DiskWriteCacheGet:input_type -> imrpc.DiskWriteCacheGetRequest
+	15, // 39: imrpc.DiskService.DiskWriteCacheSet:input_type -> imrpc.DiskWriteCacheSetRequest
+	16, // 40: imrpc.DiskService.DiskFlush:input_type -> imrpc.DiskFlushRequest
+	19, // 41: imrpc.DiskService.DiskHotplugStatusGet:input_type -> imrpc.DiskHotplugStatusGetRequest
+	24, // 42: imrpc.DiskService.DiskScrubStatusGet:input_type -> imrpc.DiskScrubStatusGetRequest
+	25, // 43: imrpc.DiskService.DiskScrubSet:input_type -> imrpc.DiskScrubSetRequest
+	33, // 44: imrpc.DiskService.DiskWatch:input_type -> imrpc.DiskWatchRequest
+	36, // 45: imrpc.DiskService.VersionGet:input_type -> google.protobuf.Empty
+	2,  // 46: imrpc.DiskService.DiskCreate:output_type -> imrpc.Disk
+	36, // 47: imrpc.DiskService.DiskDelete:output_type -> google.protobuf.Empty
+	2,  // 48: imrpc.DiskService.DiskGet:output_type -> imrpc.Disk
+	8,  // 49: imrpc.DiskService.DiskReplicaInstanceList:output_type -> imrpc.DiskReplicaInstanceListResponse
+	36, // 50: imrpc.DiskService.DiskReplicaInstanceDelete:output_type -> google.protobuf.Empty
+	11, // 51: imrpc.DiskService.DiskWipe:output_type -> imrpc.DiskWipeProgress
+	2,  // 52: imrpc.DiskService.DiskRepair:output_type -> imrpc.Disk
+	31, // 53: imrpc.DiskService.SpdkMemoryStatsGet:output_type -> imrpc.SpdkMemoryStats
+	13, // 54: imrpc.DiskService.DiskWriteCacheGet:output_type -> imrpc.DiskWriteCache
+	13, // 55: imrpc.DiskService.DiskWriteCacheSet:output_type -> imrpc.DiskWriteCache
+	36, // 56: imrpc.DiskService.DiskFlush:output_type -> google.protobuf.Empty
+	18, // 57: imrpc.DiskService.DiskHotplugStatusGet:output_type -> imrpc.DiskHotplugStatus
+	23, // 58: imrpc.DiskService.DiskScrubStatusGet:output_type -> imrpc.DiskScrubStatus
+	23, // 59: imrpc.DiskService.DiskScrubSet:output_type -> imrpc.DiskScrubStatus
+	34, // 60: imrpc.DiskService.DiskWatch:output_type -> imrpc.DiskWatchEvent
+	32, // 61: imrpc.DiskService.VersionGet:output_type -> imrpc.DiskVersionResponse
+	46, // [46:62] is the sub-list for method output_type
+	30, // [30:46] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_init() }
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_init() {
+	if File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Disk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskReplicaInstanceListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskReplicaInstanceListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskReplicaInstanceDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWipeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWipeProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskRepairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWriteCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWriteCacheGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWriteCacheSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskHotplugEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskHotplugStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskHotplugStatusGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskScrubError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskScrubResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskScrubEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskScrubStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskScrubStatusGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskScrubSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkMemoryHeap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkMempool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkIobufPoolStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkIobufStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -997,8 +3725,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_ini
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskDeleteRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HugepagesStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1009,8 +3737,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_ini
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskReplicaInstanceListRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkMemoryStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1021,8 +3749,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_ini
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskReplicaInstanceListResponse); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1033,8 +3761,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_ini
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskReplicaInstanceDeleteRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1045,8 +3773,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_ini
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskVersionResponse); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskWatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1063,8 +3791,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_ini
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_disk_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1096,6 +3824,16 @@ type DiskServiceClient interface {
 	DiskGet(ctx context.Context, in *DiskGetRequest, opts ...grpc.CallOption) (*Disk, error)
 	DiskReplicaInstanceList(ctx context.Context, in *DiskReplicaInstanceListRequest, opts ...grpc.CallOption) (*DiskReplicaInstanceListResponse, error)
 	DiskReplicaInstanceDelete(ctx context.Context, in *DiskReplicaInstanceDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskWipe(ctx context.Context, in *DiskWipeRequest, opts ...grpc.CallOption) (DiskService_DiskWipeClient, error)
+	DiskRepair(ctx context.Context, in *DiskRepairRequest, opts ...grpc.CallOption) (*Disk, error)
+	SpdkMemoryStatsGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SpdkMemoryStats, error)
+	DiskWriteCacheGet(ctx context.Context, in *DiskWriteCacheGetRequest, opts ...grpc.CallOption) (*DiskWriteCache, error)
+	DiskWriteCacheSet(ctx context.Context, in *DiskWriteCacheSetRequest, opts ...grpc.CallOption) (*DiskWriteCache, error)
+	DiskFlush(ctx context.Context, in *DiskFlushRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskHotplugStatusGet(ctx context.Context, in *DiskHotplugStatusGetRequest, opts ...grpc.CallOption) (*DiskHotplugStatus, error)
+	DiskScrubStatusGet(ctx context.Context, in *DiskScrubStatusGetRequest, opts ...grpc.CallOption) (*DiskScrubStatus, error)
+	DiskScrubSet(ctx context.Context, in *DiskScrubSetRequest, opts ...grpc.CallOption) (*DiskScrubStatus, error)
+	DiskWatch(ctx context.Context, in *DiskWatchRequest, opts ...grpc.CallOption) (DiskService_DiskWatchClient, error)
 	VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiskVersionResponse, error)
 }
 
@@ -1152,6 +3890,142 @@ func (c *diskServiceClient) DiskReplicaInstanceDelete(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *diskServiceClient) DiskWipe(ctx context.Context, in *DiskWipeRequest, opts ...grpc.CallOption) (DiskService_DiskWipeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DiskService_serviceDesc.Streams[0], "/imrpc.DiskService/DiskWipe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &diskServiceDiskWipeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DiskService_DiskWipeClient interface {
+	Recv() (*DiskWipeProgress, error)
+	grpc.ClientStream
+}
+
+type diskServiceDiskWipeClient struct {
+	grpc.ClientStream
+}
+
+func (x *diskServiceDiskWipeClient) Recv() (*DiskWipeProgress, error) {
+	m := new(DiskWipeProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *diskServiceClient) DiskRepair(ctx context.Context, in *DiskRepairRequest, opts ...grpc.CallOption) (*Disk, error) {
+	out := new(Disk)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskRepair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) SpdkMemoryStatsGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SpdkMemoryStats, error) {
+	out := new(SpdkMemoryStats)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/SpdkMemoryStatsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskWriteCacheGet(ctx context.Context, in *DiskWriteCacheGetRequest, opts ...grpc.CallOption) (*DiskWriteCache, error) {
+	out := new(DiskWriteCache)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskWriteCacheGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskWriteCacheSet(ctx context.Context, in *DiskWriteCacheSetRequest, opts ...grpc.CallOption) (*DiskWriteCache, error) {
+	out := new(DiskWriteCache)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskWriteCacheSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskFlush(ctx context.Context, in *DiskFlushRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskFlush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskHotplugStatusGet(ctx context.Context, in *DiskHotplugStatusGetRequest, opts ...grpc.CallOption) (*DiskHotplugStatus, error) {
+	out := new(DiskHotplugStatus)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskHotplugStatusGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskScrubStatusGet(ctx context.Context, in *DiskScrubStatusGetRequest, opts ...grpc.CallOption) (*DiskScrubStatus, error) {
+	out := new(DiskScrubStatus)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskScrubStatusGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskScrubSet(ctx context.Context, in *DiskScrubSetRequest, opts ...grpc.CallOption) (*DiskScrubStatus, error) {
+	out := new(DiskScrubStatus)
+	err := c.cc.Invoke(ctx, "/imrpc.DiskService/DiskScrubSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskServiceClient) DiskWatch(ctx context.Context, in *DiskWatchRequest, opts ...grpc.CallOption) (DiskService_DiskWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DiskService_serviceDesc.Streams[1], "/imrpc.DiskService/DiskWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &diskServiceDiskWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DiskService_DiskWatchClient interface {
+	Recv() (*DiskWatchEvent, error)
+	grpc.ClientStream
+}
+
+type diskServiceDiskWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *diskServiceDiskWatchClient) Recv() (*DiskWatchEvent, error) {
+	m := new(DiskWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *diskServiceClient) VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiskVersionResponse, error) {
 	out := new(DiskVersionResponse)
 	err := c.cc.Invoke(ctx, "/imrpc.DiskService/VersionGet", in, out, opts...)
@@ -1168,6 +4042,16 @@ type DiskServiceServer interface {
 	DiskGet(context.Context, *DiskGetRequest) (*Disk, error)
 	DiskReplicaInstanceList(context.Context, *DiskReplicaInstanceListRequest) (*DiskReplicaInstanceListResponse, error)
 	DiskReplicaInstanceDelete(context.Context, *DiskReplicaInstanceDeleteRequest) (*emptypb.Empty, error)
+	DiskWipe(*DiskWipeRequest, DiskService_DiskWipeServer) error
+	DiskRepair(context.Context, *DiskRepairRequest) (*Disk, error)
+	SpdkMemoryStatsGet(context.Context, *emptypb.Empty) (*SpdkMemoryStats, error)
+	DiskWriteCacheGet(context.Context, *DiskWriteCacheGetRequest) (*DiskWriteCache, error)
+	DiskWriteCacheSet(context.Context, *DiskWriteCacheSetRequest) (*DiskWriteCache, error)
+	DiskFlush(context.Context, *DiskFlushRequest) (*emptypb.Empty, error)
+	DiskHotplugStatusGet(context.Context, *DiskHotplugStatusGetRequest) (*DiskHotplugStatus, error)
+	DiskScrubStatusGet(context.Context, *DiskScrubStatusGetRequest) (*DiskScrubStatus, error)
+	DiskScrubSet(context.Context, *DiskScrubSetRequest) (*DiskScrubStatus, error)
+	DiskWatch(*DiskWatchRequest, DiskService_DiskWatchServer) error
 	VersionGet(context.Context, *emptypb.Empty) (*DiskVersionResponse, error)
 }
 
@@ -1190,6 +4074,36 @@ func (*UnimplementedDiskServiceServer) DiskReplicaInstanceList(context.Context,
 func (*UnimplementedDiskServiceServer) DiskReplicaInstanceDelete(context.Context, *DiskReplicaInstanceDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiskReplicaInstanceDelete not implemented")
 }
+func (*UnimplementedDiskServiceServer) DiskWipe(*DiskWipeRequest, DiskService_DiskWipeServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiskWipe not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskRepair(context.Context, *DiskRepairRequest) (*Disk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskRepair not implemented")
+}
+func (*UnimplementedDiskServiceServer) SpdkMemoryStatsGet(context.Context, *emptypb.Empty) (*SpdkMemoryStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpdkMemoryStatsGet not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskWriteCacheGet(context.Context, *DiskWriteCacheGetRequest) (*DiskWriteCache, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskWriteCacheGet not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskWriteCacheSet(context.Context, *DiskWriteCacheSetRequest) (*DiskWriteCache, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskWriteCacheSet not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskFlush(context.Context, *DiskFlushRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskFlush not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskHotplugStatusGet(context.Context, *DiskHotplugStatusGetRequest) (*DiskHotplugStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskHotplugStatusGet not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskScrubStatusGet(context.Context, *DiskScrubStatusGetRequest) (*DiskScrubStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskScrubStatusGet not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskScrubSet(context.Context, *DiskScrubSetRequest) (*DiskScrubStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskScrubSet not implemented")
+}
+func (*UnimplementedDiskServiceServer) DiskWatch(*DiskWatchRequest, DiskService_DiskWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiskWatch not implemented")
+}
 func (*UnimplementedDiskServiceServer) VersionGet(context.Context, *emptypb.Empty) (*DiskVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VersionGet not implemented")
 }
@@ -1288,6 +4202,192 @@ func _DiskService_DiskReplicaInstanceDelete_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiskService_DiskWipe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiskWipeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiskServiceServer).DiskWipe(m, &diskServiceDiskWipeServer{stream})
+}
+
+type DiskService_DiskWipeServer interface {
+	Send(*DiskWipeProgress) error
+	grpc.ServerStream
+}
+
+type diskServiceDiskWipeServer struct {
+	grpc.ServerStream
+}
+
+func (x *diskServiceDiskWipeServer) Send(m *DiskWipeProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DiskService_DiskRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskRepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskRepair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskRepair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskRepair(ctx, req.(*DiskRepairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_SpdkMemoryStatsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).SpdkMemoryStatsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/SpdkMemoryStatsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).SpdkMemoryStatsGet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskWriteCacheGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskWriteCacheGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskWriteCacheGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskWriteCacheGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskWriteCacheGet(ctx, req.(*DiskWriteCacheGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskWriteCacheSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskWriteCacheSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskWriteCacheSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskWriteCacheSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskWriteCacheSet(ctx, req.(*DiskWriteCacheSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskFlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskFlush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskFlush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskFlush(ctx, req.(*DiskFlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskHotplugStatusGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskHotplugStatusGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskHotplugStatusGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskHotplugStatusGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskHotplugStatusGet(ctx, req.(*DiskHotplugStatusGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskScrubStatusGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskScrubStatusGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskScrubStatusGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskScrubStatusGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskScrubStatusGet(ctx, req.(*DiskScrubStatusGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskScrubSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskScrubSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServiceServer).DiskScrubSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.DiskService/DiskScrubSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServiceServer).DiskScrubSet(ctx, req.(*DiskScrubSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiskService_DiskWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiskWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiskServiceServer).DiskWatch(m, &diskServiceDiskWatchServer{stream})
+}
+
+type DiskService_DiskWatchServer interface {
+	Send(*DiskWatchEvent) error
+	grpc.ServerStream
+}
+
+type diskServiceDiskWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *diskServiceDiskWatchServer) Send(m *DiskWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DiskService_VersionGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1330,11 +4430,54 @@ var _DiskService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DiskReplicaInstanceDelete",
 			Handler:    _DiskService_DiskReplicaInstanceDelete_Handler,
 		},
+		{
+			MethodName: "DiskRepair",
+			Handler:    _DiskService_DiskRepair_Handler,
+		},
+		{
+			MethodName: "SpdkMemoryStatsGet",
+			Handler:    _DiskService_SpdkMemoryStatsGet_Handler,
+		},
+		{
+			MethodName: "DiskWriteCacheGet",
+			Handler:    _DiskService_DiskWriteCacheGet_Handler,
+		},
+		{
+			MethodName: "DiskWriteCacheSet",
+			Handler:    _DiskService_DiskWriteCacheSet_Handler,
+		},
+		{
+			MethodName: "DiskFlush",
+			Handler:    _DiskService_DiskFlush_Handler,
+		},
+		{
+			MethodName: "DiskHotplugStatusGet",
+			Handler:    _DiskService_DiskHotplugStatusGet_Handler,
+		},
+		{
+			MethodName: "DiskScrubStatusGet",
+			Handler:    _DiskService_DiskScrubStatusGet_Handler,
+		},
+		{
+			MethodName: "DiskScrubSet",
+			Handler:    _DiskService_DiskScrubSet_Handler,
+		},
 		{
 			MethodName: "VersionGet",
 			Handler:    _DiskService_VersionGet_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DiskWipe",
+			Handler:       _DiskService_DiskWipe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DiskWatch",
+			Handler:       _DiskService_DiskWatch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "github.com/longhorn/longhorn-instance-manager/pkg/imrpc/disk.proto",
 }
@@ -35,6 +35,27 @@ type ProcessSpec struct {
 	Args      []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
 	PortCount int32    `protobuf:"varint,4,opt,name=port_count,json=portCount,proto3" json:"port_count,omitempty"`
 	PortArgs  []string `protobuf:"bytes,5,rep,name=port_args,json=portArgs,proto3" json:"port_args,omitempty"`
+	// Helper processes started after, stopped before and replaced along with the process
+	Sidecars []*ProcessSidecarSpec `protobuf:"bytes,6,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	// Environment variables of the process and its sidecars, set on top of the ones passed from the instance manager
+	Envs map[string]string `protobuf:"bytes,7,rep,name=envs,proto3" json:"envs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Labels of the instance of the process, e.g. the volume, for the label selectors of the instance service
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// critical, high or normal, which sets the OOM score adjustment and nice value of the process once started
+	PriorityClass string `protobuf:"bytes,9,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	// Limits of the process along with its children, enforced by a cgroup v2 of the process. Unlimited if 0
+	CpuMillicores int64 `protobuf:"varint,10,opt,name=cpu_millicores,json=cpuMillicores,proto3" json:"cpu_millicores,omitempty"`
+	MemoryBytes   int64 `protobuf:"varint,11,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	// CPUs and NUMA nodes the process along with its children is pinned to once started, in the list format, e.g.
+	// "2-3,6". The process is pinned to the CPUs of the set on the nodes if both are specified. Not pinned if empty
+	CpuSet   string `protobuf:"bytes,12,opt,name=cpu_set,json=cpuSet,proto3" json:"cpu_set,omitempty"`
+	NumaNode string `protobuf:"bytes,13,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
+	// Restricts the privileges of the process at spawn. The process inherits the full privileges of the instance
+	// manager if unset
+	Privileges *ProcessPrivileges `protobuf:"bytes,14,opt,name=privileges,proto3" json:"privileges,omitempty"`
+	// Time the process is given to stop gracefully on deletion before it is killed. The default of the instance
+	// manager if 0
+	StopTimeoutSeconds int64 `protobuf:"varint,15,opt,name=stop_timeout_seconds,json=stopTimeoutSeconds,proto3" json:"stop_timeout_seconds,omitempty"`
 }
 
 func (x *ProcessSpec) Reset() {
@@ -104,20 +125,89 @@ func (x *ProcessSpec) GetPortArgs() []string {
 	return nil
 }
 
-type ProcessStatus struct {
+func (x *ProcessSpec) GetSidecars() []*ProcessSidecarSpec {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
+}
+
+func (x *ProcessSpec) GetEnvs() map[string]string {
+	if x != nil {
+		return x.Envs
+	}
+	return nil
+}
+
+func (x *ProcessSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ProcessSpec) GetPriorityClass() string {
+	if x != nil {
+		return x.PriorityClass
+	}
+	return ""
+}
+
+func (x *ProcessSpec) GetCpuMillicores() int64 {
+	if x != nil {
+		return x.CpuMillicores
+	}
+	return 0
+}
+
+func (x *ProcessSpec) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ProcessSpec) GetCpuSet() string {
+	if x != nil {
+		return x.CpuSet
+	}
+	return ""
+}
+
+func (x *ProcessSpec) GetNumaNode() string {
+	if x != nil {
+		return x.NumaNode
+	}
+	return ""
+}
+
+func (x *ProcessSpec) GetPrivileges() *ProcessPrivileges {
+	if x != nil {
+		return x.Privileges
+	}
+	return nil
+}
+
+func (x *ProcessSpec) GetStopTimeoutSeconds() int64 {
+	if x != nil {
+		return x.StopTimeoutSeconds
+	}
+	return 0
+}
+
+type ProcessPrivileges struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State      string          `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	ErrorMsg   string          `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	PortStart  int32           `protobuf:"varint,3,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
-	PortEnd    int32           `protobuf:"varint,4,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
-	Conditions map[string]bool `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Capabilities the process keeps, e.g. CAP_SYS_ADMIN, with all the others dropped. All are dropped if empty
+	Capabilities []string `protobuf:"bytes,1,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// Prevents the process and its children from gaining privileges by exec, e.g. of setuid binaries
+	NoNewPrivileges bool `protobuf:"varint,2,opt,name=no_new_privileges,json=noNewPrivileges,proto3" json:"no_new_privileges,omitempty"`
 }
 
-func (x *ProcessStatus) Reset() {
-	*x = ProcessStatus{}
+func (x *ProcessPrivileges) Reset() {
+	*x = ProcessPrivileges{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -125,13 +215,13 @@ func (x *ProcessStatus) Reset() {
 	}
 }
 
-func (x *ProcessStatus) String() string {
+func (x *ProcessPrivileges) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessStatus) ProtoMessage() {}
+func (*ProcessPrivileges) ProtoMessage() {}
 
-func (x *ProcessStatus) ProtoReflect() protoreflect.Message {
+func (x *ProcessPrivileges) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -143,56 +233,37 @@ func (x *ProcessStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessStatus.ProtoReflect.Descriptor instead.
-func (*ProcessStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessPrivileges.ProtoReflect.Descriptor instead.
+func (*ProcessPrivileges) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ProcessStatus) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-func (x *ProcessStatus) GetErrorMsg() string {
-	if x != nil {
-		return x.ErrorMsg
-	}
-	return ""
-}
-
-func (x *ProcessStatus) GetPortStart() int32 {
-	if x != nil {
-		return x.PortStart
-	}
-	return 0
-}
-
-func (x *ProcessStatus) GetPortEnd() int32 {
+func (x *ProcessPrivileges) GetCapabilities() []string {
 	if x != nil {
-		return x.PortEnd
+		return x.Capabilities
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessStatus) GetConditions() map[string]bool {
+func (x *ProcessPrivileges) GetNoNewPrivileges() bool {
 	if x != nil {
-		return x.Conditions
+		return x.NoNewPrivileges
 	}
-	return nil
+	return false
 }
 
-type ProcessCreateRequest struct {
+type ProcessSidecarSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Spec *ProcessSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Binary string   `protobuf:"bytes,2,opt,name=binary,proto3" json:"binary,omitempty"`
+	Args   []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
 }
 
-func (x *ProcessCreateRequest) Reset() {
-	*x = ProcessCreateRequest{}
+func (x *ProcessSidecarSpec) Reset() {
+	*x = ProcessSidecarSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -200,13 +271,13 @@ func (x *ProcessCreateRequest) Reset() {
 	}
 }
 
-func (x *ProcessCreateRequest) String() string {
+func (x *ProcessSidecarSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessCreateRequest) ProtoMessage() {}
+func (*ProcessSidecarSpec) ProtoMessage() {}
 
-func (x *ProcessCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *ProcessSidecarSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,28 +289,51 @@ func (x *ProcessCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessCreateRequest.ProtoReflect.Descriptor instead.
-func (*ProcessCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessSidecarSpec.ProtoReflect.Descriptor instead.
+func (*ProcessSidecarSpec) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ProcessCreateRequest) GetSpec() *ProcessSpec {
+func (x *ProcessSidecarSpec) GetName() string {
 	if x != nil {
-		return x.Spec
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessSidecarSpec) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *ProcessSidecarSpec) GetArgs() []string {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-type ProcessDeleteRequest struct {
+type ProcessStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State      string                  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorMsg   string                  `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	PortStart  int32                   `protobuf:"varint,3,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd    int32                   `protobuf:"varint,4,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	Conditions map[string]bool         `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Sidecars   []*ProcessSidecarStatus `protobuf:"bytes,6,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	// Only set by ProcessGet and ProcessList
+	ResourceUsage *ResourceUsage `protobuf:"bytes,7,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
+	// Generated each time the process is created
+	Uuid string `protobuf:"bytes,8,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *ProcessDeleteRequest) Reset() {
-	*x = ProcessDeleteRequest{}
+func (x *ProcessStatus) Reset() {
+	*x = ProcessStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,13 +341,13 @@ func (x *ProcessDeleteRequest) Reset() {
 	}
 }
 
-func (x *ProcessDeleteRequest) String() string {
+func (x *ProcessStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessDeleteRequest) ProtoMessage() {}
+func (*ProcessStatus) ProtoMessage() {}
 
-func (x *ProcessDeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *ProcessStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -265,28 +359,84 @@ func (x *ProcessDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessDeleteRequest.ProtoReflect.Descriptor instead.
-func (*ProcessDeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessStatus.ProtoReflect.Descriptor instead.
+func (*ProcessStatus) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ProcessDeleteRequest) GetName() string {
+func (x *ProcessStatus) GetState() string {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
 	return ""
 }
 
-type ProcessGetRequest struct {
+func (x *ProcessStatus) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *ProcessStatus) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *ProcessStatus) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *ProcessStatus) GetConditions() map[string]bool {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *ProcessStatus) GetSidecars() []*ProcessSidecarStatus {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
+}
+
+func (x *ProcessStatus) GetResourceUsage() *ResourceUsage {
+	if x != nil {
+		return x.ResourceUsage
+	}
+	return nil
+}
+
+func (x *ProcessStatus) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type ResourceUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Cumulative CPU time in the user and the kernel mode
+	CpuTimeNs     uint64 `protobuf:"varint,1,opt,name=cpu_time_ns,json=cpuTimeNs,proto3" json:"cpu_time_ns,omitempty"`
+	RssBytes      uint64 `protobuf:"varint,2,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	OpenFds       int32  `protobuf:"varint,3,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+	UptimeSeconds int64  `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// The usage is of the process shared by the instances, e.g. spdk_tgt of the v2 data engine, rather than of the
+	// instance alone
+	Shared bool `protobuf:"varint,5,opt,name=shared,proto3" json:"shared,omitempty"`
 }
 
-func (x *ProcessGetRequest) Reset() {
-	*x = ProcessGetRequest{}
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,13 +444,13 @@ func (x *ProcessGetRequest) Reset() {
 	}
 }
 
-func (x *ProcessGetRequest) String() string {
+func (x *ResourceUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessGetRequest) ProtoMessage() {}
+func (*ResourceUsage) ProtoMessage() {}
 
-func (x *ProcessGetRequest) ProtoReflect() protoreflect.Message {
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -312,30 +462,58 @@ func (x *ProcessGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessGetRequest.ProtoReflect.Descriptor instead.
-func (*ProcessGetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ProcessGetRequest) GetName() string {
+func (x *ResourceUsage) GetCpuTimeNs() uint64 {
 	if x != nil {
-		return x.Name
+		return x.CpuTimeNs
 	}
-	return ""
+	return 0
 }
 
-type ProcessResponse struct {
+func (x *ResourceUsage) GetRssBytes() uint64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetOpenFds() int32 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type ProcessSidecarStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Spec    *ProcessSpec   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status  *ProcessStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Deleted bool           `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State    string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorMsg string `protobuf:"bytes,3,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
 }
 
-func (x *ProcessResponse) Reset() {
-	*x = ProcessResponse{}
+func (x *ProcessSidecarStatus) Reset() {
+	*x = ProcessSidecarStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,13 +521,13 @@ func (x *ProcessResponse) Reset() {
 	}
 }
 
-func (x *ProcessResponse) String() string {
+func (x *ProcessSidecarStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessResponse) ProtoMessage() {}
+func (*ProcessSidecarStatus) ProtoMessage() {}
 
-func (x *ProcessResponse) ProtoReflect() protoreflect.Message {
+func (x *ProcessSidecarStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,40 +539,45 @@ func (x *ProcessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessResponse.ProtoReflect.Descriptor instead.
-func (*ProcessResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessSidecarStatus.ProtoReflect.Descriptor instead.
+func (*ProcessSidecarStatus) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ProcessResponse) GetSpec() *ProcessSpec {
+func (x *ProcessSidecarStatus) GetName() string {
 	if x != nil {
-		return x.Spec
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ProcessResponse) GetStatus() *ProcessStatus {
+func (x *ProcessSidecarStatus) GetState() string {
 	if x != nil {
-		return x.Status
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *ProcessResponse) GetDeleted() bool {
+func (x *ProcessSidecarStatus) GetErrorMsg() string {
 	if x != nil {
-		return x.Deleted
+		return x.ErrorMsg
 	}
-	return false
+	return ""
 }
 
-type ProcessListRequest struct {
+type ProcessCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Spec *ProcessSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Validate the spec, e.g. the binary and the availability of the ports, without creating the process. The
+	// invalid fields are returned as the BadRequest details of the InvalidArgument error
+	ValidateOnly bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 }
 
-func (x *ProcessListRequest) Reset() {
-	*x = ProcessListRequest{}
+func (x *ProcessCreateRequest) Reset() {
+	*x = ProcessCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,13 +585,13 @@ func (x *ProcessListRequest) Reset() {
 	}
 }
 
-func (x *ProcessListRequest) String() string {
+func (x *ProcessCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessListRequest) ProtoMessage() {}
+func (*ProcessCreateRequest) ProtoMessage() {}
 
-func (x *ProcessListRequest) ProtoReflect() protoreflect.Message {
+func (x *ProcessCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,21 +603,37 @@ func (x *ProcessListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessListRequest.ProtoReflect.Descriptor instead.
-func (*ProcessListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessCreateRequest.ProtoReflect.Descriptor instead.
+func (*ProcessCreateRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{6}
 }
 
-type ProcessListResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ProcessCreateRequest) GetSpec() *ProcessSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
 
-	Processes map[string]*ProcessResponse `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *ProcessCreateRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
 }
 
-func (x *ProcessListResponse) Reset() {
-	*x = ProcessListResponse{}
+type ProcessDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Overrides the stop timeout of the process for this deletion if not 0
+	StopTimeoutSeconds int64 `protobuf:"varint,2,opt,name=stop_timeout_seconds,json=stopTimeoutSeconds,proto3" json:"stop_timeout_seconds,omitempty"`
+}
+
+func (x *ProcessDeleteRequest) Reset() {
+	*x = ProcessDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +641,13 @@ func (x *ProcessListResponse) Reset() {
 	}
 }
 
-func (x *ProcessListResponse) String() string {
+func (x *ProcessDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessListResponse) ProtoMessage() {}
+func (*ProcessDeleteRequest) ProtoMessage() {}
 
-func (x *ProcessListResponse) ProtoReflect() protoreflect.Message {
+func (x *ProcessDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,19 +659,26 @@ func (x *ProcessListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessListResponse.ProtoReflect.Descriptor instead.
-func (*ProcessListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ProcessDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ProcessListResponse) GetProcesses() map[string]*ProcessResponse {
+func (x *ProcessDeleteRequest) GetName() string {
 	if x != nil {
-		return x.Processes
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type LogRequest struct {
+func (x *ProcessDeleteRequest) GetStopTimeoutSeconds() int64 {
+	if x != nil {
+		return x.StopTimeoutSeconds
+	}
+	return 0
+}
+
+type ProcessGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -480,8 +686,8 @@ type LogRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *LogRequest) Reset() {
-	*x = LogRequest{}
+func (x *ProcessGetRequest) Reset() {
+	*x = ProcessGetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -489,13 +695,13 @@ func (x *LogRequest) Reset() {
 	}
 }
 
-func (x *LogRequest) String() string {
+func (x *ProcessGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogRequest) ProtoMessage() {}
+func (*ProcessGetRequest) ProtoMessage() {}
 
-func (x *LogRequest) ProtoReflect() protoreflect.Message {
+func (x *ProcessGetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,29 +713,28 @@ func (x *LogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
-func (*LogRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessGetRequest.ProtoReflect.Descriptor instead.
+func (*ProcessGetRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LogRequest) GetName() string {
+func (x *ProcessGetRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ProcessReplaceRequest struct {
+type ProcessRefreshRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Spec            *ProcessSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	TerminateSignal string       `protobuf:"bytes,2,opt,name=terminate_signal,json=terminateSignal,proto3" json:"terminate_signal,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ProcessReplaceRequest) Reset() {
-	*x = ProcessReplaceRequest{}
+func (x *ProcessRefreshRequest) Reset() {
+	*x = ProcessRefreshRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,13 +742,13 @@ func (x *ProcessReplaceRequest) Reset() {
 	}
 }
 
-func (x *ProcessReplaceRequest) String() string {
+func (x *ProcessRefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessReplaceRequest) ProtoMessage() {}
+func (*ProcessRefreshRequest) ProtoMessage() {}
 
-func (x *ProcessReplaceRequest) ProtoReflect() protoreflect.Message {
+func (x *ProcessRefreshRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,35 +760,28 @@ func (x *ProcessReplaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessReplaceRequest.ProtoReflect.Descriptor instead.
-func (*ProcessReplaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessRefreshRequest.ProtoReflect.Descriptor instead.
+func (*ProcessRefreshRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ProcessReplaceRequest) GetSpec() *ProcessSpec {
-	if x != nil {
-		return x.Spec
-	}
-	return nil
-}
-
-func (x *ProcessReplaceRequest) GetTerminateSignal() string {
+func (x *ProcessRefreshRequest) GetName() string {
 	if x != nil {
-		return x.TerminateSignal
+		return x.Name
 	}
 	return ""
 }
 
-type LogResponse struct {
+type ProcessSuspendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line string `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *LogResponse) Reset() {
-	*x = LogResponse{}
+func (x *ProcessSuspendRequest) Reset() {
+	*x = ProcessSuspendRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,13 +789,13 @@ func (x *LogResponse) Reset() {
 	}
 }
 
-func (x *LogResponse) String() string {
+func (x *ProcessSuspendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogResponse) ProtoMessage() {}
+func (*ProcessSuspendRequest) ProtoMessage() {}
 
-func (x *LogResponse) ProtoReflect() protoreflect.Message {
+func (x *ProcessSuspendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,34 +807,28 @@ func (x *LogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogResponse.ProtoReflect.Descriptor instead.
-func (*LogResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessSuspendRequest.ProtoReflect.Descriptor instead.
+func (*ProcessSuspendRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LogResponse) GetLine() string {
+func (x *ProcessSuspendRequest) GetName() string {
 	if x != nil {
-		return x.Line
+		return x.Name
 	}
 	return ""
 }
 
-type VersionResponse struct {
+type ProcessResumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version                           string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	GitCommit                         string `protobuf:"bytes,2,opt,name=gitCommit,proto3" json:"gitCommit,omitempty"`
-	BuildDate                         string `protobuf:"bytes,3,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
-	InstanceManagerAPIVersion         int64  `protobuf:"varint,4,opt,name=instanceManagerAPIVersion,proto3" json:"instanceManagerAPIVersion,omitempty"`
-	InstanceManagerAPIMinVersion      int64  `protobuf:"varint,5,opt,name=instanceManagerAPIMinVersion,proto3" json:"instanceManagerAPIMinVersion,omitempty"`
-	InstanceManagerProxyAPIVersion    int64  `protobuf:"varint,6,opt,name=instanceManagerProxyAPIVersion,proto3" json:"instanceManagerProxyAPIVersion,omitempty"`
-	InstanceManagerProxyAPIMinVersion int64  `protobuf:"varint,7,opt,name=instanceManagerProxyAPIMinVersion,proto3" json:"instanceManagerProxyAPIMinVersion,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
+func (x *ProcessResumeRequest) Reset() {
+	*x = ProcessResumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -644,13 +836,13 @@ func (x *VersionResponse) Reset() {
 	}
 }
 
-func (x *VersionResponse) String() string {
+func (x *ProcessResumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*ProcessResumeRequest) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+func (x *ProcessResumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,253 +854,2500 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessResumeRequest.ProtoReflect.Descriptor instead.
+func (*ProcessResumeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VersionResponse) GetVersion() string {
+func (x *ProcessResumeRequest) GetName() string {
 	if x != nil {
-		return x.Version
+		return x.Name
 	}
 	return ""
 }
 
-func (x *VersionResponse) GetGitCommit() string {
-	if x != nil {
-		return x.GitCommit
+type ProcessRebuildModeSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Enter the rebuild mode if true, or leave it otherwise. The process is limited by the rebuild resource limits
+	// of the node until it leaves the rebuild mode as many times as it entered it
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ProcessRebuildModeSetRequest) Reset() {
+	*x = ProcessRebuildModeSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VersionResponse) GetBuildDate() string {
+func (x *ProcessRebuildModeSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessRebuildModeSetRequest) ProtoMessage() {}
+
+func (x *ProcessRebuildModeSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessRebuildModeSetRequest.ProtoReflect.Descriptor instead.
+func (*ProcessRebuildModeSetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProcessRebuildModeSetRequest) GetName() string {
 	if x != nil {
-		return x.BuildDate
+		return x.Name
 	}
 	return ""
 }
 
-func (x *VersionResponse) GetInstanceManagerAPIVersion() int64 {
+func (x *ProcessRebuildModeSetRequest) GetEnabled() bool {
 	if x != nil {
-		return x.InstanceManagerAPIVersion
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *VersionResponse) GetInstanceManagerAPIMinVersion() int64 {
-	if x != nil {
-		return x.InstanceManagerAPIMinVersion
+type ProcessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec    *ProcessSpec   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status  *ProcessStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Deleted bool           `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *ProcessResponse) Reset() {
+	*x = ProcessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *VersionResponse) GetInstanceManagerProxyAPIVersion() int64 {
-	if x != nil {
-		return x.InstanceManagerProxyAPIVersion
+func (x *ProcessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResponse) ProtoMessage() {}
+
+func (x *ProcessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *VersionResponse) GetInstanceManagerProxyAPIMinVersion() int64 {
+// Deprecated: Use ProcessResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProcessResponse) GetSpec() *ProcessSpec {
 	if x != nil {
-		return x.InstanceManagerProxyAPIMinVersion
+		return x.Spec
 	}
-	return 0
+	return nil
 }
 
-var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto protoreflect.FileDescriptor
+func (x *ProcessResponse) GetStatus() *ProcessStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
 
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDesc = []byte{
-	0x0a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
-	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x22, 0xfb,
-	0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x3e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63,
-	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0f, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63,
-	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x41, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x1a, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x20, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63,
-	0x12, 0x29, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x21, 0x0a, 0x0b, 0x4c,
-	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xff,
-	0x02, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x19, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x50, 0x49, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x50, 0x49, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x50, 0x49, 0x4d, 0x69, 0x6e, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x50, 0x49,
-	0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x1e, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x1e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x21, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x50, 0x49, 0x4d, 0x69, 0x6e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x21, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x41, 0x50, 0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x32, 0xe2, 0x03, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x47, 0x65, 0x74,
-	0x12, 0x12, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c,
-	0x6f, 0x67, 0x12, 0x0b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0c, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x12, 0x16, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a,
-	0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e,
-	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ProcessResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
 }
 
-var (
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescOnce sync.Once
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDesc
-)
+type ProcessListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP() []byte {
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescOnce.Do(func() {
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData)
-	})
-	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData
+func (x *ProcessListRequest) Reset() {
+	*x = ProcessListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_goTypes = []interface{}{
-	(*ProcessSpec)(nil),           // 0: ProcessSpec
-	(*ProcessStatus)(nil),         // 1: ProcessStatus
-	(*ProcessCreateRequest)(nil),  // 2: ProcessCreateRequest
-	(*ProcessDeleteRequest)(nil),  // 3: ProcessDeleteRequest
-	(*ProcessGetRequest)(nil),     // 4: ProcessGetRequest
-	(*ProcessResponse)(nil),       // 5: ProcessResponse
-	(*ProcessListRequest)(nil),    // 6: ProcessListRequest
-	(*ProcessListResponse)(nil),   // 7: ProcessListResponse
-	(*LogRequest)(nil),            // 8: LogRequest
-	(*ProcessReplaceRequest)(nil), // 9: ProcessReplaceRequest
-	(*LogResponse)(nil),           // 10: LogResponse
-	(*VersionResponse)(nil),       // 11: VersionResponse
-	nil,                           // 12: ProcessStatus.ConditionsEntry
-	nil,                           // 13: ProcessListResponse.ProcessesEntry
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+func (x *ProcessListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_depIdxs = []int32{
-	12, // 0: ProcessStatus.conditions:type_name -> ProcessStatus.ConditionsEntry
-	0,  // 1: ProcessCreateRequest.spec:type_name -> ProcessSpec
-	0,  // 2: ProcessResponse.spec:type_name -> ProcessSpec
-	1,  // 3: ProcessResponse.status:type_name -> ProcessStatus
-	13, // 4: ProcessListResponse.processes:type_name -> ProcessListResponse.ProcessesEntry
-	0,  // 5: ProcessReplaceRequest.spec:type_name -> ProcessSpec
-	5,  // 6: ProcessListResponse.ProcessesEntry.value:type_name -> ProcessResponse
-	2,  // 7: ProcessManagerService.ProcessCreate:input_type -> ProcessCreateRequest
-	3,  // 8: ProcessManagerService.ProcessDelete:input_type -> ProcessDeleteRequest
-	4,  // 9: ProcessManagerService.ProcessGet:input_type -> ProcessGetRequest
-	6,  // 10: ProcessManagerService.ProcessList:input_type -> ProcessListRequest
-	8,  // 11: ProcessManagerService.ProcessLog:input_type -> LogRequest
-	14, // 12: ProcessManagerService.ProcessWatch:input_type -> google.protobuf.Empty
-	9,  // 13: ProcessManagerService.ProcessReplace:input_type -> ProcessReplaceRequest
-	14, // 14: ProcessManagerService.VersionGet:input_type -> google.protobuf.Empty
-	5,  // 15: ProcessManagerService.ProcessCreate:output_type -> ProcessResponse
-	5,  // 16: ProcessManagerService.ProcessDelete:output_type -> ProcessResponse
-	5,  // 17: ProcessManagerService.ProcessGet:output_type -> ProcessResponse
-	7,  // 18: ProcessManagerService.ProcessList:output_type -> ProcessListResponse
-	10, // 19: ProcessManagerService.ProcessLog:output_type -> LogResponse
-	5,  // 20: ProcessManagerService.ProcessWatch:output_type -> ProcessResponse
-	5,  // 21: ProcessManagerService.ProcessReplace:output_type -> ProcessResponse
-	11, // 22: ProcessManagerService.VersionGet:output_type -> VersionResponse
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (*ProcessListRequest) ProtoMessage() {}
+
+func (x *ProcessListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessListRequest.ProtoReflect.Descriptor instead.
+func (*ProcessListRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{14}
+}
+
+type ProcessListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Processes map[string]*ProcessResponse `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ProcessListResponse) Reset() {
+	*x = ProcessListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessListResponse) ProtoMessage() {}
+
+func (x *ProcessListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessListResponse.ProtoReflect.Descriptor instead.
+func (*ProcessListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProcessListResponse) GetProcesses() map[string]*ProcessResponse {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type LogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SinceSequence uint64 `protobuf:"varint,2,opt,name=since_sequence,json=sinceSequence,proto3" json:"since_sequence,omitempty"`
+	// Stream the lines in batched frames rather than one line per response
+	Batched bool `protobuf:"varint,3,opt,name=batched,proto3" json:"batched,omitempty"`
+	// Compress the batched frames with gzip
+	Compressed bool `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogRequest) GetSinceSequence() uint64 {
+	if x != nil {
+		return x.SinceSequence
+	}
+	return 0
+}
+
+func (x *LogRequest) GetBatched() bool {
+	if x != nil {
+		return x.Batched
+	}
+	return false
+}
+
+func (x *LogRequest) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+type ProcessReplaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec            *ProcessSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	TerminateSignal string       `protobuf:"bytes,2,opt,name=terminate_signal,json=terminateSignal,proto3" json:"terminate_signal,omitempty"`
+}
+
+func (x *ProcessReplaceRequest) Reset() {
+	*x = ProcessReplaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessReplaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessReplaceRequest) ProtoMessage() {}
+
+func (x *ProcessReplaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessReplaceRequest.ProtoReflect.Descriptor instead.
+func (*ProcessReplaceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProcessReplaceRequest) GetSpec() *ProcessSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ProcessReplaceRequest) GetTerminateSignal() string {
+	if x != nil {
+		return x.TerminateSignal
+	}
+	return ""
+}
+
+type ProcessBulkDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names       []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Concurrency int32    `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// Overrides the stop timeouts of the processes for this deletion if not 0
+	StopTimeoutSeconds int64 `protobuf:"varint,3,opt,name=stop_timeout_seconds,json=stopTimeoutSeconds,proto3" json:"stop_timeout_seconds,omitempty"`
+}
+
+func (x *ProcessBulkDeleteRequest) Reset() {
+	*x = ProcessBulkDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessBulkDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessBulkDeleteRequest) ProtoMessage() {}
+
+func (x *ProcessBulkDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessBulkDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ProcessBulkDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProcessBulkDeleteRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ProcessBulkDeleteRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *ProcessBulkDeleteRequest) GetStopTimeoutSeconds() int64 {
+	if x != nil {
+		return x.StopTimeoutSeconds
+	}
+	return 0
+}
+
+type ProcessBulkDeleteStatusGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *ProcessBulkDeleteStatusGetRequest) Reset() {
+	*x = ProcessBulkDeleteStatusGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessBulkDeleteStatusGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessBulkDeleteStatusGetRequest) ProtoMessage() {}
+
+func (x *ProcessBulkDeleteStatusGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessBulkDeleteStatusGetRequest.ProtoReflect.Descriptor instead.
+func (*ProcessBulkDeleteStatusGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProcessBulkDeleteStatusGetRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type ProcessBulkDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId string            `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	State       string            `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Total       int32             `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Deleted     int32             `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Failed      int32             `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors      map[string]string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ProcessBulkDeleteResponse) Reset() {
+	*x = ProcessBulkDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessBulkDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessBulkDeleteResponse) ProtoMessage() {}
+
+func (x *ProcessBulkDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessBulkDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ProcessBulkDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProcessBulkDeleteResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *ProcessBulkDeleteResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProcessBulkDeleteResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProcessBulkDeleteResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *ProcessBulkDeleteResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ProcessBulkDeleteResponse) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type PortReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PortReconcileRequest) Reset() {
+	*x = PortReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortReconcileRequest) ProtoMessage() {}
+
+func (x *PortReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortReconcileRequest.ProtoReflect.Descriptor instead.
+func (*PortReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PortReconcileRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PortDiscrepancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ProcessName string `protobuf:"bytes,2,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	PortStart   int32  `protobuf:"varint,3,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd     int32  `protobuf:"varint,4,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	Message     string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Repaired    bool   `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *PortDiscrepancy) Reset() {
+	*x = PortDiscrepancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortDiscrepancy) ProtoMessage() {}
+
+func (x *PortDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortDiscrepancy.ProtoReflect.Descriptor instead.
+func (*PortDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PortDiscrepancy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PortDiscrepancy) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *PortDiscrepancy) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *PortDiscrepancy) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *PortDiscrepancy) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PortDiscrepancy) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type PortReconcileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discrepancies []*PortDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+}
+
+func (x *PortReconcileResponse) Reset() {
+	*x = PortReconcileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortReconcileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortReconcileResponse) ProtoMessage() {}
+
+func (x *PortReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortReconcileResponse.ProtoReflect.Descriptor instead.
+func (*PortReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PortReconcileResponse) GetDiscrepancies() []*PortDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type ProcessPortEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allocated or released
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Empty for the leaked ports released by the port reconciliation
+	ProcessName string `protobuf:"bytes,2,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	ProcessUuid string `protobuf:"bytes,3,opt,name=process_uuid,json=processUuid,proto3" json:"process_uuid,omitempty"`
+	PortStart   int32  `protobuf:"varint,4,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd     int32  `protobuf:"varint,5,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	Time        string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ProcessPortEvent) Reset() {
+	*x = ProcessPortEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessPortEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPortEvent) ProtoMessage() {}
+
+func (x *ProcessPortEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPortEvent.ProtoReflect.Descriptor instead.
+func (*ProcessPortEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProcessPortEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProcessPortEvent) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *ProcessPortEvent) GetProcessUuid() string {
+	if x != nil {
+		return x.ProcessUuid
+	}
+	return ""
+}
+
+func (x *ProcessPortEvent) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *ProcessPortEvent) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *ProcessPortEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ProcessPortAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortStart int32 `protobuf:"varint,1,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd   int32 `protobuf:"varint,2,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	// Empty for the leaked ports allocated without an owner
+	ProcessName string `protobuf:"bytes,3,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	// process, replacement, or empty for the leaked ports
+	OwnerType string `protobuf:"bytes,4,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`
+	// False if the ports assigned to the process are not allocated in the allocator
+	Allocated bool `protobuf:"varint,5,opt,name=allocated,proto3" json:"allocated,omitempty"`
+}
+
+func (x *ProcessPortAllocation) Reset() {
+	*x = ProcessPortAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessPortAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPortAllocation) ProtoMessage() {}
+
+func (x *ProcessPortAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPortAllocation.ProtoReflect.Descriptor instead.
+func (*ProcessPortAllocation) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProcessPortAllocation) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *ProcessPortAllocation) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *ProcessPortAllocation) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *ProcessPortAllocation) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *ProcessPortAllocation) GetAllocated() bool {
+	if x != nil {
+		return x.Allocated
+	}
+	return false
+}
+
+type ProcessProbeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessName string `protobuf:"bytes,1,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	State       string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Empty if the process has no port to probe
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Only the starting and running processes are probed
+	Probed    bool  `protobuf:"varint,4,opt,name=probed,proto3" json:"probed,omitempty"`
+	Healthy   bool  `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	LatencyMs int64 `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+}
+
+func (x *ProcessProbeStatus) Reset() {
+	*x = ProcessProbeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessProbeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessProbeStatus) ProtoMessage() {}
+
+func (x *ProcessProbeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessProbeStatus.ProtoReflect.Descriptor instead.
+func (*ProcessProbeStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProcessProbeStatus) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *ProcessProbeStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProcessProbeStatus) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ProcessProbeStatus) GetProbed() bool {
+	if x != nil {
+		return x.Probed
+	}
+	return false
+}
+
+func (x *ProcessProbeStatus) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ProcessProbeStatus) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type ProcessStopOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessName   string `protobuf:"bytes,1,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	StoppingSince string `protobuf:"bytes,2,opt,name=stopping_since,json=stoppingSince,proto3" json:"stopping_since,omitempty"`
+}
+
+func (x *ProcessStopOperation) Reset() {
+	*x = ProcessStopOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessStopOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStopOperation) ProtoMessage() {}
+
+func (x *ProcessStopOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStopOperation.ProtoReflect.Descriptor instead.
+func (*ProcessStopOperation) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProcessStopOperation) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *ProcessStopOperation) GetStoppingSince() string {
+	if x != nil {
+		return x.StoppingSince
+	}
+	return ""
+}
+
+type ProcessManagerDebugResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortRangeStart    int32                    `protobuf:"varint,1,opt,name=port_range_start,json=portRangeStart,proto3" json:"port_range_start,omitempty"`
+	PortRangeEnd      int32                    `protobuf:"varint,2,opt,name=port_range_end,json=portRangeEnd,proto3" json:"port_range_end,omitempty"`
+	PortAllocations   []*ProcessPortAllocation `protobuf:"bytes,3,rep,name=port_allocations,json=portAllocations,proto3" json:"port_allocations,omitempty"`
+	Probes            []*ProcessProbeStatus    `protobuf:"bytes,4,rep,name=probes,proto3" json:"probes,omitempty"`
+	StoppingProcesses []*ProcessStopOperation  `protobuf:"bytes,5,rep,name=stopping_processes,json=stoppingProcesses,proto3" json:"stopping_processes,omitempty"`
+	// The bulk deletions in progress
+	BulkDeleteOperations []*ProcessBulkDeleteResponse `protobuf:"bytes,6,rep,name=bulk_delete_operations,json=bulkDeleteOperations,proto3" json:"bulk_delete_operations,omitempty"`
+}
+
+func (x *ProcessManagerDebugResponse) Reset() {
+	*x = ProcessManagerDebugResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessManagerDebugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessManagerDebugResponse) ProtoMessage() {}
+
+func (x *ProcessManagerDebugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessManagerDebugResponse.ProtoReflect.Descriptor instead.
+func (*ProcessManagerDebugResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessManagerDebugResponse) GetPortRangeStart() int32 {
+	if x != nil {
+		return x.PortRangeStart
+	}
+	return 0
+}
+
+func (x *ProcessManagerDebugResponse) GetPortRangeEnd() int32 {
+	if x != nil {
+		return x.PortRangeEnd
+	}
+	return 0
+}
+
+func (x *ProcessManagerDebugResponse) GetPortAllocations() []*ProcessPortAllocation {
+	if x != nil {
+		return x.PortAllocations
+	}
+	return nil
+}
+
+func (x *ProcessManagerDebugResponse) GetProbes() []*ProcessProbeStatus {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *ProcessManagerDebugResponse) GetStoppingProcesses() []*ProcessStopOperation {
+	if x != nil {
+		return x.StoppingProcesses
+	}
+	return nil
+}
+
+func (x *ProcessManagerDebugResponse) GetBulkDeleteOperations() []*ProcessBulkDeleteResponse {
+	if x != nil {
+		return x.BulkDeleteOperations
+	}
+	return nil
+}
+
+type ProcessPortEventListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The latest events, the oldest first
+	Events []*ProcessPortEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ProcessPortEventListResponse) Reset() {
+	*x = ProcessPortEventListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessPortEventListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPortEventListResponse) ProtoMessage() {}
+
+func (x *ProcessPortEventListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPortEventListResponse.ProtoReflect.Descriptor instead.
+func (*ProcessPortEventListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProcessPortEventListResponse) GetEvents() []*ProcessPortEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type EngineBinaryValidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Binary string `protobuf:"bytes,1,opt,name=binary,proto3" json:"binary,omitempty"`
+	// Arguments of the processes to be launched with the binary, which are parsed by the binary without running
+	DryRunArgs []*EngineBinaryArgs `protobuf:"bytes,2,rep,name=dry_run_args,json=dryRunArgs,proto3" json:"dry_run_args,omitempty"`
+	// Launch a throwaway replica with the binary and write to it
+	IoSelfTest bool `protobuf:"varint,3,opt,name=io_self_test,json=ioSelfTest,proto3" json:"io_self_test,omitempty"`
+}
+
+func (x *EngineBinaryValidateRequest) Reset() {
+	*x = EngineBinaryValidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineBinaryValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineBinaryValidateRequest) ProtoMessage() {}
+
+func (x *EngineBinaryValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineBinaryValidateRequest.ProtoReflect.Descriptor instead.
+func (*EngineBinaryValidateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EngineBinaryValidateRequest) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *EngineBinaryValidateRequest) GetDryRunArgs() []*EngineBinaryArgs {
+	if x != nil {
+		return x.DryRunArgs
+	}
+	return nil
+}
+
+func (x *EngineBinaryValidateRequest) GetIoSelfTest() bool {
+	if x != nil {
+		return x.IoSelfTest
+	}
+	return false
+}
+
+type EngineBinaryArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Args []string `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *EngineBinaryArgs) Reset() {
+	*x = EngineBinaryArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineBinaryArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineBinaryArgs) ProtoMessage() {}
+
+func (x *EngineBinaryArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineBinaryArgs.ProtoReflect.Descriptor instead.
+func (*EngineBinaryArgs) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EngineBinaryArgs) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type EngineBinaryCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed  bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *EngineBinaryCheck) Reset() {
+	*x = EngineBinaryCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineBinaryCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineBinaryCheck) ProtoMessage() {}
+
+func (x *EngineBinaryCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineBinaryCheck.ProtoReflect.Descriptor instead.
+func (*EngineBinaryCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EngineBinaryCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EngineBinaryCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *EngineBinaryCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EngineBinaryValidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Compatible              bool                 `protobuf:"varint,1,opt,name=compatible,proto3" json:"compatible,omitempty"`
+	Checks                  []*EngineBinaryCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	Version                 string               `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit               string               `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	BuildDate               string               `protobuf:"bytes,5,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	CliApiVersion           int64                `protobuf:"varint,6,opt,name=cli_api_version,json=cliApiVersion,proto3" json:"cli_api_version,omitempty"`
+	CliApiMinVersion        int64                `protobuf:"varint,7,opt,name=cli_api_min_version,json=cliApiMinVersion,proto3" json:"cli_api_min_version,omitempty"`
+	ControllerApiVersion    int64                `protobuf:"varint,8,opt,name=controller_api_version,json=controllerApiVersion,proto3" json:"controller_api_version,omitempty"`
+	ControllerApiMinVersion int64                `protobuf:"varint,9,opt,name=controller_api_min_version,json=controllerApiMinVersion,proto3" json:"controller_api_min_version,omitempty"`
+	DataFormatVersion       int64                `protobuf:"varint,10,opt,name=data_format_version,json=dataFormatVersion,proto3" json:"data_format_version,omitempty"`
+	DataFormatMinVersion    int64                `protobuf:"varint,11,opt,name=data_format_min_version,json=dataFormatMinVersion,proto3" json:"data_format_min_version,omitempty"`
+}
+
+func (x *EngineBinaryValidateResponse) Reset() {
+	*x = EngineBinaryValidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineBinaryValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineBinaryValidateResponse) ProtoMessage() {}
+
+func (x *EngineBinaryValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineBinaryValidateResponse.ProtoReflect.Descriptor instead.
+func (*EngineBinaryValidateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EngineBinaryValidateResponse) GetCompatible() bool {
+	if x != nil {
+		return x.Compatible
+	}
+	return false
+}
+
+func (x *EngineBinaryValidateResponse) GetChecks() []*EngineBinaryCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *EngineBinaryValidateResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *EngineBinaryValidateResponse) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *EngineBinaryValidateResponse) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *EngineBinaryValidateResponse) GetCliApiVersion() int64 {
+	if x != nil {
+		return x.CliApiVersion
+	}
+	return 0
+}
+
+func (x *EngineBinaryValidateResponse) GetCliApiMinVersion() int64 {
+	if x != nil {
+		return x.CliApiMinVersion
+	}
+	return 0
+}
+
+func (x *EngineBinaryValidateResponse) GetControllerApiVersion() int64 {
+	if x != nil {
+		return x.ControllerApiVersion
+	}
+	return 0
+}
+
+func (x *EngineBinaryValidateResponse) GetControllerApiMinVersion() int64 {
+	if x != nil {
+		return x.ControllerApiMinVersion
+	}
+	return 0
+}
+
+func (x *EngineBinaryValidateResponse) GetDataFormatVersion() int64 {
+	if x != nil {
+		return x.DataFormatVersion
+	}
+	return 0
+}
+
+func (x *EngineBinaryValidateResponse) GetDataFormatMinVersion() int64 {
+	if x != nil {
+		return x.DataFormatMinVersion
+	}
+	return 0
+}
+
+type EngineBinary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Binary     string `protobuf:"bytes,1,opt,name=binary,proto3" json:"binary,omitempty"`
+	Image      string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Size       int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ModifiedAt string `protobuf:"bytes,4,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+	// The SHA-256 of the binary in hex
+	Checksum string `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// The version reported by the binary and its compatibility with this instance manager. No dry run or self test
+	// is done
+	Validation *EngineBinaryValidateResponse `protobuf:"bytes,6,opt,name=validation,proto3" json:"validation,omitempty"`
+	// Set if the binary cannot be read
+	ErrorMsg string `protobuf:"bytes,7,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *EngineBinary) Reset() {
+	*x = EngineBinary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineBinary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineBinary) ProtoMessage() {}
+
+func (x *EngineBinary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineBinary.ProtoReflect.Descriptor instead.
+func (*EngineBinary) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EngineBinary) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *EngineBinary) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *EngineBinary) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *EngineBinary) GetModifiedAt() string {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return ""
+}
+
+func (x *EngineBinary) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *EngineBinary) GetValidation() *EngineBinaryValidateResponse {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+func (x *EngineBinary) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type EngineBinaryListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Binaries []*EngineBinary `protobuf:"bytes,1,rep,name=binaries,proto3" json:"binaries,omitempty"`
+}
+
+func (x *EngineBinaryListResponse) Reset() {
+	*x = EngineBinaryListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineBinaryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineBinaryListResponse) ProtoMessage() {}
+
+func (x *EngineBinaryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineBinaryListResponse.ProtoReflect.Descriptor instead.
+func (*EngineBinaryListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EngineBinaryListResponse) GetBinaries() []*EngineBinary {
+	if x != nil {
+		return x.Binaries
+	}
+	return nil
+}
+
+type LogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line      string `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The batch of lines encoded as length-prefixed binary records, set instead of the fields above if the
+	// batched frames are requested
+	Frame      []byte `protobuf:"bytes,5,opt,name=frame,proto3" json:"frame,omitempty"`
+	Compressed bool   `protobuf:"varint,6,opt,name=compressed,proto3" json:"compressed,omitempty"`
+}
+
+func (x *LogResponse) Reset() {
+	*x = LogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogResponse) ProtoMessage() {}
+
+func (x *LogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogResponse.ProtoReflect.Descriptor instead.
+func (*LogResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LogResponse) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *LogResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LogResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LogResponse) GetFrame() []byte {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *LogResponse) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version                           string        `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit                         string        `protobuf:"bytes,2,opt,name=gitCommit,proto3" json:"gitCommit,omitempty"`
+	BuildDate                         string        `protobuf:"bytes,3,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
+	InstanceManagerAPIVersion         int64         `protobuf:"varint,4,opt,name=instanceManagerAPIVersion,proto3" json:"instanceManagerAPIVersion,omitempty"`
+	InstanceManagerAPIMinVersion      int64         `protobuf:"varint,5,opt,name=instanceManagerAPIMinVersion,proto3" json:"instanceManagerAPIMinVersion,omitempty"`
+	InstanceManagerProxyAPIVersion    int64         `protobuf:"varint,6,opt,name=instanceManagerProxyAPIVersion,proto3" json:"instanceManagerProxyAPIVersion,omitempty"`
+	InstanceManagerProxyAPIMinVersion int64         `protobuf:"varint,7,opt,name=instanceManagerProxyAPIMinVersion,proto3" json:"instanceManagerProxyAPIMinVersion,omitempty"`
+	Topology                          *NodeTopology `protobuf:"bytes,8,opt,name=topology,proto3" json:"topology,omitempty"`
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetInstanceManagerAPIVersion() int64 {
+	if x != nil {
+		return x.InstanceManagerAPIVersion
+	}
+	return 0
+}
+
+func (x *VersionResponse) GetInstanceManagerAPIMinVersion() int64 {
+	if x != nil {
+		return x.InstanceManagerAPIMinVersion
+	}
+	return 0
+}
+
+func (x *VersionResponse) GetInstanceManagerProxyAPIVersion() int64 {
+	if x != nil {
+		return x.InstanceManagerProxyAPIVersion
+	}
+	return 0
+}
+
+func (x *VersionResponse) GetInstanceManagerProxyAPIMinVersion() int64 {
+	if x != nil {
+		return x.InstanceManagerProxyAPIMinVersion
+	}
+	return 0
+}
+
+func (x *VersionResponse) GetTopology() *NodeTopology {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+type NodeTopology struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zone   string            `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Rack   string            `protobuf:"bytes,2,opt,name=rack,proto3" json:"rack,omitempty"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NodeTopology) Reset() {
+	*x = NodeTopology{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeTopology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTopology) ProtoMessage() {}
+
+func (x *NodeTopology) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTopology.ProtoReflect.Descriptor instead.
+func (*NodeTopology) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NodeTopology) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *NodeTopology) GetRack() string {
+	if x != nil {
+		return x.Rack
+	}
+	return ""
+}
+
+func (x *NodeTopology) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto protoreflect.FileDescriptor
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDesc = []byte{
+	0x0a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x99, 0x05, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2f,
+	0x0a, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x6e, 0x76, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x70,
+	0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x63, 0x70, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x70, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67,
+	0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x45, 0x6e,
+	0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65,
+	0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x5f, 0x6e, 0x65,
+	0x77, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65,
+	0x67, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0xf9, 0x02, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x70,
+	0x75, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x73, 0x73, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x73, 0x73, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x22, 0x5d,
+	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x5d, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5c, 0x0a, 0x14,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2b, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x1c, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x14,
+	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a,
+	0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x81, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x29,
+	0x0a, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x30,
+	0x0a, 0x14, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x74,
+	0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x46, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x19, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x2f, 0x0a, 0x14, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0x4f,
+	0x0a, 0x15, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72,
+	0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22,
+	0xbe, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0xb1, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22,
+	0x60, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74,
+	0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x22, 0xf5, 0x02, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e,
+	0x64, 0x12, 0x41, 0x0a, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x62, 0x75, 0x6c, 0x6b, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x14, 0x62, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a, 0x1c, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x1b, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x0c,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0a, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x6f, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x54,
+	0x65, 0x73, 0x74, 0x22, 0x26, 0x0a, 0x10, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf3, 0x03, 0x0a, 0x1c, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x6c, 0x69, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x6c, 0x69, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x63, 0x6c, 0x69, 0x41, 0x70, 0x69, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x70, 0x69, 0x4d, 0x69, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x17, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe9, 0x01, 0x0a,
+	0x0c, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x3d, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x45, 0x0a, 0x18, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x91, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x22, 0xaa, 0x03, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
+	0x19, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x19, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x50,
+	0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x1c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x41, 0x50, 0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x1e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x50, 0x49,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x21, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41,
+	0x50, 0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x21, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x50, 0x49, 0x4d, 0x69, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xe1, 0x0a, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x15, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x15,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x12, 0x16, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0a, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x12, 0x0b, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x12, 0x15, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x47, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x75,
+	0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x55, 0x0a, 0x14, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f,
+	0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescOnce sync.Once
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDesc
+)
+
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescGZIP() []byte {
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescOnce.Do(func() {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData)
+	})
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDescData
+}
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_goTypes = []interface{}{
+	(*ProcessSpec)(nil),                       // 0: ProcessSpec
+	(*ProcessPrivileges)(nil),                 // 1: ProcessPrivileges
+	(*ProcessSidecarSpec)(nil),                // 2: ProcessSidecarSpec
+	(*ProcessStatus)(nil),                     // 3: ProcessStatus
+	(*ResourceUsage)(nil),                     // 4: ResourceUsage
+	(*ProcessSidecarStatus)(nil),              // 5: ProcessSidecarStatus
+	(*ProcessCreateRequest)(nil),              // 6: ProcessCreateRequest
+	(*ProcessDeleteRequest)(nil),              // 7: ProcessDeleteRequest
+	(*ProcessGetRequest)(nil),                 // 8: ProcessGetRequest
+	(*ProcessRefreshRequest)(nil),             // 9: ProcessRefreshRequest
+	(*ProcessSuspendRequest)(nil),             // 10: ProcessSuspendRequest
+	(*ProcessResumeRequest)(nil),              // 11: ProcessResumeRequest
+	(*ProcessRebuildModeSetRequest)(nil),      // 12: ProcessRebuildModeSetRequest
+	(*ProcessResponse)(nil),                   // 13: ProcessResponse
+	(*ProcessListRequest)(nil),                // 14: ProcessListRequest
+	(*ProcessListResponse)(nil),               // 15: ProcessListResponse
+	(*LogRequest)(nil),                        // 16: LogRequest
+	(*ProcessReplaceRequest)(nil),             // 17: ProcessReplaceRequest
+	(*ProcessBulkDeleteRequest)(nil),          // 18: ProcessBulkDeleteRequest
+	(*ProcessBulkDeleteStatusGetRequest)(nil), // 19: ProcessBulkDeleteStatusGetRequest
+	(*ProcessBulkDeleteResponse)(nil),         // 20: ProcessBulkDeleteResponse
+	(*PortReconcileRequest)(nil),              // 21: PortReconcileRequest
+	(*PortDiscrepancy)(nil),                   // 22: PortDiscrepancy
+	(*PortReconcileResponse)(nil),             // 23: PortReconcileResponse
+	(*ProcessPortEvent)(nil),                  // 24: ProcessPortEvent
+	(*ProcessPortAllocation)(nil),             // 25: ProcessPortAllocation
+	(*ProcessProbeStatus)(nil),                // 26: ProcessProbeStatus
+	(*ProcessStopOperation)(nil),              // 27: ProcessStopOperation
+	(*ProcessManagerDebugResponse)(nil),       // 28: ProcessManagerDebugResponse
+	(*ProcessPortEventListResponse)(nil),      // 29: ProcessPortEventListResponse
+	(*EngineBinaryValidateRequest)(nil),       // 30: EngineBinaryValidateRequest
+	(*EngineBinaryArgs)(nil),                  // 31: EngineBinaryArgs
+	(*EngineBinaryCheck)(nil),                 // 32: EngineBinaryCheck
+	(*EngineBinaryValidateResponse)(nil),      // 33: EngineBinaryValidateResponse
+	(*EngineBinary)(nil),                      // 34: EngineBinary
+	(*EngineBinaryListResponse)(nil),          // 35: EngineBinaryListResponse
+	(*LogResponse)(nil),                       // 36: LogResponse
+	(*VersionResponse)(nil),                   // 37: VersionResponse
+	(*NodeTopology)(nil),                      // 38: NodeTopology
+	nil,                                       // 39: ProcessSpec.EnvsEntry
+	nil,                                       // 40: ProcessSpec.LabelsEntry
+	nil,                                       // 41: ProcessStatus.ConditionsEntry
+	nil,                                       // 42: ProcessListResponse.ProcessesEntry
+	nil,                                       // 43: ProcessBulkDeleteResponse.ErrorsEntry
+	nil,                                       // 44: NodeTopology.LabelsEntry
+	(*emptypb.Empty)(nil),                     // 45: google.protobuf.Empty
+}
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_depIdxs = []int32{
+	2,  // 0: ProcessSpec.sidecars:type_name -> ProcessSidecarSpec
+	39, // 1: ProcessSpec.envs:type_name -> ProcessSpec.EnvsEntry
+	40, // 2: ProcessSpec.labels:type_name -> ProcessSpec.LabelsEntry
+	1,  // 3: ProcessSpec.privileges:type_name -> ProcessPrivileges
+	41, // 4: ProcessStatus.conditions:type_name -> ProcessStatus.ConditionsEntry
+	5,  // 5: ProcessStatus.sidecars:type_name -> ProcessSidecarStatus
+	4,  // 6: ProcessStatus.resource_usage:type_name -> ResourceUsage
+	0,  // 7: ProcessCreateRequest.spec:type_name -> ProcessSpec
+	0,  // 8: ProcessResponse.spec:type_name -> ProcessSpec
+	3,  // 9: ProcessResponse.status:type_name -> ProcessStatus
+	42, // 10: ProcessListResponse.processes:type_name -> ProcessListResponse.ProcessesEntry
+	0,  // 11: ProcessReplaceRequest.spec:type_name -> ProcessSpec
+	43, // 12: ProcessBulkDeleteResponse.errors:type_name -> ProcessBulkDeleteResponse.ErrorsEntry
+	22, // 13: PortReconcileResponse.discrepancies:type_name -> PortDiscrepancy
+	25, // 14: ProcessManagerDebugResponse.port_allocations:type_name -> ProcessPortAllocation
+	26, // 15: ProcessManagerDebugResponse.probes:type_name -> ProcessProbeStatus
+	27, // 16: ProcessManagerDebugResponse.stopping_processes:type_name -> ProcessStopOperation
+	20, // 17: ProcessManagerDebugResponse.bulk_delete_operations:type_name -> ProcessBulkDeleteResponse
+	24, // 18: ProcessPortEventListResponse.events:type_name -> ProcessPortEvent
+	31, // 19: EngineBinaryValidateRequest.dry_run_args:type_name -> EngineBinaryArgs
+	32, // 20: EngineBinaryValidateResponse.checks:type_name -> EngineBinaryCheck
+	33, // 21: EngineBinary.validation:type_name -> EngineBinaryValidateResponse
+	34, // 22: EngineBinaryListResponse.binaries:type_name -> EngineBinary
+	38, // 23: VersionResponse.topology:type_name -> NodeTopology
+	44, // 24: NodeTopology.labels:type_name -> NodeTopology.LabelsEntry
+	13, // 25: ProcessListResponse.ProcessesEntry.value:type_name -> ProcessResponse
+	6,  // 26: ProcessManagerService.ProcessCreate:input_type -> ProcessCreateRequest
+	7,  // 27: ProcessManagerService.ProcessDelete:input_type -> ProcessDeleteRequest
+	8,  // 28: ProcessManagerService.ProcessGet:input_type -> ProcessGetRequest
+	9,  // 29: ProcessManagerService.ProcessRefresh:input_type -> ProcessRefreshRequest
+	14, // 30: ProcessManagerService.ProcessList:input_type -> ProcessListRequest
+	16, // 31: ProcessManagerService.ProcessLog:input_type -> LogRequest
+	45, // 32: ProcessManagerService.ProcessWatch:input_type -> google.protobuf.Empty
+	17, // 33: ProcessManagerService.ProcessReplace:input_type -> ProcessReplaceRequest
+	10, // 34: ProcessManagerService.ProcessSuspend:input_type -> ProcessSuspendRequest
+	11, // 35: ProcessManagerService.ProcessResume:input_type -> ProcessResumeRequest
+	12, // 36: ProcessManagerService.ProcessRebuildModeSet:input_type -> ProcessRebuildModeSetRequest
+	18, // 37: ProcessManagerService.ProcessBulkDelete:input_type -> ProcessBulkDeleteRequest
+	19, // 38: ProcessManagerService.ProcessBulkDeleteStatusGet:input_type -> ProcessBulkDeleteStatusGetRequest
+	21, // 39: ProcessManagerService.PortReconcile:input_type -> PortReconcileRequest
+	45, // 40: ProcessManagerService.ProcessPortEventList:input_type -> google.protobuf.Empty
+	45, // 41: ProcessManagerService.ProcessPortEventWatch:input_type -> google.protobuf.Empty
+	30, // 42: ProcessManagerService.EngineBinaryValidate:input_type -> EngineBinaryValidateRequest
+	45, // 43: ProcessManagerService.EngineBinaryList:input_type -> google.protobuf.Empty
+	45, // 44: ProcessManagerService.ProcessManagerDebugGet:input_type -> google.protobuf.Empty
+	45, // 45: ProcessManagerService.VersionGet:input_type -> google.protobuf.Empty
+	13, // 46: ProcessManagerService.ProcessCreate:output_type -> ProcessResponse
+	13, // 47: ProcessManagerService.ProcessDelete:output_type -> ProcessResponse
+	13, // 48: ProcessManagerService.ProcessGet:output_type -> ProcessResponse
+	13, // 49: ProcessManagerService.ProcessRefresh:output_type -> ProcessResponse
+	15, // 50: ProcessManagerService.ProcessList:output_type -> ProcessListResponse
+	36, // 51: ProcessManagerService.ProcessLog:output_type -> LogResponse
+	13, // 52: ProcessManagerService.ProcessWatch:output_type -> ProcessResponse
+	13, // 53: ProcessManagerService.ProcessReplace:output_type -> ProcessResponse
+	13, // 54: ProcessManagerService.ProcessSuspend:output_type -> ProcessResponse
+	13, // 55: ProcessManagerService.ProcessResume:output_type -> ProcessResponse
+	13, // 56: ProcessManagerService.ProcessRebuildModeSet:output_type -> ProcessResponse
+	20, // 57: ProcessManagerService.ProcessBulkDelete:output_type -> ProcessBulkDeleteResponse
+	20, // 58: ProcessManagerService.ProcessBulkDeleteStatusGet:output_type -> ProcessBulkDeleteResponse
+	23, // 59: ProcessManagerService.PortReconcile:output_type -> PortReconcileResponse
+	29, // 60: ProcessManagerService.ProcessPortEventList:output_type -> ProcessPortEventListResponse
+	24, // 61: ProcessManagerService.ProcessPortEventWatch:output_type -> ProcessPortEvent
+	33, // 62: ProcessManagerService.EngineBinaryValidate:output_type -> EngineBinaryValidateResponse
+	35, // 63: ProcessManagerService.EngineBinaryList:output_type -> EngineBinaryListResponse
+	28, // 64: ProcessManagerService.ProcessManagerDebugGet:output_type -> ProcessManagerDebugResponse
+	37, // 65: ProcessManagerService.VersionGet:output_type -> VersionResponse
+	46, // [46:66] is the sub-list for method output_type
+	26, // [26:46] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_init() }
@@ -929,8 +3368,188 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessStatus); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessPrivileges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessSidecarSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessSidecarStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessRefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessSuspendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessRebuildModeSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -941,8 +3560,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessCreateRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessReplaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -953,8 +3572,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessDeleteRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessBulkDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,8 +3584,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessGetRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessBulkDeleteStatusGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -977,8 +3596,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessResponse); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessBulkDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -989,8 +3608,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessListRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortReconcileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1001,8 +3620,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessListResponse); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortDiscrepancy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1013,8 +3632,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortReconcileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1025,8 +3644,8 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessReplaceRequest); i {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessPortEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1037,7 +3656,139 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessPortAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessProbeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStopOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessManagerDebugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessPortEventListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineBinaryValidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineBinaryArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineBinaryCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineBinaryValidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineBinary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineBinaryListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogResponse); i {
 			case 0:
 				return &v.state
@@ -1049,7 +3800,7 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
@@ -1061,6 +3812,18 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 				return nil
 			}
 		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeTopology); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1068,7 +3831,7 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_in
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1097,10 +3860,22 @@ type ProcessManagerServiceClient interface {
 	ProcessCreate(ctx context.Context, in *ProcessCreateRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
 	ProcessDelete(ctx context.Context, in *ProcessDeleteRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
 	ProcessGet(ctx context.Context, in *ProcessGetRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
+	ProcessRefresh(ctx context.Context, in *ProcessRefreshRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
 	ProcessList(ctx context.Context, in *ProcessListRequest, opts ...grpc.CallOption) (*ProcessListResponse, error)
 	ProcessLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (ProcessManagerService_ProcessLogClient, error)
 	ProcessWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ProcessManagerService_ProcessWatchClient, error)
 	ProcessReplace(ctx context.Context, in *ProcessReplaceRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
+	ProcessSuspend(ctx context.Context, in *ProcessSuspendRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
+	ProcessResume(ctx context.Context, in *ProcessResumeRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
+	ProcessRebuildModeSet(ctx context.Context, in *ProcessRebuildModeSetRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
+	ProcessBulkDelete(ctx context.Context, in *ProcessBulkDeleteRequest, opts ...grpc.CallOption) (*ProcessBulkDeleteResponse, error)
+	ProcessBulkDeleteStatusGet(ctx context.Context, in *ProcessBulkDeleteStatusGetRequest, opts ...grpc.CallOption) (*ProcessBulkDeleteResponse, error)
+	PortReconcile(ctx context.Context, in *PortReconcileRequest, opts ...grpc.CallOption) (*PortReconcileResponse, error)
+	ProcessPortEventList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessPortEventListResponse, error)
+	ProcessPortEventWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ProcessManagerService_ProcessPortEventWatchClient, error)
+	EngineBinaryValidate(ctx context.Context, in *EngineBinaryValidateRequest, opts ...grpc.CallOption) (*EngineBinaryValidateResponse, error)
+	EngineBinaryList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EngineBinaryListResponse, error)
+	ProcessManagerDebugGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessManagerDebugResponse, error)
 	VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
 }
 
@@ -1121,71 +3896,184 @@ func (c *processManagerServiceClient) ProcessCreate(ctx context.Context, in *Pro
 	return out, nil
 }
 
-func (c *processManagerServiceClient) ProcessDelete(ctx context.Context, in *ProcessDeleteRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+func (c *processManagerServiceClient) ProcessDelete(ctx context.Context, in *ProcessDeleteRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+	out := new(ProcessResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessGet(ctx context.Context, in *ProcessGetRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+	out := new(ProcessResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessRefresh(ctx context.Context, in *ProcessRefreshRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+	out := new(ProcessResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessList(ctx context.Context, in *ProcessListRequest, opts ...grpc.CallOption) (*ProcessListResponse, error) {
+	out := new(ProcessListResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (ProcessManagerService_ProcessLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProcessManagerService_serviceDesc.Streams[0], "/ProcessManagerService/ProcessLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &processManagerServiceProcessLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProcessManagerService_ProcessLogClient interface {
+	Recv() (*LogResponse, error)
+	grpc.ClientStream
+}
+
+type processManagerServiceProcessLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *processManagerServiceProcessLogClient) Recv() (*LogResponse, error) {
+	m := new(LogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *processManagerServiceClient) ProcessWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ProcessManagerService_ProcessWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProcessManagerService_serviceDesc.Streams[1], "/ProcessManagerService/ProcessWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &processManagerServiceProcessWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProcessManagerService_ProcessWatchClient interface {
+	Recv() (*ProcessResponse, error)
+	grpc.ClientStream
+}
+
+type processManagerServiceProcessWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *processManagerServiceProcessWatchClient) Recv() (*ProcessResponse, error) {
+	m := new(ProcessResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *processManagerServiceClient) ProcessReplace(ctx context.Context, in *ProcessReplaceRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+	out := new(ProcessResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessSuspend(ctx context.Context, in *ProcessSuspendRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+	out := new(ProcessResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessSuspend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessResume(ctx context.Context, in *ProcessResumeRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
 	out := new(ProcessResponse)
-	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessDelete", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessResume", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *processManagerServiceClient) ProcessGet(ctx context.Context, in *ProcessGetRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+func (c *processManagerServiceClient) ProcessRebuildModeSet(ctx context.Context, in *ProcessRebuildModeSetRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
 	out := new(ProcessResponse)
-	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessGet", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessRebuildModeSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *processManagerServiceClient) ProcessList(ctx context.Context, in *ProcessListRequest, opts ...grpc.CallOption) (*ProcessListResponse, error) {
-	out := new(ProcessListResponse)
-	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessList", in, out, opts...)
+func (c *processManagerServiceClient) ProcessBulkDelete(ctx context.Context, in *ProcessBulkDeleteRequest, opts ...grpc.CallOption) (*ProcessBulkDeleteResponse, error) {
+	out := new(ProcessBulkDeleteResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessBulkDelete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *processManagerServiceClient) ProcessLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (ProcessManagerService_ProcessLogClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ProcessManagerService_serviceDesc.Streams[0], "/ProcessManagerService/ProcessLog", opts...)
+func (c *processManagerServiceClient) ProcessBulkDeleteStatusGet(ctx context.Context, in *ProcessBulkDeleteStatusGetRequest, opts ...grpc.CallOption) (*ProcessBulkDeleteResponse, error) {
+	out := new(ProcessBulkDeleteResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessBulkDeleteStatusGet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &processManagerServiceProcessLogClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type ProcessManagerService_ProcessLogClient interface {
-	Recv() (*LogResponse, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type processManagerServiceProcessLogClient struct {
-	grpc.ClientStream
+func (c *processManagerServiceClient) PortReconcile(ctx context.Context, in *PortReconcileRequest, opts ...grpc.CallOption) (*PortReconcileResponse, error) {
+	out := new(PortReconcileResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/PortReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *processManagerServiceProcessLogClient) Recv() (*LogResponse, error) {
-	m := new(LogResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *processManagerServiceClient) ProcessPortEventList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessPortEventListResponse, error) {
+	out := new(ProcessPortEventListResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessPortEventList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *processManagerServiceClient) ProcessWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ProcessManagerService_ProcessWatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ProcessManagerService_serviceDesc.Streams[1], "/ProcessManagerService/ProcessWatch", opts...)
+func (c *processManagerServiceClient) ProcessPortEventWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ProcessManagerService_ProcessPortEventWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProcessManagerService_serviceDesc.Streams[2], "/ProcessManagerService/ProcessPortEventWatch", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &processManagerServiceProcessWatchClient{stream}
+	x := &processManagerServiceProcessPortEventWatchClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -1195,26 +4083,44 @@ func (c *processManagerServiceClient) ProcessWatch(ctx context.Context, in *empt
 	return x, nil
 }
 
-type ProcessManagerService_ProcessWatchClient interface {
-	Recv() (*ProcessResponse, error)
+type ProcessManagerService_ProcessPortEventWatchClient interface {
+	Recv() (*ProcessPortEvent, error)
 	grpc.ClientStream
 }
 
-type processManagerServiceProcessWatchClient struct {
+type processManagerServiceProcessPortEventWatchClient struct {
 	grpc.ClientStream
 }
 
-func (x *processManagerServiceProcessWatchClient) Recv() (*ProcessResponse, error) {
-	m := new(ProcessResponse)
+func (x *processManagerServiceProcessPortEventWatchClient) Recv() (*ProcessPortEvent, error) {
+	m := new(ProcessPortEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *processManagerServiceClient) ProcessReplace(ctx context.Context, in *ProcessReplaceRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
-	out := new(ProcessResponse)
-	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessReplace", in, out, opts...)
+func (c *processManagerServiceClient) EngineBinaryValidate(ctx context.Context, in *EngineBinaryValidateRequest, opts ...grpc.CallOption) (*EngineBinaryValidateResponse, error) {
+	out := new(EngineBinaryValidateResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/EngineBinaryValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) EngineBinaryList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EngineBinaryListResponse, error) {
+	out := new(EngineBinaryListResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/EngineBinaryList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processManagerServiceClient) ProcessManagerDebugGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessManagerDebugResponse, error) {
+	out := new(ProcessManagerDebugResponse)
+	err := c.cc.Invoke(ctx, "/ProcessManagerService/ProcessManagerDebugGet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1235,10 +4141,22 @@ type ProcessManagerServiceServer interface {
 	ProcessCreate(context.Context, *ProcessCreateRequest) (*ProcessResponse, error)
 	ProcessDelete(context.Context, *ProcessDeleteRequest) (*ProcessResponse, error)
 	ProcessGet(context.Context, *ProcessGetRequest) (*ProcessResponse, error)
+	ProcessRefresh(context.Context, *ProcessRefreshRequest) (*ProcessResponse, error)
 	ProcessList(context.Context, *ProcessListRequest) (*ProcessListResponse, error)
 	ProcessLog(*LogRequest, ProcessManagerService_ProcessLogServer) error
 	ProcessWatch(*emptypb.Empty, ProcessManagerService_ProcessWatchServer) error
 	ProcessReplace(context.Context, *ProcessReplaceRequest) (*ProcessResponse, error)
+	ProcessSuspend(context.Context, *ProcessSuspendRequest) (*ProcessResponse, error)
+	ProcessResume(context.Context, *ProcessResumeRequest) (*ProcessResponse, error)
+	ProcessRebuildModeSet(context.Context, *ProcessRebuildModeSetRequest) (*ProcessResponse, error)
+	ProcessBulkDelete(context.Context, *ProcessBulkDeleteRequest) (*ProcessBulkDeleteResponse, error)
+	ProcessBulkDeleteStatusGet(context.Context, *ProcessBulkDeleteStatusGetRequest) (*ProcessBulkDeleteResponse, error)
+	PortReconcile(context.Context, *PortReconcileRequest) (*PortReconcileResponse, error)
+	ProcessPortEventList(context.Context, *emptypb.Empty) (*ProcessPortEventListResponse, error)
+	ProcessPortEventWatch(*emptypb.Empty, ProcessManagerService_ProcessPortEventWatchServer) error
+	EngineBinaryValidate(context.Context, *EngineBinaryValidateRequest) (*EngineBinaryValidateResponse, error)
+	EngineBinaryList(context.Context, *emptypb.Empty) (*EngineBinaryListResponse, error)
+	ProcessManagerDebugGet(context.Context, *emptypb.Empty) (*ProcessManagerDebugResponse, error)
 	VersionGet(context.Context, *emptypb.Empty) (*VersionResponse, error)
 }
 
@@ -1255,6 +4173,9 @@ func (*UnimplementedProcessManagerServiceServer) ProcessDelete(context.Context,
 func (*UnimplementedProcessManagerServiceServer) ProcessGet(context.Context, *ProcessGetRequest) (*ProcessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessGet not implemented")
 }
+func (*UnimplementedProcessManagerServiceServer) ProcessRefresh(context.Context, *ProcessRefreshRequest) (*ProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRefresh not implemented")
+}
 func (*UnimplementedProcessManagerServiceServer) ProcessList(context.Context, *ProcessListRequest) (*ProcessListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessList not implemented")
 }
@@ -1267,6 +4188,39 @@ func (*UnimplementedProcessManagerServiceServer) ProcessWatch(*emptypb.Empty, Pr
 func (*UnimplementedProcessManagerServiceServer) ProcessReplace(context.Context, *ProcessReplaceRequest) (*ProcessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessReplace not implemented")
 }
+func (*UnimplementedProcessManagerServiceServer) ProcessSuspend(context.Context, *ProcessSuspendRequest) (*ProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessSuspend not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessResume(context.Context, *ProcessResumeRequest) (*ProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessResume not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessRebuildModeSet(context.Context, *ProcessRebuildModeSetRequest) (*ProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRebuildModeSet not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessBulkDelete(context.Context, *ProcessBulkDeleteRequest) (*ProcessBulkDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessBulkDelete not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessBulkDeleteStatusGet(context.Context, *ProcessBulkDeleteStatusGetRequest) (*ProcessBulkDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessBulkDeleteStatusGet not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) PortReconcile(context.Context, *PortReconcileRequest) (*PortReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortReconcile not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessPortEventList(context.Context, *emptypb.Empty) (*ProcessPortEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessPortEventList not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessPortEventWatch(*emptypb.Empty, ProcessManagerService_ProcessPortEventWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessPortEventWatch not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) EngineBinaryValidate(context.Context, *EngineBinaryValidateRequest) (*EngineBinaryValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineBinaryValidate not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) EngineBinaryList(context.Context, *emptypb.Empty) (*EngineBinaryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineBinaryList not implemented")
+}
+func (*UnimplementedProcessManagerServiceServer) ProcessManagerDebugGet(context.Context, *emptypb.Empty) (*ProcessManagerDebugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessManagerDebugGet not implemented")
+}
 func (*UnimplementedProcessManagerServiceServer) VersionGet(context.Context, *emptypb.Empty) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VersionGet not implemented")
 }
@@ -1329,6 +4283,24 @@ func _ProcessManagerService_ProcessGet_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProcessManagerService_ProcessRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessRefresh(ctx, req.(*ProcessRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProcessManagerService_ProcessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProcessListRequest)
 	if err := dec(in); err != nil {
@@ -1407,6 +4379,207 @@ func _ProcessManagerService_ProcessReplace_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProcessManagerService_ProcessSuspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessSuspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessSuspend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessSuspend(ctx, req.(*ProcessSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessResume(ctx, req.(*ProcessResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessRebuildModeSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRebuildModeSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessRebuildModeSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessRebuildModeSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessRebuildModeSet(ctx, req.(*ProcessRebuildModeSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessBulkDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessBulkDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessBulkDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessBulkDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessBulkDelete(ctx, req.(*ProcessBulkDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessBulkDeleteStatusGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessBulkDeleteStatusGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessBulkDeleteStatusGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessBulkDeleteStatusGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessBulkDeleteStatusGet(ctx, req.(*ProcessBulkDeleteStatusGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_PortReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).PortReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/PortReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).PortReconcile(ctx, req.(*PortReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessPortEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessPortEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessPortEventList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessPortEventList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessPortEventWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProcessManagerServiceServer).ProcessPortEventWatch(m, &processManagerServiceProcessPortEventWatchServer{stream})
+}
+
+type ProcessManagerService_ProcessPortEventWatchServer interface {
+	Send(*ProcessPortEvent) error
+	grpc.ServerStream
+}
+
+type processManagerServiceProcessPortEventWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *processManagerServiceProcessPortEventWatchServer) Send(m *ProcessPortEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProcessManagerService_EngineBinaryValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EngineBinaryValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).EngineBinaryValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/EngineBinaryValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).EngineBinaryValidate(ctx, req.(*EngineBinaryValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_EngineBinaryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).EngineBinaryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/EngineBinaryList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).EngineBinaryList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessManagerService_ProcessManagerDebugGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessManagerServiceServer).ProcessManagerDebugGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProcessManagerService/ProcessManagerDebugGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessManagerServiceServer).ProcessManagerDebugGet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProcessManagerService_VersionGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1441,6 +4614,10 @@ var _ProcessManagerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessGet",
 			Handler:    _ProcessManagerService_ProcessGet_Handler,
 		},
+		{
+			MethodName: "ProcessRefresh",
+			Handler:    _ProcessManagerService_ProcessRefresh_Handler,
+		},
 		{
 			MethodName: "ProcessList",
 			Handler:    _ProcessManagerService_ProcessList_Handler,
@@ -1449,6 +4626,46 @@ var _ProcessManagerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessReplace",
 			Handler:    _ProcessManagerService_ProcessReplace_Handler,
 		},
+		{
+			MethodName: "ProcessSuspend",
+			Handler:    _ProcessManagerService_ProcessSuspend_Handler,
+		},
+		{
+			MethodName: "ProcessResume",
+			Handler:    _ProcessManagerService_ProcessResume_Handler,
+		},
+		{
+			MethodName: "ProcessRebuildModeSet",
+			Handler:    _ProcessManagerService_ProcessRebuildModeSet_Handler,
+		},
+		{
+			MethodName: "ProcessBulkDelete",
+			Handler:    _ProcessManagerService_ProcessBulkDelete_Handler,
+		},
+		{
+			MethodName: "ProcessBulkDeleteStatusGet",
+			Handler:    _ProcessManagerService_ProcessBulkDeleteStatusGet_Handler,
+		},
+		{
+			MethodName: "PortReconcile",
+			Handler:    _ProcessManagerService_PortReconcile_Handler,
+		},
+		{
+			MethodName: "ProcessPortEventList",
+			Handler:    _ProcessManagerService_ProcessPortEventList_Handler,
+		},
+		{
+			MethodName: "EngineBinaryValidate",
+			Handler:    _ProcessManagerService_EngineBinaryValidate_Handler,
+		},
+		{
+			MethodName: "EngineBinaryList",
+			Handler:    _ProcessManagerService_EngineBinaryList_Handler,
+		},
+		{
+			MethodName: "ProcessManagerDebugGet",
+			Handler:    _ProcessManagerService_ProcessManagerDebugGet_Handler,
+		},
 		{
 			MethodName: "VersionGet",
 			Handler:    _ProcessManagerService_VersionGet_Handler,
@@ -1465,6 +4682,11 @@ var _ProcessManagerService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ProcessManagerService_ProcessWatch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ProcessPortEventWatch",
+			Handler:       _ProcessManagerService_ProcessPortEventWatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "github.com/longhorn/longhorn-instance-manager/pkg/imrpc/imrpc.proto",
 }
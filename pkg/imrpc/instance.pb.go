@@ -30,8 +30,20 @@ type ProcessInstanceSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Binary string   `protobuf:"bytes,1,opt,name=binary,proto3" json:"binary,omitempty"`
-	Args   []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Binary   string                `protobuf:"bytes,1,opt,name=binary,proto3" json:"binary,omitempty"`
+	Args     []string              `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Sidecars []*ProcessSidecarSpec `protobuf:"bytes,3,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	Envs     map[string]string     `protobuf:"bytes,4,rep,name=envs,proto3" json:"envs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// CPU and memory limits of the process, see ProcessSpec. Unlimited if 0
+	CpuMillicores int64 `protobuf:"varint,5,opt,name=cpu_millicores,json=cpuMillicores,proto3" json:"cpu_millicores,omitempty"`
+	MemoryBytes   int64 `protobuf:"varint,6,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	// CPU pinning of the process, see ProcessSpec. Not pinned if empty
+	CpuSet   string `protobuf:"bytes,7,opt,name=cpu_set,json=cpuSet,proto3" json:"cpu_set,omitempty"`
+	NumaNode string `protobuf:"bytes,8,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
+	// Privileges of the process, see ProcessSpec. The full privileges of the instance manager if unset
+	Privileges *ProcessPrivileges `protobuf:"bytes,9,opt,name=privileges,proto3" json:"privileges,omitempty"`
+	// Graceful stop timeout of the process, see ProcessSpec. The default of the instance manager if 0
+	StopTimeoutSeconds int64 `protobuf:"varint,10,opt,name=stop_timeout_seconds,json=stopTimeoutSeconds,proto3" json:"stop_timeout_seconds,omitempty"`
 }
 
 func (x *ProcessInstanceSpec) Reset() {
@@ -80,6 +92,62 @@ func (x *ProcessInstanceSpec) GetArgs() []string {
 	return nil
 }
 
+func (x *ProcessInstanceSpec) GetSidecars() []*ProcessSidecarSpec {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
+}
+
+func (x *ProcessInstanceSpec) GetEnvs() map[string]string {
+	if x != nil {
+		return x.Envs
+	}
+	return nil
+}
+
+func (x *ProcessInstanceSpec) GetCpuMillicores() int64 {
+	if x != nil {
+		return x.CpuMillicores
+	}
+	return 0
+}
+
+func (x *ProcessInstanceSpec) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ProcessInstanceSpec) GetCpuSet() string {
+	if x != nil {
+		return x.CpuSet
+	}
+	return ""
+}
+
+func (x *ProcessInstanceSpec) GetNumaNode() string {
+	if x != nil {
+		return x.NumaNode
+	}
+	return ""
+}
+
+func (x *ProcessInstanceSpec) GetPrivileges() *ProcessPrivileges {
+	if x != nil {
+		return x.Privileges
+	}
+	return nil
+}
+
+func (x *ProcessInstanceSpec) GetStopTimeoutSeconds() int64 {
+	if x != nil {
+		return x.StopTimeoutSeconds
+	}
+	return 0
+}
+
 type SpdkInstanceSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -91,6 +159,11 @@ type SpdkInstanceSpec struct {
 	Size              uint64            `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	ExposeRequired    bool              `protobuf:"varint,5,opt,name=expose_required,json=exposeRequired,proto3" json:"expose_required,omitempty"`
 	Frontend          string            `protobuf:"bytes,6,opt,name=frontend,proto3" json:"frontend,omitempty"`
+	// Bind to the existing lvol or engine with the same name instead of failing with AlreadyExists
+	Adopt bool `protobuf:"varint,7,opt,name=adopt,proto3" json:"adopt,omitempty"`
+	// The replica an engine prefers to read from, e.g. the one local to the engine for a data locality enabled volume.
+	// Optional
+	PreferredReadReplica string `protobuf:"bytes,8,opt,name=preferred_read_replica,json=preferredReadReplica,proto3" json:"preferred_read_replica,omitempty"`
 }
 
 func (x *SpdkInstanceSpec) Reset() {
@@ -167,6 +240,20 @@ func (x *SpdkInstanceSpec) GetFrontend() string {
 	return ""
 }
 
+func (x *SpdkInstanceSpec) GetAdopt() bool {
+	if x != nil {
+		return x.Adopt
+	}
+	return false
+}
+
+func (x *SpdkInstanceSpec) GetPreferredReadReplica() string {
+	if x != nil {
+		return x.PreferredReadReplica
+	}
+	return ""
+}
+
 type InstanceSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -184,6 +271,18 @@ type InstanceSpec struct {
 	ProcessInstanceSpec *ProcessInstanceSpec `protobuf:"bytes,7,opt,name=process_instance_spec,json=processInstanceSpec,proto3" json:"process_instance_spec,omitempty"`
 	SpdkInstanceSpec    *SpdkInstanceSpec    `protobuf:"bytes,8,opt,name=spdk_instance_spec,json=spdkInstanceSpec,proto3" json:"spdk_instance_spec,omitempty"`
 	DataEngine          DataEngine           `protobuf:"varint,9,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// Labels group the instances, e.g. by volume, tier or tenant. The keys and values consist of alphanumeric
+	// characters, '-', '_', '.' and '/'. The labels of a v2 instance are kept by the instance manager in memory, so
+	// they are lost when it restarts
+	Labels map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// RestartPolicy has the instance server recreate the instance once it fails. Only the v1 instances and the v2
+	// engines can be restarted. The policies are kept by the instance manager in memory, so they are lost when it
+	// restarts
+	RestartPolicy *InstanceRestartPolicy `protobuf:"bytes,11,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	// PriorityClass is critical, high or normal. A v1 instance runs with the OOM score adjustment and nice value of
+	// its class, and spdk_tgt with the ones of the highest class among the v2 instances, since SPDK has no per-instance
+	// scheduling. Defaults to normal, which leaves the processes as they are started
+	PriorityClass string `protobuf:"bytes,12,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
 }
 
 func (x *InstanceSpec) Reset() {
@@ -282,22 +381,127 @@ func (x *InstanceSpec) GetDataEngine() DataEngine {
 	return DataEngine_DATA_ENGINE_V1
 }
 
+func (x *InstanceSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *InstanceSpec) GetRestartPolicy() *InstanceRestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return nil
+}
+
+func (x *InstanceSpec) GetPriorityClass() string {
+	if x != nil {
+		return x.PriorityClass
+	}
+	return ""
+}
+
+type InstanceRestartPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// never, on-failure or always. The instance is restarted on failure once in the error state, and always once in
+	// the error or stopped state. Defaults to never
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The restarts in a row before giving up. Unlimited if 0
+	MaxRetries int32 `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// The delay before the first restart in a row, doubled by each following one up to 5 minutes. Defaults to 1
+	// second
+	BackoffMs int64 `protobuf:"varint,3,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+}
+
+func (x *InstanceRestartPolicy) Reset() {
+	*x = InstanceRestartPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceRestartPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceRestartPolicy) ProtoMessage() {}
+
+func (x *InstanceRestartPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceRestartPolicy.ProtoReflect.Descriptor instead.
+func (*InstanceRestartPolicy) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstanceRestartPolicy) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *InstanceRestartPolicy) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *InstanceRestartPolicy) GetBackoffMs() int64 {
+	if x != nil {
+		return x.BackoffMs
+	}
+	return 0
+}
+
 type InstanceStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State      string          `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	ErrorMsg   string          `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	PortStart  int32           `protobuf:"varint,3,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
-	PortEnd    int32           `protobuf:"varint,4,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
-	Conditions map[string]bool `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	State      string                  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorMsg   string                  `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	PortStart  int32                   `protobuf:"varint,3,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd    int32                   `protobuf:"varint,4,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	Conditions map[string]bool         `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Sidecars   []*ProcessSidecarStatus `protobuf:"bytes,6,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	// Only set by InstanceGet and InstanceList
+	ResourceUsage *ResourceUsage `protobuf:"bytes,7,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
+	// The read path of a v2 engine. Only set by InstanceGet and InstanceList
+	ReadPath *InstanceReadPath `protobuf:"bytes,8,opt,name=read_path,json=readPath,proto3" json:"read_path,omitempty"`
+	// The times the instance was recreated by its restart policy
+	RestartCount int32 `protobuf:"varint,9,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// What each allocated port serves. The ports of a v1 instance are told by its type, since the longhorn binary
+	// uses them by their offsets from port_start. Empty if the type of a v1 instance is unknown
+	Ports []*InstancePort `protobuf:"bytes,10,rep,name=ports,proto3" json:"ports,omitempty"`
+	// Tells apart the instances recreated by the same name. The one of a v1 instance is the one of its process, and a
+	// v2 instance gets a new one each time it is created by the instance manager
+	Uuid string `protobuf:"bytes,11,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The NVMe identifiers of the NVMe-oF target of a v2 engine with a frontend. Only set by InstanceGet and
+	// InstanceList
+	NvmeIdentity *InstanceNvmeIdentity `protobuf:"bytes,12,opt,name=nvme_identity,json=nvmeIdentity,proto3" json:"nvme_identity,omitempty"`
 }
 
 func (x *InstanceStatus) Reset() {
 	*x = InstanceStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[3]
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -310,7 +514,7 @@ func (x *InstanceStatus) String() string {
 func (*InstanceStatus) ProtoMessage() {}
 
 func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[3]
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,7 +527,7 @@ func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceStatus.ProtoReflect.Descriptor instead.
 func (*InstanceStatus) Descriptor() ([]byte, []int) {
-	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{3}
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InstanceStatus) GetState() string {
@@ -361,71 +565,72 @@ func (x *InstanceStatus) GetConditions() map[string]bool {
 	return nil
 }
 
-type InstanceCreateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Spec *InstanceSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+func (x *InstanceStatus) GetSidecars() []*ProcessSidecarStatus {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
 }
 
-func (x *InstanceCreateRequest) Reset() {
-	*x = InstanceCreateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InstanceStatus) GetResourceUsage() *ResourceUsage {
+	if x != nil {
+		return x.ResourceUsage
 	}
+	return nil
 }
 
-func (x *InstanceCreateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InstanceStatus) GetReadPath() *InstanceReadPath {
+	if x != nil {
+		return x.ReadPath
+	}
+	return nil
 }
 
-func (*InstanceCreateRequest) ProtoMessage() {}
+func (x *InstanceStatus) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
 
-func (x *InstanceCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InstanceStatus) GetPorts() []*InstancePort {
+	if x != nil {
+		return x.Ports
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use InstanceCreateRequest.ProtoReflect.Descriptor instead.
-func (*InstanceCreateRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{4}
+func (x *InstanceStatus) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
 }
 
-func (x *InstanceCreateRequest) GetSpec() *InstanceSpec {
+func (x *InstanceStatus) GetNvmeIdentity() *InstanceNvmeIdentity {
 	if x != nil {
-		return x.Spec
+		return x.NvmeIdentity
 	}
 	return nil
 }
 
-type InstanceDeleteRequest struct {
+// The identifiers a kernel NVMe block device connected to the NVMe-oF target of a v2 engine is told by, e.g. the
+// subsystem NQN and the serial in /sys/class/nvme/nvme0, and the namespace UUID and NSID in /sys/block/nvme0n1
+type InstanceNvmeIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Replaced by `data_engine`.
-	//
-	// Deprecated: Do not use.
-	BackendStoreDriver BackendStoreDriver `protobuf:"varint,1,opt,name=backend_store_driver,json=backendStoreDriver,proto3,enum=imrpc.BackendStoreDriver" json:"backend_store_driver,omitempty"`
-	Name               string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type               string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	DiskUuid           string             `protobuf:"bytes,4,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
-	CleanupRequired    bool               `protobuf:"varint,5,opt,name=cleanup_required,json=cleanupRequired,proto3" json:"cleanup_required,omitempty"`
-	DataEngine         DataEngine         `protobuf:"varint,6,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	SubsystemNqn     string `protobuf:"bytes,1,opt,name=subsystem_nqn,json=subsystemNqn,proto3" json:"subsystem_nqn,omitempty"`
+	ControllerSerial string `protobuf:"bytes,2,opt,name=controller_serial,json=controllerSerial,proto3" json:"controller_serial,omitempty"`
+	ControllerModel  string `protobuf:"bytes,3,opt,name=controller_model,json=controllerModel,proto3" json:"controller_model,omitempty"`
+	NamespaceId      uint32 `protobuf:"varint,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceUuid    string `protobuf:"bytes,5,opt,name=namespace_uuid,json=namespaceUuid,proto3" json:"namespace_uuid,omitempty"`
+	NamespaceNguid   string `protobuf:"bytes,6,opt,name=namespace_nguid,json=namespaceNguid,proto3" json:"namespace_nguid,omitempty"`
 }
 
-func (x *InstanceDeleteRequest) Reset() {
-	*x = InstanceDeleteRequest{}
+func (x *InstanceNvmeIdentity) Reset() {
+	*x = InstanceNvmeIdentity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,13 +638,13 @@ func (x *InstanceDeleteRequest) Reset() {
 	}
 }
 
-func (x *InstanceDeleteRequest) String() string {
+func (x *InstanceNvmeIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceDeleteRequest) ProtoMessage() {}
+func (*InstanceNvmeIdentity) ProtoMessage() {}
 
-func (x *InstanceDeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *InstanceNvmeIdentity) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,70 +656,71 @@ func (x *InstanceDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceDeleteRequest.ProtoReflect.Descriptor instead.
-func (*InstanceDeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstanceNvmeIdentity.ProtoReflect.Descriptor instead.
+func (*InstanceNvmeIdentity) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{5}
 }
 
-// Deprecated: Do not use.
-func (x *InstanceDeleteRequest) GetBackendStoreDriver() BackendStoreDriver {
+func (x *InstanceNvmeIdentity) GetSubsystemNqn() string {
 	if x != nil {
-		return x.BackendStoreDriver
+		return x.SubsystemNqn
 	}
-	return BackendStoreDriver_v1
+	return ""
 }
 
-func (x *InstanceDeleteRequest) GetName() string {
+func (x *InstanceNvmeIdentity) GetControllerSerial() string {
 	if x != nil {
-		return x.Name
+		return x.ControllerSerial
 	}
 	return ""
 }
 
-func (x *InstanceDeleteRequest) GetType() string {
+func (x *InstanceNvmeIdentity) GetControllerModel() string {
 	if x != nil {
-		return x.Type
+		return x.ControllerModel
 	}
 	return ""
 }
 
-func (x *InstanceDeleteRequest) GetDiskUuid() string {
+func (x *InstanceNvmeIdentity) GetNamespaceId() uint32 {
 	if x != nil {
-		return x.DiskUuid
+		return x.NamespaceId
 	}
-	return ""
+	return 0
 }
 
-func (x *InstanceDeleteRequest) GetCleanupRequired() bool {
+func (x *InstanceNvmeIdentity) GetNamespaceUuid() string {
 	if x != nil {
-		return x.CleanupRequired
+		return x.NamespaceUuid
 	}
-	return false
+	return ""
 }
 
-func (x *InstanceDeleteRequest) GetDataEngine() DataEngine {
+func (x *InstanceNvmeIdentity) GetNamespaceNguid() string {
 	if x != nil {
-		return x.DataEngine
+		return x.NamespaceNguid
 	}
-	return DataEngine_DATA_ENGINE_V1
+	return ""
 }
 
-type InstanceGetRequest struct {
+type InstancePort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Replaced by `data_engine`.
-	//
-	// Deprecated: Do not use.
-	BackendStoreDriver BackendStoreDriver `protobuf:"varint,1,opt,name=backend_store_driver,json=backendStoreDriver,proto3,enum=imrpc.BackendStoreDriver" json:"backend_store_driver,omitempty"`
-	Name               string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type               string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	DataEngine         DataEngine         `protobuf:"varint,4,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	PortStart int32 `protobuf:"varint,1,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd   int32 `protobuf:"varint,2,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	// grpc, tcp, http or nvme-tcp
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// control, data, sync-agent, sync-transfer, rebuild or frontend
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// The IP the port is listened on in addition to the IP of the instance manager, i.e. the one of the NVMe-oF TCP
+	// interface of a v2 instance. Empty if none
+	Address string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *InstanceGetRequest) Reset() {
-	*x = InstanceGetRequest{}
+func (x *InstancePort) Reset() {
+	*x = InstancePort{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -522,13 +728,13 @@ func (x *InstanceGetRequest) Reset() {
 	}
 }
 
-func (x *InstanceGetRequest) String() string {
+func (x *InstancePort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceGetRequest) ProtoMessage() {}
+func (*InstancePort) ProtoMessage() {}
 
-func (x *InstanceGetRequest) ProtoReflect() protoreflect.Message {
+func (x *InstancePort) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -540,52 +746,66 @@ func (x *InstanceGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceGetRequest.ProtoReflect.Descriptor instead.
-func (*InstanceGetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstancePort.ProtoReflect.Descriptor instead.
+func (*InstancePort) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{6}
 }
 
-// Deprecated: Do not use.
-func (x *InstanceGetRequest) GetBackendStoreDriver() BackendStoreDriver {
+func (x *InstancePort) GetPortStart() int32 {
 	if x != nil {
-		return x.BackendStoreDriver
+		return x.PortStart
 	}
-	return BackendStoreDriver_v1
+	return 0
 }
 
-func (x *InstanceGetRequest) GetName() string {
+func (x *InstancePort) GetPortEnd() int32 {
 	if x != nil {
-		return x.Name
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *InstancePort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
 	}
 	return ""
 }
 
-func (x *InstanceGetRequest) GetType() string {
+func (x *InstancePort) GetPurpose() string {
 	if x != nil {
-		return x.Type
+		return x.Purpose
 	}
 	return ""
 }
 
-func (x *InstanceGetRequest) GetDataEngine() DataEngine {
+func (x *InstancePort) GetAddress() string {
 	if x != nil {
-		return x.DataEngine
+		return x.Address
 	}
-	return DataEngine_DATA_ENGINE_V1
+	return ""
 }
 
-type InstanceResponse struct {
+type InstanceReadPath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Spec    *InstanceSpec   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status  *InstanceStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Deleted bool            `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
-}
-
-func (x *InstanceResponse) Reset() {
-	*x = InstanceResponse{}
+	// The replica set by the spec or InstanceReadPreferenceSet
+	PreferredReplica string `protobuf:"bytes,1,opt,name=preferred_replica,json=preferredReplica,proto3" json:"preferred_replica,omitempty"`
+	// The replica the reads are served by in preference to the others. Empty if the reads are balanced across the
+	// replicas, e.g. since the preferred replica is not in RW mode
+	EffectiveReplica string `protobuf:"bytes,2,opt,name=effective_replica,json=effectiveReplica,proto3" json:"effective_replica,omitempty"`
+	// Whether the effective replica is local to the engine
+	Local bool `protobuf:"varint,3,opt,name=local,proto3" json:"local,omitempty"`
+	// The replicas in RW mode the reads can be served by
+	Replicas []string `protobuf:"bytes,4,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// The reason the preferred replica is not effective
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *InstanceReadPath) Reset() {
+	*x = InstanceReadPath{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,13 +813,13 @@ func (x *InstanceResponse) Reset() {
 	}
 }
 
-func (x *InstanceResponse) String() string {
+func (x *InstanceReadPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceResponse) ProtoMessage() {}
+func (*InstanceReadPath) ProtoMessage() {}
 
-func (x *InstanceResponse) ProtoReflect() protoreflect.Message {
+func (x *InstanceReadPath) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,42 +831,62 @@ func (x *InstanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceResponse.ProtoReflect.Descriptor instead.
-func (*InstanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstanceReadPath.ProtoReflect.Descriptor instead.
+func (*InstanceReadPath) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *InstanceResponse) GetSpec() *InstanceSpec {
+func (x *InstanceReadPath) GetPreferredReplica() string {
 	if x != nil {
-		return x.Spec
+		return x.PreferredReplica
 	}
-	return nil
+	return ""
 }
 
-func (x *InstanceResponse) GetStatus() *InstanceStatus {
+func (x *InstanceReadPath) GetEffectiveReplica() string {
 	if x != nil {
-		return x.Status
+		return x.EffectiveReplica
 	}
-	return nil
+	return ""
 }
 
-func (x *InstanceResponse) GetDeleted() bool {
+func (x *InstanceReadPath) GetLocal() bool {
 	if x != nil {
-		return x.Deleted
+		return x.Local
 	}
 	return false
 }
 
-type InstanceListResponse struct {
+func (x *InstanceReadPath) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *InstanceReadPath) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type InstanceCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Instances map[string]*InstanceResponse `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Spec *InstanceSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// The retries of a creation with the same token return the response of the first one rather than failing since
+	// the instance exists. The token is remembered for 10 minutes after a successful creation. Optional
+	IdempotencyToken string `protobuf:"bytes,2,opt,name=idempotency_token,json=idempotencyToken,proto3" json:"idempotency_token,omitempty"`
+	// Validate the spec, e.g. the binary, the availability of the ports, the disk and the size alignment, without
+	// creating the instance. The invalid fields are returned as the BadRequest details of the InvalidArgument error
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 }
 
-func (x *InstanceListResponse) Reset() {
-	*x = InstanceListResponse{}
+func (x *InstanceCreateRequest) Reset() {
+	*x = InstanceCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,13 +894,13 @@ func (x *InstanceListResponse) Reset() {
 	}
 }
 
-func (x *InstanceListResponse) String() string {
+func (x *InstanceCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceListResponse) ProtoMessage() {}
+func (*InstanceCreateRequest) ProtoMessage() {}
 
-func (x *InstanceListResponse) ProtoReflect() protoreflect.Message {
+func (x *InstanceCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,19 +912,33 @@ func (x *InstanceListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceListResponse.ProtoReflect.Descriptor instead.
-func (*InstanceListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstanceCreateRequest.ProtoReflect.Descriptor instead.
+func (*InstanceCreateRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InstanceListResponse) GetInstances() map[string]*InstanceResponse {
+func (x *InstanceCreateRequest) GetSpec() *InstanceSpec {
 	if x != nil {
-		return x.Instances
+		return x.Spec
 	}
 	return nil
 }
 
-type InstanceLogRequest struct {
+func (x *InstanceCreateRequest) GetIdempotencyToken() string {
+	if x != nil {
+		return x.IdempotencyToken
+	}
+	return ""
+}
+
+func (x *InstanceCreateRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type InstanceDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -695,11 +949,15 @@ type InstanceLogRequest struct {
 	BackendStoreDriver BackendStoreDriver `protobuf:"varint,1,opt,name=backend_store_driver,json=backendStoreDriver,proto3,enum=imrpc.BackendStoreDriver" json:"backend_store_driver,omitempty"`
 	Name               string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Type               string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	DataEngine         DataEngine         `protobuf:"varint,4,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	DiskUuid           string             `protobuf:"bytes,4,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	CleanupRequired    bool               `protobuf:"varint,5,opt,name=cleanup_required,json=cleanupRequired,proto3" json:"cleanup_required,omitempty"`
+	DataEngine         DataEngine         `protobuf:"varint,6,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// Overrides the graceful stop timeout of the v1 instance process for this deletion if not 0
+	StopTimeoutSeconds int64 `protobuf:"varint,7,opt,name=stop_timeout_seconds,json=stopTimeoutSeconds,proto3" json:"stop_timeout_seconds,omitempty"`
 }
 
-func (x *InstanceLogRequest) Reset() {
-	*x = InstanceLogRequest{}
+func (x *InstanceDeleteRequest) Reset() {
+	*x = InstanceDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,13 +965,13 @@ func (x *InstanceLogRequest) Reset() {
 	}
 }
 
-func (x *InstanceLogRequest) String() string {
+func (x *InstanceDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceLogRequest) ProtoMessage() {}
+func (*InstanceDeleteRequest) ProtoMessage() {}
 
-func (x *InstanceLogRequest) ProtoReflect() protoreflect.Message {
+func (x *InstanceDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,51 +983,74 @@ func (x *InstanceLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceLogRequest.ProtoReflect.Descriptor instead.
-func (*InstanceLogRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstanceDeleteRequest.ProtoReflect.Descriptor instead.
+func (*InstanceDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{9}
 }
 
 // Deprecated: Do not use.
-func (x *InstanceLogRequest) GetBackendStoreDriver() BackendStoreDriver {
+func (x *InstanceDeleteRequest) GetBackendStoreDriver() BackendStoreDriver {
 	if x != nil {
 		return x.BackendStoreDriver
 	}
 	return BackendStoreDriver_v1
 }
 
-func (x *InstanceLogRequest) GetName() string {
+func (x *InstanceDeleteRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *InstanceLogRequest) GetType() string {
+func (x *InstanceDeleteRequest) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *InstanceLogRequest) GetDataEngine() DataEngine {
+func (x *InstanceDeleteRequest) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *InstanceDeleteRequest) GetCleanupRequired() bool {
+	if x != nil {
+		return x.CleanupRequired
+	}
+	return false
+}
+
+func (x *InstanceDeleteRequest) GetDataEngine() DataEngine {
 	if x != nil {
 		return x.DataEngine
 	}
 	return DataEngine_DATA_ENGINE_V1
 }
 
-type InstanceReplaceRequest struct {
+func (x *InstanceDeleteRequest) GetStopTimeoutSeconds() int64 {
+	if x != nil {
+		return x.StopTimeoutSeconds
+	}
+	return 0
+}
+
+// InstanceBatchCreateRequest creates the instances in parallel. Each creation succeeds or fails on its own.
+type InstanceBatchCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Spec            *InstanceSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	TerminateSignal string        `protobuf:"bytes,2,opt,name=terminate_signal,json=terminateSignal,proto3" json:"terminate_signal,omitempty"`
+	Requests []*InstanceCreateRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The maximum number of the creations in flight. The server default is used if 0
+	Concurrency int32 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
 }
 
-func (x *InstanceReplaceRequest) Reset() {
-	*x = InstanceReplaceRequest{}
+func (x *InstanceBatchCreateRequest) Reset() {
+	*x = InstanceBatchCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -777,13 +1058,13 @@ func (x *InstanceReplaceRequest) Reset() {
 	}
 }
 
-func (x *InstanceReplaceRequest) String() string {
+func (x *InstanceBatchCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceReplaceRequest) ProtoMessage() {}
+func (*InstanceBatchCreateRequest) ProtoMessage() {}
 
-func (x *InstanceReplaceRequest) ProtoReflect() protoreflect.Message {
+func (x *InstanceBatchCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,455 +1076,7689 @@ func (x *InstanceReplaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceReplaceRequest.ProtoReflect.Descriptor instead.
-func (*InstanceReplaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstanceBatchCreateRequest.ProtoReflect.Descriptor instead.
+func (*InstanceBatchCreateRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *InstanceReplaceRequest) GetSpec() *InstanceSpec {
+func (x *InstanceBatchCreateRequest) GetRequests() []*InstanceCreateRequest {
 	if x != nil {
-		return x.Spec
+		return x.Requests
 	}
 	return nil
 }
 
-func (x *InstanceReplaceRequest) GetTerminateSignal() string {
+func (x *InstanceBatchCreateRequest) GetConcurrency() int32 {
 	if x != nil {
-		return x.TerminateSignal
+		return x.Concurrency
 	}
-	return ""
+	return 0
 }
 
-var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto protoreflect.FileDescriptor
+// InstanceBatchDeleteRequest deletes the instances in parallel. Each deletion succeeds or fails on its own.
+type InstanceBatchDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDesc = []byte{
-	0x0a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
-	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x44, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72,
-	0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e,
-	0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6d, 0x72, 0x70,
-	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x41, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16,
-	0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0xcb, 0x02, 0x0a, 0x10, 0x53,
-	0x70, 0x64, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x5e, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69,
-	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12,
-	0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x1a, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x03, 0x0a, 0x0c, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x4f, 0x0a, 0x14, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12,
-	0x4e, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x45, 0x0a, 0x12, 0x73, 0x70, 0x64, 0x6b, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6d,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x10, 0x73, 0x70, 0x64, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d,
-	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a,
-	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x0e, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x40, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x22, 0x8c, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72,
-	0x70, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75,
-	0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c,
-	0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x32, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b,
-	0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73,
-	0x70, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xb7, 0x01, 0x0a,
-	0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a,
-	0x55, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a,
-	0x14, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d,
-	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b,
-	0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d,
-	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a,
-	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0x6c, 0x0a, 0x16, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x29, 0x0a,
-	0x10, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0xb9, 0x04, 0x0a, 0x0f, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c,
-	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69,
-	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x69, 0x6d, 0x72, 0x70,
-	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x65,
-	0x74, 0x12, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69,
-	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x1b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
-	0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x19, 0x2e,
-	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x4b, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x10, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67,
-	0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Requests []*InstanceDeleteRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The maximum number of the deletions in flight. The server default is used if 0
+	Concurrency int32 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
 }
 
-var (
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescOnce sync.Once
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDesc
-)
+func (x *InstanceBatchDeleteRequest) Reset() {
+	*x = InstanceBatchDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP() []byte {
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescOnce.Do(func() {
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData)
-	})
-	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData
+func (x *InstanceBatchDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_goTypes = []interface{}{
-	(*ProcessInstanceSpec)(nil),    // 0: imrpc.ProcessInstanceSpec
-	(*SpdkInstanceSpec)(nil),       // 1: imrpc.SpdkInstanceSpec
-	(*InstanceSpec)(nil),           // 2: imrpc.InstanceSpec
-	(*InstanceStatus)(nil),         // 3: imrpc.InstanceStatus
-	(*InstanceCreateRequest)(nil),  // 4: imrpc.InstanceCreateRequest
-	(*InstanceDeleteRequest)(nil),  // 5: imrpc.InstanceDeleteRequest
-	(*InstanceGetRequest)(nil),     // 6: imrpc.InstanceGetRequest
-	(*InstanceResponse)(nil),       // 7: imrpc.InstanceResponse
-	(*InstanceListResponse)(nil),   // 8: imrpc.InstanceListResponse
-	(*InstanceLogRequest)(nil),     // 9: imrpc.InstanceLogRequest
-	(*InstanceReplaceRequest)(nil), // 10: imrpc.InstanceReplaceRequest
-	nil,                            // 11: imrpc.SpdkInstanceSpec.ReplicaAddressMapEntry
-	nil,                            // 12: imrpc.InstanceStatus.ConditionsEntry
-	nil,                            // 13: imrpc.InstanceListResponse.InstancesEntry
-	(BackendStoreDriver)(0),        // 14: imrpc.BackendStoreDriver
-	(DataEngine)(0),                // 15: imrpc.DataEngine
-	(*emptypb.Empty)(nil),          // 16: google.protobuf.Empty
-	(*LogResponse)(nil),            // 17: LogResponse
-	(*VersionResponse)(nil),        // 18: VersionResponse
+func (*InstanceBatchDeleteRequest) ProtoMessage() {}
+
+func (x *InstanceBatchDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_depIdxs = []int32{
-	11, // 0: imrpc.SpdkInstanceSpec.replica_address_map:type_name -> imrpc.SpdkInstanceSpec.ReplicaAddressMapEntry
-	14, // 1: imrpc.InstanceSpec.backend_store_driver:type_name -> imrpc.BackendStoreDriver
-	0,  // 2: imrpc.InstanceSpec.process_instance_spec:type_name -> imrpc.ProcessInstanceSpec
-	1,  // 3: imrpc.InstanceSpec.spdk_instance_spec:type_name -> imrpc.SpdkInstanceSpec
-	15, // 4: imrpc.InstanceSpec.data_engine:type_name -> imrpc.DataEngine
-	12, // 5: imrpc.InstanceStatus.conditions:type_name -> imrpc.InstanceStatus.ConditionsEntry
-	2,  // 6: imrpc.InstanceCreateRequest.spec:type_name -> imrpc.InstanceSpec
-	14, // 7: imrpc.InstanceDeleteRequest.backend_store_driver:type_name -> imrpc.BackendStoreDriver
-	15, // 8: imrpc.InstanceDeleteRequest.data_engine:type_name -> imrpc.DataEngine
-	14, // 9: imrpc.InstanceGetRequest.backend_store_driver:type_name -> imrpc.BackendStoreDriver
-	15, // 10: imrpc.InstanceGetRequest.data_engine:type_name -> imrpc.DataEngine
-	2,  // 11: imrpc.InstanceResponse.spec:type_name -> imrpc.InstanceSpec
-	3,  // 12: imrpc.InstanceResponse.status:type_name -> imrpc.InstanceStatus
-	13, // 13: imrpc.InstanceListResponse.instances:type_name -> imrpc.InstanceListResponse.InstancesEntry
-	14, // 14: imrpc.InstanceLogRequest.backend_store_driver:type_name -> imrpc.BackendStoreDriver
-	15, // 15: imrpc.InstanceLogRequest.data_engine:type_name -> imrpc.DataEngine
-	2,  // 16: imrpc.InstanceReplaceRequest.spec:type_name -> imrpc.InstanceSpec
-	7,  // 17: imrpc.InstanceListResponse.InstancesEntry.value:type_name -> imrpc.InstanceResponse
-	4,  // 18: imrpc.InstanceService.InstanceCreate:input_type -> imrpc.InstanceCreateRequest
-	5,  // 19: imrpc.InstanceService.InstanceDelete:input_type -> imrpc.InstanceDeleteRequest
-	6,  // 20: imrpc.InstanceService.InstanceGet:input_type -> imrpc.InstanceGetRequest
-	16, // 21: imrpc.InstanceService.InstanceList:input_type -> google.protobuf.Empty
-	9,  // 22: imrpc.InstanceService.InstanceLog:input_type -> imrpc.InstanceLogRequest
-	16, // 23: imrpc.InstanceService.InstanceWatch:input_type -> google.protobuf.Empty
-	10, // 24: imrpc.InstanceService.InstanceReplace:input_type -> imrpc.InstanceReplaceRequest
-	16, // 25: imrpc.InstanceService.VersionGet:input_type -> google.protobuf.Empty
-	7,  // 26: imrpc.InstanceService.InstanceCreate:output_type -> imrpc.InstanceResponse
-	7,  // 27: imrpc.InstanceService.InstanceDelete:output_type -> imrpc.InstanceResponse
-	7,  // 28: imrpc.InstanceService.InstanceGet:output_type -> imrpc.InstanceResponse
-	8,  // 29: imrpc.InstanceService.InstanceList:output_type -> imrpc.InstanceListResponse
-	17, // 30: imrpc.InstanceService.InstanceLog:output_type -> LogResponse
-	16, // 31: imrpc.InstanceService.InstanceWatch:output_type -> google.protobuf.Empty
-	7,  // 32: imrpc.InstanceService.InstanceReplace:output_type -> imrpc.InstanceResponse
-	18, // 33: imrpc.InstanceService.VersionGet:output_type -> VersionResponse
-	26, // [26:34] is the sub-list for method output_type
-	18, // [18:26] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+
+// Deprecated: Use InstanceBatchDeleteRequest.ProtoReflect.Descriptor instead.
+func (*InstanceBatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_init() }
-func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_init() {
-	if File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto != nil {
-		return
+func (x *InstanceBatchDeleteRequest) GetRequests() []*InstanceDeleteRequest {
+	if x != nil {
+		return x.Requests
 	}
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_common_proto_init()
-	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessInstanceSpec); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpdkInstanceSpec); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceSpec); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *InstanceBatchDeleteRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type InstanceBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty if the operation failed
+	Instance *InstanceResponse `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The gRPC status code of the operation, which is OK if it succeeded
+	ErrorCode int32  `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMsg  string `protobuf:"bytes,4,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *InstanceBatchResult) Reset() {
+	*x = InstanceBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceBatchResult) ProtoMessage() {}
+
+func (x *InstanceBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceStatus); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceCreateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceDeleteRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceGetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceBatchResult.ProtoReflect.Descriptor instead.
+func (*InstanceBatchResult) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InstanceBatchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceBatchResult) GetInstance() *InstanceResponse {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *InstanceBatchResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *InstanceBatchResult) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type InstanceBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results in the order of the requests
+	Results []*InstanceBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *InstanceBatchResponse) Reset() {
+	*x = InstanceBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceBatchResponse) ProtoMessage() {}
+
+func (x *InstanceBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceLogRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceBatchResponse.ProtoReflect.Descriptor instead.
+func (*InstanceBatchResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InstanceBatchResponse) GetResults() []*InstanceBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type InstanceGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Replaced by `data_engine`.
+	//
+	// Deprecated: Do not use.
+	BackendStoreDriver BackendStoreDriver `protobuf:"varint,1,opt,name=backend_store_driver,json=backendStoreDriver,proto3,enum=imrpc.BackendStoreDriver" json:"backend_store_driver,omitempty"`
+	Name               string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine         DataEngine         `protobuf:"varint,4,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// Include the recent operations applied to the instance in the response
+	Verbose bool `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	// Addresses the instance by its UUID rather than by its name. If both are set, the instance of the name is
+	// returned only if it has the UUID
+	Uuid string `protobuf:"bytes,6,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *InstanceGetRequest) Reset() {
+	*x = InstanceGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceGetRequest) ProtoMessage() {}
+
+func (x *InstanceGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceReplaceRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceGetRequest.ProtoReflect.Descriptor instead.
+func (*InstanceGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{14}
+}
+
+// Deprecated: Do not use.
+func (x *InstanceGetRequest) GetBackendStoreDriver() BackendStoreDriver {
+	if x != nil {
+		return x.BackendStoreDriver
+	}
+	return BackendStoreDriver_v1
+}
+
+func (x *InstanceGetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceGetRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceGetRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceGetRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *InstanceGetRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type InstanceRefreshRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,3,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+}
+
+func (x *InstanceRefreshRequest) Reset() {
+	*x = InstanceRefreshRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceRefreshRequest) ProtoMessage() {}
+
+func (x *InstanceRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_goTypes,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceRefreshRequest.ProtoReflect.Descriptor instead.
+func (*InstanceRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InstanceRefreshRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceRefreshRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceRefreshRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+type InstanceSuspendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,3,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+}
+
+func (x *InstanceSuspendRequest) Reset() {
+	*x = InstanceSuspendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceSuspendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSuspendRequest) ProtoMessage() {}
+
+func (x *InstanceSuspendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSuspendRequest.ProtoReflect.Descriptor instead.
+func (*InstanceSuspendRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InstanceSuspendRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceSuspendRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceSuspendRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+type InstanceResumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,3,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+}
+
+func (x *InstanceResumeRequest) Reset() {
+	*x = InstanceResumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceResumeRequest) ProtoMessage() {}
+
+func (x *InstanceResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceResumeRequest.ProtoReflect.Descriptor instead.
+func (*InstanceResumeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InstanceResumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceResumeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceResumeRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+// InstanceSwitchOverTargetRequest switches the frontend of a v2 engine over to the NVMe-oF target of another engine of
+// the volume, e.g. the one created with the spdk-tcp-nvmf frontend by the instance manager taking over during a live
+// upgrade. The I/O is queued in the frontend during the switch-over. Deleting the engine afterwards keeps the frontend,
+// which is handed over to the engine of the target.
+type InstanceSwitchOverTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// The address of the target in the format of <ip>:<port>. The frontend switches back to the target of the engine
+	// itself if it is the address of the engine
+	TargetAddress string `protobuf:"bytes,3,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+}
+
+func (x *InstanceSwitchOverTargetRequest) Reset() {
+	*x = InstanceSwitchOverTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceSwitchOverTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSwitchOverTargetRequest) ProtoMessage() {}
+
+func (x *InstanceSwitchOverTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSwitchOverTargetRequest.ProtoReflect.Descriptor instead.
+func (*InstanceSwitchOverTargetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InstanceSwitchOverTargetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceSwitchOverTargetRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceSwitchOverTargetRequest) GetTargetAddress() string {
+	if x != nil {
+		return x.TargetAddress
+	}
+	return ""
+}
+
+// InstanceDeleteTargetRequest deletes the NVMe-oF target of a v2 engine whose frontend is switched over to another
+// target, which leaves the engine as the standby of the volume.
+type InstanceDeleteTargetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+}
+
+func (x *InstanceDeleteTargetRequest) Reset() {
+	*x = InstanceDeleteTargetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceDeleteTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDeleteTargetRequest) ProtoMessage() {}
+
+func (x *InstanceDeleteTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDeleteTargetRequest.ProtoReflect.Descriptor instead.
+func (*InstanceDeleteTargetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InstanceDeleteTargetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceDeleteTargetRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+type InstanceOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// RFC 3339 timestamp of the completion of the operation
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Details   string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// Empty if the operation succeeded
+	ErrorMsg string `protobuf:"bytes,4,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *InstanceOperation) Reset() {
+	*x = InstanceOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceOperation) ProtoMessage() {}
+
+func (x *InstanceOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceOperation.ProtoReflect.Descriptor instead.
+func (*InstanceOperation) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InstanceOperation) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *InstanceOperation) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *InstanceOperation) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *InstanceOperation) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type InstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec       *InstanceSpec        `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status     *InstanceStatus      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Deleted    bool                 `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Operations []*InstanceOperation `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	Topology   *NodeTopology        `protobuf:"bytes,5,opt,name=topology,proto3" json:"topology,omitempty"`
+}
+
+func (x *InstanceResponse) Reset() {
+	*x = InstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceResponse) ProtoMessage() {}
+
+func (x *InstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceResponse.ProtoReflect.Descriptor instead.
+func (*InstanceResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InstanceResponse) GetSpec() *InstanceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *InstanceResponse) GetStatus() *InstanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *InstanceResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *InstanceResponse) GetOperations() []*InstanceOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *InstanceResponse) GetTopology() *NodeTopology {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+// InstanceListRequest lists the instances matching all the filters. A filter is skipped if it is empty.
+type InstanceListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataEngines []DataEngine `protobuf:"varint,1,rep,packed,name=data_engines,json=dataEngines,proto3,enum=imrpc.DataEngine" json:"data_engines,omitempty"`
+	// The v1 instances have no type, so they never match the type filter
+	Types      []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	States     []string `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	NamePrefix string   `protobuf:"bytes,4,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	// The instances are returned by name in pages of at most page_size instances. All the instances are returned
+	// in one response if 0
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous page, or empty for the first page
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The resource_version of a previous response, to list only the instances changed or deleted since then. The
+	// full list is returned instead if the changes are no longer known. Cannot be combined with the pagination or
+	// the state filter
+	SinceResourceVersion string `protobuf:"bytes,7,opt,name=since_resource_version,json=sinceResourceVersion,proto3" json:"since_resource_version,omitempty"`
+	// Selects the instances by labels in the form of key=value, key!=value, key and !key separated by commas
+	LabelSelector string `protobuf:"bytes,8,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+}
+
+func (x *InstanceListRequest) Reset() {
+	*x = InstanceListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceListRequest) ProtoMessage() {}
+
+func (x *InstanceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceListRequest.ProtoReflect.Descriptor instead.
+func (*InstanceListRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InstanceListRequest) GetDataEngines() []DataEngine {
+	if x != nil {
+		return x.DataEngines
+	}
+	return nil
+}
+
+func (x *InstanceListRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *InstanceListRequest) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *InstanceListRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *InstanceListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *InstanceListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *InstanceListRequest) GetSinceResourceVersion() string {
+	if x != nil {
+		return x.SinceResourceVersion
+	}
+	return ""
+}
+
+func (x *InstanceListRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type InstanceListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only the instances added or modified since since_resource_version if delta
+	Instances map[string]*InstanceResponse `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Empty if this is the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The version of the instances on the instance manager, for the since_resource_version of the next list. Empty if
+	// the data engine filter skips a data engine
+	ResourceVersion string `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	Delta           bool   `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The instances deleted since since_resource_version if delta
+	DeletedNames []string `protobuf:"bytes,5,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
+}
+
+func (x *InstanceListResponse) Reset() {
+	*x = InstanceListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceListResponse) ProtoMessage() {}
+
+func (x *InstanceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceListResponse.ProtoReflect.Descriptor instead.
+func (*InstanceListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InstanceListResponse) GetInstances() map[string]*InstanceResponse {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *InstanceListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *InstanceListResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *InstanceListResponse) GetDelta() bool {
+	if x != nil {
+		return x.Delta
+	}
+	return false
+}
+
+func (x *InstanceListResponse) GetDeletedNames() []string {
+	if x != nil {
+		return x.DeletedNames
+	}
+	return nil
+}
+
+type InstanceWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only the changes of the instances selected by the labels are sent. All the changes are sent if empty
+	LabelSelector string `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// The resume token of the last event received by a previous watch, whose missed events are replayed before
+	// the new ones. A resync event is sent instead if the events are no longer kept.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *InstanceWatchRequest) Reset() {
+	*x = InstanceWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWatchRequest) ProtoMessage() {}
+
+func (x *InstanceWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWatchRequest.ProtoReflect.Descriptor instead.
+func (*InstanceWatchRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InstanceWatchRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *InstanceWatchRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// InstanceWatchEvent is a change of an instance. An event with the type resync or an empty type, as sent by the
+// instance managers not reporting the changes, asks the client to list the instances instead.
+type InstanceWatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of added, modified, deleted and resync
+	EventType  string     `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string     `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,4,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// The states are empty for an added or a deleted instance respectively
+	OldState  string `protobuf:"bytes,5,opt,name=old_state,json=oldState,proto3" json:"old_state,omitempty"`
+	NewState  string `protobuf:"bytes,6,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	Timestamp string `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The token to resume the watch after this event with
+	ResumeToken string `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *InstanceWatchEvent) Reset() {
+	*x = InstanceWatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceWatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWatchEvent) ProtoMessage() {}
+
+func (x *InstanceWatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWatchEvent.ProtoReflect.Descriptor instead.
+func (*InstanceWatchEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InstanceWatchEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *InstanceWatchEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceWatchEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceWatchEvent) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceWatchEvent) GetOldState() string {
+	if x != nil {
+		return x.OldState
+	}
+	return ""
+}
+
+func (x *InstanceWatchEvent) GetNewState() string {
+	if x != nil {
+		return x.NewState
+	}
+	return ""
+}
+
+func (x *InstanceWatchEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *InstanceWatchEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// InstanceWatchFreezeRequest holds the notifications of the watches during a planned bulk operation, e.g. a node
+// drain. Once the freeze ends, each watch notified in the meantime receives a single resync event instead of the
+// changes.
+type InstanceWatchFreezeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The freeze ends by itself after the TTL, in case the caller never thaws it. The default TTL is used if it is
+	// 0. Freezing again while frozen extends the freeze
+	TtlSeconds int64  `protobuf:"varint,1,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *InstanceWatchFreezeRequest) Reset() {
+	*x = InstanceWatchFreezeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceWatchFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWatchFreezeRequest) ProtoMessage() {}
+
+func (x *InstanceWatchFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWatchFreezeRequest.ProtoReflect.Descriptor instead.
+func (*InstanceWatchFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InstanceWatchFreezeRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *InstanceWatchFreezeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type InstanceWatchFreezeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frozen bool   `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// RFC 3339 timestamps, empty if not frozen
+	FrozenAt  string `protobuf:"bytes,3,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	ExpiresAt string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *InstanceWatchFreezeStatus) Reset() {
+	*x = InstanceWatchFreezeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceWatchFreezeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWatchFreezeStatus) ProtoMessage() {}
+
+func (x *InstanceWatchFreezeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWatchFreezeStatus.ProtoReflect.Descriptor instead.
+func (*InstanceWatchFreezeStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InstanceWatchFreezeStatus) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *InstanceWatchFreezeStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InstanceWatchFreezeStatus) GetFrozenAt() string {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return ""
+}
+
+func (x *InstanceWatchFreezeStatus) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type InstanceLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Replaced by `data_engine`.
+	//
+	// Deprecated: Do not use.
+	BackendStoreDriver BackendStoreDriver `protobuf:"varint,1,opt,name=backend_store_driver,json=backendStoreDriver,proto3,enum=imrpc.BackendStoreDriver" json:"backend_store_driver,omitempty"`
+	Name               string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine         DataEngine         `protobuf:"varint,4,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	SinceSequence      uint64             `protobuf:"varint,5,opt,name=since_sequence,json=sinceSequence,proto3" json:"since_sequence,omitempty"`
+	Batched            bool               `protobuf:"varint,6,opt,name=batched,proto3" json:"batched,omitempty"`
+	Compressed         bool               `protobuf:"varint,7,opt,name=compressed,proto3" json:"compressed,omitempty"`
+}
+
+func (x *InstanceLogRequest) Reset() {
+	*x = InstanceLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceLogRequest) ProtoMessage() {}
+
+func (x *InstanceLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceLogRequest.ProtoReflect.Descriptor instead.
+func (*InstanceLogRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{28}
+}
+
+// Deprecated: Do not use.
+func (x *InstanceLogRequest) GetBackendStoreDriver() BackendStoreDriver {
+	if x != nil {
+		return x.BackendStoreDriver
+	}
+	return BackendStoreDriver_v1
+}
+
+func (x *InstanceLogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceLogRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceLogRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceLogRequest) GetSinceSequence() uint64 {
+	if x != nil {
+		return x.SinceSequence
+	}
+	return 0
+}
+
+func (x *InstanceLogRequest) GetBatched() bool {
+	if x != nil {
+		return x.Batched
+	}
+	return false
+}
+
+func (x *InstanceLogRequest) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+// VolumeLogRequest streams the logs of the instances of a volume on this node, i.e. its engine and replicas,
+// interleaved by time. The instances of the volume are the ones with the volume name in the spec, or named after the
+// volume as <volume>-e-<suffix> and <volume>-r-<suffix>
+type VolumeLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeName string `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+}
+
+func (x *VolumeLogRequest) Reset() {
+	*x = VolumeLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeLogRequest) ProtoMessage() {}
+
+func (x *VolumeLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeLogRequest.ProtoReflect.Descriptor instead.
+func (*VolumeLogRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VolumeLogRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+type VolumeLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName string     `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	DataEngine   DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	Line         string     `protobuf:"bytes,3,opt,name=line,proto3" json:"line,omitempty"`
+	// The sequence of the line in the log of the instance
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// 0 for the lines without a timestamp, which are sent right after the previous line of the instance
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *VolumeLogResponse) Reset() {
+	*x = VolumeLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeLogResponse) ProtoMessage() {}
+
+func (x *VolumeLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeLogResponse.ProtoReflect.Descriptor instead.
+func (*VolumeLogResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VolumeLogResponse) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *VolumeLogResponse) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *VolumeLogResponse) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *VolumeLogResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *VolumeLogResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type InstanceReplaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec            *InstanceSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	TerminateSignal string        `protobuf:"bytes,2,opt,name=terminate_signal,json=terminateSignal,proto3" json:"terminate_signal,omitempty"`
+}
+
+func (x *InstanceReplaceRequest) Reset() {
+	*x = InstanceReplaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceReplaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceReplaceRequest) ProtoMessage() {}
+
+func (x *InstanceReplaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceReplaceRequest.ProtoReflect.Descriptor instead.
+func (*InstanceReplaceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InstanceReplaceRequest) GetSpec() *InstanceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *InstanceReplaceRequest) GetTerminateSignal() string {
+	if x != nil {
+		return x.TerminateSignal
+	}
+	return ""
+}
+
+type InstanceStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for all instances
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *InstanceStatsRequest) Reset() {
+	*x = InstanceStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatsRequest) ProtoMessage() {}
+
+func (x *InstanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*InstanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InstanceStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type InstanceNetworkStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortStart int32 `protobuf:"varint,1,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd   int32 `protobuf:"varint,2,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	// Bytes sent and received on the connections to the ports of the instance, including the closed connections
+	// observed by the instance manager
+	BytesSent     uint64 `protobuf:"varint,3,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesReceived uint64 `protobuf:"varint,4,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	Connections   int32  `protobuf:"varint,5,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *InstanceNetworkStats) Reset() {
+	*x = InstanceNetworkStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceNetworkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceNetworkStats) ProtoMessage() {}
+
+func (x *InstanceNetworkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceNetworkStats.ProtoReflect.Descriptor instead.
+func (*InstanceNetworkStats) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InstanceNetworkStats) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *InstanceNetworkStats) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *InstanceNetworkStats) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *InstanceNetworkStats) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *InstanceNetworkStats) GetConnections() int32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+type InstanceStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats map[string]*InstanceNetworkStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InstanceStatsResponse) Reset() {
+	*x = InstanceStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatsResponse) ProtoMessage() {}
+
+func (x *InstanceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatsResponse.ProtoReflect.Descriptor instead.
+func (*InstanceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InstanceStatsResponse) GetStats() map[string]*InstanceNetworkStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type InstanceLatencyProbeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The engine instance of the volume
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// Required by a v1 engine, whose frontend device is named after the volume
+	VolumeName string `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	// The number of the reads, and the writes if enabled, to sample
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Read-only unless enabled. The writes overwrite the block at scratch_offset, which must be reserved by the
+	// caller, e.g. a region outside of the filesystem
+	Write         bool   `protobuf:"varint,5,opt,name=write,proto3" json:"write,omitempty"`
+	ScratchOffset uint64 `protobuf:"varint,6,opt,name=scratch_offset,json=scratchOffset,proto3" json:"scratch_offset,omitempty"`
+}
+
+func (x *InstanceLatencyProbeRequest) Reset() {
+	*x = InstanceLatencyProbeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceLatencyProbeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceLatencyProbeRequest) ProtoMessage() {}
+
+func (x *InstanceLatencyProbeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceLatencyProbeRequest.ProtoReflect.Descriptor instead.
+func (*InstanceLatencyProbeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InstanceLatencyProbeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceLatencyProbeRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceLatencyProbeRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *InstanceLatencyProbeRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *InstanceLatencyProbeRequest) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *InstanceLatencyProbeRequest) GetScratchOffset() uint64 {
+	if x != nil {
+		return x.ScratchOffset
+	}
+	return 0
+}
+
+type LatencyStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MinNs int64 `protobuf:"varint,2,opt,name=min_ns,json=minNs,proto3" json:"min_ns,omitempty"`
+	AvgNs int64 `protobuf:"varint,3,opt,name=avg_ns,json=avgNs,proto3" json:"avg_ns,omitempty"`
+	MaxNs int64 `protobuf:"varint,4,opt,name=max_ns,json=maxNs,proto3" json:"max_ns,omitempty"`
+}
+
+func (x *LatencyStats) Reset() {
+	*x = LatencyStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyStats) ProtoMessage() {}
+
+func (x *LatencyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyStats.ProtoReflect.Descriptor instead.
+func (*LatencyStats) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LatencyStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetMinNs() int64 {
+	if x != nil {
+		return x.MinNs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetAvgNs() int64 {
+	if x != nil {
+		return x.AvgNs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetMaxNs() int64 {
+	if x != nil {
+		return x.MaxNs
+	}
+	return 0
+}
+
+type InstanceLatencyProbeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device string        `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Read   *LatencyStats `protobuf:"bytes,2,opt,name=read,proto3" json:"read,omitempty"`
+	Write  *LatencyStats `protobuf:"bytes,3,opt,name=write,proto3" json:"write,omitempty"`
+	// The TCP connection latencies from the node to each replica of a v2 engine
+	ReplicaHops      map[string]*LatencyStats `protobuf:"bytes,4,rep,name=replica_hops,json=replicaHops,proto3" json:"replica_hops,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReplicaHopErrors map[string]string        `protobuf:"bytes,5,rep,name=replica_hop_errors,json=replicaHopErrors,proto3" json:"replica_hop_errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InstanceLatencyProbeResponse) Reset() {
+	*x = InstanceLatencyProbeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceLatencyProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceLatencyProbeResponse) ProtoMessage() {}
+
+func (x *InstanceLatencyProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceLatencyProbeResponse.ProtoReflect.Descriptor instead.
+func (*InstanceLatencyProbeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InstanceLatencyProbeResponse) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *InstanceLatencyProbeResponse) GetRead() *LatencyStats {
+	if x != nil {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *InstanceLatencyProbeResponse) GetWrite() *LatencyStats {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *InstanceLatencyProbeResponse) GetReplicaHops() map[string]*LatencyStats {
+	if x != nil {
+		return x.ReplicaHops
+	}
+	return nil
+}
+
+func (x *InstanceLatencyProbeResponse) GetReplicaHopErrors() map[string]string {
+	if x != nil {
+		return x.ReplicaHopErrors
+	}
+	return nil
+}
+
+type InstanceHealthCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,3,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// Reads a block of the frontend device of a v2 engine as well, if the engine has a local one
+	IoProbe bool `protobuf:"varint,4,opt,name=io_probe,json=ioProbe,proto3" json:"io_probe,omitempty"`
+}
+
+func (x *InstanceHealthCheckRequest) Reset() {
+	*x = InstanceHealthCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceHealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceHealthCheckRequest) ProtoMessage() {}
+
+func (x *InstanceHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*InstanceHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InstanceHealthCheckRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceHealthCheckRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InstanceHealthCheckRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceHealthCheckRequest) GetIoProbe() bool {
+	if x != nil {
+		return x.IoProbe
+	}
+	return false
+}
+
+// The result of probing the instance right away, which may tell apart from the state cached by the instance manager
+// since the last change of the instance
+type InstanceHealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reachable bool   `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// The time the probes took, set even if the instance is unreachable
+	LatencyNs int64 `protobuf:"varint,3,opt,name=latency_ns,json=latencyNs,proto3" json:"latency_ns,omitempty"`
+	// The probes run in order, e.g. grpc-health for a v1 instance, spdk-bdev and io for a v2 engine
+	Probes []string `protobuf:"bytes,4,rep,name=probes,proto3" json:"probes,omitempty"`
+	// Why the instance is unreachable
+	ErrorMsg string `protobuf:"bytes,5,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	// The state of the instance told by its backend
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *InstanceHealthCheckResponse) Reset() {
+	*x = InstanceHealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceHealthCheckResponse) ProtoMessage() {}
+
+func (x *InstanceHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*InstanceHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *InstanceHealthCheckResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceHealthCheckResponse) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *InstanceHealthCheckResponse) GetLatencyNs() int64 {
+	if x != nil {
+		return x.LatencyNs
+	}
+	return 0
+}
+
+func (x *InstanceHealthCheckResponse) GetProbes() []string {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *InstanceHealthCheckResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *InstanceHealthCheckResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// The I/O timeouts of the frontend of a v2 engine, i.e. of the NVMe-oF controllers the node connects to the engine
+// with. A timeout of 0 is left as is.
+type InstanceIOTimeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time an I/O is waited for before it is aborted and the controller is reset
+	IoTimeoutMs int32 `protobuf:"varint,1,opt,name=io_timeout_ms,json=ioTimeoutMs,proto3" json:"io_timeout_ms,omitempty"`
+	// The time a lost controller is reconnected for before it is removed along with the device. Forever if -1
+	CtrlLossTimeoutSec int32 `protobuf:"varint,2,opt,name=ctrl_loss_timeout_sec,json=ctrlLossTimeoutSec,proto3" json:"ctrl_loss_timeout_sec,omitempty"`
+	// The interval between the reconnections of a lost controller
+	ReconnectDelaySec int32 `protobuf:"varint,3,opt,name=reconnect_delay_sec,json=reconnectDelaySec,proto3" json:"reconnect_delay_sec,omitempty"`
+	// The time the I/Os are queued for after the controller is lost, before they fail. Queued until the controller
+	// is removed if -1
+	FastIoFailTimeoutSec int32 `protobuf:"varint,4,opt,name=fast_io_fail_timeout_sec,json=fastIoFailTimeoutSec,proto3" json:"fast_io_fail_timeout_sec,omitempty"`
+}
+
+func (x *InstanceIOTimeouts) Reset() {
+	*x = InstanceIOTimeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceIOTimeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceIOTimeouts) ProtoMessage() {}
+
+func (x *InstanceIOTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceIOTimeouts.ProtoReflect.Descriptor instead.
+func (*InstanceIOTimeouts) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InstanceIOTimeouts) GetIoTimeoutMs() int32 {
+	if x != nil {
+		return x.IoTimeoutMs
+	}
+	return 0
+}
+
+func (x *InstanceIOTimeouts) GetCtrlLossTimeoutSec() int32 {
+	if x != nil {
+		return x.CtrlLossTimeoutSec
+	}
+	return 0
+}
+
+func (x *InstanceIOTimeouts) GetReconnectDelaySec() int32 {
+	if x != nil {
+		return x.ReconnectDelaySec
+	}
+	return 0
+}
+
+func (x *InstanceIOTimeouts) GetFastIoFailTimeoutSec() int32 {
+	if x != nil {
+		return x.FastIoFailTimeoutSec
+	}
+	return 0
+}
+
+type InstanceIOTimeoutSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine          `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	Timeouts   *InstanceIOTimeouts `protobuf:"bytes,3,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+}
+
+func (x *InstanceIOTimeoutSetRequest) Reset() {
+	*x = InstanceIOTimeoutSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceIOTimeoutSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceIOTimeoutSetRequest) ProtoMessage() {}
+
+func (x *InstanceIOTimeoutSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceIOTimeoutSetRequest.ProtoReflect.Descriptor instead.
+func (*InstanceIOTimeoutSetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InstanceIOTimeoutSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceIOTimeoutSetRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceIOTimeoutSetRequest) GetTimeouts() *InstanceIOTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+type InstanceIOTimeoutGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+}
+
+func (x *InstanceIOTimeoutGetRequest) Reset() {
+	*x = InstanceIOTimeoutGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceIOTimeoutGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceIOTimeoutGetRequest) ProtoMessage() {}
+
+func (x *InstanceIOTimeoutGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceIOTimeoutGetRequest.ProtoReflect.Descriptor instead.
+func (*InstanceIOTimeoutGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InstanceIOTimeoutGetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceIOTimeoutGetRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+type InstanceIOTimeoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The timeouts set by InstanceIOTimeoutSet, which are set again once the frontend reconnects
+	Configured *InstanceIOTimeouts `protobuf:"bytes,2,opt,name=configured,proto3" json:"configured,omitempty"`
+	// The timeouts in effect, read from the controllers. Empty if the frontend is not connected
+	Current     *InstanceIOTimeouts `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	Controllers []string            `protobuf:"bytes,4,rep,name=controllers,proto3" json:"controllers,omitempty"`
+}
+
+func (x *InstanceIOTimeoutResponse) Reset() {
+	*x = InstanceIOTimeoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceIOTimeoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceIOTimeoutResponse) ProtoMessage() {}
+
+func (x *InstanceIOTimeoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceIOTimeoutResponse.ProtoReflect.Descriptor instead.
+func (*InstanceIOTimeoutResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InstanceIOTimeoutResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceIOTimeoutResponse) GetConfigured() *InstanceIOTimeouts {
+	if x != nil {
+		return x.Configured
+	}
+	return nil
+}
+
+func (x *InstanceIOTimeoutResponse) GetCurrent() *InstanceIOTimeouts {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *InstanceIOTimeoutResponse) GetControllers() []string {
+	if x != nil {
+		return x.Controllers
+	}
+	return nil
+}
+
+type InstanceReadPreferenceSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// The replica the engine prefers to read from. The preference is cleared if empty
+	ReplicaName string `protobuf:"bytes,3,opt,name=replica_name,json=replicaName,proto3" json:"replica_name,omitempty"`
+}
+
+func (x *InstanceReadPreferenceSetRequest) Reset() {
+	*x = InstanceReadPreferenceSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceReadPreferenceSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceReadPreferenceSetRequest) ProtoMessage() {}
+
+func (x *InstanceReadPreferenceSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceReadPreferenceSetRequest.ProtoReflect.Descriptor instead.
+func (*InstanceReadPreferenceSetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InstanceReadPreferenceSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceReadPreferenceSetRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *InstanceReadPreferenceSetRequest) GetReplicaName() string {
+	if x != nil {
+		return x.ReplicaName
+	}
+	return ""
+}
+
+type NetworkPathValidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The replica or engine addresses in the format of <host>:<port>
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The MTU expected on the paths. The MTU of the local interface of each path is used if 0
+	Mtu int32 `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// Discover the NVMe-oF subsystem at each address, e.g. of a v2 replica
+	NvmfDiscovery bool `protobuf:"varint,3,opt,name=nvmf_discovery,json=nvmfDiscovery,proto3" json:"nvmf_discovery,omitempty"`
+}
+
+func (x *NetworkPathValidateRequest) Reset() {
+	*x = NetworkPathValidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkPathValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPathValidateRequest) ProtoMessage() {}
+
+func (x *NetworkPathValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPathValidateRequest.ProtoReflect.Descriptor instead.
+func (*NetworkPathValidateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NetworkPathValidateRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *NetworkPathValidateRequest) GetMtu() int32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *NetworkPathValidateRequest) GetNvmfDiscovery() bool {
+	if x != nil {
+		return x.NvmfDiscovery
+	}
+	return false
+}
+
+type NetworkPathResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address        string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LocalInterface string `protobuf:"bytes,2,opt,name=local_interface,json=localInterface,proto3" json:"local_interface,omitempty"`
+	LocalMtu       int32  `protobuf:"varint,3,opt,name=local_mtu,json=localMtu,proto3" json:"local_mtu,omitempty"`
+	// The MTU validated on the path
+	Mtu int32 `protobuf:"varint,4,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// Whether a small ICMP echo is answered
+	Reachable bool `protobuf:"varint,5,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// Whether an ICMP echo of the full MTU with the fragmentation prohibited is answered
+	MtuValid         bool     `protobuf:"varint,6,opt,name=mtu_valid,json=mtuValid,proto3" json:"mtu_valid,omitempty"`
+	RttNs            int64    `protobuf:"varint,7,opt,name=rtt_ns,json=rttNs,proto3" json:"rtt_ns,omitempty"`
+	TcpConnected     bool     `protobuf:"varint,8,opt,name=tcp_connected,json=tcpConnected,proto3" json:"tcp_connected,omitempty"`
+	TcpConnectNs     int64    `protobuf:"varint,9,opt,name=tcp_connect_ns,json=tcpConnectNs,proto3" json:"tcp_connect_ns,omitempty"`
+	NvmfSubsystemNqn string   `protobuf:"bytes,10,opt,name=nvmf_subsystem_nqn,json=nvmfSubsystemNqn,proto3" json:"nvmf_subsystem_nqn,omitempty"`
+	Errors           []string `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *NetworkPathResult) Reset() {
+	*x = NetworkPathResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPathResult) ProtoMessage() {}
+
+func (x *NetworkPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPathResult.ProtoReflect.Descriptor instead.
+func (*NetworkPathResult) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NetworkPathResult) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NetworkPathResult) GetLocalInterface() string {
+	if x != nil {
+		return x.LocalInterface
+	}
+	return ""
+}
+
+func (x *NetworkPathResult) GetLocalMtu() int32 {
+	if x != nil {
+		return x.LocalMtu
+	}
+	return 0
+}
+
+func (x *NetworkPathResult) GetMtu() int32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *NetworkPathResult) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *NetworkPathResult) GetMtuValid() bool {
+	if x != nil {
+		return x.MtuValid
+	}
+	return false
+}
+
+func (x *NetworkPathResult) GetRttNs() int64 {
+	if x != nil {
+		return x.RttNs
+	}
+	return 0
+}
+
+func (x *NetworkPathResult) GetTcpConnected() bool {
+	if x != nil {
+		return x.TcpConnected
+	}
+	return false
+}
+
+func (x *NetworkPathResult) GetTcpConnectNs() int64 {
+	if x != nil {
+		return x.TcpConnectNs
+	}
+	return 0
+}
+
+func (x *NetworkPathResult) GetNvmfSubsystemNqn() string {
+	if x != nil {
+		return x.NvmfSubsystemNqn
+	}
+	return ""
+}
+
+func (x *NetworkPathResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type NetworkPathValidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*NetworkPathResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *NetworkPathValidateResponse) Reset() {
+	*x = NetworkPathValidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkPathValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPathValidateResponse) ProtoMessage() {}
+
+func (x *NetworkPathValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPathValidateResponse.ProtoReflect.Descriptor instead.
+func (*NetworkPathValidateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NetworkPathValidateResponse) GetResults() []*NetworkPathResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type EngineMigration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeName       string     `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	SourceDataEngine DataEngine `protobuf:"varint,2,opt,name=source_data_engine,json=sourceDataEngine,proto3,enum=imrpc.DataEngine" json:"source_data_engine,omitempty"`
+	TargetDataEngine DataEngine `protobuf:"varint,3,opt,name=target_data_engine,json=targetDataEngine,proto3,enum=imrpc.DataEngine" json:"target_data_engine,omitempty"`
+	// One of pending, copying, validating, completed and failed
+	Phase       string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	BytesCopied uint64 `protobuf:"varint,5,opt,name=bytes_copied,json=bytesCopied,proto3" json:"bytes_copied,omitempty"`
+	BytesTotal  uint64 `protobuf:"varint,6,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	// Empty until the migrated data is validated, then passed or failed
+	ValidationResult  string `protobuf:"bytes,7,opt,name=validation_result,json=validationResult,proto3" json:"validation_result,omitempty"`
+	ValidationMessage string `protobuf:"bytes,8,opt,name=validation_message,json=validationMessage,proto3" json:"validation_message,omitempty"`
+	ErrorMsg          string `protobuf:"bytes,9,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	// RFC 3339 timestamps
+	CreatedAt string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *EngineMigration) Reset() {
+	*x = EngineMigration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineMigration) ProtoMessage() {}
+
+func (x *EngineMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineMigration.ProtoReflect.Descriptor instead.
+func (*EngineMigration) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EngineMigration) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *EngineMigration) GetSourceDataEngine() DataEngine {
+	if x != nil {
+		return x.SourceDataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *EngineMigration) GetTargetDataEngine() DataEngine {
+	if x != nil {
+		return x.TargetDataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *EngineMigration) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *EngineMigration) GetBytesCopied() uint64 {
+	if x != nil {
+		return x.BytesCopied
+	}
+	return 0
+}
+
+func (x *EngineMigration) GetBytesTotal() uint64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *EngineMigration) GetValidationResult() string {
+	if x != nil {
+		return x.ValidationResult
+	}
+	return ""
+}
+
+func (x *EngineMigration) GetValidationMessage() string {
+	if x != nil {
+		return x.ValidationMessage
+	}
+	return ""
+}
+
+func (x *EngineMigration) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *EngineMigration) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EngineMigration) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type EngineMigrationRegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeName       string     `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	SourceDataEngine DataEngine `protobuf:"varint,2,opt,name=source_data_engine,json=sourceDataEngine,proto3,enum=imrpc.DataEngine" json:"source_data_engine,omitempty"`
+	TargetDataEngine DataEngine `protobuf:"varint,3,opt,name=target_data_engine,json=targetDataEngine,proto3,enum=imrpc.DataEngine" json:"target_data_engine,omitempty"`
+	BytesTotal       uint64     `protobuf:"varint,4,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+}
+
+func (x *EngineMigrationRegisterRequest) Reset() {
+	*x = EngineMigrationRegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineMigrationRegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineMigrationRegisterRequest) ProtoMessage() {}
+
+func (x *EngineMigrationRegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineMigrationRegisterRequest.ProtoReflect.Descriptor instead.
+func (*EngineMigrationRegisterRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EngineMigrationRegisterRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *EngineMigrationRegisterRequest) GetSourceDataEngine() DataEngine {
+	if x != nil {
+		return x.SourceDataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *EngineMigrationRegisterRequest) GetTargetDataEngine() DataEngine {
+	if x != nil {
+		return x.TargetDataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *EngineMigrationRegisterRequest) GetBytesTotal() uint64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+type EngineMigrationUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeName string `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	// Empty to keep the phase
+	Phase       string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	BytesCopied uint64 `protobuf:"varint,3,opt,name=bytes_copied,json=bytesCopied,proto3" json:"bytes_copied,omitempty"`
+	// Zero to keep the total bytes
+	BytesTotal uint64 `protobuf:"varint,4,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	// Empty to keep the validation result
+	ValidationResult  string `protobuf:"bytes,5,opt,name=validation_result,json=validationResult,proto3" json:"validation_result,omitempty"`
+	ValidationMessage string `protobuf:"bytes,6,opt,name=validation_message,json=validationMessage,proto3" json:"validation_message,omitempty"`
+	ErrorMsg          string `protobuf:"bytes,7,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *EngineMigrationUpdateRequest) Reset() {
+	*x = EngineMigrationUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineMigrationUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineMigrationUpdateRequest) ProtoMessage() {}
+
+func (x *EngineMigrationUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineMigrationUpdateRequest.ProtoReflect.Descriptor instead.
+func (*EngineMigrationUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EngineMigrationUpdateRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *EngineMigrationUpdateRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *EngineMigrationUpdateRequest) GetBytesCopied() uint64 {
+	if x != nil {
+		return x.BytesCopied
+	}
+	return 0
+}
+
+func (x *EngineMigrationUpdateRequest) GetBytesTotal() uint64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *EngineMigrationUpdateRequest) GetValidationResult() string {
+	if x != nil {
+		return x.ValidationResult
+	}
+	return ""
+}
+
+func (x *EngineMigrationUpdateRequest) GetValidationMessage() string {
+	if x != nil {
+		return x.ValidationMessage
+	}
+	return ""
+}
+
+func (x *EngineMigrationUpdateRequest) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type EngineMigrationGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeName string `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+}
+
+func (x *EngineMigrationGetRequest) Reset() {
+	*x = EngineMigrationGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineMigrationGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineMigrationGetRequest) ProtoMessage() {}
+
+func (x *EngineMigrationGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineMigrationGetRequest.ProtoReflect.Descriptor instead.
+func (*EngineMigrationGetRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *EngineMigrationGetRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+type EngineMigrationDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeName string `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+}
+
+func (x *EngineMigrationDeleteRequest) Reset() {
+	*x = EngineMigrationDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineMigrationDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineMigrationDeleteRequest) ProtoMessage() {}
+
+func (x *EngineMigrationDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineMigrationDeleteRequest.ProtoReflect.Descriptor instead.
+func (*EngineMigrationDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *EngineMigrationDeleteRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+type EngineMigrationListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Migrations map[string]*EngineMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EngineMigrationListResponse) Reset() {
+	*x = EngineMigrationListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineMigrationListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineMigrationListResponse) ProtoMessage() {}
+
+func (x *EngineMigrationListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineMigrationListResponse.ProtoReflect.Descriptor instead.
+func (*EngineMigrationListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *EngineMigrationListResponse) GetMigrations() map[string]*EngineMigration {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+// ReplicaSpare is an empty v2 replica pre-provisioned on a disk, which can be claimed by a rebuild instead of
+// creating a new replica
+type ReplicaSpare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiskName  string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid  string `protobuf:"bytes,3,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	Size      uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Ip        string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	PortStart int32  `protobuf:"varint,6,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd   int32  `protobuf:"varint,7,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt string `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ReplicaSpare) Reset() {
+	*x = ReplicaSpare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaSpare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaSpare) ProtoMessage() {}
+
+func (x *ReplicaSpare) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaSpare.ProtoReflect.Descriptor instead.
+func (*ReplicaSpare) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReplicaSpare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplicaSpare) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *ReplicaSpare) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *ReplicaSpare) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ReplicaSpare) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ReplicaSpare) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *ReplicaSpare) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *ReplicaSpare) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReplicaSpare) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReplicaSpareCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName  string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid  string `protobuf:"bytes,2,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	Size      uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	PortCount int32  `protobuf:"varint,4,opt,name=port_count,json=portCount,proto3" json:"port_count,omitempty"`
+	// The spare is deleted if not claimed within the TTL. The default TTL is used if it is 0
+	TtlSeconds int64 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+}
+
+func (x *ReplicaSpareCreateRequest) Reset() {
+	*x = ReplicaSpareCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaSpareCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaSpareCreateRequest) ProtoMessage() {}
+
+func (x *ReplicaSpareCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaSpareCreateRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaSpareCreateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReplicaSpareCreateRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *ReplicaSpareCreateRequest) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *ReplicaSpareCreateRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ReplicaSpareCreateRequest) GetPortCount() int32 {
+	if x != nil {
+		return x.PortCount
+	}
+	return 0
+}
+
+func (x *ReplicaSpareCreateRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReplicaSpareClaimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid string `protobuf:"bytes,2,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	Size     uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ReplicaSpareClaimRequest) Reset() {
+	*x = ReplicaSpareClaimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaSpareClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaSpareClaimRequest) ProtoMessage() {}
+
+func (x *ReplicaSpareClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaSpareClaimRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaSpareClaimRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReplicaSpareClaimRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *ReplicaSpareClaimRequest) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *ReplicaSpareClaimRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReplicaSpareListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spares map[string]*ReplicaSpare `protobuf:"bytes,1,rep,name=spares,proto3" json:"spares,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ReplicaSpareListResponse) Reset() {
+	*x = ReplicaSpareListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaSpareListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaSpareListResponse) ProtoMessage() {}
+
+func (x *ReplicaSpareListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaSpareListResponse.ProtoReflect.Descriptor instead.
+func (*ReplicaSpareListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReplicaSpareListResponse) GetSpares() map[string]*ReplicaSpare {
+	if x != nil {
+		return x.Spares
+	}
+	return nil
+}
+
+type ReplicaSpareDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ReplicaSpareDeleteRequest) Reset() {
+	*x = ReplicaSpareDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaSpareDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaSpareDeleteRequest) ProtoMessage() {}
+
+func (x *ReplicaSpareDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaSpareDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaSpareDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReplicaSpareDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ReplicaReadOnlyAttachment is a replica exposed read-only as a local block device, without attaching the volume or
+// joining the replica to an engine, for the data recovery and forensic tools
+type ReplicaReadOnlyAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	DiskName   string     `protobuf:"bytes,3,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Device     string     `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	CreatedAt  string     `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  string     `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ReplicaReadOnlyAttachment) Reset() {
+	*x = ReplicaReadOnlyAttachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaReadOnlyAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaReadOnlyAttachment) ProtoMessage() {}
+
+func (x *ReplicaReadOnlyAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaReadOnlyAttachment.ProtoReflect.Descriptor instead.
+func (*ReplicaReadOnlyAttachment) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReplicaReadOnlyAttachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplicaReadOnlyAttachment) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *ReplicaReadOnlyAttachment) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *ReplicaReadOnlyAttachment) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ReplicaReadOnlyAttachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReplicaReadOnlyAttachment) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReplicaReadOnlyAttachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,2,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	DiskName   string     `protobuf:"bytes,3,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	// The device is torn down after the TTL. The default TTL is used if it is 0
+	TtlSeconds int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+}
+
+func (x *ReplicaReadOnlyAttachRequest) Reset() {
+	*x = ReplicaReadOnlyAttachRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaReadOnlyAttachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaReadOnlyAttachRequest) ProtoMessage() {}
+
+func (x *ReplicaReadOnlyAttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaReadOnlyAttachRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaReadOnlyAttachRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReplicaReadOnlyAttachRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplicaReadOnlyAttachRequest) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *ReplicaReadOnlyAttachRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *ReplicaReadOnlyAttachRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReplicaReadOnlyAttachmentListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachments map[string]*ReplicaReadOnlyAttachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ReplicaReadOnlyAttachmentListResponse) Reset() {
+	*x = ReplicaReadOnlyAttachmentListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaReadOnlyAttachmentListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaReadOnlyAttachmentListResponse) ProtoMessage() {}
+
+func (x *ReplicaReadOnlyAttachmentListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaReadOnlyAttachmentListResponse.ProtoReflect.Descriptor instead.
+func (*ReplicaReadOnlyAttachmentListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReplicaReadOnlyAttachmentListResponse) GetAttachments() map[string]*ReplicaReadOnlyAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type ReplicaReadOnlyDetachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ReplicaReadOnlyDetachRequest) Reset() {
+	*x = ReplicaReadOnlyDetachRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaReadOnlyDetachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaReadOnlyDetachRequest) ProtoMessage() {}
+
+func (x *ReplicaReadOnlyDetachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaReadOnlyDetachRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaReadOnlyDetachRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReplicaReadOnlyDetachRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SpdkOrphanReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of adopt and cleanup
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Only list the orphans and the actions to take on them
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Delete the orphan lvols as well on cleanup, which loses their data. The orphan lvols are skipped otherwise
+	CleanupLvols bool `protobuf:"varint,3,opt,name=cleanup_lvols,json=cleanupLvols,proto3" json:"cleanup_lvols,omitempty"`
+	// The port count of the replicas adopted from the orphan lvols. 1 is used if it is 0
+	PortCount int32 `protobuf:"varint,4,opt,name=port_count,json=portCount,proto3" json:"port_count,omitempty"`
+}
+
+func (x *SpdkOrphanReconcileRequest) Reset() {
+	*x = SpdkOrphanReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkOrphanReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkOrphanReconcileRequest) ProtoMessage() {}
+
+func (x *SpdkOrphanReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkOrphanReconcileRequest.ProtoReflect.Descriptor instead.
+func (*SpdkOrphanReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SpdkOrphanReconcileRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SpdkOrphanReconcileRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SpdkOrphanReconcileRequest) GetCleanupLvols() bool {
+	if x != nil {
+		return x.CleanupLvols
+	}
+	return false
+}
+
+func (x *SpdkOrphanReconcileRequest) GetPortCount() int32 {
+	if x != nil {
+		return x.PortCount
+	}
+	return 0
+}
+
+// SpdkOrphanResource is an spdk_tgt resource named by the Longhorn naming conventions but owned by no engine or
+// replica of the SPDK service, e.g. left by a crash in the middle of an operation
+type SpdkOrphanResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of lvol, raid_bdev, nvme_controller and nvmf_subsystem
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The lvstore of an lvol
+	DiskName string `protobuf:"bytes,3,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	// One of adopt, cleanup and skip
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Why the resource is skipped, or how it is handled
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// The error of the action, which is empty on dry run
+	ErrorMsg string `protobuf:"bytes,6,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *SpdkOrphanResource) Reset() {
+	*x = SpdkOrphanResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkOrphanResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkOrphanResource) ProtoMessage() {}
+
+func (x *SpdkOrphanResource) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkOrphanResource.ProtoReflect.Descriptor instead.
+func (*SpdkOrphanResource) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SpdkOrphanResource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SpdkOrphanResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpdkOrphanResource) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *SpdkOrphanResource) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SpdkOrphanResource) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SpdkOrphanResource) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type SpdkOrphanReconcileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*SpdkOrphanResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *SpdkOrphanReconcileResponse) Reset() {
+	*x = SpdkOrphanReconcileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkOrphanReconcileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkOrphanReconcileResponse) ProtoMessage() {}
+
+func (x *SpdkOrphanReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkOrphanReconcileResponse.ProtoReflect.Descriptor instead.
+func (*SpdkOrphanReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SpdkOrphanReconcileResponse) GetResources() []*SpdkOrphanResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type StateExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disks and instances of the instance manager in JSON, in the format of NodeState of pkg/api
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// The version of the format of the state
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *StateExportResponse) Reset() {
+	*x = StateExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateExportResponse) ProtoMessage() {}
+
+func (x *StateExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateExportResponse.ProtoReflect.Descriptor instead.
+func (*StateExportResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StateExportResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StateExportResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type StateImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state exported by StateExport
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Only validate the state and list the results of importing it
+	ValidateOnly bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *StateImportRequest) Reset() {
+	*x = StateImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateImportRequest) ProtoMessage() {}
+
+func (x *StateImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateImportRequest.ProtoReflect.Descriptor instead.
+func (*StateImportRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StateImportRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StateImportRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type StateImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of disk and instance
+	Kind       string     `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DataEngine DataEngine `protobuf:"varint,3,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// One of created, exists, valid and failed
+	Result   string `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorMsg string `protobuf:"bytes,5,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *StateImportResult) Reset() {
+	*x = StateImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateImportResult) ProtoMessage() {}
+
+func (x *StateImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateImportResult.ProtoReflect.Descriptor instead.
+func (*StateImportResult) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StateImportResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StateImportResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StateImportResult) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *StateImportResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *StateImportResult) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type StateImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*StateImportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *StateImportResponse) Reset() {
+	*x = StateImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateImportResponse) ProtoMessage() {}
+
+func (x *StateImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateImportResponse.ProtoReflect.Descriptor instead.
+func (*StateImportResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StateImportResponse) GetResults() []*StateImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// InstanceServiceDrainRequest has the instance server reject the new creations, wait for the operations in flight and
+// the log streams to finish, and then have the instance manager exit, e.g. before a rolling upgrade. The watches are
+// not waited for, since they never finish by themselves.
+type InstanceServiceDrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time to wait for the operations and log streams to finish before exiting anyway. The default timeout is
+	// used if it is 0
+	TimeoutSeconds int64 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// Wait for the drain without exiting the instance manager afterwards. The new creations are still rejected
+	NoExit bool `protobuf:"varint,2,opt,name=no_exit,json=noExit,proto3" json:"no_exit,omitempty"`
+}
+
+func (x *InstanceServiceDrainRequest) Reset() {
+	*x = InstanceServiceDrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceServiceDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceServiceDrainRequest) ProtoMessage() {}
+
+func (x *InstanceServiceDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceServiceDrainRequest.ProtoReflect.Descriptor instead.
+func (*InstanceServiceDrainRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *InstanceServiceDrainRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *InstanceServiceDrainRequest) GetNoExit() bool {
+	if x != nil {
+		return x.NoExit
+	}
+	return false
+}
+
+type InstanceServiceDrainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether all the operations and log streams finished in time
+	Drained bool `protobuf:"varint,1,opt,name=drained,proto3" json:"drained,omitempty"`
+	// The operations and log streams still running once the drain ends
+	Operations int32 `protobuf:"varint,2,opt,name=operations,proto3" json:"operations,omitempty"`
+	LogStreams int32 `protobuf:"varint,3,opt,name=log_streams,json=logStreams,proto3" json:"log_streams,omitempty"`
+	// RFC 3339 timestamp
+	DrainingSince string `protobuf:"bytes,4,opt,name=draining_since,json=drainingSince,proto3" json:"draining_since,omitempty"`
+}
+
+func (x *InstanceServiceDrainResponse) Reset() {
+	*x = InstanceServiceDrainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceServiceDrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceServiceDrainResponse) ProtoMessage() {}
+
+func (x *InstanceServiceDrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceServiceDrainResponse.ProtoReflect.Descriptor instead.
+func (*InstanceServiceDrainResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *InstanceServiceDrainResponse) GetDrained() bool {
+	if x != nil {
+		return x.Drained
+	}
+	return false
+}
+
+func (x *InstanceServiceDrainResponse) GetOperations() int32 {
+	if x != nil {
+		return x.Operations
+	}
+	return 0
+}
+
+func (x *InstanceServiceDrainResponse) GetLogStreams() int32 {
+	if x != nil {
+		return x.LogStreams
+	}
+	return 0
+}
+
+func (x *InstanceServiceDrainResponse) GetDrainingSince() string {
+	if x != nil {
+		return x.DrainingSince
+	}
+	return ""
+}
+
+// PortRange is the ports from start to end, both included
+type PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *PortRange) Reset() {
+	*x = PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortRange) ProtoMessage() {}
+
+func (x *PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
+func (*PortRange) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PortRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *PortRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type InstancePortAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for the leaked ports of the v1 data engine, allocated without an owner
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PortStart int32  `protobuf:"varint,2,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"`
+	PortEnd   int32  `protobuf:"varint,3,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`
+	// process, replacement or empty for the leaked ports of the v1 data engine, and instance for the v2 one
+	OwnerType string `protobuf:"bytes,4,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`
+	// False if the ports of a v1 instance are not allocated in the allocator, so they may be handed out again
+	Allocated bool `protobuf:"varint,5,opt,name=allocated,proto3" json:"allocated,omitempty"`
+}
+
+func (x *InstancePortAllocation) Reset() {
+	*x = InstancePortAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstancePortAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstancePortAllocation) ProtoMessage() {}
+
+func (x *InstancePortAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstancePortAllocation.ProtoReflect.Descriptor instead.
+func (*InstancePortAllocation) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *InstancePortAllocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstancePortAllocation) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *InstancePortAllocation) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+func (x *InstancePortAllocation) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *InstancePortAllocation) GetAllocated() bool {
+	if x != nil {
+		return x.Allocated
+	}
+	return false
+}
+
+type DataEnginePorts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataEngine DataEngine `protobuf:"varint,1,opt,name=data_engine,json=dataEngine,proto3,enum=imrpc.DataEngine" json:"data_engine,omitempty"`
+	// The range the ports of the instances are allocated from, which is empty if unknown
+	Range *PortRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	// The allocations ordered by port
+	Allocations []*InstancePortAllocation `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	// The free ranges ordered by port. The ports spdk_tgt holds for itself, e.g. for a rebuild, are not known, so
+	// they are free here for the v2 data engine
+	Free      []*PortRange `protobuf:"bytes,4,rep,name=free,proto3" json:"free,omitempty"`
+	FreeCount int32        `protobuf:"varint,5,opt,name=free_count,json=freeCount,proto3" json:"free_count,omitempty"`
+}
+
+func (x *DataEnginePorts) Reset() {
+	*x = DataEnginePorts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataEnginePorts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataEnginePorts) ProtoMessage() {}
+
+func (x *DataEnginePorts) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataEnginePorts.ProtoReflect.Descriptor instead.
+func (*DataEnginePorts) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DataEnginePorts) GetDataEngine() DataEngine {
+	if x != nil {
+		return x.DataEngine
+	}
+	return DataEngine_DATA_ENGINE_V1
+}
+
+func (x *DataEnginePorts) GetRange() *PortRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *DataEnginePorts) GetAllocations() []*InstancePortAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *DataEnginePorts) GetFree() []*PortRange {
+	if x != nil {
+		return x.Free
+	}
+	return nil
+}
+
+func (x *DataEnginePorts) GetFreeCount() int32 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+type PortsGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataEngines []*DataEnginePorts `protobuf:"bytes,1,rep,name=data_engines,json=dataEngines,proto3" json:"data_engines,omitempty"`
+}
+
+func (x *PortsGetResponse) Reset() {
+	*x = PortsGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortsGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortsGetResponse) ProtoMessage() {}
+
+func (x *PortsGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortsGetResponse.ProtoReflect.Descriptor instead.
+func (*PortsGetResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PortsGetResponse) GetDataEngines() []*DataEnginePorts {
+	if x != nil {
+		return x.DataEngines
+	}
+	return nil
+}
+
+// InstanceConvertRequest converts a v1 replica to a v2 one. The data of the v1 replica, i.e. its volume head along
+// with the snapshots and the backing image under it, is copied into a new v2 replica as a whole, so the v2 replica has
+// no snapshots. The v1 replica must not be running, and is left as it is for the caller to delete once the volume
+// uses the v2 one.
+type InstanceConvertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The data directory of the v1 replica
+	SourcePath string `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	// The name of the v2 replica to create
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VolumeName string `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	DiskName   string `protobuf:"bytes,4,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	DiskUuid   string `protobuf:"bytes,5,opt,name=disk_uuid,json=diskUuid,proto3" json:"disk_uuid,omitempty"`
+	// The size of the v2 replica, which defaults to the size of the v1 replica and cannot be smaller
+	Size      uint64            `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	PortCount int32             `protobuf:"varint,7,opt,name=port_count,json=portCount,proto3" json:"port_count,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether the v2 replica is exposed for a remote engine
+	ExposeRequired bool `protobuf:"varint,9,opt,name=expose_required,json=exposeRequired,proto3" json:"expose_required,omitempty"`
+}
+
+func (x *InstanceConvertRequest) Reset() {
+	*x = InstanceConvertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceConvertRequest) ProtoMessage() {}
+
+func (x *InstanceConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceConvertRequest.ProtoReflect.Descriptor instead.
+func (*InstanceConvertRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *InstanceConvertRequest) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *InstanceConvertRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceConvertRequest) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *InstanceConvertRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *InstanceConvertRequest) GetDiskUuid() string {
+	if x != nil {
+		return x.DiskUuid
+	}
+	return ""
+}
+
+func (x *InstanceConvertRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InstanceConvertRequest) GetPortCount() int32 {
+	if x != nil {
+		return x.PortCount
+	}
+	return 0
+}
+
+func (x *InstanceConvertRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *InstanceConvertRequest) GetExposeRequired() bool {
+	if x != nil {
+		return x.ExposeRequired
+	}
+	return false
+}
+
+type InstanceConvertProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// creating, copying, complete or error
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// The bytes of the data of the v1 replica, without the holes
+	TotalBytes  int64  `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	CopiedBytes int64  `protobuf:"varint,4,opt,name=copied_bytes,json=copiedBytes,proto3" json:"copied_bytes,omitempty"`
+	Progress    int32  `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	ErrorMsg    string `protobuf:"bytes,6,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	// The v2 replica once complete
+	Instance *InstanceResponse `protobuf:"bytes,7,opt,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *InstanceConvertProgress) Reset() {
+	*x = InstanceConvertProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceConvertProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceConvertProgress) ProtoMessage() {}
+
+func (x *InstanceConvertProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceConvertProgress.ProtoReflect.Descriptor instead.
+func (*InstanceConvertProgress) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *InstanceConvertProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceConvertProgress) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *InstanceConvertProgress) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *InstanceConvertProgress) GetCopiedBytes() int64 {
+	if x != nil {
+		return x.CopiedBytes
+	}
+	return 0
+}
+
+func (x *InstanceConvertProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *InstanceConvertProgress) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *InstanceConvertProgress) GetInstance() *InstanceResponse {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+// StreamEvent is the lifetime of a stream of the gRPC servers of the instance manager, e.g. an instance watch or a
+// log stream.
+type StreamEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Method  string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// The address of the client
+	Peer      string `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`
+	StartTime string `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Empty while the stream is active
+	EndTime string `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The duration up to now while the stream is active
+	DurationMs int64 `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// completed, client_canceled, deadline_exceeded, server_stopped or error. Empty while the stream is active
+	Cause string `protobuf:"bytes,8,opt,name=cause,proto3" json:"cause,omitempty"`
+	// The gRPC status code the stream ended with
+	Code     string `protobuf:"bytes,9,opt,name=code,proto3" json:"code,omitempty"`
+	ErrorMsg string `protobuf:"bytes,10,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (x *StreamEvent) Reset() {
+	*x = StreamEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEvent) ProtoMessage() {}
+
+func (x *StreamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEvent.ProtoReflect.Descriptor instead.
+func (*StreamEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *StreamEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *StreamEvent) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StreamEvent) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+type StreamEventListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The active streams by start time
+	Active []*StreamEvent `protobuf:"bytes,1,rep,name=active,proto3" json:"active,omitempty"`
+	// The latest ended streams by end time
+	Ended []*StreamEvent `protobuf:"bytes,2,rep,name=ended,proto3" json:"ended,omitempty"`
+}
+
+func (x *StreamEventListResponse) Reset() {
+	*x = StreamEventListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventListResponse) ProtoMessage() {}
+
+func (x *StreamEventListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventListResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StreamEventListResponse) GetActive() []*StreamEvent {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *StreamEventListResponse) GetEnded() []*StreamEvent {
+	if x != nil {
+		return x.Ended
+	}
+	return nil
+}
+
+// DeferredTask is a pending cleanup of the instance manager, e.g. deleting an expired replica spare, which is kept
+// across restarts until it is done.
+type DeferredTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Args          map[string]string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt     string            `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextAttemptAt string            `protobuf:"bytes,5,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	Attempts      int32             `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string            `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *DeferredTask) Reset() {
+	*x = DeferredTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeferredTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferredTask) ProtoMessage() {}
+
+func (x *DeferredTask) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferredTask.ProtoReflect.Descriptor instead.
+func (*DeferredTask) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeferredTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeferredTask) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeferredTask) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *DeferredTask) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeferredTask) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *DeferredTask) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeferredTask) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type DeferredTaskListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks []*DeferredTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+}
+
+func (x *DeferredTaskListResponse) Reset() {
+	*x = DeferredTaskListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeferredTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferredTaskListResponse) ProtoMessage() {}
+
+func (x *DeferredTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferredTaskListResponse.ProtoReflect.Descriptor instead.
+func (*DeferredTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeferredTaskListResponse) GetTasks() []*DeferredTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+var File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto protoreflect.FileDescriptor
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDesc = []byte{
+	0x0a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e,
+	0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x44, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72,
+	0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e,
+	0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x03, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x04,
+	0x65, 0x6e, 0x76, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x70, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x70, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
+	0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75,
+	0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74,
+	0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x37, 0x0a, 0x09,
+	0x45, 0x6e, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x03, 0x0a, 0x10, 0x53, 0x70, 0x64, 0x6b, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x5e, 0x0a, 0x13, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x70, 0x64, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64,
+	0x6f, 0x70, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x1a, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x8f, 0x05, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x4f, 0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x70, 0x64, 0x6b, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x10, 0x73, 0x70, 0x64,
+	0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x32, 0x0a,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x6f, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x4d, 0x73, 0x22, 0xc9, 0x04, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x6e, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29,
+	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x40, 0x0a,
+	0x0d, 0x6e, 0x76, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x0c, 0x6e, 0x76, 0x6d, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86,
+	0x02, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x71, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x71, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x67, 0x75, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4e, 0x67, 0x75, 0x69, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x15,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xbe, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73,
+	0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x78, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x78, 0x0a, 0x1a, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x73, 0x67, 0x22, 0x4d, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0xef, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x74, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0x74, 0x0a, 0x16, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22,
+	0x73, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0x86,
+	0x01, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22, 0xe9, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x38,
+	0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x22, 0xb3, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x16,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xc5, 0x02, 0x0a, 0x14, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x55, 0x0a, 0x0e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x60, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x55, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0xa2, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x10, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x11,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6c, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x2a, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x53, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xad, 0x01,
+	0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x55, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd9, 0x01,
+	0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x69, 0x0a, 0x0c, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6d, 0x69, 0x6e, 0x4e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x76, 0x67, 0x5f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x76, 0x67, 0x4e, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d,
+	0x61, 0x78, 0x4e, 0x73, 0x22, 0xe6, 0x03, 0x0a, 0x1c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a,
+	0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x57, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x68, 0x6f, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x48, 0x6f, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x67, 0x0a, 0x12, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x48, 0x6f, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x48, 0x6f, 0x70, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x1a, 0x53, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x48, 0x6f,
+	0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x48, 0x6f, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01,
+	0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6f, 0x5f, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6f, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xd3, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6f, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
+	0x6f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x74,
+	0x72, 0x6c, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x74, 0x72, 0x6c, 0x4c,
+	0x6f, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x12, 0x36, 0x0a,
+	0x18, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x14, 0x66, 0x61, 0x73, 0x74, 0x49, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x22, 0x9c, 0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52,
+	0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x19,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x22,
+	0x8d, 0x01, 0x0a, 0x20, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x73, 0x0a, 0x1a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x74, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x76, 0x6d, 0x66, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x76, 0x6d, 0x66, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x22, 0xe8, 0x02, 0x0a, 0x11, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x74, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x74, 0x75, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74,
+	0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x74,
+	0x75, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d,
+	0x74, 0x75, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x74, 0x74, 0x4e, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x5f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x63, 0x70,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x76, 0x6d,
+	0x66, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x71, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x76, 0x6d, 0x66, 0x53, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x71, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x51, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0xc5, 0x03, 0x0a, 0x0f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x70, 0x69,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x1e, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x10, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12,
+	0x3f, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x10,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x92, 0x02, 0x0a, 0x1c, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a,
+	0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x3c, 0x0a, 0x19, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x1c, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x1b, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x55, 0x0a, 0x0f, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x19,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61, 0x72, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73,
+	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x68, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0xaf, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x06, 0x73, 0x70, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x70, 0x61, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x70, 0x61,
+	0x72, 0x65, 0x73, 0x1a, 0x4e, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x53, 0x70, 0x61, 0x72, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70,
+	0x61, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xa4, 0x01,
+	0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c,
+	0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+	0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a,
+	0x60, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x32, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x53, 0x70, 0x64, 0x6b, 0x4f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x5f, 0x6c, 0x76, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x4c, 0x76, 0x6f, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x53, 0x70,
+	0x64, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x73, 0x67, 0x22, 0x56, 0x0a, 0x1b, 0x53, 0x70, 0x64, 0x6b, 0x4f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x70, 0x64, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x13,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xa4, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x49, 0x0a, 0x13, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6e, 0x6f, 0x45, 0x78, 0x69, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x1c, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x61, 0x69, 0x6e,
+	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
+	0xa3, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x72, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x10, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x16, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xf5, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f,
+	0x70, 0x69, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22,
+	0x6f, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x22, 0xa0, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x37, 0x0a, 0x09, 0x41, 0x72,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x45, 0x0a, 0x18, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x32, 0xad, 0x1d, 0x0a, 0x0f, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49,
+	0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x47, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x12, 0x1d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x12, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x42, 0x0a, 0x09, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x17,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x5c, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x68, 0x61, 0x77, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x1d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x26, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f,
+	0x62, 0x65, 0x12, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x47, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x4f, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x12, 0x1d, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x17, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x25, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x15, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x12, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x20,
+	0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x13, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x15, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x70, 0x61, 0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61,
+	0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
+	0x70, 0x61, 0x72, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x53, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61, 0x72, 0x65,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69,
+	0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61, 0x72,
+	0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
+	0x70, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61,
+	0x72, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x70, 0x61, 0x72, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x23, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x2c, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x23, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x53, 0x70, 0x64, 0x6b, 0x4f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x4f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x22, 0x2e, 0x69, 0x6d,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x47,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x69, 0x6d, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1e, 0x2e, 0x69, 0x6d, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72,
+	0x6e, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x68, 0x6f, 0x72, 0x6e, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x69, 0x6d, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescOnce sync.Once
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData = file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDesc
+)
+
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescGZIP() []byte {
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescOnce.Do(func() {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData)
+	})
+	return file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDescData
+}
+
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_goTypes = []interface{}{
+	(*ProcessInstanceSpec)(nil),                   // 0: imrpc.ProcessInstanceSpec
+	(*SpdkInstanceSpec)(nil),                      // 1: imrpc.SpdkInstanceSpec
+	(*InstanceSpec)(nil),                          // 2: imrpc.InstanceSpec
+	(*InstanceRestartPolicy)(nil),                 // 3: imrpc.InstanceRestartPolicy
+	(*InstanceStatus)(nil),                        // 4: imrpc.InstanceStatus
+	(*InstanceNvmeIdentity)(nil),                  // 5: imrpc.InstanceNvmeIdentity
+	(*InstancePort)(nil),                          // 6: imrpc.InstancePort
+	(*InstanceReadPath)(nil),                      // 7: imrpc.InstanceReadPath
+	(*InstanceCreateRequest)(nil),                 // 8: imrpc.InstanceCreateRequest
+	(*InstanceDeleteRequest)(nil),                 // 9: imrpc.InstanceDeleteRequest
+	(*InstanceBatchCreateRequest)(nil),            // 10: imrpc.InstanceBatchCreateRequest
+	(*InstanceBatchDeleteRequest)(nil),            // 11: imrpc.InstanceBatchDeleteRequest
+	(*InstanceBatchResult)(nil),                   // 12: imrpc.InstanceBatchResult
+	(*InstanceBatchResponse)(nil),                 // 13: imrpc.InstanceBatchResponse
+	(*InstanceGetRequest)(nil),                    // 14: imrpc.InstanceGetRequest
+	(*InstanceRefreshRequest)(nil),                // 15: imrpc.InstanceRefreshRequest
+	(*InstanceSuspendRequest)(nil),                // 16: imrpc.InstanceSuspendRequest
+	(*InstanceResumeRequest)(nil),                 // 17: imrpc.InstanceResumeRequest
+	(*InstanceSwitchOverTargetRequest)(nil),       // 18: imrpc.InstanceSwitchOverTargetRequest
+	(*InstanceDeleteTargetRequest)(nil),           // 19: imrpc.InstanceDeleteTargetRequest
+	(*InstanceOperation)(nil),                     // 20: imrpc.InstanceOperation
+	(*InstanceResponse)(nil),                      // 21: imrpc.InstanceResponse
+	(*InstanceListRequest)(nil),                   // 22: imrpc.InstanceListRequest
+	(*InstanceListResponse)(nil),                  // 23: imrpc.InstanceListResponse
+	(*InstanceWatchRequest)(nil),                  // 24: imrpc.InstanceWatchRequest
+	(*InstanceWatchEvent)(nil),                    // 25: imrpc.InstanceWatchEvent
+	(*InstanceWatchFreezeRequest)(nil),            // 26: imrpc.InstanceWatchFreezeRequest
+	(*InstanceWatchFreezeStatus)(nil),             // 27: imrpc.InstanceWatchFreezeStatus
+	(*InstanceLogRequest)(nil),                    // 28: imrpc.InstanceLogRequest
+	(*VolumeLogRequest)(nil),                      // 29: imrpc.VolumeLogRequest
+	(*VolumeLogResponse)(nil),                     // 30: imrpc.VolumeLogResponse
+	(*InstanceReplaceRequest)(nil),                // 31: imrpc.InstanceReplaceRequest
+	(*InstanceStatsRequest)(nil),                  // 32: imrpc.InstanceStatsRequest
+	(*InstanceNetworkStats)(nil),                  // 33: imrpc.InstanceNetworkStats
+	(*InstanceStatsResponse)(nil),                 // 34: imrpc.InstanceStatsResponse
+	(*InstanceLatencyProbeRequest)(nil),           // 35: imrpc.InstanceLatencyProbeRequest
+	(*LatencyStats)(nil),                          // 36: imrpc.LatencyStats
+	(*InstanceLatencyProbeResponse)(nil),          // 37: imrpc.InstanceLatencyProbeResponse
+	(*InstanceHealthCheckRequest)(nil),            // 38: imrpc.InstanceHealthCheckRequest
+	(*InstanceHealthCheckResponse)(nil),           // 39: imrpc.InstanceHealthCheckResponse
+	(*InstanceIOTimeouts)(nil),                    // 40: imrpc.InstanceIOTimeouts
+	(*InstanceIOTimeoutSetRequest)(nil),           // 41: imrpc.InstanceIOTimeoutSetRequest
+	(*InstanceIOTimeoutGetRequest)(nil),           // 42: imrpc.InstanceIOTimeoutGetRequest
+	(*InstanceIOTimeoutResponse)(nil),             // 43: imrpc.InstanceIOTimeoutResponse
+	(*InstanceReadPreferenceSetRequest)(nil),      // 44: imrpc.InstanceReadPreferenceSetRequest
+	(*NetworkPathValidateRequest)(nil),            // 45: imrpc.NetworkPathValidateRequest
+	(*NetworkPathResult)(nil),                     // 46: imrpc.NetworkPathResult
+	(*NetworkPathValidateResponse)(nil),           // 47: imrpc.NetworkPathValidateResponse
+	(*EngineMigration)(nil),                       // 48: imrpc.EngineMigration
+	(*EngineMigrationRegisterRequest)(nil),        // 49: imrpc.EngineMigrationRegisterRequest
+	(*EngineMigrationUpdateRequest)(nil),          // 50: imrpc.EngineMigrationUpdateRequest
+	(*EngineMigrationGetRequest)(nil),             // 51: imrpc.EngineMigrationGetRequest
+	(*EngineMigrationDeleteRequest)(nil),          // 52: imrpc.EngineMigrationDeleteRequest
+	(*EngineMigrationListResponse)(nil),           // 53: imrpc.EngineMigrationListResponse
+	(*ReplicaSpare)(nil),                          // 54: imrpc.ReplicaSpare
+	(*ReplicaSpareCreateRequest)(nil),             // 55: imrpc.ReplicaSpareCreateRequest
+	(*ReplicaSpareClaimRequest)(nil),              // 56: imrpc.ReplicaSpareClaimRequest
+	(*ReplicaSpareListResponse)(nil),              // 57: imrpc.ReplicaSpareListResponse
+	(*ReplicaSpareDeleteRequest)(nil),             // 58: imrpc.ReplicaSpareDeleteRequest
+	(*ReplicaReadOnlyAttachment)(nil),             // 59: imrpc.ReplicaReadOnlyAttachment
+	(*ReplicaReadOnlyAttachRequest)(nil),          // 60: imrpc.ReplicaReadOnlyAttachRequest
+	(*ReplicaReadOnlyAttachmentListResponse)(nil), // 61: imrpc.ReplicaReadOnlyAttachmentListResponse
+	(*ReplicaReadOnlyDetachRequest)(nil),          // 62: imrpc.ReplicaReadOnlyDetachRequest
+	(*SpdkOrphanReconcileRequest)(nil),            // 63: imrpc.SpdkOrphanReconcileRequest
+	(*SpdkOrphanResource)(nil),                    // 64: imrpc.SpdkOrphanResource
+	(*SpdkOrphanReconcileResponse)(nil),           // 65: imrpc.SpdkOrphanReconcileResponse
+	(*StateExportResponse)(nil),                   // 66: imrpc.StateExportResponse
+	(*StateImportRequest)(nil),                    // 67: imrpc.StateImportRequest
+	(*StateImportResult)(nil),                     // 68: imrpc.StateImportResult
+	(*StateImportResponse)(nil),                   // 69: imrpc.StateImportResponse
+	(*InstanceServiceDrainRequest)(nil),           // 70: imrpc.InstanceServiceDrainRequest
+	(*InstanceServiceDrainResponse)(nil),          // 71: imrpc.InstanceServiceDrainResponse
+	(*PortRange)(nil),                             // 72: imrpc.PortRange
+	(*InstancePortAllocation)(nil),                // 73: imrpc.InstancePortAllocation
+	(*DataEnginePorts)(nil),                       // 74: imrpc.DataEnginePorts
+	(*PortsGetResponse)(nil),                      // 75: imrpc.PortsGetResponse
+	(*InstanceConvertRequest)(nil),                // 76: imrpc.InstanceConvertRequest
+	(*InstanceConvertProgress)(nil),               // 77: imrpc.InstanceConvertProgress
+	(*StreamEvent)(nil),                           // 78: imrpc.StreamEvent
+	(*StreamEventListResponse)(nil),               // 79: imrpc.StreamEventListResponse
+	(*DeferredTask)(nil),                          // 80: imrpc.DeferredTask
+	(*DeferredTaskListResponse)(nil),              // 81: imrpc.DeferredTaskListResponse
+	nil,                                           // 82: imrpc.ProcessInstanceSpec.EnvsEntry
+	nil,                                           // 83: imrpc.SpdkInstanceSpec.ReplicaAddressMapEntry
+	nil,                                           // 84: imrpc.InstanceSpec.LabelsEntry
+	nil,                                           // 85: imrpc.InstanceStatus.ConditionsEntry
+	nil,                                           // 86: imrpc.InstanceListResponse.InstancesEntry
+	nil,                                           // 87: imrpc.InstanceStatsResponse.StatsEntry
+	nil,                                           // 88: imrpc.InstanceLatencyProbeResponse.ReplicaHopsEntry
+	nil,                                           // 89: imrpc.InstanceLatencyProbeResponse.ReplicaHopErrorsEntry
+	nil,                                           // 90: imrpc.EngineMigrationListResponse.MigrationsEntry
+	nil,                                           // 91: imrpc.ReplicaSpareListResponse.SparesEntry
+	nil,                                           // 92: imrpc.ReplicaReadOnlyAttachmentListResponse.AttachmentsEntry
+	nil,                                           // 93: imrpc.InstanceConvertRequest.LabelsEntry
+	nil,                                           // 94: imrpc.DeferredTask.ArgsEntry
+	(*ProcessSidecarSpec)(nil),                    // 95: ProcessSidecarSpec
+	(*ProcessPrivileges)(nil),                     // 96: ProcessPrivileges
+	(BackendStoreDriver)(0),                       // 97: imrpc.BackendStoreDriver
+	(DataEngine)(0),                               // 98: imrpc.DataEngine
+	(*ProcessSidecarStatus)(nil),                  // 99: ProcessSidecarStatus
+	(*ResourceUsage)(nil),                         // 100: ResourceUsage
+	(*NodeTopology)(nil),                          // 101: NodeTopology
+	(*emptypb.Empty)(nil),                         // 102: google.protobuf.Empty
+	(*LogResponse)(nil),                           // 103: LogResponse
+	(*VersionResponse)(nil),                       // 104: VersionResponse
+}
+var file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_depIdxs = []int32{
+	95,  // 0: imrpc.ProcessInstanceSpec.sidecars:type_name -> ProcessSidecarSpec
+	82,  // 1: imrpc.ProcessInstanceSpec.envs:type_name -> imrpc.ProcessInstanceSpec.EnvsEntry
+	96,  // 2: imrpc.ProcessInstanceSpec.privileges:type_name -> ProcessPrivileges
+	83,  // 3: imrpc.SpdkInstanceSpec.replica_address_map:type_name -> imrpc.SpdkInstanceSpec.ReplicaAddressMapEntry
+	97,  // 4: imrpc.InstanceSpec.backend_store_driver:type_name -> imrpc.BackendStoreDriver
+	0,   // 5: imrpc.InstanceSpec.process_instance_spec:type_name -> imrpc.ProcessInstanceSpec
+	1,   // 6: imrpc.InstanceSpec.spdk_instance_spec:type_name -> imrpc.SpdkInstanceSpec
+	98,  // 7: imrpc.InstanceSpec.data_engine:type_name -> imrpc.DataEngine
+	84,  // 8: imrpc.InstanceSpec.labels:type_name -> imrpc.InstanceSpec.LabelsEntry
+	3,   // 9: imrpc.InstanceSpec.restart_policy:type_name -> imrpc.InstanceRestartPolicy
+	85,  // 10: imrpc.InstanceStatus.conditions:type_name -> imrpc.InstanceStatus.ConditionsEntry
+	99,  // 11: imrpc.InstanceStatus.sidecars:type_name -> ProcessSidecarStatus
+	100, // 12: imrpc.InstanceStatus.resource_usage:type_name -> ResourceUsage
+	7,   // 13: imrpc.InstanceStatus.read_path:type_name -> imrpc.InstanceReadPath
+	6,   // 14: imrpc.InstanceStatus.ports:type_name -> imrpc.InstancePort
+	5,   // 15: imrpc.InstanceStatus.nvme_identity:type_name -> imrpc.InstanceNvmeIdentity
+	2,   // 16: imrpc.InstanceCreateRequest.spec:type_name -> imrpc.InstanceSpec
+	97,  // 17: imrpc.InstanceDeleteRequest.backend_store_driver:type_name -> imrpc.BackendStoreDriver
+	98,  // 18: imrpc.InstanceDeleteRequest.data_engine:type_name -> imrpc.DataEngine
+	8,   // 19: imrpc.InstanceBatchCreateRequest.requests:type_name -> imrpc.InstanceCreateRequest
+	9,   // 20: imrpc.InstanceBatchDeleteRequest.requests:type_name -> imrpc.InstanceDeleteRequest
+	21,  // 21: imrpc.InstanceBatchResult.instance:type_name -> imrpc.InstanceResponse
+	12,  // 22: imrpc.InstanceBatchResponse.results:type_name -> imrpc.InstanceBatchResult
+	97,  // 23: imrpc.InstanceGetRequest.backend_store_driver:type_name -> imrpc.BackendStoreDriver
+	98,  // 24: imrpc.InstanceGetRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 25: imrpc.InstanceRefreshRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 26: imrpc.InstanceSuspendRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 27: imrpc.InstanceResumeRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 28: imrpc.InstanceSwitchOverTargetRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 29: imrpc.InstanceDeleteTargetRequest.data_engine:type_name -> imrpc.DataEngine
+	2,   // 30: imrpc.InstanceResponse.spec:type_name -> imrpc.InstanceSpec
+	4,   // 31: imrpc.InstanceResponse.status:type_name -> imrpc.InstanceStatus
+	20,  // 32: imrpc.InstanceResponse.operations:type_name -> imrpc.InstanceOperation
+	101, // 33: imrpc.InstanceResponse.topology:type_name -> NodeTopology
+	98,  // 34: imrpc.InstanceListRequest.data_engines:type_name -> imrpc.DataEngine
+	86,  // 35: imrpc.InstanceListResponse.instances:type_name -> imrpc.InstanceListResponse.InstancesEntry
+	98,  // 36: imrpc.InstanceWatchEvent.data_engine:type_name -> imrpc.DataEngine
+	97,  // 37: imrpc.InstanceLogRequest.backend_store_driver:type_name -> imrpc.BackendStoreDriver
+	98,  // 38: imrpc.InstanceLogRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 39: imrpc.VolumeLogResponse.data_engine:type_name -> imrpc.DataEngine
+	2,   // 40: imrpc.InstanceReplaceRequest.spec:type_name -> imrpc.InstanceSpec
+	87,  // 41: imrpc.InstanceStatsResponse.stats:type_name -> imrpc.InstanceStatsResponse.StatsEntry
+	98,  // 42: imrpc.InstanceLatencyProbeRequest.data_engine:type_name -> imrpc.DataEngine
+	36,  // 43: imrpc.InstanceLatencyProbeResponse.read:type_name -> imrpc.LatencyStats
+	36,  // 44: imrpc.InstanceLatencyProbeResponse.write:type_name -> imrpc.LatencyStats
+	88,  // 45: imrpc.InstanceLatencyProbeResponse.replica_hops:type_name -> imrpc.InstanceLatencyProbeResponse.ReplicaHopsEntry
+	89,  // 46: imrpc.InstanceLatencyProbeResponse.replica_hop_errors:type_name -> imrpc.InstanceLatencyProbeResponse.ReplicaHopErrorsEntry
+	98,  // 47: imrpc.InstanceHealthCheckRequest.data_engine:type_name -> imrpc.DataEngine
+	98,  // 48: imrpc.InstanceIOTimeoutSetRequest.data_engine:type_name -> imrpc.DataEngine
+	40,  // 49: imrpc.InstanceIOTimeoutSetRequest.timeouts:type_name -> imrpc.InstanceIOTimeouts
+	98,  // 50: imrpc.InstanceIOTimeoutGetRequest.data_engine:type_name -> imrpc.DataEngine
+	40,  // 51: imrpc.InstanceIOTimeoutResponse.configured:type_name -> imrpc.InstanceIOTimeouts
+	40,  // 52: imrpc.InstanceIOTimeoutResponse.current:type_name -> imrpc.InstanceIOTimeouts
+	98,  // 53: imrpc.InstanceReadPreferenceSetRequest.data_engine:type_name -> imrpc.DataEngine
+	46,  // 54: imrpc.NetworkPathValidateResponse.results:type_name -> imrpc.NetworkPathResult
+	98,  // 55: imrpc.EngineMigration.source_data_engine:type_name -> imrpc.DataEngine
+	98,  // 56: imrpc.EngineMigration.target_data_engine:type_name -> imrpc.DataEngine
+	98,  // 57: imrpc.EngineMigrationRegisterRequest.source_data_engine:type_name -> imrpc.DataEngine
+	98,  // 58: imrpc.EngineMigrationRegisterRequest.target_data_engine:type_name -> imrpc.DataEngine
+	90,  // 59: imrpc.EngineMigrationListResponse.migrations:type_name -> imrpc.EngineMigrationListResponse.MigrationsEntry
+	91,  // 60: imrpc.ReplicaSpareListResponse.spares:type_name -> imrpc.ReplicaSpareListResponse.SparesEntry
+	98,  // 61: imrpc.ReplicaReadOnlyAttachment.data_engine:type_name -> imrpc.DataEngine
+	98,  // 62: imrpc.ReplicaReadOnlyAttachRequest.data_engine:type_name -> imrpc.DataEngine
+	92,  // 63: imrpc.ReplicaReadOnlyAttachmentListResponse.attachments:type_name -> imrpc.ReplicaReadOnlyAttachmentListResponse.AttachmentsEntry
+	64,  // 64: imrpc.SpdkOrphanReconcileResponse.resources:type_name -> imrpc.SpdkOrphanResource
+	98,  // 65: imrpc.StateImportResult.data_engine:type_name -> imrpc.DataEngine
+	68,  // 66: imrpc.StateImportResponse.results:type_name -> imrpc.StateImportResult
+	98,  // 67: imrpc.DataEnginePorts.data_engine:type_name -> imrpc.DataEngine
+	72,  // 68: imrpc.DataEnginePorts.range:type_name -> imrpc.PortRange
+	73,  // 69: imrpc.DataEnginePorts.allocations:type_name -> imrpc.InstancePortAllocation
+	72,  // 70: imrpc.DataEnginePorts.free:type_name -> imrpc.PortRange
+	74,  // 71: imrpc.PortsGetResponse.data_engines:type_name -> imrpc.DataEnginePorts
+	93,  // 72: imrpc.InstanceConvertRequest.labels:type_name -> imrpc.InstanceConvertRequest.LabelsEntry
+	21,  // 73: imrpc.InstanceConvertProgress.instance:type_name -> imrpc.InstanceResponse
+	78,  // 74: imrpc.StreamEventListResponse.active:type_name -> imrpc.StreamEvent
+	78,  // 75: imrpc.StreamEventListResponse.ended:type_name -> imrpc.StreamEvent
+	94,  // 76: imrpc.DeferredTask.args:type_name -> imrpc.DeferredTask.ArgsEntry
+	80,  // 77: imrpc.DeferredTaskListResponse.tasks:type_name -> imrpc.DeferredTask
+	21,  // 78: imrpc.InstanceListResponse.InstancesEntry.value:type_name -> imrpc.InstanceResponse
+	33,  // 79: imrpc.InstanceStatsResponse.StatsEntry.value:type_name -> imrpc.InstanceNetworkStats
+	36,  // 80: imrpc.InstanceLatencyProbeResponse.ReplicaHopsEntry.value:type_name -> imrpc.LatencyStats
+	48,  // 81: imrpc.EngineMigrationListResponse.MigrationsEntry.value:type_name -> imrpc.EngineMigration
+	54,  // 82: imrpc.ReplicaSpareListResponse.SparesEntry.value:type_name -> imrpc.ReplicaSpare
+	59,  // 83: imrpc.ReplicaReadOnlyAttachmentListResponse.AttachmentsEntry.value:type_name -> imrpc.ReplicaReadOnlyAttachment
+	8,   // 84: imrpc.InstanceService.InstanceCreate:input_type -> imrpc.InstanceCreateRequest
+	9,   // 85: imrpc.InstanceService.InstanceDelete:input_type -> imrpc.InstanceDeleteRequest
+	10,  // 86: imrpc.InstanceService.InstanceBatchCreate:input_type -> imrpc.InstanceBatchCreateRequest
+	11,  // 87: imrpc.InstanceService.InstanceBatchDelete:input_type -> imrpc.InstanceBatchDeleteRequest
+	14,  // 88: imrpc.InstanceService.InstanceGet:input_type -> imrpc.InstanceGetRequest
+	15,  // 89: imrpc.InstanceService.InstanceRefresh:input_type -> imrpc.InstanceRefreshRequest
+	22,  // 90: imrpc.InstanceService.InstanceList:input_type -> imrpc.InstanceListRequest
+	28,  // 91: imrpc.InstanceService.InstanceLog:input_type -> imrpc.InstanceLogRequest
+	29,  // 92: imrpc.InstanceService.VolumeLog:input_type -> imrpc.VolumeLogRequest
+	24,  // 93: imrpc.InstanceService.InstanceWatch:input_type -> imrpc.InstanceWatchRequest
+	26,  // 94: imrpc.InstanceService.InstanceWatchFreeze:input_type -> imrpc.InstanceWatchFreezeRequest
+	102, // 95: imrpc.InstanceService.InstanceWatchThaw:input_type -> google.protobuf.Empty
+	31,  // 96: imrpc.InstanceService.InstanceReplace:input_type -> imrpc.InstanceReplaceRequest
+	16,  // 97: imrpc.InstanceService.InstanceSuspend:input_type -> imrpc.InstanceSuspendRequest
+	17,  // 98: imrpc.InstanceService.InstanceResume:input_type -> imrpc.InstanceResumeRequest
+	18,  // 99: imrpc.InstanceService.InstanceSwitchOverTarget:input_type -> imrpc.InstanceSwitchOverTargetRequest
+	19,  // 100: imrpc.InstanceService.InstanceDeleteTarget:input_type -> imrpc.InstanceDeleteTargetRequest
+	32,  // 101: imrpc.InstanceService.InstanceStats:input_type -> imrpc.InstanceStatsRequest
+	35,  // 102: imrpc.InstanceService.InstanceLatencyProbe:input_type -> imrpc.InstanceLatencyProbeRequest
+	38,  // 103: imrpc.InstanceService.InstanceHealthCheck:input_type -> imrpc.InstanceHealthCheckRequest
+	45,  // 104: imrpc.InstanceService.NetworkPathValidate:input_type -> imrpc.NetworkPathValidateRequest
+	41,  // 105: imrpc.InstanceService.InstanceIOTimeoutSet:input_type -> imrpc.InstanceIOTimeoutSetRequest
+	42,  // 106: imrpc.InstanceService.InstanceIOTimeoutGet:input_type -> imrpc.InstanceIOTimeoutGetRequest
+	44,  // 107: imrpc.InstanceService.InstanceReadPreferenceSet:input_type -> imrpc.InstanceReadPreferenceSetRequest
+	76,  // 108: imrpc.InstanceService.InstanceConvert:input_type -> imrpc.InstanceConvertRequest
+	49,  // 109: imrpc.InstanceService.EngineMigrationRegister:input_type -> imrpc.EngineMigrationRegisterRequest
+	50,  // 110: imrpc.InstanceService.EngineMigrationUpdate:input_type -> imrpc.EngineMigrationUpdateRequest
+	51,  // 111: imrpc.InstanceService.EngineMigrationGet:input_type -> imrpc.EngineMigrationGetRequest
+	102, // 112: imrpc.InstanceService.EngineMigrationList:input_type -> google.protobuf.Empty
+	52,  // 113: imrpc.InstanceService.EngineMigrationDelete:input_type -> imrpc.EngineMigrationDeleteRequest
+	55,  // 114: imrpc.InstanceService.ReplicaSpareCreate:input_type -> imrpc.ReplicaSpareCreateRequest
+	56,  // 115: imrpc.InstanceService.ReplicaSpareClaim:input_type -> imrpc.ReplicaSpareClaimRequest
+	102, // 116: imrpc.InstanceService.ReplicaSpareList:input_type -> google.protobuf.Empty
+	58,  // 117: imrpc.InstanceService.ReplicaSpareDelete:input_type -> imrpc.ReplicaSpareDeleteRequest
+	60,  // 118: imrpc.InstanceService.ReplicaReadOnlyAttach:input_type -> imrpc.ReplicaReadOnlyAttachRequest
+	102, // 119: imrpc.InstanceService.ReplicaReadOnlyAttachmentList:input_type -> google.protobuf.Empty
+	62,  // 120: imrpc.InstanceService.ReplicaReadOnlyDetach:input_type -> imrpc.ReplicaReadOnlyDetachRequest
+	102, // 121: imrpc.InstanceService.DeferredTaskList:input_type -> google.protobuf.Empty
+	63,  // 122: imrpc.InstanceService.SpdkOrphanReconcile:input_type -> imrpc.SpdkOrphanReconcileRequest
+	102, // 123: imrpc.InstanceService.StateExport:input_type -> google.protobuf.Empty
+	67,  // 124: imrpc.InstanceService.StateImport:input_type -> imrpc.StateImportRequest
+	70,  // 125: imrpc.InstanceService.InstanceServiceDrain:input_type -> imrpc.InstanceServiceDrainRequest
+	102, // 126: imrpc.InstanceService.PortsGet:input_type -> google.protobuf.Empty
+	102, // 127: imrpc.InstanceService.StreamEventList:input_type -> google.protobuf.Empty
+	102, // 128: imrpc.InstanceService.VersionGet:input_type -> google.protobuf.Empty
+	21,  // 129: imrpc.InstanceService.InstanceCreate:output_type -> imrpc.InstanceResponse
+	21,  // 130: imrpc.InstanceService.InstanceDelete:output_type -> imrpc.InstanceResponse
+	13,  // 131: imrpc.InstanceService.InstanceBatchCreate:output_type -> imrpc.InstanceBatchResponse
+	13,  // 132: imrpc.InstanceService.InstanceBatchDelete:output_type -> imrpc.InstanceBatchResponse
+	21,  // 133: imrpc.InstanceService.InstanceGet:output_type -> imrpc.InstanceResponse
+	21,  // 134: imrpc.InstanceService.InstanceRefresh:output_type -> imrpc.InstanceResponse
+	23,  // 135: imrpc.InstanceService.InstanceList:output_type -> imrpc.InstanceListResponse
+	103, // 136: imrpc.InstanceService.InstanceLog:output_type -> LogResponse
+	30,  // 137: imrpc.InstanceService.VolumeLog:output_type -> imrpc.VolumeLogResponse
+	25,  // 138: imrpc.InstanceService.InstanceWatch:output_type -> imrpc.InstanceWatchEvent
+	27,  // 139: imrpc.InstanceService.InstanceWatchFreeze:output_type -> imrpc.InstanceWatchFreezeStatus
+	27,  // 140: imrpc.InstanceService.InstanceWatchThaw:output_type -> imrpc.InstanceWatchFreezeStatus
+	21,  // 141: imrpc.InstanceService.InstanceReplace:output_type -> imrpc.InstanceResponse
+	21,  // 142: imrpc.InstanceService.InstanceSuspend:output_type -> imrpc.InstanceResponse
+	21,  // 143: imrpc.InstanceService.InstanceResume:output_type -> imrpc.InstanceResponse
+	21,  // 144: imrpc.InstanceService.InstanceSwitchOverTarget:output_type -> imrpc.InstanceResponse
+	21,  // 145: imrpc.InstanceService.InstanceDeleteTarget:output_type -> imrpc.InstanceResponse
+	34,  // 146: imrpc.InstanceService.InstanceStats:output_type -> imrpc.InstanceStatsResponse
+	37,  // 147: imrpc.InstanceService.InstanceLatencyProbe:output_type -> imrpc.InstanceLatencyProbeResponse
+	39,  // 148: imrpc.InstanceService.InstanceHealthCheck:output_type -> imrpc.InstanceHealthCheckResponse
+	47,  // 149: imrpc.InstanceService.NetworkPathValidate:output_type -> imrpc.NetworkPathValidateResponse
+	43,  // 150: imrpc.InstanceService.InstanceIOTimeoutSet:output_type -> imrpc.InstanceIOTimeoutResponse
+	43,  // 151: imrpc.InstanceService.InstanceIOTimeoutGet:output_type -> imrpc.InstanceIOTimeoutResponse
+	21,  // 152: imrpc.InstanceService.InstanceReadPreferenceSet:output_type -> imrpc.InstanceResponse
+	77,  // 153: imrpc.InstanceService.InstanceConvert:output_type -> imrpc.InstanceConvertProgress
+	48,  // 154: imrpc.InstanceService.EngineMigrationRegister:output_type -> imrpc.EngineMigration
+	48,  // 155: imrpc.InstanceService.EngineMigrationUpdate:output_type -> imrpc.EngineMigration
+	48,  // 156: imrpc.InstanceService.EngineMigrationGet:output_type -> imrpc.EngineMigration
+	53,  // 157: imrpc.InstanceService.EngineMigrationList:output_type -> imrpc.EngineMigrationListResponse
+	102, // 158: imrpc.InstanceService.EngineMigrationDelete:output_type -> google.protobuf.Empty
+	54,  // 159: imrpc.InstanceService.ReplicaSpareCreate:output_type -> imrpc.ReplicaSpare
+	54,  // 160: imrpc.InstanceService.ReplicaSpareClaim:output_type -> imrpc.ReplicaSpare
+	57,  // 161: imrpc.InstanceService.ReplicaSpareList:output_type -> imrpc.ReplicaSpareListResponse
+	102, // 162: imrpc.InstanceService.ReplicaSpareDelete:output_type -> google.protobuf.Empty
+	59,  // 163: imrpc.InstanceService.ReplicaReadOnlyAttach:output_type -> imrpc.ReplicaReadOnlyAttachment
+	61,  // 164: imrpc.InstanceService.ReplicaReadOnlyAttachmentList:output_type -> imrpc.ReplicaReadOnlyAttachmentListResponse
+	102, // 165: imrpc.InstanceService.ReplicaReadOnlyDetach:output_type -> google.protobuf.Empty
+	81,  // 166: imrpc.InstanceService.DeferredTaskList:output_type -> imrpc.DeferredTaskListResponse
+	65,  // 167: imrpc.InstanceService.SpdkOrphanReconcile:output_type -> imrpc.SpdkOrphanReconcileResponse
+	66,  // 168: imrpc.InstanceService.StateExport:output_type -> imrpc.StateExportResponse
+	69,  // 169: imrpc.InstanceService.StateImport:output_type -> imrpc.StateImportResponse
+	71,  // 170: imrpc.InstanceService.InstanceServiceDrain:output_type -> imrpc.InstanceServiceDrainResponse
+	75,  // 171: imrpc.InstanceService.PortsGet:output_type -> imrpc.PortsGetResponse
+	79,  // 172: imrpc.InstanceService.StreamEventList:output_type -> imrpc.StreamEventListResponse
+	104, // 173: imrpc.InstanceService.VersionGet:output_type -> VersionResponse
+	129, // [129:174] is the sub-list for method output_type
+	84,  // [84:129] is the sub-list for method input_type
+	84,  // [84:84] is the sub-list for extension type_name
+	84,  // [84:84] is the sub-list for extension extendee
+	0,   // [0:84] is the sub-list for field type_name
+}
+
+func init() { file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_init() }
+func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_init() {
+	if File_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto != nil {
+		return
+	}
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_common_proto_init()
+	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_imrpc_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessInstanceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkInstanceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceRestartPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceNvmeIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstancePort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceReadPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceBatchCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceBatchDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceRefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceSuspendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceSwitchOverTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceDeleteTargetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceWatchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceWatchFreezeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceWatchFreezeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceReplaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceNetworkStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceLatencyProbeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatencyStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceLatencyProbeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceHealthCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceHealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceIOTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceIOTimeoutSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceIOTimeoutGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceIOTimeoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceReadPreferenceSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkPathValidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkPathResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkPathValidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineMigration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineMigrationRegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineMigrationUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineMigrationGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineMigrationDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineMigrationListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaSpare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaSpareCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaSpareClaimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaSpareListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaSpareDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaReadOnlyAttachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaReadOnlyAttachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaReadOnlyAttachmentListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicaReadOnlyDetachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkOrphanReconcileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkOrphanResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkOrphanReconcileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceServiceDrainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceServiceDrainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstancePortAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataEnginePorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortsGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceConvertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceConvertProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeferredTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeferredTaskListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   95,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_goTypes,
 		DependencyIndexes: file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_depIdxs,
 		MessageInfos:      file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_msgTypes,
 	}.Build()
@@ -1253,327 +8768,1563 @@ func file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto
 	file_github_com_longhorn_longhorn_instance_manager_pkg_imrpc_instance_proto_depIdxs = nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// InstanceServiceClient is the client API for InstanceService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type InstanceServiceClient interface {
-	InstanceCreate(ctx context.Context, in *InstanceCreateRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
-	InstanceDelete(ctx context.Context, in *InstanceDeleteRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
-	InstanceGet(ctx context.Context, in *InstanceGetRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
-	InstanceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstanceListResponse, error)
-	InstanceLog(ctx context.Context, in *InstanceLogRequest, opts ...grpc.CallOption) (InstanceService_InstanceLogClient, error)
-	InstanceWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (InstanceService_InstanceWatchClient, error)
-	InstanceReplace(ctx context.Context, in *InstanceReplaceRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
-	VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// InstanceServiceClient is the client API for InstanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type InstanceServiceClient interface {
+	InstanceCreate(ctx context.Context, in *InstanceCreateRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceDelete(ctx context.Context, in *InstanceDeleteRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceBatchCreate(ctx context.Context, in *InstanceBatchCreateRequest, opts ...grpc.CallOption) (*InstanceBatchResponse, error)
+	InstanceBatchDelete(ctx context.Context, in *InstanceBatchDeleteRequest, opts ...grpc.CallOption) (*InstanceBatchResponse, error)
+	InstanceGet(ctx context.Context, in *InstanceGetRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceRefresh(ctx context.Context, in *InstanceRefreshRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceList(ctx context.Context, in *InstanceListRequest, opts ...grpc.CallOption) (*InstanceListResponse, error)
+	InstanceLog(ctx context.Context, in *InstanceLogRequest, opts ...grpc.CallOption) (InstanceService_InstanceLogClient, error)
+	VolumeLog(ctx context.Context, in *VolumeLogRequest, opts ...grpc.CallOption) (InstanceService_VolumeLogClient, error)
+	InstanceWatch(ctx context.Context, in *InstanceWatchRequest, opts ...grpc.CallOption) (InstanceService_InstanceWatchClient, error)
+	InstanceWatchFreeze(ctx context.Context, in *InstanceWatchFreezeRequest, opts ...grpc.CallOption) (*InstanceWatchFreezeStatus, error)
+	InstanceWatchThaw(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstanceWatchFreezeStatus, error)
+	InstanceReplace(ctx context.Context, in *InstanceReplaceRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceSuspend(ctx context.Context, in *InstanceSuspendRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceResume(ctx context.Context, in *InstanceResumeRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceSwitchOverTarget(ctx context.Context, in *InstanceSwitchOverTargetRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceDeleteTarget(ctx context.Context, in *InstanceDeleteTargetRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceStats(ctx context.Context, in *InstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStatsResponse, error)
+	InstanceLatencyProbe(ctx context.Context, in *InstanceLatencyProbeRequest, opts ...grpc.CallOption) (*InstanceLatencyProbeResponse, error)
+	InstanceHealthCheck(ctx context.Context, in *InstanceHealthCheckRequest, opts ...grpc.CallOption) (*InstanceHealthCheckResponse, error)
+	NetworkPathValidate(ctx context.Context, in *NetworkPathValidateRequest, opts ...grpc.CallOption) (*NetworkPathValidateResponse, error)
+	InstanceIOTimeoutSet(ctx context.Context, in *InstanceIOTimeoutSetRequest, opts ...grpc.CallOption) (*InstanceIOTimeoutResponse, error)
+	InstanceIOTimeoutGet(ctx context.Context, in *InstanceIOTimeoutGetRequest, opts ...grpc.CallOption) (*InstanceIOTimeoutResponse, error)
+	InstanceReadPreferenceSet(ctx context.Context, in *InstanceReadPreferenceSetRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	InstanceConvert(ctx context.Context, in *InstanceConvertRequest, opts ...grpc.CallOption) (InstanceService_InstanceConvertClient, error)
+	EngineMigrationRegister(ctx context.Context, in *EngineMigrationRegisterRequest, opts ...grpc.CallOption) (*EngineMigration, error)
+	EngineMigrationUpdate(ctx context.Context, in *EngineMigrationUpdateRequest, opts ...grpc.CallOption) (*EngineMigration, error)
+	EngineMigrationGet(ctx context.Context, in *EngineMigrationGetRequest, opts ...grpc.CallOption) (*EngineMigration, error)
+	EngineMigrationList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EngineMigrationListResponse, error)
+	EngineMigrationDelete(ctx context.Context, in *EngineMigrationDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplicaSpareCreate(ctx context.Context, in *ReplicaSpareCreateRequest, opts ...grpc.CallOption) (*ReplicaSpare, error)
+	ReplicaSpareClaim(ctx context.Context, in *ReplicaSpareClaimRequest, opts ...grpc.CallOption) (*ReplicaSpare, error)
+	ReplicaSpareList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReplicaSpareListResponse, error)
+	ReplicaSpareDelete(ctx context.Context, in *ReplicaSpareDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplicaReadOnlyAttach(ctx context.Context, in *ReplicaReadOnlyAttachRequest, opts ...grpc.CallOption) (*ReplicaReadOnlyAttachment, error)
+	ReplicaReadOnlyAttachmentList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReplicaReadOnlyAttachmentListResponse, error)
+	ReplicaReadOnlyDetach(ctx context.Context, in *ReplicaReadOnlyDetachRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeferredTaskList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DeferredTaskListResponse, error)
+	SpdkOrphanReconcile(ctx context.Context, in *SpdkOrphanReconcileRequest, opts ...grpc.CallOption) (*SpdkOrphanReconcileResponse, error)
+	StateExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StateExportResponse, error)
+	StateImport(ctx context.Context, in *StateImportRequest, opts ...grpc.CallOption) (*StateImportResponse, error)
+	InstanceServiceDrain(ctx context.Context, in *InstanceServiceDrainRequest, opts ...grpc.CallOption) (*InstanceServiceDrainResponse, error)
+	PortsGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PortsGetResponse, error)
+	StreamEventList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StreamEventListResponse, error)
+	VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type instanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstanceServiceClient(cc grpc.ClientConnInterface) InstanceServiceClient {
+	return &instanceServiceClient{cc}
+}
+
+func (c *instanceServiceClient) InstanceCreate(ctx context.Context, in *InstanceCreateRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceDelete(ctx context.Context, in *InstanceDeleteRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceBatchCreate(ctx context.Context, in *InstanceBatchCreateRequest, opts ...grpc.CallOption) (*InstanceBatchResponse, error) {
+	out := new(InstanceBatchResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceBatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceBatchDelete(ctx context.Context, in *InstanceBatchDeleteRequest, opts ...grpc.CallOption) (*InstanceBatchResponse, error) {
+	out := new(InstanceBatchResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceBatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceGet(ctx context.Context, in *InstanceGetRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceRefresh(ctx context.Context, in *InstanceRefreshRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceList(ctx context.Context, in *InstanceListRequest, opts ...grpc.CallOption) (*InstanceListResponse, error) {
+	out := new(InstanceListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceLog(ctx context.Context, in *InstanceLogRequest, opts ...grpc.CallOption) (InstanceService_InstanceLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[0], "/imrpc.InstanceService/InstanceLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &instanceServiceInstanceLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InstanceService_InstanceLogClient interface {
+	Recv() (*LogResponse, error)
+	grpc.ClientStream
+}
+
+type instanceServiceInstanceLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *instanceServiceInstanceLogClient) Recv() (*LogResponse, error) {
+	m := new(LogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *instanceServiceClient) VolumeLog(ctx context.Context, in *VolumeLogRequest, opts ...grpc.CallOption) (InstanceService_VolumeLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[1], "/imrpc.InstanceService/VolumeLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &instanceServiceVolumeLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InstanceService_VolumeLogClient interface {
+	Recv() (*VolumeLogResponse, error)
+	grpc.ClientStream
+}
+
+type instanceServiceVolumeLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *instanceServiceVolumeLogClient) Recv() (*VolumeLogResponse, error) {
+	m := new(VolumeLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *instanceServiceClient) InstanceWatch(ctx context.Context, in *InstanceWatchRequest, opts ...grpc.CallOption) (InstanceService_InstanceWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[2], "/imrpc.InstanceService/InstanceWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &instanceServiceInstanceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InstanceService_InstanceWatchClient interface {
+	Recv() (*InstanceWatchEvent, error)
+	grpc.ClientStream
+}
+
+type instanceServiceInstanceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *instanceServiceInstanceWatchClient) Recv() (*InstanceWatchEvent, error) {
+	m := new(InstanceWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *instanceServiceClient) InstanceWatchFreeze(ctx context.Context, in *InstanceWatchFreezeRequest, opts ...grpc.CallOption) (*InstanceWatchFreezeStatus, error) {
+	out := new(InstanceWatchFreezeStatus)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceWatchFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceWatchThaw(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstanceWatchFreezeStatus, error) {
+	out := new(InstanceWatchFreezeStatus)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceWatchThaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceReplace(ctx context.Context, in *InstanceReplaceRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceSuspend(ctx context.Context, in *InstanceSuspendRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceSuspend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceResume(ctx context.Context, in *InstanceResumeRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceSwitchOverTarget(ctx context.Context, in *InstanceSwitchOverTargetRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceSwitchOverTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceDeleteTarget(ctx context.Context, in *InstanceDeleteTargetRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceDeleteTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceStats(ctx context.Context, in *InstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStatsResponse, error) {
+	out := new(InstanceStatsResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceLatencyProbe(ctx context.Context, in *InstanceLatencyProbeRequest, opts ...grpc.CallOption) (*InstanceLatencyProbeResponse, error) {
+	out := new(InstanceLatencyProbeResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceLatencyProbe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceHealthCheck(ctx context.Context, in *InstanceHealthCheckRequest, opts ...grpc.CallOption) (*InstanceHealthCheckResponse, error) {
+	out := new(InstanceHealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceHealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) NetworkPathValidate(ctx context.Context, in *NetworkPathValidateRequest, opts ...grpc.CallOption) (*NetworkPathValidateResponse, error) {
+	out := new(NetworkPathValidateResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/NetworkPathValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceIOTimeoutSet(ctx context.Context, in *InstanceIOTimeoutSetRequest, opts ...grpc.CallOption) (*InstanceIOTimeoutResponse, error) {
+	out := new(InstanceIOTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceIOTimeoutSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceIOTimeoutGet(ctx context.Context, in *InstanceIOTimeoutGetRequest, opts ...grpc.CallOption) (*InstanceIOTimeoutResponse, error) {
+	out := new(InstanceIOTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceIOTimeoutGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceReadPreferenceSet(ctx context.Context, in *InstanceReadPreferenceSetRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceReadPreferenceSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceConvert(ctx context.Context, in *InstanceConvertRequest, opts ...grpc.CallOption) (InstanceService_InstanceConvertClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[3], "/imrpc.InstanceService/InstanceConvert", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &instanceServiceInstanceConvertClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InstanceService_InstanceConvertClient interface {
+	Recv() (*InstanceConvertProgress, error)
+	grpc.ClientStream
+}
+
+type instanceServiceInstanceConvertClient struct {
+	grpc.ClientStream
+}
+
+func (x *instanceServiceInstanceConvertClient) Recv() (*InstanceConvertProgress, error) {
+	m := new(InstanceConvertProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *instanceServiceClient) EngineMigrationRegister(ctx context.Context, in *EngineMigrationRegisterRequest, opts ...grpc.CallOption) (*EngineMigration, error) {
+	out := new(EngineMigration)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/EngineMigrationRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) EngineMigrationUpdate(ctx context.Context, in *EngineMigrationUpdateRequest, opts ...grpc.CallOption) (*EngineMigration, error) {
+	out := new(EngineMigration)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/EngineMigrationUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) EngineMigrationGet(ctx context.Context, in *EngineMigrationGetRequest, opts ...grpc.CallOption) (*EngineMigration, error) {
+	out := new(EngineMigration)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/EngineMigrationGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) EngineMigrationList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EngineMigrationListResponse, error) {
+	out := new(EngineMigrationListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/EngineMigrationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) EngineMigrationDelete(ctx context.Context, in *EngineMigrationDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/EngineMigrationDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaSpareCreate(ctx context.Context, in *ReplicaSpareCreateRequest, opts ...grpc.CallOption) (*ReplicaSpare, error) {
+	out := new(ReplicaSpare)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaSpareCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaSpareClaim(ctx context.Context, in *ReplicaSpareClaimRequest, opts ...grpc.CallOption) (*ReplicaSpare, error) {
+	out := new(ReplicaSpare)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaSpareClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaSpareList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReplicaSpareListResponse, error) {
+	out := new(ReplicaSpareListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaSpareList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaSpareDelete(ctx context.Context, in *ReplicaSpareDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaSpareDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaReadOnlyAttach(ctx context.Context, in *ReplicaReadOnlyAttachRequest, opts ...grpc.CallOption) (*ReplicaReadOnlyAttachment, error) {
+	out := new(ReplicaReadOnlyAttachment)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaReadOnlyAttach", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaReadOnlyAttachmentList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReplicaReadOnlyAttachmentListResponse, error) {
+	out := new(ReplicaReadOnlyAttachmentListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaReadOnlyAttachmentList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReplicaReadOnlyDetach(ctx context.Context, in *ReplicaReadOnlyDetachRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/ReplicaReadOnlyDetach", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) DeferredTaskList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DeferredTaskListResponse, error) {
+	out := new(DeferredTaskListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/DeferredTaskList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) SpdkOrphanReconcile(ctx context.Context, in *SpdkOrphanReconcileRequest, opts ...grpc.CallOption) (*SpdkOrphanReconcileResponse, error) {
+	out := new(SpdkOrphanReconcileResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/SpdkOrphanReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) StateExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StateExportResponse, error) {
+	out := new(StateExportResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/StateExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) StateImport(ctx context.Context, in *StateImportRequest, opts ...grpc.CallOption) (*StateImportResponse, error) {
+	out := new(StateImportResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/StateImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) InstanceServiceDrain(ctx context.Context, in *InstanceServiceDrainRequest, opts ...grpc.CallOption) (*InstanceServiceDrainResponse, error) {
+	out := new(InstanceServiceDrainResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceServiceDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) PortsGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PortsGetResponse, error) {
+	out := new(PortsGetResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/PortsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) StreamEventList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StreamEventListResponse, error) {
+	out := new(StreamEventListResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/StreamEventList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/VersionGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstanceServiceServer is the server API for InstanceService service.
+type InstanceServiceServer interface {
+	InstanceCreate(context.Context, *InstanceCreateRequest) (*InstanceResponse, error)
+	InstanceDelete(context.Context, *InstanceDeleteRequest) (*InstanceResponse, error)
+	InstanceBatchCreate(context.Context, *InstanceBatchCreateRequest) (*InstanceBatchResponse, error)
+	InstanceBatchDelete(context.Context, *InstanceBatchDeleteRequest) (*InstanceBatchResponse, error)
+	InstanceGet(context.Context, *InstanceGetRequest) (*InstanceResponse, error)
+	InstanceRefresh(context.Context, *InstanceRefreshRequest) (*InstanceResponse, error)
+	InstanceList(context.Context, *InstanceListRequest) (*InstanceListResponse, error)
+	InstanceLog(*InstanceLogRequest, InstanceService_InstanceLogServer) error
+	VolumeLog(*VolumeLogRequest, InstanceService_VolumeLogServer) error
+	InstanceWatch(*InstanceWatchRequest, InstanceService_InstanceWatchServer) error
+	InstanceWatchFreeze(context.Context, *InstanceWatchFreezeRequest) (*InstanceWatchFreezeStatus, error)
+	InstanceWatchThaw(context.Context, *emptypb.Empty) (*InstanceWatchFreezeStatus, error)
+	InstanceReplace(context.Context, *InstanceReplaceRequest) (*InstanceResponse, error)
+	InstanceSuspend(context.Context, *InstanceSuspendRequest) (*InstanceResponse, error)
+	InstanceResume(context.Context, *InstanceResumeRequest) (*InstanceResponse, error)
+	InstanceSwitchOverTarget(context.Context, *InstanceSwitchOverTargetRequest) (*InstanceResponse, error)
+	InstanceDeleteTarget(context.Context, *InstanceDeleteTargetRequest) (*InstanceResponse, error)
+	InstanceStats(context.Context, *InstanceStatsRequest) (*InstanceStatsResponse, error)
+	InstanceLatencyProbe(context.Context, *InstanceLatencyProbeRequest) (*InstanceLatencyProbeResponse, error)
+	InstanceHealthCheck(context.Context, *InstanceHealthCheckRequest) (*InstanceHealthCheckResponse, error)
+	NetworkPathValidate(context.Context, *NetworkPathValidateRequest) (*NetworkPathValidateResponse, error)
+	InstanceIOTimeoutSet(context.Context, *InstanceIOTimeoutSetRequest) (*InstanceIOTimeoutResponse, error)
+	InstanceIOTimeoutGet(context.Context, *InstanceIOTimeoutGetRequest) (*InstanceIOTimeoutResponse, error)
+	InstanceReadPreferenceSet(context.Context, *InstanceReadPreferenceSetRequest) (*InstanceResponse, error)
+	InstanceConvert(*InstanceConvertRequest, InstanceService_InstanceConvertServer) error
+	EngineMigrationRegister(context.Context, *EngineMigrationRegisterRequest) (*EngineMigration, error)
+	EngineMigrationUpdate(context.Context, *EngineMigrationUpdateRequest) (*EngineMigration, error)
+	EngineMigrationGet(context.Context, *EngineMigrationGetRequest) (*EngineMigration, error)
+	EngineMigrationList(context.Context, *emptypb.Empty) (*EngineMigrationListResponse, error)
+	EngineMigrationDelete(context.Context, *EngineMigrationDeleteRequest) (*emptypb.Empty, error)
+	ReplicaSpareCreate(context.Context, *ReplicaSpareCreateRequest) (*ReplicaSpare, error)
+	ReplicaSpareClaim(context.Context, *ReplicaSpareClaimRequest) (*ReplicaSpare, error)
+	ReplicaSpareList(context.Context, *emptypb.Empty) (*ReplicaSpareListResponse, error)
+	ReplicaSpareDelete(context.Context, *ReplicaSpareDeleteRequest) (*emptypb.Empty, error)
+	ReplicaReadOnlyAttach(context.Context, *ReplicaReadOnlyAttachRequest) (*ReplicaReadOnlyAttachment, error)
+	ReplicaReadOnlyAttachmentList(context.Context, *emptypb.Empty) (*ReplicaReadOnlyAttachmentListResponse, error)
+	ReplicaReadOnlyDetach(context.Context, *ReplicaReadOnlyDetachRequest) (*emptypb.Empty, error)
+	DeferredTaskList(context.Context, *emptypb.Empty) (*DeferredTaskListResponse, error)
+	SpdkOrphanReconcile(context.Context, *SpdkOrphanReconcileRequest) (*SpdkOrphanReconcileResponse, error)
+	StateExport(context.Context, *emptypb.Empty) (*StateExportResponse, error)
+	StateImport(context.Context, *StateImportRequest) (*StateImportResponse, error)
+	InstanceServiceDrain(context.Context, *InstanceServiceDrainRequest) (*InstanceServiceDrainResponse, error)
+	PortsGet(context.Context, *emptypb.Empty) (*PortsGetResponse, error)
+	StreamEventList(context.Context, *emptypb.Empty) (*StreamEventListResponse, error)
+	VersionGet(context.Context, *emptypb.Empty) (*VersionResponse, error)
+}
+
+// UnimplementedInstanceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedInstanceServiceServer struct {
+}
+
+func (*UnimplementedInstanceServiceServer) InstanceCreate(context.Context, *InstanceCreateRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceCreate not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceDelete(context.Context, *InstanceDeleteRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceDelete not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceBatchCreate(context.Context, *InstanceBatchCreateRequest) (*InstanceBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceBatchCreate not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceBatchDelete(context.Context, *InstanceBatchDeleteRequest) (*InstanceBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceBatchDelete not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceGet(context.Context, *InstanceGetRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceGet not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceRefresh(context.Context, *InstanceRefreshRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceRefresh not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceList(context.Context, *InstanceListRequest) (*InstanceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceList not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceLog(*InstanceLogRequest, InstanceService_InstanceLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method InstanceLog not implemented")
+}
+func (*UnimplementedInstanceServiceServer) VolumeLog(*VolumeLogRequest, InstanceService_VolumeLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method VolumeLog not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceWatch(*InstanceWatchRequest, InstanceService_InstanceWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method InstanceWatch not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceWatchFreeze(context.Context, *InstanceWatchFreezeRequest) (*InstanceWatchFreezeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceWatchFreeze not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceWatchThaw(context.Context, *emptypb.Empty) (*InstanceWatchFreezeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceWatchThaw not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceReplace(context.Context, *InstanceReplaceRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceReplace not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceSuspend(context.Context, *InstanceSuspendRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceSuspend not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceResume(context.Context, *InstanceResumeRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceResume not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceSwitchOverTarget(context.Context, *InstanceSwitchOverTargetRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceSwitchOverTarget not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceDeleteTarget(context.Context, *InstanceDeleteTargetRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceDeleteTarget not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceStats(context.Context, *InstanceStatsRequest) (*InstanceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceStats not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceLatencyProbe(context.Context, *InstanceLatencyProbeRequest) (*InstanceLatencyProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceLatencyProbe not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceHealthCheck(context.Context, *InstanceHealthCheckRequest) (*InstanceHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceHealthCheck not implemented")
+}
+func (*UnimplementedInstanceServiceServer) NetworkPathValidate(context.Context, *NetworkPathValidateRequest) (*NetworkPathValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkPathValidate not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceIOTimeoutSet(context.Context, *InstanceIOTimeoutSetRequest) (*InstanceIOTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceIOTimeoutSet not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceIOTimeoutGet(context.Context, *InstanceIOTimeoutGetRequest) (*InstanceIOTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceIOTimeoutGet not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceReadPreferenceSet(context.Context, *InstanceReadPreferenceSetRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceReadPreferenceSet not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceConvert(*InstanceConvertRequest, InstanceService_InstanceConvertServer) error {
+	return status.Errorf(codes.Unimplemented, "method InstanceConvert not implemented")
+}
+func (*UnimplementedInstanceServiceServer) EngineMigrationRegister(context.Context, *EngineMigrationRegisterRequest) (*EngineMigration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineMigrationRegister not implemented")
+}
+func (*UnimplementedInstanceServiceServer) EngineMigrationUpdate(context.Context, *EngineMigrationUpdateRequest) (*EngineMigration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineMigrationUpdate not implemented")
+}
+func (*UnimplementedInstanceServiceServer) EngineMigrationGet(context.Context, *EngineMigrationGetRequest) (*EngineMigration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineMigrationGet not implemented")
+}
+func (*UnimplementedInstanceServiceServer) EngineMigrationList(context.Context, *emptypb.Empty) (*EngineMigrationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineMigrationList not implemented")
+}
+func (*UnimplementedInstanceServiceServer) EngineMigrationDelete(context.Context, *EngineMigrationDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineMigrationDelete not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaSpareCreate(context.Context, *ReplicaSpareCreateRequest) (*ReplicaSpare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaSpareCreate not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaSpareClaim(context.Context, *ReplicaSpareClaimRequest) (*ReplicaSpare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaSpareClaim not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaSpareList(context.Context, *emptypb.Empty) (*ReplicaSpareListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaSpareList not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaSpareDelete(context.Context, *ReplicaSpareDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaSpareDelete not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaReadOnlyAttach(context.Context, *ReplicaReadOnlyAttachRequest) (*ReplicaReadOnlyAttachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaReadOnlyAttach not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaReadOnlyAttachmentList(context.Context, *emptypb.Empty) (*ReplicaReadOnlyAttachmentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaReadOnlyAttachmentList not implemented")
+}
+func (*UnimplementedInstanceServiceServer) ReplicaReadOnlyDetach(context.Context, *ReplicaReadOnlyDetachRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaReadOnlyDetach not implemented")
+}
+func (*UnimplementedInstanceServiceServer) DeferredTaskList(context.Context, *emptypb.Empty) (*DeferredTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredTaskList not implemented")
+}
+func (*UnimplementedInstanceServiceServer) SpdkOrphanReconcile(context.Context, *SpdkOrphanReconcileRequest) (*SpdkOrphanReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpdkOrphanReconcile not implemented")
+}
+func (*UnimplementedInstanceServiceServer) StateExport(context.Context, *emptypb.Empty) (*StateExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateExport not implemented")
+}
+func (*UnimplementedInstanceServiceServer) StateImport(context.Context, *StateImportRequest) (*StateImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateImport not implemented")
+}
+func (*UnimplementedInstanceServiceServer) InstanceServiceDrain(context.Context, *InstanceServiceDrainRequest) (*InstanceServiceDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceServiceDrain not implemented")
+}
+func (*UnimplementedInstanceServiceServer) PortsGet(context.Context, *emptypb.Empty) (*PortsGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortsGet not implemented")
+}
+func (*UnimplementedInstanceServiceServer) StreamEventList(context.Context, *emptypb.Empty) (*StreamEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamEventList not implemented")
+}
+func (*UnimplementedInstanceServiceServer) VersionGet(context.Context, *emptypb.Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VersionGet not implemented")
+}
+
+func RegisterInstanceServiceServer(s *grpc.Server, srv InstanceServiceServer) {
+	s.RegisterService(&_InstanceService_serviceDesc, srv)
+}
+
+func _InstanceService_InstanceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceCreate(ctx, req.(*InstanceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceDelete(ctx, req.(*InstanceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceBatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceBatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceBatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceBatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceBatchCreate(ctx, req.(*InstanceBatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceBatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceBatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceBatchDelete(ctx, req.(*InstanceBatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceGet(ctx, req.(*InstanceGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceRefresh(ctx, req.(*InstanceRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceList(ctx, req.(*InstanceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InstanceLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstanceServiceServer).InstanceLog(m, &instanceServiceInstanceLogServer{stream})
+}
+
+type InstanceService_InstanceLogServer interface {
+	Send(*LogResponse) error
+	grpc.ServerStream
+}
+
+type instanceServiceInstanceLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *instanceServiceInstanceLogServer) Send(m *LogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InstanceService_VolumeLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VolumeLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstanceServiceServer).VolumeLog(m, &instanceServiceVolumeLogServer{stream})
+}
+
+type InstanceService_VolumeLogServer interface {
+	Send(*VolumeLogResponse) error
+	grpc.ServerStream
+}
+
+type instanceServiceVolumeLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *instanceServiceVolumeLogServer) Send(m *VolumeLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InstanceService_InstanceWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InstanceWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstanceServiceServer).InstanceWatch(m, &instanceServiceInstanceWatchServer{stream})
+}
+
+type InstanceService_InstanceWatchServer interface {
+	Send(*InstanceWatchEvent) error
+	grpc.ServerStream
+}
+
+type instanceServiceInstanceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *instanceServiceInstanceWatchServer) Send(m *InstanceWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InstanceService_InstanceWatchFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceWatchFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceWatchFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceWatchFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceWatchFreeze(ctx, req.(*InstanceWatchFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceWatchThaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceWatchThaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceWatchThaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceWatchThaw(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceReplace(ctx, req.(*InstanceReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceSuspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceSuspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceSuspend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceSuspend(ctx, req.(*InstanceSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceResume(ctx, req.(*InstanceResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type instanceServiceClient struct {
-	cc grpc.ClientConnInterface
+func _InstanceService_InstanceSwitchOverTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceSwitchOverTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceSwitchOverTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceSwitchOverTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceSwitchOverTarget(ctx, req.(*InstanceSwitchOverTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewInstanceServiceClient(cc grpc.ClientConnInterface) InstanceServiceClient {
-	return &instanceServiceClient{cc}
+func _InstanceService_InstanceDeleteTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceDeleteTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceDeleteTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceDeleteTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceDeleteTarget(ctx, req.(*InstanceDeleteTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceCreate(ctx context.Context, in *InstanceCreateRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
-	out := new(InstanceResponse)
-	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceCreate", in, out, opts...)
-	if err != nil {
+func _InstanceService_InstanceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceStats(ctx, req.(*InstanceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceDelete(ctx context.Context, in *InstanceDeleteRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
-	out := new(InstanceResponse)
-	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceDelete", in, out, opts...)
-	if err != nil {
+func _InstanceService_InstanceLatencyProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceLatencyProbeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceLatencyProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceLatencyProbe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceLatencyProbe(ctx, req.(*InstanceLatencyProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceGet(ctx context.Context, in *InstanceGetRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
-	out := new(InstanceResponse)
-	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceGet", in, out, opts...)
-	if err != nil {
+func _InstanceService_InstanceHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceHealthCheckRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceHealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceHealthCheck(ctx, req.(*InstanceHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstanceListResponse, error) {
-	out := new(InstanceListResponse)
-	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceList", in, out, opts...)
-	if err != nil {
+func _InstanceService_NetworkPathValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkPathValidateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).NetworkPathValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/NetworkPathValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).NetworkPathValidate(ctx, req.(*NetworkPathValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceLog(ctx context.Context, in *InstanceLogRequest, opts ...grpc.CallOption) (InstanceService_InstanceLogClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[0], "/imrpc.InstanceService/InstanceLog", opts...)
-	if err != nil {
+func _InstanceService_InstanceIOTimeoutSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceIOTimeoutSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &instanceServiceInstanceLogClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceIOTimeoutSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceIOTimeoutSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceIOTimeoutSet(ctx, req.(*InstanceIOTimeoutSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceIOTimeoutGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceIOTimeoutGetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceIOTimeoutGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceIOTimeoutGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceIOTimeoutGet(ctx, req.(*InstanceIOTimeoutGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_InstanceReadPreferenceSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceReadPreferenceSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return x, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceReadPreferenceSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceReadPreferenceSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceReadPreferenceSet(ctx, req.(*InstanceReadPreferenceSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type InstanceService_InstanceLogClient interface {
-	Recv() (*LogResponse, error)
-	grpc.ClientStream
+func _InstanceService_InstanceConvert_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InstanceConvertRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstanceServiceServer).InstanceConvert(m, &instanceServiceInstanceConvertServer{stream})
 }
 
-type instanceServiceInstanceLogClient struct {
-	grpc.ClientStream
+type InstanceService_InstanceConvertServer interface {
+	Send(*InstanceConvertProgress) error
+	grpc.ServerStream
 }
 
-func (x *instanceServiceInstanceLogClient) Recv() (*LogResponse, error) {
-	m := new(LogResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+type instanceServiceInstanceConvertServer struct {
+	grpc.ServerStream
+}
+
+func (x *instanceServiceInstanceConvertServer) Send(m *InstanceConvertProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InstanceService_EngineMigrationRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EngineMigrationRegisterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).EngineMigrationRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/EngineMigrationRegister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).EngineMigrationRegister(ctx, req.(*EngineMigrationRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceWatch(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (InstanceService_InstanceWatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[1], "/imrpc.InstanceService/InstanceWatch", opts...)
-	if err != nil {
+func _InstanceService_EngineMigrationUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EngineMigrationUpdateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &instanceServiceInstanceWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).EngineMigrationUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/EngineMigrationUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).EngineMigrationUpdate(ctx, req.(*EngineMigrationUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_EngineMigrationGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EngineMigrationGetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).EngineMigrationGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/EngineMigrationGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).EngineMigrationGet(ctx, req.(*EngineMigrationGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_EngineMigrationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return x, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).EngineMigrationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/EngineMigrationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).EngineMigrationList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type InstanceService_InstanceWatchClient interface {
-	Recv() (*emptypb.Empty, error)
-	grpc.ClientStream
+func _InstanceService_EngineMigrationDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EngineMigrationDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).EngineMigrationDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/EngineMigrationDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).EngineMigrationDelete(ctx, req.(*EngineMigrationDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type instanceServiceInstanceWatchClient struct {
-	grpc.ClientStream
+func _InstanceService_ReplicaSpareCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaSpareCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReplicaSpareCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/ReplicaSpareCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReplicaSpareCreate(ctx, req.(*ReplicaSpareCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ReplicaSpareClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaSpareClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReplicaSpareClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/ReplicaSpareClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReplicaSpareClaim(ctx, req.(*ReplicaSpareClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *instanceServiceInstanceWatchClient) Recv() (*emptypb.Empty, error) {
-	m := new(emptypb.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _InstanceService_ReplicaSpareList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReplicaSpareList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/ReplicaSpareList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReplicaSpareList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) InstanceReplace(ctx context.Context, in *InstanceReplaceRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
-	out := new(InstanceResponse)
-	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/InstanceReplace", in, out, opts...)
-	if err != nil {
+func _InstanceService_ReplicaSpareDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaSpareDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReplicaSpareDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/ReplicaSpareDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReplicaSpareDelete(ctx, req.(*ReplicaSpareDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *instanceServiceClient) VersionGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/imrpc.InstanceService/VersionGet", in, out, opts...)
-	if err != nil {
+func _InstanceService_ReplicaReadOnlyAttach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaReadOnlyAttachRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// InstanceServiceServer is the server API for InstanceService service.
-type InstanceServiceServer interface {
-	InstanceCreate(context.Context, *InstanceCreateRequest) (*InstanceResponse, error)
-	InstanceDelete(context.Context, *InstanceDeleteRequest) (*InstanceResponse, error)
-	InstanceGet(context.Context, *InstanceGetRequest) (*InstanceResponse, error)
-	InstanceList(context.Context, *emptypb.Empty) (*InstanceListResponse, error)
-	InstanceLog(*InstanceLogRequest, InstanceService_InstanceLogServer) error
-	InstanceWatch(*emptypb.Empty, InstanceService_InstanceWatchServer) error
-	InstanceReplace(context.Context, *InstanceReplaceRequest) (*InstanceResponse, error)
-	VersionGet(context.Context, *emptypb.Empty) (*VersionResponse, error)
-}
-
-// UnimplementedInstanceServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedInstanceServiceServer struct {
-}
-
-func (*UnimplementedInstanceServiceServer) InstanceCreate(context.Context, *InstanceCreateRequest) (*InstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceCreate not implemented")
-}
-func (*UnimplementedInstanceServiceServer) InstanceDelete(context.Context, *InstanceDeleteRequest) (*InstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceDelete not implemented")
-}
-func (*UnimplementedInstanceServiceServer) InstanceGet(context.Context, *InstanceGetRequest) (*InstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceGet not implemented")
-}
-func (*UnimplementedInstanceServiceServer) InstanceList(context.Context, *emptypb.Empty) (*InstanceListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceList not implemented")
-}
-func (*UnimplementedInstanceServiceServer) InstanceLog(*InstanceLogRequest, InstanceService_InstanceLogServer) error {
-	return status.Errorf(codes.Unimplemented, "method InstanceLog not implemented")
-}
-func (*UnimplementedInstanceServiceServer) InstanceWatch(*emptypb.Empty, InstanceService_InstanceWatchServer) error {
-	return status.Errorf(codes.Unimplemented, "method InstanceWatch not implemented")
-}
-func (*UnimplementedInstanceServiceServer) InstanceReplace(context.Context, *InstanceReplaceRequest) (*InstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceReplace not implemented")
-}
-func (*UnimplementedInstanceServiceServer) VersionGet(context.Context, *emptypb.Empty) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VersionGet not implemented")
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReplicaReadOnlyAttach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/ReplicaReadOnlyAttach",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReplicaReadOnlyAttach(ctx, req.(*ReplicaReadOnlyAttachRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInstanceServiceServer(s *grpc.Server, srv InstanceServiceServer) {
-	s.RegisterService(&_InstanceService_serviceDesc, srv)
+func _InstanceService_ReplicaReadOnlyAttachmentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReplicaReadOnlyAttachmentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/ReplicaReadOnlyAttachmentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReplicaReadOnlyAttachmentList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InstanceService_InstanceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InstanceCreateRequest)
+func _InstanceService_ReplicaReadOnlyDetach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaReadOnlyDetachRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InstanceServiceServer).InstanceCreate(ctx, in)
+		return srv.(InstanceServiceServer).ReplicaReadOnlyDetach(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/imrpc.InstanceService/InstanceCreate",
+		FullMethod: "/imrpc.InstanceService/ReplicaReadOnlyDetach",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InstanceServiceServer).InstanceCreate(ctx, req.(*InstanceCreateRequest))
+		return srv.(InstanceServiceServer).ReplicaReadOnlyDetach(ctx, req.(*ReplicaReadOnlyDetachRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InstanceService_InstanceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InstanceDeleteRequest)
+func _InstanceService_DeferredTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InstanceServiceServer).InstanceDelete(ctx, in)
+		return srv.(InstanceServiceServer).DeferredTaskList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/imrpc.InstanceService/InstanceDelete",
+		FullMethod: "/imrpc.InstanceService/DeferredTaskList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InstanceServiceServer).InstanceDelete(ctx, req.(*InstanceDeleteRequest))
+		return srv.(InstanceServiceServer).DeferredTaskList(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InstanceService_InstanceGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InstanceGetRequest)
+func _InstanceService_SpdkOrphanReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpdkOrphanReconcileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InstanceServiceServer).InstanceGet(ctx, in)
+		return srv.(InstanceServiceServer).SpdkOrphanReconcile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/imrpc.InstanceService/InstanceGet",
+		FullMethod: "/imrpc.InstanceService/SpdkOrphanReconcile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InstanceServiceServer).InstanceGet(ctx, req.(*InstanceGetRequest))
+		return srv.(InstanceServiceServer).SpdkOrphanReconcile(ctx, req.(*SpdkOrphanReconcileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InstanceService_InstanceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _InstanceService_StateExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InstanceServiceServer).InstanceList(ctx, in)
+		return srv.(InstanceServiceServer).StateExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/imrpc.InstanceService/InstanceList",
+		FullMethod: "/imrpc.InstanceService/StateExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InstanceServiceServer).InstanceList(ctx, req.(*emptypb.Empty))
+		return srv.(InstanceServiceServer).StateExport(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InstanceService_InstanceLog_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InstanceLogRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _InstanceService_StateImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(InstanceServiceServer).InstanceLog(m, &instanceServiceInstanceLogServer{stream})
-}
-
-type InstanceService_InstanceLogServer interface {
-	Send(*LogResponse) error
-	grpc.ServerStream
-}
-
-type instanceServiceInstanceLogServer struct {
-	grpc.ServerStream
-}
-
-func (x *instanceServiceInstanceLogServer) Send(m *LogResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _InstanceService_InstanceWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).StateImport(ctx, in)
 	}
-	return srv.(InstanceServiceServer).InstanceWatch(m, &instanceServiceInstanceWatchServer{stream})
-}
-
-type InstanceService_InstanceWatchServer interface {
-	Send(*emptypb.Empty) error
-	grpc.ServerStream
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/StateImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).StateImport(ctx, req.(*StateImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type instanceServiceInstanceWatchServer struct {
-	grpc.ServerStream
+func _InstanceService_InstanceServiceDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceServiceDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).InstanceServiceDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/InstanceServiceDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).InstanceServiceDrain(ctx, req.(*InstanceServiceDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *instanceServiceInstanceWatchServer) Send(m *emptypb.Empty) error {
-	return x.ServerStream.SendMsg(m)
+func _InstanceService_PortsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).PortsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/imrpc.InstanceService/PortsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).PortsGet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InstanceService_InstanceReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InstanceReplaceRequest)
+func _InstanceService_StreamEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InstanceServiceServer).InstanceReplace(ctx, in)
+		return srv.(InstanceServiceServer).StreamEventList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/imrpc.InstanceService/InstanceReplace",
+		FullMethod: "/imrpc.InstanceService/StreamEventList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InstanceServiceServer).InstanceReplace(ctx, req.(*InstanceReplaceRequest))
+		return srv.(InstanceServiceServer).StreamEventList(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1608,18 +10359,158 @@ var _InstanceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InstanceDelete",
 			Handler:    _InstanceService_InstanceDelete_Handler,
 		},
+		{
+			MethodName: "InstanceBatchCreate",
+			Handler:    _InstanceService_InstanceBatchCreate_Handler,
+		},
+		{
+			MethodName: "InstanceBatchDelete",
+			Handler:    _InstanceService_InstanceBatchDelete_Handler,
+		},
 		{
 			MethodName: "InstanceGet",
 			Handler:    _InstanceService_InstanceGet_Handler,
 		},
+		{
+			MethodName: "InstanceRefresh",
+			Handler:    _InstanceService_InstanceRefresh_Handler,
+		},
 		{
 			MethodName: "InstanceList",
 			Handler:    _InstanceService_InstanceList_Handler,
 		},
+		{
+			MethodName: "InstanceWatchFreeze",
+			Handler:    _InstanceService_InstanceWatchFreeze_Handler,
+		},
+		{
+			MethodName: "InstanceWatchThaw",
+			Handler:    _InstanceService_InstanceWatchThaw_Handler,
+		},
 		{
 			MethodName: "InstanceReplace",
 			Handler:    _InstanceService_InstanceReplace_Handler,
 		},
+		{
+			MethodName: "InstanceSuspend",
+			Handler:    _InstanceService_InstanceSuspend_Handler,
+		},
+		{
+			MethodName: "InstanceResume",
+			Handler:    _InstanceService_InstanceResume_Handler,
+		},
+		{
+			MethodName: "InstanceSwitchOverTarget",
+			Handler:    _InstanceService_InstanceSwitchOverTarget_Handler,
+		},
+		{
+			MethodName: "InstanceDeleteTarget",
+			Handler:    _InstanceService_InstanceDeleteTarget_Handler,
+		},
+		{
+			MethodName: "InstanceStats",
+			Handler:    _InstanceService_InstanceStats_Handler,
+		},
+		{
+			MethodName: "InstanceLatencyProbe",
+			Handler:    _InstanceService_InstanceLatencyProbe_Handler,
+		},
+		{
+			MethodName: "InstanceHealthCheck",
+			Handler:    _InstanceService_InstanceHealthCheck_Handler,
+		},
+		{
+			MethodName: "NetworkPathValidate",
+			Handler:    _InstanceService_NetworkPathValidate_Handler,
+		},
+		{
+			MethodName: "InstanceIOTimeoutSet",
+			Handler:    _InstanceService_InstanceIOTimeoutSet_Handler,
+		},
+		{
+			MethodName: "InstanceIOTimeoutGet",
+			Handler:    _InstanceService_InstanceIOTimeoutGet_Handler,
+		},
+		{
+			MethodName: "InstanceReadPreferenceSet",
+			Handler:    _InstanceService_InstanceReadPreferenceSet_Handler,
+		},
+		{
+			MethodName: "EngineMigrationRegister",
+			Handler:    _InstanceService_EngineMigrationRegister_Handler,
+		},
+		{
+			MethodName: "EngineMigrationUpdate",
+			Handler:    _InstanceService_EngineMigrationUpdate_Handler,
+		},
+		{
+			MethodName: "EngineMigrationGet",
+			Handler:    _InstanceService_EngineMigrationGet_Handler,
+		},
+		{
+			MethodName: "EngineMigrationList",
+			Handler:    _InstanceService_EngineMigrationList_Handler,
+		},
+		{
+			MethodName: "EngineMigrationDelete",
+			Handler:    _InstanceService_EngineMigrationDelete_Handler,
+		},
+		{
+			MethodName: "ReplicaSpareCreate",
+			Handler:    _InstanceService_ReplicaSpareCreate_Handler,
+		},
+		{
+			MethodName: "ReplicaSpareClaim",
+			Handler:    _InstanceService_ReplicaSpareClaim_Handler,
+		},
+		{
+			MethodName: "ReplicaSpareList",
+			Handler:    _InstanceService_ReplicaSpareList_Handler,
+		},
+		{
+			MethodName: "ReplicaSpareDelete",
+			Handler:    _InstanceService_ReplicaSpareDelete_Handler,
+		},
+		{
+			MethodName: "ReplicaReadOnlyAttach",
+			Handler:    _InstanceService_ReplicaReadOnlyAttach_Handler,
+		},
+		{
+			MethodName: "ReplicaReadOnlyAttachmentList",
+			Handler:    _InstanceService_ReplicaReadOnlyAttachmentList_Handler,
+		},
+		{
+			MethodName: "ReplicaReadOnlyDetach",
+			Handler:    _InstanceService_ReplicaReadOnlyDetach_Handler,
+		},
+		{
+			MethodName: "DeferredTaskList",
+			Handler:    _InstanceService_DeferredTaskList_Handler,
+		},
+		{
+			MethodName: "SpdkOrphanReconcile",
+			Handler:    _InstanceService_SpdkOrphanReconcile_Handler,
+		},
+		{
+			MethodName: "StateExport",
+			Handler:    _InstanceService_StateExport_Handler,
+		},
+		{
+			MethodName: "StateImport",
+			Handler:    _InstanceService_StateImport_Handler,
+		},
+		{
+			MethodName: "InstanceServiceDrain",
+			Handler:    _InstanceService_InstanceServiceDrain_Handler,
+		},
+		{
+			MethodName: "PortsGet",
+			Handler:    _InstanceService_PortsGet_Handler,
+		},
+		{
+			MethodName: "StreamEventList",
+			Handler:    _InstanceService_StreamEventList_Handler,
+		},
 		{
 			MethodName: "VersionGet",
 			Handler:    _InstanceService_VersionGet_Handler,
@@ -1631,11 +10522,21 @@ var _InstanceService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _InstanceService_InstanceLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "VolumeLog",
+			Handler:       _InstanceService_VolumeLog_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "InstanceWatch",
 			Handler:       _InstanceService_InstanceWatch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "InstanceConvert",
+			Handler:       _InstanceService_InstanceConvert_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "github.com/longhorn/longhorn-instance-manager/pkg/imrpc/instance.proto",
 }
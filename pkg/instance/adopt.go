@@ -0,0 +1,147 @@
+package instance
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// instanceAdopt binds the instance to the existing lvol or engine of the same name, e.g. after the disaster
+// recovery of an lvstore, once its geometry is validated against the spec.
+func (ops V2DataEngineInstanceOps) instanceAdopt(ctx context.Context, c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) (*rpc.InstanceResponse, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"name": spec.Name,
+		"type": spec.Type,
+	})
+
+	var resp *rpc.InstanceResponse
+	var err error
+	switch spec.Type {
+	case types.InstanceTypeEngine:
+		resp, err = adoptEngine(ctx, c, spec, log)
+	case types.InstanceTypeReplica:
+		if err := ops.safeModeDisks.CheckWritable(spec.SpdkInstanceSpec.DiskName); err != nil {
+			return nil, err
+		}
+		resp, err = adoptReplica(c, spec, log)
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "unknown instance type %v", spec.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ops.labels.set(spec.Name, spec.Labels)
+	ops.priorities.set(spec.Name, spec.PriorityClass)
+	if spec.Type == types.InstanceTypeEngine {
+		ops.readPreferences.set(spec.Name, spec.SpdkInstanceSpec.PreferredReadReplica)
+	}
+	return ops.priorities.apply(ops.labels.apply(resp)), nil
+}
+
+func adoptReplica(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec, log logrus.FieldLogger) (*rpc.InstanceResponse, error) {
+	// The SPDK service discovers the existing lvols periodically, so a missing replica may show up later
+	replica, err := c.ReplicaGet(spec.Name)
+	if err != nil {
+		if grpcstatus.Code(err) == grpccodes.NotFound {
+			return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find existing lvol of replica %v to adopt", spec.Name)
+		}
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to get replica %v to adopt", spec.Name).Error())
+	}
+
+	if err := validateAdoptedReplica(replica, spec.SpdkInstanceSpec); err != nil {
+		return nil, grpcstatus.Error(grpccodes.FailedPrecondition, errors.Wrapf(err, "cannot adopt replica %v", spec.Name).Error())
+	}
+
+	switch replica.State {
+	case spdktypes.InstanceStateRunning:
+		log.Info("Adopted running replica")
+		return replicaResponseToInstanceResponse(replica), nil
+	case spdktypes.InstanceStateStopped:
+		// The replica is discovered from the existing lvol, so creating it only starts it without touching the data
+		log.Info("Adopting stopped replica by starting it")
+		replica, err = c.ReplicaCreate(spec.Name, spec.SpdkInstanceSpec.DiskName, spec.SpdkInstanceSpec.DiskUuid, spec.SpdkInstanceSpec.Size, spec.SpdkInstanceSpec.ExposeRequired, spec.PortCount)
+		if err != nil {
+			return nil, err
+		}
+		return replicaResponseToInstanceResponse(replica), nil
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot adopt replica %v in state %v", spec.Name, replica.State)
+	}
+}
+
+func validateAdoptedReplica(replica *spdkapi.Replica, spec *rpc.SpdkInstanceSpec) error {
+	if spec.DiskName != "" && replica.LvsName != spec.DiskName {
+		return errors.Errorf("lvstore name %v doesn't match disk name %v", replica.LvsName, spec.DiskName)
+	}
+	if spec.DiskUuid != "" && replica.LvsUUID != spec.DiskUuid {
+		return errors.Errorf("lvstore UUID %v doesn't match disk UUID %v", replica.LvsUUID, spec.DiskUuid)
+	}
+	if spec.Size != 0 && replica.SpecSize != spec.Size {
+		return errors.Errorf("lvol size %v doesn't match size %v", replica.SpecSize, spec.Size)
+	}
+	return nil
+}
+
+func adoptEngine(ctx context.Context, c *spdkclient.SPDKClient, spec *rpc.InstanceSpec, log logrus.FieldLogger) (*rpc.InstanceResponse, error) {
+	engine, err := c.EngineGet(spec.Name)
+	if err != nil {
+		if grpcstatus.Code(err) != grpccodes.NotFound {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to get engine %v to adopt", spec.Name).Error())
+		}
+
+		// RAID bdevs don't persist across spdk_tgt restarts, so an engine unknown to the SPDK service is adopted
+		// by assembling it from its adopted replicas
+		log.Info("Adopting engine by creating it from the existing replicas")
+		engine, err = createEngine(ctx, c, spec)
+		if err != nil {
+			return nil, err
+		}
+		return engineResponseToInstanceResponse(engine), nil
+	}
+
+	if err := validateAdoptedEngine(ctx, engine, spec); err != nil {
+		return nil, grpcstatus.Error(grpccodes.FailedPrecondition, errors.Wrapf(err, "cannot adopt engine %v", spec.Name).Error())
+	}
+	if engine.State != spdktypes.InstanceStateRunning {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot adopt engine %v in state %v", spec.Name, engine.State)
+	}
+
+	log.Info("Adopted running engine")
+	return engineResponseToInstanceResponse(engine), nil
+}
+
+func validateAdoptedEngine(ctx context.Context, engine *spdkapi.Engine, spec *rpc.InstanceSpec) error {
+	if engine.VolumeName != spec.VolumeName {
+		return errors.Errorf("volume name %v doesn't match %v", engine.VolumeName, spec.VolumeName)
+	}
+	if spec.SpdkInstanceSpec.Size != 0 && engine.SpecSize != spec.SpdkInstanceSpec.Size {
+		return errors.Errorf("size %v doesn't match %v", engine.SpecSize, spec.SpdkInstanceSpec.Size)
+	}
+	if engine.Frontend != spec.SpdkInstanceSpec.Frontend {
+		return errors.Errorf("frontend %v doesn't match %v", engine.Frontend, spec.SpdkInstanceSpec.Frontend)
+	}
+	if len(spec.SpdkInstanceSpec.ReplicaAddressMap) != 0 && !reflect.DeepEqual(engine.ReplicaAddressMap, spec.SpdkInstanceSpec.ReplicaAddressMap) {
+		// The engine has the resolved addresses if the replicas are specified by names
+		replicaAddressMap, err := util.ResolveAddressMap(ctx, spec.SpdkInstanceSpec.ReplicaAddressMap)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(engine.ReplicaAddressMap, replicaAddressMap) {
+			return errors.Errorf("replicas %v don't match %v", engine.ReplicaAddressMap, spec.SpdkInstanceSpec.ReplicaAddressMap)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package instance
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	// instanceBatchMaxSize caps the operations of a batch, so that a response stays well below the gRPC message
+	// size limit
+	instanceBatchMaxSize = 1000
+	// instanceBatchDefaultConcurrency is the number of the operations of a batch in flight unless requested otherwise
+	instanceBatchDefaultConcurrency = 16
+	// instanceBatchMaxConcurrency caps the requested concurrency, so that a batch cannot flood the backends
+	instanceBatchMaxConcurrency = 64
+)
+
+// InstanceBatchCreate creates the instances in parallel, e.g. when the manager restores the instances of a node.
+// The creations go through InstanceCreate, so the instance limits apply to each of them.
+func (s *Server) InstanceBatchCreate(ctx context.Context, req *rpc.InstanceBatchCreateRequest) (*rpc.InstanceBatchResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"count":       len(req.Requests),
+		"concurrency": req.Concurrency,
+	}).Info("Creating instances in batch")
+
+	names := make([]string, len(req.Requests))
+	for i, r := range req.Requests {
+		if r.GetSpec().GetName() == "" {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "missing required argument name of request %v", i)
+		}
+		names[i] = r.Spec.Name
+	}
+
+	return runInstanceBatch(ctx, names, req.Concurrency, func(i int) (*rpc.InstanceResponse, error) {
+		if err := s.spdkGate.checkDataEngine(req.Requests[i].Spec.DataEngine); err != nil {
+			return nil, err
+		}
+		return s.InstanceCreate(ctx, req.Requests[i])
+	})
+}
+
+// InstanceBatchDelete deletes the instances in parallel, e.g. when the manager drains a node.
+func (s *Server) InstanceBatchDelete(ctx context.Context, req *rpc.InstanceBatchDeleteRequest) (*rpc.InstanceBatchResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"count":       len(req.Requests),
+		"concurrency": req.Concurrency,
+	}).Info("Deleting instances in batch")
+
+	names := make([]string, len(req.Requests))
+	for i, r := range req.Requests {
+		if r.GetName() == "" {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "missing required argument name of request %v", i)
+		}
+		names[i] = r.Name
+	}
+
+	return runInstanceBatch(ctx, names, req.Concurrency, func(i int) (*rpc.InstanceResponse, error) {
+		if err := s.spdkGate.checkDataEngine(req.Requests[i].DataEngine); err != nil {
+			return nil, err
+		}
+		return s.InstanceDelete(ctx, req.Requests[i])
+	})
+}
+
+// runInstanceBatch runs the operation on each of the named instances with at most concurrency operations in flight,
+// and returns the result of each in order. An instance can appear only once in a batch, since the operations on the
+// same instance would race. The operations not started yet fail once the context is done.
+func runInstanceBatch(ctx context.Context, names []string, concurrency int32, operation func(i int) (*rpc.InstanceResponse, error)) (*rpc.InstanceBatchResponse, error) {
+	if len(names) > instanceBatchMaxSize {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "at most %v instances can be handled in a batch", instanceBatchMaxSize)
+	}
+	if concurrency < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid concurrency %v", concurrency)
+	}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if seen[name] {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "instance %v appears more than once in the batch", name)
+		}
+		seen[name] = true
+	}
+
+	workers := int(concurrency)
+	if workers == 0 {
+		workers = instanceBatchDefaultConcurrency
+	}
+	if workers > instanceBatchMaxConcurrency {
+		workers = instanceBatchMaxConcurrency
+	}
+
+	results := make([]*rpc.InstanceBatchResult, len(names))
+	slots := make(chan struct{}, workers)
+	wg := &sync.WaitGroup{}
+	for i, name := range names {
+		acquired := false
+		if ctx.Err() == nil {
+			select {
+			case slots <- struct{}{}:
+				acquired = true
+			case <-ctx.Done():
+			}
+		}
+		if !acquired {
+			results[i] = newInstanceBatchResult(name, nil, grpcstatus.FromContextError(ctx.Err()).Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, name string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			resp, err := operation(i)
+			results[i] = newInstanceBatchResult(name, resp, err)
+		}(i, name)
+	}
+	wg.Wait()
+
+	return &rpc.InstanceBatchResponse{
+		Results: results,
+	}, nil
+}
+
+func newInstanceBatchResult(name string, resp *rpc.InstanceResponse, err error) *rpc.InstanceBatchResult {
+	if err != nil {
+		st := grpcstatus.Convert(err)
+		return &rpc.InstanceBatchResult{
+			Name:      name,
+			ErrorCode: int32(st.Code()),
+			ErrorMsg:  st.Message(),
+		}
+	}
+	return &rpc.InstanceBatchResult{
+		Name:      name,
+		Instance:  resp,
+		ErrorCode: int32(grpccodes.OK),
+	}
+}
@@ -0,0 +1,77 @@
+package instance
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// instanceOperationSemaphore bounds the creations and deletions running at once for each data engine. The
+// operations beyond the bound wait for a slot rather than failing, until the deadline of the call expires.
+type instanceOperationSemaphore struct {
+	lock    *sync.Mutex
+	slots   map[rpc.DataEngine]chan struct{}
+	waiting map[rpc.DataEngine]int
+}
+
+func newInstanceOperationSemaphore(limits *InstanceLimits) *instanceOperationSemaphore {
+	sem := &instanceOperationSemaphore{
+		lock:    &sync.Mutex{},
+		slots:   map[rpc.DataEngine]chan struct{}{},
+		waiting: map[rpc.DataEngine]int{},
+	}
+	if limits == nil {
+		return sem
+	}
+	for dataEngine, limit := range limits.MaxConcurrentOperations {
+		if limit > 0 {
+			sem.slots[dataEngine] = make(chan struct{}, limit)
+		}
+	}
+	return sem
+}
+
+// acquire waits for a slot of the data engine. The returned function releases the slot once the operation is done.
+func (sem *instanceOperationSemaphore) acquire(ctx context.Context, dataEngine rpc.DataEngine, operation, name string) (func(), error) {
+	slots, ok := sem.slots[dataEngine]
+	if !ok {
+		return func() {}, nil
+	}
+	release := func() { <-slots }
+
+	select {
+	case slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	logrus.Infof("Waiting to %v instance %v since %v %v operations are running", operation, name, cap(slots), nodeStateDataEngines[dataEngine])
+	sem.setWaiting(dataEngine, 1)
+	defer sem.setWaiting(dataEngine, -1)
+
+	select {
+	case slots <- struct{}{}:
+		return release, nil
+	case <-ctx.Done():
+		st := grpcstatus.FromContextError(ctx.Err())
+		return nil, grpcstatus.Errorf(st.Code(), "timed out waiting to %v instance %v since %v %v operations are running",
+			operation, name, cap(slots), nodeStateDataEngines[dataEngine])
+	}
+}
+
+func (sem *instanceOperationSemaphore) setWaiting(dataEngine rpc.DataEngine, delta int) {
+	sem.lock.Lock()
+	sem.waiting[dataEngine] += delta
+	waiting := sem.waiting[dataEngine]
+	sem.lock.Unlock()
+
+	metrics.SetGauge(metrics.MetricInstanceOperationsWaiting, map[string]string{
+		"data_engine": nodeStateDataEngines[dataEngine],
+	}, float64(waiting))
+}
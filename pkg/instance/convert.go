@@ -0,0 +1,412 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	commonNet "github.com/longhorn/go-common-libs/net"
+	helpernvme "github.com/longhorn/go-spdk-helper/pkg/nvme"
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	"github.com/longhorn/longhorn-engine/pkg/backingfile"
+	"github.com/longhorn/longhorn-engine/pkg/replica"
+	"github.com/longhorn/sparse-tools/sparse"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	InstanceConvertStateCreating = "creating"
+	InstanceConvertStateCopying  = "copying"
+	InstanceConvertStateComplete = "complete"
+	InstanceConvertStateError    = "error"
+
+	// replicaConvertSuffix names the NVMe-oF subsystem and the device the data is copied through apart from the ones
+	// of the replica
+	replicaConvertSuffix            = "-convert"
+	instanceConvertChunkSize        = 4 << 20
+	instanceConvertProgressInterval = 1 << 30
+)
+
+// instanceConverter copies the data of a v1 replica into a new v2 replica. The v1 replica is read from its files
+// like the v1 engine does for a backup, so only the data ranges are copied, and the v2 replica is written through a
+// device connected to its lvol.
+type instanceConverter struct {
+	s    *Server
+	ctx  context.Context
+	req  *rpc.InstanceConvertRequest
+	send func(*rpc.InstanceConvertProgress) error
+
+	source     *replica.Replica
+	sourceSize int64
+	intervals  []sparse.Interval
+	totalBytes int64
+
+	// deviceName and nqn are of the device connected to the lvol of the v2 replica. The lvol is exposed by a
+	// dedicated subsystem only if the replica is not exposed already.
+	deviceName  string
+	nqn         string
+	exposedLvol bool
+}
+
+func (c *instanceConverter) progress(state string, copiedBytes int64, errMsg string) *rpc.InstanceConvertProgress {
+	progress := int32(0)
+	if c.totalBytes > 0 {
+		progress = int32(copiedBytes * 100 / c.totalBytes)
+	} else if state == InstanceConvertStateComplete {
+		progress = 100
+	}
+	return &rpc.InstanceConvertProgress{
+		Name:        c.req.Name,
+		State:       state,
+		TotalBytes:  c.totalBytes,
+		CopiedBytes: copiedBytes,
+		Progress:    progress,
+		ErrorMsg:    errMsg,
+	}
+}
+
+// checkSourceNotInUse makes sure that no v1 replica is running with the data directory, since its data would change
+// while copied.
+func (c *instanceConverter) checkSourceNotInUse() error {
+	instances := map[string]*rpc.InstanceResponse{}
+	if err := c.s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(c.ctx, instances); err != nil {
+		return err
+	}
+
+	sourcePath := filepath.Clean(c.req.SourcePath)
+	for name, instance := range instances {
+		state := instance.GetStatus().GetState()
+		if state == types.ProcessStateStopped || state == types.ProcessStateError {
+			continue
+		}
+		for _, arg := range instance.GetSpec().GetProcessInstanceSpec().GetArgs() {
+			if filepath.Clean(arg) == sourcePath {
+				return grpcstatus.Errorf(grpccodes.FailedPrecondition, "v1 replica %v is %v with %v", name, state, c.req.SourcePath)
+			}
+		}
+	}
+	return nil
+}
+
+// openSource opens the v1 replica read-only, including its backing image, and finds its data ranges.
+func (c *instanceConverter) openSource() (err error) {
+	if _, err := os.Stat(c.req.SourcePath); err != nil {
+		if os.IsNotExist(err) {
+			return grpcstatus.Errorf(grpccodes.NotFound, "cannot find v1 replica %v", c.req.SourcePath)
+		}
+		return grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	info, err := replica.ReadInfo(c.req.SourcePath)
+	if err != nil {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "failed to read the metadata of v1 replica %v: %v", c.req.SourcePath, err)
+	}
+	if info.Dirty {
+		logrus.Warnf("Converting v1 replica %v, which was not closed cleanly", c.req.SourcePath)
+	}
+
+	backingFile, err := backingfile.OpenBackingFile(info.BackingFilePath)
+	if err != nil {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "failed to open backing image %v of v1 replica %v: %v", info.BackingFilePath, c.req.SourcePath, err)
+	}
+	r, err := replica.NewReadOnly(c.req.SourcePath, info.Head, backingFile)
+	if err != nil {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "failed to open v1 replica %v: %v", c.req.SourcePath, err)
+	}
+	defer func() {
+		if err != nil {
+			if closeErr := r.Close(); closeErr != nil {
+				logrus.WithError(closeErr).Warnf("Failed to close v1 replica %v", c.req.SourcePath)
+			}
+		}
+	}()
+
+	if err := r.Preload(true); err != nil {
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to load the data layout of v1 replica %v: %v", c.req.SourcePath, err)
+	}
+	layout, _, err := r.GetDataLayout(c.ctx)
+	if err != nil {
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to get the data layout of v1 replica %v: %v", c.req.SourcePath, err)
+	}
+	for interval := range layout {
+		if interval.Kind != sparse.SparseData {
+			continue
+		}
+		c.intervals = append(c.intervals, interval.Interval)
+		c.totalBytes += interval.Len()
+	}
+	if err := c.ctx.Err(); err != nil {
+		return grpcstatus.FromContextError(err).Err()
+	}
+
+	c.source = r
+	c.sourceSize = info.Size
+	return nil
+}
+
+func (c *instanceConverter) createReplica() (*rpc.InstanceResponse, error) {
+	size := uint64(c.sourceSize)
+	if c.req.Size != 0 {
+		if c.req.Size < size {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "size %v is smaller than size %v of v1 replica %v", c.req.Size, size, c.req.SourcePath)
+		}
+		size = c.req.Size
+	}
+
+	resp, err := c.s.InstanceCreate(c.ctx, &rpc.InstanceCreateRequest{
+		Spec: &rpc.InstanceSpec{
+			Name:       c.req.Name,
+			Type:       types.InstanceTypeReplica,
+			VolumeName: c.req.VolumeName,
+			PortCount:  c.req.PortCount,
+			DataEngine: rpc.DataEngine_DATA_ENGINE_V2,
+			Labels:     c.req.Labels,
+			SpdkInstanceSpec: &rpc.SpdkInstanceSpec{
+				DiskName:       c.req.DiskName,
+				DiskUuid:       c.req.DiskUuid,
+				Size:           size,
+				ExposeRequired: c.req.ExposeRequired,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The SPDK service reports a failed creation by the state of the replica rather than an error
+	if resp.GetStatus().GetState() == types.ProcessStateError {
+		err := grpcstatus.Errorf(grpccodes.Internal, "failed to create v2 replica %v: %v", c.req.Name, resp.GetStatus().GetErrorMsg())
+		c.deleteReplica()
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *instanceConverter) deleteReplica() {
+	if _, err := c.s.InstanceDelete(context.Background(), &rpc.InstanceDeleteRequest{
+		Name:            c.req.Name,
+		Type:            types.InstanceTypeReplica,
+		DiskUuid:        c.req.DiskUuid,
+		CleanupRequired: true,
+		DataEngine:      rpc.DataEngine_DATA_ENGINE_V2,
+	}); err != nil {
+		logrus.WithError(err).Warnf("Failed to delete v2 replica %v after the failed conversion", c.req.Name)
+	}
+}
+
+// attach connects the host to the lvol of the v2 replica. The subsystem of the replica is connected to if it is
+// exposed, since the lvol cannot be exposed twice.
+func (c *instanceConverter) attach(resp *rpc.InstanceResponse) (device string, err error) {
+	ip, err := commonNet.GetIPForPod()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the pod IP")
+	}
+
+	c.deviceName = c.req.Name + replicaConvertSuffix
+	port := int(resp.GetStatus().GetPortStart())
+	if c.req.ExposeRequired && port != 0 {
+		c.nqn = helpertypes.GetNQN(c.req.Name)
+	} else {
+		spdkHelperClient, err := spdkhelperclient.NewClient(c.ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create SPDK helper client")
+		}
+		defer spdkHelperClient.Close()
+
+		if port, err = getFreePort(); err != nil {
+			return "", err
+		}
+		c.nqn = helpertypes.GetNQN(c.deviceName)
+		lvolName := c.req.DiskName + "/" + c.req.Name
+		if err := spdkHelperClient.StartExposeBdev(c.nqn, lvolName, ip, strconv.Itoa(port)); err != nil {
+			return "", errors.Wrapf(err, "failed to expose lvol %v", lvolName)
+		}
+		c.exposedLvol = true
+	}
+
+	initiator, err := helpernvme.NewInitiator(c.deviceName, c.nqn, helpernvme.HostProc)
+	if err != nil {
+		return "", err
+	}
+	if _, err := initiator.Start(ip, strconv.Itoa(port), false); err != nil {
+		return "", err
+	}
+	return initiator.GetEndpoint(), nil
+}
+
+func (c *instanceConverter) detach() error {
+	if c.nqn == "" {
+		return nil
+	}
+	if c.exposedLvol {
+		return detachLvolDevice(c.deviceName, c.nqn)
+	}
+	return disconnectLvolDevice(c.deviceName, c.nqn)
+}
+
+// copyData copies the data ranges of the v1 replica to the device. The holes are left alone, since a new lvol reads
+// zeroes.
+func (c *instanceConverter) copyData(device string) error {
+	f, err := openBlockDevice(device, os.O_WRONLY)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, instanceConvertChunkSize)
+	var copied, lastReported int64
+	for _, interval := range c.intervals {
+		for offset := interval.Begin; offset < interval.End; {
+			select {
+			case <-c.ctx.Done():
+				return errors.Wrapf(c.ctx.Err(), "copy interrupted after %v bytes", copied)
+			default:
+			}
+
+			chunk := int64(len(buf))
+			if interval.End-offset < chunk {
+				chunk = interval.End - offset
+			}
+			if _, err := c.source.ReadAt(buf[:chunk], offset); err != nil {
+				return errors.Wrapf(err, "failed to read v1 replica at offset %v", offset)
+			}
+			if _, err := f.WriteAt(buf[:chunk], offset); err != nil {
+				return errors.Wrapf(err, "failed to write v2 replica at offset %v", offset)
+			}
+			offset += chunk
+			copied += chunk
+
+			if copied-lastReported >= instanceConvertProgressInterval {
+				lastReported = copied
+				if err := c.send(c.progress(InstanceConvertStateCopying, copied, "")); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return f.Sync()
+}
+
+// Run converts the replica and streams the progress. The final message always carries either the complete or the
+// error state, except when the request is invalid.
+func (c *instanceConverter) Run() (err error) {
+	if err := c.checkSourceNotInUse(); err != nil {
+		return err
+	}
+	if err := c.openSource(); err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := c.source.Close(); closeErr != nil {
+			logrus.WithError(closeErr).Warnf("Failed to close v1 replica %v", c.req.SourcePath)
+		}
+	}()
+
+	if err := c.send(c.progress(InstanceConvertStateCreating, 0, "")); err != nil {
+		return err
+	}
+	resp, err := c.createReplica()
+	if err != nil {
+		return c.fail(err)
+	}
+
+	if err := c.send(c.progress(InstanceConvertStateCopying, 0, "")); err != nil {
+		c.deleteReplica()
+		return err
+	}
+	device, err := c.attach(resp)
+	if err == nil {
+		err = c.copyData(device)
+	}
+	if detachErr := c.detach(); detachErr != nil {
+		if err == nil {
+			err = errors.Wrapf(detachErr, "failed to detach v2 replica %v", c.req.Name)
+		} else {
+			logrus.WithError(detachErr).Warnf("Failed to detach v2 replica %v after the failed conversion", c.req.Name)
+		}
+	}
+	if err != nil {
+		c.deleteReplica()
+		return c.fail(grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to copy v1 replica %v to v2 replica %v", c.req.SourcePath, c.req.Name).Error()))
+	}
+
+	complete := c.progress(InstanceConvertStateComplete, c.totalBytes, "")
+	complete.Instance = resp
+	return c.send(complete)
+}
+
+func (c *instanceConverter) fail(err error) error {
+	if sendErr := c.send(c.progress(InstanceConvertStateError, 0, err.Error())); sendErr != nil {
+		return errors.Wrapf(err, "failed to send conversion progress: %v", sendErr)
+	}
+	return err
+}
+
+// InstanceConvert converts a v1 replica to a v2 one, so that a volume can be moved to the v2 data engine through the
+// instance manager alone. The v1 replica must not be running.
+func (s *Server) InstanceConvert(req *rpc.InstanceConvertRequest, srv rpc.InstanceService_InstanceConvertServer) (err error) {
+	logrus.WithFields(logrus.Fields{
+		"sourcePath": req.SourcePath,
+		"name":       req.Name,
+		"volumeName": req.VolumeName,
+		"diskName":   req.DiskName,
+		"size":       req.Size,
+	}).Info("Converting v1 replica to v2")
+
+	violations := util.FieldViolations{}
+	if req.SourcePath == "" {
+		violations.Add("source_path", "source path is required")
+	} else if !filepath.IsAbs(req.SourcePath) {
+		violations.Add("source_path", "source path %v is not absolute", req.SourcePath)
+	}
+	if req.Name == "" {
+		violations.Add("name", "name is required")
+	}
+	if req.DiskName == "" {
+		violations.Add("disk_name", "disk name is required")
+	}
+	if req.PortCount < 0 {
+		violations.Add("port_count", "invalid port count %v", req.PortCount)
+	}
+	if err := violations.Err(); err != nil {
+		return err
+	}
+	if !s.v2DataEngineEnabled {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "data engine %v is not enabled", rpc.DataEngine_DATA_ENGINE_V2)
+	}
+	if err := s.spdkGate.check(); err != nil {
+		return err
+	}
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return err
+	}
+	if err := s.drain.checkAdmission(); err != nil {
+		return err
+	}
+	done := s.drain.startOperation()
+	defer done()
+
+	defer func() {
+		s.operations.Record(req.Name, types.InstanceOperationConvert, fmt.Sprintf("sourcePath=%v", req.SourcePath), err)
+	}()
+
+	converter := &instanceConverter{
+		s:    s,
+		ctx:  srv.Context(),
+		req:  req,
+		send: srv.Send,
+	}
+	return converter.Run()
+}
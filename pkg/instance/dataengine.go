@@ -0,0 +1,61 @@
+package instance
+
+import (
+	"context"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// getInstanceOfAnyDataEngine looks up the instance in both data engines, for the callers that only know its name. A
+// v2 instance of an empty type is looked up as an engine and then as a replica. An instance found in both data
+// engines is ambiguous, since the caller cannot tell which one it meant.
+func (s *Server) getInstanceOfAnyDataEngine(ctx context.Context, name, instanceType string) (*rpc.InstanceResponse, error) {
+	found := []*rpc.InstanceResponse{}
+
+	resp, err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceGet(ctx, &rpc.InstanceGetRequest{
+		Name:       name,
+		Type:       instanceType,
+		DataEngine: rpc.DataEngine_DATA_ENGINE_V1,
+	})
+	if err != nil && grpcstatus.Code(err) != grpccodes.NotFound {
+		return nil, err
+	}
+	if err == nil {
+		found = append(found, resp)
+	}
+
+	if s.v2DataEngineEnabled {
+		instanceTypes := []string{instanceType}
+		if instanceType == "" {
+			instanceTypes = []string{types.InstanceTypeEngine, types.InstanceTypeReplica}
+		}
+		for _, t := range instanceTypes {
+			resp, err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceGet(ctx, &rpc.InstanceGetRequest{
+				Name:       name,
+				Type:       t,
+				DataEngine: rpc.DataEngine_DATA_ENGINE_V2,
+			})
+			if err != nil && grpcstatus.Code(err) != grpccodes.NotFound {
+				return nil, err
+			}
+			if err == nil {
+				found = append(found, resp)
+				break
+			}
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find instance %v in any data engine", name)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "instance %v exists in both data engines, the data engine is required", name)
+	}
+}
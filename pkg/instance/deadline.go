@@ -0,0 +1,58 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+)
+
+type unaryResult struct {
+	resp interface{}
+	err  error
+}
+
+// UnaryServerInterceptor bounds each unary call of the instance service by OperationTimeout, or by the deadline of
+// the caller if it is earlier. The handler keeps running in the background after the timeout, since the SPDK client
+// does not take a context and a hung backend would otherwise wedge the call forever. The streaming calls, e.g. the
+// watches and the logs, are not bounded, and neither is the drain, which has a timeout of its own. Each call is
+// tracked as an operation in flight for the drain as well. The calls of the v2 data engine are rejected until the
+// SPDK startup gate opens.
+func (s *Server) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if dataEngine, ok := getRequestDataEngine(req); ok {
+			if err := s.spdkGate.checkDataEngine(dataEngine); err != nil {
+				return nil, err
+			}
+		}
+		handler = s.drain.trackUnaryHandler(info, handler)
+
+		timeout := s.OperationTimeout
+		if timeout <= 0 || info.FullMethod == instanceServiceDrainMethod {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		resultCh := make(chan unaryResult, 1)
+		go func() {
+			defer cancel()
+			resp, err := handler(ctx, req)
+			resultCh <- unaryResult{resp: resp, err: err}
+		}()
+
+		select {
+		case result := <-resultCh:
+			return result.resp, result.err
+		case <-ctx.Done():
+			if ctx.Err() == context.Canceled {
+				return nil, grpcstatus.Errorf(grpccodes.Canceled, "%v was canceled by the caller", info.FullMethod)
+			}
+			logrus.Warnf("%s: %v did not finish in %v, a backend may be hung", types.InstanceGrpcService, info.FullMethod, timeout)
+			return nil, grpcstatus.Errorf(grpccodes.DeadlineExceeded, "%v did not finish in %v", info.FullMethod, timeout)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestUnaryServerInterceptor(c *C) {
+	server := &Server{
+		OperationTimeout: 100 * time.Millisecond,
+		drain:            newInstanceDrain(),
+	}
+	interceptor := server.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/imrpc.InstanceService/InstanceGet"}
+
+	// The call within the timeout gets the result of the handler
+	resp, err := interceptor(context.Background(), &rpc.InstanceGetRequest{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "done", nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp, Equals, "done")
+
+	// The hung handler fails the call, and is still counted as an operation in flight until it returns
+	releaseCh := make(chan struct{})
+	returnedCh := make(chan struct{})
+	hung := func(ctx context.Context, req interface{}) (interface{}, error) {
+		defer close(returnedCh)
+		<-releaseCh
+		return nil, nil
+	}
+	_, err = interceptor(context.Background(), &rpc.InstanceGetRequest{}, info, hung)
+	c.Assert(grpcstatus.Code(err), Equals, grpccodes.DeadlineExceeded)
+	c.Assert(server.drain.wait(0).Operations, Equals, int32(1))
+	close(releaseCh)
+	<-returnedCh
+	c.Assert(server.drain.wait(time.Second).Drained, Equals, true)
+
+	// The earlier deadline of the caller applies, and so does its cancellation
+	blocked := func(ctx context.Context, req interface{}) (interface{}, error) {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return nil, ctx.Err()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = interceptor(ctx, &rpc.InstanceGetRequest{}, info, blocked)
+	c.Assert(grpcstatus.Code(err), Equals, grpccodes.Canceled)
+
+	// The drain is not bounded by the timeout
+	drainInfo := &grpc.UnaryServerInfo{FullMethod: instanceServiceDrainMethod}
+	resp, err = interceptor(context.Background(), &rpc.InstanceServiceDrainRequest{}, drainInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, hasDeadline := ctx.Deadline()
+		c.Check(hasDeadline, Equals, false)
+		time.Sleep(2 * server.OperationTimeout)
+		return "drained", nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp, Equals, "drained")
+}
+
+func (s *TestSuite) TestUnaryServerInterceptorSPDKStartupGate(c *C) {
+	server := &Server{
+		drain:    newInstanceDrain(),
+		spdkGate: newSPDKStartupGate(),
+	}
+	interceptor := server.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/imrpc.InstanceService/InstanceCreate"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "created", nil
+	}
+
+	testCases := []struct {
+		req  interface{}
+		code grpccodes.Code
+	}{
+		{&rpc.InstanceCreateRequest{Spec: &rpc.InstanceSpec{DataEngine: rpc.DataEngine_DATA_ENGINE_V2}}, grpccodes.Unavailable},
+		{&rpc.InstanceDeleteRequest{DataEngine: rpc.DataEngine_DATA_ENGINE_V2}, grpccodes.Unavailable},
+		{&rpc.InstanceCreateRequest{Spec: &rpc.InstanceSpec{DataEngine: rpc.DataEngine_DATA_ENGINE_V1}}, grpccodes.OK},
+		{&rpc.InstanceCreateRequest{}, grpccodes.OK},
+	}
+	for i, testCase := range testCases {
+		_, err := interceptor(context.Background(), testCase.req, info, handler)
+		c.Assert(grpcstatus.Code(err), Equals, testCase.code, Commentf("test case %v: request %+v", i, testCase.req))
+	}
+
+	// Nothing is rejected once the gate opens
+	server.spdkGate.lock.Lock()
+	server.spdkGate.open = true
+	server.spdkGate.lock.Unlock()
+	_, err := interceptor(context.Background(), testCases[0].req, info, handler)
+	c.Assert(err, IsNil)
+}
@@ -0,0 +1,181 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+)
+
+const (
+	// DefaultDrainTimeout is the time to drain for on SIGTERM. The instance manager exits right away by default
+	DefaultDrainTimeout time.Duration = 0
+	// defaultServiceDrainTimeout is the time to drain for by InstanceServiceDrain without a timeout
+	defaultServiceDrainTimeout = 30 * time.Second
+	maxDrainTimeout            = 30 * time.Minute
+	// drainExitDelay is the time the response of InstanceServiceDrain is given to reach the caller before the
+	// instance manager exits
+	drainExitDelay = time.Second
+
+	instanceServiceDrainMethod = "/imrpc.InstanceService/InstanceServiceDrain"
+)
+
+// instanceDrain tracks the unary operations and the log streams in flight, so that the instance manager exits only
+// once they finish rather than aborting them, e.g. an engine creation in the middle of a rolling upgrade.
+type instanceDrain struct {
+	lock *sync.Mutex
+
+	draining      bool
+	drainingSince time.Time
+	operations    int
+	logStreams    int
+	// changedCh is closed and replaced each time an operation or log stream finishes
+	changedCh chan struct{}
+}
+
+func newInstanceDrain() *instanceDrain {
+	return &instanceDrain{
+		lock:      &sync.Mutex{},
+		changedCh: make(chan struct{}),
+	}
+}
+
+// start begins the drain. Draining again keeps the time the first drain began.
+func (d *instanceDrain) start() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if !d.draining {
+		d.draining = true
+		d.drainingSince = time.Now()
+	}
+}
+
+func (d *instanceDrain) isDraining() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.draining
+}
+
+// checkAdmission rejects the new instances once draining, so that the caller retries on another instance manager
+// or once this one is back.
+func (d *instanceDrain) checkAdmission() error {
+	if d.isDraining() {
+		return grpcstatus.Error(grpccodes.Unavailable, "instance manager is draining, cannot create new instances")
+	}
+	return nil
+}
+
+func (d *instanceDrain) startOperation() func() {
+	return d.track(&d.operations)
+}
+
+func (d *instanceDrain) startLogStream() func() {
+	return d.track(&d.logStreams)
+}
+
+func (d *instanceDrain) track(counter *int) func() {
+	d.lock.Lock()
+	*counter++
+	d.lock.Unlock()
+
+	once := &sync.Once{}
+	return func() {
+		once.Do(func() {
+			d.lock.Lock()
+			defer d.lock.Unlock()
+
+			*counter--
+			close(d.changedCh)
+			d.changedCh = make(chan struct{})
+		})
+	}
+}
+
+// wait waits for the operations and log streams in flight to finish, or for the timeout.
+func (d *instanceDrain) wait(timeout time.Duration) *rpc.InstanceServiceDrainResponse {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		d.lock.Lock()
+		resp := &rpc.InstanceServiceDrainResponse{
+			Drained:       d.operations == 0 && d.logStreams == 0,
+			Operations:    int32(d.operations),
+			LogStreams:    int32(d.logStreams),
+			DrainingSince: d.drainingSince.UTC().Format(time.RFC3339),
+		}
+		changedCh := d.changedCh
+		d.lock.Unlock()
+
+		if resp.Drained {
+			return resp
+		}
+		select {
+		case <-changedCh:
+		case <-timer.C:
+			return resp
+		}
+	}
+}
+
+// trackUnaryHandler counts the call as an operation in flight until the handler returns, which may be after the
+// interceptor gives up on it. The drain itself is not counted, since it would wait for itself.
+func (d *instanceDrain) trackUnaryHandler(info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	if info.FullMethod == instanceServiceDrainMethod {
+		return handler
+	}
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		done := d.startOperation()
+		defer done()
+		return handler(ctx, req)
+	}
+}
+
+// Drain rejects the new instance creations, and waits for the operations and log streams in flight to finish, or
+// for the timeout. The instance manager is left running.
+func (s *Server) Drain(timeout time.Duration) *rpc.InstanceServiceDrainResponse {
+	s.drain.start()
+	logrus.Infof("%s: draining, waiting up to %v for the operations and log streams in flight", types.InstanceGrpcService, timeout)
+
+	resp := s.drain.wait(timeout)
+	if resp.Drained {
+		logrus.Infof("%s: drained", types.InstanceGrpcService)
+	} else {
+		logrus.Warnf("%s: %v operations and %v log streams are still running after draining for %v",
+			types.InstanceGrpcService, resp.Operations, resp.LogStreams, timeout)
+	}
+	return resp
+}
+
+func (s *Server) InstanceServiceDrain(ctx context.Context, req *rpc.InstanceServiceDrainRequest) (*rpc.InstanceServiceDrainResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"timeoutSeconds": req.TimeoutSeconds,
+		"noExit":         req.NoExit,
+	}).Info("Draining instance service")
+
+	timeout := defaultServiceDrainTimeout
+	if req.TimeoutSeconds < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid timeout %vs", req.TimeoutSeconds)
+	}
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+	if timeout > maxDrainTimeout {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "timeout %v exceeds the maximum %v", timeout, maxDrainTimeout)
+	}
+
+	resp := s.Drain(timeout)
+	if !req.NoExit && s.Exit != nil {
+		time.AfterFunc(drainExitDelay, s.Exit)
+	}
+	return resp, nil
+}
@@ -0,0 +1,172 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const instanceHealthCheckTimeout = 10 * time.Second
+
+// InstanceHealthCheck probes the instance right away rather than reporting the state cached since its last change,
+// for the external probes to tell whether the instance actually serves. An unreachable instance is reported in the
+// response, so the call fails only if the instance cannot be found.
+func (s *Server) InstanceHealthCheck(ctx context.Context, req *rpc.InstanceHealthCheckRequest) (*rpc.InstanceHealthCheckResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"type":       req.Type,
+		"dataEngine": req.DataEngine,
+		"ioProbe":    req.IoProbe,
+	}).Trace("Checking instance health")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "name is required")
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	return ops.InstanceHealthCheck(ctx, req)
+}
+
+func (ops V1DataEngineInstanceOps) InstanceHealthCheck(ctx context.Context, req *rpc.InstanceHealthCheckRequest) (*rpc.InstanceHealthCheckResponse, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	process, err := pmClient.ProcessGet(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpc.InstanceHealthCheckResponse{
+		Name:   req.Name,
+		State:  process.Status.State,
+		Probes: []string{types.InstanceHealthProbeGRPC},
+	}
+	if process.Status.PortStart == 0 {
+		resp.ErrorMsg = fmt.Sprintf("instance %v has no port to probe", req.Name)
+		return resp, nil
+	}
+
+	// The gRPC service of the longhorn binary is at the first port, for an engine and a replica alike
+	address := util.GetURL("localhost", int(process.Status.PortStart))
+	healthChecker := &GRPCHealthChecker{}
+	return runInstanceHealthCheck(ctx, resp, func() error {
+		if !healthChecker.IsRunning(address) {
+			return fmt.Errorf("gRPC health check of %v failed", address)
+		}
+		return nil
+	}), nil
+}
+
+func (ops V2DataEngineInstanceOps) InstanceHealthCheck(ctx context.Context, req *rpc.InstanceHealthCheckRequest) (*rpc.InstanceHealthCheckResponse, error) {
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	resp := &rpc.InstanceHealthCheckResponse{
+		Name:   req.Name,
+		Probes: []string{types.InstanceHealthProbeSPDKBdev},
+	}
+	bdevName := ""
+	device := ""
+	switch req.Type {
+	case types.InstanceTypeEngine:
+		engine, err := c.EngineGet(req.Name)
+		if err != nil {
+			return nil, err
+		}
+		resp.State = engine.State
+		// The RAID bdev of an engine is named after it
+		bdevName = engine.Name
+		if req.IoProbe {
+			if engine.Frontend != spdktypes.FrontendSPDKTCPBlockdev || !strings.HasPrefix(engine.Endpoint, "/dev/") {
+				return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v has no local frontend device", req.Name)
+			}
+			device = engine.Endpoint
+			resp.Probes = append(resp.Probes, types.InstanceHealthProbeIO)
+		}
+	case types.InstanceTypeReplica:
+		replica, err := c.ReplicaGet(req.Name)
+		if err != nil {
+			return nil, err
+		}
+		resp.State = replica.State
+		bdevName = replica.LvsName + "/" + replica.Name
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "unknown instance type %v", req.Type)
+	}
+
+	return runInstanceHealthCheck(ctx, resp, func() error {
+		if err := chaos.CheckSPDKAvailable(); err != nil {
+			return err
+		}
+
+		spdkHelperClient, err := spdkhelperclient.NewClient(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to create SPDK helper client")
+		}
+		defer spdkHelperClient.Close()
+
+		bdevs, err := spdkHelperClient.BdevGetBdevs(bdevName, 0)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get bdev %v", bdevName)
+		}
+		if len(bdevs) == 0 {
+			return fmt.Errorf("cannot find bdev %v", bdevName)
+		}
+
+		if device != "" {
+			if _, _, err := probeDeviceLatency(device, 1, false, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// runInstanceHealthCheck runs the probes of the instance, and reports the instance unreachable if they fail or do
+// not finish in time, e.g. on a hung spdk_tgt or device.
+func runInstanceHealthCheck(ctx context.Context, resp *rpc.InstanceHealthCheckResponse, probe func() error) *rpc.InstanceHealthCheckResponse {
+	ctx, cancel := context.WithTimeout(ctx, instanceHealthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- probe()
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		err = fmt.Errorf("probes of instance %v did not finish in %v", resp.Name, time.Since(start).Round(time.Millisecond))
+	case err = <-errCh:
+	}
+
+	resp.LatencyNs = int64(time.Since(start))
+	resp.Reachable = err == nil
+	if err != nil {
+		resp.ErrorMsg = err.Error()
+	}
+	return resp
+}
@@ -0,0 +1,108 @@
+package instance
+
+import (
+	"sync"
+	"time"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	// instanceCreateTokenTTL is how long the response of a creation is kept for the retries with the same token
+	instanceCreateTokenTTL = 10 * time.Minute
+	// maxInstanceCreateTokens caps the remembered tokens, so that the clients generating a token per request
+	// cannot grow the cache unbounded. The oldest tokens are forgotten first.
+	maxInstanceCreateTokens = 4096
+)
+
+type instanceCreateTokenEntry struct {
+	spec *rpc.InstanceSpec
+	// done is closed once the creation completes
+	done      chan struct{}
+	resp      *rpc.InstanceResponse
+	err       error
+	expiresAt time.Time
+}
+
+// instanceCreateTokenCache dedupes the retries of InstanceCreate carrying the same idempotency token. A retry of a
+// successful creation gets the original response, and a retry during the creation waits for it. The failed
+// creations are forgotten, so that they can be retried. The tokens are not persisted.
+type instanceCreateTokenCache struct {
+	lock    *sync.Mutex
+	entries map[string]*instanceCreateTokenEntry
+}
+
+func newInstanceCreateTokenCache() *instanceCreateTokenCache {
+	return &instanceCreateTokenCache{
+		lock:    &sync.Mutex{},
+		entries: map[string]*instanceCreateTokenEntry{},
+	}
+}
+
+// do runs the creation unless the token is already used by a successful or an ongoing creation of the same spec.
+func (c *instanceCreateTokenCache) do(token string, spec *rpc.InstanceSpec, create func() (*rpc.InstanceResponse, error)) (*rpc.InstanceResponse, error) {
+	if token == "" {
+		return create()
+	}
+
+	c.lock.Lock()
+	c.expire()
+	if entry, exists := c.entries[token]; exists {
+		c.lock.Unlock()
+		if !proto.Equal(entry.spec, spec) {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "idempotency token %v is already used by the creation of a different spec of instance %v", token, entry.spec.GetName())
+		}
+		<-entry.done
+		if entry.err != nil {
+			return nil, entry.err
+		}
+		return entry.resp, nil
+	}
+	entry := &instanceCreateTokenEntry{
+		spec: proto.Clone(spec).(*rpc.InstanceSpec),
+		done: make(chan struct{}),
+	}
+	c.entries[token] = entry
+	c.lock.Unlock()
+
+	resp, err := create()
+
+	c.lock.Lock()
+	entry.resp = resp
+	entry.err = err
+	entry.expiresAt = time.Now().Add(instanceCreateTokenTTL)
+	if err != nil {
+		delete(c.entries, token)
+	}
+	c.lock.Unlock()
+	close(entry.done)
+
+	return resp, err
+}
+
+// expire forgets the expired tokens and the oldest ones beyond the limit. The ongoing creations are kept. It is
+// called with the lock held.
+func (c *instanceCreateTokenCache) expire() {
+	now := time.Now()
+	oldestToken := ""
+	var oldest *instanceCreateTokenEntry
+	for token, entry := range c.entries {
+		if entry.expiresAt.IsZero() {
+			continue
+		}
+		if now.After(entry.expiresAt) {
+			delete(c.entries, token)
+			continue
+		}
+		if oldest == nil || entry.expiresAt.Before(oldest.expiresAt) {
+			oldestToken, oldest = token, entry
+		}
+	}
+	if oldest != nil && len(c.entries) >= maxInstanceCreateTokens {
+		delete(c.entries, oldestToken)
+	}
+}
@@ -0,0 +1,117 @@
+package instance
+
+import (
+	"fmt"
+	"time"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestInstanceCreateTokenCache(c *C) {
+	cache := newInstanceCreateTokenCache()
+	spec := &rpc.InstanceSpec{Name: "vol-e-0", Type: "engine"}
+
+	creations := 0
+	create := func() (*rpc.InstanceResponse, error) {
+		creations++
+		return &rpc.InstanceResponse{Spec: spec, Status: &rpc.InstanceStatus{State: fmt.Sprintf("created-%v", creations)}}, nil
+	}
+	fail := func() (*rpc.InstanceResponse, error) {
+		creations++
+		return nil, grpcstatus.Error(grpccodes.Internal, "failed to create")
+	}
+
+	testCases := []struct {
+		comment   string
+		token     string
+		spec      *rpc.InstanceSpec
+		create    func() (*rpc.InstanceResponse, error)
+		code      grpccodes.Code
+		state     string
+		creations int
+	}{
+		{"first creation", "token-0", spec, create, grpccodes.OK, "created-1", 1},
+		{"retry gets the original response", "token-0", spec, create, grpccodes.OK, "created-1", 1},
+		{"retry with a different spec", "token-0", &rpc.InstanceSpec{Name: "vol-e-1", Type: "engine"}, create, grpccodes.InvalidArgument, "", 1},
+		{"no token is never deduped", "", spec, create, grpccodes.OK, "created-2", 2},
+		{"no token is never deduped again", "", spec, create, grpccodes.OK, "created-3", 3},
+		{"failed creation", "token-1", spec, fail, grpccodes.Internal, "", 4},
+		{"failed creation is retried", "token-1", spec, create, grpccodes.OK, "created-5", 5},
+		{"retry of the retried creation", "token-1", spec, fail, grpccodes.OK, "created-5", 5},
+	}
+	for i, testCase := range testCases {
+		comment := Commentf("test case %v: %v", i, testCase.comment)
+		resp, err := cache.do(testCase.token, testCase.spec, testCase.create)
+		c.Assert(grpcstatus.Code(err), Equals, testCase.code, comment)
+		if testCase.code == grpccodes.OK {
+			c.Assert(resp.Status.State, Equals, testCase.state, comment)
+		}
+		c.Assert(creations, Equals, testCase.creations, comment)
+	}
+
+	// The expired token is forgotten
+	cache.lock.Lock()
+	cache.entries["token-0"].expiresAt = time.Now().Add(-time.Second)
+	cache.lock.Unlock()
+	resp, err := cache.do("token-0", spec, create)
+	c.Assert(err, IsNil)
+	c.Assert(resp.Status.State, Equals, "created-6")
+}
+
+func (s *TestSuite) TestInstanceCreateTokenCacheOngoing(c *C) {
+	cache := newInstanceCreateTokenCache()
+	spec := &rpc.InstanceSpec{Name: "vol-e-0", Type: "engine"}
+
+	startedCh, releaseCh := make(chan struct{}), make(chan struct{})
+	go func() {
+		_, _ = cache.do("token-0", spec, func() (*rpc.InstanceResponse, error) {
+			close(startedCh)
+			<-releaseCh
+			return &rpc.InstanceResponse{Spec: spec, Status: &rpc.InstanceStatus{State: "created"}}, nil
+		})
+	}()
+	<-startedCh
+
+	// The retry during the creation waits for it rather than creating again
+	respCh := make(chan *rpc.InstanceResponse, 1)
+	go func() {
+		resp, err := cache.do("token-0", spec, func() (*rpc.InstanceResponse, error) {
+			c.Error("the retry must not create the instance again")
+			return nil, nil
+		})
+		c.Check(err, IsNil)
+		respCh <- resp
+	}()
+	select {
+	case <-respCh:
+		c.Fatal("the retry returned during the creation")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(releaseCh)
+	select {
+	case resp := <-respCh:
+		c.Assert(resp.Status.State, Equals, "created")
+	case <-time.After(5 * time.Second):
+		c.Fatal("timed out waiting for the retry")
+	}
+}
+
+func (s *TestSuite) TestInstanceCreateTokenCacheLimit(c *C) {
+	cache := newInstanceCreateTokenCache()
+	create := func() (*rpc.InstanceResponse, error) {
+		return &rpc.InstanceResponse{}, nil
+	}
+
+	for i := 0; i < maxInstanceCreateTokens+10; i++ {
+		_, err := cache.do(fmt.Sprintf("token-%v", i), &rpc.InstanceSpec{Name: fmt.Sprintf("vol-e-%v", i)}, create)
+		c.Assert(err, IsNil)
+	}
+	c.Assert(len(cache.entries) <= maxInstanceCreateTokens, Equals, true)
+	_, exists := cache.entries[fmt.Sprintf("token-%v", maxInstanceCreateTokens+9)]
+	c.Assert(exists, Equals, true)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"reflect"
 	"time"
 
 	"github.com/pkg/errors"
@@ -11,14 +12,20 @@ import (
 	"golang.org/x/sync/errgroup"
 	grpccodes "google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
 	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
 	"github.com/longhorn/longhorn-instance-manager/pkg/client"
+	"github.com/longhorn/longhorn-instance-manager/pkg/disk"
 	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
 	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util/broadcaster"
 
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
@@ -26,40 +33,126 @@ import (
 const (
 	maxMonitorRetryCount     = 10
 	monitorRetryPollInterval = 1 * time.Second
+
+	spdkTgtProcessName = "spdk_tgt"
 )
 
 type InstanceOps interface {
-	InstanceCreate(*rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error)
-	InstanceDelete(*rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error)
-	InstanceGet(*rpc.InstanceGetRequest) (*rpc.InstanceResponse, error)
-	InstanceList(map[string]*rpc.InstanceResponse) error
-	InstanceReplace(*rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error)
+	InstanceCreate(context.Context, *rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error)
+	InstanceCreateValidate(context.Context, *rpc.InstanceSpec, *util.FieldViolations) error
+	InstanceDelete(context.Context, *rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error)
+	InstanceGet(context.Context, *rpc.InstanceGetRequest) (*rpc.InstanceResponse, error)
+	InstanceRefresh(context.Context, *rpc.InstanceRefreshRequest) (*rpc.InstanceResponse, error)
+	InstanceList(context.Context, map[string]*rpc.InstanceResponse) error
+	InstanceReplace(context.Context, *rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error)
+	InstanceSuspend(context.Context, *rpc.InstanceSuspendRequest) (*rpc.InstanceResponse, error)
+	InstanceResume(context.Context, *rpc.InstanceResumeRequest) (*rpc.InstanceResponse, error)
 	InstanceLog(*rpc.InstanceLogRequest, rpc.InstanceService_InstanceLogServer) error
+	InstanceLogStream(context.Context, string) (<-chan *util.LogLine, error)
+	InstanceLatencyProbe(context.Context, *rpc.InstanceLatencyProbeRequest) (*rpc.InstanceLatencyProbeResponse, error)
+	InstanceHealthCheck(context.Context, *rpc.InstanceHealthCheckRequest) (*rpc.InstanceHealthCheckResponse, error)
+	InstanceIOTimeoutSet(context.Context, *rpc.InstanceIOTimeoutSetRequest) (*rpc.InstanceIOTimeoutResponse, error)
+	InstanceIOTimeoutGet(context.Context, *rpc.InstanceIOTimeoutGetRequest) (*rpc.InstanceIOTimeoutResponse, error)
+	InstanceReadPreferenceSet(context.Context, *rpc.InstanceReadPreferenceSetRequest) (*rpc.InstanceResponse, error)
 }
 
 type V1DataEngineInstanceOps struct {
 	processManagerServiceAddress string
+	clients                      *backendClientPool
 }
 type V2DataEngineInstanceOps struct {
 	spdkServiceAddress string
+	clients            *backendClientPool
+	safeModeDisks      *disk.SafeModeTracker
+	suspendedEngines   *engineSuspendTracker
+	labels             *instanceLabelStore
+	ioTimeouts         *engineIOTimeoutTracker
+	readPreferences    *engineReadPreferenceTracker
+	targets            *engineTargetTracker
+	priorities         *instancePriorityTracker
+	nvmeTCPListeners   *nvmeTCPListenerTracker
+	// spdkTgtLogPath is the log file of spdk_tgt, which the logs of the v2 instances are filtered from
+	spdkTgtLogPath string
 }
 
 type Server struct {
 	ctx           context.Context
 	logsDir       string
 	HealthChecker HealthChecker
+	// WatchCoalescingWindow is the minimum interval between the notifications sent to a watch client. The changes
+	// within the window are sent as one notification at the end of it. Not rate-limited if 0.
+	WatchCoalescingWindow time.Duration
+	// StuckInstanceTimeout is the time an instance stays starting or stopping for before it is reported in the error
+	// state. Never reported if 0.
+	StuckInstanceTimeout time.Duration
+	// OperationTimeout bounds each unary call of the service, so that a hung backend fails the call rather than
+	// wedging it. Not bounded if 0.
+	OperationTimeout time.Duration
+	// Disks is the disk service the disks of the node state are exported from and imported into. The node state has
+	// no disks if it is nil.
+	Disks DiskStateService
+	// Exit has the instance manager exit once drained by InstanceServiceDrain. The instance manager keeps running if
+	// it is nil.
+	Exit func()
+	// SPDKPortRangeStart and SPDKPortRangeEnd are the port range of the v2 instances, which PortsGet reports the free
+	// ports within. No free ports are reported for the v2 instances if they are 0.
+	SPDKPortRangeStart int32
+	SPDKPortRangeEnd   int32
 
 	v2DataEngineEnabled bool
 	ops                 map[rpc.DataEngine]InstanceOps
+	clients             *backendClientPool
+
+	networkStats *networkStatsTracker
+	operations   *util.OperationHistory
+	migrations   *engineMigrationTracker
+	spares       *replicaSpareTracker
+	taskQueue    *util.TaskQueue
+
+	readOnlyAttachments *replicaReadOnlyAttachmentTracker
+	limiter             *instanceLimiter
+	concurrency         *instanceOperationSemaphore
+	journal             *instanceJournal
+	createTokens        *instanceCreateTokenCache
+	ioTimeouts          *engineIOTimeoutTracker
+	stuckInstances      *instanceStuckTracker
+	watchFreeze         *watchFreeze
+	restarts            *instanceRestartTracker
+	uuids               *instanceUUIDTracker
+	priorities          *instancePriorityTracker
+	nvmeTCPListeners    *nvmeTCPListenerTracker
+	drain               *instanceDrain
+	spdkGate            *spdkStartupGate
+
+	// broadcaster notifies the instance watchers of the changes found by the instance server itself, e.g. by a
+	// refresh, in addition to the ones from the process manager and the SPDK service
+	broadcaster *broadcaster.Broadcaster
+	broadcastCh chan interface{}
 }
 
-func NewServer(ctx context.Context, logsDir, processManagerServiceAddress, spdkServiceAddress string, v2DataEngineEnabled bool) (*Server, error) {
+func NewServer(ctx context.Context, logsDir, processManagerServiceAddress, spdkServiceAddress string, v2DataEngineEnabled bool, safeModeDisks *disk.SafeModeTracker, operations *util.OperationHistory,
+	taskQueue *util.TaskQueue, spdkTgtLogPath string, limits *InstanceLimits) (*Server, error) {
+	clients := newBackendClientPool(processManagerServiceAddress, spdkServiceAddress)
+	ioTimeouts := newEngineIOTimeoutTracker()
+	priorities := newInstancePriorityTracker()
+	nvmeTCPListeners := newNvmeTCPListenerTracker(clients)
 	ops := map[rpc.DataEngine]InstanceOps{
 		rpc.DataEngine_DATA_ENGINE_V1: V1DataEngineInstanceOps{
 			processManagerServiceAddress: processManagerServiceAddress,
+			clients:                      clients,
 		},
 		rpc.DataEngine_DATA_ENGINE_V2: V2DataEngineInstanceOps{
 			spdkServiceAddress: spdkServiceAddress,
+			clients:            clients,
+			safeModeDisks:      safeModeDisks,
+			suspendedEngines:   newEngineSuspendTracker(),
+			labels:             newInstanceLabelStore(),
+			ioTimeouts:         ioTimeouts,
+			readPreferences:    newEngineReadPreferenceTracker(),
+			targets:            newEngineTargetTracker(getEngineTargetStatePath(logsDir)),
+			priorities:         priorities,
+			nvmeTCPListeners:   nvmeTCPListeners,
+			spdkTgtLogPath:     spdkTgtLogPath,
 		},
 	}
 
@@ -69,6 +162,33 @@ func NewServer(ctx context.Context, logsDir, processManagerServiceAddress, spdkS
 		v2DataEngineEnabled: v2DataEngineEnabled,
 		HealthChecker:       &GRPCHealthChecker{},
 		ops:                 ops,
+		clients:             clients,
+		networkStats:        newNetworkStatsTracker(),
+		operations:          operations,
+		migrations:          newEngineMigrationTracker(),
+		spares:              newReplicaSpareTracker(clients, safeModeDisks, taskQueue),
+		taskQueue:           taskQueue,
+		readOnlyAttachments: newReplicaReadOnlyAttachmentTracker(taskQueue),
+		limiter:             newInstanceLimiter(limits),
+		concurrency:         newInstanceOperationSemaphore(limits),
+		journal:             newInstanceJournal(),
+		createTokens:        newInstanceCreateTokenCache(),
+		ioTimeouts:          ioTimeouts,
+		stuckInstances:      newInstanceStuckTracker(),
+		watchFreeze:         newWatchFreeze(),
+		restarts:            newInstanceRestartTracker(),
+		uuids:               newInstanceUUIDTracker(),
+		priorities:          priorities,
+		nvmeTCPListeners:    nvmeTCPListeners,
+		drain:               newInstanceDrain(),
+		broadcaster:         &broadcaster.Broadcaster{},
+		broadcastCh:         make(chan interface{}),
+	}
+	// help to kickstart the broadcaster
+	c, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := s.broadcaster.Subscribe(c, s.broadcastConnector); err != nil {
+		return nil, err
 	}
 
 	go s.startMonitoring()
@@ -77,12 +197,39 @@ func NewServer(ctx context.Context, logsDir, processManagerServiceAddress, spdkS
 }
 
 func (s *Server) startMonitoring() {
+	ticker := time.NewTicker(networkStatsUpdateInterval)
+	defer ticker.Stop()
+	reconcileTicker := time.NewTicker(reconcileInterval)
+	defer reconcileTicker.Stop()
+	restartTicker := time.NewTicker(restartCheckInterval)
+	defer restartTicker.Stop()
+
 	done := false
 	for {
 		select {
 		case <-s.ctx.Done():
 			logrus.Infof("%s: stopped monitoring replicas due to the context done", types.InstanceGrpcService)
+			s.clients.close()
 			done = true
+		case <-ticker.C:
+			if err := s.updateNetworkStats(s.ctx); err != nil {
+				logrus.WithError(err).Warnf("%s: failed to update network stats of instances", types.InstanceGrpcService)
+			}
+			s.ioTimeouts.reapply()
+			if s.v2DataEngineEnabled {
+				s.priorities.reconcileSpdkTgt()
+			}
+		case <-reconcileTicker.C:
+			if err := s.reconcileInstances(s.ctx); err != nil {
+				logrus.WithError(err).Warnf("%s: failed to reconcile instances", types.InstanceGrpcService)
+			}
+			if s.v2DataEngineEnabled {
+				s.nvmeTCPListeners.reconcileAll()
+			}
+		case <-restartTicker.C:
+			if err := s.restartFailedInstances(s.ctx); err != nil {
+				logrus.WithError(err).Warnf("%s: failed to restart failed instances", types.InstanceGrpcService)
+			}
 		}
 		if done {
 			break
@@ -102,66 +249,180 @@ func (s *Server) VersionGet(ctx context.Context, req *emptypb.Empty) (*rpc.Versi
 
 		InstanceManagerProxyAPIVersion:    int64(v.InstanceManagerProxyAPIVersion),
 		InstanceManagerProxyAPIMinVersion: int64(v.InstanceManagerProxyAPIMinVersion),
+
+		Topology: api.TopologyToRPC(v.Topology),
 	}, nil
 }
 
 func (s *Server) InstanceCreate(ctx context.Context, req *rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error) {
 	logrus.WithFields(logrus.Fields{
-		"name":       req.Spec.Name,
-		"type":       req.Spec.Type,
-		"dataEngine": req.Spec.DataEngine,
+		"name":       req.Spec.GetName(),
+		"type":       req.Spec.GetType(),
+		"dataEngine": req.Spec.GetDataEngine(),
+		"token":      req.IdempotencyToken,
 	}).Info("Creating instance")
 
+	if req.ValidateOnly {
+		return s.validateInstanceCreate(ctx, req.Spec)
+	}
+	if err := s.drain.checkAdmission(); err != nil {
+		return nil, err
+	}
+
 	ops, ok := s.ops[req.Spec.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.Spec.DataEngine)
 	}
-	return ops.InstanceCreate(req)
+	if err := util.ValidateLabels(req.Spec.Labels); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if err := validateRestartPolicy(req.Spec); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if err := util.ValidatePriorityClass(req.Spec.PriorityClass); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	return s.createTokens.do(req.IdempotencyToken, req.Spec, func() (*rpc.InstanceResponse, error) {
+		release, err := s.limiter.admit(ctx, s, req.Spec)
+		if err != nil {
+			s.operations.Record(req.Spec.Name, types.InstanceOperationCreate, "", err)
+			return nil, err
+		}
+		defer release()
+
+		releaseSlot, err := s.concurrency.acquire(ctx, req.Spec.DataEngine, types.InstanceOperationCreate, req.Spec.Name)
+		if err != nil {
+			s.operations.Record(req.Spec.Name, types.InstanceOperationCreate, "", err)
+			return nil, err
+		}
+		defer releaseSlot()
+
+		resp, err := ops.InstanceCreate(ctx, req)
+		s.operations.Record(req.Spec.Name, types.InstanceOperationCreate, "", err)
+		if err != nil {
+			return nil, err
+		}
+		s.uuids.reset(req.Spec.Name)
+		s.restarts.set(req.Spec)
+		return s.uuids.apply(s.restarts.apply(resp)), nil
+	})
 }
 
-func (ops V1DataEngineInstanceOps) InstanceCreate(req *rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error) {
+func (ops V1DataEngineInstanceOps) InstanceCreate(ctx context.Context, req *rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error) {
 	if req.Spec.ProcessInstanceSpec == nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "ProcessInstanceSpec is required for longhorn data engine")
 	}
 
-	pmClient, err := client.NewProcessManagerClient("tcp://"+ops.processManagerServiceAddress, nil)
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
 	}
-	defer pmClient.Close()
 
-	process, err := pmClient.ProcessCreate(req.Spec.Name, req.Spec.ProcessInstanceSpec.Binary, int(req.Spec.PortCount), req.Spec.ProcessInstanceSpec.Args, req.Spec.PortArgs)
+	process, err := pmClient.ProcessCreateWithSpec(&rpc.ProcessSpec{
+		Name:      req.Spec.Name,
+		Binary:    req.Spec.ProcessInstanceSpec.Binary,
+		Args:      req.Spec.ProcessInstanceSpec.Args,
+		PortCount: req.Spec.PortCount,
+		PortArgs:  req.Spec.PortArgs,
+		Sidecars:  req.Spec.ProcessInstanceSpec.Sidecars,
+		Envs:      req.Spec.ProcessInstanceSpec.Envs,
+		Labels:    req.Spec.Labels,
+
+		PriorityClass:      req.Spec.PriorityClass,
+		CpuMillicores:      req.Spec.ProcessInstanceSpec.CpuMillicores,
+		MemoryBytes:        req.Spec.ProcessInstanceSpec.MemoryBytes,
+		CpuSet:             req.Spec.ProcessInstanceSpec.CpuSet,
+		NumaNode:           req.Spec.ProcessInstanceSpec.NumaNode,
+		Privileges:         req.Spec.ProcessInstanceSpec.Privileges,
+		StopTimeoutSeconds: req.Spec.ProcessInstanceSpec.StopTimeoutSeconds,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return processResponseToInstanceResponse(process), nil
 }
 
-func (ops V2DataEngineInstanceOps) InstanceCreate(req *rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error) {
-	c, err := spdkclient.NewSPDKClient(ops.spdkServiceAddress)
+func (ops V2DataEngineInstanceOps) InstanceCreate(ctx context.Context, req *rpc.InstanceCreateRequest) (*rpc.InstanceResponse, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	c, err := ops.clients.getSPDKClient()
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
 	}
-	defer c.Close()
+
+	if req.Spec.Type == types.InstanceTypeEngine {
+		if err := validateReadPreference(req.Spec.SpdkInstanceSpec.ReplicaAddressMap, req.Spec.SpdkInstanceSpec.PreferredReadReplica); err != nil {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+		}
+	}
+
+	if req.Spec.SpdkInstanceSpec.Adopt {
+		return ops.instanceAdopt(ctx, c, req.Spec)
+	}
 
 	switch req.Spec.Type {
 	case types.InstanceTypeEngine:
-		engine, err := c.EngineCreate(req.Spec.Name, req.Spec.VolumeName, req.Spec.SpdkInstanceSpec.Frontend, req.Spec.SpdkInstanceSpec.Size, req.Spec.SpdkInstanceSpec.ReplicaAddressMap, req.Spec.PortCount)
+		engine, err := createEngineWithRemediation(ctx, c, req.Spec)
 		if err != nil {
 			return nil, err
 		}
-		return engineResponseToInstanceResponse(engine), nil
+		ops.labels.set(engine.Name, req.Spec.Labels)
+		ops.priorities.set(engine.Name, req.Spec.PriorityClass)
+		ops.readPreferences.set(engine.Name, req.Spec.SpdkInstanceSpec.PreferredReadReplica)
+		resp := ops.priorities.apply(ops.labels.apply(engineResponseToInstanceResponse(engine)))
+		engines := map[string]*spdkapi.Engine{engine.Name: engine}
+		ops.nvmeTCPListeners.reconcile(nil, engines)
+		ops.nvmeTCPListeners.applyAddresses(nil, engines, map[string]*rpc.InstanceResponse{engine.Name: resp})
+		return resp, nil
 	case types.InstanceTypeReplica:
-		replica, err := c.ReplicaCreate(req.Spec.Name, req.Spec.SpdkInstanceSpec.DiskName, req.Spec.SpdkInstanceSpec.DiskUuid, req.Spec.SpdkInstanceSpec.Size, req.Spec.SpdkInstanceSpec.ExposeRequired, req.Spec.PortCount)
+		if err := ops.safeModeDisks.CheckWritable(req.Spec.SpdkInstanceSpec.DiskName); err != nil {
+			return nil, err
+		}
+		replica, err := createReplicaWithRemediation(c, req.Spec)
 		if err != nil {
 			return nil, err
 		}
-		return replicaResponseToInstanceResponse(replica), nil
+		ops.labels.set(replica.Name, req.Spec.Labels)
+		ops.priorities.set(replica.Name, req.Spec.PriorityClass)
+		resp := ops.priorities.apply(ops.labels.apply(replicaResponseToInstanceResponse(replica)))
+		replicas := map[string]*spdkapi.Replica{replica.Name: replica}
+		ops.nvmeTCPListeners.reconcile(replicas, nil)
+		ops.nvmeTCPListeners.applyAddresses(replicas, nil, map[string]*rpc.InstanceResponse{replica.Name: resp})
+		return resp, nil
 	default:
 		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "unknown instance type %v", req.Spec.Type)
 	}
 }
 
+// createEngine creates the engine with the replica addresses resolved, since the SPDK engine connects to the
+// replicas by IPs only. If the creation fails and any replica address resolves differently from the first time,
+// e.g. the replica is rescheduled, the failed engine is deleted and the creation is retried with the new addresses.
+func createEngine(ctx context.Context, c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) (*spdkapi.Engine, error) {
+	replicaAddressMap, err := util.ResolveAddressMap(ctx, spec.SpdkInstanceSpec.ReplicaAddressMap)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Unavailable, err.Error())
+	}
+
+	engine, err := c.EngineCreate(spec.Name, spec.VolumeName, spec.SpdkInstanceSpec.Frontend, spec.SpdkInstanceSpec.Size, replicaAddressMap, spec.PortCount)
+	if err == nil || util.IsAddressMapResolved(spec.SpdkInstanceSpec.ReplicaAddressMap) {
+		return engine, err
+	}
+
+	newReplicaAddressMap, resolveErr := util.ResolveAddressMap(ctx, spec.SpdkInstanceSpec.ReplicaAddressMap)
+	if resolveErr != nil || reflect.DeepEqual(newReplicaAddressMap, replicaAddressMap) {
+		return nil, err
+	}
+	logrus.WithError(err).Warnf("Retrying creating engine %v since the replica addresses %v are resolved to %v now",
+		spec.Name, replicaAddressMap, newReplicaAddressMap)
+	if deleteErr := c.EngineDelete(spec.Name); deleteErr != nil && grpcstatus.Code(deleteErr) != grpccodes.NotFound {
+		return nil, err
+	}
+	return c.EngineCreate(spec.Name, spec.VolumeName, spec.SpdkInstanceSpec.Frontend, spec.SpdkInstanceSpec.Size, newReplicaAddressMap, spec.PortCount)
+}
+
 func (s *Server) InstanceDelete(ctx context.Context, req *rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error) {
 	logrus.WithFields(logrus.Fields{
 		"name":            req.Name,
@@ -171,41 +432,78 @@ func (s *Server) InstanceDelete(ctx context.Context, req *rpc.InstanceDeleteRequ
 		"cleanupRequired": req.CleanupRequired,
 	}).Info("Deleting instance")
 
+	if req.DataEngine == rpc.DataEngine_DATA_ENGINE_ANY {
+		instance, err := s.getInstanceOfAnyDataEngine(ctx, req.Name, req.Type)
+		if err != nil {
+			return nil, err
+		}
+		req = proto.Clone(req).(*rpc.InstanceDeleteRequest)
+		req.DataEngine = instance.Spec.DataEngine
+		if req.Type == "" {
+			req.Type = instance.Spec.Type
+		}
+	}
+
 	ops, ok := s.ops[req.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
 	}
-	return ops.InstanceDelete(req)
+	releaseSlot, err := s.concurrency.acquire(ctx, req.DataEngine, types.InstanceOperationDelete, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseSlot()
+
+	// The restart policy is dropped before the deletion, so that the instance is not restarted while being deleted
+	s.restarts.delete(req.Name)
+	resp, err := ops.InstanceDelete(ctx, req)
+	s.operations.Record(req.Name, types.InstanceOperationDelete, fmt.Sprintf("cleanupRequired=%v", req.CleanupRequired), err)
+	if err == nil {
+		s.uuids.delete(req.Name)
+	}
+	return resp, err
 }
 
-func (ops V1DataEngineInstanceOps) InstanceDelete(req *rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error) {
-	pmClient, err := client.NewProcessManagerClient("tcp://"+ops.processManagerServiceAddress, nil)
+func (ops V1DataEngineInstanceOps) InstanceDelete(ctx context.Context, req *rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
 	}
-	defer pmClient.Close()
 
-	process, err := pmClient.ProcessDelete(req.Name)
+	process, err := pmClient.ProcessDeleteWithStopTimeout(req.Name, time.Duration(req.StopTimeoutSeconds)*time.Second)
 	if err != nil {
 		return nil, err
 	}
 	return processResponseToInstanceResponse(process), nil
 }
 
-func (ops V2DataEngineInstanceOps) InstanceDelete(req *rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error) {
-	c, err := spdkclient.NewSPDKClient(ops.spdkServiceAddress)
+func (ops V2DataEngineInstanceOps) InstanceDelete(ctx context.Context, req *rpc.InstanceDeleteRequest) (*rpc.InstanceResponse, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	c, err := ops.clients.getSPDKClient()
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
 	}
-	defer c.Close()
 
 	switch req.Type {
 	case types.InstanceTypeEngine:
 		if req.CleanupRequired {
-			err = c.EngineDelete(req.Name)
+			err = ops.resumeEngineBeforeDeletion(c, req.Name)
+			if err == nil {
+				err = ops.deleteEngine(c, req.Name)
+			}
+			if err == nil {
+				ops.forgetEngine(req.Name)
+			}
 		}
 	case types.InstanceTypeReplica:
 		err = c.ReplicaDelete(req.Name, req.CleanupRequired)
+		if err == nil {
+			ops.labels.delete(req.Name)
+			ops.priorities.delete(req.Name)
+		}
 	default:
 		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "unknown instance type %v", req.Type)
 	}
@@ -220,30 +518,70 @@ func (ops V2DataEngineInstanceOps) InstanceDelete(req *rpc.InstanceDeleteRequest
 		Status: &rpc.InstanceStatus{
 			State: types.ProcessStateStopped,
 		},
-		Deleted: true,
+		Deleted:  true,
+		Topology: api.TopologyToRPC(meta.GetTopology()),
 	}, nil
 }
 
+// forgetEngine drops what the instance server keeps for the deleted engine.
+func (ops V2DataEngineInstanceOps) forgetEngine(name string) {
+	ops.labels.delete(name)
+	ops.priorities.delete(name)
+	ops.ioTimeouts.delete(name)
+	ops.readPreferences.delete(name)
+}
+
 func (s *Server) InstanceGet(ctx context.Context, req *rpc.InstanceGetRequest) (*rpc.InstanceResponse, error) {
 	logrus.WithFields(logrus.Fields{
 		"name":       req.Name,
 		"type":       req.Type,
 		"dataEngine": req.DataEngine,
+		"uuid":       req.Uuid,
 	}).Trace("Getting instance")
 
-	ops, ok := s.ops[req.DataEngine]
-	if !ok {
-		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	if req.Uuid != "" && req.Name == "" {
+		resp, err := s.getInstanceByUUID(ctx, req.Uuid)
+		if err != nil {
+			return nil, err
+		}
+		if req.Verbose {
+			resp.Operations = s.getInstanceOperations(resp.Spec.Name)
+		}
+		return resp, nil
+	}
+
+	var resp *rpc.InstanceResponse
+	var err error
+	if req.DataEngine == rpc.DataEngine_DATA_ENGINE_ANY {
+		resp, err = s.getInstanceOfAnyDataEngine(ctx, req.Name, req.Type)
+	} else {
+		ops, ok := s.ops[req.DataEngine]
+		if !ok {
+			return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+		}
+		resp, err = ops.InstanceGet(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.stuckInstances.apply(resp)
+	s.restarts.apply(resp)
+	s.uuids.apply(resp)
+	// The instance of the name may be a stale one recreated since the UUID was seen
+	if req.Uuid != "" && resp.Status.Uuid != req.Uuid {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find instance %v of UUID %v", req.Name, req.Uuid)
 	}
-	return ops.InstanceGet(req)
+	if req.Verbose {
+		resp.Operations = s.getInstanceOperations(req.Name)
+	}
+	return resp, nil
 }
 
-func (ops V1DataEngineInstanceOps) InstanceGet(req *rpc.InstanceGetRequest) (*rpc.InstanceResponse, error) {
-	pmClient, err := client.NewProcessManagerClient("tcp://"+ops.processManagerServiceAddress, nil)
+func (ops V1DataEngineInstanceOps) InstanceGet(ctx context.Context, req *rpc.InstanceGetRequest) (*rpc.InstanceResponse, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
 	}
-	defer pmClient.Close()
 
 	process, err := pmClient.ProcessGet(req.Name)
 	if err != nil {
@@ -252,12 +590,15 @@ func (ops V1DataEngineInstanceOps) InstanceGet(req *rpc.InstanceGetRequest) (*rp
 	return processResponseToInstanceResponse(process), nil
 }
 
-func (ops V2DataEngineInstanceOps) InstanceGet(req *rpc.InstanceGetRequest) (*rpc.InstanceResponse, error) {
-	c, err := spdkclient.NewSPDKClient(ops.spdkServiceAddress)
+func (ops V2DataEngineInstanceOps) InstanceGet(ctx context.Context, req *rpc.InstanceGetRequest) (*rpc.InstanceResponse, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	c, err := ops.clients.getSPDKClient()
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
 	}
-	defer c.Close()
 
 	switch req.Type {
 	case types.InstanceTypeEngine:
@@ -265,46 +606,171 @@ func (ops V2DataEngineInstanceOps) InstanceGet(req *rpc.InstanceGetRequest) (*rp
 		if err != nil {
 			return nil, err
 		}
-		return engineResponseToInstanceResponse(engine), nil
+		resp := ops.readPreferences.apply(engine, ops.getEngineResponse(engine))
+		resp.Status.ResourceUsage = ops.getSPDKTgtResourceUsage()
+		engines := map[string]*spdkapi.Engine{engine.Name: engine}
+		instances := map[string]*rpc.InstanceResponse{engine.Name: resp}
+		applyEngineNvmeIdentities(ops.clients, engines, instances)
+		ops.nvmeTCPListeners.applyAddresses(nil, engines, instances)
+		return resp, nil
 	case types.InstanceTypeReplica:
 		replica, err := c.ReplicaGet(req.Name)
 		if err != nil {
 			return nil, err
 		}
-		return replicaResponseToInstanceResponse(replica), nil
+		resp := ops.priorities.apply(ops.labels.apply(replicaResponseToInstanceResponse(replica)))
+		resp.Status.ResourceUsage = ops.getSPDKTgtResourceUsage()
+		ops.nvmeTCPListeners.applyAddresses(map[string]*spdkapi.Replica{replica.Name: replica}, nil,
+			map[string]*rpc.InstanceResponse{replica.Name: resp})
+		return resp, nil
 	default:
 		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "unknown instance type %v", req.Type)
 	}
 }
 
-func (s *Server) InstanceList(ctx context.Context, req *emptypb.Empty) (*rpc.InstanceListResponse, error) {
-	logrus.WithFields(logrus.Fields{}).Trace("Listing instances")
+// InstanceRefresh re-queries the backend for the state of the instance rather than relying on the state kept by
+// the backend services, and notifies the instance watchers.
+func (s *Server) InstanceRefresh(ctx context.Context, req *rpc.InstanceRefreshRequest) (*rpc.InstanceResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"type":       req.Type,
+		"dataEngine": req.DataEngine,
+	}).Info("Refreshing instance")
 
-	instances := map[string]*rpc.InstanceResponse{}
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
 
-	err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(instances)
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	resp, err := ops.InstanceRefresh(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	if s.v2DataEngineEnabled {
-		err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(instances)
+	s.broadcastCh <- interface{}(resp)
+	return resp, nil
+}
+
+func (ops V1DataEngineInstanceOps) InstanceRefresh(ctx context.Context, req *rpc.InstanceRefreshRequest) (*rpc.InstanceResponse, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	process, err := pmClient.ProcessRefresh(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return processResponseToInstanceResponse(process), nil
+}
+
+// InstanceRefresh gets the instance from the SPDK service, which keeps the state of the instances in sync with
+// spdk_tgt by itself, since there is no cache of the SPDK instances in the instance manager.
+func (ops V2DataEngineInstanceOps) InstanceRefresh(ctx context.Context, req *rpc.InstanceRefreshRequest) (*rpc.InstanceResponse, error) {
+	return ops.InstanceGet(ctx, &rpc.InstanceGetRequest{
+		Name:       req.Name,
+		Type:       req.Type,
+		DataEngine: req.DataEngine,
+	})
+}
+
+func (s *Server) InstanceList(ctx context.Context, req *rpc.InstanceListRequest) (*rpc.InstanceListResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"dataEngines": req.DataEngines,
+		"types":       req.Types,
+		"states":      req.States,
+		"namePrefix":  req.NamePrefix,
+		"pageSize":    req.PageSize,
+		"pageToken":   req.PageToken,
+		"since":       req.SinceResourceVersion,
+		"selector":    req.LabelSelector,
+	}).Trace("Listing instances")
+
+	if req.PageSize < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid page size %v", req.PageSize)
+	}
+	selector, err := util.ParseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if req.SinceResourceVersion != "" && (req.PageSize != 0 || req.PageToken != "" || len(req.States) != 0) {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "since resource version cannot be combined with the pagination or the state filter")
+	}
+
+	// The journal is fed by the lists of all the data engines only, so a delta lists all of them and filters them
+	// afterwards
+	listV1 := req.SinceResourceVersion != "" || matchInstanceListDataEngine(req, rpc.DataEngine_DATA_ENGINE_V1)
+	listV2 := s.v2DataEngineEnabled && (req.SinceResourceVersion != "" || matchInstanceListDataEngine(req, rpc.DataEngine_DATA_ENGINE_V2))
+
+	if listV2 {
+		// Listing without the v2 instances would have them look gone
+		if err := s.spdkGate.check(); err != nil {
+			return nil, err
+		}
+	}
+
+	instances := map[string]*rpc.InstanceResponse{}
+
+	if listV1 {
+		err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(ctx, instances)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &rpc.InstanceListResponse{
-		Instances: instances,
-	}, nil
+	if listV2 {
+		err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(ctx, instances)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.stuckInstances.applyList(instances)
+	s.restarts.applyList(instances)
+	s.uuids.applyList(instances)
+
+	resourceVersion := ""
+	if listV1 && (listV2 || !s.v2DataEngineEnabled) {
+		resourceVersion = s.journal.update(instances)
+	}
+
+	if req.SinceResourceVersion != "" {
+		changed, deleted, currentResourceVersion, ok := s.journal.since(req.SinceResourceVersion, func(name string, instance *rpc.InstanceResponse) bool {
+			return matchInstanceListFilters(req, selector, name, instance)
+		})
+		if ok {
+			for name := range changed {
+				// Prefer the instances just listed, whose resource usage is up to date
+				if instance, exists := instances[name]; exists {
+					changed[name] = instance
+				}
+			}
+			return &rpc.InstanceListResponse{
+				Instances:       changed,
+				ResourceVersion: currentResourceVersion,
+				Delta:           true,
+				DeletedNames:    deleted,
+			}, nil
+		}
+		logrus.Debugf("Listing all instances since the changes since resource version %v are unknown", req.SinceResourceVersion)
+	}
+
+	resp, err := filterInstanceList(req, selector, instances)
+	if err != nil {
+		return nil, err
+	}
+	resp.ResourceVersion = resourceVersion
+	return resp, nil
 }
 
-func (ops V1DataEngineInstanceOps) InstanceList(instances map[string]*rpc.InstanceResponse) error {
-	pmClient, err := client.NewProcessManagerClient("tcp://"+ops.processManagerServiceAddress, nil)
+func (ops V1DataEngineInstanceOps) InstanceList(ctx context.Context, instances map[string]*rpc.InstanceResponse) error {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
 	if err != nil {
 		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
 	}
-	defer pmClient.Close()
 
 	processes, err := pmClient.ProcessList()
 	if err != nil {
@@ -316,19 +782,22 @@ func (ops V1DataEngineInstanceOps) InstanceList(instances map[string]*rpc.Instan
 	return nil
 }
 
-func (ops V2DataEngineInstanceOps) InstanceList(instances map[string]*rpc.InstanceResponse) error {
-	c, err := spdkclient.NewSPDKClient(ops.spdkServiceAddress)
+func (ops V2DataEngineInstanceOps) InstanceList(ctx context.Context, instances map[string]*rpc.InstanceResponse) error {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return err
+	}
+
+	c, err := ops.clients.getSPDKClient()
 	if err != nil {
 		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
 	}
-	defer c.Close()
 
 	replicas, err := c.ReplicaList()
 	if err != nil {
 		return err
 	}
 	for _, replica := range replicas {
-		instances[replica.Name] = replicaResponseToInstanceResponse(replica)
+		instances[replica.Name] = ops.priorities.apply(ops.labels.apply(replicaResponseToInstanceResponse(replica)))
 	}
 
 	engines, err := c.EngineList()
@@ -336,11 +805,37 @@ func (ops V2DataEngineInstanceOps) InstanceList(instances map[string]*rpc.Instan
 		return err
 	}
 	for _, engine := range engines {
-		instances[engine.Name] = engineResponseToInstanceResponse(engine)
+		instances[engine.Name] = ops.readPreferences.apply(engine, ops.getEngineResponse(engine))
+	}
+	applyEngineNvmeIdentities(ops.clients, engines, instances)
+	ops.nvmeTCPListeners.applyAddresses(replicas, engines, instances)
+
+	if usage := ops.getSPDKTgtResourceUsage(); usage != nil {
+		for _, replica := range replicas {
+			instances[replica.Name].Status.ResourceUsage = proto.Clone(usage).(*rpc.ResourceUsage)
+		}
+		for _, engine := range engines {
+			instances[engine.Name].Status.ResourceUsage = proto.Clone(usage).(*rpc.ResourceUsage)
+		}
 	}
 	return nil
 }
 
+// getSPDKTgtResourceUsage returns the resource usage of spdk_tgt, which serves all the v2 instances, so the usage
+// of a v2 instance alone is unknown. Returns nil if spdk_tgt is not found on the node.
+func (ops V2DataEngineInstanceOps) getSPDKTgtResourceUsage() *rpc.ResourceUsage {
+	pid, err := ops.clients.getSPDKTgtPid()
+	if err != nil || pid == 0 {
+		return nil
+	}
+	usage, err := util.GetProcessResourceUsage(pid)
+	if err != nil {
+		logrus.WithError(err).Debug("Failed to get resource usage of spdk_tgt")
+		return nil
+	}
+	return api.ProcessResourceUsageToRPC(usage, true)
+}
+
 func (s *Server) InstanceReplace(ctx context.Context, req *rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error) {
 	logrus.WithFields(logrus.Fields{
 		"name":       req.Spec.Name,
@@ -352,22 +847,53 @@ func (s *Server) InstanceReplace(ctx context.Context, req *rpc.InstanceReplaceRe
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.Spec.DataEngine)
 	}
-	return ops.InstanceReplace(req)
+	if err := util.ValidateLabels(req.Spec.Labels); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if err := validateRestartPolicy(req.Spec); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if err := util.ValidatePriorityClass(req.Spec.PriorityClass); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	resp, err := ops.InstanceReplace(ctx, req)
+	s.operations.Record(req.Spec.Name, types.InstanceOperationReplace, "", err)
+	if err != nil {
+		return nil, err
+	}
+	s.uuids.reset(req.Spec.Name)
+	s.restarts.set(req.Spec)
+	return s.uuids.apply(s.restarts.apply(resp)), nil
 }
 
-func (ops V1DataEngineInstanceOps) InstanceReplace(req *rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error) {
+func (ops V1DataEngineInstanceOps) InstanceReplace(ctx context.Context, req *rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error) {
 	if req.Spec.ProcessInstanceSpec == nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "ProcessInstanceSpec is required for longhorn data engine")
 	}
 
-	pmClient, err := client.NewProcessManagerClient("tcp://"+ops.processManagerServiceAddress, nil)
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
 	}
-	defer pmClient.Close()
 
-	process, err := pmClient.ProcessReplace(req.Spec.Name,
-		req.Spec.ProcessInstanceSpec.Binary, int(req.Spec.PortCount), req.Spec.ProcessInstanceSpec.Args, req.Spec.PortArgs, req.TerminateSignal)
+	process, err := pmClient.ProcessReplaceWithSpec(&rpc.ProcessSpec{
+		Name:      req.Spec.Name,
+		Binary:    req.Spec.ProcessInstanceSpec.Binary,
+		Args:      req.Spec.ProcessInstanceSpec.Args,
+		PortCount: req.Spec.PortCount,
+		PortArgs:  req.Spec.PortArgs,
+		Sidecars:  req.Spec.ProcessInstanceSpec.Sidecars,
+		Envs:      req.Spec.ProcessInstanceSpec.Envs,
+		Labels:    req.Spec.Labels,
+
+		PriorityClass:      req.Spec.PriorityClass,
+		CpuMillicores:      req.Spec.ProcessInstanceSpec.CpuMillicores,
+		MemoryBytes:        req.Spec.ProcessInstanceSpec.MemoryBytes,
+		CpuSet:             req.Spec.ProcessInstanceSpec.CpuSet,
+		NumaNode:           req.Spec.ProcessInstanceSpec.NumaNode,
+		Privileges:         req.Spec.ProcessInstanceSpec.Privileges,
+		StopTimeoutSeconds: req.Spec.ProcessInstanceSpec.StopTimeoutSeconds,
+	}, req.TerminateSignal)
 	if err != nil {
 		return nil, err
 	}
@@ -375,37 +901,42 @@ func (ops V1DataEngineInstanceOps) InstanceReplace(req *rpc.InstanceReplaceReque
 	return processResponseToInstanceResponse(process), nil
 }
 
-func (ops V2DataEngineInstanceOps) InstanceReplace(req *rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error) {
-	return nil, grpcstatus.Error(grpccodes.Unimplemented, "v2 data engine instance replace is not supported")
-}
-
 func (s *Server) InstanceLog(req *rpc.InstanceLogRequest, srv rpc.InstanceService_InstanceLogServer) error {
 	logrus.WithFields(logrus.Fields{
 		"name":       req.Name,
 		"type":       req.Type,
 		"dataEngine": req.DataEngine,
+		"batched":    req.Batched,
 	}).Info("Getting instance log")
 
 	ops, ok := s.ops[req.DataEngine]
 	if !ok {
 		return grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
 	}
+	if err := s.spdkGate.checkDataEngine(req.DataEngine); err != nil {
+		return err
+	}
+	done := s.drain.startLogStream()
+	defer done()
 	return ops.InstanceLog(req, srv)
 }
 
 func (ops V1DataEngineInstanceOps) InstanceLog(req *rpc.InstanceLogRequest, srv rpc.InstanceService_InstanceLogServer) error {
-	pmClient, err := client.NewProcessManagerClient("tcp://"+ops.processManagerServiceAddress, nil)
+	pmClient, err := ops.clients.getProcessManagerClient(srv.Context())
 	if err != nil {
 		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
 	}
-	defer pmClient.Close()
 
-	stream, err := pmClient.ProcessLog(context.Background(), req.Name)
+	if req.Batched {
+		return forwardLogFrames(pmClient, req, srv)
+	}
+
+	stream, err := pmClient.ProcessLogSince(srv.Context(), req.Name, req.SinceSequence)
 	if err != nil {
 		return err
 	}
 	for {
-		line, err := stream.Recv()
+		entry, err := stream.RecvEntry()
 		if err == io.EOF {
 			break
 		} else if err != nil {
@@ -413,35 +944,96 @@ func (ops V1DataEngineInstanceOps) InstanceLog(req *rpc.InstanceLogRequest, srv
 			return err
 		}
 
-		if err := srv.Send(&rpc.LogResponse{Line: line}); err != nil {
+		if err := srv.Send(&rpc.LogResponse{
+			Line:      entry.Line,
+			Sequence:  entry.Sequence,
+			Timestamp: entry.Timestamp,
+		}); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (ops V2DataEngineInstanceOps) InstanceLog(req *rpc.InstanceLogRequest, srv rpc.InstanceService_InstanceLogServer) error {
-	return grpcstatus.Error(grpccodes.Unimplemented, "v2 data engine instance log is not supported")
+// forwardLogFrames forwards the batched frames of the process manager as they are, without decoding them.
+func forwardLogFrames(pmClient *client.ProcessManagerClient, req *rpc.InstanceLogRequest, srv rpc.InstanceService_InstanceLogServer) error {
+	stream, err := pmClient.ProcessLogBatched(srv.Context(), req.Name, req.SinceSequence, req.Compressed)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.RecvResponse()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			logrus.WithError(err).Error("Failed to receive log")
+			return err
+		}
+		if err := srv.Send(resp); err != nil {
+			return err
+		}
+	}
 }
 
-func (s *Server) handleNotify(ctx context.Context, notifyChan chan struct{}, srv rpc.InstanceService_InstanceWatchServer) error {
+func (s *Server) handleNotify(ctx context.Context, notifications *watchNotifier, events *watchEventGenerator, replayed []*rpc.InstanceWatchEvent, srv rpc.InstanceService_InstanceWatchServer) error {
 	logrus.Info("Start handling notify")
 
+	for _, event := range replayed {
+		if err := srv.Send(event); err != nil {
+			return errors.Wrap(err, "failed to send replayed instance watch event")
+		}
+	}
+
+	dropCh := chaos.WatchStreamDropped()
 	for {
 		select {
 		case <-ctx.Done():
 			logrus.Info("Stopped handling notify due to the context done")
 			return ctx.Err()
-		case <-notifyChan:
-			if err := srv.Send(&emptypb.Empty{}); err != nil {
-				return errors.Wrap(err, "failed to send instance response")
+		case <-dropCh:
+			return grpcstatus.Error(grpccodes.Unavailable, "chaos: instance watch stream is dropped")
+		case <-notifications.ch:
+			// The changes during a freeze are sent as one resync once it ends
+			frozen, err := s.watchFreeze.wait(ctx)
+			if err != nil {
+				logrus.Info("Stopped handling notify due to the context done")
+				return err
+			}
+			if frozen {
+				if err := srv.Send(events.resync()); err != nil {
+					return errors.Wrap(err, "failed to send instance watch resync event")
+				}
+				break
+			}
+			for _, event := range events.next() {
+				if err := srv.Send(event); err != nil {
+					return errors.Wrap(err, "failed to send instance watch event")
+				}
+			}
+		}
+
+		// The changes found in the meantime are coalesced into the next notification
+		if s.WatchCoalescingWindow > 0 {
+			select {
+			case <-ctx.Done():
+				logrus.Info("Stopped handling notify due to the context done")
+				return ctx.Err()
+			case <-time.After(s.WatchCoalescingWindow):
 			}
 		}
 	}
 }
 
-func (s *Server) InstanceWatch(req *emptypb.Empty, srv rpc.InstanceService_InstanceWatchServer) error {
-	logrus.Info("Start watching instances")
+func (s *Server) InstanceWatch(req *rpc.InstanceWatchRequest, srv rpc.InstanceService_InstanceWatchServer) error {
+	logrus.WithFields(logrus.Fields{
+		"selector":    req.LabelSelector,
+		"resumeToken": req.ResumeToken,
+	}).Info("Start watching instances")
+
+	selector, err := util.ParseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
 
 	done := make(chan struct{})
 
@@ -474,6 +1066,10 @@ func (s *Server) InstanceWatch(req *emptypb.Empty, srv rpc.InstanceService_Insta
 	var spdkClient *spdkclient.SPDKClient
 	if s.v2DataEngineEnabled {
 		// Create a client for watching SPDK engines and replicas
+		if err := chaos.CheckSPDKAvailable(); err != nil {
+			done <- struct{}{}
+			return err
+		}
 		ops := s.ops[rpc.DataEngine_DATA_ENGINE_V2].(V2DataEngineInstanceOps)
 		spdkClient, err = spdkclient.NewSPDKClient(ops.spdkServiceAddress)
 		if err != nil {
@@ -483,8 +1079,13 @@ func (s *Server) InstanceWatch(req *emptypb.Empty, srv rpc.InstanceService_Insta
 		clients["spdkClient"] = spdkClient
 	}
 
-	notifyChan := make(chan struct{}, 1024)
-	defer close(notifyChan)
+	notifications := newWatchNotifier()
+	// List the instances before watching them, so that the changes since then are reported
+	events := newWatchEventGenerator(srv.Context(), s, selector)
+	var replayed []*rpc.InstanceWatchEvent
+	if req.ResumeToken != "" {
+		replayed = events.replay(s.journal, req.ResumeToken, selector)
+	}
 
 	g, ctx := errgroup.WithContext(s.ctx)
 
@@ -493,20 +1094,24 @@ func (s *Server) InstanceWatch(req *emptypb.Empty, srv rpc.InstanceService_Insta
 			// Close the clients for closing streams and unblocking notifier Recv() with error.
 			done <- struct{}{}
 		}()
-		return s.handleNotify(ctx, notifyChan, srv)
+		return s.handleNotify(ctx, notifications, events, replayed, srv)
+	})
+
+	g.Go(func() error {
+		return s.watchProcess(ctx, req, pmClient, notifications)
 	})
 
 	g.Go(func() error {
-		return s.watchProcess(ctx, req, pmClient, notifyChan)
+		return s.watchBroadcast(ctx, notifications)
 	})
 
 	if s.v2DataEngineEnabled {
 		g.Go(func() error {
-			return s.watchSPDKEngine(ctx, req, spdkClient, notifyChan)
+			return s.watchSPDKEngine(ctx, req, spdkClient, notifications)
 		})
 
 		g.Go(func() error {
-			return s.watchSPDKReplica(ctx, req, spdkClient, notifyChan)
+			return s.watchSPDKReplica(ctx, req, spdkClient, notifications)
 		})
 	}
 
@@ -518,10 +1123,37 @@ func (s *Server) InstanceWatch(req *emptypb.Empty, srv rpc.InstanceService_Insta
 	return nil
 }
 
-func (s *Server) watchSPDKReplica(ctx context.Context, req *emptypb.Empty, client *spdkclient.SPDKClient, notifyChan chan struct{}) error {
+func (s *Server) broadcastConnector() (chan interface{}, error) {
+	return s.broadcastCh, nil
+}
+
+func (s *Server) watchBroadcast(ctx context.Context, notifications *watchNotifier) error {
+	responseChan, err := s.broadcaster.Subscribe(ctx, s.broadcastConnector)
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe instance server broadcaster")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logrus.Info("Stopped watching instance server broadcaster")
+			return ctx.Err()
+		case _, ok := <-responseChan:
+			if !ok {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return fmt.Errorf("instance server broadcaster is closed")
+			}
+			notifications.notify()
+		}
+	}
+}
+
+func (s *Server) watchSPDKReplica(ctx context.Context, req *rpc.InstanceWatchRequest, client *spdkclient.SPDKClient, notifications *watchNotifier) error {
 	logrus.Info("Start watching SPDK replicas")
 
-	notifier, err := client.ReplicaWatch(context.Background())
+	notifier, err := client.ReplicaWatch(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to create SPDK replica watch notifier")
 	}
@@ -549,16 +1181,16 @@ func (s *Server) watchSPDKReplica(ctx context.Context, req *emptypb.Empty, clien
 				time.Sleep(monitorRetryPollInterval)
 				failureCount++
 			} else {
-				notifyChan <- struct{}{}
+				notifications.notify()
 			}
 		}
 	}
 }
 
-func (s *Server) watchSPDKEngine(ctx context.Context, req *emptypb.Empty, client *spdkclient.SPDKClient, notifyChan chan struct{}) error {
+func (s *Server) watchSPDKEngine(ctx context.Context, req *rpc.InstanceWatchRequest, client *spdkclient.SPDKClient, notifications *watchNotifier) error {
 	logrus.Info("Start watching SPDK engines")
 
-	notifier, err := client.EngineWatch(context.Background())
+	notifier, err := client.EngineWatch(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to create SPDK engine watch notifier")
 	}
@@ -586,16 +1218,16 @@ func (s *Server) watchSPDKEngine(ctx context.Context, req *emptypb.Empty, client
 				time.Sleep(monitorRetryPollInterval)
 				failureCount++
 			} else {
-				notifyChan <- struct{}{}
+				notifications.notify()
 			}
 		}
 	}
 }
 
-func (s *Server) watchProcess(ctx context.Context, req *emptypb.Empty, client *client.ProcessManagerClient, notifyChan chan struct{}) error {
+func (s *Server) watchProcess(ctx context.Context, req *rpc.InstanceWatchRequest, client *client.ProcessManagerClient, notifications *watchNotifier) error {
 	logrus.Info("Start watching processes")
 
-	notifier, err := client.ProcessWatch(context.Background())
+	notifier, err := client.ProcessWatch(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to create process watch notifier")
 	}
@@ -623,14 +1255,27 @@ func (s *Server) watchProcess(ctx context.Context, req *emptypb.Empty, client *c
 				time.Sleep(monitorRetryPollInterval)
 				failureCount++
 			} else {
-				notifyChan <- struct{}{}
+				notifications.notify()
 			}
 		}
 	}
 }
 
+func (s *Server) getInstanceOperations(name string) []*rpc.InstanceOperation {
+	operations := []*rpc.InstanceOperation{}
+	for _, record := range s.operations.Get(name) {
+		operations = append(operations, &rpc.InstanceOperation{
+			Operation: record.Operation,
+			Timestamp: record.Time.Format(time.RFC3339Nano),
+			Details:   record.Details,
+			ErrorMsg:  record.Error,
+		})
+	}
+	return operations
+}
+
 func processResponseToInstanceResponse(p *rpc.ProcessResponse) *rpc.InstanceResponse {
-	return &rpc.InstanceResponse{
+	resp := &rpc.InstanceResponse{
 		Spec: &rpc.InstanceSpec{
 			Name: p.Spec.Name,
 			// Leave Type empty. It will be determined in longhorn manager.
@@ -639,11 +1284,23 @@ func processResponseToInstanceResponse(p *rpc.ProcessResponse) *rpc.InstanceResp
 			BackendStoreDriver: rpc.BackendStoreDriver_v1,
 			DataEngine:         rpc.DataEngine_DATA_ENGINE_V1,
 			ProcessInstanceSpec: &rpc.ProcessInstanceSpec{
-				Binary: p.Spec.Binary,
-				Args:   p.Spec.Args,
+				Binary:   p.Spec.Binary,
+				Args:     p.Spec.Args,
+				Sidecars: p.Spec.Sidecars,
+				Envs:     p.Spec.Envs,
+
+				CpuMillicores:      p.Spec.CpuMillicores,
+				MemoryBytes:        p.Spec.MemoryBytes,
+				CpuSet:             p.Spec.CpuSet,
+				NumaNode:           p.Spec.NumaNode,
+				Privileges:         p.Spec.Privileges,
+				StopTimeoutSeconds: p.Spec.StopTimeoutSeconds,
 			},
 			PortCount: int32(p.Spec.PortCount),
 			PortArgs:  p.Spec.PortArgs,
+			Labels:    p.Spec.Labels,
+
+			PriorityClass: p.Spec.PriorityClass,
 		},
 		Status: &rpc.InstanceStatus{
 			State:      p.Status.State,
@@ -651,9 +1308,17 @@ func processResponseToInstanceResponse(p *rpc.ProcessResponse) *rpc.InstanceResp
 			PortEnd:    p.Status.PortEnd,
 			ErrorMsg:   p.Status.ErrorMsg,
 			Conditions: p.Status.Conditions,
+			Sidecars:   p.Status.Sidecars,
+
+			ResourceUsage: p.Status.ResourceUsage,
+
+			Uuid: p.Status.Uuid,
 		},
-		Deleted: p.Deleted,
+		Deleted:  p.Deleted,
+		Topology: api.TopologyToRPC(meta.GetTopology()),
 	}
+	resp.Status.Ports = getProcessInstancePorts(resp)
+	return resp
 }
 
 func replicaResponseToInstanceResponse(r *spdkapi.Replica) *rpc.InstanceResponse {
@@ -671,7 +1336,9 @@ func replicaResponseToInstanceResponse(r *spdkapi.Replica) *rpc.InstanceResponse
 			PortStart:  r.PortStart,
 			PortEnd:    r.PortEnd,
 			Conditions: make(map[string]bool),
+			Ports:      getReplicaInstancePorts(r.PortStart, r.PortEnd),
 		},
+		Topology: api.TopologyToRPC(meta.GetTopology()),
 	}
 }
 
@@ -690,6 +1357,8 @@ func engineResponseToInstanceResponse(e *spdkapi.Engine) *rpc.InstanceResponse {
 			PortStart:  e.Port,
 			PortEnd:    e.Port,
 			Conditions: make(map[string]bool),
+			Ports:      getEngineInstancePorts(e.Port),
 		},
+		Topology: api.TopologyToRPC(meta.GetTopology()),
 	}
 }
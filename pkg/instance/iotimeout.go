@@ -0,0 +1,395 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	nvmeClassDirectory = "/sys/class/nvme"
+
+	// nvmeTimeoutOff is shown by the controller attributes for the timeouts disabled by -1
+	nvmeTimeoutOff = "off"
+)
+
+// engineIOTimeoutTracker keeps the I/O timeouts set for the frontends of the v2 engines. The timeouts are the ones of
+// the NVMe-oF controllers of the node rather than of spdk_tgt, since the NVMe bdev options of spdk_tgt are global and
+// fixed once the first controller is attached. The kernel forgets them once a controller is connected again, so they
+// are set again periodically. They are not persisted, so they are lost once the instance manager restarts.
+type engineIOTimeoutTracker struct {
+	lock     *sync.RWMutex
+	timeouts map[string]*rpc.InstanceIOTimeouts
+}
+
+func newEngineIOTimeoutTracker() *engineIOTimeoutTracker {
+	return &engineIOTimeoutTracker{
+		lock:     &sync.RWMutex{},
+		timeouts: map[string]*rpc.InstanceIOTimeouts{},
+	}
+}
+
+// set merges the non-zero timeouts into the ones of the engine, and returns the result.
+func (t *engineIOTimeoutTracker) set(name string, timeouts *rpc.InstanceIOTimeouts) *rpc.InstanceIOTimeouts {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	configured, exists := t.timeouts[name]
+	if !exists {
+		configured = &rpc.InstanceIOTimeouts{}
+		t.timeouts[name] = configured
+	}
+	if timeouts.IoTimeoutMs != 0 {
+		configured.IoTimeoutMs = timeouts.IoTimeoutMs
+	}
+	if timeouts.CtrlLossTimeoutSec != 0 {
+		configured.CtrlLossTimeoutSec = timeouts.CtrlLossTimeoutSec
+	}
+	if timeouts.ReconnectDelaySec != 0 {
+		configured.ReconnectDelaySec = timeouts.ReconnectDelaySec
+	}
+	if timeouts.FastIoFailTimeoutSec != 0 {
+		configured.FastIoFailTimeoutSec = timeouts.FastIoFailTimeoutSec
+	}
+	return proto.Clone(configured).(*rpc.InstanceIOTimeouts)
+}
+
+func (t *engineIOTimeoutTracker) get(name string) *rpc.InstanceIOTimeouts {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if configured, exists := t.timeouts[name]; exists {
+		return proto.Clone(configured).(*rpc.InstanceIOTimeouts)
+	}
+	return &rpc.InstanceIOTimeouts{}
+}
+
+func (t *engineIOTimeoutTracker) delete(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.timeouts, name)
+}
+
+// reapply sets the timeouts again for the controllers connected since they were set, e.g. after a reconnection.
+func (t *engineIOTimeoutTracker) reapply() {
+	t.lock.RLock()
+	timeouts := map[string]*rpc.InstanceIOTimeouts{}
+	for name, configured := range t.timeouts {
+		timeouts[name] = proto.Clone(configured).(*rpc.InstanceIOTimeouts)
+	}
+	t.lock.RUnlock()
+
+	for name, configured := range timeouts {
+		controllers, err := findEngineNVMeControllers(name)
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to find the NVMe controllers of engine %v", name)
+			continue
+		}
+		for _, controller := range controllers {
+			if err := setNVMeControllerIOTimeouts(controller, configured); err != nil {
+				logrus.WithError(err).Warnf("Failed to set the I/O timeouts of NVMe controller %v of engine %v", controller, name)
+			}
+		}
+	}
+}
+
+func validateInstanceIOTimeouts(timeouts *rpc.InstanceIOTimeouts) error {
+	if timeouts == nil {
+		return fmt.Errorf("missing required argument timeouts")
+	}
+	if timeouts.IoTimeoutMs < 0 {
+		return fmt.Errorf("invalid I/O timeout %vms", timeouts.IoTimeoutMs)
+	}
+	if timeouts.ReconnectDelaySec < 0 {
+		return fmt.Errorf("invalid reconnect delay %vs", timeouts.ReconnectDelaySec)
+	}
+	if timeouts.CtrlLossTimeoutSec < -1 {
+		return fmt.Errorf("invalid controller loss timeout %vs", timeouts.CtrlLossTimeoutSec)
+	}
+	if timeouts.FastIoFailTimeoutSec < -1 {
+		return fmt.Errorf("invalid fast I/O fail timeout %vs", timeouts.FastIoFailTimeoutSec)
+	}
+	if timeouts.CtrlLossTimeoutSec > 0 && timeouts.FastIoFailTimeoutSec > timeouts.CtrlLossTimeoutSec {
+		return fmt.Errorf("fast I/O fail timeout %vs is longer than the controller loss timeout %vs",
+			timeouts.FastIoFailTimeoutSec, timeouts.CtrlLossTimeoutSec)
+	}
+	return nil
+}
+
+// InstanceIOTimeoutSet sets the I/O timeouts of the frontend of the engine, and keeps them for the controllers
+// connected later.
+func (s *Server) InstanceIOTimeoutSet(ctx context.Context, req *rpc.InstanceIOTimeoutSetRequest) (*rpc.InstanceIOTimeoutResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"dataEngine": req.DataEngine,
+		"timeouts":   req.Timeouts,
+	}).Info("Setting instance I/O timeouts")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+	if err := validateInstanceIOTimeouts(req.Timeouts); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	return ops.InstanceIOTimeoutSet(ctx, req)
+}
+
+// InstanceIOTimeoutGet returns the I/O timeouts set for the frontend of the engine along with the ones in effect.
+func (s *Server) InstanceIOTimeoutGet(ctx context.Context, req *rpc.InstanceIOTimeoutGetRequest) (*rpc.InstanceIOTimeoutResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"dataEngine": req.DataEngine,
+	}).Trace("Getting instance I/O timeouts")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	return ops.InstanceIOTimeoutGet(ctx, req)
+}
+
+func (ops V1DataEngineInstanceOps) InstanceIOTimeoutSet(ctx context.Context, req *rpc.InstanceIOTimeoutSetRequest) (*rpc.InstanceIOTimeoutResponse, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "cannot set the I/O timeouts of %v since only the engine frontends of the v2 data engine are supported", req.Name)
+}
+
+func (ops V1DataEngineInstanceOps) InstanceIOTimeoutGet(ctx context.Context, req *rpc.InstanceIOTimeoutGetRequest) (*rpc.InstanceIOTimeoutResponse, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "cannot get the I/O timeouts of %v since only the engine frontends of the v2 data engine are supported", req.Name)
+}
+
+func (ops V2DataEngineInstanceOps) InstanceIOTimeoutSet(ctx context.Context, req *rpc.InstanceIOTimeoutSetRequest) (*rpc.InstanceIOTimeoutResponse, error) {
+	if err := ops.checkEngineNVMeFrontend(req.Name); err != nil {
+		return nil, err
+	}
+
+	configured := ops.ioTimeouts.set(req.Name, req.Timeouts)
+	controllers, err := findEngineNVMeControllers(req.Name)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to find the NVMe controllers of engine %v", req.Name).Error())
+	}
+	for _, controller := range controllers {
+		if err := setNVMeControllerIOTimeouts(controller, configured); err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to set the I/O timeouts of engine %v", req.Name).Error())
+		}
+	}
+
+	return getInstanceIOTimeoutResponse(req.Name, configured, controllers)
+}
+
+func (ops V2DataEngineInstanceOps) InstanceIOTimeoutGet(ctx context.Context, req *rpc.InstanceIOTimeoutGetRequest) (*rpc.InstanceIOTimeoutResponse, error) {
+	if err := ops.checkEngineNVMeFrontend(req.Name); err != nil {
+		return nil, err
+	}
+
+	controllers, err := findEngineNVMeControllers(req.Name)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to find the NVMe controllers of engine %v", req.Name).Error())
+	}
+	return getInstanceIOTimeoutResponse(req.Name, ops.ioTimeouts.get(req.Name), controllers)
+}
+
+// checkEngineNVMeFrontend checks that the engine exposes its frontend to the node by NVMe-oF, whose controllers are
+// the ones the timeouts are set for.
+func (ops V2DataEngineInstanceOps) checkEngineNVMeFrontend(name string) error {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return err
+	}
+
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	engine, err := c.EngineGet(name)
+	if err != nil {
+		return err
+	}
+	if engine.Frontend != spdktypes.FrontendSPDKTCPBlockdev {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v has no NVMe-oF frontend on the node but %v", name, engine.Frontend)
+	}
+	return nil
+}
+
+func getInstanceIOTimeoutResponse(name string, configured *rpc.InstanceIOTimeouts, controllers []string) (*rpc.InstanceIOTimeoutResponse, error) {
+	resp := &rpc.InstanceIOTimeoutResponse{
+		Name:       name,
+		Configured: configured,
+		Current:    &rpc.InstanceIOTimeouts{},
+	}
+	for _, controller := range controllers {
+		resp.Controllers = append(resp.Controllers, filepath.Base(controller))
+	}
+	if len(controllers) == 0 {
+		return resp, nil
+	}
+
+	// The controllers of an engine are set alike, so the first one tells the timeouts in effect
+	current, err := getNVMeControllerIOTimeouts(controllers[0])
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to get the I/O timeouts of engine %v", name).Error())
+	}
+	resp.Current = current
+	return resp, nil
+}
+
+// findEngineNVMeControllers returns the sysfs directories of the NVMe controllers connected to the subsystem of the
+// engine, ordered by name.
+func findEngineNVMeControllers(engineName string) ([]string, error) {
+	nqn := helpertypes.GetNQN(engineName)
+
+	paths, err := filepath.Glob(filepath.Join(nvmeClassDirectory, "nvme*"))
+	if err != nil {
+		return nil, err
+	}
+	controllers := []string{}
+	for _, path := range paths {
+		subsystemNQN, err := readSysfsAttribute(filepath.Join(path, "subsysnqn"))
+		if err != nil {
+			// The controller may be removed in the meantime
+			continue
+		}
+		if subsystemNQN == nqn {
+			controllers = append(controllers, path)
+		}
+	}
+	sort.Strings(controllers)
+	return controllers, nil
+}
+
+// getNVMeNamespaceQueueDirs returns the queue directories of the namespaces of the controller, i.e. of nvme<c>n<n>
+// or, with the native multipath, of the path devices nvme<s>c<c>n<n>.
+func getNVMeNamespaceQueueDirs(controller string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(controller, "nvme*n*", "queue"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// setNVMeControllerIOTimeouts sets the non-zero timeouts of the controller and its namespaces. The reconnect delay is
+// set first, since the kernel turns the controller loss timeout into a number of reconnections by it.
+func setNVMeControllerIOTimeouts(controller string, timeouts *rpc.InstanceIOTimeouts) error {
+	for _, attr := range []struct {
+		name  string
+		value int32
+	}{
+		{"reconnect_delay", timeouts.ReconnectDelaySec},
+		{"ctrl_loss_tmo", timeouts.CtrlLossTimeoutSec},
+		{"fast_io_fail_tmo", timeouts.FastIoFailTimeoutSec},
+	} {
+		if attr.value == 0 {
+			continue
+		}
+		if err := updateSysfsTimeout(filepath.Join(controller, attr.name), attr.value); err != nil {
+			return err
+		}
+	}
+
+	if timeouts.IoTimeoutMs == 0 {
+		return nil
+	}
+	queueDirs, err := getNVMeNamespaceQueueDirs(controller)
+	if err != nil {
+		return err
+	}
+	for _, queueDir := range queueDirs {
+		if err := updateSysfsTimeout(filepath.Join(queueDir, "io_timeout"), timeouts.IoTimeoutMs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getNVMeControllerIOTimeouts(controller string) (*rpc.InstanceIOTimeouts, error) {
+	timeouts := &rpc.InstanceIOTimeouts{}
+
+	var err error
+	if timeouts.ReconnectDelaySec, err = readSysfsTimeout(filepath.Join(controller, "reconnect_delay")); err != nil {
+		return nil, err
+	}
+	if timeouts.CtrlLossTimeoutSec, err = readSysfsTimeout(filepath.Join(controller, "ctrl_loss_tmo")); err != nil {
+		return nil, err
+	}
+	if timeouts.FastIoFailTimeoutSec, err = readSysfsTimeout(filepath.Join(controller, "fast_io_fail_tmo")); err != nil {
+		return nil, err
+	}
+
+	queueDirs, err := getNVMeNamespaceQueueDirs(controller)
+	if err != nil {
+		return nil, err
+	}
+	if len(queueDirs) > 0 {
+		if timeouts.IoTimeoutMs, err = readSysfsTimeout(filepath.Join(queueDirs[0], "io_timeout")); err != nil {
+			return nil, err
+		}
+	}
+	return timeouts, nil
+}
+
+// updateSysfsTimeout writes the timeout unless it is in effect already, so that the timeouts set again periodically
+// are mostly left alone.
+func updateSysfsTimeout(path string, value int32) error {
+	current, err := readSysfsTimeout(path)
+	if err != nil {
+		return err
+	}
+	if current == value {
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(strconv.Itoa(int(value))), 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %v to %v", value, path)
+	}
+	return nil
+}
+
+func readSysfsTimeout(path string) (int32, error) {
+	value, err := readSysfsAttribute(path)
+	if err != nil {
+		return 0, err
+	}
+	if value == nvmeTimeoutOff {
+		return -1, nil
+	}
+	timeout, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %v", path)
+	}
+	return int32(timeout), nil
+}
+
+func readSysfsAttribute(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read %v", path)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
@@ -0,0 +1,235 @@
+package instance
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+)
+
+const (
+	// maxInstanceJournalTombstones caps the deleted instances remembered by the journal. The deltas since the
+	// revisions older than the forgotten deletions cannot be told, so they are answered by full lists.
+	maxInstanceJournalTombstones = 1024
+	// maxInstanceJournalEvents caps the events kept for the replay to the resumed watches. The watches resumed
+	// from before the forgotten events are asked to resync.
+	maxInstanceJournalEvents = 4096
+)
+
+type instanceJournalEntry struct {
+	revision uint64
+	// instance is the last known instance, which is kept after the deletion for the filters of the deltas
+	instance *rpc.InstanceResponse
+	deleted  bool
+}
+
+// instanceJournalEvent is a change of an instance kept for the replay, along with the labels before and after the
+// change for the selectors of the watches.
+type instanceJournalEvent struct {
+	revision  uint64
+	event     *rpc.InstanceWatchEvent
+	oldLabels map[string]string
+	newLabels map[string]string
+}
+
+// instanceJournal tracks the revision at which each instance last changed, so that InstanceList can return only the
+// changes since a previous list. The journal is fed by the full lists, including the ones of the watches, so the
+// changes between two lists are seen as one. The resource usage changes on every list and is not tracked.
+//
+// The journal also keeps the latest changes as watch events, so that a resumed watch can replay the ones it missed.
+//
+// The resource version is <epoch>-<revision>, where the epoch tells apart the revisions of the instance manager
+// restarts, since the journal is not persisted. It is the resume token of the watches as well.
+type instanceJournal struct {
+	lock *sync.Mutex
+
+	epoch    string
+	revision uint64
+	// compactedRevision is the latest revision of the forgotten deletions
+	compactedRevision uint64
+	entries           map[string]*instanceJournalEntry
+
+	events []*instanceJournalEvent
+	// compactedEventRevision is the latest revision of the forgotten events
+	compactedEventRevision uint64
+}
+
+func newInstanceJournal() *instanceJournal {
+	return &instanceJournal{
+		lock:    &sync.Mutex{},
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		entries: map[string]*instanceJournalEntry{},
+	}
+}
+
+// update records the changes of the instances against the last full list, and returns the resource version of the
+// instances.
+func (j *instanceJournal) update(instances map[string]*rpc.InstanceResponse) string {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	changed := []string{}
+	for name, instance := range instances {
+		entry, exists := j.entries[name]
+		if !exists || entry.deleted || !proto.Equal(entry.instance.Spec, instance.Spec) || !instanceStatusEqual(entry.instance.Status, instance.Status) {
+			changed = append(changed, name)
+		}
+	}
+	for name, entry := range j.entries {
+		if _, exists := instances[name]; !exists && !entry.deleted {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) == 0 {
+		return j.resourceVersion()
+	}
+
+	j.revision++
+	sort.Strings(changed)
+	timestamp := time.Now().UTC().Format(time.RFC3339Nano)
+	for _, name := range changed {
+		entry := j.entries[name]
+		instance, exists := instances[name]
+		if !exists {
+			event := newInstanceWatchEvent(types.InstanceWatchEventTypeDeleted, name, entry.instance, entry.instance.GetStatus().GetState(), timestamp)
+			event.NewState = ""
+			j.recordEvent(event, entry.instance.GetSpec().GetLabels(), nil)
+
+			entry.revision = j.revision
+			entry.deleted = true
+			continue
+		}
+
+		if entry == nil || entry.deleted {
+			j.recordEvent(newInstanceWatchEvent(types.InstanceWatchEventTypeAdded, name, instance, "", timestamp), nil, instance.GetSpec().GetLabels())
+		} else {
+			j.recordEvent(newInstanceWatchEvent(types.InstanceWatchEventTypeModified, name, instance, entry.instance.GetStatus().GetState(), timestamp),
+				entry.instance.GetSpec().GetLabels(), instance.GetSpec().GetLabels())
+		}
+		j.entries[name] = &instanceJournalEntry{
+			revision: j.revision,
+			instance: instance,
+		}
+	}
+	// Only the last event of the revision resumes after the revision, so that a watch interrupted in the middle
+	// of the events of a revision replays all of them
+	j.events[len(j.events)-1].event.ResumeToken = j.resourceVersion()
+	j.compact()
+	return j.resourceVersion()
+}
+
+// recordEvent keeps the event of the current revision, and forgets the oldest events beyond the limit. It is called
+// with the lock held.
+func (j *instanceJournal) recordEvent(event *rpc.InstanceWatchEvent, oldLabels, newLabels map[string]string) {
+	event.ResumeToken = fmt.Sprintf("%v-%v", j.epoch, j.revision-1)
+	j.events = append(j.events, &instanceJournalEvent{
+		revision:  j.revision,
+		event:     event,
+		oldLabels: oldLabels,
+		newLabels: newLabels,
+	})
+	if len(j.events) > maxInstanceJournalEvents {
+		forgotten := len(j.events) - maxInstanceJournalEvents
+		j.compactedEventRevision = j.events[forgotten-1].revision
+		j.events = append([]*instanceJournalEvent{}, j.events[forgotten:]...)
+	}
+}
+
+// compact forgets the oldest deletions beyond the limit. It is called with the lock held.
+func (j *instanceJournal) compact() {
+	tombstones := []string{}
+	for name, entry := range j.entries {
+		if entry.deleted {
+			tombstones = append(tombstones, name)
+		}
+	}
+	if len(tombstones) <= maxInstanceJournalTombstones {
+		return
+	}
+
+	sort.Slice(tombstones, func(a, b int) bool { return j.entries[tombstones[a]].revision < j.entries[tombstones[b]].revision })
+	for _, name := range tombstones[:len(tombstones)-maxInstanceJournalTombstones] {
+		if revision := j.entries[name].revision; revision > j.compactedRevision {
+			j.compactedRevision = revision
+		}
+		delete(j.entries, name)
+	}
+}
+
+// since returns the instances changed and the names of the ones deleted after the resource version, along with the
+// current resource version. It returns false if the changes are unknown, e.g. the resource version is from before a
+// restart or older than the forgotten deletions.
+func (j *instanceJournal) since(resourceVersion string, match func(name string, instance *rpc.InstanceResponse) bool) (changed map[string]*rpc.InstanceResponse, deleted []string, currentResourceVersion string, ok bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	revision, err := j.parseResourceVersion(resourceVersion)
+	if err != nil || revision < j.compactedRevision || revision > j.revision {
+		return nil, nil, j.resourceVersion(), false
+	}
+
+	changed = map[string]*rpc.InstanceResponse{}
+	deleted = []string{}
+	for name, entry := range j.entries {
+		if entry.revision <= revision || !match(name, entry.instance) {
+			continue
+		}
+		if entry.deleted {
+			deleted = append(deleted, name)
+		} else {
+			changed[name] = entry.instance
+		}
+	}
+	sort.Strings(deleted)
+	return changed, deleted, j.resourceVersion(), true
+}
+
+// eventsSince returns the events after the resume token up to the resource version, the oldest first. It returns
+// false if the events are unknown, e.g. the resume token is from before a restart or older than the forgotten events.
+func (j *instanceJournal) eventsSince(resumeToken, resourceVersion string) ([]*instanceJournalEvent, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	since, err := j.parseResourceVersion(resumeToken)
+	if err != nil || since < j.compactedEventRevision || since > j.revision {
+		return nil, false
+	}
+	until, err := j.parseResourceVersion(resourceVersion)
+	if err != nil {
+		until = j.revision
+	}
+
+	events := []*instanceJournalEvent{}
+	for _, e := range j.events {
+		if e.revision > since && e.revision <= until {
+			events = append(events, e)
+		}
+	}
+	return events, true
+}
+
+// currentResourceVersion returns the resource version of the instances last listed.
+func (j *instanceJournal) currentResourceVersion() string {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	return j.resourceVersion()
+}
+
+func (j *instanceJournal) resourceVersion() string {
+	return fmt.Sprintf("%v-%v", j.epoch, j.revision)
+}
+
+func (j *instanceJournal) parseResourceVersion(resourceVersion string) (uint64, error) {
+	parts := strings.SplitN(resourceVersion, "-", 2)
+	if len(parts) != 2 || parts[0] != j.epoch {
+		return 0, fmt.Errorf("resource version %v is not of epoch %v", resourceVersion, j.epoch)
+	}
+	return strconv.ParseUint(parts[1], 10, 64)
+}
@@ -0,0 +1,69 @@
+package instance
+
+import (
+	"sync"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// instanceLabelStore keeps the labels of the v2 instances, since the SPDK service has no place for them. The
+// labels of the v1 instances are kept by the process manager along with the processes.
+type instanceLabelStore struct {
+	lock   *sync.RWMutex
+	labels map[string]map[string]string
+}
+
+func newInstanceLabelStore() *instanceLabelStore {
+	return &instanceLabelStore{
+		lock:   &sync.RWMutex{},
+		labels: map[string]map[string]string{},
+	}
+}
+
+func (s *instanceLabelStore) set(name string, labels map[string]string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(labels) == 0 {
+		delete(s.labels, name)
+		return
+	}
+	s.labels[name] = copyLabels(labels)
+}
+
+func (s *instanceLabelStore) get(name string) map[string]string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	labels, exists := s.labels[name]
+	if !exists {
+		return nil
+	}
+	return copyLabels(labels)
+}
+
+func (s *instanceLabelStore) delete(name string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.labels, name)
+}
+
+// apply sets the labels of the instance in the response.
+func (s *instanceLabelStore) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if labels, exists := s.labels[resp.GetSpec().GetName()]; exists && resp.Spec != nil {
+		resp.Spec.Labels = copyLabels(labels)
+	}
+	return resp
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	ret := map[string]string{}
+	for key, value := range labels {
+		ret[key] = value
+	}
+	return ret
+}
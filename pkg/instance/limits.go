@@ -0,0 +1,135 @@
+package instance
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// InstanceLimits caps the instances on the node, so that an overloaded node rejects the creations with
+// ResourceExhausted, which the scheduler can redirect elsewhere, rather than failing in the kernel later. A limit
+// is disabled if 0.
+type InstanceLimits struct {
+	MaxInstances int
+	MaxEngines   int
+	MaxReplicas  int
+
+	// MaxConcurrentOperations bounds the creations and deletions running at once for each data engine, e.g. so that
+	// a storm of creations during a recovery cannot exhaust the hugepages of SPDK. Not bounded if 0.
+	MaxConcurrentOperations map[rpc.DataEngine]int
+}
+
+func (l *InstanceLimits) enabled() bool {
+	return l != nil && (l.MaxInstances > 0 || l.MaxEngines > 0 || l.MaxReplicas > 0)
+}
+
+// instanceLimiter admits the creations within the limits. The instances being created are counted as well, so that
+// the concurrent creations cannot exceed the limits together.
+type instanceLimiter struct {
+	lock     *sync.Mutex
+	limits   *InstanceLimits
+	creating map[string]string
+}
+
+func newInstanceLimiter(limits *InstanceLimits) *instanceLimiter {
+	return &instanceLimiter{
+		lock:     &sync.Mutex{},
+		limits:   limits,
+		creating: map[string]string{},
+	}
+}
+
+type instanceCounts struct {
+	total    int
+	engines  int
+	replicas int
+}
+
+func (c *instanceCounts) add(instanceType string) {
+	c.total++
+	switch instanceType {
+	case types.InstanceTypeEngine:
+		c.engines++
+	case types.InstanceTypeReplica:
+		c.replicas++
+	}
+}
+
+// admit reserves the creation of the instance if it is within the limits. The returned function releases the
+// reservation once the creation is done. An existing instance, e.g. one being adopted, is always admitted.
+func (l *instanceLimiter) admit(ctx context.Context, s *Server, spec *rpc.InstanceSpec) (func(), error) {
+	if !l.limits.enabled() {
+		return func() {}, nil
+	}
+
+	resp, err := s.InstanceList(ctx, &rpc.InstanceListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := resp.Instances[spec.Name]; exists {
+		return func() {}, nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	counts := &instanceCounts{}
+	for name, instance := range resp.Instances {
+		if _, creating := l.creating[name]; !creating {
+			counts.add(getInstanceType(instance))
+		}
+	}
+	for _, instanceType := range l.creating {
+		counts.add(instanceType)
+	}
+
+	exceeded := ""
+	switch {
+	case l.limits.MaxInstances > 0 && counts.total >= l.limits.MaxInstances:
+		exceeded = "instances"
+	case spec.Type == types.InstanceTypeEngine && l.limits.MaxEngines > 0 && counts.engines >= l.limits.MaxEngines:
+		exceeded = "engines"
+	case spec.Type == types.InstanceTypeReplica && l.limits.MaxReplicas > 0 && counts.replicas >= l.limits.MaxReplicas:
+		exceeded = "replicas"
+	}
+	if exceeded != "" {
+		return nil, grpcstatus.Errorf(grpccodes.ResourceExhausted,
+			"cannot create %v %v since the node reaches the limit of %v: %v instances (max %v), %v engines (max %v), %v replicas (max %v)",
+			spec.Type, spec.Name, exceeded, counts.total, l.limits.MaxInstances, counts.engines, l.limits.MaxEngines, counts.replicas, l.limits.MaxReplicas)
+	}
+
+	l.creating[spec.Name] = spec.Type
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		delete(l.creating, spec.Name)
+	}, nil
+}
+
+// getInstanceType returns the type of the instance. The v1 instances have no type, so it is told by the
+// subcommand of the longhorn binary.
+func getInstanceType(instance *rpc.InstanceResponse) string {
+	if instanceType := instance.GetSpec().GetType(); instanceType != "" {
+		return instanceType
+	}
+	for _, arg := range instance.GetSpec().GetProcessInstanceSpec().GetArgs() {
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+		switch arg {
+		case "controller":
+			return types.InstanceTypeEngine
+		case "replica":
+			return types.InstanceTypeReplica
+		}
+		break
+	}
+	return ""
+}
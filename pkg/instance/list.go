@@ -0,0 +1,95 @@
+package instance
+
+import (
+	"sort"
+	"strings"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+// maxInstanceListPageSize caps the page size, so that a response stays well below the gRPC message size limit
+const maxInstanceListPageSize = 1000
+
+func matchInstanceListDataEngine(req *rpc.InstanceListRequest, dataEngine rpc.DataEngine) bool {
+	if len(req.DataEngines) == 0 {
+		return true
+	}
+	for _, e := range req.DataEngines {
+		if e == dataEngine {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func matchInstanceListFilters(req *rpc.InstanceListRequest, selector util.LabelSelector, name string, instance *rpc.InstanceResponse) bool {
+	if !strings.HasPrefix(name, req.NamePrefix) {
+		return false
+	}
+	if !matchInstanceListDataEngine(req, instance.GetSpec().GetDataEngine()) {
+		return false
+	}
+	if len(req.Types) != 0 && !containsString(req.Types, instance.GetSpec().GetType()) {
+		return false
+	}
+	if len(req.States) != 0 && !containsString(req.States, instance.GetStatus().GetState()) {
+		return false
+	}
+	if !selector.Matches(instance.GetSpec().GetLabels()) {
+		return false
+	}
+	return true
+}
+
+// filterInstanceList returns the page of the instances matching the filters of the request. The page token is the
+// name of the last instance of the previous page, so that the instances created or deleted between the pages do not
+// shift the pages.
+func filterInstanceList(req *rpc.InstanceListRequest, selector util.LabelSelector, instances map[string]*rpc.InstanceResponse) (*rpc.InstanceListResponse, error) {
+	names := []string{}
+	for name, instance := range instances {
+		if matchInstanceListFilters(req, selector, name, instance) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if req.PageToken != "" {
+		if req.PageSize == 0 {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, "page token requires a page size")
+		}
+		start := sort.Search(len(names), func(i int) bool { return names[i] > req.PageToken })
+		names = names[start:]
+	}
+
+	nextPageToken := ""
+	pageSize := int(req.PageSize)
+	if pageSize > maxInstanceListPageSize {
+		pageSize = maxInstanceListPageSize
+	}
+	if pageSize > 0 && len(names) > pageSize {
+		names = names[:pageSize]
+		nextPageToken = names[len(names)-1]
+	}
+
+	resp := &rpc.InstanceListResponse{
+		Instances:     map[string]*rpc.InstanceResponse{},
+		NextPageToken: nextPageToken,
+	}
+	for _, name := range names {
+		resp.Instances[name] = instances[name]
+	}
+	return resp, nil
+}
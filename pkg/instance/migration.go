@@ -0,0 +1,222 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// engineMigrationTransitions are the phases each phase of an engine migration can move to. A migration in a
+// terminal phase cannot be updated anymore.
+var engineMigrationTransitions = map[string][]string{
+	types.EngineMigrationPhasePending:    {types.EngineMigrationPhaseCopying, types.EngineMigrationPhaseFailed},
+	types.EngineMigrationPhaseCopying:    {types.EngineMigrationPhaseCopying, types.EngineMigrationPhaseValidating, types.EngineMigrationPhaseFailed},
+	types.EngineMigrationPhaseValidating: {types.EngineMigrationPhaseValidating, types.EngineMigrationPhaseCompleted, types.EngineMigrationPhaseFailed},
+	types.EngineMigrationPhaseCompleted:  {},
+	types.EngineMigrationPhaseFailed:     {},
+}
+
+// engineMigrationTracker keeps the status of the data engine migrations of the volumes on this node, e.g. from
+// v1 to v2, reported by the components moving the data.
+type engineMigrationTracker struct {
+	lock       *sync.RWMutex
+	migrations map[string]*rpc.EngineMigration
+}
+
+func newEngineMigrationTracker() *engineMigrationTracker {
+	return &engineMigrationTracker{
+		lock:       &sync.RWMutex{},
+		migrations: map[string]*rpc.EngineMigration{},
+	}
+}
+
+func isTerminalEngineMigrationPhase(phase string) bool {
+	return len(engineMigrationTransitions[phase]) == 0
+}
+
+func (t *engineMigrationTracker) register(req *rpc.EngineMigrationRegisterRequest) (*rpc.EngineMigration, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if m, exists := t.migrations[req.VolumeName]; exists && !isTerminalEngineMigrationPhase(m.Phase) {
+		return nil, grpcstatus.Errorf(grpccodes.AlreadyExists, "engine migration of volume %v is in phase %v", req.VolumeName, m.Phase)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	m := &rpc.EngineMigration{
+		VolumeName:       req.VolumeName,
+		SourceDataEngine: req.SourceDataEngine,
+		TargetDataEngine: req.TargetDataEngine,
+		Phase:            types.EngineMigrationPhasePending,
+		BytesTotal:       req.BytesTotal,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	t.migrations[req.VolumeName] = m
+	return proto.Clone(m).(*rpc.EngineMigration), nil
+}
+
+func (t *engineMigrationTracker) update(req *rpc.EngineMigrationUpdateRequest) (*rpc.EngineMigration, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	current, exists := t.migrations[req.VolumeName]
+	if !exists {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find engine migration of volume %v", req.VolumeName)
+	}
+	if isTerminalEngineMigrationPhase(current.Phase) {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine migration of volume %v is already %v", req.VolumeName, current.Phase)
+	}
+
+	m := proto.Clone(current).(*rpc.EngineMigration)
+	if req.BytesTotal != 0 {
+		m.BytesTotal = req.BytesTotal
+	}
+	if req.BytesCopied < m.BytesCopied {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "copied bytes %v of volume %v cannot be less than %v", req.BytesCopied, req.VolumeName, m.BytesCopied)
+	}
+	if m.BytesTotal != 0 && req.BytesCopied > m.BytesTotal {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "copied bytes %v of volume %v cannot exceed total bytes %v", req.BytesCopied, req.VolumeName, m.BytesTotal)
+	}
+	m.BytesCopied = req.BytesCopied
+
+	switch req.ValidationResult {
+	case "":
+	case types.EngineMigrationValidationPassed, types.EngineMigrationValidationFailed:
+		m.ValidationResult = req.ValidationResult
+		m.ValidationMessage = req.ValidationMessage
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid validation result %v", req.ValidationResult)
+	}
+
+	if req.Phase != "" && req.Phase != m.Phase {
+		allowed := false
+		for _, phase := range engineMigrationTransitions[m.Phase] {
+			if phase == req.Phase {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine migration of volume %v cannot move from phase %v to %v", req.VolumeName, m.Phase, req.Phase)
+		}
+		if req.Phase == types.EngineMigrationPhaseCompleted && m.ValidationResult != types.EngineMigrationValidationPassed {
+			return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine migration of volume %v cannot complete without passing the validation", req.VolumeName)
+		}
+		m.Phase = req.Phase
+	}
+	if m.ValidationResult == types.EngineMigrationValidationFailed {
+		m.Phase = types.EngineMigrationPhaseFailed
+	}
+	if req.ErrorMsg != "" {
+		m.ErrorMsg = req.ErrorMsg
+	}
+	m.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	t.migrations[req.VolumeName] = m
+	return proto.Clone(m).(*rpc.EngineMigration), nil
+}
+
+func (t *engineMigrationTracker) get(volumeName string) (*rpc.EngineMigration, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	m, exists := t.migrations[volumeName]
+	if !exists {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find engine migration of volume %v", volumeName)
+	}
+	return proto.Clone(m).(*rpc.EngineMigration), nil
+}
+
+func (t *engineMigrationTracker) list() map[string]*rpc.EngineMigration {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	migrations := map[string]*rpc.EngineMigration{}
+	for name, m := range t.migrations {
+		migrations[name] = proto.Clone(m).(*rpc.EngineMigration)
+	}
+	return migrations
+}
+
+func (t *engineMigrationTracker) delete(volumeName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.migrations, volumeName)
+}
+
+func (s *Server) EngineMigrationRegister(ctx context.Context, req *rpc.EngineMigrationRegisterRequest) (*rpc.EngineMigration, error) {
+	logrus.WithFields(logrus.Fields{
+		"volumeName":       req.VolumeName,
+		"sourceDataEngine": req.SourceDataEngine,
+		"targetDataEngine": req.TargetDataEngine,
+		"bytesTotal":       req.BytesTotal,
+	}).Info("Registering engine migration")
+
+	if req.VolumeName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "volume name is required")
+	}
+	if req.SourceDataEngine == req.TargetDataEngine {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "source and target data engines are both %v", req.SourceDataEngine)
+	}
+	if req.TargetDataEngine == rpc.DataEngine_DATA_ENGINE_V2 && !s.v2DataEngineEnabled {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "target data engine %v is not enabled", req.TargetDataEngine)
+	}
+	return s.migrations.register(req)
+}
+
+func (s *Server) EngineMigrationUpdate(ctx context.Context, req *rpc.EngineMigrationUpdateRequest) (*rpc.EngineMigration, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"volumeName":       req.VolumeName,
+		"phase":            req.Phase,
+		"bytesCopied":      req.BytesCopied,
+		"validationResult": req.ValidationResult,
+	})
+	log.Trace("Updating engine migration")
+
+	if req.VolumeName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "volume name is required")
+	}
+	m, err := s.migrations.update(req)
+	if err != nil {
+		return nil, err
+	}
+	if isTerminalEngineMigrationPhase(m.Phase) {
+		log.Infof("Engine migration of volume %v is %v", req.VolumeName, m.Phase)
+	}
+	return m, nil
+}
+
+func (s *Server) EngineMigrationGet(ctx context.Context, req *rpc.EngineMigrationGetRequest) (*rpc.EngineMigration, error) {
+	if req.VolumeName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "volume name is required")
+	}
+	return s.migrations.get(req.VolumeName)
+}
+
+func (s *Server) EngineMigrationList(ctx context.Context, req *emptypb.Empty) (*rpc.EngineMigrationListResponse, error) {
+	return &rpc.EngineMigrationListResponse{
+		Migrations: s.migrations.list(),
+	}, nil
+}
+
+func (s *Server) EngineMigrationDelete(ctx context.Context, req *rpc.EngineMigrationDeleteRequest) (*emptypb.Empty, error) {
+	logrus.WithFields(logrus.Fields{"volumeName": req.VolumeName}).Info("Deleting engine migration")
+
+	if req.VolumeName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "volume name is required")
+	}
+	s.migrations.delete(req.VolumeName)
+	return &emptypb.Empty{}, nil
+}
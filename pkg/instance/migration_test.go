@@ -0,0 +1,76 @@
+package instance
+
+import (
+	"testing"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct{}
+
+var _ = Suite(&TestSuite{})
+
+func (s *TestSuite) TestEngineMigrationTracker(c *C) {
+	t := newEngineMigrationTracker()
+	register := &rpc.EngineMigrationRegisterRequest{
+		VolumeName:       "vol",
+		SourceDataEngine: rpc.DataEngine_DATA_ENGINE_V1,
+		TargetDataEngine: rpc.DataEngine_DATA_ENGINE_V2,
+		BytesTotal:       100,
+	}
+	m, err := t.register(register)
+	c.Assert(err, IsNil)
+	c.Assert(m.Phase, Equals, types.EngineMigrationPhasePending)
+	_, err = t.register(register)
+	c.Assert(grpcstatus.Code(err), Equals, grpccodes.AlreadyExists)
+
+	testCases := []struct {
+		comment string
+		req     *rpc.EngineMigrationUpdateRequest
+		code    grpccodes.Code
+		phase   string
+	}{
+		{"skipping the copy", &rpc.EngineMigrationUpdateRequest{Phase: types.EngineMigrationPhaseValidating}, grpccodes.FailedPrecondition, types.EngineMigrationPhasePending},
+		{"copying", &rpc.EngineMigrationUpdateRequest{Phase: types.EngineMigrationPhaseCopying, BytesCopied: 50}, grpccodes.OK, types.EngineMigrationPhaseCopying},
+		{"copied bytes going back", &rpc.EngineMigrationUpdateRequest{BytesCopied: 40}, grpccodes.InvalidArgument, types.EngineMigrationPhaseCopying},
+		{"copied bytes beyond the total", &rpc.EngineMigrationUpdateRequest{BytesCopied: 101}, grpccodes.InvalidArgument, types.EngineMigrationPhaseCopying},
+		{"invalid validation result", &rpc.EngineMigrationUpdateRequest{BytesCopied: 100, ValidationResult: "unknown"}, grpccodes.InvalidArgument, types.EngineMigrationPhaseCopying},
+		{"validating", &rpc.EngineMigrationUpdateRequest{Phase: types.EngineMigrationPhaseValidating, BytesCopied: 100}, grpccodes.OK, types.EngineMigrationPhaseValidating},
+		{"completing without the validation", &rpc.EngineMigrationUpdateRequest{Phase: types.EngineMigrationPhaseCompleted, BytesCopied: 100}, grpccodes.FailedPrecondition, types.EngineMigrationPhaseValidating},
+		{"completing", &rpc.EngineMigrationUpdateRequest{Phase: types.EngineMigrationPhaseCompleted, BytesCopied: 100, ValidationResult: types.EngineMigrationValidationPassed}, grpccodes.OK, types.EngineMigrationPhaseCompleted},
+		{"updating the completed", &rpc.EngineMigrationUpdateRequest{BytesCopied: 100}, grpccodes.FailedPrecondition, types.EngineMigrationPhaseCompleted},
+	}
+	for i, testCase := range testCases {
+		comment := Commentf("test case %v: %v", i, testCase.comment)
+		testCase.req.VolumeName = "vol"
+		_, err := t.update(testCase.req)
+		c.Assert(grpcstatus.Code(err), Equals, testCase.code, comment)
+		m, err := t.get("vol")
+		c.Assert(err, IsNil, comment)
+		c.Assert(m.Phase, Equals, testCase.phase, comment)
+	}
+
+	// A completed migration can be registered again, and a failed validation fails it in any phase
+	_, err = t.register(register)
+	c.Assert(err, IsNil)
+	m, err = t.update(&rpc.EngineMigrationUpdateRequest{VolumeName: "vol", Phase: types.EngineMigrationPhaseCopying,
+		ValidationResult: types.EngineMigrationValidationFailed, ValidationMessage: "checksum mismatch"})
+	c.Assert(err, IsNil)
+	c.Assert(m.Phase, Equals, types.EngineMigrationPhaseFailed)
+	c.Assert(m.ValidationMessage, Equals, "checksum mismatch")
+
+	_, err = t.update(&rpc.EngineMigrationUpdateRequest{VolumeName: "other"})
+	c.Assert(grpcstatus.Code(err), Equals, grpccodes.NotFound)
+	c.Assert(t.list(), HasLen, 1)
+	t.delete("vol")
+	c.Assert(t.list(), HasLen, 0)
+}
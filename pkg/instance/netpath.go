@@ -0,0 +1,146 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	commonTypes "github.com/longhorn/go-common-libs/types"
+	helperutil "github.com/longhorn/go-spdk-helper/pkg/util"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	networkPathMaxAddresses   = 64
+	networkPathPingTimeout    = 2 * time.Second
+	networkPathConnectTimeout = 3 * time.Second
+	// networkPathSmallPayloadSize is the default payload size of ping, which fits any MTU
+	networkPathSmallPayloadSize = 56
+)
+
+// NetworkPathValidate validates the paths from this node to the addresses, so that an MTU mismatch on the storage
+// network, which passes the small packets but drops the large ones, e.g. the rebuilding traffic, is told apart from
+// an unreachable peer.
+func (s *Server) NetworkPathValidate(ctx context.Context, req *rpc.NetworkPathValidateRequest) (*rpc.NetworkPathValidateResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"addresses":     req.Addresses,
+		"mtu":           req.Mtu,
+		"nvmfDiscovery": req.NvmfDiscovery,
+	}).Info("Validating network paths")
+
+	if len(req.Addresses) == 0 {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "addresses are required")
+	}
+	if len(req.Addresses) > networkPathMaxAddresses {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "at most %v addresses can be validated at once", networkPathMaxAddresses)
+	}
+	if req.Mtu < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid MTU %v", req.Mtu)
+	}
+
+	results := make([]*rpc.NetworkPathResult, len(req.Addresses))
+	wg := &sync.WaitGroup{}
+	for i, address := range req.Addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			results[i] = validateNetworkPath(ctx, address, int(req.Mtu), req.NvmfDiscovery)
+		}(i, address)
+	}
+	wg.Wait()
+
+	return &rpc.NetworkPathValidateResponse{
+		Results: results,
+	}, nil
+}
+
+func validateNetworkPath(ctx context.Context, address string, mtu int, nvmfDiscovery bool) *rpc.NetworkPathResult {
+	result := &rpc.NetworkPathResult{
+		Address: address,
+		Errors:  []string{},
+	}
+	addError := func(format string, args ...interface{}) {
+		result.Errors = append(result.Errors, fmt.Sprintf(format, args...))
+	}
+
+	resolved, err := util.ResolveAddress(ctx, address)
+	if err != nil {
+		addError("%v", err)
+		return result
+	}
+	host, port, err := net.SplitHostPort(resolved)
+	if err != nil {
+		addError("%v", err)
+		return result
+	}
+	ip := net.ParseIP(host)
+
+	iface, err := util.GetRouteInterface(ip)
+	if err != nil {
+		addError("%v", err)
+	} else {
+		result.LocalInterface = iface.Name
+		result.LocalMtu = int32(iface.MTU)
+	}
+	if mtu == 0 {
+		mtu = int(result.LocalMtu)
+	}
+	result.Mtu = int32(mtu)
+
+	if rtt, err := util.Ping(ip, networkPathSmallPayloadSize, networkPathPingTimeout); err != nil {
+		addError("failed to ping: %v", err)
+	} else {
+		result.Reachable = true
+		result.RttNs = int64(rtt)
+	}
+	if result.Reachable && mtu > 0 {
+		if _, err := util.Ping(ip, util.GetPingPayloadSize(ip, mtu), networkPathPingTimeout); err != nil {
+			addError("failed to ping with MTU %v and fragmentation prohibited: %v", mtu, err)
+		} else {
+			result.MtuValid = true
+		}
+	}
+
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", resolved, networkPathConnectTimeout)
+	if err != nil {
+		addError("failed to connect: %v", err)
+	} else {
+		result.TcpConnected = true
+		result.TcpConnectNs = int64(time.Since(start))
+		conn.Close()
+	}
+
+	if nvmfDiscovery && result.TcpConnected {
+		nqn, err := discoverNvmfSubsystem(ctx, host, port)
+		if err != nil {
+			addError("failed to discover NVMe-oF subsystem: %v", err)
+		} else {
+			result.NvmfSubsystemNqn = nqn
+		}
+	}
+	return result
+}
+
+// discoverNvmfSubsystem discovers the subsystem in the host network namespace, where the NVMe-oF initiators of the
+// frontends connect from. The nvme command or the native initiator has its own timeout, so the context is only checked
+// before it starts.
+func discoverNvmfSubsystem(ctx context.Context, ip, port string) (string, error) {
+	executor, err := helperutil.NewExecutor(commonTypes.ProcDirectory)
+	if err != nil {
+		return "", err
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return util.NvmfDiscoverTarget(ip, port, executor)
+}
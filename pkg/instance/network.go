@@ -0,0 +1,156 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	networkStatsUpdateInterval = 30 * time.Second
+)
+
+// networkStatsTracker accumulates the traffic on the ports allocated to the instances by sampling the TCP sockets
+// of the network namespace. A connection opened and closed between two samples is not accounted.
+type networkStatsTracker struct {
+	lock *sync.Mutex
+	// sockets are the sockets seen by the last sample, keyed by the socket cookie
+	sockets   map[uint64]*util.TCPSocketTraffic
+	instances map[string]*rpc.InstanceNetworkStats
+}
+
+func newNetworkStatsTracker() *networkStatsTracker {
+	return &networkStatsTracker{
+		lock:      &sync.Mutex{},
+		sockets:   map[uint64]*util.TCPSocketTraffic{},
+		instances: map[string]*rpc.InstanceNetworkStats{},
+	}
+}
+
+// update samples the sockets and attributes the traffic since the last sample to the instances by the local port.
+func (t *networkStatsTracker) update(instances map[string]*rpc.InstanceResponse) error {
+	portInstances := map[int32]string{}
+	for name, instance := range instances {
+		if instance.Status == nil || instance.Status.PortStart == 0 {
+			continue
+		}
+		for port := instance.Status.PortStart; port <= instance.Status.PortEnd; port++ {
+			portInstances[port] = name
+		}
+	}
+
+	// Sample with the lock held so that the concurrent samples are applied in order
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	sockets, err := util.GetTCPSocketTraffic()
+	if err != nil {
+		return err
+	}
+
+	stats := map[string]*rpc.InstanceNetworkStats{}
+	for name, instance := range instances {
+		if instance.Status == nil || instance.Status.PortStart == 0 {
+			continue
+		}
+		s, ok := t.instances[name]
+		if !ok || s.PortStart != instance.Status.PortStart || s.PortEnd != instance.Status.PortEnd {
+			s = &rpc.InstanceNetworkStats{
+				PortStart: instance.Status.PortStart,
+				PortEnd:   instance.Status.PortEnd,
+			}
+		}
+		s.Connections = 0
+		stats[name] = s
+	}
+
+	currentSockets := map[uint64]*util.TCPSocketTraffic{}
+	for _, socket := range sockets {
+		name, ok := portInstances[socket.LocalPort]
+		if !ok {
+			continue
+		}
+		currentSockets[socket.Cookie] = socket
+
+		sent, received := socket.BytesSent, socket.BytesReceived
+		if last, ok := t.sockets[socket.Cookie]; ok && last.LocalPort == socket.LocalPort &&
+			last.BytesSent <= socket.BytesSent && last.BytesReceived <= socket.BytesReceived {
+			sent -= last.BytesSent
+			received -= last.BytesReceived
+		}
+
+		s := stats[name]
+		s.BytesSent += sent
+		s.BytesReceived += received
+		s.Connections++
+
+		labels := map[string]string{"instance": name}
+		metrics.AddCounter(metrics.MetricInstanceNetworkSentBytes, labels, float64(sent))
+		metrics.AddCounter(metrics.MetricInstanceNetworkReceivedBytes, labels, float64(received))
+	}
+
+	t.sockets = currentSockets
+	t.instances = stats
+	return nil
+}
+
+func (t *networkStatsTracker) get(name string) map[string]*rpc.InstanceNetworkStats {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	stats := map[string]*rpc.InstanceNetworkStats{}
+	for n, s := range t.instances {
+		if name != "" && n != name {
+			continue
+		}
+		stats[n] = &rpc.InstanceNetworkStats{
+			PortStart:     s.PortStart,
+			PortEnd:       s.PortEnd,
+			BytesSent:     s.BytesSent,
+			BytesReceived: s.BytesReceived,
+			Connections:   s.Connections,
+		}
+	}
+	return stats
+}
+
+func (s *Server) updateNetworkStats(ctx context.Context) error {
+	resp, err := s.InstanceList(ctx, &rpc.InstanceListRequest{})
+	if err != nil {
+		return err
+	}
+	return s.networkStats.update(resp.Instances)
+}
+
+func (s *Server) InstanceStats(ctx context.Context, req *rpc.InstanceStatsRequest) (*rpc.InstanceStatsResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name": req.Name,
+	}).Trace("Getting instance stats")
+
+	resp, err := s.InstanceList(ctx, &rpc.InstanceListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != "" {
+		if _, ok := resp.Instances[req.Name]; !ok {
+			return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find instance %v", req.Name)
+		}
+	}
+	if err := s.networkStats.update(resp.Instances); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to get network stats of instances").Error())
+	}
+
+	return &rpc.InstanceStatsResponse{
+		Stats: s.networkStats.get(req.Name),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package instance
+
+import "time"
+
+const (
+	DefaultWatchCoalescingWindow = 100 * time.Millisecond
+)
+
+// watchNotifier coalesces the changes found by the instance watchers into the notifications of a watch client.
+// Reporting a change never blocks, and a pending notification absorbs the changes reported before it is sent, so
+// that a burst of changes, e.g. during a mass rebuild, cannot overflow the watch or overload the client.
+type watchNotifier struct {
+	ch chan struct{}
+}
+
+func newWatchNotifier() *watchNotifier {
+	return &watchNotifier{
+		ch: make(chan struct{}, 1),
+	}
+}
+
+func (n *watchNotifier) notify() {
+	select {
+	case n.ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,66 @@
+package instance
+
+import (
+	"github.com/sirupsen/logrus"
+
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// applyEngineNvmeIdentities sets the NVMe identifiers of the NVMe-oF targets of the engines, so that the CSI node
+// service can match the kernel block devices to the volumes deterministically. They are queried from spdk_tgt, since
+// the SPDK service does not keep them, and are left unset if spdk_tgt cannot be queried.
+func applyEngineNvmeIdentities(clients *backendClientPool, engines map[string]*spdkapi.Engine, instances map[string]*rpc.InstanceResponse) {
+	nqns := map[string]string{}
+	for _, engine := range engines {
+		if engine.Frontend == spdktypes.FrontendEmpty || engine.Port == 0 {
+			continue
+		}
+		nqns[helpertypes.GetNQN(engine.Name)] = engine.Name
+	}
+	if len(nqns) == 0 {
+		return
+	}
+
+	spdkHelperClient, err := clients.getSPDKHelperClient()
+	if err != nil {
+		logrus.WithError(err).Debug("Failed to create SPDK helper client to get the NVMe identifiers of the engines")
+		return
+	}
+
+	subsystems, err := spdkHelperClient.NvmfGetSubsystems("", "")
+	if err != nil {
+		logrus.WithError(err).Debug("Failed to get the NVMe-oF subsystems of the engines")
+		clients.resetSPDKHelperClient(spdkHelperClient)
+		return
+	}
+	for _, subsystem := range subsystems {
+		name, ok := nqns[subsystem.Nqn]
+		if !ok {
+			continue
+		}
+		instance, ok := instances[name]
+		if !ok || instance.Status == nil {
+			continue
+		}
+		identity := &rpc.InstanceNvmeIdentity{
+			SubsystemNqn:     subsystem.Nqn,
+			ControllerSerial: subsystem.SerialNumber,
+			ControllerModel:  subsystem.ModelNumber,
+		}
+		// The RAID bdev of an engine, named after it, is the only namespace of its subsystem
+		for _, ns := range subsystem.Namespaces {
+			if ns.BdevName != name {
+				continue
+			}
+			identity.NamespaceId = ns.Nsid
+			identity.NamespaceUuid = ns.UUID
+			identity.NamespaceNguid = ns.Nguid
+			break
+		}
+		instance.Status.NvmeIdentity = identity
+	}
+}
@@ -0,0 +1,233 @@
+package instance
+
+import (
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	spdkhelpertypes "github.com/longhorn/go-spdk-helper/pkg/spdk/types"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+// nvmeTCPListener is a listen address of the NVMe-oF subsystem of a v2 instance.
+type nvmeTCPListener struct {
+	nqn  string
+	ip   string
+	port string
+}
+
+// nvmeTCPListenerTracker has the NVMe-oF TCP targets of the v2 instances listen on the NICs configured for the
+// storage traffic, the one of its disk for a replica and the global one for an engine. The SPDK service creates the
+// targets on the pod IP, which is kept so that the initiators not aware of the NICs still connect, and the address on
+// the NIC is reported by the ports of the instances. A tracker without config has no NIC.
+type nvmeTCPListenerTracker struct {
+	config  *util.NvmeTCPSocketConfig
+	clients *backendClientPool
+}
+
+func newNvmeTCPListenerTracker(clients *backendClientPool) *nvmeTCPListenerTracker {
+	return &nvmeTCPListenerTracker{
+		clients: clients,
+	}
+}
+
+// resolveIPs returns the IPs of the configured NICs by the NIC names. The NICs failing to resolve are left out.
+func (t *nvmeTCPListenerTracker) resolveIPs() map[string]string {
+	ips := map[string]string{}
+	if t.config == nil {
+		return ips
+	}
+	ifaces := []string{t.config.Interface}
+	for _, iface := range t.config.DiskInterfaces {
+		ifaces = append(ifaces, iface)
+	}
+	for _, iface := range ifaces {
+		if _, ok := ips[iface]; ok || iface == "" {
+			continue
+		}
+		ip, err := util.GetNvmeTCPInterfaceIP(iface)
+		if err != nil {
+			logrus.WithError(err).Debugf("Failed to resolve NVMe-oF TCP interface %v", iface)
+			continue
+		}
+		if ip != "" {
+			ips[iface] = ip
+		}
+	}
+	return ips
+}
+
+// getListenIP returns the IP the target of an instance on the disk listens on in addition to the pod IP, or an
+// empty string if there is none. The disk name is empty for an engine.
+func (t *nvmeTCPListenerTracker) getListenIP(ips map[string]string, diskName string) string {
+	return ips[t.config.GetInterface(diskName)]
+}
+
+// getReplicaListener returns the listener of the target of the replica on the NIC, or nil if there is none. The
+// target of a replica listens on the first port of the replica.
+func (t *nvmeTCPListenerTracker) getReplicaListener(ips map[string]string, replica *spdkapi.Replica) *nvmeTCPListener {
+	ip := t.getListenIP(ips, replica.LvsName)
+	if ip == "" || replica.PortStart == 0 {
+		return nil
+	}
+	return &nvmeTCPListener{
+		nqn:  helpertypes.GetNQN(replica.Name),
+		ip:   ip,
+		port: strconv.Itoa(int(replica.PortStart)),
+	}
+}
+
+// getEngineListener returns the listener of the target of the engine frontend on the NIC, or nil if there is none.
+func (t *nvmeTCPListenerTracker) getEngineListener(ips map[string]string, engine *spdkapi.Engine) *nvmeTCPListener {
+	ip := t.getListenIP(ips, "")
+	if ip == "" || engine.Frontend == spdktypes.FrontendEmpty || engine.Port == 0 {
+		return nil
+	}
+	return &nvmeTCPListener{
+		nqn:  helpertypes.GetNQN(engine.Name),
+		ip:   ip,
+		port: strconv.Itoa(int(engine.Port)),
+	}
+}
+
+// getNvmeTCPListenersToAdd returns the listeners missing from the subsystems. The listeners of the subsystems not
+// created are left out, e.g. of a replica not exposed since its engine is on the same node.
+func getNvmeTCPListenersToAdd(subsystems []spdkhelpertypes.NvmfSubsystem, listeners []*nvmeTCPListener) []*nvmeTCPListener {
+	existing := map[string]map[nvmeTCPListener]bool{}
+	for _, subsystem := range subsystems {
+		addresses := map[nvmeTCPListener]bool{}
+		for _, address := range subsystem.ListenAddresses {
+			addresses[nvmeTCPListener{nqn: subsystem.Nqn, ip: address.Traddr, port: address.Trsvcid}] = true
+		}
+		existing[subsystem.Nqn] = addresses
+	}
+
+	missing := []*nvmeTCPListener{}
+	for _, listener := range listeners {
+		addresses, ok := existing[listener.nqn]
+		if !ok || addresses[*listener] {
+			continue
+		}
+		missing = append(missing, listener)
+	}
+	return missing
+}
+
+// reconcile adds the missing listeners of the v2 instances on the NICs. It is called after the creation of a v2
+// instance, and periodically for the targets exposed later, e.g. the replica exposed once its engine is on another
+// node.
+func (t *nvmeTCPListenerTracker) reconcile(replicas map[string]*spdkapi.Replica, engines map[string]*spdkapi.Engine) {
+	if t.config == nil {
+		return
+	}
+	ips := t.resolveIPs()
+	if len(ips) == 0 {
+		return
+	}
+
+	listeners := []*nvmeTCPListener{}
+	for _, replica := range replicas {
+		if listener := t.getReplicaListener(ips, replica); listener != nil {
+			listeners = append(listeners, listener)
+		}
+	}
+	for _, engine := range engines {
+		if listener := t.getEngineListener(ips, engine); listener != nil {
+			listeners = append(listeners, listener)
+		}
+	}
+	if len(listeners) == 0 {
+		return
+	}
+
+	spdkHelperClient, err := t.clients.getSPDKHelperClient()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to create SPDK helper client to add the NVMe-oF TCP listeners")
+		return
+	}
+	subsystems, err := spdkHelperClient.NvmfGetSubsystems("", "")
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to get the NVMe-oF subsystems to add the NVMe-oF TCP listeners")
+		t.clients.resetSPDKHelperClient(spdkHelperClient)
+		return
+	}
+	for _, listener := range getNvmeTCPListenersToAdd(subsystems, listeners) {
+		if _, err := spdkHelperClient.NvmfSubsystemAddListener(listener.nqn, listener.ip, listener.port,
+			spdkhelpertypes.NvmeTransportTypeTCP, spdkhelpertypes.NvmeAddressFamilyIPv4); err != nil {
+			logrus.WithError(err).Warnf("Failed to add NVMe-oF TCP listener %v:%v to subsystem %v", listener.ip, listener.port, listener.nqn)
+			continue
+		}
+		logrus.Infof("Added NVMe-oF TCP listener %v:%v to subsystem %v", listener.ip, listener.port, listener.nqn)
+	}
+}
+
+// reconcileAll adds the missing listeners of all the v2 instances.
+func (t *nvmeTCPListenerTracker) reconcileAll() {
+	if t.config == nil {
+		return
+	}
+	c, err := t.clients.getSPDKClient()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to create SPDK client to add the NVMe-oF TCP listeners")
+		return
+	}
+	replicas, err := c.ReplicaList()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to list replicas to add the NVMe-oF TCP listeners")
+		return
+	}
+	engines, err := c.EngineList()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to list engines to add the NVMe-oF TCP listeners")
+		return
+	}
+	t.reconcile(replicas, engines)
+}
+
+// applyAddresses sets the addresses of the NVMe-oF TCP ports of the v2 instances on the NICs.
+func (t *nvmeTCPListenerTracker) applyAddresses(replicas map[string]*spdkapi.Replica, engines map[string]*spdkapi.Engine,
+	instances map[string]*rpc.InstanceResponse) {
+	if t.config == nil {
+		return
+	}
+	ips := t.resolveIPs()
+	if len(ips) == 0 {
+		return
+	}
+
+	for name, replica := range replicas {
+		if listener := t.getReplicaListener(ips, replica); listener != nil {
+			setInstancePortAddress(instances[name], types.InstancePortPurposeData, listener.ip)
+		}
+	}
+	for name, engine := range engines {
+		if listener := t.getEngineListener(ips, engine); listener != nil {
+			setInstancePortAddress(instances[name], types.InstancePortPurposeFrontend, listener.ip)
+		}
+	}
+}
+
+func setInstancePortAddress(instance *rpc.InstanceResponse, purpose, address string) {
+	if instance == nil || instance.Status == nil {
+		return
+	}
+	for _, port := range instance.Status.Ports {
+		if port.Protocol == types.InstancePortProtocolNVMeTCP && port.Purpose == purpose {
+			port.Address = address
+		}
+	}
+}
+
+// EnableNvmeTCPListeners has the NVMe-oF TCP targets of the v2 instances listen on the NICs of the config as well.
+func (s *Server) EnableNvmeTCPListeners(config *util.NvmeTCPSocketConfig) {
+	if !s.v2DataEngineEnabled {
+		return
+	}
+	s.nvmeTCPListeners.config = config
+}
@@ -0,0 +1,80 @@
+package instance
+
+import (
+	. "gopkg.in/check.v1"
+
+	spdkhelpertypes "github.com/longhorn/go-spdk-helper/pkg/spdk/types"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+func (s *TestSuite) TestNvmeTCPListeners(c *C) {
+	t := newNvmeTCPListenerTracker(nil)
+	t.config = &util.NvmeTCPSocketConfig{
+		Interface:      "eth1",
+		DiskInterfaces: map[string]string{"disk-1": "eth2", "disk-3": "eth3"},
+	}
+	// eth3 fails to resolve
+	ips := map[string]string{"eth1": "10.0.1.1", "eth2": "10.0.2.1"}
+
+	// A replica listens on the NIC of its disk, or on the global one
+	c.Assert(t.getReplicaListener(ips, &spdkapi.Replica{Name: "r-1", LvsName: "disk-1", PortStart: 20001, PortEnd: 20010}),
+		DeepEquals, &nvmeTCPListener{nqn: helpertypes.GetNQN("r-1"), ip: "10.0.2.1", port: "20001"})
+	c.Assert(t.getReplicaListener(ips, &spdkapi.Replica{Name: "r-2", LvsName: "disk-2", PortStart: 20011, PortEnd: 20020}),
+		DeepEquals, &nvmeTCPListener{nqn: helpertypes.GetNQN("r-2"), ip: "10.0.1.1", port: "20011"})
+	c.Assert(t.getReplicaListener(ips, &spdkapi.Replica{Name: "r-3", LvsName: "disk-3", PortStart: 20021, PortEnd: 20030}), IsNil)
+	c.Assert(t.getReplicaListener(ips, &spdkapi.Replica{Name: "r-4", LvsName: "disk-1"}), IsNil)
+
+	// An engine listens on the global NIC, only with a frontend
+	c.Assert(t.getEngineListener(ips, &spdkapi.Engine{Name: "e-1", Frontend: spdktypes.FrontendSPDKTCPBlockdev, Port: 20031}),
+		DeepEquals, &nvmeTCPListener{nqn: helpertypes.GetNQN("e-1"), ip: "10.0.1.1", port: "20031"})
+	c.Assert(t.getEngineListener(ips, &spdkapi.Engine{Name: "e-2", Frontend: spdktypes.FrontendEmpty, Port: 20032}), IsNil)
+	c.Assert(t.getEngineListener(map[string]string{"eth2": "10.0.2.1"}, &spdkapi.Engine{Name: "e-1", Frontend: spdktypes.FrontendSPDKTCPBlockdev, Port: 20031}), IsNil)
+}
+
+func (s *TestSuite) TestGetNvmeTCPListenersToAdd(c *C) {
+	subsystems := []spdkhelpertypes.NvmfSubsystem{
+		{
+			Nqn: "nqn-r-1",
+			ListenAddresses: []spdkhelpertypes.NvmfSubsystemListenAddress{
+				{Traddr: "10.42.0.5", Trsvcid: "20001"},
+				{Traddr: "10.0.2.1", Trsvcid: "20001"},
+			},
+		},
+		{
+			Nqn: "nqn-r-2",
+			ListenAddresses: []spdkhelpertypes.NvmfSubsystemListenAddress{
+				{Traddr: "10.42.0.5", Trsvcid: "20011"},
+			},
+		},
+	}
+	listeners := []*nvmeTCPListener{
+		{nqn: "nqn-r-1", ip: "10.0.2.1", port: "20001"},
+		{nqn: "nqn-r-2", ip: "10.0.1.1", port: "20011"},
+		// The replica is not exposed
+		{nqn: "nqn-r-3", ip: "10.0.1.1", port: "20021"},
+	}
+	c.Assert(getNvmeTCPListenersToAdd(subsystems, listeners), DeepEquals, []*nvmeTCPListener{
+		{nqn: "nqn-r-2", ip: "10.0.1.1", port: "20011"},
+	})
+}
+
+func (s *TestSuite) TestSetInstancePortAddress(c *C) {
+	resp := replicaResponseToInstanceResponse(&spdkapi.Replica{Name: "r-1", PortStart: 20001, PortEnd: 20010})
+	setInstancePortAddress(resp, types.InstancePortPurposeData, "10.0.2.1")
+	c.Assert(resp.Status.Ports, DeepEquals, []*rpc.InstancePort{
+		{PortStart: 20001, PortEnd: 20001, Protocol: types.InstancePortProtocolNVMeTCP, Purpose: types.InstancePortPurposeData, Address: "10.0.2.1"},
+		{PortStart: 20002, PortEnd: 20010, Protocol: types.InstancePortProtocolNVMeTCP, Purpose: types.InstancePortPurposeRebuild},
+	})
+
+	// A tracker without config reports no address
+	t := newNvmeTCPListenerTracker(nil)
+	resp = replicaResponseToInstanceResponse(&spdkapi.Replica{Name: "r-1", PortStart: 20001, PortEnd: 20010})
+	t.applyAddresses(map[string]*spdkapi.Replica{"r-1": {Name: "r-1", PortStart: 20001}}, nil, map[string]*rpc.InstanceResponse{"r-1": resp})
+	c.Assert(resp.Status.Ports[0].Address, Equals, "")
+}
@@ -0,0 +1,336 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	helperspdktypes "github.com/longhorn/go-spdk-helper/pkg/spdk/types"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+	spdk "github.com/longhorn/longhorn-spdk-engine/pkg/spdk"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// spdkOrphanKindOrder is the order the orphans are handled in, so that a resource is cleaned up before the ones it
+// is built on, e.g. the NVMe-oF subsystem exposing a RAID bdev before the RAID bdev.
+var spdkOrphanKindOrder = map[string]int{
+	types.SpdkOrphanKindNvmfSubsystem:  0,
+	types.SpdkOrphanKindRaidBdev:       1,
+	types.SpdkOrphanKindNvmeController: 2,
+	types.SpdkOrphanKindLvol:           3,
+}
+
+type spdkOrphan struct {
+	resource *rpc.SpdkOrphanResource
+
+	// lvsUUID and size are of an orphan lvol
+	lvsUUID    string
+	size       uint64
+	isSnapshot bool
+}
+
+// spdkOwners are the names of the engines, replicas and disks of the SPDK service. The resources of an owner are
+// named after it, e.g. <replica>-snap-<snapshot> for a snapshot lvol of a replica.
+type spdkOwners map[string]struct{}
+
+func (o spdkOwners) owns(name string) bool {
+	if _, exists := o[name]; exists {
+		return true
+	}
+	for owner := range o {
+		if strings.HasPrefix(name, owner+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// SpdkOrphanReconcile finds the spdk_tgt resources owned by no engine or replica of the SPDK service, and adopts
+// them as replicas or cleans them up. An engine, a RAID bdev or an NVMe-oF resource cannot be adopted without its
+// spec, which is only known by longhorn-manager, so it is skipped on adoption.
+func (s *Server) SpdkOrphanReconcile(ctx context.Context, req *rpc.SpdkOrphanReconcileRequest) (*rpc.SpdkOrphanReconcileResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"action":       req.Action,
+		"dryRun":       req.DryRun,
+		"cleanupLvols": req.CleanupLvols,
+		"portCount":    req.PortCount,
+	}).Info("Reconciling orphan SPDK resources")
+
+	if req.Action != types.SpdkOrphanActionAdopt && req.Action != types.SpdkOrphanActionCleanup {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid action %v", req.Action)
+	}
+	if req.PortCount < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid port count %v", req.PortCount)
+	}
+	if !s.v2DataEngineEnabled {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "data engine %v is not enabled", rpc.DataEngine_DATA_ENGINE_V2)
+	}
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	ops := s.ops[rpc.DataEngine_DATA_ENGINE_V2].(V2DataEngineInstanceOps)
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to create SPDK helper client").Error())
+	}
+	defer spdkHelperClient.Close()
+
+	owners, err := getSpdkOwners(c, s.readOnlyAttachments.list())
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	orphans, err := findSpdkOrphans(spdkHelperClient, owners)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	if req.Action == types.SpdkOrphanActionAdopt {
+		adoptSpdkOrphans(c, orphans, req)
+	} else {
+		cleanupSpdkOrphans(spdkHelperClient, orphans, req)
+	}
+
+	resp := &rpc.SpdkOrphanReconcileResponse{
+		Resources: []*rpc.SpdkOrphanResource{},
+	}
+	for _, orphan := range orphans {
+		resp.Resources = append(resp.Resources, orphan.resource)
+	}
+	return resp, nil
+}
+
+func getSpdkOwners(c *spdkclient.SPDKClient, attachments map[string]*rpc.ReplicaReadOnlyAttachment) (spdkOwners, error) {
+	owners := spdkOwners{}
+
+	replicas, err := c.ReplicaList()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list replicas")
+	}
+	for name, replica := range replicas {
+		owners[name] = struct{}{}
+		owners[replica.LvsName] = struct{}{}
+	}
+
+	engines, err := c.EngineList()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list engines")
+	}
+	for name, engine := range engines {
+		owners[name] = struct{}{}
+		// The engine attaches the remote replicas by their names
+		for replicaName := range engine.ReplicaAddressMap {
+			owners[replicaName] = struct{}{}
+		}
+	}
+
+	for name := range attachments {
+		owners[name+replicaReadOnlySuffix] = struct{}{}
+	}
+	return owners, nil
+}
+
+// findSpdkOrphans returns the orphans in the order they are handled in.
+func findSpdkOrphans(c *spdkhelperclient.Client, owners spdkOwners) ([]*spdkOrphan, error) {
+	orphans := []*spdkOrphan{}
+	addOrphan := func(kind, name, diskName string) *spdkOrphan {
+		orphan := &spdkOrphan{
+			resource: &rpc.SpdkOrphanResource{
+				Kind:     kind,
+				Name:     name,
+				DiskName: diskName,
+			},
+		}
+		orphans = append(orphans, orphan)
+		return orphan
+	}
+
+	subsystems, err := c.NvmfGetSubsystems("", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list NVMe-oF subsystems")
+	}
+	for _, subsystem := range subsystems {
+		prefix := helpertypes.NQNPrefix + ":"
+		if !strings.HasPrefix(subsystem.Nqn, prefix) {
+			continue
+		}
+		if !owners.owns(strings.TrimPrefix(subsystem.Nqn, prefix)) {
+			addOrphan(types.SpdkOrphanKindNvmfSubsystem, subsystem.Nqn, "")
+		}
+	}
+
+	raids, err := c.BdevRaidGetInfoByCategory(helperspdktypes.BdevRaidCategoryAll)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list RAID bdevs")
+	}
+	for _, raid := range raids {
+		if !owners.owns(raid.Name) {
+			addOrphan(types.SpdkOrphanKindRaidBdev, raid.Name, "")
+		}
+	}
+
+	controllers, err := c.BdevNvmeGetControllers("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list NVMe controllers")
+	}
+	for _, controller := range controllers {
+		// The local NVMe disks are attached by PCIe, while the replicas are attached by TCP
+		remote := false
+		for _, ctrlr := range controller.Ctrlrs {
+			if ctrlr.Trid.Trtype == helperspdktypes.NvmeTransportTypeTCP {
+				remote = true
+			}
+		}
+		if remote && !owners.owns(controller.Name) {
+			addOrphan(types.SpdkOrphanKindNvmeController, controller.Name, "")
+		}
+	}
+
+	lvstores, err := c.BdevLvolGetLvstore("", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list lvstores")
+	}
+	for _, lvs := range lvstores {
+		lvols, err := c.BdevLvolGetLvols(lvs.Name, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list lvols of lvstore %v", lvs.Name)
+		}
+		for _, lvol := range lvols {
+			if owners.owns(lvol.Name) {
+				continue
+			}
+			orphan := addOrphan(types.SpdkOrphanKindLvol, lvol.Name, lvs.Name)
+			orphan.lvsUUID = lvs.UUID
+			orphan.isSnapshot = lvol.IsSnapshot
+			if bdevs, err := c.BdevLvolGet(lvol.UUID, 0); err == nil && len(bdevs) != 0 {
+				orphan.size = bdevs[0].NumBlocks * uint64(bdevs[0].BlockSize)
+			}
+		}
+	}
+
+	sort.SliceStable(orphans, func(i, j int) bool {
+		return spdkOrphanKindOrder[orphans[i].resource.Kind] < spdkOrphanKindOrder[orphans[j].resource.Kind]
+	})
+	return orphans, nil
+}
+
+// adoptSpdkOrphans creates the replicas of the orphan head lvols, which takes their snapshot lvols along. The
+// other orphans are skipped.
+func adoptSpdkOrphans(c *spdkclient.SPDKClient, orphans []*spdkOrphan, req *rpc.SpdkOrphanReconcileRequest) {
+	portCount := req.PortCount
+	if portCount == 0 {
+		portCount = 1
+	}
+
+	adopted := spdkOwners{}
+	for _, orphan := range orphans {
+		r := orphan.resource
+		if r.Kind == types.SpdkOrphanKindLvol && !orphan.isSnapshot {
+			adopted[r.Name] = struct{}{}
+		}
+	}
+
+	for _, orphan := range orphans {
+		r := orphan.resource
+		switch {
+		case r.Kind != types.SpdkOrphanKindLvol:
+			r.Action = types.SpdkOrphanActionSkip
+			r.Message = fmt.Sprintf("%v cannot be adopted without the spec of its engine, which is recreated by InstanceCreate", r.Kind)
+		case orphan.isSnapshot:
+			if adopted.owns(r.Name) {
+				r.Action = types.SpdkOrphanActionAdopt
+				r.Message = "adopted along with the replica lvol it belongs to"
+			} else {
+				r.Action = types.SpdkOrphanActionSkip
+				r.Message = "snapshot lvol can only be adopted along with the replica lvol it belongs to"
+			}
+		case strings.HasSuffix(r.Name, "-"+spdk.ReplicaRebuildingLvolSuffix):
+			r.Action = types.SpdkOrphanActionSkip
+			r.Message = "rebuilding lvol is left by an interrupted rebuild and cannot be adopted"
+		case orphan.size == 0:
+			r.Action = types.SpdkOrphanActionSkip
+			r.Message = "cannot get the size of the lvol"
+		default:
+			r.Action = types.SpdkOrphanActionAdopt
+			r.Message = fmt.Sprintf("adopted as replica %v of size %v", r.Name, orphan.size)
+			if req.DryRun {
+				continue
+			}
+			if _, err := c.ReplicaCreate(r.Name, r.DiskName, orphan.lvsUUID, orphan.size, true, portCount); err != nil {
+				r.ErrorMsg = err.Error()
+				logrus.WithError(err).Warnf("Failed to adopt orphan lvol %v as a replica", r.Name)
+			}
+		}
+	}
+}
+
+// cleanupSpdkOrphans deletes the orphans. The orphan lvols are deleted only if requested, in passes so that a
+// snapshot lvol is deleted after its children.
+func cleanupSpdkOrphans(c *spdkhelperclient.Client, orphans []*spdkOrphan, req *rpc.SpdkOrphanReconcileRequest) {
+	pendingLvols := []*spdkOrphan{}
+	for _, orphan := range orphans {
+		r := orphan.resource
+		if r.Kind == types.SpdkOrphanKindLvol && !req.CleanupLvols {
+			r.Action = types.SpdkOrphanActionSkip
+			r.Message = "orphan lvols are only deleted if requested, since their data is lost"
+			continue
+		}
+		r.Action = types.SpdkOrphanActionCleanup
+		if req.DryRun {
+			continue
+		}
+
+		var err error
+		switch r.Kind {
+		case types.SpdkOrphanKindNvmfSubsystem:
+			err = c.StopExposeBdev(r.Name)
+		case types.SpdkOrphanKindRaidBdev:
+			_, err = c.BdevRaidDelete(r.Name)
+		case types.SpdkOrphanKindNvmeController:
+			_, err = c.BdevNvmeDetachController(r.Name)
+		case types.SpdkOrphanKindLvol:
+			pendingLvols = append(pendingLvols, orphan)
+		}
+		if err != nil {
+			r.ErrorMsg = err.Error()
+			logrus.WithError(err).Warnf("Failed to clean up orphan %v %v", r.Kind, r.Name)
+		}
+	}
+
+	for len(pendingLvols) != 0 {
+		remaining := []*spdkOrphan{}
+		for _, orphan := range pendingLvols {
+			r := orphan.resource
+			if _, err := c.BdevLvolDelete(r.DiskName + "/" + r.Name); err != nil {
+				r.ErrorMsg = err.Error()
+				remaining = append(remaining, orphan)
+				continue
+			}
+			r.ErrorMsg = ""
+		}
+		if len(remaining) == len(pendingLvols) {
+			for _, orphan := range remaining {
+				logrus.Warnf("Failed to clean up orphan lvol %v: %v", orphan.resource.Name, orphan.resource.ErrorMsg)
+			}
+			break
+		}
+		pendingLvols = remaining
+	}
+}
@@ -0,0 +1,34 @@
+package instance
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestSpdkOwners(c *C) {
+	owners := spdkOwners{
+		"vol-e-0":   {},
+		"vol-r-abc": {},
+		"disk-1":    {},
+	}
+
+	testCases := []struct {
+		name  string
+		owned bool
+	}{
+		{"vol-e-0", true},
+		{"vol-r-abc", true},
+		{"vol-r-abc-snap-snap-0", true},
+		{"vol-e-0-nvmf-controller", true},
+		{"disk-1", true},
+		// A name only sharing the prefix of an owner without the separator belongs to another resource
+		{"vol-e-00", false},
+		{"vol-r-abcd", false},
+		{"vol-r", false},
+		{"spare-r-12345678", false},
+		{"", false},
+	}
+	for i, testCase := range testCases {
+		c.Assert(owners.owns(testCase.name), Equals, testCase.owned, Commentf("test case %v: name %v", i, testCase.name))
+	}
+	c.Assert(spdkOwners{}.owns("vol-e-0"), Equals, false)
+}
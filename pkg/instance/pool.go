@@ -0,0 +1,194 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/client"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	// backendClientHealthCheckInterval is the minimum interval between the health checks of a pooled client, so
+	// that a busy reconcile loop does not probe the backend on every call
+	backendClientHealthCheckInterval = 10 * time.Second
+)
+
+// backendClientPool shares the clients of the process manager and the SPDK service among the instance RPCs,
+// rather than dialing a new connection per RPC. A client is dialed on the first use, and is redialed once the
+// backend fails a health check. The pooled clients must not be closed by the users.
+//
+// The SPDK helper client talking to spdk_tgt directly is pooled as well, along with the pid of spdk_tgt, since both
+// are needed by every InstanceList of the v2 instances.
+//
+// The watches still use their own clients, since closing the clients is how the watch streams are stopped.
+type backendClientPool struct {
+	lock *sync.Mutex
+
+	processManagerServiceAddress string
+	spdkServiceAddress           string
+
+	pmClient          *client.ProcessManagerClient
+	pmClientCheckedAt time.Time
+
+	spdkClient          *spdkclient.SPDKClient
+	spdkClientCheckedAt time.Time
+
+	spdkHelperClient *spdkhelperclient.Client
+
+	spdkTgtPid       int
+	spdkTgtStartTime uint64
+
+	isHealthy func(address string) bool
+}
+
+func newBackendClientPool(processManagerServiceAddress, spdkServiceAddress string) *backendClientPool {
+	return &backendClientPool{
+		lock:                         &sync.Mutex{},
+		processManagerServiceAddress: processManagerServiceAddress,
+		spdkServiceAddress:           spdkServiceAddress,
+		isHealthy:                    util.GRPCServiceReadinessProbe,
+	}
+}
+
+// getProcessManagerClient returns the shared process manager client, whose calls are bound to the context.
+func (p *backendClientPool) getProcessManagerClient(ctx context.Context) (*client.ProcessManagerClient, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.pmClient != nil && !p.checkHealth(p.processManagerServiceAddress, &p.pmClientCheckedAt) {
+		logrus.Warnf("Redialing the pooled process manager client since %v is unhealthy", p.processManagerServiceAddress)
+		if err := p.pmClient.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close the pooled process manager client")
+		}
+		p.pmClient = nil
+	}
+	if p.pmClient == nil {
+		c, err := client.NewProcessManagerClient("tcp://"+p.processManagerServiceAddress, nil)
+		if err != nil {
+			return nil, err
+		}
+		p.pmClient = c
+		p.pmClientCheckedAt = time.Now()
+	}
+	return p.pmClient.WithContext(ctx), nil
+}
+
+// getSPDKClient returns the shared SPDK client.
+func (p *backendClientPool) getSPDKClient() (*spdkclient.SPDKClient, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.spdkClient != nil && !p.checkHealth(p.spdkServiceAddress, &p.spdkClientCheckedAt) {
+		logrus.Warnf("Redialing the pooled SPDK client since %v is unhealthy", p.spdkServiceAddress)
+		if err := p.spdkClient.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close the pooled SPDK client")
+		}
+		p.spdkClient = nil
+	}
+	if p.spdkClient == nil {
+		c, err := spdkclient.NewSPDKClient(p.spdkServiceAddress)
+		if err != nil {
+			return nil, err
+		}
+		p.spdkClient = c
+		p.spdkClientCheckedAt = time.Now()
+	}
+	return p.spdkClient, nil
+}
+
+// getSPDKHelperClient returns the shared SPDK helper client. spdk_tgt has no health check of its own, so a user
+// failing a call over the client reports it by resetSPDKHelperClient for the next user to redial.
+func (p *backendClientPool) getSPDKHelperClient() (*spdkhelperclient.Client, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.spdkHelperClient == nil {
+		c, err := spdkhelperclient.NewClient(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		p.spdkHelperClient = c
+	}
+	return p.spdkHelperClient, nil
+}
+
+// resetSPDKHelperClient closes the shared SPDK helper client if it is still c, e.g. since spdk_tgt is restarted.
+func (p *backendClientPool) resetSPDKHelperClient(c *spdkhelperclient.Client) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.spdkHelperClient != c {
+		return
+	}
+	if err := p.spdkHelperClient.Close(); err != nil {
+		logrus.WithError(err).Warn("Failed to close the pooled SPDK helper client")
+	}
+	p.spdkHelperClient = nil
+}
+
+// getSPDKTgtPid returns the pid of spdk_tgt, or 0 if it is not found on the node. The pid is only looked up again
+// once the process of the cached one is gone, rather than scanning /proc on every call.
+func (p *backendClientPool) getSPDKTgtPid() (int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.spdkTgtPid != 0 && util.IsProcessRunning(p.spdkTgtPid, p.spdkTgtStartTime) {
+		return p.spdkTgtPid, nil
+	}
+	p.spdkTgtPid, p.spdkTgtStartTime = 0, 0
+
+	pid, err := util.FindProcessByName(spdkTgtProcessName)
+	if err != nil || pid == 0 {
+		return 0, err
+	}
+	startTime, err := util.GetProcessStartTime(pid)
+	if err != nil {
+		return 0, err
+	}
+	p.spdkTgtPid, p.spdkTgtStartTime = pid, startTime
+	return pid, nil
+}
+
+// checkHealth probes the backend if it has not been checked within the interval. It is called with the lock held.
+func (p *backendClientPool) checkHealth(address string, checkedAt *time.Time) bool {
+	if time.Since(*checkedAt) < backendClientHealthCheckInterval {
+		return true
+	}
+	if !p.isHealthy(address) {
+		return false
+	}
+	*checkedAt = time.Now()
+	return true
+}
+
+// close closes the pooled clients. A later call dials them again.
+func (p *backendClientPool) close() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.pmClient != nil {
+		if err := p.pmClient.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close the pooled process manager client")
+		}
+		p.pmClient = nil
+	}
+	if p.spdkClient != nil {
+		if err := p.spdkClient.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close the pooled SPDK client")
+		}
+		p.spdkClient = nil
+	}
+	if p.spdkHelperClient != nil {
+		if err := p.spdkHelperClient.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close the pooled SPDK helper client")
+		}
+		p.spdkHelperClient = nil
+	}
+}
@@ -0,0 +1,34 @@
+package instance
+
+import (
+	"os"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+func (s *TestSuite) TestBackendClientPoolSPDKTgtPid(c *C) {
+	p := newBackendClientPool("", "")
+
+	// Nothing is cached if spdk_tgt is not found
+	pid, err := p.getSPDKTgtPid()
+	c.Assert(err, IsNil)
+	c.Assert(pid, Equals, 0)
+	c.Assert(p.spdkTgtPid, Equals, 0)
+
+	// The cached pid is kept while its process is running
+	startTime, err := util.GetProcessStartTime(os.Getpid())
+	c.Assert(err, IsNil)
+	p.spdkTgtPid, p.spdkTgtStartTime = os.Getpid(), startTime
+	pid, err = p.getSPDKTgtPid()
+	c.Assert(err, IsNil)
+	c.Assert(pid, Equals, os.Getpid())
+
+	// and is looked up again once a later process reuses it
+	p.spdkTgtStartTime = startTime + 1
+	pid, err = p.getSPDKTgtPid()
+	c.Assert(err, IsNil)
+	c.Assert(pid, Equals, 0)
+	c.Assert(p.spdkTgtPid, Equals, 0)
+}
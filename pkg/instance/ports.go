@@ -0,0 +1,201 @@
+package instance
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+)
+
+const portOwnerTypeInstance = "instance"
+
+// PortsGet returns the port ranges allocated to the instances of each data engine along with the free ones, e.g. to
+// diagnose a port already in use. The v1 ports are the ones of the allocator of the process manager, and the v2 ones
+// are the ones the SPDK instances report.
+func (s *Server) PortsGet(ctx context.Context, req *emptypb.Empty) (*rpc.PortsGetResponse, error) {
+	logrus.Trace("Getting instance ports")
+
+	resp := &rpc.PortsGetResponse{}
+
+	pmClient, err := s.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+	debug, err := pmClient.ProcessManagerDebugGet()
+	if err != nil {
+		return nil, err
+	}
+	v1Ports := &rpc.DataEnginePorts{
+		DataEngine:  rpc.DataEngine_DATA_ENGINE_V1,
+		Range:       &rpc.PortRange{Start: debug.PortRangeStart, End: debug.PortRangeEnd},
+		Allocations: []*rpc.InstancePortAllocation{},
+	}
+	for _, allocation := range debug.PortAllocations {
+		v1Ports.Allocations = append(v1Ports.Allocations, &rpc.InstancePortAllocation{
+			Name:      allocation.ProcessName,
+			PortStart: allocation.PortStart,
+			PortEnd:   allocation.PortEnd,
+			OwnerType: allocation.OwnerType,
+			Allocated: allocation.Allocated,
+		})
+	}
+	setFreePortRanges(v1Ports)
+	resp.DataEngines = append(resp.DataEngines, v1Ports)
+
+	if s.v2DataEngineEnabled {
+		instances := map[string]*rpc.InstanceResponse{}
+		if err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(ctx, instances); err != nil {
+			return nil, err
+		}
+		v2Ports := &rpc.DataEnginePorts{
+			DataEngine:  rpc.DataEngine_DATA_ENGINE_V2,
+			Allocations: []*rpc.InstancePortAllocation{},
+		}
+		if s.SPDKPortRangeStart != 0 || s.SPDKPortRangeEnd != 0 {
+			v2Ports.Range = &rpc.PortRange{Start: s.SPDKPortRangeStart, End: s.SPDKPortRangeEnd}
+		}
+		for name, instance := range instances {
+			status := instance.GetStatus()
+			if status.GetPortStart() == 0 {
+				continue
+			}
+			v2Ports.Allocations = append(v2Ports.Allocations, &rpc.InstancePortAllocation{
+				Name:      name,
+				PortStart: status.PortStart,
+				PortEnd:   status.PortEnd,
+				OwnerType: portOwnerTypeInstance,
+				Allocated: true,
+			})
+		}
+		sort.Slice(v2Ports.Allocations, func(i, j int) bool {
+			return v2Ports.Allocations[i].PortStart < v2Ports.Allocations[j].PortStart
+		})
+		setFreePortRanges(v2Ports)
+		resp.DataEngines = append(resp.DataEngines, v2Ports)
+	}
+
+	return resp, nil
+}
+
+// setFreePortRanges sets the ranges of the ports within the range not allocated to any instance. The ports of the
+// instances not allocated in the allocator count as free, since they may be handed out again.
+func setFreePortRanges(ports *rpc.DataEnginePorts) {
+	ports.Free = []*rpc.PortRange{}
+	ports.FreeCount = 0
+	if ports.Range == nil || ports.Range.End < ports.Range.Start {
+		return
+	}
+
+	allocated := map[int32]bool{}
+	for _, allocation := range ports.Allocations {
+		if !allocation.Allocated {
+			continue
+		}
+		for port := allocation.PortStart; port <= allocation.PortEnd; port++ {
+			allocated[port] = true
+		}
+	}
+
+	var free *rpc.PortRange
+	for port := ports.Range.Start; port <= ports.Range.End; port++ {
+		if allocated[port] {
+			free = nil
+			continue
+		}
+		ports.FreeCount++
+		if free == nil {
+			free = &rpc.PortRange{Start: port}
+			ports.Free = append(ports.Free, free)
+		}
+		free.End = port
+	}
+}
+
+// getProcessInstancePorts returns what each port of a v1 instance serves. The engine serves its gRPC service on the
+// first port. The replica serves its gRPC service, its data path and the service of its sync agent on the first
+// three ports, and the sync agent transfers the files on the rest.
+func getProcessInstancePorts(instance *rpc.InstanceResponse) []*rpc.InstancePort {
+	status := instance.GetStatus()
+	if status.GetPortStart() == 0 || status.PortEnd < status.PortStart {
+		return nil
+	}
+
+	ports := []*rpc.InstancePort{}
+	add := func(offset, count int32, protocol, purpose string) {
+		start := status.PortStart + offset
+		end := start + count - 1
+		if count <= 0 || end > status.PortEnd {
+			end = status.PortEnd
+		}
+		if start > end {
+			return
+		}
+		ports = append(ports, &rpc.InstancePort{
+			PortStart: start,
+			PortEnd:   end,
+			Protocol:  protocol,
+			Purpose:   purpose,
+		})
+	}
+
+	switch getInstanceType(instance) {
+	case types.InstanceTypeEngine:
+		add(0, 1, types.InstancePortProtocolGRPC, types.InstancePortPurposeControl)
+	case types.InstanceTypeReplica:
+		add(0, 1, types.InstancePortProtocolGRPC, types.InstancePortPurposeControl)
+		add(1, 1, types.InstancePortProtocolTCP, types.InstancePortPurposeData)
+		add(2, 1, types.InstancePortProtocolGRPC, types.InstancePortPurposeSyncAgent)
+		add(3, 0, types.InstancePortProtocolHTTP, types.InstancePortPurposeSyncTransfer)
+	default:
+		return nil
+	}
+	return ports
+}
+
+// getReplicaInstancePorts returns what each port of a v2 replica serves. The head is exposed as an NVMe-oF target on
+// the first port, and the lvols are exposed for rebuilding on the rest.
+func getReplicaInstancePorts(portStart, portEnd int32) []*rpc.InstancePort {
+	if portStart == 0 || portEnd < portStart {
+		return nil
+	}
+
+	ports := []*rpc.InstancePort{
+		{
+			PortStart: portStart,
+			PortEnd:   portStart,
+			Protocol:  types.InstancePortProtocolNVMeTCP,
+			Purpose:   types.InstancePortPurposeData,
+		},
+	}
+	if portEnd > portStart {
+		ports = append(ports, &rpc.InstancePort{
+			PortStart: portStart + 1,
+			PortEnd:   portEnd,
+			Protocol:  types.InstancePortProtocolNVMeTCP,
+			Purpose:   types.InstancePortPurposeRebuild,
+		})
+	}
+	return ports
+}
+
+// getEngineInstancePorts returns what the port of a v2 engine serves, which is the NVMe-oF target of its frontend.
+func getEngineInstancePorts(port int32) []*rpc.InstancePort {
+	if port == 0 {
+		return nil
+	}
+	return []*rpc.InstancePort{
+		{
+			PortStart: port,
+			PortEnd:   port,
+			Protocol:  types.InstancePortProtocolNVMeTCP,
+			Purpose:   types.InstancePortPurposeFrontend,
+		},
+	}
+}
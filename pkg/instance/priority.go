@@ -0,0 +1,138 @@
+package instance
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// instancePriorityTracker keeps the priority classes of the v2 instances, since the SPDK service has no place for
+// them. The classes of the v1 instances are kept by the process manager along with the processes. SPDK has no
+// scheduling per instance either, so spdk_tgt, which serves all the v2 instances, runs with the priority of the
+// highest class among them, and gets its own priority back once none of them is above normal.
+type instancePriorityTracker struct {
+	lock    *sync.RWMutex
+	classes map[string]string
+
+	spdkTgtLock *sync.Mutex
+	// spdkTgtPid is the spdk_tgt process spdkTgtClass is set for, and spdkTgtPriority its priority before then
+	spdkTgtPid      int
+	spdkTgtClass    string
+	spdkTgtPriority util.ProcessPriority
+}
+
+func newInstancePriorityTracker() *instancePriorityTracker {
+	return &instancePriorityTracker{
+		lock:        &sync.RWMutex{},
+		classes:     map[string]string{},
+		spdkTgtLock: &sync.Mutex{},
+	}
+}
+
+func (t *instancePriorityTracker) set(name, class string) {
+	t.lock.Lock()
+	if util.PriorityClassRank(class) == util.PriorityClassRank(util.PriorityClassNormal) {
+		delete(t.classes, name)
+	} else {
+		t.classes[name] = class
+	}
+	t.lock.Unlock()
+
+	t.reconcileSpdkTgt()
+}
+
+func (t *instancePriorityTracker) get(name string) string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.classes[name]
+}
+
+func (t *instancePriorityTracker) delete(name string) {
+	t.lock.Lock()
+	_, exists := t.classes[name]
+	delete(t.classes, name)
+	t.lock.Unlock()
+
+	if exists {
+		t.reconcileSpdkTgt()
+	}
+}
+
+// apply sets the priority class of the instance in the response.
+func (t *instancePriorityTracker) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if class, exists := t.classes[resp.GetSpec().GetName()]; exists && resp.Spec != nil {
+		resp.Spec.PriorityClass = class
+	}
+	return resp
+}
+
+func (t *instancePriorityTracker) highestClass() string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	highest := ""
+	for _, class := range t.classes {
+		if util.PriorityClassRank(class) > util.PriorityClassRank(highest) {
+			highest = class
+		}
+	}
+	return highest
+}
+
+// reconcileSpdkTgt sets the priority of spdk_tgt by the highest class of the v2 instances. It is called periodically
+// as well, so that spdk_tgt gets the priority again once restarted.
+func (t *instancePriorityTracker) reconcileSpdkTgt() {
+	highest := t.highestClass()
+
+	t.spdkTgtLock.Lock()
+	defer t.spdkTgtLock.Unlock()
+
+	if highest == "" && t.spdkTgtClass == "" {
+		return
+	}
+
+	pid, err := util.FindProcessByName(spdkTgtProcessName)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to find %v to set its priority", spdkTgtProcessName)
+		return
+	}
+	if pid != t.spdkTgtPid {
+		// A new spdk_tgt starts with its own priority
+		t.spdkTgtPid = pid
+		t.spdkTgtClass = ""
+	}
+	if pid == 0 || highest == t.spdkTgtClass {
+		return
+	}
+
+	if t.spdkTgtClass == "" {
+		priority, err := util.GetProcessPriority(pid)
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to get the priority of %v", spdkTgtProcessName)
+			return
+		}
+		t.spdkTgtPriority = priority
+	}
+	if highest == "" {
+		err = util.SetProcessPriority(pid, t.spdkTgtPriority)
+	} else {
+		err = util.SetProcessPriorityClass(pid, highest)
+	}
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to set the priority of %v for priority class %v", spdkTgtProcessName, highest)
+		return
+	}
+	t.spdkTgtClass = highest
+	if highest == "" {
+		highest = util.PriorityClassNormal
+	}
+	logrus.Infof("Set the priority of %v for priority class %v", spdkTgtProcessName, highest)
+}
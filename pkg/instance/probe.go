@@ -0,0 +1,240 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	volumeDeviceDirectory = "/dev/longhorn"
+
+	latencyProbeBlockSize      = 4096
+	latencyProbeDefaultCount   = 5
+	latencyProbeMaxCount       = 100
+	latencyProbeTimeout        = 30 * time.Second
+	latencyProbeConnectTimeout = 3 * time.Second
+)
+
+// InstanceLatencyProbe measures the latency of tiny direct I/Os to the frontend device of the engine of a volume,
+// through the whole data path, to quickly tell whether a slow volume is caused by the storage path. The connection
+// latencies to the replicas of a v2 engine are measured as well, to tell the network hops apart.
+func (s *Server) InstanceLatencyProbe(ctx context.Context, req *rpc.InstanceLatencyProbeRequest) (*rpc.InstanceLatencyProbeResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":          req.Name,
+		"dataEngine":    req.DataEngine,
+		"volumeName":    req.VolumeName,
+		"count":         req.Count,
+		"write":         req.Write,
+		"scratchOffset": req.ScratchOffset,
+	}).Info("Probing instance latency")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "name is required")
+	}
+	if req.Count < 0 || req.Count > latencyProbeMaxCount {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "count must be between 0 and %v", latencyProbeMaxCount)
+	}
+	if req.ScratchOffset%latencyProbeBlockSize != 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "scratch offset %v is not aligned to %v", req.ScratchOffset, latencyProbeBlockSize)
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	return ops.InstanceLatencyProbe(ctx, req)
+}
+
+func (ops V1DataEngineInstanceOps) InstanceLatencyProbe(ctx context.Context, req *rpc.InstanceLatencyProbeRequest) (*rpc.InstanceLatencyProbeResponse, error) {
+	if req.VolumeName == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "volume name is required for v1 data engine")
+	}
+
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	process, err := pmClient.ProcessGet(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if process.Status.State != types.ProcessStateRunning {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v is %v", req.Name, process.Status.State)
+	}
+
+	return probeInstanceLatency(ctx, req, filepath.Join(volumeDeviceDirectory, req.VolumeName), nil)
+}
+
+func (ops V2DataEngineInstanceOps) InstanceLatencyProbe(ctx context.Context, req *rpc.InstanceLatencyProbeRequest) (*rpc.InstanceLatencyProbeResponse, error) {
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	engine, err := c.EngineGet(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if engine.State != spdktypes.InstanceStateRunning {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v is %v", req.Name, engine.State)
+	}
+	if engine.Frontend != spdktypes.FrontendSPDKTCPBlockdev || !strings.HasPrefix(engine.Endpoint, "/dev/") {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v has no local frontend device", req.Name)
+	}
+
+	return probeInstanceLatency(ctx, req, engine.Endpoint, engine.ReplicaAddressMap)
+}
+
+func probeInstanceLatency(ctx context.Context, req *rpc.InstanceLatencyProbeRequest, device string, replicaAddressMap map[string]string) (*rpc.InstanceLatencyProbeResponse, error) {
+	count := int(req.Count)
+	if count == 0 {
+		count = latencyProbeDefaultCount
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, latencyProbeTimeout)
+	defer cancel()
+
+	type result struct {
+		resp *rpc.InstanceLatencyProbeResponse
+		err  error
+	}
+	// A hung device blocks the I/O for good, so never wait for it longer than the timeout
+	resultCh := make(chan result, 1)
+	go func() {
+		resp := &rpc.InstanceLatencyProbeResponse{
+			Device:           device,
+			ReplicaHops:      map[string]*rpc.LatencyStats{},
+			ReplicaHopErrors: map[string]string{},
+		}
+		var err error
+		resp.Read, resp.Write, err = probeDeviceLatency(device, count, req.Write, int64(req.ScratchOffset))
+		if err != nil {
+			resultCh <- result{err: err}
+			return
+		}
+		for replicaName, address := range replicaAddressMap {
+			hop, err := probeConnectLatency(address, count)
+			if err != nil {
+				resp.ReplicaHopErrors[replicaName] = err.Error()
+				continue
+			}
+			resp.ReplicaHops[replicaName] = hop
+		}
+		resultCh <- result{resp: resp}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, grpcstatus.Errorf(grpccodes.DeadlineExceeded, "timed out probing the latency of device %v", device)
+	case r := <-resultCh:
+		if r.err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, r.err.Error())
+		}
+		return r.resp, nil
+	}
+}
+
+// probeDeviceLatency reads the block at the offset with direct I/O, bypassing the page cache, and writes the same
+// data back if write is enabled, so that the content of the block is kept.
+func probeDeviceLatency(device string, count int, write bool, offset int64) (*rpc.LatencyStats, *rpc.LatencyStats, error) {
+	flags := unix.O_RDONLY | unix.O_DIRECT
+	if write {
+		flags = unix.O_RDWR | unix.O_DIRECT | unix.O_DSYNC
+	}
+	f, err := os.OpenFile(device, flags, 0)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to open device %v", device)
+	}
+	defer f.Close()
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get the size of device %v", device)
+	}
+	if offset+latencyProbeBlockSize > size {
+		return nil, nil, fmt.Errorf("offset %v is beyond the size %v of device %v", offset, size, device)
+	}
+
+	// The direct I/O requires an aligned buffer, which an anonymous mapping is
+	buf, err := unix.Mmap(-1, 0, latencyProbeBlockSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to allocate the I/O buffer")
+	}
+	defer unix.Munmap(buf)
+
+	reads := []time.Duration{}
+	writes := []time.Duration{}
+	for i := 0; i < count; i++ {
+		start := time.Now()
+		if _, err := unix.Pread(int(f.Fd()), buf, offset); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to read device %v at offset %v", device, offset)
+		}
+		reads = append(reads, time.Since(start))
+
+		if !write {
+			continue
+		}
+		start = time.Now()
+		if _, err := unix.Pwrite(int(f.Fd()), buf, offset); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to write device %v at offset %v", device, offset)
+		}
+		writes = append(writes, time.Since(start))
+	}
+	return getLatencyStats(reads), getLatencyStats(writes), nil
+}
+
+func probeConnectLatency(address string, count int) (*rpc.LatencyStats, error) {
+	latencies := []time.Duration{}
+	for i := 0; i < count; i++ {
+		start := time.Now()
+		conn, err := net.DialTimeout("tcp", address, latencyProbeConnectTimeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to connect to %v", address)
+		}
+		latencies = append(latencies, time.Since(start))
+		conn.Close()
+	}
+	return getLatencyStats(latencies), nil
+}
+
+func getLatencyStats(latencies []time.Duration) *rpc.LatencyStats {
+	stats := &rpc.LatencyStats{
+		Count: int32(len(latencies)),
+	}
+	if len(latencies) == 0 {
+		return stats
+	}
+
+	var total time.Duration
+	stats.MinNs = int64(latencies[0])
+	for _, latency := range latencies {
+		total += latency
+		if int64(latency) < stats.MinNs {
+			stats.MinNs = int64(latency)
+		}
+		if int64(latency) > stats.MaxNs {
+			stats.MaxNs = int64(latency)
+		}
+	}
+	stats.AvgNs = int64(total) / int64(len(latencies))
+	return stats
+}
@@ -0,0 +1,352 @@
+package instance
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	commonNet "github.com/longhorn/go-common-libs/net"
+	helpernvme "github.com/longhorn/go-spdk-helper/pkg/nvme"
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	helperutil "github.com/longhorn/go-spdk-helper/pkg/util"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	// replicaReadOnlySuffix names the NVMe-oF subsystem and the device of the attachment apart from the ones of the
+	// replica and its volume
+	replicaReadOnlySuffix            = "-ro"
+	defaultReplicaReadOnlyAttachTTL  = time.Hour
+	maxReplicaReadOnlyAttachTTL      = 24 * time.Hour
+	replicaReadOnlyDetachTaskType    = "replica-read-only-detach"
+	replicaReadOnlyDeviceWaitTimeout = 30 * time.Second
+)
+
+type replicaReadOnlyAttachment struct {
+	attachment *rpc.ReplicaReadOnlyAttachment
+	// detachTaskID is the deferred task tearing down the attachment once it expires
+	detachTaskID string
+}
+
+// replicaReadOnlyAttachmentTracker keeps the v2 replicas exposed read-only on this node. The lvol of the replica is
+// exposed by a dedicated NVMe-oF subsystem, which the host connects to without a device mapper device, and the
+// kernel block device is set read-only. The attachments are torn down by the deferred tasks once expired, including
+// the ones left by the previous instance manager.
+type replicaReadOnlyAttachmentTracker struct {
+	lock        *sync.Mutex
+	attachments map[string]*replicaReadOnlyAttachment
+
+	taskQueue *util.TaskQueue
+}
+
+func newReplicaReadOnlyAttachmentTracker(taskQueue *util.TaskQueue) *replicaReadOnlyAttachmentTracker {
+	t := &replicaReadOnlyAttachmentTracker{
+		lock:        &sync.Mutex{},
+		attachments: map[string]*replicaReadOnlyAttachment{},
+		taskQueue:   taskQueue,
+	}
+	taskQueue.RegisterHandler(replicaReadOnlyDetachTaskType, t.expire)
+	return t
+}
+
+func (t *replicaReadOnlyAttachmentTracker) attach(ctx context.Context, req *rpc.ReplicaReadOnlyAttachRequest) (*rpc.ReplicaReadOnlyAttachment, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	ttl := defaultReplicaReadOnlyAttachTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+
+	t.lock.Lock()
+	if _, exists := t.attachments[req.Name]; exists {
+		t.lock.Unlock()
+		return nil, grpcstatus.Errorf(grpccodes.AlreadyExists, "replica %v is already attached read-only", req.Name)
+	}
+	// Reserve the name while attaching
+	t.attachments[req.Name] = &replicaReadOnlyAttachment{}
+	t.lock.Unlock()
+
+	attachment, err := t.attachReplica(ctx, req, ttl)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if err != nil {
+		delete(t.attachments, req.Name)
+		return nil, err
+	}
+	t.attachments[req.Name] = attachment
+	return proto.Clone(attachment.attachment).(*rpc.ReplicaReadOnlyAttachment), nil
+}
+
+func (t *replicaReadOnlyAttachmentTracker) attachReplica(ctx context.Context, req *rpc.ReplicaReadOnlyAttachRequest, ttl time.Duration) (attachment *replicaReadOnlyAttachment, err error) {
+	spdkHelperClient, err := spdkhelperclient.NewClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to create SPDK helper client").Error())
+	}
+	defer spdkHelperClient.Close()
+
+	lvolName := req.DiskName + "/" + req.Name
+	if _, err := spdkHelperClient.BdevLvolGet(lvolName, 0); err != nil {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find lvol %v of replica %v: %v", lvolName, req.Name, err)
+	}
+
+	ip, err := commonNet.GetIPForPod()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to get the pod IP").Error())
+	}
+	port, err := getFreePort()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	name := req.Name + replicaReadOnlySuffix
+	nqn := helpertypes.GetNQN(name)
+	detachArgs := map[string]string{
+		"name": req.Name,
+		"nqn":  nqn,
+	}
+	// The detach task is scheduled first, so that nothing is left behind even if the instance manager crashes while
+	// attaching
+	detachTaskID, err := t.taskQueue.Enqueue(replicaReadOnlyDetachTaskType, detachArgs, ttl)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to schedule the detachment of replica %v", req.Name).Error())
+	}
+	defer func() {
+		if err != nil {
+			if detachErr := t.expire(detachArgs); detachErr != nil {
+				logrus.WithError(detachErr).Warnf("Failed to clean up the failed read-only attachment of replica %v", req.Name)
+			} else if cancelErr := t.taskQueue.Cancel(detachTaskID); cancelErr != nil {
+				logrus.WithError(cancelErr).Warnf("Failed to cancel the detachment of replica %v", req.Name)
+			}
+		}
+	}()
+
+	if err := spdkHelperClient.StartExposeBdev(nqn, lvolName, ip, strconv.Itoa(port)); err != nil {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "failed to expose lvol %v, which may be in use by the replica: %v", lvolName, err)
+	}
+
+	initiator, err := helpernvme.NewInitiator(name, nqn, helpernvme.HostProc)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	if _, err := initiator.Start(ip, strconv.Itoa(port), false); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	device := initiator.GetEndpoint()
+	if err := setBlockDeviceReadOnly(device); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	now := time.Now()
+	return &replicaReadOnlyAttachment{
+		attachment: &rpc.ReplicaReadOnlyAttachment{
+			Name:       req.Name,
+			DataEngine: req.DataEngine,
+			DiskName:   req.DiskName,
+			Device:     device,
+			CreatedAt:  now.UTC().Format(time.RFC3339),
+			ExpiresAt:  now.Add(ttl).UTC().Format(time.RFC3339),
+		},
+		detachTaskID: detachTaskID,
+	}, nil
+}
+
+func (t *replicaReadOnlyAttachmentTracker) list() map[string]*rpc.ReplicaReadOnlyAttachment {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	attachments := map[string]*rpc.ReplicaReadOnlyAttachment{}
+	for name, a := range t.attachments {
+		if a.attachment == nil {
+			continue
+		}
+		attachments[name] = proto.Clone(a.attachment).(*rpc.ReplicaReadOnlyAttachment)
+	}
+	return attachments
+}
+
+func (t *replicaReadOnlyAttachmentTracker) detach(name string) error {
+	t.lock.Lock()
+	a, exists := t.attachments[name]
+	if !exists || a.attachment == nil {
+		t.lock.Unlock()
+		return grpcstatus.Errorf(grpccodes.NotFound, "cannot find read-only attachment of replica %v", name)
+	}
+	delete(t.attachments, name)
+	t.lock.Unlock()
+
+	if err := detachReplicaReadOnly(name, helpertypes.GetNQN(name+replicaReadOnlySuffix)); err != nil {
+		t.lock.Lock()
+		t.attachments[name] = a
+		t.lock.Unlock()
+		return grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	if err := t.taskQueue.Cancel(a.detachTaskID); err != nil {
+		logrus.WithError(err).Warnf("Failed to cancel the expiry of the read-only attachment of replica %v", name)
+	}
+	return nil
+}
+
+// expire tears down the expired attachment. It is the handler of the detach task, which is retried until the
+// attachment is torn down, including by the next instance manager after a restart.
+func (t *replicaReadOnlyAttachmentTracker) expire(args map[string]string) error {
+	name := args["name"]
+	if name == "" || args["nqn"] == "" {
+		return nil
+	}
+
+	t.lock.Lock()
+	a, exists := t.attachments[name]
+	if exists && a.attachment != nil {
+		delete(t.attachments, name)
+	}
+	t.lock.Unlock()
+
+	logrus.Infof("Detaching expired read-only attachment of replica %v", name)
+	if err := detachReplicaReadOnly(name, args["nqn"]); err != nil {
+		if exists && a.attachment != nil {
+			t.lock.Lock()
+			t.attachments[name] = a
+			t.lock.Unlock()
+		}
+		return err
+	}
+	return nil
+}
+
+func detachReplicaReadOnly(name, nqn string) error {
+	return detachLvolDevice(name+replicaReadOnlySuffix, nqn)
+}
+
+// detachLvolDevice disconnects the device of the lvol exposed by the NVMe-oF subsystem, and stops exposing it.
+func detachLvolDevice(name, nqn string) error {
+	if err := disconnectLvolDevice(name, nqn); err != nil {
+		return err
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "failed to create SPDK helper client")
+	}
+	defer spdkHelperClient.Close()
+
+	if err := spdkHelperClient.StopExposeBdev(nqn); err != nil {
+		return errors.Wrapf(err, "failed to stop exposing %v", name)
+	}
+	return nil
+}
+
+func disconnectLvolDevice(name, nqn string) error {
+	initiator, err := helpernvme.NewInitiator(name, nqn, helpernvme.HostProc)
+	if err != nil {
+		return err
+	}
+	if _, err := initiator.Stop(false, false); err != nil {
+		return errors.Wrapf(err, "failed to disconnect device %v", name)
+	}
+	if err := helperutil.RemoveDevice(initiator.Endpoint); err != nil && !os.IsNotExist(errors.Cause(err)) {
+		return errors.Wrapf(err, "failed to remove device %v", name)
+	}
+	return nil
+}
+
+// openBlockDevice opens the device, waiting for it to show up after the host connects to the subsystem.
+func openBlockDevice(device string, flag int) (*os.File, error) {
+	var f *os.File
+	var err error
+	for start := time.Now(); time.Since(start) < replicaReadOnlyDeviceWaitTimeout; time.Sleep(time.Second) {
+		if f, err = os.OpenFile(device, flag, 0); err == nil {
+			return f, nil
+		}
+	}
+	return nil, errors.Wrapf(err, "failed to open device %v", device)
+}
+
+// setBlockDeviceReadOnly makes the kernel reject the writes to the device, including the ones of a mount.
+func setBlockDeviceReadOnly(device string) error {
+	f, err := openBlockDevice(device, os.O_RDONLY)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := unix.IoctlSetPointerInt(int(f.Fd()), unix.BLKROSET, 1); err != nil {
+		return errors.Wrapf(err, "failed to set device %v read-only", device)
+	}
+	return nil
+}
+
+func getFreePort() (int, error) {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find a free port")
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func (s *Server) ReplicaReadOnlyAttach(ctx context.Context, req *rpc.ReplicaReadOnlyAttachRequest) (*rpc.ReplicaReadOnlyAttachment, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"dataEngine": req.DataEngine,
+		"diskName":   req.DiskName,
+		"ttlSeconds": req.TtlSeconds,
+	}).Info("Attaching replica read-only")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "name is required")
+	}
+	if req.TtlSeconds < 0 || time.Duration(req.TtlSeconds)*time.Second > maxReplicaReadOnlyAttachTTL {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "TTL must be between 0 and %v", maxReplicaReadOnlyAttachTTL)
+	}
+
+	switch req.DataEngine {
+	case rpc.DataEngine_DATA_ENGINE_V2:
+		if !s.v2DataEngineEnabled {
+			return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "data engine %v is not enabled", req.DataEngine)
+		}
+		if req.DiskName == "" {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, "disk name is required for v2 data engine")
+		}
+		return s.readOnlyAttachments.attach(ctx, req)
+	default:
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "read-only attachment of data engine %v replicas is not supported", req.DataEngine)
+	}
+}
+
+func (s *Server) ReplicaReadOnlyAttachmentList(ctx context.Context, req *emptypb.Empty) (*rpc.ReplicaReadOnlyAttachmentListResponse, error) {
+	return &rpc.ReplicaReadOnlyAttachmentListResponse{
+		Attachments: s.readOnlyAttachments.list(),
+	}, nil
+}
+
+func (s *Server) ReplicaReadOnlyDetach(ctx context.Context, req *rpc.ReplicaReadOnlyDetachRequest) (*emptypb.Empty, error) {
+	logrus.WithFields(logrus.Fields{
+		"name": req.Name,
+	}).Info("Detaching read-only replica")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "name is required")
+	}
+	if err := s.readOnlyAttachments.detach(req.Name); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
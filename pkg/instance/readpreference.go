@@ -0,0 +1,167 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// engineReadPreferenceTracker keeps the replicas the v2 engines prefer to read from. The RAID1 bdev of spdk_tgt has
+// no read policy to set; it reads from the base bdev with the fewest outstanding reads, which favors the replica
+// local to the engine since its reads skip NVMe-oF. So the preference is only effective for a local replica in RW
+// mode, and the read path reported tells whether it is. The preferences are not persisted, so they are lost once the
+// instance manager restarts.
+type engineReadPreferenceTracker struct {
+	lock     *sync.RWMutex
+	replicas map[string]string
+}
+
+func newEngineReadPreferenceTracker() *engineReadPreferenceTracker {
+	return &engineReadPreferenceTracker{
+		lock:     &sync.RWMutex{},
+		replicas: map[string]string{},
+	}
+}
+
+// set sets the replica the engine prefers to read from, or clears the preference if the replica is empty.
+func (t *engineReadPreferenceTracker) set(name, replicaName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if replicaName == "" {
+		delete(t.replicas, name)
+		return
+	}
+	t.replicas[name] = replicaName
+}
+
+func (t *engineReadPreferenceTracker) get(name string) string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.replicas[name]
+}
+
+func (t *engineReadPreferenceTracker) delete(name string) {
+	t.set(name, "")
+}
+
+// apply reports the read path of the engine in the response.
+func (t *engineReadPreferenceTracker) apply(engine *spdkapi.Engine, resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	if resp.Status == nil {
+		return resp
+	}
+	resp.Status.ReadPath = getEngineReadPath(engine, t.get(engine.Name))
+	return resp
+}
+
+func getEngineReadPath(engine *spdkapi.Engine, preferredReplica string) *rpc.InstanceReadPath {
+	readPath := &rpc.InstanceReadPath{
+		PreferredReplica: preferredReplica,
+		Replicas:         []string{},
+	}
+	for replicaName, mode := range engine.ReplicaModeMap {
+		if mode == spdktypes.ModeRW {
+			readPath.Replicas = append(readPath.Replicas, replicaName)
+		}
+	}
+	sort.Strings(readPath.Replicas)
+
+	if preferredReplica == "" {
+		return readPath
+	}
+	mode, exists := engine.ReplicaModeMap[preferredReplica]
+	switch {
+	case !exists:
+		readPath.Reason = fmt.Sprintf("replica %v is not a replica of the engine", preferredReplica)
+	case mode != spdktypes.ModeRW:
+		readPath.Reason = fmt.Sprintf("replica %v is in mode %v", preferredReplica, mode)
+	case !isReplicaLocalToEngine(engine, preferredReplica):
+		readPath.Reason = fmt.Sprintf("replica %v is not local to the engine, so the reads are balanced across the replicas", preferredReplica)
+	default:
+		readPath.EffectiveReplica = preferredReplica
+		readPath.Local = true
+	}
+	return readPath
+}
+
+func isReplicaLocalToEngine(engine *spdkapi.Engine, replicaName string) bool {
+	host, _, err := net.SplitHostPort(engine.ReplicaAddressMap[replicaName])
+	if err != nil {
+		return false
+	}
+	return engine.IP != "" && host == engine.IP
+}
+
+func validateReadPreference(replicaAddressMap map[string]string, replicaName string) error {
+	if replicaName == "" {
+		return nil
+	}
+	if _, exists := replicaAddressMap[replicaName]; !exists {
+		return fmt.Errorf("replica %v is not in the replica address map", replicaName)
+	}
+	return nil
+}
+
+// InstanceReadPreferenceSet sets the replica the engine prefers to read from, e.g. the one local to the engine.
+func (s *Server) InstanceReadPreferenceSet(ctx context.Context, req *rpc.InstanceReadPreferenceSetRequest) (*rpc.InstanceResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":        req.Name,
+		"dataEngine":  req.DataEngine,
+		"replicaName": req.ReplicaName,
+	}).Info("Setting instance read preference")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	return ops.InstanceReadPreferenceSet(ctx, req)
+}
+
+func (ops V1DataEngineInstanceOps) InstanceReadPreferenceSet(ctx context.Context, req *rpc.InstanceReadPreferenceSetRequest) (*rpc.InstanceResponse, error) {
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "cannot set the read preference of %v since only the engines of the v2 data engine are supported", req.Name)
+}
+
+func (ops V2DataEngineInstanceOps) InstanceReadPreferenceSet(ctx context.Context, req *rpc.InstanceReadPreferenceSetRequest) (*rpc.InstanceResponse, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	engine, err := c.EngineGet(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateReadPreference(engine.ReplicaAddressMap, req.ReplicaName); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, errors.Wrapf(err, "failed to set the read preference of engine %v", req.Name).Error())
+	}
+
+	ops.readPreferences.set(req.Name, req.ReplicaName)
+	resp := ops.readPreferences.apply(engine, ops.getEngineResponse(engine))
+	if req.ReplicaName != "" && resp.Status.ReadPath.EffectiveReplica == "" {
+		logrus.Warnf("The read preference of engine %v for replica %v is not effective: %v", req.Name, req.ReplicaName, resp.Status.ReadPath.Reason)
+	}
+	return resp, nil
+}
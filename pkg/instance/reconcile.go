@@ -0,0 +1,143 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	reconcileInterval = 30 * time.Second
+
+	DefaultStuckInstanceTimeout = 5 * time.Minute
+)
+
+// transitionalInstanceStates are the states of the processes and the SPDK instances that are expected to end by
+// themselves. An instance staying in one of them is stuck.
+var transitionalInstanceStates = map[string]bool{
+	types.ProcessStateStarting:         true,
+	types.ProcessStateStopping:         true,
+	spdktypes.InstanceStatePending:     true,
+	spdktypes.InstanceStateTerminating: true,
+}
+
+type instanceTransition struct {
+	state string
+	since time.Time
+	stuck bool
+}
+
+// instanceStuckTracker tracks how long the instances stay in the transitional states. The stuck instances are
+// reported in the error state with the reason until they leave the state, since neither the process manager nor the
+// SPDK service tells a slow transition from a hung one.
+type instanceStuckTracker struct {
+	lock        *sync.RWMutex
+	transitions map[string]*instanceTransition
+}
+
+func newInstanceStuckTracker() *instanceStuckTracker {
+	return &instanceStuckTracker{
+		lock:        &sync.RWMutex{},
+		transitions: map[string]*instanceTransition{},
+	}
+}
+
+// observe records the transitional states of the instances listed from the backends, and returns the names of the
+// instances newly found stuck. No instance is found stuck if the timeout is 0.
+func (t *instanceStuckTracker) observe(instances map[string]*rpc.InstanceResponse, timeout time.Duration) []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	stuck := []string{}
+	for name, instance := range instances {
+		state := instance.GetStatus().GetState()
+		if !transitionalInstanceStates[state] {
+			delete(t.transitions, name)
+			continue
+		}
+
+		transition, exists := t.transitions[name]
+		if !exists || transition.state != state {
+			t.transitions[name] = &instanceTransition{
+				state: state,
+				since: now,
+			}
+			continue
+		}
+		if timeout > 0 && !transition.stuck && now.Sub(transition.since) >= timeout {
+			transition.stuck = true
+			stuck = append(stuck, name)
+		}
+	}
+	for name := range t.transitions {
+		if _, exists := instances[name]; !exists {
+			delete(t.transitions, name)
+		}
+	}
+	sort.Strings(stuck)
+	return stuck
+}
+
+// apply reports the instance in the error state if it is stuck in its current state.
+func (t *instanceStuckTracker) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	if resp.Status == nil {
+		return resp
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	transition, exists := t.transitions[resp.GetSpec().GetName()]
+	if !exists || !transition.stuck || transition.state != resp.Status.State {
+		return resp
+	}
+	resp.Status.State = types.ProcessStateError
+	resp.Status.ErrorMsg = fmt.Sprintf("instance is stuck in state %v since %v", transition.state, transition.since.UTC().Format(time.RFC3339))
+	return resp
+}
+
+func (t *instanceStuckTracker) applyList(instances map[string]*rpc.InstanceResponse) {
+	for _, instance := range instances {
+		t.apply(instance)
+	}
+}
+
+// reconcileInstances lists the instances from the backends and compares them against the ones last published to the
+// watches. The watches are notified of the changes they missed, e.g. the ones whose notifications were lost by a
+// backend, and of the instances newly found stuck.
+func (s *Server) reconcileInstances(ctx context.Context) error {
+	instances := map[string]*rpc.InstanceResponse{}
+	if err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(ctx, instances); err != nil {
+		return errors.Wrap(err, "failed to list v1 instances")
+	}
+	if s.v2DataEngineEnabled {
+		if err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(ctx, instances); err != nil {
+			return errors.Wrap(err, "failed to list v2 instances")
+		}
+	}
+
+	for _, name := range s.stuckInstances.observe(instances, s.StuckInstanceTimeout) {
+		logrus.Warnf("%s: instance %v is stuck in state %v for longer than %v, reporting it in state %v",
+			types.InstanceGrpcService, name, instances[name].Status.State, s.StuckInstanceTimeout, types.ProcessStateError)
+	}
+	s.stuckInstances.applyList(instances)
+
+	lastResourceVersion := s.journal.currentResourceVersion()
+	if resourceVersion := s.journal.update(instances); resourceVersion != lastResourceVersion {
+		logrus.Debugf("%s: found the instance changes up to resource version %v by reconciliation", types.InstanceGrpcService, resourceVersion)
+		s.broadcastCh <- interface{}(instances)
+	}
+	return nil
+}
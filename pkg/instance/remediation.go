@@ -0,0 +1,163 @@
+package instance
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// remediation is the cleanup of the leftovers of a partially failed creation of a v2 instance, e.g. the nvmf
+// subsystem of a previous run, which makes the creation fail until it is cleaned up by hand. The creation is retried
+// once after the cleanup.
+type remediation struct {
+	name         string
+	instanceType string
+	signature    *regexp.Regexp
+	// cleanup must only touch the instance being created, and must keep the data of a replica
+	cleanup func(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) error
+}
+
+var remediations = []*remediation{
+	{
+		name:         "engine-stale-nvmf-subsystem",
+		instanceType: types.InstanceTypeEngine,
+		signature:    regexp.MustCompile(`(?i)subsystem .*already exists|unable to create subsystem`),
+		cleanup:      deleteFailedEngine,
+	},
+	{
+		name:         "engine-stale-bdev",
+		instanceType: types.InstanceTypeEngine,
+		signature:    regexp.MustCompile(`(?i)bdev .*already exists|already claimed|device or resource busy`),
+		cleanup:      deleteFailedEngine,
+	},
+	{
+		name:         "replica-stale-nvmf-subsystem",
+		instanceType: types.InstanceTypeReplica,
+		signature:    regexp.MustCompile(`(?i)subsystem .*already exists|unable to create subsystem`),
+		cleanup:      deleteFailedReplica,
+	},
+	{
+		name:         "replica-lvol-still-claimed",
+		instanceType: types.InstanceTypeReplica,
+		signature:    regexp.MustCompile(`(?i)(lvol|bdev) .*(still |already )claimed|device or resource busy`),
+		cleanup:      deleteFailedReplica,
+	},
+}
+
+// findRemediation returns the remediation of the failure of the creation, or nil if the failure is unknown.
+func findRemediation(instanceType, failure string) *remediation {
+	if failure == "" {
+		return nil
+	}
+	for _, r := range remediations {
+		if r.instanceType == instanceType && r.signature.MatchString(failure) {
+			return r
+		}
+	}
+	return nil
+}
+
+// getCreationFailure returns the failure of the creation of an instance, which fails either with an error or with
+// the instance in error state. An instance already existing is never remediated, since it may be in use.
+func getCreationFailure(err error, state, errorMsg string) string {
+	if err != nil {
+		if grpcstatus.Code(err) == grpccodes.AlreadyExists {
+			return ""
+		}
+		return err.Error()
+	}
+	if state == spdktypes.InstanceStateError {
+		return errorMsg
+	}
+	return ""
+}
+
+// remediate runs the cleanup of the remediation of the failure, and returns true if the creation should be
+// retried.
+func remediate(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec, failure string) bool {
+	r := findRemediation(spec.Type, failure)
+	if r == nil {
+		return false
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"name":        spec.Name,
+		"type":        spec.Type,
+		"remediation": r.name,
+		"failure":     failure,
+	})
+	log.Warn("Remediating the failed creation of instance")
+
+	result := "retried"
+	defer func() {
+		metrics.AddCounter(metrics.MetricInstanceRemediations, map[string]string{
+			"remediation": r.name,
+			"result":      result,
+		}, 1)
+	}()
+
+	if err := r.cleanup(c, spec); err != nil {
+		result = "cleanup_failed"
+		log.WithError(err).Error("Failed to clean up the failed creation of instance")
+		return false
+	}
+	return true
+}
+
+func deleteFailedEngine(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) error {
+	if err := c.EngineDelete(spec.Name); err != nil && grpcstatus.Code(err) != grpccodes.NotFound {
+		return errors.Wrapf(err, "failed to delete engine %v", spec.Name)
+	}
+	return nil
+}
+
+func deleteFailedReplica(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) error {
+	// Keep the lvol, which is reused by the retried creation
+	if err := c.ReplicaDelete(spec.Name, false); err != nil && grpcstatus.Code(err) != grpccodes.NotFound {
+		return errors.Wrapf(err, "failed to delete replica %v", spec.Name)
+	}
+	return nil
+}
+
+// createEngineWithRemediation creates the engine, and retries the creation once if the failure is remediated.
+func createEngineWithRemediation(ctx context.Context, c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) (*spdkapi.Engine, error) {
+	engine, err := createEngine(ctx, c, spec)
+	failure := getCreationFailure(err, "", "")
+	if engine != nil {
+		failure = getCreationFailure(err, engine.State, engine.ErrorMsg)
+	}
+	if remediate(c, spec, failure) {
+		return createEngine(ctx, c, spec)
+	}
+	return engine, err
+}
+
+// createReplicaWithRemediation creates the replica, and retries the creation once if the failure is remediated.
+func createReplicaWithRemediation(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) (*spdkapi.Replica, error) {
+	create := func() (*spdkapi.Replica, error) {
+		return c.ReplicaCreate(spec.Name, spec.SpdkInstanceSpec.DiskName, spec.SpdkInstanceSpec.DiskUuid, spec.SpdkInstanceSpec.Size, spec.SpdkInstanceSpec.ExposeRequired, spec.PortCount)
+	}
+
+	replica, err := create()
+	failure := getCreationFailure(err, "", "")
+	if replica != nil {
+		failure = getCreationFailure(err, replica.State, replica.ErrorMsg)
+	}
+	if remediate(c, spec, failure) {
+		return create()
+	}
+	return replica, err
+}
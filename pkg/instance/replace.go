@@ -0,0 +1,141 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// InstanceReplace replaces the engine of the volume with a new one of the spec, e.g. with a different replica set, the
+// way a live upgrade does. spdk_tgt cannot host two engines of the same name, so the new engine must be named
+// differently from the old one. The new engine is created along with the old one, the frontend of the old engine is
+// switched over to the target of the new one, and the old engine is deleted with its frontend handed over to the new
+// one. The old engine keeps serving the volume until the switch-over succeeds, so a failed replacement never leaves
+// the volume without an engine.
+func (ops V2DataEngineInstanceOps) InstanceReplace(ctx context.Context, req *rpc.InstanceReplaceRequest) (*rpc.InstanceResponse, error) {
+	if req.Spec.SpdkInstanceSpec == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "SpdkInstanceSpec is required for v2 data engine")
+	}
+	if req.Spec.Type != types.InstanceTypeEngine {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "instance type %v of v2 data engine cannot be replaced", req.Spec.Type)
+	}
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	oldEngine, newEngine, err := getReplacedEngines(c, req.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if oldEngine == nil {
+		if newEngine == nil {
+			return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find the engine of volume %v to replace", req.Spec.VolumeName)
+		}
+		// The replacement is already done
+		return ops.getEngineResponse(newEngine), nil
+	}
+	oldEndpoint := ops.getEngineEndpoint(oldEngine)
+
+	if newEngine == nil {
+		spec := req.Spec
+		if oldEndpoint != "" {
+			// The new engine only exposes its target, which the frontend of the old engine is switched over to
+			spec = proto.Clone(req.Spec).(*rpc.InstanceSpec)
+			spec.SpdkInstanceSpec.Frontend = spdktypes.FrontendSPDKTCPNvmf
+		}
+		newEngine, err = createEngineWithRemediation(ctx, c, spec)
+		if err != nil {
+			if deleteErr := deleteFailedEngine(c, req.Spec); deleteErr != nil {
+				logrus.WithError(deleteErr).Warnf("Failed to clean up the failed replacement %v of engine %v", req.Spec.Name, oldEngine.Name)
+			}
+			return nil, err
+		}
+	}
+
+	if oldEndpoint != "" {
+		if err := ops.switchOverEngineTarget(oldEngine, getEngineTargetAddress(newEngine)); err != nil {
+			logrus.WithError(err).Warnf("Failed to switch engine %v over to its replacement %v, deleting the replacement", oldEngine.Name, newEngine.Name)
+			if deleteErr := deleteFailedEngine(c, req.Spec); deleteErr != nil {
+				logrus.WithError(deleteErr).Warnf("Failed to clean up the failed replacement %v of engine %v", newEngine.Name, oldEngine.Name)
+			}
+			return nil, err
+		}
+	}
+
+	// The labels and the priority class are carried over from the old engine if the request doesn't specify any
+	labels := req.Spec.Labels
+	if len(labels) == 0 {
+		labels = ops.labels.get(oldEngine.Name)
+	}
+	if len(labels) != 0 {
+		ops.labels.set(newEngine.Name, labels)
+	}
+	priorityClass := req.Spec.PriorityClass
+	if priorityClass == "" {
+		priorityClass = ops.priorities.get(oldEngine.Name)
+	}
+	if priorityClass != "" {
+		ops.priorities.set(newEngine.Name, priorityClass)
+	}
+
+	// The new engine serves the volume from now on, so the old one left behind is only reported as switched over and
+	// can be deleted again later
+	if err := ops.deleteEngine(c, oldEngine.Name); err != nil {
+		logrus.WithError(err).Warnf("Failed to delete engine %v after it is replaced by %v", oldEngine.Name, newEngine.Name)
+	} else {
+		ops.forgetEngine(oldEngine.Name)
+	}
+
+	return ops.getEngineResponse(newEngine), nil
+}
+
+// getReplacedEngines returns the engine of the volume to be replaced, and the replacement of the spec if it is
+// already created.
+func getReplacedEngines(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec) (oldEngine, newEngine *spdkapi.Engine, err error) {
+	engines, err := c.EngineList()
+	if err != nil {
+		return nil, nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to list engines").Error())
+	}
+	return findReplacedEngines(engines, spec)
+}
+
+func findReplacedEngines(engines map[string]*spdkapi.Engine, spec *rpc.InstanceSpec) (oldEngine, newEngine *spdkapi.Engine, err error) {
+	for _, engine := range engines {
+		if engine.Name == spec.Name {
+			if engine.VolumeName != spec.VolumeName {
+				return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "engine %v belongs to volume %v rather than %v", spec.Name, engine.VolumeName, spec.VolumeName)
+			}
+			newEngine = engine
+			continue
+		}
+		if engine.VolumeName != spec.VolumeName {
+			continue
+		}
+		if oldEngine != nil {
+			return nil, nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot replace the engine of volume %v since both engines %v and %v exist", spec.VolumeName, oldEngine.Name, engine.Name)
+		}
+		oldEngine = engine
+	}
+	if oldEngine == nil && newEngine != nil && newEngine.Endpoint != "" {
+		return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "cannot replace engine %v by an engine of the same name", spec.Name)
+	}
+	return oldEngine, newEngine, nil
+}
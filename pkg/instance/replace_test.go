@@ -0,0 +1,46 @@
+package instance
+
+import (
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestFindReplacedEngines(c *C) {
+	spec := &rpc.InstanceSpec{Name: "vol-e-1", VolumeName: "vol"}
+	oldEngine := &spdkapi.Engine{Name: "vol-e-0", VolumeName: "vol", Endpoint: "/dev/longhorn/vol"}
+	newEngine := &spdkapi.Engine{Name: "vol-e-1", VolumeName: "vol"}
+	otherEngine := &spdkapi.Engine{Name: "other-e-0", VolumeName: "other"}
+
+	testCases := []struct {
+		name      string
+		engines   []*spdkapi.Engine
+		oldEngine *spdkapi.Engine
+		newEngine *spdkapi.Engine
+		code      grpccodes.Code
+	}{
+		{"replacement not created yet", []*spdkapi.Engine{oldEngine, otherEngine}, oldEngine, nil, grpccodes.OK},
+		{"replacement created", []*spdkapi.Engine{oldEngine, newEngine}, oldEngine, newEngine, grpccodes.OK},
+		{"replacement done", []*spdkapi.Engine{newEngine, otherEngine}, nil, newEngine, grpccodes.OK},
+		{"nothing to replace", []*spdkapi.Engine{otherEngine}, nil, nil, grpccodes.OK},
+		{"same name", []*spdkapi.Engine{{Name: "vol-e-1", VolumeName: "vol", Endpoint: "/dev/longhorn/vol"}}, nil, nil, grpccodes.InvalidArgument},
+		{"another volume", []*spdkapi.Engine{oldEngine, {Name: "vol-e-1", VolumeName: "other"}}, nil, nil, grpccodes.InvalidArgument},
+		{"two old engines", []*spdkapi.Engine{oldEngine, {Name: "vol-e-2", VolumeName: "vol"}}, nil, nil, grpccodes.FailedPrecondition},
+	}
+	for _, testCase := range testCases {
+		comment := Commentf("test case %v", testCase.name)
+		engines := map[string]*spdkapi.Engine{}
+		for _, engine := range testCase.engines {
+			engines[engine.Name] = engine
+		}
+		foundOld, foundNew, err := findReplacedEngines(engines, spec)
+		c.Assert(grpcstatus.Code(err), Equals, testCase.code, comment)
+		c.Assert(foundOld, Equals, testCase.oldEngine, comment)
+		c.Assert(foundNew, Equals, testCase.newEngine, comment)
+	}
+}
@@ -0,0 +1,324 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	restartCheckInterval  = 5 * time.Second
+	defaultRestartBackoff = time.Second
+	maxRestartBackoff     = 5 * time.Minute
+	// restartResetPeriod is the time an instance keeps running after a restart for before its restarts in a row are
+	// reset
+	restartResetPeriod = 10 * time.Minute
+)
+
+// restartStates are the states the instances are restarted in by each restart policy. The states of the processes
+// and the SPDK instances are named the same.
+var restartStates = map[string]map[string]bool{
+	types.InstanceRestartPolicyOnFailure: {
+		spdktypes.InstanceStateError: true,
+	},
+	types.InstanceRestartPolicyAlways: {
+		spdktypes.InstanceStateError:   true,
+		spdktypes.InstanceStateStopped: true,
+	},
+}
+
+type instanceRestart struct {
+	spec *rpc.InstanceSpec
+	// count is the restarts in total, and retries the ones in a row
+	count       int32
+	retries     int32
+	failedSince time.Time
+	lastRestart time.Time
+	restarting  bool
+	exhausted   bool
+}
+
+// instanceRestartTracker keeps the specs of the instances with a restart policy, so that the failed ones are
+// recreated by the instance server itself rather than once longhorn-manager notices them. Only the instances found in
+// a failed state are restarted; a missing one may be deleted by anyone and is left alone.
+type instanceRestartTracker struct {
+	lock     *sync.RWMutex
+	restarts map[string]*instanceRestart
+}
+
+func newInstanceRestartTracker() *instanceRestartTracker {
+	return &instanceRestartTracker{
+		lock:     &sync.RWMutex{},
+		restarts: map[string]*instanceRestart{},
+	}
+}
+
+// set records the spec the instance is recreated with, or drops it if the instance is never restarted.
+func (t *instanceRestartTracker) set(spec *rpc.InstanceSpec) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if getRestartPolicy(spec.RestartPolicy) == types.InstanceRestartPolicyNever {
+		delete(t.restarts, spec.Name)
+		return
+	}
+	spec = proto.Clone(spec).(*rpc.InstanceSpec)
+	// A restart creates the instance anew rather than adopting the failed one
+	if spec.SpdkInstanceSpec != nil {
+		spec.SpdkInstanceSpec.Adopt = false
+	}
+	t.restarts[spec.Name] = &instanceRestart{
+		spec: spec,
+	}
+}
+
+func (t *instanceRestartTracker) delete(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.restarts, name)
+}
+
+func (t *instanceRestartTracker) empty() bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return len(t.restarts) == 0
+}
+
+// observe records the failures of the instances listed from the backends, and returns the specs of the ones due to
+// be restarted, i.e. failed for longer than the backoff of their restarts in a row.
+func (t *instanceRestartTracker) observe(instances map[string]*rpc.InstanceResponse, now time.Time) []*rpc.InstanceSpec {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	due := []*rpc.InstanceSpec{}
+	for name, r := range t.restarts {
+		if r.restarting {
+			continue
+		}
+		instance, exists := instances[name]
+		if !exists || instance.GetSpec().GetDataEngine() != r.spec.DataEngine {
+			r.failedSince = time.Time{}
+			continue
+		}
+
+		policy := r.spec.RestartPolicy
+		state := instance.GetStatus().GetState()
+		if !restartStates[policy.Policy][state] {
+			r.failedSince = time.Time{}
+			r.exhausted = false
+			if r.retries > 0 && now.Sub(r.lastRestart) >= restartResetPeriod {
+				r.retries = 0
+			}
+			continue
+		}
+		if r.exhausted {
+			continue
+		}
+		if policy.MaxRetries > 0 && r.retries >= policy.MaxRetries {
+			r.exhausted = true
+			logrus.Warnf("%s: instance %v is in state %v after %v restarts in a row, giving up restarting it",
+				types.InstanceGrpcService, name, state, r.retries)
+			continue
+		}
+		if r.failedSince.IsZero() {
+			r.failedSince = now
+		}
+		if now.Sub(r.failedSince) < getRestartBackoff(policy, r.retries) {
+			continue
+		}
+		r.restarting = true
+		due = append(due, r.spec)
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].Name < due[j].Name
+	})
+	return due
+}
+
+// isCurrent tells whether the instance is still restarted with the spec, i.e. it is not deleted or created anew in
+// the meantime.
+func (t *instanceRestartTracker) isCurrent(spec *rpc.InstanceSpec) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	r, exists := t.restarts[spec.Name]
+	return exists && r.spec == spec
+}
+
+// done records the end of the restart. A failed restart counts as a retry as well, so that the backoff grows.
+func (t *instanceRestartTracker) done(spec *rpc.InstanceSpec, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	r, exists := t.restarts[spec.Name]
+	if !exists || r.spec != spec {
+		return
+	}
+	r.restarting = false
+	r.failedSince = time.Time{}
+	r.retries++
+	if err == nil {
+		r.count++
+		r.lastRestart = time.Now()
+	}
+}
+
+// apply reports the restart policy and the restarts of the instance in the response.
+func (t *instanceRestartTracker) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	if resp.Spec == nil || resp.Status == nil {
+		return resp
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	r, exists := t.restarts[resp.Spec.Name]
+	if !exists || resp.Spec.DataEngine != r.spec.DataEngine {
+		return resp
+	}
+	resp.Spec.RestartPolicy = r.spec.RestartPolicy
+	resp.Status.RestartCount = r.count
+	return resp
+}
+
+func (t *instanceRestartTracker) applyList(instances map[string]*rpc.InstanceResponse) {
+	for _, instance := range instances {
+		t.apply(instance)
+	}
+}
+
+func getRestartPolicy(policy *rpc.InstanceRestartPolicy) string {
+	if policy == nil || policy.Policy == "" {
+		return types.InstanceRestartPolicyNever
+	}
+	return policy.Policy
+}
+
+// getRestartBackoff returns the delay before the restart following the restarts in a row, which doubles by each of
+// them up to maxRestartBackoff.
+func getRestartBackoff(policy *rpc.InstanceRestartPolicy, retries int32) time.Duration {
+	backoff := defaultRestartBackoff
+	if policy.BackoffMs > 0 {
+		backoff = time.Duration(policy.BackoffMs) * time.Millisecond
+	}
+	for i := int32(0); i < retries && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRestartBackoff {
+		backoff = maxRestartBackoff
+	}
+	return backoff
+}
+
+func validateRestartPolicy(spec *rpc.InstanceSpec) error {
+	policy := spec.RestartPolicy
+	if policy == nil {
+		return nil
+	}
+	switch policy.Policy {
+	case "", types.InstanceRestartPolicyNever:
+		return nil
+	case types.InstanceRestartPolicyOnFailure, types.InstanceRestartPolicyAlways:
+	default:
+		return fmt.Errorf("unknown restart policy %v", policy.Policy)
+	}
+	if policy.MaxRetries < 0 {
+		return fmt.Errorf("invalid max retries %v", policy.MaxRetries)
+	}
+	if policy.BackoffMs < 0 {
+		return fmt.Errorf("invalid backoff %vms", policy.BackoffMs)
+	}
+	// Recreating a v2 replica would create an empty lvol in place of the failed one
+	if spec.DataEngine == rpc.DataEngine_DATA_ENGINE_V2 && spec.Type != types.InstanceTypeEngine {
+		return fmt.Errorf("only the v1 instances and the v2 engines can be restarted")
+	}
+	return nil
+}
+
+// restartFailedInstances recreates the failed instances due to be restarted by their restart policy. The backends
+// are not listed unless any instance has a restart policy, and nothing is restarted once draining.
+func (s *Server) restartFailedInstances(ctx context.Context) error {
+	if s.restarts.empty() || s.drain.isDraining() {
+		return nil
+	}
+
+	instances := map[string]*rpc.InstanceResponse{}
+	if err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(ctx, instances); err != nil {
+		return errors.Wrap(err, "failed to list v1 instances")
+	}
+	if s.v2DataEngineEnabled {
+		if err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(ctx, instances); err != nil {
+			return errors.Wrap(err, "failed to list v2 instances")
+		}
+	}
+
+	for _, spec := range s.restarts.observe(instances, time.Now()) {
+		go s.restartInstance(spec)
+	}
+	return nil
+}
+
+func (s *Server) restartInstance(spec *rpc.InstanceSpec) {
+	log := logrus.WithFields(logrus.Fields{
+		"name":       spec.Name,
+		"type":       spec.Type,
+		"dataEngine": spec.DataEngine,
+		"policy":     spec.RestartPolicy.Policy,
+	})
+	log.Warn("Restarting failed instance by its restart policy")
+
+	// The restart is an operation in flight for the drain as well
+	done := s.drain.startOperation()
+	defer done()
+
+	ctx, cancel := context.WithTimeout(s.ctx, types.GRPCServiceTimeout)
+	defer cancel()
+
+	err := s.recreateInstance(ctx, spec)
+	s.restarts.done(spec, err)
+	s.operations.Record(spec.Name, types.InstanceOperationRestart, fmt.Sprintf("policy=%v", spec.RestartPolicy.Policy), err)
+	if err != nil {
+		log.WithError(err).Error("Failed to restart instance")
+		return
+	}
+	log.Info("Restarted instance")
+}
+
+func (s *Server) recreateInstance(ctx context.Context, spec *rpc.InstanceSpec) error {
+	ops := s.ops[spec.DataEngine]
+
+	_, err := ops.InstanceDelete(ctx, &rpc.InstanceDeleteRequest{
+		Name:            spec.Name,
+		Type:            spec.Type,
+		DataEngine:      spec.DataEngine,
+		CleanupRequired: true,
+	})
+	if err != nil && grpcstatus.Code(err) != grpccodes.NotFound {
+		return errors.Wrap(err, "failed to delete the failed instance")
+	}
+	// The instance is not created again if it is deleted by InstanceDelete in the meantime
+	if !s.restarts.isCurrent(spec) {
+		return fmt.Errorf("instance %v is deleted or created anew during the restart", spec.Name)
+	}
+	if _, err := ops.InstanceCreate(ctx, &rpc.InstanceCreateRequest{Spec: spec}); err != nil {
+		return errors.Wrap(err, "failed to create the instance again")
+	}
+	s.uuids.reset(spec.Name)
+	return nil
+}
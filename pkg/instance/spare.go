@@ -0,0 +1,273 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/disk"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	replicaSparePrefix     = "spare-r-"
+	defaultReplicaSpareTTL = 30 * time.Minute
+
+	replicaSpareExpireTaskType = "replica-spare-expire"
+	// replicaSpareExpireDelay delays the deletion of an expired spare, so that it never races with a claim made
+	// right before the expiry
+	replicaSpareExpireDelay = 10 * time.Second
+)
+
+type replicaSpare struct {
+	spare     *rpc.ReplicaSpare
+	createdAt time.Time
+	expiresAt time.Time
+	// expireTaskID is the deferred task deleting the spare once it expires
+	expireTaskID string
+}
+
+// replicaSpareTracker keeps the empty v2 replicas pre-provisioned on the disks of this node. A claimed spare is
+// no longer tracked, and becomes an ordinary replica instance of the claimer. The spares are not persisted, so
+// the ones left after the instance manager restarts cannot be claimed, but they are still deleted once expired
+// by the deferred tasks.
+type replicaSpareTracker struct {
+	lock   *sync.Mutex
+	spares map[string]*replicaSpare
+
+	clients       *backendClientPool
+	safeModeDisks *disk.SafeModeTracker
+	taskQueue     *util.TaskQueue
+}
+
+func newReplicaSpareTracker(clients *backendClientPool, safeModeDisks *disk.SafeModeTracker, taskQueue *util.TaskQueue) *replicaSpareTracker {
+	t := &replicaSpareTracker{
+		lock:          &sync.Mutex{},
+		spares:        map[string]*replicaSpare{},
+		clients:       clients,
+		safeModeDisks: safeModeDisks,
+		taskQueue:     taskQueue,
+	}
+	taskQueue.RegisterHandler(replicaSpareExpireTaskType, t.expire)
+	return t
+}
+
+func (t *replicaSpareTracker) create(req *rpc.ReplicaSpareCreateRequest) (*rpc.ReplicaSpare, error) {
+	if err := t.safeModeDisks.CheckWritable(req.DiskName); err != nil {
+		return nil, err
+	}
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	ttl := defaultReplicaSpareTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+
+	c, err := t.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	// The spare is exposed right away, so that it can be attached by a rebuild without waiting for the exposure
+	name := replicaSparePrefix + util.UUID()[:8]
+	replica, err := c.ReplicaCreate(name, req.DiskName, req.DiskUuid, req.Size, true, req.PortCount)
+	if err != nil {
+		return nil, err
+	}
+
+	expireTaskID, err := t.taskQueue.Enqueue(replicaSpareExpireTaskType, map[string]string{"name": name}, ttl+replicaSpareExpireDelay)
+	if err != nil {
+		if deleteErr := t.deleteReplica(name); deleteErr != nil {
+			logrus.WithError(deleteErr).Warnf("Failed to delete replica spare %v without the expiry task", name)
+		}
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to schedule the expiry of replica spare %v", name).Error())
+	}
+
+	now := time.Now()
+	s := &replicaSpare{
+		spare: &rpc.ReplicaSpare{
+			Name:      replica.Name,
+			DiskName:  replica.LvsName,
+			DiskUuid:  replica.LvsUUID,
+			Size:      replica.SpecSize,
+			Ip:        replica.IP,
+			PortStart: replica.PortStart,
+			PortEnd:   replica.PortEnd,
+			CreatedAt: now.UTC().Format(time.RFC3339),
+			ExpiresAt: now.Add(ttl).UTC().Format(time.RFC3339),
+		},
+		createdAt:    now,
+		expiresAt:    now.Add(ttl),
+		expireTaskID: expireTaskID,
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.spares[name] = s
+	return proto.Clone(s.spare).(*rpc.ReplicaSpare), nil
+}
+
+// claim hands out the oldest unexpired spare of the size on the disk.
+func (t *replicaSpareTracker) claim(req *rpc.ReplicaSpareClaimRequest) (*rpc.ReplicaSpare, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	var claimed *replicaSpare
+	for _, s := range t.spares {
+		if s.spare.Size != req.Size || now.After(s.expiresAt) {
+			continue
+		}
+		if req.DiskName != "" && s.spare.DiskName != req.DiskName {
+			continue
+		}
+		if req.DiskUuid != "" && s.spare.DiskUuid != req.DiskUuid {
+			continue
+		}
+		if claimed == nil || s.createdAt.Before(claimed.createdAt) {
+			claimed = s
+		}
+	}
+	if claimed == nil {
+		return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find replica spare of size %v on disk %v(%v)", req.Size, req.DiskName, req.DiskUuid)
+	}
+	delete(t.spares, claimed.spare.Name)
+	if err := t.taskQueue.Cancel(claimed.expireTaskID); err != nil {
+		t.spares[claimed.spare.Name] = claimed
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to cancel the expiry of replica spare %v", claimed.spare.Name).Error())
+	}
+	return claimed.spare, nil
+}
+
+func (t *replicaSpareTracker) list() map[string]*rpc.ReplicaSpare {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	spares := map[string]*rpc.ReplicaSpare{}
+	for name, s := range t.spares {
+		spares[name] = proto.Clone(s.spare).(*rpc.ReplicaSpare)
+	}
+	return spares
+}
+
+func (t *replicaSpareTracker) delete(name string) error {
+	t.lock.Lock()
+	s, exists := t.spares[name]
+	if !exists {
+		t.lock.Unlock()
+		return grpcstatus.Errorf(grpccodes.NotFound, "cannot find replica spare %v", name)
+	}
+	delete(t.spares, name)
+	t.lock.Unlock()
+
+	if err := t.deleteReplica(name); err != nil {
+		t.lock.Lock()
+		t.spares[name] = s
+		t.lock.Unlock()
+		return err
+	}
+	if err := t.taskQueue.Cancel(s.expireTaskID); err != nil {
+		logrus.WithError(err).Warnf("Failed to cancel the expiry of deleted replica spare %v", name)
+	}
+	return nil
+}
+
+func (t *replicaSpareTracker) deleteReplica(name string) error {
+	c, err := t.clients.getSPDKClient()
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	if err := c.ReplicaDelete(name, true); err != nil && grpcstatus.Code(err) != grpccodes.NotFound {
+		return err
+	}
+	return nil
+}
+
+// expire deletes the expired spare. It is the handler of the expiry task, which is retried until the spare is
+// deleted, including by the next instance manager after a restart.
+func (t *replicaSpareTracker) expire(args map[string]string) error {
+	name := args["name"]
+	if name == "" {
+		return nil
+	}
+
+	t.lock.Lock()
+	s, exists := t.spares[name]
+	delete(t.spares, name)
+	t.lock.Unlock()
+
+	logrus.Infof("Deleting expired replica spare %v", name)
+	if err := t.deleteReplica(name); err != nil {
+		if exists {
+			t.lock.Lock()
+			t.spares[name] = s
+			t.lock.Unlock()
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Server) ReplicaSpareCreate(ctx context.Context, req *rpc.ReplicaSpareCreateRequest) (*rpc.ReplicaSpare, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskName":   req.DiskName,
+		"diskUUID":   req.DiskUuid,
+		"size":       req.Size,
+		"ttlSeconds": req.TtlSeconds,
+	}).Info("Creating replica spare")
+
+	if !s.v2DataEngineEnabled {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "data engine %v is not enabled", rpc.DataEngine_DATA_ENGINE_V2)
+	}
+	if req.DiskName == "" || req.Size == 0 {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument")
+	}
+	if req.TtlSeconds < 0 {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid TTL %v", req.TtlSeconds)
+	}
+	return s.spares.create(req)
+}
+
+func (s *Server) ReplicaSpareClaim(ctx context.Context, req *rpc.ReplicaSpareClaimRequest) (*rpc.ReplicaSpare, error) {
+	logrus.WithFields(logrus.Fields{
+		"diskName": req.DiskName,
+		"diskUUID": req.DiskUuid,
+		"size":     req.Size,
+	}).Info("Claiming replica spare")
+
+	if req.Size == 0 || (req.DiskName == "" && req.DiskUuid == "") {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument")
+	}
+	return s.spares.claim(req)
+}
+
+func (s *Server) ReplicaSpareList(ctx context.Context, req *emptypb.Empty) (*rpc.ReplicaSpareListResponse, error) {
+	return &rpc.ReplicaSpareListResponse{
+		Spares: s.spares.list(),
+	}, nil
+}
+
+func (s *Server) ReplicaSpareDelete(ctx context.Context, req *rpc.ReplicaSpareDeleteRequest) (*emptypb.Empty, error) {
+	logrus.WithFields(logrus.Fields{"name": req.Name}).Info("Deleting replica spare")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+	if err := s.spares.delete(req.Name); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,65 @@
+package instance
+
+import (
+	"time"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestReplicaSpareTrackerClaim(c *C) {
+	taskQueue, err := util.NewTaskQueue(c.MkDir())
+	c.Assert(err, IsNil)
+	t := newReplicaSpareTracker(nil, nil, taskQueue)
+
+	now := time.Now()
+	addSpare := func(name, diskName string, size uint64, createdAt time.Time) {
+		taskID, err := taskQueue.Enqueue(replicaSpareExpireTaskType, map[string]string{"name": name}, time.Hour)
+		c.Assert(err, IsNil)
+		t.spares[name] = &replicaSpare{
+			spare:        &rpc.ReplicaSpare{Name: name, DiskName: diskName, DiskUuid: diskName + "-uuid", Size: size},
+			createdAt:    createdAt,
+			expiresAt:    createdAt.Add(defaultReplicaSpareTTL),
+			expireTaskID: taskID,
+		}
+	}
+	addSpare("spare-r-0", "disk-0", 1024, now.Add(-time.Minute))
+	addSpare("spare-r-1", "disk-0", 1024, now.Add(-2*time.Minute))
+	addSpare("spare-r-2", "disk-1", 1024, now.Add(-3*time.Minute))
+	addSpare("spare-r-3", "disk-0", 2048, now.Add(-4*time.Minute))
+	addSpare("spare-r-4", "disk-0", 1024, now.Add(-defaultReplicaSpareTTL-time.Minute))
+
+	testCases := []struct {
+		comment string
+		req     *rpc.ReplicaSpareClaimRequest
+		claimed string
+	}{
+		{"oldest unexpired spare of the size on the disk", &rpc.ReplicaSpareClaimRequest{Size: 1024, DiskName: "disk-0"}, "spare-r-1"},
+		{"the next one", &rpc.ReplicaSpareClaimRequest{Size: 1024, DiskName: "disk-0"}, "spare-r-0"},
+		{"expired spare is never claimed", &rpc.ReplicaSpareClaimRequest{Size: 1024, DiskName: "disk-0"}, ""},
+		{"by the disk UUID", &rpc.ReplicaSpareClaimRequest{Size: 1024, DiskUuid: "disk-1-uuid"}, "spare-r-2"},
+		{"of another size on any disk", &rpc.ReplicaSpareClaimRequest{Size: 2048}, "spare-r-3"},
+	}
+	for i, testCase := range testCases {
+		comment := Commentf("test case %v: %v", i, testCase.comment)
+		spare, err := t.claim(testCase.req)
+		if testCase.claimed == "" {
+			c.Assert(grpcstatus.Code(err), Equals, grpccodes.NotFound, comment)
+			continue
+		}
+		c.Assert(err, IsNil, comment)
+		c.Assert(spare.Name, Equals, testCase.claimed, comment)
+	}
+
+	// The expiry tasks of the claimed spares are canceled, and the unclaimed one is left to expire
+	c.Assert(t.list(), HasLen, 1)
+	tasks := taskQueue.List()
+	c.Assert(tasks, HasLen, 1)
+	c.Assert(tasks[0].Args["name"], Equals, "spare-r-4")
+}
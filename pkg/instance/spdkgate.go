@@ -0,0 +1,146 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	DefaultSPDKStartupGateTimeout = 5 * time.Minute
+
+	spdkStartupGatePollInterval     = time.Second
+	spdkStartupGateProgressInterval = 15 * time.Second
+	// spdkStartupGateRetryDelay is the delay the rejected calls are told to retry after
+	spdkStartupGateRetryDelay = 5 * time.Second
+)
+
+// spdkStartupGate rejects the v2 calls with Unavailable until spdk_tgt and the SPDK service are ready at startup,
+// rather than letting them fail with the errors of the half started backends. The gate opens anyway once the timeout
+// passes, so that the errors of the backends surface then. A nil gate is open.
+type spdkStartupGate struct {
+	lock      *sync.RWMutex
+	startedAt time.Time
+	open      bool
+	// waitingFor is the backend not ready yet
+	waitingFor string
+}
+
+func newSPDKStartupGate() *spdkStartupGate {
+	return &spdkStartupGate{
+		lock:       &sync.RWMutex{},
+		startedAt:  time.Now(),
+		waitingFor: spdkTgtProcessName,
+	}
+}
+
+// run waits for the backends until ready or the timeout passes, and then opens the gate.
+func (g *spdkStartupGate) run(ctx context.Context, spdkServiceAddress string, timeout time.Duration) {
+	ticker := time.NewTicker(spdkStartupGatePollInterval)
+	defer ticker.Stop()
+
+	lastReported := g.startedAt
+	for {
+		waitingFor := ""
+		if !util.IsSPDKTgtReady(spdkStartupGatePollInterval) {
+			waitingFor = spdkTgtProcessName
+		} else if !util.GRPCServiceReadinessProbe(spdkServiceAddress) {
+			waitingFor = "SPDK service"
+		}
+
+		g.lock.Lock()
+		g.waitingFor = waitingFor
+		if waitingFor == "" {
+			g.open = true
+		}
+		g.lock.Unlock()
+
+		elapsed := time.Since(g.startedAt).Round(time.Second)
+		if waitingFor == "" {
+			logrus.Infof("Serving the v2 data engine calls since %v and the SPDK service are ready after %v", spdkTgtProcessName, elapsed)
+			return
+		}
+		if elapsed >= timeout {
+			g.lock.Lock()
+			g.open = true
+			g.lock.Unlock()
+			logrus.Warnf("Serving the v2 data engine calls although %v is not ready after %v", waitingFor, timeout)
+			return
+		}
+		if time.Since(lastReported) >= spdkStartupGateProgressInterval {
+			lastReported = time.Now()
+			logrus.Infof("Waiting for %v to be ready before serving the v2 data engine calls, %v passed out of %v", waitingFor, elapsed, timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check returns Unavailable with the delay to retry after as the RetryInfo details while the gate is closed.
+func (g *spdkStartupGate) check() error {
+	if g == nil {
+		return nil
+	}
+
+	g.lock.RLock()
+	open, waitingFor, startedAt := g.open, g.waitingFor, g.startedAt
+	g.lock.RUnlock()
+	if open {
+		return nil
+	}
+
+	st := grpcstatus.Newf(grpccodes.Unavailable, "v2 data engine is starting, waiting for %v to be ready since %v",
+		waitingFor, startedAt.UTC().Format(time.RFC3339))
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(spdkStartupGateRetryDelay)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// checkDataEngine checks the gate for the calls of the v2 data engine only.
+func (g *spdkStartupGate) checkDataEngine(dataEngine rpc.DataEngine) error {
+	if dataEngine != rpc.DataEngine_DATA_ENGINE_V2 {
+		return nil
+	}
+	return g.check()
+}
+
+// getRequestDataEngine returns the data engine a request of the instance service is for, or false if the request is
+// not for a single data engine.
+func getRequestDataEngine(req interface{}) (rpc.DataEngine, bool) {
+	switch r := req.(type) {
+	case interface{ GetSpec() *rpc.InstanceSpec }:
+		if r.GetSpec() == nil {
+			return 0, false
+		}
+		return r.GetSpec().GetDataEngine(), true
+	case interface{ GetDataEngine() rpc.DataEngine }:
+		return r.GetDataEngine(), true
+	}
+	return 0, false
+}
+
+// EnableSPDKStartupGate has the v2 calls rejected until spdk_tgt and the SPDK service are ready, or until the
+// timeout passes. The gate is disabled if the timeout is 0.
+func (s *Server) EnableSPDKStartupGate(spdkServiceAddress string, timeout time.Duration) {
+	if !s.v2DataEngineEnabled || timeout <= 0 {
+		return
+	}
+	s.spdkGate = newSPDKStartupGate()
+	go s.spdkGate.run(s.ctx, spdkServiceAddress, timeout)
+}
@@ -0,0 +1,89 @@
+package instance
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// InstanceLog streams the lines of the spdk_tgt log mentioning the instance, since the v2 instances are bdevs of
+// spdk_tgt rather than processes with their own logs. The raid bdev of an engine and the lvol of a replica are named
+// after the instance. The line numbers of the log are used as the sequences.
+func (ops V2DataEngineInstanceOps) InstanceLog(req *rpc.InstanceLogRequest, srv rpc.InstanceService_InstanceLogServer) error {
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+	logChan, err := ops.streamInstanceLog(ctx, req.Name, req.SinceSequence)
+	if err != nil {
+		return err
+	}
+
+	if req.Batched {
+		return sendLogFrames(logChan, req.Compressed, srv)
+	}
+	for logLine := range logChan {
+		if err := srv.Send(&rpc.LogResponse{
+			Line:      logLine.Line,
+			Sequence:  logLine.Sequence,
+			Timestamp: getLogTimestamp(logLine),
+		}); err != nil {
+			return err
+		}
+	}
+	logrus.Infof("Got SPDK log for instance %v", req.Name)
+	return nil
+}
+
+// InstanceLogStream streams the lines of the spdk_tgt log mentioning the instance until the end of the log.
+func (ops V2DataEngineInstanceOps) InstanceLogStream(ctx context.Context, name string) (<-chan *util.LogLine, error) {
+	return ops.streamInstanceLog(ctx, name, 0)
+}
+
+func (ops V2DataEngineInstanceOps) streamInstanceLog(ctx context.Context, name string, sinceSequence uint64) (<-chan *util.LogLine, error) {
+	if ops.spdkTgtLogPath == "" {
+		return nil, grpcstatus.Error(grpccodes.FailedPrecondition, "spdk_tgt log file is not configured")
+	}
+	logChan, err := util.StreamSPDKLog(ctx, ops.spdkTgtLogPath, []string{name}, sinceSequence)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find spdk_tgt log file %v", ops.spdkTgtLogPath)
+		}
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to open spdk_tgt log file %v", ops.spdkTgtLogPath).Error())
+	}
+	return logChan, nil
+}
+
+// sendLogFrames sends the log lines in the batched frames, in the same format as the process manager does.
+func sendLogFrames(logChan <-chan *util.LogLine, compressed bool, srv rpc.InstanceService_InstanceLogServer) error {
+	for {
+		batch, ok := util.NextLogBatch(logChan)
+		if !ok {
+			return nil
+		}
+		frame, err := util.EncodeLogFrame(batch, compressed)
+		if err != nil {
+			return grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
+		if err := srv.Send(&rpc.LogResponse{
+			Frame:      frame,
+			Compressed: compressed,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// getLogTimestamp returns 0 for the lines without a timestamp.
+func getLogTimestamp(logLine *util.LogLine) int64 {
+	if logLine.Time.IsZero() {
+		return 0
+	}
+	return logLine.Time.UnixNano()
+}
@@ -0,0 +1,439 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
+	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const nodeStateDiskTypeBlock = "block"
+
+var nodeStateDataEngines = map[rpc.DataEngine]string{
+	rpc.DataEngine_DATA_ENGINE_V1: "v1",
+	rpc.DataEngine_DATA_ENGINE_V2: "v2",
+}
+
+// DiskStateService is the disk service of the instance manager, which the disks of the node state are exported from
+// and imported into.
+type DiskStateService interface {
+	DiskStateList() []*rpc.DiskCreateRequest
+	DiskCreate(ctx context.Context, req *rpc.DiskCreateRequest) (*rpc.Disk, error)
+}
+
+// StateExport returns the disks and instances of the instance manager along with the specs they are created with,
+// see api.NodeState for the format.
+func (s *Server) StateExport(ctx context.Context, req *emptypb.Empty) (*rpc.StateExportResponse, error) {
+	logrus.Info("Exporting node state")
+
+	state := &api.NodeState{
+		Version:                api.NodeStateVersion,
+		ExportedAt:             time.Now().UTC().Format(time.RFC3339),
+		InstanceManagerVersion: meta.GetVersion().Version,
+		Topology:               meta.GetTopology(),
+		Disks:                  []*api.NodeStateDisk{},
+		Instances:              []*api.NodeStateInstance{},
+	}
+
+	if s.Disks != nil {
+		for _, d := range s.Disks.DiskStateList() {
+			state.Disks = append(state.Disks, &api.NodeStateDisk{
+				Name:      d.DiskName,
+				Type:      nodeStateDiskTypeBlock,
+				Path:      d.DiskPath,
+				UUID:      d.DiskUuid,
+				BlockSize: d.BlockSize,
+			})
+		}
+	}
+
+	specs, err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].(V1DataEngineInstanceOps).instanceStateSpecs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.v2DataEngineEnabled {
+		v2Specs, err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].(V2DataEngineInstanceOps).instanceStateSpecs()
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, v2Specs...)
+	}
+	sortNodeStateSpecs(specs)
+
+	for _, spec := range specs {
+		s.restarts.apply(&rpc.InstanceResponse{Spec: spec, Status: &rpc.InstanceStatus{}})
+		encoded, err := protojson.Marshal(spec)
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to encode the spec of instance %v", spec.Name).Error())
+		}
+		state.Instances = append(state.Instances, &api.NodeStateInstance{
+			Name:       spec.Name,
+			Type:       spec.Type,
+			DataEngine: nodeStateDataEngines[spec.DataEngine],
+			Spec:       encoded,
+		})
+	}
+
+	encoded, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to encode the node state").Error())
+	}
+	return &rpc.StateExportResponse{
+		State:   string(encoded),
+		Version: api.NodeStateVersion,
+	}, nil
+}
+
+// instanceStateSpecs returns the specs the processes are created with. The port args are appended to the args of a
+// process once its ports are allocated, so they are stripped from the args again.
+func (ops V1DataEngineInstanceOps) instanceStateSpecs(ctx context.Context) ([]*rpc.InstanceSpec, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	processes, err := pmClient.ProcessList()
+	if err != nil {
+		return nil, err
+	}
+
+	specs := []*rpc.InstanceSpec{}
+	for _, p := range processes {
+		if p.Spec == nil {
+			continue
+		}
+		specs = append(specs, &rpc.InstanceSpec{
+			Name:       p.Spec.Name,
+			DataEngine: rpc.DataEngine_DATA_ENGINE_V1,
+			PortCount:  p.Spec.PortCount,
+			PortArgs:   p.Spec.PortArgs,
+			ProcessInstanceSpec: &rpc.ProcessInstanceSpec{
+				Binary:   p.Spec.Binary,
+				Args:     stripPortArgs(p.Spec.Args, p.Spec.PortArgs),
+				Sidecars: p.Spec.Sidecars,
+				Envs:     p.Spec.Envs,
+
+				CpuMillicores:      p.Spec.CpuMillicores,
+				MemoryBytes:        p.Spec.MemoryBytes,
+				CpuSet:             p.Spec.CpuSet,
+				NumaNode:           p.Spec.NumaNode,
+				Privileges:         p.Spec.Privileges,
+				StopTimeoutSeconds: p.Spec.StopTimeoutSeconds,
+			},
+			Labels:        p.Spec.Labels,
+			PriorityClass: p.Spec.PriorityClass,
+		})
+	}
+	return specs, nil
+}
+
+// stripPortArgs removes the args appended for the port args, each of which is split by ',' into one or more args.
+func stripPortArgs(args, portArgs []string) []string {
+	count := 0
+	for _, portArg := range portArgs {
+		count += len(strings.Split(portArg, ","))
+	}
+	if count > len(args) {
+		return args
+	}
+	return args[:len(args)-count]
+}
+
+// instanceStateSpecs returns the specs the replicas and engines are created with. The replicas are created exposed,
+// since the engines on the other nodes connect to them.
+func (ops V2DataEngineInstanceOps) instanceStateSpecs() ([]*rpc.InstanceSpec, error) {
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	specs := []*rpc.InstanceSpec{}
+
+	replicas, err := c.ReplicaList()
+	if err != nil {
+		return nil, err
+	}
+	for _, replica := range replicas {
+		portCount := int32(1)
+		if replica.PortStart != 0 && replica.PortEnd >= replica.PortStart {
+			portCount = replica.PortEnd - replica.PortStart + 1
+		}
+		specs = append(specs, ops.applyInstanceState(&rpc.InstanceSpec{
+			Name:       replica.Name,
+			Type:       types.InstanceTypeReplica,
+			DataEngine: rpc.DataEngine_DATA_ENGINE_V2,
+			PortCount:  portCount,
+			SpdkInstanceSpec: &rpc.SpdkInstanceSpec{
+				DiskName:       replica.LvsName,
+				DiskUuid:       replica.LvsUUID,
+				Size:           replica.SpecSize,
+				ExposeRequired: true,
+			},
+		}))
+	}
+
+	engines, err := c.EngineList()
+	if err != nil {
+		return nil, err
+	}
+	for _, engine := range engines {
+		specs = append(specs, ops.applyInstanceState(&rpc.InstanceSpec{
+			Name:       engine.Name,
+			Type:       types.InstanceTypeEngine,
+			DataEngine: rpc.DataEngine_DATA_ENGINE_V2,
+			VolumeName: engine.VolumeName,
+			PortCount:  1,
+			SpdkInstanceSpec: &rpc.SpdkInstanceSpec{
+				ReplicaAddressMap:    engine.ReplicaAddressMap,
+				Size:                 engine.SpecSize,
+				Frontend:             engine.Frontend,
+				PreferredReadReplica: ops.readPreferences.get(engine.Name),
+			},
+		}))
+	}
+	return specs, nil
+}
+
+// applyInstanceState sets the labels and priority class kept by the instance manager in the spec.
+func (ops V2DataEngineInstanceOps) applyInstanceState(spec *rpc.InstanceSpec) *rpc.InstanceSpec {
+	return ops.priorities.apply(ops.labels.apply(&rpc.InstanceResponse{Spec: spec})).Spec
+}
+
+// sortNodeStateSpecs sorts the specs in the order they are imported: the v2 replicas, the v2 engines connecting to
+// them, and the v1 instances, each by name.
+func sortNodeStateSpecs(specs []*rpc.InstanceSpec) {
+	rank := func(spec *rpc.InstanceSpec) int {
+		switch {
+		case spec.DataEngine == rpc.DataEngine_DATA_ENGINE_V2 && spec.Type == types.InstanceTypeReplica:
+			return 0
+		case spec.DataEngine == rpc.DataEngine_DATA_ENGINE_V2:
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(specs, func(i, j int) bool {
+		if rank(specs[i]) != rank(specs[j]) {
+			return rank(specs[i]) < rank(specs[j])
+		}
+		return specs[i].Name < specs[j].Name
+	})
+}
+
+// StateImport creates the disks and instances of the state exported by StateExport, e.g. on a replacement node. The
+// whole state is validated first, and nothing is created if any part of it is invalid. The disks and instances
+// existing already are left as they are, and a failed one does not stop the import of the others.
+func (s *Server) StateImport(ctx context.Context, req *rpc.StateImportRequest) (*rpc.StateImportResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"validateOnly": req.ValidateOnly,
+	}).Info("Importing node state")
+
+	state, specs, err := s.parseNodeState(req.State)
+	if err != nil {
+		return nil, err
+	}
+
+	existingDisks := map[string]bool{}
+	if len(state.Disks) != 0 {
+		if s.Disks == nil {
+			return nil, grpcstatus.Error(grpccodes.FailedPrecondition, "cannot import disks without the disk service")
+		}
+		for _, d := range s.Disks.DiskStateList() {
+			existingDisks[d.DiskName] = true
+		}
+	}
+	existingInstances := map[string]*rpc.InstanceResponse{}
+	if err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(ctx, existingInstances); err != nil {
+		return nil, err
+	}
+	if s.v2DataEngineEnabled {
+		if err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(ctx, existingInstances); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &rpc.StateImportResponse{Results: []*rpc.StateImportResult{}}
+	for _, d := range state.Disks {
+		result := &rpc.StateImportResult{
+			Kind:   types.StateImportKindDisk,
+			Name:   d.Name,
+			Result: types.StateImportResultValid,
+		}
+		resp.Results = append(resp.Results, result)
+		if existingDisks[d.Name] {
+			result.Result = types.StateImportResultExists
+			continue
+		}
+		if req.ValidateOnly {
+			continue
+		}
+		_, err := s.Disks.DiskCreate(ctx, &rpc.DiskCreateRequest{
+			DiskType:  rpc.DiskType_block,
+			DiskName:  d.Name,
+			DiskUuid:  d.UUID,
+			DiskPath:  d.Path,
+			BlockSize: d.BlockSize,
+		})
+		setStateImportResult(result, err)
+	}
+
+	for _, spec := range specs {
+		result := &rpc.StateImportResult{
+			Kind:       types.StateImportKindInstance,
+			Name:       spec.Name,
+			DataEngine: spec.DataEngine,
+			Result:     types.StateImportResultValid,
+		}
+		resp.Results = append(resp.Results, result)
+		if existing, exists := existingInstances[spec.Name]; exists && existing.GetSpec().GetDataEngine() == spec.DataEngine {
+			result.Result = types.StateImportResultExists
+			continue
+		}
+		if req.ValidateOnly {
+			continue
+		}
+		_, err := s.InstanceCreate(ctx, &rpc.InstanceCreateRequest{Spec: spec})
+		setStateImportResult(result, err)
+	}
+
+	return resp, nil
+}
+
+func setStateImportResult(result *rpc.StateImportResult, err error) {
+	switch {
+	case err == nil:
+		result.Result = types.StateImportResultCreated
+	case grpcstatus.Code(err) == grpccodes.AlreadyExists:
+		result.Result = types.StateImportResultExists
+	default:
+		result.Result = types.StateImportResultFailed
+		result.ErrorMsg = err.Error()
+		logrus.WithError(err).Warnf("Failed to import %v %v", result.Kind, result.Name)
+	}
+}
+
+// parseNodeState decodes and validates the state, and returns the specs of its instances in the order they are
+// imported. The fields unknown to this instance manager are ignored, since they are only added within a version.
+func (s *Server) parseNodeState(encoded string) (*api.NodeState, []*rpc.InstanceSpec, error) {
+	state := &api.NodeState{}
+	if err := json.Unmarshal([]byte(encoded), state); err != nil {
+		return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "failed to decode the node state: %v", err)
+	}
+	if state.Version < 1 || state.Version > api.NodeStateVersion {
+		return nil, nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "unsupported node state version %v, the supported versions are 1 to %v",
+			state.Version, api.NodeStateVersion)
+	}
+
+	violations := util.FieldViolations{}
+
+	diskNames := map[string]bool{}
+	for i, d := range state.Disks {
+		field := fmt.Sprintf("disks[%d]", i)
+		if d == nil {
+			violations.Add(field, "missing required argument")
+			continue
+		}
+		if d.Name == "" {
+			violations.Add(field+".name", "missing required argument")
+		} else if diskNames[d.Name] {
+			violations.Add(field+".name", "duplicate disk %v", d.Name)
+		}
+		diskNames[d.Name] = true
+		if d.Type != nodeStateDiskTypeBlock {
+			violations.Add(field+".type", "unsupported disk type %v", d.Type)
+		}
+		if d.Path == "" {
+			violations.Add(field+".path", "missing required argument")
+		}
+		if d.BlockSize < 0 {
+			violations.Add(field+".blockSize", "invalid block size %v", d.BlockSize)
+		}
+	}
+
+	specs := []*rpc.InstanceSpec{}
+	instanceNames := map[string]bool{}
+	for i, instance := range state.Instances {
+		field := fmt.Sprintf("instances[%d]", i)
+		if instance == nil {
+			violations.Add(field, "missing required argument")
+			continue
+		}
+		spec := &rpc.InstanceSpec{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(instance.Spec, spec); err != nil {
+			violations.Add(field+".spec", "failed to decode the spec: %v", err)
+			continue
+		}
+		validateNodeStateInstance(field, instance, spec, s.v2DataEngineEnabled, &violations)
+
+		key := nodeStateDataEngines[spec.DataEngine] + "/" + spec.Name
+		if instanceNames[key] {
+			violations.Add(field+".name", "duplicate instance %v", spec.Name)
+		}
+		instanceNames[key] = true
+		specs = append(specs, spec)
+	}
+
+	if err := violations.Err(); err != nil {
+		return nil, nil, err
+	}
+	sortNodeStateSpecs(specs)
+	return state, specs, nil
+}
+
+func validateNodeStateInstance(field string, instance *api.NodeStateInstance, spec *rpc.InstanceSpec, v2DataEngineEnabled bool, violations *util.FieldViolations) {
+	if spec.Name == "" {
+		violations.Add(field+".spec.name", "missing required argument")
+	} else if spec.Name != instance.Name {
+		violations.Add(field+".name", "name %v does not match the one of the spec %v", instance.Name, spec.Name)
+	}
+	if dataEngine, ok := nodeStateDataEngines[spec.DataEngine]; !ok {
+		violations.Add(field+".spec.dataEngine", "unsupported data engine %v", spec.DataEngine)
+	} else if dataEngine != instance.DataEngine {
+		violations.Add(field+".dataEngine", "data engine %v does not match the one of the spec %v", instance.DataEngine, dataEngine)
+	}
+
+	switch spec.DataEngine {
+	case rpc.DataEngine_DATA_ENGINE_V1:
+		if spec.ProcessInstanceSpec == nil || spec.ProcessInstanceSpec.Binary == "" {
+			violations.Add(field+".spec.processInstanceSpec.binary", "missing required argument")
+		}
+	case rpc.DataEngine_DATA_ENGINE_V2:
+		if !v2DataEngineEnabled {
+			violations.Add(field+".spec.dataEngine", "v2 data engine is disabled")
+		}
+		if spec.Type != types.InstanceTypeEngine && spec.Type != types.InstanceTypeReplica {
+			violations.Add(field+".spec.type", "unknown instance type %v", spec.Type)
+		}
+		if spec.SpdkInstanceSpec == nil {
+			violations.Add(field+".spec.spdkInstanceSpec", "missing required argument")
+		}
+	}
+	if spec.PortCount < 0 {
+		violations.Add(field+".spec.portCount", "invalid port count %v", spec.PortCount)
+	}
+	if err := util.ValidateLabels(spec.Labels); err != nil {
+		violations.Add(field+".spec.labels", err.Error())
+	}
+	if err := validateRestartPolicy(spec); err != nil {
+		violations.Add(field+".spec.restartPolicy", err.Error())
+	}
+	if err := util.ValidatePriorityClass(spec.PriorityClass); err != nil {
+		violations.Add(field+".spec.priorityClass", err.Error())
+	}
+}
@@ -0,0 +1,52 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// StreamEventList returns the active and the latest ended streams of all the gRPC servers of the instance manager,
+// with the cause each one ended for, e.g. to tell why a watch of longhorn-manager was lost.
+func (s *Server) StreamEventList(ctx context.Context, req *emptypb.Empty) (*rpc.StreamEventListResponse, error) {
+	logrus.Trace("Listing stream events")
+
+	active, ended := metrics.StreamList()
+	resp := &rpc.StreamEventListResponse{
+		Active: []*rpc.StreamEvent{},
+		Ended:  []*rpc.StreamEvent{},
+	}
+	for _, record := range active {
+		resp.Active = append(resp.Active, streamRecordToStreamEvent(record))
+	}
+	for _, record := range ended {
+		resp.Ended = append(resp.Ended, streamRecordToStreamEvent(record))
+	}
+	return resp, nil
+}
+
+func streamRecordToStreamEvent(record *metrics.StreamRecord) *rpc.StreamEvent {
+	event := &rpc.StreamEvent{
+		Id:        record.ID,
+		Service:   record.Service,
+		Method:    record.Method,
+		Peer:      record.Peer,
+		StartTime: record.StartTime.Format(time.RFC3339Nano),
+		Cause:     record.Cause,
+		ErrorMsg:  record.Error,
+	}
+	endTime := time.Now()
+	if !record.EndTime.IsZero() {
+		endTime = record.EndTime
+		event.EndTime = record.EndTime.Format(time.RFC3339Nano)
+		event.Code = record.Code.String()
+	}
+	event.DurationMs = endTime.Sub(record.StartTime).Milliseconds()
+	return event
+}
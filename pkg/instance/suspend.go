@@ -0,0 +1,227 @@
+package instance
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	helpernvme "github.com/longhorn/go-spdk-helper/pkg/nvme"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// engineSuspendTracker keeps the v2 engines whose frontend is suspended. spdk_tgt knows nothing about the suspension
+// of the device mapper device in front of the NVMe-oF initiator, so the state of these engines is reported as
+// suspended by the instance server itself. The suspensions are not persisted, so the engines suspended before the
+// instance manager restarts are reported as running.
+type engineSuspendTracker struct {
+	lock    *sync.RWMutex
+	engines map[string]bool
+}
+
+func newEngineSuspendTracker() *engineSuspendTracker {
+	return &engineSuspendTracker{
+		lock:    &sync.RWMutex{},
+		engines: map[string]bool{},
+	}
+}
+
+func (t *engineSuspendTracker) set(name string, suspended bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if suspended {
+		t.engines[name] = true
+	} else {
+		delete(t.engines, name)
+	}
+}
+
+func (t *engineSuspendTracker) isSuspended(name string) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.engines[name]
+}
+
+// apply reports the running engine as suspended if its frontend is suspended.
+func (t *engineSuspendTracker) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	if resp.Status != nil && resp.Status.State == types.ProcessStateRunning && t.isSuspended(resp.Spec.Name) {
+		resp.Status.State = types.ProcessStateSuspended
+	}
+	return resp
+}
+
+// InstanceSuspend quiesces the instance, e.g. during a node maintenance or a live migration, until InstanceResume.
+func (s *Server) InstanceSuspend(ctx context.Context, req *rpc.InstanceSuspendRequest) (*rpc.InstanceResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"type":       req.Type,
+		"dataEngine": req.DataEngine,
+	}).Info("Suspending instance")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	resp, err := ops.InstanceSuspend(ctx, req)
+	s.operations.Record(req.Name, types.InstanceOperationSuspend, "", err)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastCh <- interface{}(resp)
+	return resp, nil
+}
+
+// InstanceResume resumes the instance suspended by InstanceSuspend.
+func (s *Server) InstanceResume(ctx context.Context, req *rpc.InstanceResumeRequest) (*rpc.InstanceResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"type":       req.Type,
+		"dataEngine": req.DataEngine,
+	}).Info("Resuming instance")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+
+	ops, ok := s.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	resp, err := ops.InstanceResume(ctx, req)
+	s.operations.Record(req.Name, types.InstanceOperationResume, "", err)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastCh <- interface{}(resp)
+	return resp, nil
+}
+
+// InstanceSuspend stops the process by SIGSTOP.
+func (ops V1DataEngineInstanceOps) InstanceSuspend(ctx context.Context, req *rpc.InstanceSuspendRequest) (*rpc.InstanceResponse, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	process, err := pmClient.ProcessSuspend(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return processResponseToInstanceResponse(process), nil
+}
+
+// InstanceResume continues the process by SIGCONT.
+func (ops V1DataEngineInstanceOps) InstanceResume(ctx context.Context, req *rpc.InstanceResumeRequest) (*rpc.InstanceResponse, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	process, err := pmClient.ProcessResume(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return processResponseToInstanceResponse(process), nil
+}
+
+// InstanceSuspend suspends the device mapper device of the engine frontend, which queues the I/O of the volume
+// until it is resumed. The replicas have no frontend to suspend.
+func (ops V2DataEngineInstanceOps) InstanceSuspend(ctx context.Context, req *rpc.InstanceSuspendRequest) (*rpc.InstanceResponse, error) {
+	engine, err := ops.getEngineWithFrontend(req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if ops.suspendedEngines.isSuspended(engine.Name) {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v is already suspended", engine.Name)
+	}
+
+	initiator, err := helpernvme.NewInitiator(engine.VolumeName, helpertypes.GetNQN(engine.Name), helpernvme.HostProc)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create NVMe initiator of engine %v", engine.Name).Error())
+	}
+	if err := initiator.Suspend(false, false); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to suspend the frontend of engine %v", engine.Name).Error())
+	}
+	ops.suspendedEngines.set(engine.Name, true)
+
+	return ops.priorities.apply(ops.labels.apply(ops.suspendedEngines.apply(engineResponseToInstanceResponse(engine)))), nil
+}
+
+// InstanceResume resumes the device mapper device of the engine frontend.
+func (ops V2DataEngineInstanceOps) InstanceResume(ctx context.Context, req *rpc.InstanceResumeRequest) (*rpc.InstanceResponse, error) {
+	engine, err := ops.getEngineWithFrontend(req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if !ops.suspendedEngines.isSuspended(engine.Name) {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v is not suspended", engine.Name)
+	}
+
+	if err := resumeEngineFrontend(engine.VolumeName); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to resume the frontend of engine %v", engine.Name).Error())
+	}
+	ops.suspendedEngines.set(engine.Name, false)
+
+	return ops.priorities.apply(ops.labels.apply(engineResponseToInstanceResponse(engine))), nil
+}
+
+func (ops V2DataEngineInstanceOps) getEngineWithFrontend(name, instanceType string) (*spdkapi.Engine, error) {
+	if instanceType != types.InstanceTypeEngine {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "cannot suspend or resume %v %v since only the engine frontends of the v2 data engine can be suspended", instanceType, name)
+	}
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	engine, err := c.EngineGet(name)
+	if err != nil {
+		return nil, err
+	}
+	engine.Endpoint = ops.getEngineEndpoint(engine)
+	if engine.Endpoint == "" {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "engine %v has no block device frontend", name)
+	}
+	return engine, nil
+}
+
+// resumeEngineBeforeDeletion resumes the suspended engine, so that the I/O queued in its frontend is flushed or
+// failed rather than hanging the removal of the frontend.
+func (ops V2DataEngineInstanceOps) resumeEngineBeforeDeletion(c *spdkclient.SPDKClient, name string) error {
+	if !ops.suspendedEngines.isSuspended(name) {
+		return nil
+	}
+	engine, err := c.EngineGet(name)
+	if err != nil {
+		return err
+	}
+	if ops.getEngineEndpoint(engine) != "" {
+		if err := resumeEngineFrontend(engine.VolumeName); err != nil {
+			return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to resume the frontend of engine %v before deletion", name).Error())
+		}
+	}
+	ops.suspendedEngines.set(name, false)
+	return nil
+}
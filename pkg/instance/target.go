@@ -0,0 +1,519 @@
+package instance
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	commonTypes "github.com/longhorn/go-common-libs/types"
+	helpernvme "github.com/longhorn/go-spdk-helper/pkg/nvme"
+	spdkhelperclient "github.com/longhorn/go-spdk-helper/pkg/spdk/client"
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+	helperutil "github.com/longhorn/go-spdk-helper/pkg/util"
+	"github.com/longhorn/nsfilelock"
+
+	spdkapi "github.com/longhorn/longhorn-spdk-engine/pkg/api"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	// engineTargetStateDirName is the run directory of the logs directory the switch-overs are persisted under
+	engineTargetStateDirName  = "run"
+	engineTargetStateFileName = "engine-targets.json"
+
+	// engineFrontendHandoverSuffix is appended to the names of the device mapper device and the endpoint of a frontend
+	// while the engine it is handed over from is deleted
+	engineFrontendHandoverSuffix = "-handover"
+)
+
+// engineTargetStateSchema is the schema of the engine target state file.
+var engineTargetStateSchema = &util.StateSchema{
+	Kind:           "engine-target",
+	CurrentVersion: 0,
+}
+
+// engineTarget is the NVMe-oF target the frontend held by an engine is connected to.
+type engineTarget struct {
+	Address string `json:"address"`
+	NQN     string `json:"nqn"`
+	// SwitchedOver tells that the target is the one of another engine
+	SwitchedOver bool `json:"switchedOver,omitempty"`
+	// OwnTargetDeleted tells that the target of the engine itself is deleted, so that it cannot be switched back
+	OwnTargetDeleted bool `json:"ownTargetDeleted,omitempty"`
+	// Endpoint is the frontend handed over to the engine once the engine it was created by is deleted. spdk_tgt
+	// knows nothing about it, so it is removed by the instance server along with the engine.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+type engineTargetStateFile struct {
+	Targets map[string]*engineTarget `json:"targets"`
+}
+
+// engineTargetTracker keeps the v2 engines whose frontend is switched over to the target of another engine, e.g.
+// during a live upgrade, and the engines holding the frontend handed over by a deleted engine. spdk_tgt knows nothing
+// about either, since the switch-over is done by the initiator of the node, so the engines are reported with the
+// conditions by the instance server itself. The targets are persisted, so that the frontends survive the restart of
+// the instance manager.
+type engineTargetTracker struct {
+	lock      *sync.RWMutex
+	targets   map[string]*engineTarget
+	statePath string
+}
+
+// newEngineTargetTracker loads the targets persisted in the state file if the path is not empty.
+func newEngineTargetTracker(statePath string) *engineTargetTracker {
+	t := &engineTargetTracker{
+		lock:      &sync.RWMutex{},
+		targets:   map[string]*engineTarget{},
+		statePath: statePath,
+	}
+	if err := t.load(); err != nil {
+		logrus.WithError(err).Warnf("Failed to load the engine targets from %v", statePath)
+	}
+	return t
+}
+
+func getEngineTargetStatePath(logsDir string) string {
+	if logsDir == "" {
+		return ""
+	}
+	return filepath.Join(logsDir, engineTargetStateDirName, engineTargetStateFileName)
+}
+
+func (t *engineTargetTracker) load() error {
+	if t.statePath == "" {
+		return nil
+	}
+	content, err := os.ReadFile(t.statePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	stateFile := &engineTargetStateFile{}
+	if err := engineTargetStateSchema.Unmarshal(content, stateFile); err != nil {
+		return err
+	}
+	for name, target := range stateFile.Targets {
+		t.targets[name] = target
+	}
+	return nil
+}
+
+// save persists the targets. It must be called with the lock held.
+func (t *engineTargetTracker) save() {
+	if t.statePath == "" {
+		return
+	}
+	content, err := engineTargetStateSchema.Marshal(&engineTargetStateFile{Targets: t.targets})
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to encode the engine targets")
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(t.statePath), 0755); err != nil {
+		logrus.WithError(err).Warnf("Failed to create the directory of engine target state file %v", t.statePath)
+		return
+	}
+	tmpPath := t.statePath + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		logrus.WithError(err).Warnf("Failed to write engine target state file %v", tmpPath)
+		return
+	}
+	if err := os.Rename(tmpPath, t.statePath); err != nil {
+		logrus.WithError(err).Warnf("Failed to rename engine target state file %v", tmpPath)
+	}
+}
+
+func (t *engineTargetTracker) get(name string) (engineTarget, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	target, exists := t.targets[name]
+	if !exists {
+		return engineTarget{}, false
+	}
+	return *target, true
+}
+
+func (t *engineTargetTracker) set(name string, target engineTarget) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.targets[name] = &target
+	t.save()
+}
+
+func (t *engineTargetTracker) delete(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, exists := t.targets[name]; !exists {
+		return
+	}
+	delete(t.targets, name)
+	t.save()
+}
+
+func (t *engineTargetTracker) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	if resp.Status == nil {
+		return resp
+	}
+	target, exists := t.get(resp.Spec.Name)
+	if !exists || !target.SwitchedOver {
+		return resp
+	}
+	if resp.Status.Conditions == nil {
+		resp.Status.Conditions = map[string]bool{}
+	}
+	resp.Status.Conditions[types.EngineConditionTargetSwitchedOver] = true
+	resp.Status.Conditions[types.EngineConditionTargetDeleted] = target.OwnTargetDeleted
+	return resp
+}
+
+// InstanceSwitchOverTarget switches the frontend of the engine over to the NVMe-oF target of another engine of the
+// volume.
+func (s *Server) InstanceSwitchOverTarget(ctx context.Context, req *rpc.InstanceSwitchOverTargetRequest) (*rpc.InstanceResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":          req.Name,
+		"dataEngine":    req.DataEngine,
+		"targetAddress": req.TargetAddress,
+	}).Info("Switching over instance target")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+	if _, _, err := splitTargetAddress(req.TargetAddress); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if req.DataEngine != rpc.DataEngine_DATA_ENGINE_V2 {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "cannot switch over the target of %v since only the engines of the v2 data engine are supported", req.Name)
+	}
+	if !s.v2DataEngineEnabled {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "data engine %v is not enabled", req.DataEngine)
+	}
+
+	ops := s.ops[rpc.DataEngine_DATA_ENGINE_V2].(V2DataEngineInstanceOps)
+	return ops.instanceSwitchOverTarget(req)
+}
+
+// InstanceDeleteTarget deletes the NVMe-oF target of the engine once its frontend is switched over to another target.
+func (s *Server) InstanceDeleteTarget(ctx context.Context, req *rpc.InstanceDeleteTargetRequest) (*rpc.InstanceResponse, error) {
+	logrus.WithFields(logrus.Fields{
+		"name":       req.Name,
+		"dataEngine": req.DataEngine,
+	}).Info("Deleting instance target")
+
+	if req.Name == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument name")
+	}
+	if req.DataEngine != rpc.DataEngine_DATA_ENGINE_V2 {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "cannot delete the target of %v since only the engines of the v2 data engine are supported", req.Name)
+	}
+	if !s.v2DataEngineEnabled {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "data engine %v is not enabled", req.DataEngine)
+	}
+
+	ops := s.ops[rpc.DataEngine_DATA_ENGINE_V2].(V2DataEngineInstanceOps)
+	return ops.instanceDeleteTarget(ctx, req)
+}
+
+// instanceSwitchOverTarget switches the frontend of the engine over to the target of the request, unless the engine
+// is suspended.
+func (ops V2DataEngineInstanceOps) instanceSwitchOverTarget(req *rpc.InstanceSwitchOverTargetRequest) (*rpc.InstanceResponse, error) {
+	engine, err := ops.getEngineWithFrontend(req.Name, types.InstanceTypeEngine)
+	if err != nil {
+		return nil, err
+	}
+	if ops.suspendedEngines.isSuspended(engine.Name) {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot switch over the target of engine %v since it is suspended", engine.Name)
+	}
+	if err := ops.switchOverEngineTarget(engine, req.TargetAddress); err != nil {
+		return nil, err
+	}
+	return ops.getEngineResponse(engine), nil
+}
+
+// switchOverEngineTarget connects the initiator of the engine frontend to the target of the address, and reloads the
+// device mapper device in front of it with the new NVMe device. The device is suspended during the switch-over, so
+// the I/O is queued rather than failed. If the switch-over fails, the frontend is connected back to the current
+// target.
+func (ops V2DataEngineInstanceOps) switchOverEngineTarget(engine *spdkapi.Engine, targetAddress string) error {
+	ownAddress := getEngineTargetAddress(engine)
+	current, tracked := ops.targets.get(engine.Name)
+	if !tracked {
+		current = engineTarget{
+			Address: ownAddress,
+			NQN:     helpertypes.GetNQN(engine.Name),
+		}
+	}
+	if targetAddress == current.Address {
+		logrus.Infof("The frontend of engine %v is already connected to target %v", engine.Name, targetAddress)
+		return nil
+	}
+	if targetAddress == ownAddress && current.OwnTargetDeleted {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot switch engine %v back to its own target since it is deleted", engine.Name)
+	}
+	ip, port, err := splitTargetAddress(targetAddress)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	initiator, err := helpernvme.NewInitiator(engine.VolumeName, current.NQN, helpernvme.HostProc)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create NVMe initiator of engine %v", engine.Name).Error())
+	}
+	if err := initiator.Suspend(true, true); err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to suspend the frontend of engine %v", engine.Name).Error())
+	}
+
+	if _, err := initiator.Start(ip, port, true); err != nil {
+		err = errors.Wrapf(err, "failed to switch over the frontend of engine %v to target %v", engine.Name, targetAddress)
+		ops.rollbackTargetSwitchOver(engine, current)
+		return grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	// The device mapper device is only resumed by the initiator if it is busy and reloaded rather than recreated
+	if err := resumeEngineFrontend(engine.VolumeName); err != nil {
+		logrus.WithError(err).Warnf("Failed to resume the frontend of engine %v after the switch-over", engine.Name)
+	}
+
+	// A handed over frontend is still tracked after it is switched back, since spdk_tgt knows nothing about it
+	if targetAddress == ownAddress && current.Endpoint == "" {
+		ops.targets.delete(engine.Name)
+	} else {
+		ops.targets.set(engine.Name, engineTarget{
+			Address:          targetAddress,
+			NQN:              initiator.SubsystemNQN,
+			SwitchedOver:     targetAddress != ownAddress,
+			OwnTargetDeleted: current.OwnTargetDeleted,
+			Endpoint:         current.Endpoint,
+		})
+	}
+	logrus.Infof("Switched over the frontend of engine %v from target %v to %v", engine.Name, current.Address, targetAddress)
+
+	return nil
+}
+
+// rollbackTargetSwitchOver connects the frontend back to the target it was connected to before the failed
+// switch-over, and resumes it in any case, so that the I/O does not stay queued.
+func (ops V2DataEngineInstanceOps) rollbackTargetSwitchOver(engine *spdkapi.Engine, current engineTarget) {
+	log := logrus.WithFields(logrus.Fields{
+		"engine": engine.Name,
+		"target": current.Address,
+	})
+
+	initiator, err := helpernvme.NewInitiator(engine.VolumeName, current.NQN, helpernvme.HostProc)
+	if err == nil {
+		ip, port, _ := splitTargetAddress(current.Address)
+		_, err = initiator.Start(ip, port, true)
+	}
+	if err != nil {
+		log.WithError(err).Error("Failed to connect the frontend back to the target after the failed switch-over")
+	}
+	if err := resumeEngineFrontend(engine.VolumeName); err != nil {
+		log.WithError(err).Error("Failed to resume the frontend after the failed switch-over")
+	}
+}
+
+// instanceDeleteTarget stops exposing the engine by NVMe-oF. The engine itself is kept, since its deletion would
+// remove the frontend the volume is switched over by.
+func (ops V2DataEngineInstanceOps) instanceDeleteTarget(ctx context.Context, req *rpc.InstanceDeleteTargetRequest) (*rpc.InstanceResponse, error) {
+	engine, err := ops.getEngineWithFrontend(req.Name, types.InstanceTypeEngine)
+	if err != nil {
+		return nil, err
+	}
+	target, tracked := ops.targets.get(engine.Name)
+	if !tracked || !target.SwitchedOver {
+		return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot delete the target of engine %v since its frontend is still connected to it", engine.Name)
+	}
+	if target.OwnTargetDeleted {
+		return ops.getEngineResponse(engine), nil
+	}
+
+	spdkHelperClient, err := spdkhelperclient.NewClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to create SPDK helper client").Error())
+	}
+	defer spdkHelperClient.Close()
+
+	if err := spdkHelperClient.StopExposeBdev(helpertypes.GetNQN(engine.Name)); err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to delete the target of engine %v", engine.Name).Error())
+	}
+	target.OwnTargetDeleted = true
+	ops.targets.set(engine.Name, target)
+
+	return ops.getEngineResponse(engine), nil
+}
+
+// deleteEngine deletes the engine. The SPDK service removes the frontend of the volume along with the engine that
+// created it, so the frontend of an engine switched over to the target of another engine is moved aside during the
+// deletion and handed over to the engine of the target, which keeps serving the volume. A frontend handed over to the
+// engine is removed by the instance server after the deletion instead.
+func (ops V2DataEngineInstanceOps) deleteEngine(c *spdkclient.SPDKClient, name string) error {
+	target, tracked := ops.targets.get(name)
+	if !tracked {
+		return c.EngineDelete(name)
+	}
+
+	engine, err := c.EngineGet(name)
+	if err != nil {
+		if grpcstatus.Code(err) == grpccodes.NotFound {
+			ops.targets.delete(name)
+		}
+		return err
+	}
+	if !target.SwitchedOver {
+		if err := c.EngineDelete(name); err != nil {
+			return err
+		}
+		if err := removeEngineFrontend(engine.VolumeName, target.NQN); err != nil {
+			return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to remove the frontend of engine %v", name).Error())
+		}
+		ops.targets.delete(name)
+		return nil
+	}
+
+	endpoint := engine.Endpoint
+	if endpoint == "" {
+		endpoint = target.Endpoint
+	}
+	if engine.Endpoint != "" {
+		if err := moveEngineFrontend(engine.VolumeName, engine.Endpoint, "", engineFrontendHandoverSuffix); err != nil {
+			return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to move the frontend of engine %v aside before deletion", name).Error())
+		}
+	}
+	err = c.EngineDelete(name)
+	if engine.Endpoint != "" {
+		if moveErr := moveEngineFrontend(engine.VolumeName, engine.Endpoint, engineFrontendHandoverSuffix, ""); moveErr != nil {
+			return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(moveErr, "failed to move the frontend of engine %v back after deletion", name).Error())
+		}
+	}
+	if err != nil {
+		return err
+	}
+	ops.targets.delete(name)
+
+	ops.handOverEngineFrontend(c, engine.VolumeName, endpoint, target)
+	return nil
+}
+
+// handOverEngineFrontend hands the frontend over to the engine of the volume whose target it is connected to.
+func (ops V2DataEngineInstanceOps) handOverEngineFrontend(c *spdkclient.SPDKClient, volumeName, endpoint string, target engineTarget) {
+	engines, err := c.EngineList()
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to list engines to hand over the frontend of volume %v", volumeName)
+		return
+	}
+	for _, engine := range engines {
+		if engine.VolumeName != volumeName || getEngineTargetAddress(engine) != target.Address {
+			continue
+		}
+		if current, tracked := ops.targets.get(engine.Name); tracked && current.SwitchedOver {
+			logrus.Warnf("Cannot hand over the frontend of volume %v to engine %v since the engine is switched over to target %v", volumeName, engine.Name, current.Address)
+			return
+		}
+		ops.targets.set(engine.Name, engineTarget{
+			Address:  target.Address,
+			NQN:      target.NQN,
+			Endpoint: endpoint,
+		})
+		logrus.Infof("Handed over the frontend %v of volume %v to engine %v", endpoint, volumeName, engine.Name)
+		return
+	}
+	logrus.Warnf("Cannot find the engine of volume %v with target %v to hand over the frontend %v to", volumeName, target.Address, endpoint)
+}
+
+// getEngineEndpoint returns the frontend of the engine, which is either created by the engine itself or handed over
+// to it.
+func (ops V2DataEngineInstanceOps) getEngineEndpoint(engine *spdkapi.Engine) string {
+	if engine.Endpoint != "" {
+		return engine.Endpoint
+	}
+	if target, tracked := ops.targets.get(engine.Name); tracked {
+		return target.Endpoint
+	}
+	return ""
+}
+
+// moveEngineFrontend renames the device mapper device named after the volume and the endpoint from the names with the
+// suffix to the ones with the other suffix. The opened device keeps working since only its names are changed.
+func moveEngineFrontend(volumeName, endpoint, fromSuffix, toSuffix string) error {
+	executor, err := helperutil.NewExecutor(commonTypes.ProcDirectory)
+	if err != nil {
+		return err
+	}
+
+	lock := nsfilelock.NewLockWithTimeout(helperutil.GetHostNamespacePath(helpernvme.HostProc), helpernvme.LockFile, helpernvme.LockTimeout)
+	if err := lock.Lock(); err != nil {
+		return errors.Wrapf(err, "failed to get file lock for initiator %v", volumeName)
+	}
+	defer lock.Unlock()
+
+	if _, err := executor.Execute("dmsetup", []string{"rename", volumeName + fromSuffix, volumeName + toSuffix}, helpertypes.ExecuteTimeout); err != nil {
+		return errors.Wrapf(err, "failed to rename device mapper device %v", volumeName+fromSuffix)
+	}
+	if err := os.Rename(endpoint+fromSuffix, endpoint+toSuffix); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to rename endpoint %v", endpoint+fromSuffix)
+	}
+	return nil
+}
+
+// removeEngineFrontend removes the device mapper device and the endpoint of the volume, and disconnects the initiator
+// from the target.
+func removeEngineFrontend(volumeName, nqn string) error {
+	initiator, err := helpernvme.NewInitiator(volumeName, nqn, helpernvme.HostProc)
+	if err != nil {
+		return err
+	}
+	_, err = initiator.Stop(true, true)
+	return err
+}
+
+// resumeEngineFrontend resumes the device mapper device named after the volume. The initiator lock serializes it
+// with the other operations of the initiators on the node.
+func resumeEngineFrontend(volumeName string) error {
+	executor, err := helperutil.NewExecutor(commonTypes.ProcDirectory)
+	if err != nil {
+		return err
+	}
+
+	lock := nsfilelock.NewLockWithTimeout(helperutil.GetHostNamespacePath(helpernvme.HostProc), helpernvme.LockFile, helpernvme.LockTimeout)
+	if err := lock.Lock(); err != nil {
+		return errors.Wrapf(err, "failed to get file lock for initiator %v", volumeName)
+	}
+	defer lock.Unlock()
+
+	return helperutil.DmsetupResume(volumeName, executor)
+}
+
+func (ops V2DataEngineInstanceOps) getEngineResponse(engine *spdkapi.Engine) *rpc.InstanceResponse {
+	return ops.targets.apply(ops.priorities.apply(ops.labels.apply(ops.suspendedEngines.apply(engineResponseToInstanceResponse(engine)))))
+}
+
+func getEngineTargetAddress(engine *spdkapi.Engine) string {
+	return net.JoinHostPort(engine.IP, strconv.Itoa(int(engine.Port)))
+}
+
+func splitTargetAddress(address string) (string, string, error) {
+	ip, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "invalid target address %v", address)
+	}
+	if ip == "" || port == "" {
+		return "", "", errors.Errorf("invalid target address %v", address)
+	}
+	return ip, port, nil
+}
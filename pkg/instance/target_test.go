@@ -0,0 +1,69 @@
+package instance
+
+import (
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestEngineTargetTrackerPersistence(c *C) {
+	statePath := getEngineTargetStatePath(c.MkDir())
+	targets := newEngineTargetTracker(statePath)
+	targets.set("vol-e-0", engineTarget{
+		Address:          "10.0.0.2:20001",
+		NQN:              "nqn.2023-01.io.longhorn.spdk:vol-e-1",
+		SwitchedOver:     true,
+		OwnTargetDeleted: true,
+	})
+	targets.set("vol-e-1", engineTarget{
+		Address:  "10.0.0.2:20001",
+		NQN:      "nqn.2023-01.io.longhorn.spdk:vol-e-1",
+		Endpoint: "/dev/longhorn/vol",
+	})
+	targets.delete("vol-e-2")
+
+	// The switch-overs and the handed over frontends survive the restart
+	restarted := newEngineTargetTracker(statePath)
+	target, exists := restarted.get("vol-e-0")
+	c.Assert(exists, Equals, true)
+	c.Assert(target.SwitchedOver, Equals, true)
+	c.Assert(target.OwnTargetDeleted, Equals, true)
+	target, exists = restarted.get("vol-e-1")
+	c.Assert(exists, Equals, true)
+	c.Assert(target.Endpoint, Equals, "/dev/longhorn/vol")
+
+	restarted.delete("vol-e-0")
+	_, exists = newEngineTargetTracker(statePath).get("vol-e-0")
+	c.Assert(exists, Equals, false)
+
+	// Nothing is persisted without a state path
+	c.Assert(getEngineTargetStatePath(""), Equals, "")
+	c.Assert(newEngineTargetTracker(filepath.Join(c.MkDir(), "missing", "targets.json")).targets, HasLen, 0)
+}
+
+func (s *TestSuite) TestEngineTargetTrackerApply(c *C) {
+	targets := newEngineTargetTracker("")
+	targets.set("vol-e-0", engineTarget{Address: "10.0.0.2:20001", SwitchedOver: true})
+	targets.set("vol-e-1", engineTarget{Address: "10.0.0.2:20001", Endpoint: "/dev/longhorn/vol"})
+
+	newResponse := func(name string) *rpc.InstanceResponse {
+		return &rpc.InstanceResponse{
+			Spec:   &rpc.InstanceSpec{Name: name},
+			Status: &rpc.InstanceStatus{},
+		}
+	}
+
+	resp := targets.apply(newResponse("vol-e-0"))
+	c.Assert(resp.Status.Conditions[types.EngineConditionTargetSwitchedOver], Equals, true)
+	c.Assert(resp.Status.Conditions[types.EngineConditionTargetDeleted], Equals, false)
+
+	// The engine holding the handed over frontend is connected to its own target
+	resp = targets.apply(newResponse("vol-e-1"))
+	c.Assert(resp.Status.Conditions[types.EngineConditionTargetSwitchedOver], Equals, false)
+	resp = targets.apply(newResponse("vol-e-2"))
+	c.Assert(resp.Status.Conditions, HasLen, 0)
+}
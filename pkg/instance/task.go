@@ -0,0 +1,30 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// DeferredTaskList returns the pending deferred cleanups of the instance manager, including the ones of the
+// process manager, since both share the same task queue.
+func (s *Server) DeferredTaskList(ctx context.Context, req *emptypb.Empty) (*rpc.DeferredTaskListResponse, error) {
+	tasks := []*rpc.DeferredTask{}
+	for _, task := range s.taskQueue.List() {
+		tasks = append(tasks, &rpc.DeferredTask{
+			Id:            task.ID,
+			Type:          task.Type,
+			Args:          task.Args,
+			CreatedAt:     task.CreatedAt.UTC().Format(time.RFC3339),
+			NextAttemptAt: task.NextAttemptAt.UTC().Format(time.RFC3339),
+			Attempts:      int32(task.Attempts),
+			LastError:     task.LastError,
+		})
+	}
+	return &rpc.DeferredTaskListResponse{
+		Tasks: tasks,
+	}, nil
+}
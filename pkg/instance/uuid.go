@@ -0,0 +1,80 @@
+package instance
+
+import (
+	"context"
+	"sync"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// instanceUUIDTracker assigns the UUIDs of the v2 instances, which the SPDK service does not have, while the UUIDs
+// of the v1 instances are the ones of their processes. A v2 instance gets a new UUID each time it is created by the
+// instance service, and the one first seen otherwise, e.g. a replica loaded from the disk after a restart.
+type instanceUUIDTracker struct {
+	lock  *sync.Mutex
+	uuids map[string]string
+}
+
+func newInstanceUUIDTracker() *instanceUUIDTracker {
+	return &instanceUUIDTracker{
+		lock:  &sync.Mutex{},
+		uuids: map[string]string{},
+	}
+}
+
+// reset assigns a new UUID to the instance just created.
+func (t *instanceUUIDTracker) reset(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.uuids[name] = util.UUID()
+}
+
+func (t *instanceUUIDTracker) delete(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.uuids, name)
+}
+
+func (t *instanceUUIDTracker) apply(resp *rpc.InstanceResponse) *rpc.InstanceResponse {
+	if resp.Spec == nil || resp.Status == nil || resp.Spec.DataEngine != rpc.DataEngine_DATA_ENGINE_V2 {
+		return resp
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	uuid, exists := t.uuids[resp.Spec.Name]
+	if !exists {
+		uuid = util.UUID()
+		t.uuids[resp.Spec.Name] = uuid
+	}
+	resp.Status.Uuid = uuid
+	return resp
+}
+
+func (t *instanceUUIDTracker) applyList(instances map[string]*rpc.InstanceResponse) {
+	for _, instance := range instances {
+		t.apply(instance)
+	}
+}
+
+// getInstanceByUUID finds the instance of the UUID among the instances of all the data engines.
+func (s *Server) getInstanceByUUID(ctx context.Context, uuid string) (*rpc.InstanceResponse, error) {
+	resp, err := s.InstanceList(ctx, &rpc.InstanceListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range resp.Instances {
+		if instance.GetStatus().GetUuid() == uuid {
+			return instance, nil
+		}
+	}
+	return nil, grpcstatus.Errorf(grpccodes.NotFound, "cannot find instance of UUID %v", uuid)
+}
@@ -0,0 +1,226 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	helpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// processSpecFields maps the fields of the process spec validated by the process manager to the ones of the
+// instance spec. The fields not listed are named the same.
+var processSpecFields = map[string]string{
+	"spec.binary":   "spec.process_instance_spec.binary",
+	"spec.sidecars": "spec.process_instance_spec.sidecars",
+	"spec.envs":     "spec.process_instance_spec.envs",
+}
+
+// validateInstanceCreate validates the spec as InstanceCreate would, along with the checks against the backends, e.g.
+// the existence of the binary, the availability of the ports and the disk of a v2 replica, without creating the
+// instance. All the invalid fields are returned at once. The instance limits are not checked, since they depend on
+// the creations in progress.
+func (s *Server) validateInstanceCreate(ctx context.Context, spec *rpc.InstanceSpec) (*rpc.InstanceResponse, error) {
+	violations := util.FieldViolations{}
+	if spec == nil {
+		violations.Add("spec", "missing required argument")
+		return nil, violations.Err()
+	}
+
+	ops, ok := s.ops[spec.DataEngine]
+	if !ok || (spec.DataEngine == rpc.DataEngine_DATA_ENGINE_V2 && !s.v2DataEngineEnabled) {
+		violations.Add("spec.data_engine", "unsupported data engine %v", spec.DataEngine)
+		return nil, violations.Err()
+	}
+	if err := validateRestartPolicy(spec); err != nil {
+		violations.Add("spec.restart_policy", err.Error())
+	}
+	if err := ops.InstanceCreateValidate(ctx, spec, &violations); err != nil {
+		return nil, err
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+	return &rpc.InstanceResponse{Spec: spec}, nil
+}
+
+// InstanceCreateValidate has the process manager validate the spec of the process, which knows the binaries and the
+// ports.
+func (ops V1DataEngineInstanceOps) InstanceCreateValidate(ctx context.Context, spec *rpc.InstanceSpec, violations *util.FieldViolations) error {
+	if spec.ProcessInstanceSpec == nil {
+		violations.Add("spec.process_instance_spec", "ProcessInstanceSpec is required for longhorn data engine")
+		return nil
+	}
+
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	err = pmClient.ProcessCreateValidate(&rpc.ProcessSpec{
+		Name:      spec.Name,
+		Binary:    spec.ProcessInstanceSpec.Binary,
+		Args:      spec.ProcessInstanceSpec.Args,
+		PortCount: spec.PortCount,
+		PortArgs:  spec.PortArgs,
+		Sidecars:  spec.ProcessInstanceSpec.Sidecars,
+		Envs:      spec.ProcessInstanceSpec.Envs,
+		Labels:    spec.Labels,
+
+		PriorityClass:      spec.PriorityClass,
+		CpuMillicores:      spec.ProcessInstanceSpec.CpuMillicores,
+		MemoryBytes:        spec.ProcessInstanceSpec.MemoryBytes,
+		CpuSet:             spec.ProcessInstanceSpec.CpuSet,
+		NumaNode:           spec.ProcessInstanceSpec.NumaNode,
+		Privileges:         spec.ProcessInstanceSpec.Privileges,
+		StopTimeoutSeconds: spec.ProcessInstanceSpec.StopTimeoutSeconds,
+	})
+	if err == nil {
+		return nil
+	}
+	processViolations := util.GetFieldViolations(err)
+	if len(processViolations) == 0 {
+		return err
+	}
+	for _, violation := range processViolations {
+		field := violation.Field
+		if instanceField, exists := processSpecFields[field]; exists {
+			field = instanceField
+		}
+		violations.Add(field, "%s", violation.Description)
+	}
+	return nil
+}
+
+// InstanceCreateValidate validates the spec against the SPDK service, e.g. the disk of a replica and the alignment of
+// the size, which spdk_tgt would otherwise round up silently.
+func (ops V2DataEngineInstanceOps) InstanceCreateValidate(ctx context.Context, spec *rpc.InstanceSpec, violations *util.FieldViolations) error {
+	if spec.Name == "" {
+		violations.Add("spec.name", "missing required argument")
+	}
+	if err := util.ValidateLabels(spec.Labels); err != nil {
+		violations.Add("spec.labels", err.Error())
+	}
+	if err := util.ValidatePriorityClass(spec.PriorityClass); err != nil {
+		violations.Add("spec.priority_class", err.Error())
+	}
+	if spec.PortCount < 0 {
+		violations.Add("spec.port_count", "invalid port count %v", spec.PortCount)
+	}
+	if spec.SpdkInstanceSpec == nil {
+		violations.Add("spec.spdk_instance_spec", "SpdkInstanceSpec is required for v2 data engine")
+		return nil
+	}
+
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return err
+	}
+	c, err := ops.clients.getSPDKClient()
+	if err != nil {
+		return grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create SPDK client").Error())
+	}
+
+	switch spec.Type {
+	case types.InstanceTypeEngine:
+		_, err := c.EngineGet(spec.Name)
+		if err := validateInstanceExistence(spec, err, violations); err != nil {
+			return err
+		}
+		return validateEngineSpec(ctx, spec, violations)
+	case types.InstanceTypeReplica:
+		_, err := c.ReplicaGet(spec.Name)
+		if err := validateInstanceExistence(spec, err, violations); err != nil {
+			return err
+		}
+		return ops.validateReplicaSpec(c, spec, violations)
+	default:
+		violations.Add("spec.type", "unknown instance type %v", spec.Type)
+		return nil
+	}
+}
+
+// validateInstanceExistence validates that the instance does not exist, or exists if it is to be adopted, by the
+// error of getting it.
+func validateInstanceExistence(spec *rpc.InstanceSpec, getErr error, violations *util.FieldViolations) error {
+	if getErr != nil && grpcstatus.Code(getErr) != grpccodes.NotFound {
+		return getErr
+	}
+	exists := getErr == nil
+	switch {
+	case exists && !spec.SpdkInstanceSpec.Adopt:
+		violations.Add("spec.name", "%v %v already exists", spec.Type, spec.Name)
+	case !exists && spec.SpdkInstanceSpec.Adopt:
+		violations.Add("spec.spdk_instance_spec.adopt", "cannot find %v %v to adopt", spec.Type, spec.Name)
+	}
+	return nil
+}
+
+func validateEngineSpec(ctx context.Context, spec *rpc.InstanceSpec, violations *util.FieldViolations) error {
+	size := spec.SpdkInstanceSpec.Size
+	if size == 0 {
+		violations.Add("spec.spdk_instance_spec.size", "missing required argument")
+	} else if size%helpertypes.MiB != 0 {
+		violations.Add("spec.spdk_instance_spec.size", "size %v is not a multiple of %v", size, helpertypes.MiB)
+	}
+
+	switch spec.SpdkInstanceSpec.Frontend {
+	case spdktypes.FrontendEmpty, spdktypes.FrontendSPDKTCPNvmf, spdktypes.FrontendSPDKTCPBlockdev:
+	default:
+		violations.Add("spec.spdk_instance_spec.frontend", "unknown frontend %v", spec.SpdkInstanceSpec.Frontend)
+	}
+
+	if _, err := util.ResolveAddressMap(ctx, spec.SpdkInstanceSpec.ReplicaAddressMap); err != nil {
+		violations.Add("spec.spdk_instance_spec.replica_address_map", err.Error())
+	}
+	if err := validateReadPreference(spec.SpdkInstanceSpec.ReplicaAddressMap, spec.SpdkInstanceSpec.PreferredReadReplica); err != nil {
+		violations.Add("spec.spdk_instance_spec.preferred_read_replica", err.Error())
+	}
+	return nil
+}
+
+func (ops V2DataEngineInstanceOps) validateReplicaSpec(c *spdkclient.SPDKClient, spec *rpc.InstanceSpec, violations *util.FieldViolations) error {
+	diskName := spec.SpdkInstanceSpec.DiskName
+	if diskName == "" {
+		violations.Add("spec.spdk_instance_spec.disk_name", "missing required argument")
+	}
+	if spec.SpdkInstanceSpec.DiskUuid == "" {
+		violations.Add("spec.spdk_instance_spec.disk_uuid", "missing required argument")
+	}
+	size := spec.SpdkInstanceSpec.Size
+	if size == 0 {
+		violations.Add("spec.spdk_instance_spec.size", "missing required argument")
+	}
+	if diskName == "" {
+		return nil
+	}
+
+	disk, err := c.DiskGet(diskName)
+	if err != nil {
+		if grpcstatus.Code(err) != grpccodes.NotFound {
+			return err
+		}
+		violations.Add("spec.spdk_instance_spec.disk_name", "cannot find disk %v", diskName)
+		return nil
+	}
+	if spec.SpdkInstanceSpec.DiskUuid != "" && disk.Uuid != spec.SpdkInstanceSpec.DiskUuid {
+		violations.Add("spec.spdk_instance_spec.disk_uuid", "disk %v has UUID %v rather than %v", diskName, disk.Uuid, spec.SpdkInstanceSpec.DiskUuid)
+	}
+	if size != 0 && disk.ClusterSize > 0 && size%uint64(disk.ClusterSize) != 0 {
+		violations.Add("spec.spdk_instance_spec.size", "size %v is not a multiple of the cluster size %v of disk %v", size, disk.ClusterSize, diskName)
+	}
+	if err := ops.safeModeDisks.CheckWritable(diskName); err != nil {
+		violations.Add("spec.spdk_instance_spec.disk_name", grpcstatus.Convert(err).Message())
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package instance
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// VolumeLog streams the logs of the engine and replicas of a volume on this node interleaved by time, so that an
+// incident of the volume can be followed in one stream. The logs are streamed until the end of each of them.
+func (s *Server) VolumeLog(req *rpc.VolumeLogRequest, srv rpc.InstanceService_VolumeLogServer) error {
+	log := logrus.WithFields(logrus.Fields{
+		"volumeName": req.VolumeName,
+	})
+	log.Info("Getting volume log")
+
+	if req.VolumeName == "" {
+		return grpcstatus.Error(grpccodes.InvalidArgument, "volume name is required")
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	instances, err := s.listVolumeInstances(ctx, req.VolumeName)
+	if err != nil {
+		return err
+	}
+	if len(instances) == 0 {
+		return grpcstatus.Errorf(grpccodes.NotFound, "cannot find any instance of volume %v", req.VolumeName)
+	}
+
+	done := s.drain.startLogStream()
+	defer done()
+
+	logs := map[string]<-chan *util.LogLine{}
+	for name, instance := range instances {
+		logChan, err := s.ops[instance.Spec.DataEngine].InstanceLogStream(ctx, name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get log of instance %v", name)
+		}
+		logs[name] = logChan
+	}
+
+	for line := range util.MergeLogs(ctx, logs) {
+		if err := srv.Send(&rpc.VolumeLogResponse{
+			InstanceName: line.Name,
+			DataEngine:   instances[line.Name].Spec.DataEngine,
+			Line:         line.Line,
+			Sequence:     line.Sequence,
+			Timestamp:    getLogTimestamp(line.LogLine),
+		}); err != nil {
+			return err
+		}
+	}
+	log.Infof("Got logs of %v instances of volume", len(logs))
+	return nil
+}
+
+// listVolumeInstances lists the instances of the volume of all the enabled data engines.
+func (s *Server) listVolumeInstances(ctx context.Context, volumeName string) (map[string]*rpc.InstanceResponse, error) {
+	instances := map[string]*rpc.InstanceResponse{}
+	if err := s.ops[rpc.DataEngine_DATA_ENGINE_V1].InstanceList(ctx, instances); err != nil {
+		return nil, err
+	}
+	if s.v2DataEngineEnabled {
+		if err := s.spdkGate.check(); err != nil {
+			return nil, err
+		}
+		if err := s.ops[rpc.DataEngine_DATA_ENGINE_V2].InstanceList(ctx, instances); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, instance := range instances {
+		if !isVolumeInstance(volumeName, name, instance) {
+			delete(instances, name)
+		}
+	}
+	return instances, nil
+}
+
+// isVolumeInstance returns true if the instance has the volume name in the spec, which only the v2 engines have, or
+// is named after the volume as the engines and replicas of Longhorn are.
+func isVolumeInstance(volumeName, name string, instance *rpc.InstanceResponse) bool {
+	if instance.Spec.VolumeName != "" {
+		return instance.Spec.VolumeName == volumeName
+	}
+	return strings.HasPrefix(name, volumeName+"-e-") || strings.HasPrefix(name, volumeName+"-r-")
+}
+
+// InstanceLogStream streams the log of the process of the instance until the end of the log. A failure in the
+// middle of the stream ends it, so that the logs merged with it are still streamed.
+func (ops V1DataEngineInstanceOps) InstanceLogStream(ctx context.Context, name string) (<-chan *util.LogLine, error) {
+	pmClient, err := ops.clients.getProcessManagerClient(ctx)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to create ProcessManagerClient").Error())
+	}
+
+	stream, err := pmClient.ProcessLogBatched(ctx, name, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	logChan := make(chan *util.LogLine, util.MaxLogFrameLines)
+	go func() {
+		defer close(logChan)
+		for {
+			entry, err := stream.RecvEntry()
+			if err == io.EOF {
+				return
+			} else if err != nil {
+				if ctx.Err() == nil {
+					logrus.WithError(err).Warnf("Failed to receive log of instance %v", name)
+				}
+				return
+			}
+
+			line := &util.LogLine{
+				Sequence: entry.Sequence,
+				Line:     entry.Line,
+			}
+			if entry.Timestamp != 0 {
+				line.Time = time.Unix(0, entry.Timestamp)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case logChan <- line:
+			}
+		}
+	}()
+	return logChan, nil
+}
@@ -0,0 +1,170 @@
+package instance
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+// watchEventGenerator turns the notifications of a watch client into the changes of the instances, by diffing the
+// instances against the ones last reported to the client. The intermediate states within a coalesced notification
+// are not reported.
+type watchEventGenerator struct {
+	list func() (map[string]*rpc.InstanceResponse, string, error)
+
+	// instances is nil until the instances are listed successfully
+	instances map[string]*rpc.InstanceResponse
+	// resourceVersion is the resource version of the instances in the journal
+	resourceVersion string
+}
+
+// newWatchEventGenerator returns the generator of the changes of the instances selected by the selector. An instance
+// whose labels no longer match the selector is reported as deleted, and vice versa.
+func newWatchEventGenerator(ctx context.Context, s *Server, selector util.LabelSelector) *watchEventGenerator {
+	g := &watchEventGenerator{
+		list: func() (map[string]*rpc.InstanceResponse, string, error) {
+			resp, err := s.InstanceList(ctx, &rpc.InstanceListRequest{})
+			if err != nil {
+				return nil, "", err
+			}
+			for name, instance := range resp.Instances {
+				if !selector.Matches(instance.GetSpec().GetLabels()) {
+					delete(resp.Instances, name)
+				}
+			}
+			return resp.Instances, resp.ResourceVersion, nil
+		},
+	}
+	g.instances, g.resourceVersion, _ = g.list()
+	return g
+}
+
+// next returns the changes since the last call. A resync event is returned instead if the instances cannot be
+// listed, or could not be last time, so that the client lists the instances itself.
+func (g *watchEventGenerator) next() []*rpc.InstanceWatchEvent {
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+
+	instances, resourceVersion, err := g.list()
+	if err != nil || g.instances == nil {
+		g.instances, g.resourceVersion = instances, resourceVersion
+		return []*rpc.InstanceWatchEvent{newInstanceWatchResyncEvent(now, resourceVersion)}
+	}
+
+	events := diffInstances(g.instances, instances, now)
+	// Only the last event resumes after the instances just listed, so that a watch interrupted in the middle of
+	// the events replays all of them
+	for i, event := range events {
+		event.ResumeToken = g.resourceVersion
+		if i == len(events)-1 {
+			event.ResumeToken = resourceVersion
+		}
+	}
+	g.instances, g.resourceVersion = instances, resourceVersion
+	return events
+}
+
+// resync lists the instances for the next changes, and returns the resync event asking the client to list the
+// instances itself.
+func (g *watchEventGenerator) resync() *rpc.InstanceWatchEvent {
+	g.instances, g.resourceVersion, _ = g.list()
+	return newInstanceWatchResyncEvent(time.Now().UTC().Format(time.RFC3339Nano), g.resourceVersion)
+}
+
+// replay returns the events of the journal missed since the resume token up to the instances listed by the
+// generator, or a resync event if they are no longer kept.
+func (g *watchEventGenerator) replay(journal *instanceJournal, resumeToken string, selector util.LabelSelector) []*rpc.InstanceWatchEvent {
+	journalEvents, ok := journal.eventsSince(resumeToken, g.resourceVersion)
+	if !ok {
+		return []*rpc.InstanceWatchEvent{newInstanceWatchResyncEvent(time.Now().UTC().Format(time.RFC3339Nano), g.resourceVersion)}
+	}
+
+	events := []*rpc.InstanceWatchEvent{}
+	for _, e := range journalEvents {
+		if event := selectJournalEvent(e, selector); event != nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// selectJournalEvent returns the event of the journal as seen by a watch of the selector. An instance whose labels
+// no longer match the selector is deleted for the watch, and vice versa.
+func selectJournalEvent(e *instanceJournalEvent, selector util.LabelSelector) *rpc.InstanceWatchEvent {
+	oldMatched := e.event.EventType != types.InstanceWatchEventTypeAdded && selector.Matches(e.oldLabels)
+	newMatched := e.event.EventType != types.InstanceWatchEventTypeDeleted && selector.Matches(e.newLabels)
+
+	event := proto.Clone(e.event).(*rpc.InstanceWatchEvent)
+	switch {
+	case oldMatched && newMatched:
+	case oldMatched:
+		event.EventType = types.InstanceWatchEventTypeDeleted
+		event.NewState = ""
+	case newMatched:
+		event.EventType = types.InstanceWatchEventTypeAdded
+		event.OldState = ""
+	default:
+		return nil
+	}
+	return event
+}
+
+func newInstanceWatchResyncEvent(timestamp, resourceVersion string) *rpc.InstanceWatchEvent {
+	return &rpc.InstanceWatchEvent{
+		EventType:   types.InstanceWatchEventTypeResync,
+		Timestamp:   timestamp,
+		ResumeToken: resourceVersion,
+	}
+}
+
+// diffInstances returns the events turning the old instances into the new ones, sorted by name.
+func diffInstances(oldInstances, newInstances map[string]*rpc.InstanceResponse, timestamp string) []*rpc.InstanceWatchEvent {
+	events := []*rpc.InstanceWatchEvent{}
+	for name, instance := range newInstances {
+		old, exists := oldInstances[name]
+		switch {
+		case !exists:
+			events = append(events, newInstanceWatchEvent(types.InstanceWatchEventTypeAdded, name, instance, "", timestamp))
+		case !proto.Equal(old.Spec, instance.Spec) || !instanceStatusEqual(old.Status, instance.Status):
+			events = append(events, newInstanceWatchEvent(types.InstanceWatchEventTypeModified, name, instance, old.GetStatus().GetState(), timestamp))
+		}
+	}
+	for name, old := range oldInstances {
+		if _, exists := newInstances[name]; !exists {
+			event := newInstanceWatchEvent(types.InstanceWatchEventTypeDeleted, name, old, old.GetStatus().GetState(), timestamp)
+			event.NewState = ""
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Name < events[j].Name })
+	return events
+}
+
+// instanceStatusEqual compares the statuses apart from the resource usage, which changes on every list.
+func instanceStatusEqual(a, b *rpc.InstanceStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	a = proto.Clone(a).(*rpc.InstanceStatus)
+	b = proto.Clone(b).(*rpc.InstanceStatus)
+	a.ResourceUsage = nil
+	b.ResourceUsage = nil
+	return proto.Equal(a, b)
+}
+
+func newInstanceWatchEvent(eventType, name string, instance *rpc.InstanceResponse, oldState, timestamp string) *rpc.InstanceWatchEvent {
+	return &rpc.InstanceWatchEvent{
+		EventType:  eventType,
+		Name:       name,
+		Type:       instance.GetSpec().GetType(),
+		DataEngine: instance.GetSpec().GetDataEngine(),
+		OldState:   oldState,
+		NewState:   instance.GetStatus().GetState(),
+		Timestamp:  timestamp,
+	}
+}
@@ -0,0 +1,149 @@
+package instance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+)
+
+const (
+	defaultWatchFreezeTTL = 5 * time.Minute
+	maxWatchFreezeTTL     = 30 * time.Minute
+)
+
+// watchFreeze holds the notifications of the watches during a planned bulk operation, so that the clients are not
+// flooded with the changes of every instance involved. The changes are still recorded by the journal, and the
+// watches notified during the freeze are asked to resync once it ends.
+type watchFreeze struct {
+	lock *sync.Mutex
+
+	reason    string
+	frozenAt  time.Time
+	expiresAt time.Time
+	// thawCh is closed once the freeze ends. It is nil if not frozen
+	thawCh chan struct{}
+	timer  *time.Timer
+}
+
+func newWatchFreeze() *watchFreeze {
+	return &watchFreeze{
+		lock: &sync.Mutex{},
+	}
+}
+
+// freeze holds the notifications until thawed or until the TTL passes. Freezing again while frozen extends the
+// freeze rather than starting another one.
+func (f *watchFreeze) freeze(ttl time.Duration, reason string) *rpc.InstanceWatchFreezeStatus {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	now := time.Now()
+	if f.thawCh == nil {
+		f.thawCh = make(chan struct{})
+		f.frozenAt = now
+	}
+	if f.timer != nil {
+		f.timer.Stop()
+	}
+	f.reason = reason
+	f.expiresAt = now.Add(ttl)
+	f.timer = time.AfterFunc(ttl, f.expire)
+	return f.statusLocked()
+}
+
+func (f *watchFreeze) thaw() *rpc.InstanceWatchFreezeStatus {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.thawLocked()
+	return f.statusLocked()
+}
+
+// expire ends the freeze once the TTL passes, unless it is extended in the meantime.
+func (f *watchFreeze) expire() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.thawCh == nil || time.Now().Before(f.expiresAt) {
+		return
+	}
+	logrus.Warnf("%s: the freeze of the instance watches for %v was not thawed in time, thawing it", types.InstanceGrpcService, f.reason)
+	f.thawLocked()
+}
+
+func (f *watchFreeze) thawLocked() {
+	if f.thawCh == nil {
+		return
+	}
+	close(f.thawCh)
+	f.thawCh = nil
+	if f.timer != nil {
+		f.timer.Stop()
+		f.timer = nil
+	}
+	f.reason = ""
+	f.frozenAt = time.Time{}
+	f.expiresAt = time.Time{}
+}
+
+func (f *watchFreeze) statusLocked() *rpc.InstanceWatchFreezeStatus {
+	if f.thawCh == nil {
+		return &rpc.InstanceWatchFreezeStatus{}
+	}
+	return &rpc.InstanceWatchFreezeStatus{
+		Frozen:    true,
+		Reason:    f.reason,
+		FrozenAt:  f.frozenAt.UTC().Format(time.RFC3339),
+		ExpiresAt: f.expiresAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// wait blocks until the freeze ends, and returns whether it was frozen.
+func (f *watchFreeze) wait(ctx context.Context) (bool, error) {
+	f.lock.Lock()
+	thawCh := f.thawCh
+	f.lock.Unlock()
+
+	if thawCh == nil {
+		return false, nil
+	}
+	select {
+	case <-ctx.Done():
+		return true, ctx.Err()
+	case <-thawCh:
+		return true, nil
+	}
+}
+
+// InstanceWatchFreeze holds the notifications of the instance watches, e.g. during a node drain, until thawed.
+func (s *Server) InstanceWatchFreeze(ctx context.Context, req *rpc.InstanceWatchFreezeRequest) (*rpc.InstanceWatchFreezeStatus, error) {
+	logrus.WithFields(logrus.Fields{
+		"ttlSeconds": req.TtlSeconds,
+		"reason":     req.Reason,
+	}).Info("Freezing instance watches")
+
+	ttl := defaultWatchFreezeTTL
+	if req.TtlSeconds != 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	if ttl < 0 || ttl > maxWatchFreezeTTL {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "TTL must be between 0 and %v", maxWatchFreezeTTL)
+	}
+	return s.watchFreeze.freeze(ttl, req.Reason), nil
+}
+
+// InstanceWatchThaw ends the freeze of the instance watches. The watches notified during the freeze are asked to
+// resync.
+func (s *Server) InstanceWatchThaw(ctx context.Context, req *emptypb.Empty) (*rpc.InstanceWatchFreezeStatus, error) {
+	logrus.Info("Thawing instance watches")
+
+	return s.watchFreeze.thaw(), nil
+}
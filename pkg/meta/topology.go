@@ -0,0 +1,58 @@
+package meta
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Topology is the location of the node the instance manager runs on. It is configured on start and returned along
+// with the instances and the version, so that the clients can account for the cross-zone traffic and verify the
+// anti-affinity of the replicas without looking up the node.
+type Topology struct {
+	Zone   string            `json:"zone"`
+	Rack   string            `json:"rack"`
+	Labels map[string]string `json:"labels"`
+}
+
+var (
+	topologyLock = &sync.RWMutex{}
+	topology     = Topology{}
+)
+
+// ParseTopologyLabels parses the labels in the format of <key>=<value>.
+func ParseTopologyLabels(labels []string) (map[string]string, error) {
+	ret := map[string]string{}
+	for _, label := range labels {
+		key, value, found := strings.Cut(label, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid topology label %v, expecting <key>=<value>", label)
+		}
+		ret[key] = strings.TrimSpace(value)
+	}
+	return ret, nil
+}
+
+func SetTopology(t Topology) {
+	topologyLock.Lock()
+	defer topologyLock.Unlock()
+
+	topology = t
+}
+
+// GetTopology returns a copy of the topology of the node.
+func GetTopology() Topology {
+	topologyLock.RLock()
+	defer topologyLock.RUnlock()
+
+	ret := Topology{
+		Zone:   topology.Zone,
+		Rack:   topology.Rack,
+		Labels: map[string]string{},
+	}
+	for k, v := range topology.Labels {
+		ret.Labels[k] = v
+	}
+	return ret
+}
@@ -31,6 +31,8 @@ type VersionOutput struct {
 
 	InstanceManagerProxyAPIVersion    int `json:"instanceManagerProxyAPIVersion"`
 	InstanceManagerProxyAPIMinVersion int `json:"instanceManagerProxyAPIMinVersion"`
+
+	Topology Topology `json:"topology"`
 }
 
 func GetVersion() VersionOutput {
@@ -44,6 +46,8 @@ func GetVersion() VersionOutput {
 
 		InstanceManagerProxyAPIVersion:    InstanceManagerProxyAPIVersion,
 		InstanceManagerProxyAPIMinVersion: InstanceManagerProxyAPIMinVersion,
+
+		Topology: GetTopology(),
 	}
 }
 
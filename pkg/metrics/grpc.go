@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ServerOptions returns the options recording the requests handled by a gRPC server.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryServerInterceptor),
+		grpc.ChainStreamInterceptor(streamServerInterceptor),
+	}
+}
+
+func unaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	recordRequest(info.FullMethod, start, err)
+	return resp, err
+}
+
+func streamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	service, method := splitFullMethod(info.FullMethod)
+	record := streams.start(ss.Context(), service, method)
+	err := handler(srv, ss)
+	streams.end(ss.Context(), record, err)
+	recordRequest(info.FullMethod, start, err)
+	return err
+}
+
+// splitFullMethod splits the full method into the service and the method, e.g.
+// "/imrpc.ProcessManagerService/ProcessCreate".
+func splitFullMethod(fullMethod string) (service, method string) {
+	service, method = "", strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		service, method = method[:i], method[i+1:]
+	}
+	return service, method
+}
+
+func recordRequest(fullMethod string, start time.Time, err error) {
+	service, method := splitFullMethod(fullMethod)
+
+	AddCounter(MetricGRPCRequests, map[string]string{
+		"service": service,
+		"method":  method,
+		"code":    status.Code(err).String(),
+	}, 1)
+	ObserveHistogram(MetricGRPCRequestDuration, map[string]string{
+		"service": service,
+		"method":  method,
+	}, time.Since(start).Seconds())
+}
@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	BackendPrometheus = "prometheus"
+	BackendOTLP       = "otlp"
+	BackendStatsd     = "statsd"
+	BackendNone       = "none"
+
+	Namespace = "longhorn_instance_manager"
+
+	DefaultPushInterval = 30 * time.Second
+)
+
+const (
+	MetricGRPCRequests          = "grpc_requests_total"
+	MetricGRPCRequestDuration   = "grpc_request_duration_seconds"
+	MetricGRPCStreamsActive     = "grpc_streams_active"
+	MetricGRPCStreamEnds        = "grpc_stream_ends_total"
+	MetricProcesses             = "processes"
+	MetricProcessLogFloods      = "process_log_floods_total"
+	MetricProcessUpdatesDropped = "process_updates_dropped_total"
+
+	MetricInstanceNetworkSentBytes     = "instance_network_sent_bytes_total"
+	MetricInstanceNetworkReceivedBytes = "instance_network_received_bytes_total"
+	MetricInstanceRemediations         = "instance_remediations_total"
+	MetricInstanceOperationsWaiting    = "instance_operations_waiting"
+
+	MetricSPDKMemoryHeapFreeBytes     = "spdk_memory_heap_free_bytes"
+	MetricSPDKMemoryHeapFragmentation = "spdk_memory_heap_fragmentation"
+	MetricSPDKMempoolAvailable        = "spdk_mempool_available"
+	MetricSPDKMempoolInUse            = "spdk_mempool_in_use"
+	MetricSPDKIobufRetries            = "spdk_iobuf_retries"
+	MetricHugepagesTotal              = "hugepages_total"
+	MetricHugepagesFree               = "hugepages_free"
+
+	MetricDiskScrubErrors = "disk_scrub_errors_total"
+)
+
+var metricHelps = map[string]string{
+	MetricGRPCRequests:          "Total number of gRPC requests handled by the instance manager",
+	MetricGRPCRequestDuration:   "Duration in seconds of the gRPC requests handled by the instance manager",
+	MetricGRPCStreamsActive:     "Number of the active gRPC streams of each method, e.g. the instance watches",
+	MetricGRPCStreamEnds:        "Number of the ended gRPC streams of each method by the cause of the end",
+	MetricProcesses:             "Number of processes managed by the process manager in each state",
+	MetricProcessLogFloods:      "Number of the log floods of the processes managed by the process manager",
+	MetricProcessUpdatesDropped: "Number of the process updates dropped at each stage, either coalesced into a pending update of the same process or dropped since too many are pending",
+
+	MetricInstanceNetworkSentBytes:     "Bytes sent on the connections to the ports of each instance",
+	MetricInstanceNetworkReceivedBytes: "Bytes received on the connections to the ports of each instance",
+	MetricInstanceRemediations:         "Number of the failed creations of v2 instances remediated by each remediation",
+	MetricInstanceOperationsWaiting:    "Number of the instance creations and deletions of each data engine waiting for the concurrency limit",
+
+	MetricSPDKMemoryHeapFreeBytes:     "Free bytes of each DPDK malloc heap of spdk_tgt",
+	MetricSPDKMemoryHeapFragmentation: "Ratio of the free memory of each DPDK malloc heap of spdk_tgt that cannot be allocated in one piece",
+	MetricSPDKMempoolAvailable:        "Number of the free elements of each DPDK mempool of spdk_tgt",
+	MetricSPDKMempoolInUse:            "Number of the elements in use of each DPDK mempool of spdk_tgt",
+	MetricSPDKIobufRetries:            "Number of the SPDK iobuf allocations of each module that had to wait since the pool was exhausted",
+	MetricHugepagesTotal:              "Number of the hugepages of each page size of the node",
+	MetricHugepagesFree:               "Number of the free hugepages of each page size of the node",
+
+	MetricDiskScrubErrors: "Number of the unreadable ranges found by the scrubs of each disk",
+}
+
+// histogramBuckets are the upper bounds of the histogram buckets in seconds.
+var histogramBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Backend emits the metrics of the instance manager to a monitoring system. The label names of a metric must be
+// the same for all emissions.
+type Backend interface {
+	// AddCounter adds the delta to the counter.
+	AddCounter(name string, labels map[string]string, delta float64)
+	// SetGauge sets the gauge to the value.
+	SetGauge(name string, labels map[string]string, value float64)
+	// ObserveHistogram records the value in the histogram.
+	ObserveHistogram(name string, labels map[string]string, value float64)
+	// Close flushes the pending metrics and releases the resources of the backend.
+	Close() error
+}
+
+type Config struct {
+	Backend string
+	// OTLPEndpoint is the OTLP/HTTP metrics endpoint, e.g. http://otel-collector:4318/v1/metrics
+	OTLPEndpoint string
+	// StatsdAddress is the UDP address of the statsd server, e.g. statsd:8125
+	StatsdAddress string
+	// PushInterval is the interval the OTLP backend pushes the metrics at
+	PushInterval time.Duration
+}
+
+func NewBackend(config *Config) (Backend, error) {
+	switch config.Backend {
+	case BackendPrometheus, "":
+		return NewPrometheusBackend()
+	case BackendOTLP:
+		if config.OTLPEndpoint == "" {
+			return nil, fmt.Errorf("missing OTLP endpoint for metrics backend %v", config.Backend)
+		}
+		interval := config.PushInterval
+		if interval <= 0 {
+			interval = DefaultPushInterval
+		}
+		return NewOTLPBackend(config.OTLPEndpoint, interval)
+	case BackendStatsd:
+		if config.StatsdAddress == "" {
+			return nil, fmt.Errorf("missing statsd address for metrics backend %v", config.Backend)
+		}
+		return NewStatsdBackend(config.StatsdAddress)
+	case BackendNone:
+		return noopBackend{}, nil
+	}
+	return nil, fmt.Errorf("unsupported metrics backend %v", config.Backend)
+}
+
+var (
+	backendLock         = &sync.RWMutex{}
+	backend     Backend = noopBackend{}
+)
+
+// SetBackend sets the backend the metrics are emitted to. The metrics are dropped until a backend is set.
+func SetBackend(b Backend) {
+	backendLock.Lock()
+	defer backendLock.Unlock()
+
+	backend = b
+}
+
+func getBackend() Backend {
+	backendLock.RLock()
+	defer backendLock.RUnlock()
+
+	return backend
+}
+
+func AddCounter(name string, labels map[string]string, delta float64) {
+	getBackend().AddCounter(name, labels, delta)
+}
+
+func SetGauge(name string, labels map[string]string, value float64) {
+	getBackend().SetGauge(name, labels, value)
+}
+
+func ObserveHistogram(name string, labels map[string]string, value float64) {
+	getBackend().ObserveHistogram(name, labels, value)
+}
+
+func getHelp(name string) string {
+	if help, ok := metricHelps[name]; ok {
+		return help
+	}
+	return name
+}
+
+func getLabelNames(labels map[string]string) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getSeriesKey returns the key identifying the series of the metric with the labels.
+func getSeriesKey(name string, labels map[string]string) string {
+	key := &strings.Builder{}
+	key.WriteString(name)
+	for _, labelName := range getLabelNames(labels) {
+		fmt.Fprintf(key, ",%s=%s", labelName, labels[labelName])
+	}
+	return key.String()
+}
+
+type noopBackend struct{}
+
+func (noopBackend) AddCounter(name string, labels map[string]string, delta float64)       {}
+func (noopBackend) SetGauge(name string, labels map[string]string, value float64)         {}
+func (noopBackend) ObserveHistogram(name string, labels map[string]string, value float64) {}
+func (noopBackend) Close() error                                                          { return nil }
@@ -0,0 +1,267 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct{}
+
+var _ = Suite(&TestSuite{})
+
+// newTestOTLPBackend returns the backend aggregating the metrics without pushing them.
+func newTestOTLPBackend() *OTLPBackend {
+	return &OTLPBackend{
+		hostname:  "node-1",
+		lock:      &sync.Mutex{},
+		startTime: time.Unix(1700000000, 0),
+		series:    map[string]*otlpSeries{},
+	}
+}
+
+func (s *TestSuite) TestOTLPExport(c *C) {
+	b := newTestOTLPBackend()
+	methodLabels := map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessCreate"}
+	b.AddCounter(MetricGRPCRequests, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessCreate", "code": "OK"}, 1)
+	b.AddCounter(MetricGRPCRequests, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessCreate", "code": "OK"}, 1)
+	b.AddCounter(MetricGRPCRequests, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessCreate", "code": "NotFound"}, 1)
+	b.SetGauge(MetricProcesses, map[string]string{"state": "running"}, 5)
+	b.SetGauge(MetricProcesses, map[string]string{"state": "running"}, 3)
+	b.ObserveHistogram(MetricGRPCRequestDuration, methodLabels, 0.5)
+	b.ObserveHistogram(MetricGRPCRequestDuration, methodLabels, 40)
+
+	expected := `{
+		"resourceMetrics": [{
+			"resource": {"attributes": [
+				{"key": "host.name", "value": {"stringValue": "node-1"}},
+				{"key": "service.name", "value": {"stringValue": "longhorn-instance-manager"}}
+			]},
+			"scopeMetrics": [{
+				"scope": {"name": "github.com/longhorn/longhorn-instance-manager"},
+				"metrics": [
+					{
+						"name": "longhorn_instance_manager_grpc_request_duration_seconds",
+						"description": "Duration in seconds of the gRPC requests handled by the instance manager",
+						"histogram": {
+							"dataPoints": [{
+								"attributes": [
+									{"key": "method", "value": {"stringValue": "ProcessCreate"}},
+									{"key": "service", "value": {"stringValue": "imrpc.ProcessManagerService"}}
+								],
+								"startTimeUnixNano": "1700000000000000000",
+								"timeUnixNano": "1700000030000000000",
+								"count": "2",
+								"sum": 40.5,
+								"bucketCounts": ["0", "0", "0", "0", "0", "0", "1", "0", "0", "0", "0", "0", "1", "0"],
+								"explicitBounds": [0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60]
+							}],
+							"aggregationTemporality": 2
+						}
+					},
+					{
+						"name": "longhorn_instance_manager_grpc_requests_total",
+						"description": "Total number of gRPC requests handled by the instance manager",
+						"sum": {
+							"dataPoints": [
+								{
+									"attributes": [
+										{"key": "code", "value": {"stringValue": "NotFound"}},
+										{"key": "method", "value": {"stringValue": "ProcessCreate"}},
+										{"key": "service", "value": {"stringValue": "imrpc.ProcessManagerService"}}
+									],
+									"startTimeUnixNano": "1700000000000000000",
+									"timeUnixNano": "1700000030000000000",
+									"asDouble": 1
+								},
+								{
+									"attributes": [
+										{"key": "code", "value": {"stringValue": "OK"}},
+										{"key": "method", "value": {"stringValue": "ProcessCreate"}},
+										{"key": "service", "value": {"stringValue": "imrpc.ProcessManagerService"}}
+									],
+									"startTimeUnixNano": "1700000000000000000",
+									"timeUnixNano": "1700000030000000000",
+									"asDouble": 2
+								}
+							],
+							"aggregationTemporality": 2,
+							"isMonotonic": true
+						}
+					},
+					{
+						"name": "longhorn_instance_manager_processes",
+						"description": "Number of processes managed by the process manager in each state",
+						"gauge": {
+							"dataPoints": [{
+								"attributes": [{"key": "state", "value": {"stringValue": "running"}}],
+								"startTimeUnixNano": "1700000000000000000",
+								"timeUnixNano": "1700000030000000000",
+								"asDouble": 3
+							}]
+						}
+					}
+				]
+			}]
+		}]
+	}`
+	golden := &bytes.Buffer{}
+	c.Assert(json.Compact(golden, []byte(expected)), IsNil)
+
+	body, err := json.Marshal(b.export(time.Unix(1700000030, 0)))
+	c.Assert(err, IsNil)
+	c.Assert(string(body), Equals, golden.String())
+
+	// Nothing recorded is exported as an empty scope
+	body, err = json.Marshal(newTestOTLPBackend().export(time.Unix(1700000030, 0)))
+	c.Assert(err, IsNil)
+	c.Assert(string(body), Equals, `{"resourceMetrics":[{"resource":{"attributes":[`+
+		`{"key":"host.name","value":{"stringValue":"node-1"}},`+
+		`{"key":"service.name","value":{"stringValue":"longhorn-instance-manager"}}]},`+
+		`"scopeMetrics":[{"scope":{"name":"github.com/longhorn/longhorn-instance-manager"},"metrics":[]}]}]}`)
+}
+
+func (s *TestSuite) TestStatsdBackend(c *C) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer server.Close()
+
+	b, err := NewStatsdBackend(server.LocalAddr().String())
+	c.Assert(err, IsNil)
+	defer b.Close()
+
+	testCases := []struct {
+		emit func()
+		line string
+	}{
+		{
+			func() {
+				b.AddCounter(MetricGRPCRequests, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessCreate", "code": "OK"}, 1)
+			},
+			"longhorn_instance_manager.grpc_requests_total:1|c|#code:OK,method:ProcessCreate,service:imrpc.ProcessManagerService",
+		},
+		{
+			func() { b.SetGauge(MetricProcesses, map[string]string{"state": "running"}, 3) },
+			"longhorn_instance_manager.processes:3|g|#state:running",
+		},
+		{
+			func() {
+				b.ObserveHistogram(MetricGRPCRequestDuration, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessCreate"}, 0.025)
+			},
+			"longhorn_instance_manager.grpc_request_duration_seconds:0.025|h|#method:ProcessCreate,service:imrpc.ProcessManagerService",
+		},
+		{
+			func() { b.AddCounter(MetricProcessLogFloods, nil, 1.5) },
+			"longhorn_instance_manager.process_log_floods_total:1.5|c",
+		},
+	}
+	buf := make([]byte, 1024)
+	for i, testCase := range testCases {
+		comment := Commentf("test case %v", i)
+		testCase.emit()
+		c.Assert(server.SetReadDeadline(time.Now().Add(5*time.Second)), IsNil, comment)
+		n, _, err := server.ReadFrom(buf)
+		c.Assert(err, IsNil, comment)
+		c.Assert(string(buf[:n]), Equals, testCase.line, comment)
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *TestSuite) TestServerInterceptors(c *C) {
+	b := newTestOTLPBackend()
+	SetBackend(b)
+	defer SetBackend(noopBackend{})
+
+	getValue := func(name string, labels map[string]string) float64 {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		if series, exists := b.series[getSeriesKey(name, labels)]; exists {
+			return series.value
+		}
+		return -1
+	}
+	getCount := func(name string, labels map[string]string) uint64 {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		if series, exists := b.series[getSeriesKey(name, labels)]; exists {
+			return series.count
+		}
+		return 0
+	}
+
+	// The unary calls are counted by the code, and timed
+	unaryInfo := &grpc.UnaryServerInfo{FullMethod: "/imrpc.ProcessManagerService/ProcessGet"}
+	for i := 0; i < 2; i++ {
+		_, err := unaryServerInterceptor(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "cannot find process")
+		})
+		c.Assert(status.Code(err), Equals, codes.NotFound)
+	}
+	resp, err := unaryServerInterceptor(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "process", nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp, Equals, "process")
+	methodLabels := map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessGet"}
+	c.Assert(getValue(MetricGRPCRequests, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessGet", "code": "NotFound"}), Equals, float64(2))
+	c.Assert(getValue(MetricGRPCRequests, map[string]string{"service": "imrpc.ProcessManagerService", "method": "ProcessGet", "code": "OK"}), Equals, float64(1))
+	c.Assert(getCount(MetricGRPCRequestDuration, methodLabels), Equals, uint64(3))
+
+	// The streams are tracked while active, and counted by the cause of the end
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/imrpc.InstanceService/InstanceWatch"}
+	streamLabels := map[string]string{"service": "imrpc.InstanceService", "method": "InstanceWatch"}
+	ctx, cancel := context.WithCancel(context.Background())
+	err = streamServerInterceptor(nil, &testServerStream{ctx: ctx}, streamInfo, func(srv interface{}, ss grpc.ServerStream) error {
+		c.Check(getValue(MetricGRPCStreamsActive, streamLabels), Equals, float64(1))
+		active, _ := StreamList()
+		c.Check(active, HasLen, 1)
+		cancel()
+		return status.Error(codes.Canceled, "context canceled")
+	})
+	c.Assert(status.Code(err), Equals, codes.Canceled)
+	c.Assert(getValue(MetricGRPCStreamsActive, streamLabels), Equals, float64(0))
+	c.Assert(getValue(MetricGRPCStreamEnds, map[string]string{"service": "imrpc.InstanceService", "method": "InstanceWatch", "cause": StreamEndClientCanceled}), Equals, float64(1))
+	c.Assert(getValue(MetricGRPCRequests, map[string]string{"service": "imrpc.InstanceService", "method": "InstanceWatch", "code": "Canceled"}), Equals, float64(1))
+	active, ended := StreamList()
+	c.Assert(active, HasLen, 0)
+	c.Assert(ended[len(ended)-1].Method, Equals, "InstanceWatch")
+	c.Assert(ended[len(ended)-1].Cause, Equals, StreamEndClientCanceled)
+}
+
+func (s *TestSuite) TestSplitFullMethod(c *C) {
+	testCases := []struct {
+		fullMethod string
+		service    string
+		method     string
+	}{
+		{"/imrpc.ProcessManagerService/ProcessCreate", "imrpc.ProcessManagerService", "ProcessCreate"},
+		{"/grpc.health.v1.Health/Check", "grpc.health.v1.Health", "Check"},
+		{"ProcessCreate", "", "ProcessCreate"},
+	}
+	for i, testCase := range testCases {
+		service, method := splitFullMethod(testCase.fullMethod)
+		comment := Commentf("test case %v: %v", i, testCase.fullMethod)
+		c.Assert(service, Equals, testCase.service, comment)
+		c.Assert(method, Equals, testCase.method, comment)
+	}
+}
@@ -0,0 +1,341 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	otlpServiceName = "longhorn-instance-manager"
+	otlpScopeName   = "github.com/longhorn/longhorn-instance-manager"
+
+	otlpPushTimeout = 10 * time.Second
+
+	// otlpTemporalityCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE
+	otlpTemporalityCumulative = 2
+
+	otlpKindCounter   = "counter"
+	otlpKindGauge     = "gauge"
+	otlpKindHistogram = "histogram"
+)
+
+type otlpSeries struct {
+	name   string
+	kind   string
+	labels map[string]string
+
+	value float64
+
+	count        uint64
+	sum          float64
+	bucketCounts []uint64
+}
+
+// OTLPBackend aggregates the metrics in memory and periodically pushes them with cumulative temporality to an
+// OTLP/HTTP endpoint, encoded in the OTLP JSON format.
+type OTLPBackend struct {
+	endpoint string
+	client   *http.Client
+	hostname string
+
+	lock      *sync.Mutex
+	startTime time.Time
+	series    map[string]*otlpSeries
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewOTLPBackend(endpoint string, interval time.Duration) (*OTLPBackend, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	b := &OTLPBackend{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: otlpPushTimeout},
+		hostname: hostname,
+
+		lock:      &sync.Mutex{},
+		startTime: time.Now(),
+		series:    map[string]*otlpSeries{},
+
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	go b.startPushing(interval)
+	return b, nil
+}
+
+func (b *OTLPBackend) getSeries(name, kind string, labels map[string]string) *otlpSeries {
+	key := getSeriesKey(name, labels)
+	s, exists := b.series[key]
+	if !exists {
+		s = &otlpSeries{
+			name:   name,
+			kind:   kind,
+			labels: map[string]string{},
+		}
+		for k, v := range labels {
+			s.labels[k] = v
+		}
+		if kind == otlpKindHistogram {
+			s.bucketCounts = make([]uint64, len(histogramBuckets)+1)
+		}
+		b.series[key] = s
+	}
+	return s
+}
+
+func (b *OTLPBackend) AddCounter(name string, labels map[string]string, delta float64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.getSeries(name, otlpKindCounter, labels).value += delta
+}
+
+func (b *OTLPBackend) SetGauge(name string, labels map[string]string, value float64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.getSeries(name, otlpKindGauge, labels).value = value
+}
+
+func (b *OTLPBackend) ObserveHistogram(name string, labels map[string]string, value float64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	s := b.getSeries(name, otlpKindHistogram, labels)
+	s.count++
+	s.sum += value
+	s.bucketCounts[sort.SearchFloat64s(histogramBuckets, value)]++
+}
+
+func (b *OTLPBackend) Close() error {
+	close(b.stopCh)
+	<-b.doneCh
+	return b.push()
+}
+
+func (b *OTLPBackend) startPushing(interval time.Duration) {
+	defer close(b.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stopCh:
+			return
+		case <-ticker.C:
+			if err := b.push(); err != nil {
+				logrus.WithError(err).Warnf("Failed to push metrics to OTLP endpoint %v", b.endpoint)
+			}
+		}
+	}
+}
+
+func (b *OTLPBackend) push() error {
+	body, err := json.Marshal(b.export(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.client.Post(b.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %v: %s", resp.Status, message)
+	}
+	return nil
+}
+
+// The types below follow the JSON encoding of the OTLP ExportMetricsServiceRequest, in which the 64-bit integers
+// are encoded as strings.
+
+type otlpExportRequest struct {
+	ResourceMetrics []*otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     *otlpResource       `json:"resource"`
+	ScopeMetrics []*otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   *otlpScope    `json:"scope"`
+	Metrics []*otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpAttribute struct {
+	Key   string          `json:"key"`
+	Value *otlpStringAttr `json:"value"`
+}
+
+type otlpStringAttr struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpMetric struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Sum         *otlpSum       `json:"sum,omitempty"`
+	Gauge       *otlpGauge     `json:"gauge,omitempty"`
+	Histogram   *otlpHistogram `json:"histogram,omitempty"`
+}
+
+type otlpSum struct {
+	DataPoints             []*otlpNumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int                    `json:"aggregationTemporality"`
+	IsMonotonic            bool                   `json:"isMonotonic"`
+}
+
+type otlpGauge struct {
+	DataPoints []*otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpHistogram struct {
+	DataPoints             []*otlpHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                       `json:"aggregationTemporality"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes        []*otlpAttribute `json:"attributes"`
+	StartTimeUnixNano string           `json:"startTimeUnixNano"`
+	TimeUnixNano      string           `json:"timeUnixNano"`
+	AsDouble          float64          `json:"asDouble"`
+}
+
+type otlpHistogramDataPoint struct {
+	Attributes        []*otlpAttribute `json:"attributes"`
+	StartTimeUnixNano string           `json:"startTimeUnixNano"`
+	TimeUnixNano      string           `json:"timeUnixNano"`
+	Count             string           `json:"count"`
+	Sum               float64          `json:"sum"`
+	BucketCounts      []string         `json:"bucketCounts"`
+	ExplicitBounds    []float64        `json:"explicitBounds"`
+}
+
+func toOTLPAttributes(labels map[string]string) []*otlpAttribute {
+	attributes := []*otlpAttribute{}
+	for _, name := range getLabelNames(labels) {
+		attributes = append(attributes, &otlpAttribute{
+			Key:   name,
+			Value: &otlpStringAttr{StringValue: labels[name]},
+		})
+	}
+	return attributes
+}
+
+func (b *OTLPBackend) export(now time.Time) *otlpExportRequest {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	startTime := strconv.FormatInt(b.startTime.UnixNano(), 10)
+	timestamp := strconv.FormatInt(now.UnixNano(), 10)
+
+	keys := make([]string, 0, len(b.series))
+	for key := range b.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	metrics := map[string]*otlpMetric{}
+	names := []string{}
+	for _, key := range keys {
+		s := b.series[key]
+		metric, exists := metrics[s.name]
+		if !exists {
+			metric = &otlpMetric{
+				Name:        Namespace + "_" + s.name,
+				Description: getHelp(s.name),
+			}
+			switch s.kind {
+			case otlpKindCounter:
+				metric.Sum = &otlpSum{AggregationTemporality: otlpTemporalityCumulative, IsMonotonic: true}
+			case otlpKindGauge:
+				metric.Gauge = &otlpGauge{}
+			case otlpKindHistogram:
+				metric.Histogram = &otlpHistogram{AggregationTemporality: otlpTemporalityCumulative}
+			}
+			metrics[s.name] = metric
+			names = append(names, s.name)
+		}
+
+		switch s.kind {
+		case otlpKindCounter:
+			metric.Sum.DataPoints = append(metric.Sum.DataPoints, &otlpNumberDataPoint{
+				Attributes:        toOTLPAttributes(s.labels),
+				StartTimeUnixNano: startTime,
+				TimeUnixNano:      timestamp,
+				AsDouble:          s.value,
+			})
+		case otlpKindGauge:
+			metric.Gauge.DataPoints = append(metric.Gauge.DataPoints, &otlpNumberDataPoint{
+				Attributes:        toOTLPAttributes(s.labels),
+				StartTimeUnixNano: startTime,
+				TimeUnixNano:      timestamp,
+				AsDouble:          s.value,
+			})
+		case otlpKindHistogram:
+			bucketCounts := make([]string, len(s.bucketCounts))
+			for i, count := range s.bucketCounts {
+				bucketCounts[i] = strconv.FormatUint(count, 10)
+			}
+			metric.Histogram.DataPoints = append(metric.Histogram.DataPoints, &otlpHistogramDataPoint{
+				Attributes:        toOTLPAttributes(s.labels),
+				StartTimeUnixNano: startTime,
+				TimeUnixNano:      timestamp,
+				Count:             strconv.FormatUint(s.count, 10),
+				Sum:               s.sum,
+				BucketCounts:      bucketCounts,
+				ExplicitBounds:    histogramBuckets,
+			})
+		}
+	}
+
+	scopeMetrics := &otlpScopeMetrics{
+		Scope:   &otlpScope{Name: otlpScopeName},
+		Metrics: []*otlpMetric{},
+	}
+	for _, name := range names {
+		scopeMetrics.Metrics = append(scopeMetrics.Metrics, metrics[name])
+	}
+
+	return &otlpExportRequest{
+		ResourceMetrics: []*otlpResourceMetrics{
+			{
+				Resource: &otlpResource{
+					Attributes: toOTLPAttributes(map[string]string{
+						"service.name": otlpServiceName,
+						"host.name":    b.hostname,
+					}),
+				},
+				ScopeMetrics: []*otlpScopeMetrics{scopeMetrics},
+			},
+		},
+	}
+}
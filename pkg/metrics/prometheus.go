@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sirupsen/logrus"
+)
+
+// PrometheusBackend keeps the metrics in a Prometheus registry to be pulled via Handler. The metric vectors are
+// registered on the first emission, using the label names of that emission.
+type PrometheusBackend struct {
+	lock     *sync.Mutex
+	registry *prometheus.Registry
+
+	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
+	histograms map[string]*prometheus.HistogramVec
+}
+
+func NewPrometheusBackend() (*PrometheusBackend, error) {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(prometheus.NewGoCollector()); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{})); err != nil {
+		return nil, err
+	}
+
+	return &PrometheusBackend{
+		lock:     &sync.Mutex{},
+		registry: registry,
+
+		counters:   map[string]*prometheus.CounterVec{},
+		gauges:     map[string]*prometheus.GaugeVec{},
+		histograms: map[string]*prometheus.HistogramVec{},
+	}, nil
+}
+
+func (b *PrometheusBackend) register(name string, collector prometheus.Collector) bool {
+	if err := b.registry.Register(collector); err != nil {
+		logrus.WithError(err).Warnf("Failed to register Prometheus metric %v", name)
+		return false
+	}
+	return true
+}
+
+func (b *PrometheusBackend) AddCounter(name string, labels map[string]string, delta float64) {
+	b.lock.Lock()
+	vec, exists := b.counters[name]
+	if !exists {
+		vec = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      name,
+			Help:      getHelp(name),
+		}, getLabelNames(labels))
+		if b.register(name, vec) {
+			b.counters[name] = vec
+		}
+	}
+	b.lock.Unlock()
+
+	counter, err := vec.GetMetricWith(labels)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to get Prometheus counter %v", name)
+		return
+	}
+	counter.Add(delta)
+}
+
+func (b *PrometheusBackend) SetGauge(name string, labels map[string]string, value float64) {
+	b.lock.Lock()
+	vec, exists := b.gauges[name]
+	if !exists {
+		vec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      name,
+			Help:      getHelp(name),
+		}, getLabelNames(labels))
+		if b.register(name, vec) {
+			b.gauges[name] = vec
+		}
+	}
+	b.lock.Unlock()
+
+	gauge, err := vec.GetMetricWith(labels)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to get Prometheus gauge %v", name)
+		return
+	}
+	gauge.Set(value)
+}
+
+func (b *PrometheusBackend) ObserveHistogram(name string, labels map[string]string, value float64) {
+	b.lock.Lock()
+	vec, exists := b.histograms[name]
+	if !exists {
+		vec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      name,
+			Help:      getHelp(name),
+			Buckets:   histogramBuckets,
+		}, getLabelNames(labels))
+		if b.register(name, vec) {
+			b.histograms[name] = vec
+		}
+	}
+	b.lock.Unlock()
+
+	histogram, err := vec.GetMetricWith(labels)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to get Prometheus histogram %v", name)
+		return
+	}
+	histogram.Observe(value)
+}
+
+func (b *PrometheusBackend) Close() error {
+	return nil
+}
+
+// Handler returns the handler serving the metrics in the Prometheus exposition format.
+func (b *PrometheusBackend) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		families, err := b.registry.Gather()
+		if err != nil {
+			logrus.WithError(err).Warn("Failed to gather some of the Prometheus metrics")
+		}
+
+		format := expfmt.Negotiate(r.Header)
+		w.Header().Set("Content-Type", string(format))
+		encoder := expfmt.NewEncoder(w, format)
+		for _, family := range families {
+			if err := encoder.Encode(family); err != nil {
+				logrus.WithError(err).Warn("Failed to encode Prometheus metrics")
+				return
+			}
+		}
+	})
+}
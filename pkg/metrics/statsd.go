@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// StatsdBackend sends each emission to a statsd server over UDP as soon as it happens. The labels are sent as
+// DogStatsD tags, e.g. "longhorn_instance_manager.processes:3|g|#state:running".
+type StatsdBackend struct {
+	address string
+	conn    net.Conn
+}
+
+func NewStatsdBackend(address string) (*StatsdBackend, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &StatsdBackend{
+		address: address,
+		conn:    conn,
+	}, nil
+}
+
+func (b *StatsdBackend) send(name, metricType string, labels map[string]string, value float64) {
+	line := &strings.Builder{}
+	fmt.Fprintf(line, "%s.%s:%s|%s", Namespace, name, strconv.FormatFloat(value, 'f', -1, 64), metricType)
+	for i, labelName := range getLabelNames(labels) {
+		if i == 0 {
+			line.WriteString("|#")
+		} else {
+			line.WriteString(",")
+		}
+		fmt.Fprintf(line, "%s:%s", labelName, labels[labelName])
+	}
+
+	if _, err := b.conn.Write([]byte(line.String())); err != nil {
+		logrus.WithError(err).Debugf("Failed to send metric %v to statsd server %v", name, b.address)
+	}
+}
+
+func (b *StatsdBackend) AddCounter(name string, labels map[string]string, delta float64) {
+	b.send(name, "c", labels, delta)
+}
+
+func (b *StatsdBackend) SetGauge(name string, labels map[string]string, value float64) {
+	b.send(name, "g", labels, value)
+}
+
+func (b *StatsdBackend) ObserveHistogram(name string, labels map[string]string, value float64) {
+	b.send(name, "h", labels, value)
+}
+
+func (b *StatsdBackend) Close() error {
+	return b.conn.Close()
+}
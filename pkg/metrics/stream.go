@@ -0,0 +1,182 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// StreamEndCompleted is a stream ended by the server, e.g. a log stream reaching the end of the log
+	StreamEndCompleted = "completed"
+	// StreamEndClientCanceled is a stream canceled or disconnected by the client
+	StreamEndClientCanceled = "client_canceled"
+	// StreamEndDeadlineExceeded is a stream ended by the deadline of the client
+	StreamEndDeadlineExceeded = "deadline_exceeded"
+	// StreamEndServerStopped is a stream aborted by the instance manager stopping its servers
+	StreamEndServerStopped = "server_stopped"
+	// StreamEndError is a stream failed by the server with an error
+	StreamEndError = "error"
+
+	maxStreamHistory = 256
+)
+
+// StreamRecord is the lifetime of a stream of a gRPC server, e.g. an instance watch.
+type StreamRecord struct {
+	ID      uint64
+	Service string
+	Method  string
+	// Peer is the address of the client
+	Peer      string
+	StartTime time.Time
+	// EndTime is zero and Cause empty while the stream is active
+	EndTime time.Time
+	Cause   string
+	Code    codes.Code
+	Error   string
+}
+
+// streamTracker keeps the active streams and the latest ended ones, so that a lost stream, e.g. a watch of
+// longhorn-manager, can be told apart from the server side without correlating the logs of both sides.
+type streamTracker struct {
+	lock     *sync.Mutex
+	nextID   uint64
+	active   map[uint64]*StreamRecord
+	ended    []*StreamRecord
+	stopping atomic.Bool
+}
+
+var streams = &streamTracker{
+	lock:   &sync.Mutex{},
+	active: map[uint64]*StreamRecord{},
+}
+
+func (t *streamTracker) start(ctx context.Context, service, method string) *StreamRecord {
+	record := &StreamRecord{
+		Service:   service,
+		Method:    method,
+		StartTime: time.Now().UTC(),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Peer = p.Addr.String()
+	}
+
+	t.lock.Lock()
+	t.nextID++
+	record.ID = t.nextID
+	t.active[record.ID] = record
+	count := t.countActive(service, method)
+	t.lock.Unlock()
+
+	SetGauge(MetricGRPCStreamsActive, map[string]string{
+		"service": service,
+		"method":  method,
+	}, float64(count))
+	return record
+}
+
+func (t *streamTracker) end(ctx context.Context, record *StreamRecord, err error) {
+	ended := *record
+	ended.EndTime = time.Now().UTC()
+	ended.Code = status.Code(err)
+	ended.Cause = t.getEndCause(ctx, err)
+	if err != nil {
+		ended.Error = err.Error()
+	}
+
+	t.lock.Lock()
+	delete(t.active, record.ID)
+	t.ended = append(t.ended, &ended)
+	if len(t.ended) > maxStreamHistory {
+		t.ended = t.ended[len(t.ended)-maxStreamHistory:]
+	}
+	count := t.countActive(record.Service, record.Method)
+	t.lock.Unlock()
+
+	SetGauge(MetricGRPCStreamsActive, map[string]string{
+		"service": record.Service,
+		"method":  record.Method,
+	}, float64(count))
+	AddCounter(MetricGRPCStreamEnds, map[string]string{
+		"service": record.Service,
+		"method":  record.Method,
+		"cause":   ended.Cause,
+	}, 1)
+
+	log := logrus.WithFields(logrus.Fields{
+		"service":  ended.Service,
+		"method":   ended.Method,
+		"peer":     ended.Peer,
+		"duration": ended.EndTime.Sub(ended.StartTime).Round(time.Millisecond),
+		"cause":    ended.Cause,
+	})
+	if ended.Cause == StreamEndError {
+		log.WithError(err).Warn("gRPC stream ended")
+	} else {
+		log.Debug("gRPC stream ended")
+	}
+}
+
+// getEndCause tells why the stream ended. The client leaving and the server stopping both cancel the context of
+// the stream, so the stopping of the server is flagged beforehand by SetServersStopping.
+func (t *streamTracker) getEndCause(ctx context.Context, err error) string {
+	if t.stopping.Load() && ctx.Err() != nil {
+		return StreamEndServerStopped
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded {
+		return StreamEndDeadlineExceeded
+	}
+	if errors.Is(ctx.Err(), context.Canceled) || status.Code(err) == codes.Canceled {
+		return StreamEndClientCanceled
+	}
+	if err != nil {
+		return StreamEndError
+	}
+	return StreamEndCompleted
+}
+
+func (t *streamTracker) countActive(service, method string) int {
+	count := 0
+	for _, record := range t.active {
+		if record.Service == service && record.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+// SetServersStopping flags the streams ending from then on as aborted by the instance manager stopping its
+// servers.
+func SetServersStopping() {
+	streams.stopping.Store(true)
+}
+
+// StreamList returns the active streams of the gRPC servers by start time, and the latest ended ones by end time.
+func StreamList() (active, ended []*StreamRecord) {
+	streams.lock.Lock()
+	defer streams.lock.Unlock()
+
+	active = []*StreamRecord{}
+	for _, record := range streams.active {
+		r := *record
+		active = append(active, &r)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].ID < active[j].ID
+	})
+
+	ended = []*StreamRecord{}
+	for _, record := range streams.ended {
+		r := *record
+		ended = append(ended, &r)
+	}
+	return active, ended
+}
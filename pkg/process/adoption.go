@@ -0,0 +1,462 @@
+package process
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	processStateFileName = "processes.json"
+	logPipeSuffix        = ".log.pipe"
+
+	// logPipeSize is the output buffered in the log pipe of a process while the instance manager is restarting,
+	// beyond which the writes of the process block until it is adopted
+	logPipeSize = 1 << 20
+
+	adoptedProcessCheckInterval = 1 * time.Second
+)
+
+// ProcessStateSchema is the schema of the process state file.
+var ProcessStateSchema = &util.StateSchema{
+	Kind:           "process",
+	CurrentVersion: 0,
+}
+
+// processState is a process persisted for the adoption. The pid along with its start time identifies the process,
+// so that a later process reusing the pid is never adopted.
+type processState struct {
+	Spec       *rpc.ProcessSpec `json:"spec"`
+	UUID       string           `json:"uuid"`
+	State      State            `json:"state"`
+	PortStart  int32            `json:"portStart"`
+	PortEnd    int32            `json:"portEnd"`
+	Conditions map[string]bool  `json:"conditions,omitempty"`
+	Pid        int              `json:"pid"`
+	StartTime  uint64           `json:"startTime"`
+	// Log is the state of the logger handed off by the previous instance manager, if any
+	Log *util.LogHandoff `json:"log,omitempty"`
+}
+
+type processStateFile struct {
+	Processes []*processState `json:"processes"`
+}
+
+func (pm *Manager) processStateFilePath() string {
+	return filepath.Join(pm.stateDir, processStateFileName)
+}
+
+// getLogPipePath returns the path of the log pipe of the process, or empty if the processes are not adoptable.
+func (pm *Manager) getLogPipePath(uuid string) string {
+	if pm.stateDir == "" {
+		return ""
+	}
+	return filepath.Join(pm.stateDir, uuid+logPipeSuffix)
+}
+
+// createLogPipe creates the log pipe of a process. It returns the read end for the instance manager, along with the
+// write end for the output of the process and another read end kept open in the process, so that the writes of the
+// process never fail while the instance manager is restarting.
+func createLogPipe(path string) (*os.File, *os.File, *os.File, error) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, nil, nil, errors.Wrapf(err, "failed to remove stale log pipe %v", path)
+	}
+	if err := unix.Mkfifo(path, 0600); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "failed to create log pipe %v", path)
+	}
+	reader, err := openLogPipe(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keeper, err := openLogPipe(path)
+	if err != nil {
+		reader.Close()
+		return nil, nil, nil, err
+	}
+	writer, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		reader.Close()
+		keeper.Close()
+		return nil, nil, nil, errors.Wrapf(err, "failed to open log pipe %v for writing", path)
+	}
+	if _, err := unix.FcntlInt(writer.Fd(), unix.F_SETPIPE_SZ, logPipeSize); err != nil {
+		logrus.WithError(err).Debugf("Process Manager: failed to enlarge log pipe %v", path)
+	}
+	return reader, writer, keeper, nil
+}
+
+// openLogPipe opens the read end of the log pipe without waiting for a writer.
+func openLogPipe(path string) (*os.File, error) {
+	reader, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open log pipe %v for reading", path)
+	}
+	return reader, nil
+}
+
+// startLogForwarding stores the output of the process from the log pipe in the background. The caller must hold the
+// lock.
+func (p *Process) startLogForwarding(reader *os.File) {
+	forwarded := make(chan struct{})
+	p.logReader = reader
+	p.logForwarded = forwarded
+	go func() {
+		defer close(forwarded)
+		p.forwardLog(reader)
+	}()
+}
+
+// forwardLog stores the output of the process from the log pipe until the process along with its children holding
+// the output exit, and then removes the log pipe. The log pipe is kept for the next instance manager if the reading
+// is interrupted by the log handoff.
+func (p *Process) forwardLog(reader *os.File) {
+	_, err := io.Copy(p.logger, reader)
+	if err != nil && !errors.Is(err, os.ErrDeadlineExceeded) {
+		logrus.WithError(err).Warnf("Process Manager: failed to store the output of process %v", p.Name)
+		// Keep draining the pipe so that the process never blocks on its output
+		_, err = io.Copy(io.Discard, reader)
+	}
+	reader.Close()
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return
+	}
+	if err := os.Remove(p.logPipePath); err != nil && !os.IsNotExist(err) {
+		logrus.WithError(err).Warnf("Process Manager: failed to remove log pipe of process %v", p.Name)
+	}
+}
+
+// handOffLog stops storing the output of the process and hands off its logger, leaving the output from now on in the
+// log pipe for the next instance manager. Every chunk read from the log pipe is stored before the reading stops, so
+// no output is lost in between. Nothing is handed off if the output does not go through a log pipe, or the process is
+// stopping and so not to be adopted.
+func (p *Process) handOffLog() error {
+	p.lock.RLock()
+	reader, forwarded, state := p.logReader, p.logForwarded, p.State
+	p.lock.RUnlock()
+	if reader == nil || state == StateStopping || state == StateStopped || state == StateError {
+		return nil
+	}
+
+	// The read end is polled, so the deadline interrupts the reading in progress. It fails if the reading is over.
+	_ = reader.SetReadDeadline(time.Now())
+	<-forwarded
+
+	handoff, err := p.logger.HandOff()
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	p.logHandoff = handoff
+	p.lock.Unlock()
+	return nil
+}
+
+// HandOffLogs stops storing the output of the processes, and persists the state of their loggers along with the
+// processes, for the next instance manager adopting the processes to continue the logs without losing or splitting
+// any line. The output in the meantime is buffered in the log pipes. It is called last before the instance manager
+// exits, since the output is no longer stored afterwards.
+func (pm *Manager) HandOffLogs() {
+	if pm.stateDir == "" {
+		return
+	}
+
+	pm.lock.RLock()
+	processes := []*Process{}
+	for _, p := range pm.processes {
+		processes = append(processes, p)
+	}
+	pm.lock.RUnlock()
+
+	for _, p := range processes {
+		if err := p.handOffLog(); err != nil {
+			logrus.WithError(err).Warnf("Process Manager: failed to hand off the log of process %v", p.Name)
+		}
+	}
+	pm.saveProcessState()
+	logrus.Info("Process Manager: handed off the logs of the processes")
+}
+
+// adoptedCommand is the command of a process adopted after the instance manager restarts. The process is not a
+// child of the instance manager, so its exit is observed by polling and its exit status is unknown.
+type adoptedCommand struct {
+	*sync.RWMutex
+
+	pid       int
+	startTime uint64
+	stopped   bool
+}
+
+func (ac *adoptedCommand) Run() error {
+	for util.IsProcessRunning(ac.pid, ac.startTime) {
+		time.Sleep(adoptedProcessCheckInterval)
+	}
+	// The zombie is reaped here in case the instance manager is the subreaper it is reparented to
+	_, _ = syscall.Wait4(ac.pid, nil, syscall.WNOHANG, nil)
+
+	ac.RLock()
+	defer ac.RUnlock()
+	if !ac.stopped {
+		return fmt.Errorf("adopted process with pid %v exited with unknown status", ac.pid)
+	}
+	return nil
+}
+
+func (ac *adoptedCommand) SetOutput(writer io.Writer) {
+}
+
+func (ac *adoptedCommand) SetEnv(env []string) {
+}
+
+func (ac *adoptedCommand) Detach(files ...*os.File) {
+}
+
+func (ac *adoptedCommand) Started() bool {
+	return true
+}
+
+func (ac *adoptedCommand) Stop() {
+	ac.StopWithSignal(syscall.SIGINT)
+}
+
+func (ac *adoptedCommand) StopWithSignal(signal syscall.Signal) {
+	ac.Lock()
+	defer ac.Unlock()
+	if signal != syscall.SIGSTOP && signal != syscall.SIGCONT {
+		ac.stopped = true
+	}
+	if util.IsProcessRunning(ac.pid, ac.startTime) {
+		_ = syscall.Kill(ac.pid, signal)
+	}
+}
+
+func (ac *adoptedCommand) Kill() {
+	ac.StopWithSignal(syscall.SIGKILL)
+}
+
+func (ac *adoptedCommand) Pid() int {
+	return ac.pid
+}
+
+// saveProcessState persists the processes alive, so that they can be adopted after the instance manager restarts.
+// The replacement processes not registered yet are not persisted.
+func (pm *Manager) saveProcessState() {
+	if pm.stateDir == "" {
+		return
+	}
+
+	pm.stateLock.Lock()
+	defer pm.stateLock.Unlock()
+
+	stateFile := &processStateFile{
+		Processes: []*processState{},
+	}
+	pm.lock.RLock()
+	for _, p := range pm.processes {
+		pid := p.Pid()
+		if pid <= 0 || p.IsStopped() {
+			continue
+		}
+		startTime, err := util.GetProcessStartTime(pid)
+		if err != nil {
+			continue
+		}
+		resp := p.RPCResponse()
+		p.lock.RLock()
+		logHandoff := p.logHandoff
+		p.lock.RUnlock()
+		stateFile.Processes = append(stateFile.Processes, &processState{
+			Spec:       resp.Spec,
+			UUID:       resp.Status.Uuid,
+			State:      State(resp.Status.State),
+			PortStart:  resp.Status.PortStart,
+			PortEnd:    resp.Status.PortEnd,
+			Conditions: resp.Status.Conditions,
+			Pid:        pid,
+			StartTime:  startTime,
+			Log:        logHandoff,
+		})
+	}
+	pm.lock.RUnlock()
+
+	content, err := ProcessStateSchema.Marshal(stateFile)
+	if err != nil {
+		logrus.WithError(err).Warn("Process Manager: failed to encode the process state")
+		return
+	}
+	path := pm.processStateFilePath()
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to write process state file %v", tmpPath)
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to rename process state file %v", tmpPath)
+	}
+}
+
+// AdoptProcesses persists the processes from now on, and adopts the ones persisted by the previous instance manager
+// that are still running, rather than leaving them behind unmanaged. The processes the previous instance manager was
+// stopping are killed instead. It returns the number of the processes adopted.
+func (pm *Manager) AdoptProcesses(stateDir string) (int, error) {
+	if err := os.MkdirAll(stateDir, 0755); err != nil {
+		return 0, errors.Wrapf(err, "failed to create process state directory %v", stateDir)
+	}
+	pm.stateDir = stateDir
+
+	path := pm.processStateFilePath()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "failed to read process state file %v", path)
+	}
+	stateFile := &processStateFile{}
+	if err := ProcessStateSchema.Unmarshal(content, stateFile); err != nil {
+		return 0, errors.Wrapf(err, "failed to decode process state file %v", path)
+	}
+
+	adopted := 0
+	for _, state := range stateFile.Processes {
+		if state.Spec == nil || !util.IsProcessRunning(state.Pid, state.StartTime) {
+			logrus.Warnf("Process Manager: process %v with pid %v is no longer running, cannot adopt it",
+				state.Spec.GetName(), state.Pid)
+			os.Remove(pm.getLogPipePath(state.UUID))
+			continue
+		}
+		if state.State == StateStopping {
+			logrus.Warnf("Process Manager: killing process %v with pid %v the previous instance manager was stopping",
+				state.Spec.Name, state.Pid)
+			if err := syscall.Kill(state.Pid, syscall.SIGKILL); err != nil {
+				logrus.WithError(err).Warnf("Process Manager: failed to kill process %v", state.Spec.Name)
+			}
+			continue
+		}
+		if err := pm.adoptProcess(state); err != nil {
+			logrus.WithError(err).Errorf("Process Manager: failed to adopt process %v with pid %v", state.Spec.Name, state.Pid)
+			continue
+		}
+		adopted++
+	}
+	pm.saveProcessState()
+	return adopted, nil
+}
+
+func (pm *Manager) adoptProcess(state *processState) error {
+	spec := state.Spec
+	logger, err := util.NewLonghornWriter(spec.Name, pm.logsDir)
+	if err != nil {
+		return err
+	}
+	logger.SetFloodProtection(pm.LogFlood)
+	logger.SetRotation(pm.LogRotation)
+	if state.Log != nil {
+		logger.TakeOver(state.Log)
+	}
+
+	conditions := state.Conditions
+	if conditions == nil {
+		conditions = map[string]bool{}
+	}
+	p := &Process{
+		Name:      spec.Name,
+		Binary:    spec.Binary,
+		Args:      spec.Args,
+		PortCount: spec.PortCount,
+		PortArgs:  spec.PortArgs,
+		Envs:      spec.Envs,
+		Labels:    spec.Labels,
+
+		PriorityClass: spec.PriorityClass,
+		CPUMillicores: spec.CpuMillicores,
+		MemoryBytes:   spec.MemoryBytes,
+		CPUSet:        spec.CpuSet,
+		NUMANode:      spec.NumaNode,
+		Privileges:    spec.Privileges,
+		StopTimeout:   time.Duration(spec.StopTimeoutSeconds) * time.Second,
+
+		UUID: state.UUID,
+
+		State:      state.State,
+		Conditions: conditions,
+		PortStart:  state.PortStart,
+		PortEnd:    state.PortEnd,
+
+		lock: &sync.RWMutex{},
+		cmd: &adoptedCommand{
+			RWMutex:   &sync.RWMutex{},
+			pid:       state.Pid,
+			startTime: state.StartTime,
+		},
+
+		logger:      logger,
+		logPipePath: pm.getLogPipePath(state.UUID),
+
+		env:           pm.getProcessEnv(spec.Envs),
+		executor:      pm.Executor,
+		healthChecker: pm.HealthChecker,
+	}
+	p.Sidecars = newSidecars(spec.Sidecars, pm.logsDir, pm.Executor, p.env)
+
+	if err := pm.registerAdoptedProcess(p); err != nil {
+		logger.Close()
+		return err
+	}
+
+	p.lock.Lock()
+	reader, err := openLogPipe(p.logPipePath)
+	if err != nil {
+		logrus.WithError(err).Warnf("Process Manager: cannot store the output of adopted process %v", p.Name)
+	} else {
+		p.startLogForwarding(reader)
+	}
+	p.run(nil, true)
+	p.lock.Unlock()
+	p.publishUpdate()
+
+	logrus.Infof("Process Manager: adopted process %v with pid %v in state %v", p.Name, state.Pid, p.State)
+	return nil
+}
+
+// registerAdoptedProcess registers the adopted process with the ports it already listens on. The process stays in
+// the rebuild mode if it was in the middle of a rebuild.
+func (pm *Manager) registerAdoptedProcess(p *Process) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	if _, exists := pm.processes[p.Name]; exists {
+		return fmt.Errorf("process %v already exists", p.Name)
+	}
+	if err := pm.acquireProcessLease(p); err != nil {
+		return err
+	}
+	if p.PortCount > 0 {
+		if err := pm.availablePorts.AllocateSpecificRange(p.PortStart, p.PortEnd); err != nil {
+			pm.releaseProcessLease(p)
+			return errors.Wrapf(err, "cannot allocate ports %v-%v for %v", p.PortStart, p.PortEnd, p.Name)
+		}
+		pm.recordPortEvent(PortEventAllocated, p.Name, p.UUID, p.PortStart, p.PortEnd)
+	}
+
+	if p.Conditions[types.ProcessConditionRebuildThrottled] {
+		pm.rebuildLock.Lock()
+		pm.rebuildModes[p.Name] = 1
+		pm.rebuildLock.Unlock()
+	}
+
+	p.updates = pm.processUpdates
+	pm.processes[p.Name] = p
+	return nil
+}
@@ -2,6 +2,7 @@ package process
 
 import (
 	"io"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"sync"
@@ -15,10 +16,16 @@ type Executor interface {
 type Command interface {
 	Run() error
 	SetOutput(io.Writer)
+	// SetEnv sets the environment of the command. The command inherits the environment of the caller if it's nil.
+	SetEnv([]string)
+	// Detach leaves the command running once the instance manager exits rather than killing it, with the files
+	// kept open in the command.
+	Detach(files ...*os.File)
 	Started() bool
 	Stop()
 	StopWithSignal(signal syscall.Signal)
 	Kill()
+	Pid() int
 }
 
 type BinaryExecutor struct{}
@@ -62,6 +69,19 @@ func (bc *BinaryCommand) SetOutput(writer io.Writer) {
 	bc.Stderr = writer
 }
 
+func (bc *BinaryCommand) SetEnv(env []string) {
+	bc.Lock()
+	defer bc.Unlock()
+	bc.Env = env
+}
+
+func (bc *BinaryCommand) Detach(files ...*os.File) {
+	bc.Lock()
+	defer bc.Unlock()
+	bc.SysProcAttr.Pdeathsig = 0
+	bc.ExtraFiles = files
+}
+
 func (bc *BinaryCommand) Started() bool {
 	bc.RLock()
 	defer bc.RUnlock()
@@ -92,6 +112,15 @@ func (bc *BinaryCommand) Kill() {
 	}
 }
 
+func (bc *BinaryCommand) Pid() int {
+	bc.RLock()
+	defer bc.RUnlock()
+	if bc.Process == nil {
+		return 0
+	}
+	return bc.Process.Pid
+}
+
 type MockExecutor struct {
 	CreationHook func(cmd *MockCommand) (*MockCommand, error)
 }
@@ -109,6 +138,9 @@ type MockCommand struct {
 
 	Binary string
 	Args   []string
+	Env    []string
+	// Detached is true if the command is left running once the instance manager exits
+	Detached bool
 
 	stopCh chan error
 
@@ -141,6 +173,18 @@ func (mc *MockCommand) Run() error {
 func (mc *MockCommand) SetOutput(writer io.Writer) {
 }
 
+func (mc *MockCommand) SetEnv(env []string) {
+	mc.Lock()
+	defer mc.Unlock()
+	mc.Env = env
+}
+
+func (mc *MockCommand) Detach(files ...*os.File) {
+	mc.Lock()
+	defer mc.Unlock()
+	mc.Detached = true
+}
+
 func (mc *MockCommand) Started() bool {
 	mc.RLock()
 	defer mc.RUnlock()
@@ -156,8 +200,16 @@ func (mc *MockCommand) Stop() {
 }
 
 func (mc *MockCommand) StopWithSignal(signal syscall.Signal) {
+	// SIGSTOP and SIGCONT only pause and continue the process
+	if signal == syscall.SIGSTOP || signal == syscall.SIGCONT {
+		return
+	}
 	mc.Stop()
 }
 
 func (mc *MockCommand) Kill() {
 }
+
+func (mc *MockCommand) Pid() int {
+	return 0
+}
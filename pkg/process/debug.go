@@ -0,0 +1,161 @@
+package process
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	PortOwnerTypeProcess     = "process"
+	PortOwnerTypeReplacement = "replacement"
+)
+
+// ProcessManagerDebugGet returns the internal state of the process manager for the triage of a node, i.e. the port
+// allocation map, the live health probes of the processes, and the pending stops of the processes. Nothing is
+// changed, unlike PortReconcile.
+func (pm *Manager) ProcessManagerDebugGet(ctx context.Context, req *emptypb.Empty) (*rpc.ProcessManagerDebugResponse, error) {
+	logrus.Debug("Process Manager: getting the debug state")
+
+	pm.lock.RLock()
+	processes := []*Process{}
+	ownerTypes := map[*Process]string{}
+	for _, p := range pm.processes {
+		processes = append(processes, p)
+		ownerTypes[p] = PortOwnerTypeProcess
+	}
+	for _, p := range pm.replacementProcesses {
+		processes = append(processes, p)
+		ownerTypes[p] = PortOwnerTypeReplacement
+	}
+	allocations := pm.getPortAllocations(processes, ownerTypes)
+	pm.lock.RUnlock()
+
+	resp := &rpc.ProcessManagerDebugResponse{
+		PortRangeStart:  pm.portRangeMin,
+		PortRangeEnd:    pm.portRangeMax,
+		PortAllocations: allocations,
+		Probes:          probeProcesses(processes, ownerTypes),
+	}
+
+	for _, p := range processes {
+		p.lock.RLock()
+		if p.State == StateStopping && ownerTypes[p] == PortOwnerTypeProcess {
+			resp.StoppingProcesses = append(resp.StoppingProcesses, &rpc.ProcessStopOperation{
+				ProcessName:   p.Name,
+				StoppingSince: p.stoppingAt.UTC().Format(time.RFC3339),
+			})
+		}
+		p.lock.RUnlock()
+	}
+	sort.Slice(resp.StoppingProcesses, func(i, j int) bool {
+		return resp.StoppingProcesses[i].ProcessName < resp.StoppingProcesses[j].ProcessName
+	})
+
+	pm.bulkDeleteLock.RLock()
+	for _, op := range pm.bulkDeleteOperations {
+		if operation := op.RPCResponse(); operation.State == BulkDeleteStateInProgress {
+			resp.BulkDeleteOperations = append(resp.BulkDeleteOperations, operation)
+		}
+	}
+	pm.bulkDeleteLock.RUnlock()
+	sort.Slice(resp.BulkDeleteOperations, func(i, j int) bool {
+		return resp.BulkDeleteOperations[i].OperationId < resp.BulkDeleteOperations[j].OperationId
+	})
+
+	return resp, nil
+}
+
+// getPortAllocations returns the port ranges of the processes along with the allocated ports without an owner,
+// ordered by port. The caller must hold the lock.
+func (pm *Manager) getPortAllocations(processes []*Process, ownerTypes map[*Process]string) []*rpc.ProcessPortAllocation {
+	allocations := []*rpc.ProcessPortAllocation{}
+	owned := map[int32]struct{}{}
+	for _, p := range processes {
+		p.lock.RLock()
+		name, portStart, portEnd := p.Name, p.PortStart, p.PortEnd
+		p.lock.RUnlock()
+		if portStart == 0 {
+			continue
+		}
+
+		allocated := true
+		for port := portStart; port <= portEnd; port++ {
+			owned[port] = struct{}{}
+			if !pm.availablePorts.IsAllocated(port) {
+				allocated = false
+			}
+		}
+		allocations = append(allocations, &rpc.ProcessPortAllocation{
+			PortStart:   portStart,
+			PortEnd:     portEnd,
+			ProcessName: name,
+			OwnerType:   ownerTypes[p],
+			Allocated:   allocated,
+		})
+	}
+
+	leaked := []int32{}
+	for _, port := range pm.availablePorts.AllocatedPorts() {
+		if _, exists := owned[port]; !exists {
+			leaked = append(leaked, port)
+		}
+	}
+	for _, r := range groupPortRanges(leaked) {
+		allocations = append(allocations, &rpc.ProcessPortAllocation{
+			PortStart: r[0],
+			PortEnd:   r[1],
+			Allocated: true,
+		})
+	}
+
+	sort.Slice(allocations, func(i, j int) bool { return allocations[i].PortStart < allocations[j].PortStart })
+	return allocations
+}
+
+// probeProcesses probes the gRPC services of the starting and running processes in parallel, ordered by name.
+func probeProcesses(processes []*Process, ownerTypes map[*Process]string) []*rpc.ProcessProbeStatus {
+	probes := []*rpc.ProcessProbeStatus{}
+	toProbe := map[*rpc.ProcessProbeStatus]*Process{}
+	for _, p := range processes {
+		if ownerTypes[p] != PortOwnerTypeProcess {
+			continue
+		}
+		p.lock.RLock()
+		probe := &rpc.ProcessProbeStatus{
+			ProcessName: p.Name,
+			State:       string(p.State),
+		}
+		if p.PortStart != 0 {
+			probe.Address = util.GetURL("localhost", int(p.PortStart))
+			if p.State == StateStarting || p.State == StateRunning {
+				toProbe[probe] = p
+			}
+		}
+		p.lock.RUnlock()
+		probes = append(probes, probe)
+	}
+
+	wg := &sync.WaitGroup{}
+	for probe, p := range toProbe {
+		wg.Add(1)
+		go func(probe *rpc.ProcessProbeStatus, p *Process) {
+			defer wg.Done()
+			start := time.Now()
+			probe.Healthy = p.healthChecker.IsRunning(probe.Address)
+			probe.LatencyMs = time.Since(start).Milliseconds()
+			probe.Probed = true
+		}(probe, p)
+	}
+	wg.Wait()
+
+	sort.Slice(probes, func(i, j int) bool { return probes[i].ProcessName < probes[j].ProcessName })
+	return probes
+}
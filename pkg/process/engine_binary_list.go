@@ -0,0 +1,165 @@
+package process
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// engineBinaryDirectories are the directories the engine images deploy their binaries to, as <image>/longhorn.
+var engineBinaryDirectories = []string{
+	"/engine-binaries/",
+	"/host/var/lib/longhorn/engine-binaries/",
+}
+
+// engineBinaryCache keeps the scanned binaries by path, so that a binary is checksummed and launched again only once
+// it changes.
+type engineBinaryCache struct {
+	lock     *sync.Mutex
+	binaries map[string]*engineBinaryCacheEntry
+}
+
+type engineBinaryCacheEntry struct {
+	size    int64
+	modTime time.Time
+	binary  *rpc.EngineBinary
+}
+
+func newEngineBinaryCache() *engineBinaryCache {
+	return &engineBinaryCache{
+		lock:     &sync.Mutex{},
+		binaries: map[string]*engineBinaryCacheEntry{},
+	}
+}
+
+// EngineBinaryList scans the engine binary directories and reports the checksum, the version and the compatibility
+// of each binary, so that the caller can tell whether an engine image finished deploying on the node. A binary still
+// being written out is reported with the version check failed.
+func (pm *Manager) EngineBinaryList(ctx context.Context, req *emptypb.Empty) (*rpc.EngineBinaryListResponse, error) {
+	logrus.Debug("Process Manager: listing engine binaries")
+
+	workDir, err := os.MkdirTemp("", "engine-binary-list-")
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to create validation directory").Error())
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			logrus.WithError(err).Warnf("Process Manager: failed to remove validation directory %v", workDir)
+		}
+	}()
+
+	resp := &rpc.EngineBinaryListResponse{}
+	scanned := map[string]bool{}
+	for _, dir := range engineBinaryDirectories {
+		images, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				logrus.WithError(err).Warnf("Process Manager: failed to read engine binary directory %v", dir)
+			}
+			continue
+		}
+		for _, image := range images {
+			if !image.IsDir() {
+				continue
+			}
+			binary := filepath.Join(dir, image.Name(), "longhorn")
+			info, err := os.Stat(binary)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			scanned[binary] = true
+			resp.Binaries = append(resp.Binaries, pm.engineBinaries.get(ctx, workDir, binary, image.Name(), info))
+		}
+	}
+	pm.engineBinaries.prune(scanned)
+
+	sort.Slice(resp.Binaries, func(i, j int) bool {
+		return resp.Binaries[i].Binary < resp.Binaries[j].Binary
+	})
+	return resp, nil
+}
+
+// get returns the cached result of the binary if the binary is unchanged since, otherwise it scans the binary.
+func (c *engineBinaryCache) get(ctx context.Context, workDir, binary, image string, info os.FileInfo) *rpc.EngineBinary {
+	c.lock.Lock()
+	entry, exists := c.binaries[binary]
+	c.lock.Unlock()
+	if exists && entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
+		return proto.Clone(entry.binary).(*rpc.EngineBinary)
+	}
+
+	result := scanEngineBinary(ctx, workDir, binary, image, info)
+	// The binaries failing to report the version are not cached, since the binary may be still being written out
+	if result.GetValidation().GetVersion() != "" {
+		c.lock.Lock()
+		c.binaries[binary] = &engineBinaryCacheEntry{
+			size:    info.Size(),
+			modTime: info.ModTime(),
+			binary:  proto.Clone(result).(*rpc.EngineBinary),
+		}
+		c.lock.Unlock()
+	}
+	return result
+}
+
+// prune drops the binaries removed since, e.g. the ones of the engine images undeployed.
+func (c *engineBinaryCache) prune(scanned map[string]bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for binary := range c.binaries {
+		if !scanned[binary] {
+			delete(c.binaries, binary)
+		}
+	}
+}
+
+func scanEngineBinary(ctx context.Context, workDir, binary, image string, info os.FileInfo) *rpc.EngineBinary {
+	result := &rpc.EngineBinary{
+		Binary:     binary,
+		Image:      image,
+		Size:       info.Size(),
+		ModifiedAt: info.ModTime().UTC().Format(time.RFC3339),
+	}
+
+	checksum, err := getFileChecksum(binary)
+	if err != nil {
+		result.ErrorMsg = err.Error()
+		return result
+	}
+	result.Checksum = checksum
+
+	result.Validation = &rpc.EngineBinaryValidateResponse{}
+	checkEngineBinaryVersion(ctx, workDir, binary, result.Validation)
+	setEngineBinaryCompatible(binary, result.Validation)
+	return result
+}
+
+func getFileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open %v", path)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", errors.Wrapf(err, "failed to checksum %v", path)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
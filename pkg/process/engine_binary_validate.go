@@ -0,0 +1,289 @@
+package process
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	emeta "github.com/longhorn/longhorn-engine/pkg/meta"
+	eclient "github.com/longhorn/longhorn-engine/pkg/replica/client"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	EngineBinaryCheckVersion       = "version"
+	EngineBinaryCheckControllerAPI = "controller-api"
+	EngineBinaryCheckDataFormat    = "data-format"
+	EngineBinaryCheckDryRun        = "dry-run"
+	EngineBinaryCheckIOSelfTest    = "io-self-test"
+
+	engineBinaryCommandTimeout  = 30 * time.Second
+	engineBinarySelfTestTimeout = 2 * time.Minute
+	// engineBinarySelfTestPortCount covers the ports of the replica, i.e. the gRPC, data and sync agent ports
+	engineBinarySelfTestPortCount  = 10
+	engineBinarySelfTestSize       = 16 * 1024 * 1024
+	engineBinarySelfTestExpandSize = 2 * engineBinarySelfTestSize
+
+	// engineBinaryOutputLimit bounds the output of a failed command reported back to the caller
+	engineBinaryOutputLimit = 1024
+)
+
+// EngineBinaryValidate launches the candidate engine binary in a sandbox to check whether it can be used by this
+// instance manager before any volume uses it. The sandbox drops all the capabilities of the binary and keeps its
+// mounts private, see newSandboxedCommand. The binary is never registered as a process, and everything it writes
+// goes to a throwaway directory removed afterwards.
+func (pm *Manager) EngineBinaryValidate(ctx context.Context, req *rpc.EngineBinaryValidateRequest) (*rpc.EngineBinaryValidateResponse, error) {
+	if req.Binary == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing required argument")
+	}
+	binary, err := ensureValidProcessPath(req.Binary)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logrus.Infof("Process Manager: validating engine binary %v", binary)
+
+	workDir, err := os.MkdirTemp("", "engine-binary-validate-")
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to create validation directory").Error())
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			logrus.WithError(err).Warnf("Process Manager: failed to remove validation directory %v", workDir)
+		}
+	}()
+
+	resp := &rpc.EngineBinaryValidateResponse{}
+
+	checkEngineBinaryVersion(ctx, workDir, binary, resp)
+
+	for _, dryRunArgs := range req.DryRunArgs {
+		// The binary exits right after parsing the arguments if the help flag comes last, and fails on any
+		// undefined flag before it
+		args := append(append([]string{}, dryRunArgs.Args...), "--help")
+		_, err := runSandboxedCommand(ctx, workDir, binary, args...)
+		addEngineBinaryCheck(resp, EngineBinaryCheckDryRun, err, strings.Join(dryRunArgs.Args, " "))
+	}
+
+	if req.IoSelfTest {
+		addEngineBinaryCheck(resp, EngineBinaryCheckIOSelfTest, pm.selfTestEngineBinary(ctx, workDir, binary), "")
+	}
+
+	setEngineBinaryCompatible(binary, resp)
+	logrus.Infof("Process Manager: validated engine binary %v, compatible %v", binary, resp.Compatible)
+	return resp, nil
+}
+
+func addEngineBinaryCheck(resp *rpc.EngineBinaryValidateResponse, name string, err error, message string) {
+	check := &rpc.EngineBinaryCheck{
+		Name:    name,
+		Passed:  err == nil,
+		Message: message,
+	}
+	if err != nil {
+		check.Message = err.Error()
+	}
+	resp.Checks = append(resp.Checks, check)
+}
+
+func setEngineBinaryCompatible(binary string, resp *rpc.EngineBinaryValidateResponse) {
+	resp.Compatible = true
+	for _, check := range resp.Checks {
+		if !check.Passed {
+			resp.Compatible = false
+			logrus.Warnf("Process Manager: engine binary %v failed %v check: %v", binary, check.Name, check.Message)
+		}
+	}
+}
+
+// checkEngineBinaryVersion sets the version reported by the binary, and checks that the controller API and data
+// format versions of the instance manager are within the ranges supported by the binary.
+func checkEngineBinaryVersion(ctx context.Context, workDir, binary string, resp *rpc.EngineBinaryValidateResponse) {
+	version, err := getEngineBinaryVersion(ctx, workDir, binary)
+	addEngineBinaryCheck(resp, EngineBinaryCheckVersion, err, "")
+	if version == nil {
+		return
+	}
+
+	resp.Version = version.Version
+	resp.GitCommit = version.GitCommit
+	resp.BuildDate = version.BuildDate
+	resp.CliApiVersion = int64(version.CLIAPIVersion)
+	resp.CliApiMinVersion = int64(version.CLIAPIMinVersion)
+	resp.ControllerApiVersion = int64(version.ControllerAPIVersion)
+	resp.ControllerApiMinVersion = int64(version.ControllerAPIMinVersion)
+	resp.DataFormatVersion = int64(version.DataFormatVersion)
+	resp.DataFormatMinVersion = int64(version.DataFormatMinVersion)
+
+	addEngineBinaryCheck(resp, EngineBinaryCheckControllerAPI, checkVersionInRange("controller API", emeta.ControllerAPIVersion,
+		version.ControllerAPIMinVersion, version.ControllerAPIVersion), "")
+	addEngineBinaryCheck(resp, EngineBinaryCheckDataFormat, checkVersionInRange("data format", emeta.DataFormatVersion,
+		version.DataFormatMinVersion, version.DataFormatVersion), "")
+}
+
+func checkVersionInRange(name string, version, minVersion, maxVersion int) error {
+	if version < minVersion || version > maxVersion {
+		return fmt.Errorf("%v version %v of the instance manager is not in the supported range %v to %v", name, version, minVersion, maxVersion)
+	}
+	return nil
+}
+
+func getEngineBinaryVersion(ctx context.Context, workDir, binary string) (*emeta.VersionOutput, error) {
+	output, err := runSandboxedCommand(ctx, workDir, binary, "version", "--client-only")
+	if err != nil {
+		return nil, err
+	}
+
+	version := struct {
+		ClientVersion *emeta.VersionOutput `json:"clientVersion"`
+	}{}
+	if err := json.Unmarshal(output, &version); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse version output %v", truncateOutput(output))
+	}
+	if version.ClientVersion == nil {
+		return nil, fmt.Errorf("missing client version in version output %v", truncateOutput(output))
+	}
+	return version.ClientVersion, nil
+}
+
+// newSandboxedCommand returns the command running the binary by SpawnCommand with all the capabilities dropped and
+// no_new_privs set, in a new mount namespace whose mounts do not propagate back to the node. The binary runs in its
+// own process group within the work directory, with a minimal environment. The network namespace is shared with the
+// instance manager, since the self test replica listens on the ports of the node. The whole process group is killed
+// once the context is done.
+func newSandboxedCommand(ctx context.Context, workDir, binary string, args ...string) (*exec.Cmd, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find the instance manager binary to spawn the sandboxed command")
+	}
+
+	privileges := &rpc.ProcessPrivileges{NoNewPrivileges: true}
+	cmd := exec.CommandContext(ctx, self, getSpawnArgs(privileges, true, binary, args)...)
+	cmd.Dir = workDir
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + workDir,
+		"TMPDIR=" + workDir,
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:    true,
+		Pdeathsig:  syscall.SIGKILL,
+		Cloneflags: syscall.CLONE_NEWNS,
+	}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = time.Second
+	return cmd, nil
+}
+
+// runSandboxedCommand runs the command to completion and returns its stdout.
+func runSandboxedCommand(ctx context.Context, workDir, binary string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, engineBinaryCommandTimeout)
+	defer cancel()
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd, err := newSandboxedCommand(ctx, workDir, binary, args...)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "failed to run %v %v, output %v", filepath.Base(binary), strings.Join(args, " "),
+			truncateOutput(append(stdout.Bytes(), stderr.Bytes()...)))
+	}
+	return stdout.Bytes(), nil
+}
+
+func truncateOutput(output []byte) string {
+	s := strings.TrimSpace(string(output))
+	if len(s) > engineBinaryOutputLimit {
+		s = "..." + s[len(s)-engineBinaryOutputLimit:]
+	}
+	return s
+}
+
+// selfTestEngineBinary launches a throwaway replica with the binary on ports borrowed from the port range, then
+// opens and expands it, which writes the replica metadata and the volume head file.
+func (pm *Manager) selfTestEngineBinary(ctx context.Context, workDir, binary string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, engineBinarySelfTestTimeout)
+	defer cancel()
+
+	portStart, portEnd, err := pm.allocatePorts(engineBinarySelfTestPortCount)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := pm.releasePorts(portStart, portEnd); err != nil {
+			logrus.WithError(err).Warnf("Process Manager: failed to release ports %v-%v of engine binary self test", portStart, portEnd)
+		}
+	}()
+
+	replicaDir := filepath.Join(workDir, "replica")
+	if err := os.Mkdir(replicaDir, 0700); err != nil {
+		return errors.Wrap(err, "failed to create replica directory")
+	}
+
+	address := util.GetURL("localhost", int(portStart))
+	output := &bytes.Buffer{}
+	cmd, err := newSandboxedCommand(ctx, workDir, binary, "replica", "--listen", address,
+		"--size", strconv.Itoa(engineBinarySelfTestSize), replicaDir)
+	if err != nil {
+		return err
+	}
+	cmd.Stdout = output
+	cmd.Stderr = output
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "failed to start replica")
+	}
+	exitCh := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exitCh)
+	}()
+	defer func() {
+		cancel()
+		<-exitCh
+		if err != nil {
+			err = errors.Wrapf(err, "replica output %v", truncateOutput(output.Bytes()))
+		}
+	}()
+
+	if !pm.HealthChecker.WaitForRunning(address, "engine-binary-self-test", exitCh) {
+		return fmt.Errorf("replica failed to start at %v", address)
+	}
+
+	replicaClient, err := eclient.NewReplicaClient(address, "", "")
+	if err != nil {
+		return err
+	}
+	defer replicaClient.Close()
+
+	if err := replicaClient.OpenReplica(); err != nil {
+		return err
+	}
+	replica, err := replicaClient.ExpandReplica(engineBinarySelfTestExpandSize)
+	if err != nil {
+		return err
+	}
+	if replica.Size != strconv.Itoa(engineBinarySelfTestExpandSize) {
+		return fmt.Errorf("replica size %v after expansion does not match the expected size %v", replica.Size, engineBinarySelfTestExpandSize)
+	}
+	return replicaClient.CloseReplica()
+}
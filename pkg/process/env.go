@@ -0,0 +1,61 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// defaultEnvWhitelist are the environment variables of the instance manager always passed to the processes when
+// the environment isolation is enabled.
+var defaultEnvWhitelist = []string{"PATH", "HOME", "HOSTNAME", "LANG", "LC_ALL", "TZ", "TMPDIR"}
+
+// validateProcessEnvs validates the environment variables specified for a process.
+func validateProcessEnvs(envs map[string]string) error {
+	for key, value := range envs {
+		if key == "" || strings.ContainsAny(key, "=\x00") {
+			return fmt.Errorf("invalid environment variable name %q", key)
+		}
+		if strings.Contains(value, "\x00") {
+			return fmt.Errorf("invalid value of environment variable %v", key)
+		}
+	}
+	return nil
+}
+
+// getProcessEnv returns the environment of a process with the specified variables. It returns nil, which means
+// inheriting the whole environment of the instance manager, if the isolation is disabled and nothing is specified.
+// Otherwise, only the whitelisted variables are passed from the instance manager when the isolation is enabled.
+func (pm *Manager) getProcessEnv(envs map[string]string) []string {
+	if !pm.EnvIsolation && len(envs) == 0 {
+		return nil
+	}
+
+	env := []string{}
+	if pm.EnvIsolation {
+		whitelisted := map[string]struct{}{}
+		for _, key := range append(append([]string{}, defaultEnvWhitelist...), pm.EnvWhitelist...) {
+			if _, exists := whitelisted[key]; exists {
+				continue
+			}
+			whitelisted[key] = struct{}{}
+			if value, exists := os.LookupEnv(key); exists {
+				env = append(env, key+"="+value)
+			}
+		}
+	} else {
+		env = os.Environ()
+	}
+
+	keys := make([]string, 0, len(envs))
+	for key := range envs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	// The later one wins if a variable is duplicated
+	for _, key := range keys {
+		env = append(env, key+"="+envs[key])
+	}
+	return env
+}
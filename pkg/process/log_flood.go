@@ -0,0 +1,61 @@
+package process
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+)
+
+const LogFloodCheckInterval = time.Second
+
+func (pm *Manager) startLogFloodCheck() {
+	ticker := time.NewTicker(LogFloodCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pm.ctx.Done():
+			logrus.Infof("%s: stopped checking log floods due to the context done", types.ProcessManagerGrpcService)
+			return
+		case <-ticker.C:
+			if pm.LogFlood != nil {
+				pm.checkLogFloods()
+			}
+		}
+	}
+}
+
+// checkLogFloods updates the log flooding conditions of the processes, so that the flood is raised as a process
+// event to the watchers.
+func (pm *Manager) checkLogFloods() {
+	pm.lock.RLock()
+	defer pm.lock.RUnlock()
+
+	var processToUpdate []*Process
+	for _, p := range pm.processes {
+		p.lock.Lock()
+		if p.logger != nil {
+			flooding := p.logger.IsFlooding()
+			if p.Conditions[types.ProcessConditionLogFlooding] != flooding {
+				p.Conditions[types.ProcessConditionLogFlooding] = flooding
+				processToUpdate = append(processToUpdate, p)
+				if flooding {
+					logrus.Warnf("Process Manager: process %v is logging more than %v lines per second for %v",
+						p.Name, pm.LogFlood.MaxLinesPerSecond, pm.LogFlood.SustainedPeriod)
+					metrics.AddCounter(metrics.MetricProcessLogFloods, map[string]string{
+						"process": p.Name,
+					}, 1)
+				} else {
+					logrus.Infof("Process Manager: process %v stopped flooding the log", p.Name)
+				}
+			}
+		}
+		p.lock.Unlock()
+	}
+	for _, p := range processToUpdate {
+		p.publishUpdate()
+	}
+}
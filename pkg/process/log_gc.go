@@ -0,0 +1,71 @@
+package process
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	// DefaultProcessLogRetention is the time the logs of a deleted process are kept for. The logs are never removed
+	// by default
+	DefaultProcessLogRetention time.Duration = 0
+
+	processLogRemoveTaskType = "process-log-remove"
+)
+
+// EnableLogGC removes the log files of a deleted process after the retention via the deferred task queue, so that
+// the logs of the processes deleted before a restart are still removed. The logs of a process created again with
+// the same name in the meantime are kept.
+func (pm *Manager) EnableLogGC(taskQueue *util.TaskQueue, retention time.Duration) {
+	if taskQueue == nil || retention <= 0 {
+		return
+	}
+	pm.taskQueue = taskQueue
+	pm.logRetention = retention
+	taskQueue.RegisterHandler(processLogRemoveTaskType, pm.removeProcessLogs)
+}
+
+func (pm *Manager) scheduleProcessLogRemoval(name string) {
+	if pm.taskQueue == nil {
+		return
+	}
+	if _, err := pm.taskQueue.Enqueue(processLogRemoveTaskType, map[string]string{"name": name}, pm.logRetention); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to schedule the removal of the logs of process %v", name)
+	}
+}
+
+func (pm *Manager) removeProcessLogs(args map[string]string) error {
+	name := args["name"]
+	if name == "" {
+		return nil
+	}
+	pm.lock.RLock()
+	defer pm.lock.RUnlock()
+
+	if _, exists := pm.processes[name]; exists {
+		logrus.Infof("Process Manager: keeping the logs of process %v since it is created again", name)
+		return nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(pm.logsDir, getSidecarLogName(name, "*")+".log"))
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the sidecar logs of process %v", name)
+	}
+	names := []string{name}
+	for _, path := range paths {
+		names = append(names, strings.TrimSuffix(filepath.Base(path), ".log"))
+	}
+	for _, logName := range names {
+		if err := util.RemoveLogFiles(pm.logsDir, logName); err != nil {
+			return err
+		}
+	}
+	logrus.Infof("Process Manager: removed the logs of deleted process %v", name)
+	return nil
+}
@@ -0,0 +1,113 @@
+package process
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	PortEventAllocated = "allocated"
+	PortEventReleased  = "released"
+
+	maxPortEventHistory = 256
+	portEventBufferSize = 1024
+)
+
+// recordPortEvent records the allocation or the release of the ports of a process, and notifies the port event
+// watchers, e.g. the firewall automation opening and closing the node ports along with the processes.
+func (pm *Manager) recordPortEvent(action, processName, processUUID string, portStart, portEnd int32) {
+	if portStart == 0 && portEnd == 0 {
+		return
+	}
+
+	event := &rpc.ProcessPortEvent{
+		Action:      action,
+		ProcessName: processName,
+		ProcessUuid: processUUID,
+		PortStart:   portStart,
+		PortEnd:     portEnd,
+		Time:        time.Now().UTC().Format(time.RFC3339Nano),
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"action":      action,
+		"processName": processName,
+		"processUUID": processUUID,
+		"portStart":   portStart,
+		"portEnd":     portEnd,
+	}).Info("Process Manager: Recorded port event")
+
+	pm.portEventLock.Lock()
+	pm.portEvents = append(pm.portEvents, event)
+	if len(pm.portEvents) > maxPortEventHistory {
+		pm.portEvents = pm.portEvents[len(pm.portEvents)-maxPortEventHistory:]
+	}
+	pm.portEventLock.Unlock()
+
+	// The ports are allocated and released with the process lock held, so never block on the watchers
+	select {
+	case pm.portEventCh <- interface{}(event):
+	default:
+		logrus.Warnf("Process Manager: Dropped port event %v of ports %v-%v of process %v since the watchers are too slow",
+			action, portStart, portEnd, processName)
+	}
+}
+
+func (pm *Manager) portEventConnector() (chan interface{}, error) {
+	return pm.portEventCh, nil
+}
+
+// ProcessPortEventList returns the latest port events, so that a watcher can catch up with the ones missed
+// before it starts watching.
+func (pm *Manager) ProcessPortEventList(ctx context.Context, req *emptypb.Empty) (*rpc.ProcessPortEventListResponse, error) {
+	pm.portEventLock.Lock()
+	defer pm.portEventLock.Unlock()
+
+	return &rpc.ProcessPortEventListResponse{
+		Events: append([]*rpc.ProcessPortEvent{}, pm.portEvents...),
+	}, nil
+}
+
+func (pm *Manager) ProcessPortEventWatch(req *emptypb.Empty, srv rpc.ProcessManagerService_ProcessPortEventWatchServer) (err error) {
+	responseChan, err := pm.portEventBroadcaster.Subscribe(srv.Context(), pm.portEventConnector)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			logrus.WithError(err).Error("Process manager port event watch errored out")
+		} else {
+			logrus.Info("Process manager port event watch ended successfully")
+		}
+	}()
+	logrus.Info("Started new process manager port event watch")
+
+	dropCh := chaos.WatchStreamDropped()
+	for {
+		select {
+		case <-dropCh:
+			return status.Error(codes.Unavailable, "chaos: port event watch stream is dropped")
+		case resp, ok := <-responseChan:
+			if !ok {
+				return nil
+			}
+			event, ok := resp.(*rpc.ProcessPortEvent)
+			if !ok {
+				return fmt.Errorf("BUG: cannot get ProcessPortEvent from channel")
+			}
+			if err := srv.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	// PortDiscrepancyUnrecorded means the ports assigned to a managed process are not allocated in the allocator.
+	PortDiscrepancyUnrecorded = "unrecorded"
+	// PortDiscrepancyOutOfRange means a managed process listens on a port of the port range that is not assigned to it.
+	PortDiscrepancyOutOfRange = "out-of-range"
+	// PortDiscrepancyConflict means a managed process listens on a port assigned to another managed process.
+	PortDiscrepancyConflict = "conflict"
+	// PortDiscrepancyForeign means a process other than the managed processes listens on a port of the port range.
+	PortDiscrepancyForeign = "foreign"
+	// PortDiscrepancyLeaked means the ports are allocated in the allocator but not listened on by any process.
+	PortDiscrepancyLeaked = "leaked"
+)
+
+// PortReconcile rebuilds the view of the port allocator from the listening sockets of the node. The ports assigned to
+// the managed processes and the ports listened on in the port range are allocated, and the allocated ports neither
+// assigned nor listened on are released. A socket held by a process other than the managed processes and their
+// children is reported as foreign. All the discrepancies found are reported, and left untouched if DryRun is set.
+func (pm *Manager) PortReconcile(ctx context.Context, req *rpc.PortReconcileRequest) (*rpc.PortReconcileResponse, error) {
+	logrus.Infof("Process Manager: reconciling port allocation, dry run %v", req.DryRun)
+
+	// Walking /proc takes a while, so the sockets are collected before the lock is taken
+	sockets, err := util.GetListeningSockets(pm.portRangeMin, pm.portRangeMax)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get listening sockets").Error())
+	}
+
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	processes := []*Process{}
+	for _, p := range pm.processes {
+		processes = append(processes, p)
+	}
+	for _, p := range pm.replacementProcesses {
+		processes = append(processes, p)
+	}
+	sort.Slice(processes, func(i, j int) bool { return processes[i].Name < processes[j].Name })
+
+	discrepancies := []*rpc.PortDiscrepancy{}
+	report := func(d *rpc.PortDiscrepancy) {
+		discrepancies = append(discrepancies, d)
+		logrus.Warnf("Process Manager: found %v port discrepancy %v-%v of process %q: %v, repaired %v",
+			d.Type, d.PortStart, d.PortEnd, d.ProcessName, d.Message, d.Repaired)
+	}
+
+	owners := map[int32]string{}
+	managed := map[int]*Process{}
+	for _, p := range processes {
+		if pid := p.Pid(); pid > 0 && !p.IsStopped() {
+			managed[pid] = p
+		}
+		if p.PortCount == 0 || p.PortStart == 0 {
+			continue
+		}
+
+		unrecorded := []int32{}
+		for port := p.PortStart; port <= p.PortEnd; port++ {
+			owners[port] = p.Name
+			if !pm.availablePorts.IsAllocated(port) {
+				unrecorded = append(unrecorded, port)
+			}
+		}
+		for _, r := range groupPortRanges(unrecorded) {
+			report(pm.repairPortRange(&rpc.PortDiscrepancy{
+				Type:        PortDiscrepancyUnrecorded,
+				ProcessName: p.Name,
+				PortStart:   r[0],
+				PortEnd:     r[1],
+				Message:     "ports assigned to the process are not allocated",
+			}, req.DryRun, false))
+		}
+	}
+
+	ports := []int32{}
+	for port := range sockets.Pids {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	for _, port := range ports {
+		owner, owned := owners[port]
+		foreignPids := []int{}
+		for _, pid := range sockets.Pids[port] {
+			p := findManagedProcess(managed, sockets.Parents, pid)
+			switch {
+			case p == nil:
+				foreignPids = append(foreignPids, pid)
+			case owner == p.Name:
+			case owned:
+				report(&rpc.PortDiscrepancy{
+					Type:        PortDiscrepancyConflict,
+					ProcessName: p.Name,
+					PortStart:   port,
+					PortEnd:     port,
+					Message:     fmt.Sprintf("the process listens on port %v assigned to process %v", port, owner),
+				})
+			default:
+				report(pm.repairPortRange(&rpc.PortDiscrepancy{
+					Type:        PortDiscrepancyOutOfRange,
+					ProcessName: p.Name,
+					PortStart:   port,
+					PortEnd:     port,
+					Message:     fmt.Sprintf("the process listens on port %v out of its assigned range %v-%v", port, p.PortStart, p.PortEnd),
+				}, req.DryRun || pm.availablePorts.IsAllocated(port), false))
+			}
+		}
+		if len(foreignPids) == 0 {
+			continue
+		}
+		if owned {
+			report(&rpc.PortDiscrepancy{
+				Type:        PortDiscrepancyConflict,
+				ProcessName: owner,
+				PortStart:   port,
+				PortEnd:     port,
+				Message:     fmt.Sprintf("port %v assigned to the process is listened on by unmanaged processes %v", port, foreignPids),
+			})
+			continue
+		}
+		report(pm.repairPortRange(&rpc.PortDiscrepancy{
+			Type:      PortDiscrepancyForeign,
+			PortStart: port,
+			PortEnd:   port,
+			Message:   fmt.Sprintf("port %v is listened on by unmanaged processes %v", port, foreignPids),
+		}, req.DryRun || pm.availablePorts.IsAllocated(port), false))
+	}
+
+	leaked := []int32{}
+	for _, port := range pm.availablePorts.AllocatedPorts() {
+		if _, owned := owners[port]; owned {
+			continue
+		}
+		if _, used := sockets.Pids[port]; used {
+			continue
+		}
+		leaked = append(leaked, port)
+	}
+	for _, r := range groupPortRanges(leaked) {
+		report(pm.repairPortRange(&rpc.PortDiscrepancy{
+			Type:      PortDiscrepancyLeaked,
+			PortStart: r[0],
+			PortEnd:   r[1],
+			Message:   "ports are allocated without any process using them",
+		}, req.DryRun, true))
+	}
+
+	logrus.Infof("Process Manager: reconciled port allocation with %v discrepancies", len(discrepancies))
+	return &rpc.PortReconcileResponse{
+		Discrepancies: discrepancies,
+	}, nil
+}
+
+// findManagedProcess returns the managed process of the pid or of its closest managed ancestor, since the socket
+// may be held by a child of the managed process.
+func findManagedProcess(managed map[int]*Process, parents map[int]int, pid int) *Process {
+	visited := map[int]struct{}{}
+	for pid > 1 {
+		if p, exists := managed[pid]; exists {
+			return p
+		}
+		if _, exists := visited[pid]; exists {
+			return nil
+		}
+		visited[pid] = struct{}{}
+		pid = parents[pid]
+	}
+	return nil
+}
+
+// repairPortRange allocates or releases the port range of the discrepancy unless skip is set. It must be called
+// with the manager lock held.
+func (pm *Manager) repairPortRange(d *rpc.PortDiscrepancy, skip, release bool) *rpc.PortDiscrepancy {
+	if skip {
+		return d
+	}
+
+	var err error
+	if release {
+		err = pm.availablePorts.ReleaseRange(d.PortStart, d.PortEnd)
+	} else {
+		err = pm.availablePorts.AllocateSpecificRange(d.PortStart, d.PortEnd)
+	}
+	if err != nil {
+		d.Message = fmt.Sprintf("%v, failed to repair: %v", d.Message, err)
+		return d
+	}
+	d.Repaired = true
+
+	action := PortEventAllocated
+	if release {
+		action = PortEventReleased
+	}
+	pm.recordPortEvent(action, d.ProcessName, "", d.PortStart, d.PortEnd)
+	return d
+}
+
+// groupPortRanges groups the sorted ports into ranges of consecutive ports.
+func groupPortRanges(ports []int32) [][2]int32 {
+	ranges := [][2]int32{}
+	for _, port := range ports {
+		if len(ranges) > 0 && ranges[len(ranges)-1][1]+1 == port {
+			ranges[len(ranges)-1][1] = port
+			continue
+		}
+		ranges = append(ranges, [2]int32{port, port})
+	}
+	return ranges
+}
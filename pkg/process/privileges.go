@@ -0,0 +1,32 @@
+package process
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+func validateProcessPrivileges(privileges *rpc.ProcessPrivileges) error {
+	if privileges == nil {
+		return nil
+	}
+	_, err := util.ParseCapabilities(privileges.Capabilities)
+	return err
+}
+
+// getSpawnCommand returns the command the process is started with, which is the binary of the process itself unless
+// its privileges are restricted.
+func (p *Process) getSpawnCommand() (string, []string, error) {
+	if p.Privileges == nil {
+		return p.Binary, p.Args, nil
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to find the instance manager binary to spawn the process with restricted privileges")
+	}
+	return self, getSpawnArgs(p.Privileges, false, p.Binary, p.Args), nil
+}
@@ -1,12 +1,15 @@
 package process
 
 import (
+	"fmt"
+	"os"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 	"github.com/longhorn/longhorn-instance-manager/pkg/types"
 	"github.com/longhorn/longhorn-instance-manager/pkg/util"
@@ -15,11 +18,20 @@ import (
 type State string
 
 const (
-	StateStarting = State(types.ProcessStateStarting)
-	StateRunning  = State(types.ProcessStateRunning)
-	StateStopping = State(types.ProcessStateStopping)
-	StateStopped  = State(types.ProcessStateStopped)
-	StateError    = State(types.ProcessStateError)
+	StateStarting  = State(types.ProcessStateStarting)
+	StateRunning   = State(types.ProcessStateRunning)
+	StateStopping  = State(types.ProcessStateStopping)
+	StateStopped   = State(types.ProcessStateStopped)
+	StateError     = State(types.ProcessStateError)
+	StateSuspended = State(types.ProcessStateSuspended)
+)
+
+const (
+	priorityClassApplyInterval = 10 * time.Millisecond
+	priorityClassApplyTimeout  = 5 * time.Second
+
+	// processKillTimeout is the time the process is given to exit once killed for not stopping gracefully in time
+	processKillTimeout = 10 * time.Second
 )
 
 type Process struct {
@@ -28,6 +40,24 @@ type Process struct {
 	Args      []string
 	PortCount int32
 	PortArgs  []string
+	Sidecars  []*Sidecar
+	Envs      map[string]string
+	Labels    map[string]string
+	// PriorityClass sets the OOM score adjustment and nice value of the process once started
+	PriorityClass string
+	// CPUMillicores and MemoryBytes limit the process along with its children by a cgroup of the process once
+	// started. Unlimited if 0
+	CPUMillicores int64
+	MemoryBytes   int64
+	// CPUSet and NUMANode pin the process along with its children to the CPUs once started. Not pinned if empty
+	CPUSet   string
+	NUMANode string
+	// Privileges restricts the capabilities of the process at spawn. The process inherits the full privileges of the
+	// instance manager if nil
+	Privileges *rpc.ProcessPrivileges
+	// StopTimeout is the time the process is given to stop gracefully on deletion before it is killed. The default
+	// if 0
+	StopTimeout time.Duration
 
 	UUID       string
 	State      State
@@ -35,10 +65,23 @@ type Process struct {
 	Conditions map[string]bool
 	PortStart  int32
 	PortEnd    int32
+	// stoppingAt is when the process started stopping, and stoppingTimeout is the time it is given to stop gracefully
+	stoppingAt      time.Time
+	stoppingTimeout time.Duration
+	// logPipePath is the named pipe the output of the process goes through to the logger, so that the process can
+	// outlive the instance manager and be adopted after it restarts. The output goes to the logger directly if empty
+	logPipePath string
+	// logReader is the read end of the log pipe the output is stored from, and logForwarded is closed once it is no
+	// longer read
+	logReader    *os.File
+	logForwarded chan struct{}
+	// logHandoff is the state of the logger handed off to the next instance manager
+	logHandoff *util.LogHandoff
 
-	lock     *sync.RWMutex
-	cmd      Command
-	UpdateCh chan *Process
+	lock    *sync.RWMutex
+	cmd     Command
+	env     []string
+	updates *processUpdateQueue
 
 	logger *util.LonghornWriter
 
@@ -50,18 +93,53 @@ func (p *Process) Start() error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
-	cmd, err := p.executor.NewCommand(p.Binary, p.Args...)
+	binary, args, err := p.getSpawnCommand()
+	if err != nil {
+		p.State = StateError
+		p.ErrorMsg = err.Error()
+		return err
+	}
+	cmd, err := p.executor.NewCommand(binary, args...)
 	if err != nil {
 		p.State = StateError
 		p.ErrorMsg = err.Error()
 		return err
 	}
-	cmd.SetOutput(p.logger)
+	var outputFiles []*os.File
+	if p.logPipePath != "" {
+		reader, writer, keeper, err := createLogPipe(p.logPipePath)
+		if err != nil {
+			p.State = StateError
+			p.ErrorMsg = err.Error()
+			return err
+		}
+		cmd.SetOutput(writer)
+		cmd.Detach(keeper)
+		outputFiles = []*os.File{writer, keeper}
+		p.startLogForwarding(reader)
+	} else {
+		cmd.SetOutput(p.logger)
+	}
+	cmd.SetEnv(p.env)
 	p.cmd = cmd
 
+	p.run(outputFiles, false)
+	return nil
+}
+
+// run waits for the command of the process to exit, and for the process to get running if it is starting. The
+// output files passed to the command are closed once it exits. The post start settings are skipped for the adopted process, which
+// already has them.
+func (p *Process) run(outputFiles []*os.File, adopted bool) {
+	cmd := p.cmd
 	probeStopCh := make(chan struct{})
 	go func() {
-		if err := cmd.Run(); err != nil {
+		defer p.releaseResourceLimits()
+		err := cmd.Run()
+		for _, file := range outputFiles {
+			file.Close()
+		}
+		if err != nil {
 			close(probeStopCh)
 			p.lock.Lock()
 			p.State = StateError
@@ -69,7 +147,8 @@ func (p *Process) Start() error {
 			logrus.Infof("Process Manager: process %v error out, error msg: %v", p.Name, p.ErrorMsg)
 			p.lock.Unlock()
 
-			p.UpdateCh <- p
+			p.publishUpdate()
+			p.stopSidecars()
 			return
 		}
 		close(probeStopCh)
@@ -78,17 +157,39 @@ func (p *Process) Start() error {
 		logrus.Infof("Process Manager: process %v stopped", p.Name)
 		p.lock.Unlock()
 
-		p.UpdateCh <- p
+		p.publishUpdate()
+		p.stopSidecars()
 	}()
 
+	if !adopted {
+		go p.applyPriorityClass()
+		go p.applyResourceLimits()
+		go p.applyCPUAffinity()
+		if p.logPipePath != "" {
+			// The process is persisted for the adoption once its pid is known
+			go func() {
+				if p.waitForPid() != 0 {
+					p.publishUpdate()
+				}
+			}()
+		}
+	}
+
+	// The adopted process may be running already
+	running := adopted && p.State != StateStarting
 	go func() {
+		if running {
+			p.startSidecars()
+			return
+		}
 		if p.PortStart != 0 {
 			address := util.GetURL("localhost", int(p.PortStart))
 			if p.healthChecker.WaitForRunning(address, p.Name, probeStopCh) {
 				p.lock.Lock()
 				p.State = StateRunning
 				p.lock.Unlock()
-				p.UpdateCh <- p
+				p.publishUpdate()
+				p.startSidecars()
 				return
 			}
 			// fail to start the process, then try to stop it.
@@ -100,11 +201,10 @@ func (p *Process) Start() error {
 			p.lock.Lock()
 			p.State = StateRunning
 			p.lock.Unlock()
-			p.UpdateCh <- p
+			p.publishUpdate()
+			p.startSidecars()
 		}
 	}()
-
-	return nil
 }
 
 func (p *Process) RPCResponse() *rpc.ProcessResponse {
@@ -113,6 +213,10 @@ func (p *Process) RPCResponse() *rpc.ProcessResponse {
 	if p.ErrorMsg != "" {
 		logrus.Warnf("Process update: %v: state %v: errorMsg: %v", p.Name, p.State, p.ErrorMsg)
 	}
+	sidecarStatuses := []*rpc.ProcessSidecarStatus{}
+	for _, s := range p.Sidecars {
+		sidecarStatuses = append(sidecarStatuses, s.status())
+	}
 	return &rpc.ProcessResponse{
 		Spec: &rpc.ProcessSpec{
 			Name:      p.Name,
@@ -120,6 +224,18 @@ func (p *Process) RPCResponse() *rpc.ProcessResponse {
 			Args:      p.Args,
 			PortCount: p.PortCount,
 			PortArgs:  p.PortArgs,
+			Sidecars:  p.sidecarSpecs(),
+			Envs:      p.Envs,
+			Labels:    p.Labels,
+
+			PriorityClass: p.PriorityClass,
+			CpuMillicores: p.CPUMillicores,
+			MemoryBytes:   p.MemoryBytes,
+			CpuSet:        p.CPUSet,
+			NumaNode:      p.NUMANode,
+			Privileges:    p.Privileges,
+
+			StopTimeoutSeconds: int64(p.StopTimeout / time.Second),
 		},
 
 		Status: &rpc.ProcessStatus{
@@ -128,27 +244,122 @@ func (p *Process) RPCResponse() *rpc.ProcessResponse {
 			PortStart:  p.PortStart,
 			PortEnd:    p.PortEnd,
 			Conditions: p.Conditions,
+			Sidecars:   sidecarStatuses,
+			Uuid:       p.UUID,
 		},
 	}
 }
 
+// applyPriorityClass sets the priority of the process once it is started. The threads started by the process before
+// then keep the nice value they are started with.
+func (p *Process) applyPriorityClass() {
+	if _, ok := util.PriorityClassToProcessPriority(p.PriorityClass); !ok {
+		return
+	}
+
+	pid := p.waitForPid()
+	if pid == 0 {
+		logrus.Warnf("Process Manager: process %v is not started in %v, cannot set its priority class %v",
+			p.Name, priorityClassApplyTimeout, p.PriorityClass)
+		return
+	}
+	if err := util.SetProcessPriorityClass(pid, p.PriorityClass); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to set priority class %v of process %v", p.PriorityClass, p.Name)
+		return
+	}
+	logrus.Infof("Process Manager: set priority class %v of process %v", p.PriorityClass, p.Name)
+}
+
+// applyCPUAffinity pins the process along with its children to the CPUs once it is started, e.g. away from the cores
+// of the SPDK pollers. The process is left running unpinned if it fails.
+func (p *Process) applyCPUAffinity() {
+	if p.CPUSet == "" && p.NUMANode == "" {
+		return
+	}
+
+	cpus, err := util.GetCPUAffinity(p.CPUSet, p.NUMANode)
+	if err != nil {
+		logrus.WithError(err).Warnf("Process Manager: cannot find the CPUs to pin process %v to", p.Name)
+		return
+	}
+	pid := p.waitForPid()
+	if pid == 0 {
+		logrus.Warnf("Process Manager: process %v is not started in %v, cannot pin it to CPUs %v",
+			p.Name, priorityClassApplyTimeout, cpus)
+		return
+	}
+	if err := util.SetProcessTreeAffinity(pid, cpus); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to pin process %v to CPUs %v", p.Name, cpus)
+		return
+	}
+	logrus.Infof("Process Manager: pinned process %v to CPUs %v", p.Name, cpus)
+}
+
+// waitForPid returns the pid of the process once it is started, or 0 if it is not started in time.
+func (p *Process) waitForPid() int {
+	deadline := time.Now().Add(priorityClassApplyTimeout)
+	pid := p.Pid()
+	for pid == 0 {
+		if time.Now().After(deadline) {
+			return 0
+		}
+		time.Sleep(priorityClassApplyInterval)
+		pid = p.Pid()
+	}
+	return pid
+}
+
 func (p *Process) Stop() {
 	p.StopWithSignal(syscall.SIGINT)
 }
 
 func (p *Process) StopWithSignal(signal syscall.Signal) {
+	p.stop(signal, 0)
+}
+
+// StopWithTimeout stops the process, giving it the timeout rather than its own stop timeout to stop gracefully
+// before it is killed. The stop timeout of the process applies if the timeout is 0.
+func (p *Process) StopWithTimeout(timeout time.Duration) {
+	p.stop(syscall.SIGINT, timeout)
+}
+
+func validateStopTimeout(seconds int64) error {
+	if seconds < 0 {
+		return fmt.Errorf("invalid stop timeout %vs", seconds)
+	}
+	return nil
+}
+
+// getStopTimeout returns the time the process is given to stop gracefully, which is the timeout if specified, or the
+// stop timeout of the process or the default otherwise.
+func (p *Process) getStopTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	if p.StopTimeout > 0 {
+		return p.StopTimeout
+	}
+	return time.Duration(types.WaitCount) * types.WaitInterval
+}
+
+func (p *Process) stop(signal syscall.Signal, timeout time.Duration) {
 	needStop := false
+	suspended := false
 	p.lock.Lock()
 	if p.State != StateStopping && p.State != StateStopped && p.State != StateError {
+		suspended = p.State == StateSuspended
 		p.State = StateStopping
+		p.stoppingAt = time.Now()
+		p.stoppingTimeout = p.getStopTimeout(timeout)
 		needStop = true
 	}
+	stopTimeout := p.stoppingTimeout
 	p.lock.Unlock()
 
 	if !needStop {
 		return
 	}
-	p.UpdateCh <- p
+	p.publishUpdate()
 
 	p.lock.RLock()
 	cmd := p.cmd
@@ -161,7 +372,10 @@ func (p *Process) StopWithSignal(signal syscall.Signal) {
 			}
 		}()
 
-		if cmd == nil || !cmd.Started() {
+		// The sidecars may depend on the process, so stop them first
+		p.stopSidecars()
+
+		if cmd == nil || !p.waitForCommandStarted(cmd, stopTimeout) {
 			logrus.Errorf("Process Manager: cmd of %v hasn't started, no need to stop", p.Name)
 			return
 		}
@@ -169,18 +383,151 @@ func (p *Process) StopWithSignal(signal syscall.Signal) {
 		// no need for lock
 		logrus.Infof("Process Manager: trying to stop process %v", p.Name)
 		cmd.StopWithSignal(signal)
-		for i := 0; i < types.WaitCount; i++ {
+		if suspended {
+			// A stopped process cannot handle the signal until it continues
+			cmd.StopWithSignal(syscall.SIGCONT)
+		}
+		deadline := time.Now().Add(stopTimeout)
+		for time.Now().Before(deadline) {
 			if p.IsStopped() {
 				return
 			}
 			logrus.Infof("Wait for process %v to shutdown", p.Name)
 			time.Sleep(types.WaitInterval)
 		}
-		logrus.Warnf("Process Manager: cannot graceful stop process %v in %v, will kill the process", p.Name, time.Duration(types.WaitCount)*types.WaitInterval)
+		logrus.Warnf("Process Manager: cannot graceful stop process %v in %v, will kill the process", p.Name, stopTimeout)
 		cmd.Kill()
 	}()
 }
 
+// waitForCommandStarted waits for the command to start, since the process may be stopped right after it is started
+// and before its command runs. It returns false if the command fails to run or doesn't start in time.
+func (p *Process) waitForCommandStarted(cmd Command, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for !cmd.Started() {
+		if p.IsStopped() || time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(types.WaitInterval)
+	}
+	return true
+}
+
+// waitForStopped waits for the stopping process to stop, and gives up a while after the process is killed for not
+// stopping gracefully in time. It returns true if the process is stopped.
+func (p *Process) waitForStopped() bool {
+	p.lock.RLock()
+	deadline := p.stoppingAt.Add(p.stoppingTimeout + processKillTimeout)
+	p.lock.RUnlock()
+
+	for !p.IsStopped() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(types.WaitInterval)
+	}
+	return true
+}
+
+// Suspend stops the running process by SIGSTOP, e.g. for quiescing it during a node maintenance. The sidecars are
+// left running.
+func (p *Process) Suspend() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.State != StateRunning {
+		return fmt.Errorf("cannot suspend process %v in state %v", p.Name, p.State)
+	}
+	if p.cmd == nil || !p.cmd.Started() {
+		return fmt.Errorf("cmd of %v hasn't started", p.Name)
+	}
+	p.cmd.StopWithSignal(syscall.SIGSTOP)
+	p.State = StateSuspended
+	logrus.Infof("Process Manager: suspended process %v", p.Name)
+	return nil
+}
+
+// Resume continues the suspended process by SIGCONT.
+func (p *Process) Resume() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.State != StateSuspended {
+		return fmt.Errorf("cannot resume process %v in state %v", p.Name, p.State)
+	}
+	p.cmd.StopWithSignal(syscall.SIGCONT)
+	p.State = StateRunning
+	logrus.Infof("Process Manager: resumed process %v", p.Name)
+	return nil
+}
+
+// Crash kills the process directly. Unlike Stop, the state is left untouched so that the process ends up in
+// the error state once it exits.
+func (p *Process) Crash() error {
+	p.lock.RLock()
+	cmd := p.cmd
+	p.lock.RUnlock()
+
+	if cmd == nil || !cmd.Started() {
+		return fmt.Errorf("cmd of %v hasn't started", p.Name)
+	}
+	cmd.Kill()
+	return nil
+}
+
+// resourceUsage returns the resource usage of the process if it is alive, or nil otherwise.
+func (p *Process) resourceUsage() *rpc.ResourceUsage {
+	p.lock.RLock()
+	state := p.State
+	p.lock.RUnlock()
+	if state != StateStarting && state != StateRunning && state != StateSuspended {
+		return nil
+	}
+
+	pid := p.Pid()
+	if pid <= 0 {
+		return nil
+	}
+	usage, err := util.GetProcessResourceUsage(pid)
+	if err != nil {
+		logrus.WithError(err).Debugf("Process Manager: failed to get resource usage of process %v", p.Name)
+		return nil
+	}
+	return api.ProcessResourceUsageToRPC(usage, false)
+}
+
+// Pid returns 0 if the process hasn't started.
+func (p *Process) Pid() int {
+	p.lock.RLock()
+	cmd := p.cmd
+	p.lock.RUnlock()
+
+	if cmd == nil {
+		return 0
+	}
+	return cmd.Pid()
+}
+
+// refresh marks the running process as errored if it no longer exists on the node, e.g. it is killed while its
+// exit is not observed yet. The caller must hold the process lock.
+func (p *Process) refresh() {
+	if p.State != StateStarting && p.State != StateRunning && p.State != StateSuspended {
+		return
+	}
+	if p.cmd == nil || !p.cmd.Started() {
+		return
+	}
+	pid := p.cmd.Pid()
+	if pid <= 0 {
+		return
+	}
+	if err := syscall.Kill(pid, 0); err == syscall.ESRCH {
+		p.State = StateError
+		p.ErrorMsg = fmt.Sprintf("process %v with pid %v no longer exists", p.Name, pid)
+		logrus.Warnf("Process Manager: %v", p.ErrorMsg)
+	}
+}
+
 func (p *Process) IsStopped() bool {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
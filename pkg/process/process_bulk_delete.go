@@ -0,0 +1,267 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	DefaultBulkDeleteConcurrency = 10
+
+	BulkDeleteOperationRetention = 1 * time.Hour
+
+	BulkDeleteStateInProgress = "in-progress"
+	BulkDeleteStateComplete   = "complete"
+	BulkDeleteStateError      = "error"
+)
+
+// BulkDeleteOperation tracks the progress of a ProcessBulkDelete call.
+type BulkDeleteOperation struct {
+	lock *sync.RWMutex
+
+	ID      string
+	State   string
+	Total   int32
+	Deleted int32
+	Failed  int32
+	Errors  map[string]string
+
+	completedAt time.Time
+	doneCh      chan struct{}
+}
+
+func newBulkDeleteOperation(total int) *BulkDeleteOperation {
+	return &BulkDeleteOperation{
+		lock: &sync.RWMutex{},
+
+		ID:     util.UUID(),
+		State:  BulkDeleteStateInProgress,
+		Total:  int32(total),
+		Errors: map[string]string{},
+
+		doneCh: make(chan struct{}),
+	}
+}
+
+func (op *BulkDeleteOperation) record(name string, err error) {
+	op.lock.Lock()
+	defer op.lock.Unlock()
+
+	if err != nil {
+		op.Failed++
+		op.Errors[name] = err.Error()
+		return
+	}
+	op.Deleted++
+}
+
+func (op *BulkDeleteOperation) finish() {
+	op.lock.Lock()
+	defer op.lock.Unlock()
+
+	op.State = BulkDeleteStateComplete
+	if op.Failed > 0 {
+		op.State = BulkDeleteStateError
+	}
+	op.completedAt = time.Now()
+	close(op.doneCh)
+}
+
+func (op *BulkDeleteOperation) isExpired() bool {
+	op.lock.RLock()
+	defer op.lock.RUnlock()
+
+	return op.State != BulkDeleteStateInProgress && time.Since(op.completedAt) > BulkDeleteOperationRetention
+}
+
+// Done returns a channel that is closed once all processes of the operation are handled.
+func (op *BulkDeleteOperation) Done() <-chan struct{} {
+	return op.doneCh
+}
+
+func (op *BulkDeleteOperation) RPCResponse() *rpc.ProcessBulkDeleteResponse {
+	op.lock.RLock()
+	defer op.lock.RUnlock()
+
+	errs := map[string]string{}
+	for name, msg := range op.Errors {
+		errs[name] = msg
+	}
+	return &rpc.ProcessBulkDeleteResponse{
+		OperationId: op.ID,
+		State:       op.State,
+		Total:       op.Total,
+		Deleted:     op.Deleted,
+		Failed:      op.Failed,
+		Errors:      errs,
+	}
+}
+
+// ProcessBulkDelete stops and deletes the processes named by the request, or all processes if no name is specified.
+// The deletion runs in the background with a pool of workers. For each volume the engine processes are stopped
+// before the replica processes. The returned operation ID can be used to query the progress.
+func (pm *Manager) ProcessBulkDelete(ctx context.Context, req *rpc.ProcessBulkDeleteRequest) (*rpc.ProcessBulkDeleteResponse, error) {
+	if err := validateStopTimeout(req.StopTimeoutSeconds); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	op, err := pm.startBulkDelete(req.Names, int(req.Concurrency), time.Duration(req.StopTimeoutSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return op.RPCResponse(), nil
+}
+
+// ProcessBulkDeleteStatusGet returns the progress of a bulk delete operation.
+// If the operation doesn't exist or has expired, the call will return with ErrorNotFound
+func (pm *Manager) ProcessBulkDeleteStatusGet(ctx context.Context, req *rpc.ProcessBulkDeleteStatusGetRequest) (*rpc.ProcessBulkDeleteResponse, error) {
+	if req.OperationId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing required argument operation ID")
+	}
+
+	pm.bulkDeleteLock.RLock()
+	op := pm.bulkDeleteOperations[req.OperationId]
+	pm.bulkDeleteLock.RUnlock()
+	if op == nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find bulk delete operation %v", req.OperationId)
+	}
+
+	return op.RPCResponse(), nil
+}
+
+func (pm *Manager) startBulkDelete(names []string, concurrency int, stopTimeout time.Duration) (*BulkDeleteOperation, error) {
+	if concurrency < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid concurrency %v", concurrency)
+	}
+	if concurrency == 0 {
+		concurrency = DefaultBulkDeleteConcurrency
+	}
+
+	var processes []*Process
+	var missing []string
+	pm.lock.RLock()
+	if len(names) == 0 {
+		for _, p := range pm.processes {
+			processes = append(processes, p)
+		}
+	} else {
+		for _, name := range names {
+			if p, exists := pm.processes[name]; exists {
+				processes = append(processes, p)
+			} else {
+				missing = append(missing, name)
+			}
+		}
+	}
+	pm.lock.RUnlock()
+
+	op := newBulkDeleteOperation(len(processes) + len(missing))
+	for _, name := range missing {
+		op.record(name, fmt.Errorf("cannot find process %v", name))
+	}
+
+	pm.bulkDeleteLock.Lock()
+	for id, existing := range pm.bulkDeleteOperations {
+		if existing.isExpired() {
+			delete(pm.bulkDeleteOperations, id)
+		}
+	}
+	pm.bulkDeleteOperations[op.ID] = op
+	pm.bulkDeleteLock.Unlock()
+
+	logrus.Infof("Process Manager: starting bulk delete operation %v for %v processes with concurrency %v", op.ID, op.Total, concurrency)
+	go pm.runBulkDelete(op, processes, concurrency, stopTimeout)
+
+	return op, nil
+}
+
+func (pm *Manager) runBulkDelete(op *BulkDeleteOperation, processes []*Process, concurrency int, stopTimeout time.Duration) {
+	var engines, replicas []*Process
+	for _, p := range processes {
+		if isEngineProcess(p) {
+			engines = append(engines, p)
+		} else {
+			replicas = append(replicas, p)
+		}
+	}
+
+	// The replica processes of a volume wait for all engine processes of the same volume to be stopped.
+	// Jobs are dequeued in order and all engines are queued first, so a waiting replica job never blocks
+	// an engine job from being picked up by another worker.
+	engineStopped := map[string]*sync.WaitGroup{}
+	for _, p := range engines {
+		volumeName := processNameToVolumeName(p.Name)
+		if _, exists := engineStopped[volumeName]; !exists {
+			engineStopped[volumeName] = &sync.WaitGroup{}
+		}
+		engineStopped[volumeName].Add(1)
+	}
+
+	jobs := make(chan *Process, len(processes))
+	for _, p := range engines {
+		jobs <- p
+	}
+	for _, p := range replicas {
+		jobs <- p
+	}
+	close(jobs)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range jobs {
+				volumeName := processNameToVolumeName(p.Name)
+				isEngine := isEngineProcess(p)
+				if !isEngine {
+					if stopped, exists := engineStopped[volumeName]; exists {
+						stopped.Wait()
+					}
+				}
+
+				err := pm.deleteProcessAndWait(p, stopTimeout)
+				if err != nil {
+					logrus.WithError(err).Errorf("Process Manager: failed to delete process %v in bulk delete operation %v", p.Name, op.ID)
+				}
+				op.record(p.Name, err)
+
+				if isEngine {
+					engineStopped[volumeName].Done()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	op.finish()
+	resp := op.RPCResponse()
+	logrus.Infof("Process Manager: finished bulk delete operation %v: deleted %v, failed %v of %v processes",
+		op.ID, resp.Deleted, resp.Failed, resp.Total)
+}
+
+func (pm *Manager) deleteProcessAndWait(p *Process, stopTimeout time.Duration) error {
+	p.StopWithTimeout(stopTimeout)
+	pm.unregisterProcess(p)
+
+	if !p.waitForStopped() {
+		return fmt.Errorf("timed out waiting for process %v to stop", p.Name)
+	}
+	return nil
+}
+
+func processNameToVolumeName(processName string) string {
+	if len(strings.Split(processName, "-")) < 3 {
+		return processName
+	}
+	return util.ProcessNameToVolumeName(processName)
+}
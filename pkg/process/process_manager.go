@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -19,7 +20,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"k8s.io/mount-utils"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
 	"github.com/longhorn/longhorn-instance-manager/pkg/types"
 	"github.com/longhorn/longhorn-instance-manager/pkg/util"
 	"github.com/longhorn/longhorn-instance-manager/pkg/util/broadcaster"
@@ -45,16 +48,53 @@ type Manager struct {
 	broadcaster *broadcaster.Broadcaster
 	broadcastCh chan interface{}
 
-	lock            *sync.RWMutex
-	processes       map[string]*Process
-	processUpdateCh chan *Process
+	// portEventBroadcaster notifies the watchers of the port allocations and releases of the processes
+	portEventBroadcaster *broadcaster.Broadcaster
+	portEventCh          chan interface{}
+	portEventLock        *sync.Mutex
+	portEvents           []*rpc.ProcessPortEvent
+
+	lock           *sync.RWMutex
+	processes      map[string]*Process
+	processUpdates *processUpdateQueue
+	// replacementProcesses holds the replacement processes which have allocated ports but are not registered yet
+	replacementProcesses map[string]*Process
 
 	availablePorts *util.Bitmap
 
+	bulkDeleteLock       *sync.RWMutex
+	bulkDeleteOperations map[string]*BulkDeleteOperation
+
+	engineBinaries *engineBinaryCache
+
+	rebuildLock *sync.Mutex
+	// rebuildModes are the number of the rebuilds each process in the rebuild mode takes part in
+	rebuildModes map[string]int
+
 	logsDir string
+	// stateDir has the process state file and the log pipes of the processes, which are adopted after the instance
+	// manager restarts. The processes are not adoptable if empty
+	stateDir  string
+	stateLock *sync.Mutex
 
 	Executor      Executor
 	HealthChecker HealthChecker
+	LeaseManager  *util.LeaseManager
+
+	// EnvIsolation starts the processes with the whitelisted environment variables of the instance manager only,
+	// rather than inheriting the whole environment, e.g. the service account related ones of the pod.
+	EnvIsolation bool
+	// EnvWhitelist are the variables passed to the processes in addition to the default ones when isolated
+	EnvWhitelist []string
+	// LogFlood detects, and optionally throttles, the processes logging above the rate. Disabled if nil
+	LogFlood *util.LogFloodConfig
+	// LogRotation rotates and compresses the log files of the processes. Disabled if nil
+	LogRotation *util.LogRotationConfig
+	// RebuildLimits are the CPU and IO limits of the processes in the rebuild mode. Not limited if nil
+	RebuildLimits *util.CgroupLimits
+
+	taskQueue    *util.TaskQueue
+	logRetention time.Duration
 }
 
 func NewManager(ctx context.Context, portRange string, logsDir string) (*Manager, error) {
@@ -68,14 +108,28 @@ func NewManager(ctx context.Context, portRange string, logsDir string) (*Manager
 		portRangeMax: end,
 
 		broadcaster: &broadcaster.Broadcaster{},
-		broadcastCh: make(chan interface{}),
+		broadcastCh: make(chan interface{}, processBroadcastBufferSize),
+
+		portEventBroadcaster: &broadcaster.Broadcaster{},
+		portEventCh:          make(chan interface{}, portEventBufferSize),
+		portEventLock:        &sync.Mutex{},
+
+		lock:                 &sync.RWMutex{},
+		processes:            map[string]*Process{},
+		processUpdates:       newProcessUpdateQueue(processUpdateStagePublish),
+		replacementProcesses: map[string]*Process{},
+		availablePorts:       util.NewBitmap(start, end),
 
-		lock:            &sync.RWMutex{},
-		processes:       map[string]*Process{},
-		processUpdateCh: make(chan *Process),
-		availablePorts:  util.NewBitmap(start, end),
+		bulkDeleteLock:       &sync.RWMutex{},
+		bulkDeleteOperations: map[string]*BulkDeleteOperation{},
 
-		logsDir: logsDir,
+		engineBinaries: newEngineBinaryCache(),
+
+		rebuildLock:  &sync.Mutex{},
+		rebuildModes: map[string]int{},
+
+		logsDir:   logsDir,
+		stateLock: &sync.Mutex{},
 
 		Executor:      &BinaryExecutor{},
 		HealthChecker: &GRPCHealthChecker{},
@@ -86,8 +140,12 @@ func NewManager(ctx context.Context, portRange string, logsDir string) (*Manager
 	if _, err := pm.broadcaster.Subscribe(c, pm.broadcastConnector); err != nil {
 		return nil, err
 	}
+	if _, err := pm.portEventBroadcaster.Subscribe(c, pm.portEventConnector); err != nil {
+		return nil, err
+	}
 	go pm.startMonitoring()
 	go pm.startInstanceConditionCheck()
+	go pm.startLogFloodCheck()
 	return pm, nil
 }
 
@@ -99,15 +157,20 @@ func (pm *Manager) startMonitoring() {
 		case <-pm.ctx.Done():
 			logrus.Infof("%s: stopped monitoring replicas due to the context done", types.ProcessManagerGrpcService)
 			done = true
-		case p := <-pm.processUpdateCh:
-			resp := p.RPCResponse()
-			pm.lock.RLock()
-			// Modify response to indicate deletion.
-			if _, exists := pm.processes[p.Name]; !exists {
-				resp.Deleted = true
+		case <-pm.processUpdates.ready():
+			for _, update := range pm.processUpdates.take() {
+				p := update.(*Process)
+				resp := p.RPCResponse()
+				pm.lock.RLock()
+				// Modify response to indicate deletion.
+				if _, exists := pm.processes[p.Name]; !exists {
+					resp.Deleted = true
+				}
+				pm.lock.RUnlock()
+				pm.broadcastCh <- interface{}(resp)
 			}
-			pm.lock.RUnlock()
-			pm.broadcastCh <- interface{}(resp)
+			pm.recordProcessMetrics()
+			pm.saveProcessState()
 		}
 		if done {
 			break
@@ -115,6 +178,26 @@ func (pm *Manager) startMonitoring() {
 	}
 }
 
+func (pm *Manager) recordProcessMetrics() {
+	counts := map[string]int{
+		types.ProcessStateStarting:  0,
+		types.ProcessStateRunning:   0,
+		types.ProcessStateSuspended: 0,
+		types.ProcessStateStopping:  0,
+		types.ProcessStateStopped:   0,
+		types.ProcessStateError:     0,
+	}
+	pm.lock.RLock()
+	for _, p := range pm.processes {
+		counts[p.RPCResponse().Status.State]++
+	}
+	pm.lock.RUnlock()
+
+	for state, count := range counts {
+		metrics.SetGauge(metrics.MetricProcesses, map[string]string{"state": state}, float64(count))
+	}
+}
+
 func (pm *Manager) startInstanceConditionCheck() {
 	done := false
 
@@ -146,7 +229,7 @@ func (pm *Manager) checkMountPointStatusForEngine() {
 
 	processToUpdate := pm.getProcessToUpdateConditions(volumeMountPointMap)
 	for _, p := range processToUpdate {
-		p.UpdateCh <- p
+		p.publishUpdate()
 	}
 }
 
@@ -154,21 +237,32 @@ func (pm *Manager) getProcessToUpdateConditions(volumeMountPointMap map[string]m
 	var processToUpdate []*Process
 	for _, p := range pm.processes {
 		p.lock.Lock()
-		if isEngineProcess(p) && p.State == StateRunning {
-			volumeName := util.ProcessNameToVolumeName(p.Name)
-			volumeNameSHA := sha256.Sum256([]byte(volumeName))
-			volumeNameSHAStr := hex.EncodeToString(volumeNameSHA[:])
-
-			if mp, exists := volumeMountPointMap[volumeNameSHAStr]; exists {
-				p.Conditions[types.EngineConditionFilesystemReadOnly] = util.IsMountPointReadOnly(mp)
-				processToUpdate = append(processToUpdate, p)
-			}
+		if updateProcessConditions(p, volumeMountPointMap) {
+			processToUpdate = append(processToUpdate, p)
 		}
 		p.lock.Unlock()
 	}
 	return processToUpdate
 }
 
+// updateProcessConditions updates the conditions of the running engine process by the mount point of its volume.
+// The caller must hold the process lock.
+func updateProcessConditions(p *Process, volumeMountPointMap map[string]mount.MountPoint) bool {
+	if !isEngineProcess(p) || p.State != StateRunning {
+		return false
+	}
+	volumeName := util.ProcessNameToVolumeName(p.Name)
+	volumeNameSHA := sha256.Sum256([]byte(volumeName))
+	volumeNameSHAStr := hex.EncodeToString(volumeNameSHA[:])
+
+	mp, exists := volumeMountPointMap[volumeNameSHAStr]
+	if !exists {
+		return false
+	}
+	p.Conditions[types.EngineConditionFilesystemReadOnly] = util.IsMountPointReadOnly(mp)
+	return true
+}
+
 func isEngineProcess(p *Process) bool {
 	return p.PortCount == DefaultEnginePortCount
 }
@@ -214,20 +308,28 @@ func ensureValidProcessPath(path string) (string, error) {
 // ProcessCreate will create a process according to the request.
 // If the specified process name exists already, the creation will fail.
 func (pm *Manager) ProcessCreate(ctx context.Context, req *rpc.ProcessCreateRequest) (ret *rpc.ProcessResponse, err error) {
+	if req.ValidateOnly {
+		return pm.validateProcessCreate(req.Spec)
+	}
 	if req.Spec.Name == "" || req.Spec.Binary == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "missing required argument")
 	}
 
-	logrus.Infof("Process Manager: prepare to create process %v", req.Spec.Name)
-	logger, err := util.NewLonghornWriter(req.Spec.Name, pm.logsDir)
+	processPath, err := ensureValidProcessPath(req.Spec.Binary)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateProcessSpec(req.Spec).Err(); err != nil {
 		return nil, err
 	}
 
-	processPath, err := ensureValidProcessPath(req.Spec.Binary)
+	logrus.Infof("Process Manager: prepare to create process %v", req.Spec.Name)
+	logger, err := util.NewLonghornWriter(req.Spec.Name, pm.logsDir)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
+	logger.SetFloodProtection(pm.LogFlood)
+	logger.SetRotation(pm.LogRotation)
 
 	p := &Process{
 		Name:      req.Spec.Name,
@@ -235,6 +337,16 @@ func (pm *Manager) ProcessCreate(ctx context.Context, req *rpc.ProcessCreateRequ
 		Args:      req.Spec.Args,
 		PortCount: req.Spec.PortCount,
 		PortArgs:  req.Spec.PortArgs,
+		Envs:      req.Spec.Envs,
+		Labels:    req.Spec.Labels,
+
+		PriorityClass: req.Spec.PriorityClass,
+		CPUMillicores: req.Spec.CpuMillicores,
+		MemoryBytes:   req.Spec.MemoryBytes,
+		CPUSet:        req.Spec.CpuSet,
+		NUMANode:      req.Spec.NumaNode,
+		Privileges:    req.Spec.Privileges,
+		StopTimeout:   time.Duration(req.Spec.StopTimeoutSeconds) * time.Second,
 
 		UUID: util.UUID(),
 
@@ -245,20 +357,24 @@ func (pm *Manager) ProcessCreate(ctx context.Context, req *rpc.ProcessCreateRequ
 
 		logger: logger,
 
+		env:           pm.getProcessEnv(req.Spec.Envs),
 		executor:      pm.Executor,
 		healthChecker: pm.HealthChecker,
 	}
+	p.Sidecars = newSidecars(req.Spec.Sidecars, pm.logsDir, pm.Executor, p.env)
+	p.logPipePath = pm.getLogPipePath(p.UUID)
 
 	if err := pm.registerProcess(p); err != nil {
+		logger.Close()
 		return nil, err
 	}
 
-	p.UpdateCh <- p
+	p.publishUpdate()
 	if err := p.Start(); err != nil {
 		// initializing failed so we sent event about the failed state, but still return the process rpc below
 		// this is to be consistent with the prior implementation
 		logrus.WithError(err).Errorf("Process Manager: failed to init new process %v", req.Spec.Name)
-		p.UpdateCh <- p
+		p.publishUpdate()
 	} else {
 		logrus.Infof("Process Manager: created process %v", req.Spec.Name)
 	}
@@ -266,17 +382,87 @@ func (pm *Manager) ProcessCreate(ctx context.Context, req *rpc.ProcessCreateRequ
 	return p.RPCResponse(), nil
 }
 
+// validateProcessCreate validates the spec the same as ProcessCreate, along with the existence of the binary and the
+// availability of the ports, without creating the process. All the invalid fields are returned at once.
+func (pm *Manager) validateProcessCreate(spec *rpc.ProcessSpec) (*rpc.ProcessResponse, error) {
+	violations := util.FieldViolations{}
+
+	if spec.GetName() == "" {
+		violations.Add("spec.name", "missing required argument")
+	} else if pm.findProcess(spec.Name) != nil {
+		violations.Add("spec.name", "process %v already exists", spec.Name)
+	}
+
+	if spec.GetBinary() == "" {
+		violations.Add("spec.binary", "missing required argument")
+	} else if processPath, err := ensureValidProcessPath(spec.Binary); err != nil {
+		violations.Add("spec.binary", err.Error())
+	} else if info, err := os.Stat(processPath); err != nil {
+		violations.Add("spec.binary", "cannot find binary %v: %v", processPath, err)
+	} else if info.IsDir() || info.Mode()&0111 == 0 {
+		violations.Add("spec.binary", "binary %v is not executable", processPath)
+	}
+
+	violations = append(violations, validateProcessSpec(spec)...)
+
+	if spec.GetPortCount() < 0 {
+		violations.Add("spec.port_count", "invalid port count %v", spec.PortCount)
+	} else if spec.GetPortCount() > 0 && !pm.availablePorts.HasRange(spec.PortCount) {
+		violations.Add("spec.port_count", "cannot find %v contiguous available ports in range %v-%v",
+			spec.PortCount, pm.portRangeMin, pm.portRangeMax)
+	}
+
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+	return &rpc.ProcessResponse{Spec: spec}, nil
+}
+
+// validateProcessSpec validates the fields of the spec shared by the creation and the replacement of a process,
+// other than the name, the binary and the ports.
+func validateProcessSpec(spec *rpc.ProcessSpec) util.FieldViolations {
+	violations := util.FieldViolations{}
+	if err := validateSidecarSpecs(spec.GetSidecars()); err != nil {
+		violations.Add("spec.sidecars", err.Error())
+	}
+	if err := validateProcessEnvs(spec.GetEnvs()); err != nil {
+		violations.Add("spec.envs", err.Error())
+	}
+	if err := util.ValidateLabels(spec.GetLabels()); err != nil {
+		violations.Add("spec.labels", err.Error())
+	}
+	if err := util.ValidatePriorityClass(spec.GetPriorityClass()); err != nil {
+		violations.Add("spec.priority_class", err.Error())
+	}
+	if err := validateProcessResourceLimits(spec.GetCpuMillicores(), spec.GetMemoryBytes()); err != nil {
+		violations.Add("spec.resource_limits", err.Error())
+	}
+	if err := util.ValidateCPUAffinity(spec.GetCpuSet(), spec.GetNumaNode()); err != nil {
+		violations.Add("spec.cpu_affinity", err.Error())
+	}
+	if err := validateProcessPrivileges(spec.GetPrivileges()); err != nil {
+		violations.Add("spec.privileges", err.Error())
+	}
+	if err := validateStopTimeout(spec.GetStopTimeoutSeconds()); err != nil {
+		violations.Add("spec.stop_timeout_seconds", err.Error())
+	}
+	return violations
+}
+
 // ProcessDelete will delete the process named by the request.
 // If the process doesn't exist, the deletion will return with ErrorNotFound
 func (pm *Manager) ProcessDelete(ctx context.Context, req *rpc.ProcessDeleteRequest) (ret *rpc.ProcessResponse, err error) {
 	logrus.Infof("Process Manager: prepare to delete process %v", req.Name)
 
+	if err := validateStopTimeout(req.StopTimeoutSeconds); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	p := pm.findProcess(req.Name)
 	if p == nil {
 		return nil, status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
 	}
 
-	p.Stop()
+	p.StopWithTimeout(time.Duration(req.StopTimeoutSeconds) * time.Second)
 
 	resp := p.RPCResponse()
 	resp.Deleted = true
@@ -287,6 +473,56 @@ func (pm *Manager) ProcessDelete(ctx context.Context, req *rpc.ProcessDeleteRequ
 	return resp, nil
 }
 
+// ProcessSuspend stops the running process by SIGSTOP until ProcessResume, e.g. for quiescing it during a node
+// maintenance or a live migration.
+func (pm *Manager) ProcessSuspend(ctx context.Context, req *rpc.ProcessSuspendRequest) (*rpc.ProcessResponse, error) {
+	logrus.Infof("Process Manager: prepare to suspend process %v", req.Name)
+
+	p := pm.findProcess(req.Name)
+	if p == nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
+	}
+
+	if err := p.Suspend(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	p.publishUpdate()
+
+	return p.RPCResponse(), nil
+}
+
+// ProcessResume continues the process suspended by ProcessSuspend.
+func (pm *Manager) ProcessResume(ctx context.Context, req *rpc.ProcessResumeRequest) (*rpc.ProcessResponse, error) {
+	logrus.Infof("Process Manager: prepare to resume process %v", req.Name)
+
+	p := pm.findProcess(req.Name)
+	if p == nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
+	}
+
+	if err := p.Resume(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	p.publishUpdate()
+
+	return p.RPCResponse(), nil
+}
+
+// ProcessCrash kills the process without stopping it first, so that the process errors out as if it crashed.
+// It is used by the process_crash chaos fault.
+func (pm *Manager) ProcessCrash(name string) error {
+	p := pm.findProcess(name)
+	if p == nil {
+		return status.Errorf(codes.NotFound, "cannot find process %v", name)
+	}
+
+	logrus.Warnf("Process Manager: crashing process %v", name)
+	if err := p.Crash(); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
 func (pm *Manager) registerProcess(p *Process) error {
 	pm.lock.Lock()
 	defer pm.lock.Unlock()
@@ -296,11 +532,16 @@ func (pm *Manager) registerProcess(p *Process) error {
 		return status.Errorf(codes.AlreadyExists, "process %v already exists", p.Name)
 	}
 
+	if err := pm.acquireProcessLease(p); err != nil {
+		return err
+	}
+
 	if err := pm.allocateProcessPorts(p); err != nil {
+		pm.releaseProcessLease(p)
 		return err
 	}
 
-	p.UpdateCh = pm.processUpdateCh
+	p.updates = pm.processUpdates
 	pm.processes[p.Name] = p
 
 	return nil
@@ -316,15 +557,8 @@ func (pm *Manager) unregisterProcess(p *Process) {
 	}
 
 	go func() {
-		for i := 0; i < types.WaitCount; i++ {
-			if p.IsStopped() {
-				break
-			}
-			logrus.Debugf("Process Manager: wait for process %v to shutdown before unregistering process", p.Name)
-			time.Sleep(types.WaitInterval)
-		}
-
-		if !p.IsStopped() {
+		logrus.Debugf("Process Manager: wait for process %v to shutdown before unregistering process", p.Name)
+		if !p.waitForStopped() {
 			logrus.Errorf("Process Manager: failed to unregister process %v since it is state %v rather than stopped", p.Name, p.State)
 			return
 		}
@@ -338,10 +572,12 @@ func (pm *Manager) unregisterProcess(p *Process) {
 
 			delete(pm.processes, p.Name)
 			pm.releaseProcessPorts(p)
+			pm.releaseProcessLease(p)
+			pm.scheduleProcessLogRemoval(p.Name)
 		}()
 
 		logrus.Infof("Process Manager: successfully unregistered process %v", p.Name)
-		p.UpdateCh <- p
+		p.publishUpdate()
 	}()
 }
 
@@ -360,6 +596,33 @@ func (pm *Manager) ProcessGet(ctx context.Context, req *rpc.ProcessGetRequest) (
 		return nil, status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
 	}
 
+	resp := p.RPCResponse()
+	resp.Status.ResourceUsage = p.resourceUsage()
+	return resp, nil
+}
+
+// ProcessRefresh re-checks the process against the process table of the node and the mount point of its volume,
+// instead of waiting for the process goroutines and the periodic condition check, then notifies the watchers.
+func (pm *Manager) ProcessRefresh(ctx context.Context, req *rpc.ProcessRefreshRequest) (*rpc.ProcessResponse, error) {
+	logrus.Infof("Process Manager: refreshing process %v", req.Name)
+
+	p := pm.findProcess(req.Name)
+	if p == nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
+	}
+
+	volumeMountPointMap, err := util.GetVolumeMountPointMap()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to get all volume mount points")
+	}
+
+	p.lock.Lock()
+	p.refresh()
+	updateProcessConditions(p, volumeMountPointMap)
+	p.lock.Unlock()
+
+	p.publishUpdate()
+
 	return p.RPCResponse(), nil
 }
 
@@ -372,6 +635,7 @@ func (pm *Manager) ProcessList(ctx context.Context, req *rpc.ProcessListRequest)
 	}
 	for _, p := range pm.processes {
 		resp.Processes[p.Name] = p.RPCResponse()
+		resp.Processes[p.Name].Status.ResourceUsage = p.resourceUsage()
 	}
 	return resp, nil
 }
@@ -382,15 +646,25 @@ func (pm *Manager) ProcessLog(req *rpc.LogRequest, srv rpc.ProcessManagerService
 	if p == nil {
 		return status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
 	}
-	doneChan := make(chan struct{})
-	logChan, err := p.logger.StreamLog(doneChan)
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+	logChan, err := p.logger.StreamLog(ctx, req.SinceSequence)
 	if err != nil {
 		return err
 	}
+	if req.Batched {
+		if err := sendLogFrames(logChan, req.Compressed, srv); err != nil {
+			return err
+		}
+		logrus.Infof("Process Manager: got logs for process %v", req.Name)
+		return nil
+	}
 	for logLine := range logChan {
-		if err := srv.Send(&rpc.LogResponse{Line: logLine}); err != nil {
-			doneChan <- struct{}{}
-			close(doneChan)
+		if err := srv.Send(&rpc.LogResponse{
+			Line:      logLine.Line,
+			Sequence:  logLine.Sequence,
+			Timestamp: logLine.Time.UnixNano(),
+		}); err != nil {
 			return err
 		}
 	}
@@ -398,6 +672,27 @@ func (pm *Manager) ProcessLog(req *rpc.LogRequest, srv rpc.ProcessManagerService
 	return nil
 }
 
+// sendLogFrames sends the log lines in batched frames, which saves most of the marshaling of a message per line
+// when a chatty process is tailed.
+func sendLogFrames(logChan <-chan *util.LogLine, compressed bool, srv rpc.ProcessManagerService_ProcessLogServer) error {
+	for {
+		batch, ok := util.NextLogBatch(logChan)
+		if !ok {
+			return nil
+		}
+		frame, err := util.EncodeLogFrame(batch, compressed)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := srv.Send(&rpc.LogResponse{
+			Frame:      frame,
+			Compressed: compressed,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
 func (pm *Manager) broadcastConnector() (chan interface{}, error) {
 	return pm.broadcastCh, nil
 }
@@ -407,7 +702,10 @@ func (pm *Manager) Subscribe() (<-chan interface{}, error) {
 }
 
 func (pm *Manager) ProcessWatch(req *emptypb.Empty, srv rpc.ProcessManagerService_ProcessWatchServer) (err error) {
-	responseChan, err := pm.Subscribe()
+	// Unsubscribe once the watch ends
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	responseChan, err := pm.broadcaster.Subscribe(ctx, pm.broadcastConnector)
 	if err != nil {
 		return err
 	}
@@ -421,16 +719,43 @@ func (pm *Manager) ProcessWatch(req *emptypb.Empty, srv rpc.ProcessManagerServic
 	}()
 	logrus.Info("Started new process manager update watch")
 
-	for resp := range responseChan {
-		r, ok := resp.(*rpc.ProcessResponse)
-		if !ok {
-			return fmt.Errorf("BUG: cannot get ProcessResponse from channel")
+	// Queue the responses by process for the watcher, so that a slow watcher gets the latest state of each process
+	// rather than holding back the broadcaster until it drops the watcher
+	updates := newProcessUpdateQueue(processUpdateStageWatch)
+	unsubscribedCh := make(chan struct{})
+	go func() {
+		defer close(unsubscribedCh)
+		for resp := range responseChan {
+			r, ok := resp.(*rpc.ProcessResponse)
+			if !ok {
+				logrus.Error("BUG: cannot get ProcessResponse from channel")
+				continue
+			}
+			updates.publish(r.Spec.Name, r)
 		}
-		if err := srv.Send(r); err != nil {
-			return err
+	}()
+
+	dropCh := chaos.WatchStreamDropped()
+	for {
+		select {
+		case <-dropCh:
+			return status.Error(codes.Unavailable, "chaos: process watch stream is dropped")
+		case <-updates.ready():
+			if err := sendProcessResponses(srv, updates.take()); err != nil {
+				return err
+			}
+		case <-unsubscribedCh:
+			return sendProcessResponses(srv, updates.take())
 		}
 	}
+}
 
+func sendProcessResponses(srv rpc.ProcessManagerService_ProcessWatchServer, responses []interface{}) error {
+	for _, resp := range responses {
+		if err := srv.Send(resp.(*rpc.ProcessResponse)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -489,12 +814,17 @@ func (pm *Manager) ProcessReplace(ctx context.Context, req *rpc.ProcessReplaceRe
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := validateProcessSpec(req.Spec).Err(); err != nil {
+		return nil, err
+	}
 
 	logrus.Infof("Process Manager: prepare to replace process %v", req.Spec.Name)
 	logger, err := util.NewLonghornWriter(req.Spec.Name, pm.logsDir)
 	if err != nil {
 		return nil, err
 	}
+	logger.SetFloodProtection(pm.LogFlood)
+	logger.SetRotation(pm.LogRotation)
 
 	p := &Process{
 		Name:      req.Spec.Name,
@@ -516,10 +846,61 @@ func (pm *Manager) ProcessReplace(ctx context.Context, req *rpc.ProcessReplaceRe
 		healthChecker: pm.HealthChecker,
 	}
 
+	p.logPipePath = pm.getLogPipePath(p.UUID)
+
 	processToReplace, err := pm.initProcessReplace(p)
 	if err != nil {
+		logger.Close()
 		return nil, err
 	}
+	defer pm.finishProcessReplace(p)
+
+	// The sidecars are replaced along with the process, and kept as they are if the request doesn't specify any
+	sidecarSpecs := req.Spec.Sidecars
+	if len(sidecarSpecs) == 0 {
+		sidecarSpecs = processToReplace.sidecarSpecs()
+	}
+	// So are the environment variables
+	p.Envs = req.Spec.Envs
+	if len(p.Envs) == 0 {
+		p.Envs = processToReplace.Envs
+	}
+	p.env = pm.getProcessEnv(p.Envs)
+	p.Sidecars = newSidecars(sidecarSpecs, pm.logsDir, pm.Executor, p.env)
+	// And the labels
+	p.Labels = req.Spec.Labels
+	if len(p.Labels) == 0 {
+		p.Labels = processToReplace.Labels
+	}
+	// And the priority class
+	p.PriorityClass = req.Spec.PriorityClass
+	if p.PriorityClass == "" {
+		p.PriorityClass = processToReplace.PriorityClass
+	}
+	// And the resource limits
+	p.CPUMillicores = req.Spec.CpuMillicores
+	p.MemoryBytes = req.Spec.MemoryBytes
+	if p.CPUMillicores == 0 && p.MemoryBytes == 0 {
+		p.CPUMillicores = processToReplace.CPUMillicores
+		p.MemoryBytes = processToReplace.MemoryBytes
+	}
+	// And the CPU pinning
+	p.CPUSet = req.Spec.CpuSet
+	p.NUMANode = req.Spec.NumaNode
+	if p.CPUSet == "" && p.NUMANode == "" {
+		p.CPUSet = processToReplace.CPUSet
+		p.NUMANode = processToReplace.NUMANode
+	}
+	// And the privileges
+	p.Privileges = req.Spec.Privileges
+	if p.Privileges == nil {
+		p.Privileges = processToReplace.Privileges
+	}
+	// And the stop timeout
+	p.StopTimeout = time.Duration(req.Spec.StopTimeoutSeconds) * time.Second
+	if p.StopTimeout == 0 {
+		p.StopTimeout = processToReplace.StopTimeout
+	}
 
 	if processToReplace.Binary == p.Binary {
 		logrus.Infof("Process Manager: the existing process already has the updated engine image %v", p.Binary)
@@ -581,7 +962,7 @@ func (pm *Manager) ProcessReplace(ctx context.Context, req *rpc.ProcessReplaceRe
 	logrus.Infof("Process Manager: process %v successfully registered replacement with UUID %v", p.Name, p.UUID)
 	pm.lock.Unlock()
 
-	p.UpdateCh <- p
+	p.publishUpdate()
 	logrus.Infof("Process Manager: successfully replaced process %v", req.Spec.Name)
 	return p.RPCResponse(), nil
 }
@@ -598,11 +979,19 @@ func (pm *Manager) initProcessReplace(p *Process) (*Process, error) {
 	if err := pm.allocateProcessPorts(p); err != nil {
 		return nil, err
 	}
+	pm.replacementProcesses[p.UUID] = p
 
-	p.UpdateCh = pm.processUpdateCh
+	p.updates = pm.processUpdates
 	return oldProcess, nil
 }
 
+func (pm *Manager) finishProcessReplace(p *Process) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	delete(pm.replacementProcesses, p.UUID)
+}
+
 func (pm *Manager) allocateProcessPorts(p *Process) error {
 	var err error
 	if len(p.PortArgs) > int(p.PortCount) {
@@ -623,6 +1012,7 @@ func (pm *Manager) allocateProcessPorts(p *Process) error {
 		}
 	}
 
+	pm.recordPortEvent(PortEventAllocated, p.Name, p.UUID, p.PortStart, p.PortEnd)
 	return nil
 }
 
@@ -630,5 +1020,43 @@ func (pm *Manager) releaseProcessPorts(p *Process) {
 	if err := pm.releasePorts(p.PortStart, p.PortEnd); err != nil {
 		logrus.WithError(err).Errorf("Process Manager: cannot deallocate %v ports (%v-%v) for %v",
 			p.PortCount, p.PortStart, p.PortEnd, p.Name)
+		return
+	}
+	pm.recordPortEvent(PortEventReleased, p.Name, p.UUID, p.PortStart, p.PortEnd)
+}
+
+// getReplicaDataDirectory returns the data directory of a replica process, which is the argument following the
+// replica subcommand, e.g. "replica /host/var/lib/longhorn/replicas/<volume>-<random> --size ...".
+func getReplicaDataDirectory(args []string) string {
+	if len(args) < 2 || args[0] != "replica" {
+		return ""
+	}
+	return filepath.Clean(args[1])
+}
+
+// acquireProcessLease claims the data directory of a replica process, so that no other process of this or another
+// instance manager can serve the same replica data concurrently.
+func (pm *Manager) acquireProcessLease(p *Process) error {
+	dataDir := getReplicaDataDirectory(p.Args)
+	if dataDir == "" {
+		return nil
+	}
+	if err := pm.LeaseManager.Acquire(replicaDataDirectoryLeaseResource(dataDir), p.Name); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "cannot claim data directory %v for process %v: %v", dataDir, p.Name, err)
 	}
+	return nil
+}
+
+func (pm *Manager) releaseProcessLease(p *Process) {
+	dataDir := getReplicaDataDirectory(p.Args)
+	if dataDir == "" {
+		return
+	}
+	if err := pm.LeaseManager.Release(replicaDataDirectoryLeaseResource(dataDir), p.Name); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to release the claim of data directory %v for %v", dataDir, p.Name)
+	}
+}
+
+func replicaDataDirectoryLeaseResource(dataDir string) string {
+	return "replica-data-directory:" + dataDir
 }
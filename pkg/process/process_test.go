@@ -2,10 +2,15 @@ package process
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
+	"syscall"
 	"testing"
 	"time"
 
@@ -14,9 +19,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
 
 	. "gopkg.in/check.v1"
 )
@@ -31,6 +38,32 @@ const (
 
 func Test(t *testing.T) { TestingT(t) }
 
+// TestMain makes the test binary act as SpawnCommand of the instance manager binary, since the sandboxed commands
+// are spawned by os.Executable.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == SpawnCommand {
+		capabilities := []string{}
+		noNewPrivileges, privateMounts := false, false
+		args := os.Args[2:]
+		for len(args) > 0 && args[0] != "--" {
+			switch args[0] {
+			case "--capability":
+				capabilities = append(capabilities, args[1])
+				args = args[1:]
+			case "--no-new-privileges":
+				noNewPrivileges = true
+			case "--private-mounts":
+				privateMounts = true
+			}
+			args = args[1:]
+		}
+		err := SpawnProcess(args[1], args[2:], capabilities, noNewPrivileges, privateMounts)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
 type TestSuite struct {
 	shutdownCh chan error
 	pm         *Manager
@@ -52,6 +85,17 @@ func (pw *ProcessWatcher) Send(resp *rpc.ProcessResponse) error {
 	return nil
 }
 
+// BlockedProcessWatcher is a watcher stuck in sending until unblocked
+type BlockedProcessWatcher struct {
+	grpc.ServerStream
+	unblockCh chan struct{}
+}
+
+func (pw *BlockedProcessWatcher) Send(resp *rpc.ProcessResponse) error {
+	<-pw.unblockCh
+	return nil
+}
+
 func (s *TestSuite) SetUpSuite(c *C) {
 	var err error
 
@@ -102,6 +146,8 @@ func (s *TestSuite) TestCRUD(c *C) {
 			})
 			c.Assert(err, IsNil)
 			c.Assert(getResp.Spec.Name, Equals, name)
+			c.Assert(getResp.Status.Uuid, Not(Equals), "")
+			c.Assert(getResp.Status.Uuid, Equals, createResp.Status.Uuid)
 			c.Assert(getResp.Status.State, Not(Equals), types.ProcessStateStopping)
 			c.Assert(getResp.Status.State, Not(Equals), types.ProcessStateStopped)
 			c.Assert(getResp.Status.State, Not(Equals), types.ProcessStateError)
@@ -173,6 +219,85 @@ func (s *TestSuite) TestProcessDeletion(c *C) {
 	wg.Wait()
 }
 
+func (s *TestSuite) TestProcessRefresh(c *C) {
+	name := "test-process-refresh-e-0"
+
+	_, err := s.pm.ProcessRefresh(nil, &rpc.ProcessRefreshRequest{Name: name})
+	c.Assert(err, NotNil)
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+
+	assertProcessCreation(c, s.pm, name, TestBinary)
+
+	resp, err := s.pm.ProcessRefresh(nil, &rpc.ProcessRefreshRequest{Name: name})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Spec.Name, Equals, name)
+	c.Assert(resp.Status.State, Equals, types.ProcessStateRunning)
+
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessSuspendResume(c *C) {
+	name := "test-process-suspend-e-0"
+
+	_, err := s.pm.ProcessSuspend(nil, &rpc.ProcessSuspendRequest{Name: name})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+
+	assertProcessCreation(c, s.pm, name, TestBinary)
+
+	_, err = s.pm.ProcessResume(nil, &rpc.ProcessResumeRequest{Name: name})
+	c.Assert(status.Code(err), Equals, codes.FailedPrecondition)
+
+	resp, err := s.pm.ProcessSuspend(nil, &rpc.ProcessSuspendRequest{Name: name})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Status.State, Equals, types.ProcessStateSuspended)
+
+	_, err = s.pm.ProcessSuspend(nil, &rpc.ProcessSuspendRequest{Name: name})
+	c.Assert(status.Code(err), Equals, codes.FailedPrecondition)
+
+	resp, err = s.pm.ProcessResume(nil, &rpc.ProcessResumeRequest{Name: name})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Status.State, Equals, types.ProcessStateRunning)
+
+	// A suspended process can still be deleted
+	_, err = s.pm.ProcessSuspend(nil, &rpc.ProcessSuspendRequest{Name: name})
+	c.Assert(err, IsNil)
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessPortEvents(c *C) {
+	name := "test-process-port-events-e-0"
+
+	assertProcessCreation(c, s.pm, name, TestBinary)
+	resp, err := s.pm.ProcessGet(nil, &rpc.ProcessGetRequest{Name: name})
+	c.Assert(err, IsNil)
+	assertProcessDeletion(c, s.pm, name)
+
+	// The ports are released once the process is stopped
+	events := []*rpc.ProcessPortEvent{}
+	for j := 0; j < RetryCount; j++ {
+		list, err := s.pm.ProcessPortEventList(nil, nil)
+		c.Assert(err, IsNil)
+		events = []*rpc.ProcessPortEvent{}
+		for _, event := range list.Events {
+			if event.ProcessName == name {
+				events = append(events, event)
+			}
+		}
+		if len(events) == 2 {
+			break
+		}
+		time.Sleep(RetryInterval)
+	}
+	c.Assert(events, HasLen, 2)
+	for i, action := range []string{PortEventAllocated, PortEventReleased} {
+		c.Assert(events[i].Action, Equals, action)
+		c.Assert(events[i].ProcessUuid, Not(Equals), "")
+		c.Assert(events[i].ProcessUuid, Equals, events[0].ProcessUuid)
+		c.Assert(events[i].PortStart, Equals, resp.Status.PortStart)
+		c.Assert(events[i].PortEnd, Equals, resp.Status.PortEnd)
+	}
+}
+
 // there was a deadlock when the im.monitor is processing an element
 // from the updateChannel, it will try to RLock, to evaluate the existing
 // processes. This will deadlock, if during that time a process is
@@ -342,6 +467,309 @@ func (s *TestSuite) TestProcessInvalidProcessBinary(c *C) {
 	}
 }
 
+func (s *TestSuite) TestProcessBulkDelete(c *C) {
+	volumeCount := 5
+	replicaCount := 3
+	var names []string
+	for i := 0; i < volumeCount; i++ {
+		volumeName := "test-bulk-delete-vol" + strconv.Itoa(i)
+
+		engineName := volumeName + "-e-0"
+		assertProcessCreation(c, s.pm, engineName, TestBinary)
+		names = append(names, engineName)
+
+		for j := 0; j < replicaCount; j++ {
+			replicaName := volumeName + "-r-" + strconv.Itoa(j)
+			createResp, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{
+				Spec: &rpc.ProcessSpec{
+					Name:      replicaName,
+					Binary:    TestBinary,
+					Args:      []string{},
+					PortCount: 2,
+				},
+			})
+			c.Assert(err, IsNil)
+			c.Assert(createResp, NotNil)
+			names = append(names, replicaName)
+		}
+	}
+	missingName := "test-bulk-delete-missing-e-0"
+
+	resp, err := s.pm.ProcessBulkDelete(nil, &rpc.ProcessBulkDeleteRequest{
+		Names:       append(names, missingName),
+		Concurrency: 4,
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp.OperationId, Not(Equals), "")
+	c.Assert(resp.Total, Equals, int32(len(names)+1))
+
+	var statusResp *rpc.ProcessBulkDeleteResponse
+	for i := 0; i < RetryCount; i++ {
+		statusResp, err = s.pm.ProcessBulkDeleteStatusGet(nil, &rpc.ProcessBulkDeleteStatusGetRequest{
+			OperationId: resp.OperationId,
+		})
+		c.Assert(err, IsNil)
+		if statusResp.State != BulkDeleteStateInProgress {
+			break
+		}
+		time.Sleep(RetryInterval)
+	}
+	c.Assert(statusResp.State, Equals, BulkDeleteStateError)
+	c.Assert(statusResp.Deleted, Equals, int32(len(names)))
+	c.Assert(statusResp.Failed, Equals, int32(1))
+	c.Assert(statusResp.Errors[missingName], Not(Equals), "")
+
+	deleted, err := waitForProcessListState(s.pm, func(processes map[string]*rpc.ProcessResponse) bool {
+		for _, name := range names {
+			if _, exists := processes[name]; exists {
+				return false
+			}
+		}
+		return true
+	})
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, true)
+
+	_, err = s.pm.ProcessBulkDeleteStatusGet(nil, &rpc.ProcessBulkDeleteStatusGetRequest{
+		OperationId: generateUUID(),
+	})
+	c.Assert(err, NotNil)
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+}
+
+func (s *TestSuite) TestProcessEnv(c *C) {
+	c.Assert(os.Setenv("TEST_PROCESS_ENV_SECRET", "secret"), IsNil)
+	c.Assert(os.Setenv("TEST_PROCESS_ENV_WHITELISTED", "whitelisted"), IsNil)
+	defer os.Unsetenv("TEST_PROCESS_ENV_SECRET")
+	defer os.Unsetenv("TEST_PROCESS_ENV_WHITELISTED")
+
+	pm := &Manager{}
+	c.Assert(pm.getProcessEnv(nil), IsNil)
+	env := pm.getProcessEnv(map[string]string{"SPEC": "value"})
+	c.Assert(env, DeepEquals, append(os.Environ(), "SPEC=value"))
+
+	pm.EnvIsolation = true
+	pm.EnvWhitelist = []string{"TEST_PROCESS_ENV_WHITELISTED", "PATH"}
+	expected := []string{}
+	for _, key := range defaultEnvWhitelist {
+		if value, exists := os.LookupEnv(key); exists {
+			expected = append(expected, key+"="+value)
+		}
+	}
+	expected = append(expected, "TEST_PROCESS_ENV_WHITELISTED=whitelisted", "PATH=/spec", "SPEC=value")
+	env = pm.getProcessEnv(map[string]string{"SPEC": "value", "PATH": "/spec"})
+	c.Assert(env, DeepEquals, expected)
+
+	c.Assert(validateProcessEnvs(map[string]string{"KEY": "value"}), IsNil)
+	c.Assert(validateProcessEnvs(map[string]string{"": "value"}), NotNil)
+	c.Assert(validateProcessEnvs(map[string]string{"KEY=": "value"}), NotNil)
+}
+
+func (s *TestSuite) TestPortReconcile(c *C) {
+	name := "test-port-reconcile-e-0"
+	assertProcessCreation(c, s.pm, name, TestBinary)
+
+	// Start from a consistent allocator
+	_, err := s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+
+	p := s.pm.findProcess(name)
+	c.Assert(p, NotNil)
+	leakedStart, leakedEnd, err := s.pm.availablePorts.AllocateRange(3)
+	c.Assert(err, IsNil)
+	err = s.pm.availablePorts.ReleaseRange(p.PortStart, p.PortEnd)
+	c.Assert(err, IsNil)
+
+	assertDiscrepancies := func(resp *rpc.PortReconcileResponse, repaired bool) {
+		c.Assert(resp.Discrepancies, HasLen, 2)
+		for _, d := range resp.Discrepancies {
+			switch d.Type {
+			case PortDiscrepancyUnrecorded:
+				c.Assert(d.ProcessName, Equals, name)
+				c.Assert(d.PortStart, Equals, p.PortStart)
+				c.Assert(d.PortEnd, Equals, p.PortEnd)
+			case PortDiscrepancyLeaked:
+				c.Assert(d.PortStart, Equals, leakedStart)
+				c.Assert(d.PortEnd, Equals, leakedEnd)
+			default:
+				c.Fatalf("unexpected discrepancy %+v", d)
+			}
+			c.Assert(d.Repaired, Equals, repaired)
+		}
+	}
+
+	resp, err := s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{DryRun: true})
+	c.Assert(err, IsNil)
+	assertDiscrepancies(resp, false)
+	c.Assert(s.pm.availablePorts.IsAllocated(p.PortStart), Equals, false)
+	c.Assert(s.pm.availablePorts.IsAllocated(leakedStart), Equals, true)
+
+	resp, err = s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+	assertDiscrepancies(resp, true)
+	c.Assert(s.pm.availablePorts.IsAllocated(p.PortStart), Equals, true)
+	c.Assert(s.pm.availablePorts.IsAllocated(leakedStart), Equals, false)
+
+	resp, err = s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Discrepancies, HasLen, 0)
+
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestPortReconcileForeign(c *C) {
+	_, err := s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+
+	// The test itself is not a managed process
+	var l net.Listener
+	for port := s.pm.portRangeMax; port > s.pm.portRangeMax-100; port-- {
+		if s.pm.availablePorts.IsAllocated(port) {
+			continue
+		}
+		if l, err = net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(port)))); err == nil {
+			break
+		}
+	}
+	c.Assert(l, NotNil)
+	port := int32(l.Addr().(*net.TCPAddr).Port)
+
+	findDiscrepancy := func(resp *rpc.PortReconcileResponse, discrepancyType string) *rpc.PortDiscrepancy {
+		for _, d := range resp.Discrepancies {
+			if d.Type == discrepancyType && d.PortStart == port {
+				return d
+			}
+		}
+		return nil
+	}
+
+	resp, err := s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{DryRun: true})
+	c.Assert(err, IsNil)
+	d := findDiscrepancy(resp, PortDiscrepancyForeign)
+	c.Assert(d, NotNil)
+	c.Assert(d.Message, Matches, fmt.Sprintf(".*unmanaged processes \\[%v\\].*", os.Getpid()))
+	c.Assert(d.Repaired, Equals, false)
+	c.Assert(s.pm.availablePorts.IsAllocated(port), Equals, false)
+
+	resp, err = s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(findDiscrepancy(resp, PortDiscrepancyForeign).Repaired, Equals, true)
+	c.Assert(s.pm.availablePorts.IsAllocated(port), Equals, true)
+
+	// The port is released once nothing listens on it
+	c.Assert(l.Close(), IsNil)
+	resp, err = s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(findDiscrepancy(resp, PortDiscrepancyLeaked), NotNil)
+	c.Assert(s.pm.availablePorts.IsAllocated(port), Equals, false)
+}
+
+func (s *TestSuite) TestProcessManagerDebugGet(c *C) {
+	name := "test-process-debug-e-0"
+	assertProcessCreation(c, s.pm, name, TestBinary)
+
+	_, err := s.pm.PortReconcile(nil, &rpc.PortReconcileRequest{})
+	c.Assert(err, IsNil)
+	leakedStart, leakedEnd, err := s.pm.availablePorts.AllocateRange(2)
+	c.Assert(err, IsNil)
+
+	resp, err := s.pm.ProcessManagerDebugGet(nil, nil)
+	c.Assert(err, IsNil)
+	c.Assert(resp.PortRangeStart, Equals, int32(10000))
+	c.Assert(resp.PortRangeEnd, Equals, int32(30000))
+
+	p := s.pm.findProcess(name)
+	c.Assert(p, NotNil)
+	c.Assert(resp.PortAllocations, HasLen, 2)
+	for _, a := range resp.PortAllocations {
+		if a.ProcessName == "" {
+			c.Assert(a.PortStart, Equals, leakedStart)
+			c.Assert(a.PortEnd, Equals, leakedEnd)
+			c.Assert(a.OwnerType, Equals, "")
+		} else {
+			c.Assert(a.ProcessName, Equals, name)
+			c.Assert(a.PortStart, Equals, p.PortStart)
+			c.Assert(a.PortEnd, Equals, p.PortEnd)
+			c.Assert(a.OwnerType, Equals, PortOwnerTypeProcess)
+		}
+		c.Assert(a.Allocated, Equals, true)
+	}
+
+	c.Assert(resp.Probes, HasLen, 1)
+	c.Assert(resp.Probes[0].ProcessName, Equals, name)
+	c.Assert(resp.Probes[0].Probed, Equals, true)
+	c.Assert(resp.Probes[0].Healthy, Equals, true)
+	c.Assert(resp.StoppingProcesses, HasLen, 0)
+
+	err = s.pm.availablePorts.ReleaseRange(leakedStart, leakedEnd)
+	c.Assert(err, IsNil)
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessSidecar(c *C) {
+	name := "test-process-sidecar-r-0"
+	spec := createProcessSpec(name, TestBinary)
+	spec.Sidecars = []*rpc.ProcessSidecarSpec{
+		{
+			Name:   "exporter",
+			Binary: "/engine-binaries/test/longhorn-exporter",
+			Args:   []string{"--listen", "localhost:9500"},
+		},
+	}
+
+	_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{
+		Spec: &rpc.ProcessSpec{
+			Name:   name,
+			Binary: TestBinary,
+			Sidecars: []*rpc.ProcessSidecarSpec{
+				{Name: "exporter", Binary: "/usr/bin/exporter"},
+			},
+		},
+	})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	_, err = s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(err, IsNil)
+
+	sidecarRunning := func(process *rpc.ProcessResponse) bool {
+		return process.Status.State == types.ProcessStateRunning &&
+			len(process.Status.Sidecars) == 1 &&
+			process.Status.Sidecars[0].State == types.ProcessStateRunning
+	}
+	running, err := waitForProcessState(s.pm, name, sidecarRunning)
+	c.Assert(err, IsNil)
+	c.Assert(running, Equals, true)
+
+	// the sidecars are kept and restarted with the replacement
+	oldProcess := s.pm.findProcess(name)
+	c.Assert(oldProcess, NotNil)
+	assertProcessReplace(c, s.pm, name, TestBinaryReplace)
+	running, err = waitForProcessState(s.pm, name, sidecarRunning)
+	c.Assert(err, IsNil)
+	c.Assert(running, Equals, true)
+	for i := 0; i < RetryCount && !oldProcess.IsStopped(); i++ {
+		time.Sleep(RetryInterval)
+	}
+	c.Assert(oldProcess.IsStopped(), Equals, true)
+	// the sidecars are stopped before the process
+	c.Assert(oldProcess.Sidecars[0].status().State, Equals, types.ProcessStateStopped)
+
+	p := s.pm.findProcess(name)
+	c.Assert(p, NotNil)
+	c.Assert(p.UUID, Not(Equals), oldProcess.UUID)
+	c.Assert(p.Sidecars, HasLen, 1)
+	c.Assert(p.Sidecars[0].Args, DeepEquals, spec.Sidecars[0].Args)
+
+	assertProcessDeletion(c, s.pm, name)
+	deleted, err := waitForProcessListState(s.pm, func(processes map[string]*rpc.ProcessResponse) bool {
+		_, exists := processes[name]
+		return !exists
+	})
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, true)
+	c.Assert(p.Sidecars[0].status().State, Equals, types.ProcessStateStopped)
+}
+
 func assertProcessReplace(c *C, pm *Manager, name, binary string) {
 	replaceReq := &rpc.ProcessReplaceRequest{
 		Spec:            createProcessSpec(name, binary),
@@ -353,6 +781,62 @@ func assertProcessReplace(c *C, pm *Manager, name, binary string) {
 	c.Assert(rsp, NotNil)
 }
 
+func (s *TestSuite) TestProcessCreateValidateOnly(c *C) {
+	name := "test-process-validate-e-0"
+	assertProcessCreation(c, s.pm, name, TestBinary)
+
+	spec := createProcessSpec(name, TestBinary)
+	spec.PortCount = 30000
+	spec.Envs = map[string]string{"A=B": "c"}
+	_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec, ValidateOnly: true})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	fields := map[string]string{}
+	for _, violation := range util.GetFieldViolations(err) {
+		fields[violation.Field] = violation.Description
+	}
+	c.Assert(fields, HasLen, 4)
+	c.Assert(fields["spec.name"], Matches, ".*already exists")
+	c.Assert(fields["spec.binary"], Matches, "cannot find binary.*")
+	c.Assert(fields["spec.envs"], Not(Equals), "")
+	c.Assert(fields["spec.port_count"], Matches, "cannot find 30000 contiguous available ports.*")
+
+	newName := "test-process-validate-e-1"
+	_, err = s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{
+		Spec:         createProcessSpec(newName, "/engine-binaries/test/invalid"),
+		ValidateOnly: true,
+	})
+	fields = map[string]string{}
+	for _, violation := range util.GetFieldViolations(err) {
+		fields[violation.Field] = violation.Description
+	}
+	c.Assert(fields, HasLen, 1)
+	c.Assert(fields["spec.binary"], Equals, "unsupported binary invalid")
+	c.Assert(s.pm.findProcess(newName), IsNil)
+
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessCreateReplaceInvalidSpec(c *C) {
+	name := "test-process-invalid-spec-0"
+	logPath := filepath.Join(s.logDir, name+".log")
+	os.Remove(logPath)
+
+	spec := createProcessSpec(name, TestBinary)
+	spec.Envs = map[string]string{"A=B": "c"}
+	spec.StopTimeoutSeconds = -1
+	_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	c.Assert(util.GetFieldViolations(err), DeepEquals, validateProcessSpec(spec))
+	c.Assert(util.GetFieldViolations(err), HasLen, 2)
+	c.Assert(s.pm.findProcess(name), IsNil)
+	_, err = os.Stat(logPath)
+	c.Assert(os.IsNotExist(err), Equals, true)
+
+	_, err = s.pm.ProcessReplace(nil, &rpc.ProcessReplaceRequest{Spec: spec, TerminateSignal: "SIGHUP"})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	c.Assert(util.GetFieldViolations(err), HasLen, 2)
+}
+
 func assertProcessCreation(c *C, pm *Manager, name, binary string) {
 	createReq := &rpc.ProcessCreateRequest{
 		Spec: createProcessSpec(name, binary),
@@ -401,6 +885,411 @@ func createProcessSpec(name, binary string) *rpc.ProcessSpec {
 	}
 }
 
+func (s *TestSuite) TestEngineBinaryList(c *C) {
+	dir := c.MkDir()
+	defer func(dirs []string) {
+		engineBinaryDirectories = dirs
+	}(engineBinaryDirectories)
+	engineBinaryDirectories = []string{dir}
+
+	writeBinary := func(image, controllerAPIVersion string) string {
+		c.Assert(os.MkdirAll(filepath.Join(dir, image), 0755), IsNil)
+		binary := filepath.Join(dir, image, "longhorn")
+		script := "#!/bin/sh\necho '{\"clientVersion\": {\"version\": \"" + image + "\", " +
+			"\"controllerAPIVersion\": " + controllerAPIVersion + ", \"controllerAPIMinVersion\": 1, " +
+			"\"dataFormatVersion\": 1, \"dataFormatMinVersion\": 1}}'\n"
+		c.Assert(os.WriteFile(binary, []byte(script), 0755), IsNil)
+		return binary
+	}
+	compatible := writeBinary("compatible", "100")
+	incompatible := writeBinary("incompatible", "1")
+	c.Assert(os.MkdirAll(filepath.Join(dir, "not-deployed"), 0755), IsNil)
+
+	resp, err := s.pm.EngineBinaryList(context.Background(), &emptypb.Empty{})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Binaries, HasLen, 2)
+
+	c.Assert(resp.Binaries[0].Binary, Equals, compatible)
+	c.Assert(resp.Binaries[0].Image, Equals, "compatible")
+	c.Assert(resp.Binaries[0].Checksum, HasLen, 64)
+	c.Assert(resp.Binaries[0].Validation.Version, Equals, "compatible")
+	c.Assert(resp.Binaries[0].Validation.Compatible, Equals, true)
+
+	c.Assert(resp.Binaries[1].Binary, Equals, incompatible)
+	c.Assert(resp.Binaries[1].Validation.Compatible, Equals, false)
+
+	// The changed binary is scanned again rather than served from the cache
+	checksum := resp.Binaries[1].Checksum
+	writeBinary("incompatible", "100")
+	c.Assert(os.Chtimes(incompatible, time.Now(), time.Now().Add(time.Minute)), IsNil)
+	resp, err = s.pm.EngineBinaryList(context.Background(), &emptypb.Empty{})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Binaries, HasLen, 2)
+	c.Assert(resp.Binaries[1].Checksum, Not(Equals), checksum)
+	c.Assert(resp.Binaries[1].Validation.Compatible, Equals, true)
+}
+
+func (s *TestSuite) TestProcessRebuildModeSet(c *C) {
+	c.Assert(getReplicaDirectory([]string{"--volume-name", "vol", "replica", "/host/var/lib/longhorn/replicas/vol-1", "--size", "1024"}),
+		Equals, "/host/var/lib/longhorn/replicas/vol-1")
+	c.Assert(getReplicaDirectory([]string{"controller", "vol", "--frontend", "tgt-blockdev"}), Equals, "")
+
+	_, err := s.pm.ProcessRebuildModeSet(context.Background(), &rpc.ProcessRebuildModeSetRequest{Name: "missing", Enabled: true})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+	_, err = s.pm.ProcessRebuildModeSet(context.Background(), &rpc.ProcessRebuildModeSetRequest{})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+}
+
+func (s *TestSuite) TestProcessResourceLimits(c *C) {
+	name := "test-process-limits-e-0"
+
+	for _, spec := range []*rpc.ProcessSpec{
+		{Name: name, Binary: TestBinary, CpuMillicores: -1},
+		{Name: name, Binary: TestBinary, CpuMillicores: 5},
+		{Name: name, Binary: TestBinary, MemoryBytes: -1},
+	} {
+		_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+		c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	}
+
+	spec := createProcessSpec(name, TestBinary)
+	spec.CpuMillicores = 500
+	spec.MemoryBytes = 1 << 30
+	resp, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Spec.CpuMillicores, Equals, int64(500))
+	c.Assert(resp.Spec.MemoryBytes, Equals, int64(1<<30))
+
+	p := s.pm.findProcess(name)
+	c.Assert(p, NotNil)
+	c.Assert(p.cgroupName(), Equals, processCgroupPrefix+resp.Status.Uuid)
+	c.Assert(*p.resourceLimits(), Equals, util.CgroupLimits{CPUMaxMillicores: 500, MemoryMaxBytes: 1 << 30})
+
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessCPUAffinity(c *C) {
+	name := "test-process-affinity-e-0"
+
+	spec := createProcessSpec(name, TestBinary)
+	spec.CpuSet = "3-1"
+	_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	spec.CpuSet = "2-3,6"
+	spec.NumaNode = "0"
+	resp, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Spec.CpuSet, Equals, "2-3,6")
+	c.Assert(resp.Spec.NumaNode, Equals, "0")
+
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessPrivileges(c *C) {
+	name := "test-process-privileges-e-0"
+
+	spec := createProcessSpec(name, TestBinary)
+	spec.Privileges = &rpc.ProcessPrivileges{Capabilities: []string{"CAP_EVERYTHING"}}
+	_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	spec.Privileges = &rpc.ProcessPrivileges{Capabilities: []string{"CAP_SYS_ADMIN"}, NoNewPrivileges: true}
+	resp, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Spec.Privileges.Capabilities, DeepEquals, []string{"CAP_SYS_ADMIN"})
+
+	p := s.pm.findProcess(name)
+	c.Assert(p, NotNil)
+	self, err := os.Executable()
+	c.Assert(err, IsNil)
+	binary, args, err := p.getSpawnCommand()
+	c.Assert(err, IsNil)
+	c.Assert(binary, Equals, self)
+	c.Assert(args, DeepEquals, append([]string{SpawnCommand, "--capability", "CAP_SYS_ADMIN", "--no-new-privileges", "--", p.Binary}, p.Args...))
+
+	p.Privileges = nil
+	binary, args, err = p.getSpawnCommand()
+	c.Assert(err, IsNil)
+	c.Assert(binary, Equals, p.Binary)
+	c.Assert(args, DeepEquals, p.Args)
+
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessStopTimeout(c *C) {
+	name := "test-process-stop-timeout-e-0"
+
+	spec := createProcessSpec(name, TestBinary)
+	spec.StopTimeoutSeconds = -1
+	_, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	spec.StopTimeoutSeconds = 5
+	resp, err := s.pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{Spec: spec})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Spec.StopTimeoutSeconds, Equals, int64(5))
+
+	p := s.pm.findProcess(name)
+	c.Assert(p, NotNil)
+	c.Assert(p.getStopTimeout(0), Equals, 5*time.Second)
+	c.Assert(p.getStopTimeout(time.Second), Equals, time.Second)
+
+	_, err = s.pm.ProcessDelete(nil, &rpc.ProcessDeleteRequest{Name: name, StopTimeoutSeconds: -1})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	deleteResp, err := s.pm.ProcessDelete(nil, &rpc.ProcessDeleteRequest{Name: name, StopTimeoutSeconds: 1})
+	c.Assert(err, IsNil)
+	c.Assert(deleteResp.Deleted, Equals, true)
+	assertProcessDeletion(c, s.pm, name)
+}
+
+func (s *TestSuite) TestProcessAdoption(c *C) {
+	stateDir := c.MkDir()
+	pm, err := NewManager(context.Background(), "30001-30100", c.MkDir())
+	c.Assert(err, IsNil)
+	var detached *MockCommand
+	pm.Executor = &MockExecutor{
+		CreationHook: func(cmd *MockCommand) (*MockCommand, error) {
+			detached = cmd
+			return cmd, nil
+		},
+	}
+	pm.HealthChecker = &MockHealthChecker{}
+
+	running := exec.Command("sleep", "30")
+	c.Assert(running.Start(), IsNil)
+	exitCh := make(chan struct{})
+	go func() {
+		_ = running.Wait()
+		close(exitCh)
+	}()
+	defer running.Process.Kill()
+	startTime, err := util.GetProcessStartTime(running.Process.Pid)
+	c.Assert(err, IsNil)
+
+	name := "test_adopted_process"
+	uuid := generateUUID()
+	spec := createProcessSpec(name, TestBinary)
+	spec.Args = []string{"replica", "--listen", "localhost:30001"}
+	content, err := ProcessStateSchema.Marshal(&processStateFile{
+		Processes: []*processState{
+			{
+				Spec:      spec,
+				UUID:      uuid,
+				State:     StateRunning,
+				PortStart: 30001,
+				PortEnd:   30001,
+				Pid:       running.Process.Pid,
+				StartTime: startTime,
+			},
+			{
+				// The pid is reused by another process
+				Spec:      createProcessSpec("test_reused_pid_process", TestBinary),
+				UUID:      generateUUID(),
+				State:     StateRunning,
+				PortStart: 30002,
+				PortEnd:   30002,
+				Pid:       running.Process.Pid,
+				StartTime: startTime + 1,
+			},
+		},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(os.WriteFile(filepath.Join(stateDir, processStateFileName), content, 0644), IsNil)
+
+	adopted, err := pm.AdoptProcesses(stateDir)
+	c.Assert(err, IsNil)
+	c.Assert(adopted, Equals, 1)
+
+	resp, err := pm.ProcessGet(nil, &rpc.ProcessGetRequest{Name: name})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Status.State, Equals, types.ProcessStateRunning)
+	c.Assert(resp.Status.Uuid, Equals, uuid)
+	c.Assert(resp.Status.PortStart, Equals, int32(30001))
+	c.Assert(resp.Spec.Args, DeepEquals, spec.Args)
+	c.Assert(pm.availablePorts.IsAllocated(30001), Equals, true)
+	_, err = pm.ProcessGet(nil, &rpc.ProcessGetRequest{Name: "test_reused_pid_process"})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+
+	// The adopted process is persisted for the next adoption
+	pm.saveProcessState()
+	content, err = os.ReadFile(filepath.Join(stateDir, processStateFileName))
+	c.Assert(err, IsNil)
+	stateFile := &processStateFile{}
+	c.Assert(ProcessStateSchema.Unmarshal(content, stateFile), IsNil)
+	c.Assert(stateFile.Processes, HasLen, 1)
+	c.Assert(stateFile.Processes[0].UUID, Equals, uuid)
+	c.Assert(stateFile.Processes[0].Pid, Equals, running.Process.Pid)
+	c.Assert(stateFile.Processes[0].StartTime, Equals, startTime)
+
+	// The new processes are detached with the output through the log pipes
+	createResp, err := pm.ProcessCreate(nil, &rpc.ProcessCreateRequest{
+		Spec: createProcessSpec("test_adoptable_process", TestBinary),
+	})
+	c.Assert(err, IsNil)
+	c.Assert(detached.Detached, Equals, true)
+	logPipePath := pm.getLogPipePath(createResp.Status.Uuid)
+	info, err := os.Stat(logPipePath)
+	c.Assert(err, IsNil)
+	c.Assert(info.Mode()&os.ModeNamedPipe, Not(Equals), os.FileMode(0))
+
+	assertProcessDeletion(c, pm, "test_adoptable_process")
+	assertProcessDeletion(c, pm, name)
+	select {
+	case <-exitCh:
+	case <-time.After(RetryCount * RetryInterval):
+		c.Fatal("adopted process is not stopped")
+	}
+	deleted, err := waitForProcessListState(pm, func(processes map[string]*rpc.ProcessResponse) bool {
+		return len(processes) == 0
+	})
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, true)
+	_, err = os.Stat(logPipePath)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *TestSuite) TestProcessLogHandoff(c *C) {
+	stateDir := c.MkDir()
+	logsDir := c.MkDir()
+	name := "test_log_handoff_process"
+
+	// The previous instance manager stores the output of the process until it hands off the log
+	previous, err := NewManager(context.Background(), "30101-30200", logsDir)
+	c.Assert(err, IsNil)
+	previous.Executor = &MockExecutor{}
+	previous.HealthChecker = &MockHealthChecker{}
+	_, err = previous.AdoptProcesses(stateDir)
+	c.Assert(err, IsNil)
+	createResp, err := previous.ProcessCreate(nil, &rpc.ProcessCreateRequest{
+		Spec: createProcessSpec(name, TestBinary),
+	})
+	c.Assert(err, IsNil)
+	running, err := waitForProcessState(previous, name, func(process *rpc.ProcessResponse) bool {
+		return process.Status.State == types.ProcessStateRunning
+	})
+	c.Assert(err, IsNil)
+	c.Assert(running, Equals, true)
+
+	logPipePath := previous.getLogPipePath(createResp.Status.Uuid)
+	output, err := os.OpenFile(logPipePath, os.O_WRONLY, 0)
+	c.Assert(err, IsNil)
+	defer output.Close()
+	_, err = output.Write([]byte("first line\nsecond "))
+	c.Assert(err, IsNil)
+	logPath := filepath.Join(logsDir, name+".log")
+	stored := false
+	for i := 0; i < RetryCount && !stored; i++ {
+		content, _ := os.ReadFile(logPath)
+		stored = strings.Contains(string(content), "first line")
+		time.Sleep(RetryInterval)
+	}
+	c.Assert(stored, Equals, true)
+
+	previous.HandOffLogs()
+	p := previous.findProcess(name)
+	c.Assert(p.logHandoff, NotNil)
+	c.Assert(p.logHandoff.Sequence, Equals, uint64(1))
+	// The output in between the instance managers is kept in the log pipe
+	_, err = output.Write([]byte("half\nthird line\n"))
+	c.Assert(err, IsNil)
+	info, err := os.Stat(logPipePath)
+	c.Assert(err, IsNil)
+	c.Assert(info.Mode()&os.ModeNamedPipe, Not(Equals), os.FileMode(0))
+
+	// The next instance manager adopts the process and continues the log
+	sleeping := exec.Command("sleep", "30")
+	c.Assert(sleeping.Start(), IsNil)
+	defer sleeping.Process.Kill()
+	go func() {
+		_ = sleeping.Wait()
+	}()
+	startTime, err := util.GetProcessStartTime(sleeping.Process.Pid)
+	c.Assert(err, IsNil)
+	content, err := ProcessStateSchema.Marshal(&processStateFile{
+		Processes: []*processState{
+			{
+				Spec:      createResp.Spec,
+				UUID:      createResp.Status.Uuid,
+				State:     StateRunning,
+				PortStart: createResp.Status.PortStart,
+				PortEnd:   createResp.Status.PortEnd,
+				Pid:       sleeping.Process.Pid,
+				StartTime: startTime,
+				Log:       p.logHandoff,
+			},
+		},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(os.WriteFile(filepath.Join(stateDir, processStateFileName), content, 0644), IsNil)
+
+	next, err := NewManager(context.Background(), "30101-30200", logsDir)
+	c.Assert(err, IsNil)
+	next.Executor = &MockExecutor{}
+	next.HealthChecker = &MockHealthChecker{}
+	adopted, err := next.AdoptProcesses(stateDir)
+	c.Assert(err, IsNil)
+	c.Assert(adopted, Equals, 1)
+
+	var lines []*util.LogLine
+	for i := 0; i < RetryCount && len(lines) < 3; i++ {
+		time.Sleep(RetryInterval)
+		logChan, err := next.findProcess(name).logger.StreamLog(context.Background(), 0)
+		c.Assert(err, IsNil)
+		lines = nil
+		for line := range logChan {
+			lines = append(lines, line)
+		}
+	}
+	c.Assert(lines, HasLen, 3)
+	for i, expected := range []string{"first line", "second half", "third line"} {
+		c.Assert(lines[i].Sequence, Equals, uint64(i+1))
+		c.Assert(lines[i].Line, Equals, expected)
+	}
+
+	assertProcessDeletion(c, next, name)
+	assertProcessDeletion(c, previous, name)
+}
+
+func (s *TestSuite) TestProcessUpdateQueue(c *C) {
+	q := newProcessUpdateQueue(processUpdateStagePublish)
+	q.publish("a", 1)
+	q.publish("b", 2)
+	q.publish("a", 3)
+	select {
+	case <-q.ready():
+	default:
+		c.Fatal("no update is ready")
+	}
+	c.Assert(q.take(), DeepEquals, []interface{}{3, 2})
+	c.Assert(q.take(), HasLen, 0)
+
+	for i := 0; i < processUpdateQueueSize+1; i++ {
+		q.publish(strconv.Itoa(i), i)
+	}
+	c.Assert(q.take(), HasLen, processUpdateQueueSize)
+}
+
+func (s *TestSuite) TestProcessWatchBlocked(c *C) {
+	pw := &BlockedProcessWatcher{unblockCh: make(chan struct{})}
+	defer close(pw.unblockCh)
+	go s.pm.ProcessWatch(nil, pw)
+
+	// The stuck watcher must not hold back the state transitions of the processes
+	for i := 0; i < 10; i++ {
+		name := "test_process_watch_blocked-" + strconv.Itoa(i)
+		assertProcessCreation(c, s.pm, name, TestBinary)
+		assertProcessDeletion(c, s.pm, name)
+		deleted, err := waitForProcessListState(s.pm, func(processes map[string]*rpc.ProcessResponse) bool {
+			_, exists := processes[name]
+			return !exists
+		})
+		c.Assert(err, IsNil)
+		c.Assert(deleted, Equals, true)
+	}
+}
+
 func waitForProcessState(pm *Manager, name string, predicate func(process *rpc.ProcessResponse) bool) (bool, error) {
 	for j := 0; j < RetryCount; j++ {
 		getResp, err := pm.ProcessGet(nil, &rpc.ProcessGetRequest{
@@ -435,3 +1324,26 @@ func waitForProcessListState(pm *Manager, predicate func(processes map[string]*r
 	}
 	return false, nil
 }
+
+func (s *TestSuite) TestEngineBinarySandboxedCommand(c *C) {
+	workDir := c.MkDir()
+	cmd, err := newSandboxedCommand(context.Background(), workDir, TestBinary, "version", "--client-only")
+	c.Assert(err, IsNil)
+
+	// The binary is spawned with all the capabilities dropped, in a mount namespace of its own
+	self, err := os.Executable()
+	c.Assert(err, IsNil)
+	c.Assert(cmd.Path, Equals, self)
+	c.Assert(cmd.Args[1:], DeepEquals, []string{SpawnCommand, "--no-new-privileges", "--private-mounts", "--", TestBinary, "version", "--client-only"})
+	c.Assert(cmd.SysProcAttr.Cloneflags&syscall.CLONE_NEWNS, Not(Equals), uintptr(0))
+	c.Assert(cmd.Dir, Equals, workDir)
+
+	output, err := runSandboxedCommand(context.Background(), workDir, "/bin/sh", "-c",
+		"grep -E '^(CapEff|CapBnd|NoNewPrivs):' /proc/self/status; readlink /proc/self/ns/mnt")
+	c.Assert(err, IsNil)
+	mountNamespace, err := os.Readlink("/proc/self/ns/mnt")
+	c.Assert(err, IsNil)
+	fields := strings.Fields(string(output))
+	c.Assert(fields, DeepEquals, []string{"CapEff:", "0000000000000000", "CapBnd:", "0000000000000000", "NoNewPrivs:", "1", fields[6]})
+	c.Assert(fields[6], Not(Equals), mountNamespace)
+}
@@ -0,0 +1,100 @@
+package process
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
+)
+
+const (
+	processUpdateStagePublish = "publish"
+	processUpdateStageWatch   = "watch"
+
+	processUpdateDropReasonCoalesced = "coalesced"
+	processUpdateDropReasonOverflow  = "overflow"
+
+	// processUpdateQueueSize bounds the keys with a pending update in a queue, which is only reached if the
+	// consumer is stuck while the processes keep being created
+	processUpdateQueueSize = 4096
+	// processBroadcastBufferSize is the number of the process responses the watchers can lag behind the monitor
+	processBroadcastBufferSize = 1024
+)
+
+// processUpdateQueue passes the updates of the processes to a consumer without ever blocking the publishers, so
+// that a slow consumer cannot hold back the state transitions of the processes. An update is coalesced into the
+// pending one of the same key, since only the latest state matters to the consumer, so the queue holds at most one
+// update per key. An update of a new key is dropped once processUpdateQueueSize keys are pending.
+type processUpdateQueue struct {
+	lock    *sync.Mutex
+	stage   string
+	keys    []string
+	pending map[string]interface{}
+	// readyCh has a value once there is an update pending
+	readyCh chan struct{}
+}
+
+func newProcessUpdateQueue(stage string) *processUpdateQueue {
+	return &processUpdateQueue{
+		lock:    &sync.Mutex{},
+		stage:   stage,
+		pending: map[string]interface{}{},
+		readyCh: make(chan struct{}, 1),
+	}
+}
+
+func (q *processUpdateQueue) publish(key string, update interface{}) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if _, exists := q.pending[key]; exists {
+		q.pending[key] = update
+		recordProcessUpdateDrop(q.stage, processUpdateDropReasonCoalesced)
+		return
+	}
+	if len(q.keys) >= processUpdateQueueSize {
+		logrus.Warnf("Process Manager: dropped the %v update of %v since %v updates are pending", q.stage, key, len(q.keys))
+		recordProcessUpdateDrop(q.stage, processUpdateDropReasonOverflow)
+		return
+	}
+	q.keys = append(q.keys, key)
+	q.pending[key] = update
+
+	select {
+	case q.readyCh <- struct{}{}:
+	default:
+	}
+}
+
+// ready returns the channel to wait on for the pending updates.
+func (q *processUpdateQueue) ready() <-chan struct{} {
+	return q.readyCh
+}
+
+// take returns the pending updates in the order their keys were first published, and empties the queue.
+func (q *processUpdateQueue) take() []interface{} {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	updates := make([]interface{}, 0, len(q.keys))
+	for _, key := range q.keys {
+		updates = append(updates, q.pending[key])
+	}
+	q.keys = nil
+	q.pending = map[string]interface{}{}
+	return updates
+}
+
+func recordProcessUpdateDrop(stage, reason string) {
+	metrics.AddCounter(metrics.MetricProcessUpdatesDropped, map[string]string{
+		"stage":  stage,
+		"reason": reason,
+	}, 1)
+}
+
+// publishUpdate notifies the process manager of the change of the process without blocking. The change is sent to
+// the watchers along with the latest state of the process once the manager gets to it.
+func (p *Process) publishUpdate() {
+	p.updates.publish(p.UUID, p)
+}
@@ -0,0 +1,122 @@
+package process
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const rebuildCgroupPrefix = "rebuild-"
+
+// ProcessRebuildModeSet enters or leaves the rebuild mode of the process. The process along with its children, e.g.
+// the sync agent of a replica, is limited by the rebuild resource limits of the node while in the rebuild mode, so
+// that a rebuild cannot starve the foreground workloads. The process is left as it is if there are no rebuild
+// limits.
+func (pm *Manager) ProcessRebuildModeSet(ctx context.Context, req *rpc.ProcessRebuildModeSetRequest) (*rpc.ProcessResponse, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing required argument")
+	}
+
+	logrus.Infof("Process Manager: prepare to set rebuild mode %v of process %v", req.Enabled, req.Name)
+
+	p := pm.findProcess(req.Name)
+	if p == nil {
+		if !req.Enabled {
+			// The process deleted during the rebuild may leave the cgroup behind
+			pm.leaveRebuildMode(req.Name, "", true)
+		}
+		return nil, status.Errorf(codes.NotFound, "cannot find process %v", req.Name)
+	}
+	if !pm.RebuildLimits.Enabled() {
+		return p.RPCResponse(), nil
+	}
+
+	throttled := false
+	if req.Enabled {
+		pid := p.Pid()
+		if pid == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "process %v is not running", req.Name)
+		}
+		// The limits of the process itself still apply in the rebuild mode
+		limits := pm.RebuildLimits.Merge(p.resourceLimits())
+		if err := pm.enterRebuildMode(p.Name, pid, limits, getReplicaDirectory(p.Args)); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		throttled = true
+	} else {
+		throttled = !pm.leaveRebuildMode(p.Name, p.cgroupName(), false)
+	}
+
+	p.lock.Lock()
+	changed := p.Conditions[types.ProcessConditionRebuildThrottled] != throttled
+	p.Conditions[types.ProcessConditionRebuildThrottled] = throttled
+	p.lock.Unlock()
+	if changed {
+		p.publishUpdate()
+	}
+
+	return p.RPCResponse(), nil
+}
+
+// enterRebuildMode limits the process on entering the rebuild mode for the first time. The concurrent rebuilds the
+// process takes part in, e.g. as the source of two replicas, are counted so that it is relaxed after the last one.
+func (pm *Manager) enterRebuildMode(name string, pid int, limits *util.CgroupLimits, replicaDirectory string) error {
+	pm.rebuildLock.Lock()
+	defer pm.rebuildLock.Unlock()
+
+	if pm.rebuildModes[name] == 0 {
+		if err := util.LimitProcessTree(rebuildCgroupPrefix+name, pid, limits, replicaDirectory); err != nil {
+			return err
+		}
+		logrus.Infof("Process Manager: limited process %v for rebuilding", name)
+	}
+	pm.rebuildModes[name]++
+	return nil
+}
+
+// leaveRebuildMode relaxes the process on leaving the rebuild mode for the last time, or right away if forced, by
+// moving it back into the cgroup of the process if any. It returns true if the process is relaxed or not in the
+// rebuild mode.
+func (pm *Manager) leaveRebuildMode(name, processCgroup string, force bool) bool {
+	pm.rebuildLock.Lock()
+	defer pm.rebuildLock.Unlock()
+
+	if pm.rebuildModes[name] == 0 {
+		return true
+	}
+	if !force && pm.rebuildModes[name] > 1 {
+		pm.rebuildModes[name]--
+		return false
+	}
+	delete(pm.rebuildModes, name)
+	if err := util.UnlimitCgroupTo(rebuildCgroupPrefix+name, processCgroup); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to relax process %v after rebuilding", name)
+		return false
+	}
+	logrus.Infof("Process Manager: relaxed process %v after rebuilding", name)
+	return true
+}
+
+// getReplicaDirectory returns the directory of a replica process, i.e. the first absolute path following the replica
+// subcommand of the longhorn binary, or empty if the process is not a replica.
+func getReplicaDirectory(args []string) string {
+	for i, arg := range args {
+		if arg != "replica" {
+			continue
+		}
+		for _, replicaArg := range args[i+1:] {
+			if strings.HasPrefix(replicaArg, "/") {
+				return replicaArg
+			}
+		}
+		break
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package process
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	processCgroupPrefix = "process-"
+
+	// minProcessCPUMillicores is the quota of 1ms per period of cpu.max, below which the kernel refuses the limit
+	minProcessCPUMillicores = 10
+)
+
+// validateProcessResourceLimits validates the CPU and memory limits of a process, which are unlimited if 0.
+func validateProcessResourceLimits(cpuMillicores, memoryBytes int64) error {
+	if cpuMillicores < 0 || (cpuMillicores > 0 && cpuMillicores < minProcessCPUMillicores) {
+		return fmt.Errorf("invalid CPU limit %vm, should be 0 or at least %vm", cpuMillicores, minProcessCPUMillicores)
+	}
+	if memoryBytes < 0 {
+		return fmt.Errorf("invalid memory limit %v bytes", memoryBytes)
+	}
+	return nil
+}
+
+func (p *Process) resourceLimits() *util.CgroupLimits {
+	return &util.CgroupLimits{
+		CPUMaxMillicores: p.CPUMillicores,
+		MemoryMaxBytes:   p.MemoryBytes,
+	}
+}
+
+// cgroupName returns the name of the cgroup of the process, which is by the UUID rather than the name since the
+// replacement process runs along with the process it replaces for a while.
+func (p *Process) cgroupName() string {
+	return processCgroupPrefix + p.UUID
+}
+
+// applyResourceLimits places the process along with its children in the cgroup of the process once it is started.
+// The process is left running unlimited if it fails, without the ResourceLimited condition.
+func (p *Process) applyResourceLimits() {
+	if !p.resourceLimits().Enabled() {
+		return
+	}
+
+	pid := p.waitForPid()
+	if pid == 0 {
+		logrus.Warnf("Process Manager: process %v is not started in %v, cannot limit its resources",
+			p.Name, priorityClassApplyTimeout)
+		return
+	}
+	if err := util.LimitProcessTree(p.cgroupName(), pid, p.resourceLimits(), ""); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to limit the resources of process %v", p.Name)
+		return
+	}
+	// The exit of the process may be handled in the meantime, leaving the cgroup behind
+	if p.IsStopped() {
+		p.releaseResourceLimits()
+		return
+	}
+
+	p.lock.Lock()
+	p.Conditions[types.ProcessConditionResourceLimited] = true
+	p.lock.Unlock()
+	p.publishUpdate()
+	logrus.Infof("Process Manager: limited process %v to %vm CPU and %v bytes memory", p.Name, p.CPUMillicores, p.MemoryBytes)
+}
+
+// releaseResourceLimits removes the cgroup of the process once it exits. The children left behind are moved back
+// into the home cgroup.
+func (p *Process) releaseResourceLimits() {
+	if !p.resourceLimits().Enabled() {
+		return
+	}
+	if err := util.UnlimitCgroup(p.cgroupName()); err != nil {
+		logrus.WithError(err).Warnf("Process Manager: failed to remove the cgroup of process %v", p.Name)
+	}
+}
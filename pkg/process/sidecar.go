@@ -0,0 +1,212 @@
+package process
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+// Sidecar is a helper process tied to the lifecycle of a process, e.g. a liveness exporter. It is started after
+// the process is running, stopped before the process is stopped, and replaced along with the process.
+type Sidecar struct {
+	Name   string
+	Binary string
+	Args   []string
+
+	State    State
+	ErrorMsg string
+
+	lock   *sync.RWMutex
+	cmd    Command
+	exitCh chan struct{}
+
+	logsDir  string
+	executor Executor
+	env      []string
+}
+
+func newSidecars(specs []*rpc.ProcessSidecarSpec, logsDir string, executor Executor, env []string) []*Sidecar {
+	sidecars := []*Sidecar{}
+	for _, spec := range specs {
+		sidecars = append(sidecars, &Sidecar{
+			Name:   spec.Name,
+			Binary: spec.Binary,
+			Args:   spec.Args,
+
+			State: StateStarting,
+
+			lock: &sync.RWMutex{},
+
+			logsDir:  logsDir,
+			executor: executor,
+			env:      env,
+		})
+	}
+	return sidecars
+}
+
+// validateSidecarSpecs validates the sidecar specs. Unlike the process binary, the binary of a sidecar can be
+// any binary shipped along with the process binaries.
+func validateSidecarSpecs(specs []*rpc.ProcessSidecarSpec) error {
+	names := map[string]struct{}{}
+	for _, spec := range specs {
+		if spec.Name == "" || spec.Binary == "" {
+			return fmt.Errorf("missing required argument for sidecar")
+		}
+		if _, exists := names[spec.Name]; exists {
+			return fmt.Errorf("duplicate sidecar %v", spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+
+		dir, _, _ := decodeProcessPath(spec.Binary)
+		if !isValidDirectory(dir) {
+			return fmt.Errorf("unsupported sidecar %v path %v", spec.Name, spec.Binary)
+		}
+	}
+	return nil
+}
+
+func getSidecarLogName(processName, sidecarName string) string {
+	return processName + "-sidecar-" + sidecarName
+}
+
+// start starts the sidecar and calls the notify function whenever the sidecar state changes afterwards.
+func (s *Sidecar) start(processName string, notify func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.State != StateStarting {
+		return
+	}
+
+	logger, err := util.NewLonghornWriter(getSidecarLogName(processName, s.Name), s.logsDir)
+	if err != nil {
+		s.State = StateError
+		s.ErrorMsg = err.Error()
+		return
+	}
+	cmd, err := s.executor.NewCommand(s.Binary, s.Args...)
+	if err != nil {
+		s.State = StateError
+		s.ErrorMsg = err.Error()
+		if err := logger.Close(); err != nil {
+			logrus.WithError(err).Warnf("Process Manager: failed to close sidecar %v logger of process %v", s.Name, processName)
+		}
+		return
+	}
+	cmd.SetOutput(logger)
+	cmd.SetEnv(s.env)
+	s.cmd = cmd
+	s.exitCh = make(chan struct{})
+	s.State = StateRunning
+
+	go func() {
+		err := cmd.Run()
+		if err := logger.Close(); err != nil {
+			logrus.WithError(err).Warnf("Process Manager: failed to close sidecar %v logger of process %v", s.Name, processName)
+		}
+
+		s.lock.Lock()
+		if s.State == StateStopping {
+			s.State = StateStopped
+			logrus.Infof("Process Manager: sidecar %v of process %v stopped", s.Name, processName)
+		} else {
+			s.State = StateError
+			if err != nil {
+				s.ErrorMsg = err.Error()
+			} else {
+				s.ErrorMsg = "sidecar exited unexpectedly"
+			}
+			logrus.Infof("Process Manager: sidecar %v of process %v error out, error msg: %v", s.Name, processName, s.ErrorMsg)
+		}
+		close(s.exitCh)
+		s.lock.Unlock()
+
+		notify()
+	}()
+}
+
+// stop stops the sidecar and waits for it to exit. The sidecar is killed if it cannot be stopped gracefully.
+func (s *Sidecar) stop(processName string) {
+	s.lock.Lock()
+	if s.State == StateStarting {
+		// The sidecar never started, e.g. the process failed to become running
+		s.State = StateStopped
+	}
+	if s.State != StateRunning {
+		s.lock.Unlock()
+		return
+	}
+	s.State = StateStopping
+	cmd := s.cmd
+	exitCh := s.exitCh
+	s.lock.Unlock()
+
+	logrus.Infof("Process Manager: trying to stop sidecar %v of process %v", s.Name, processName)
+	cmd.StopWithSignal(syscall.SIGINT)
+	select {
+	case <-exitCh:
+		return
+	case <-time.After(time.Duration(types.WaitCount) * types.WaitInterval):
+	}
+	logrus.Warnf("Process Manager: cannot graceful stop sidecar %v of process %v in %v, will kill it",
+		s.Name, processName, time.Duration(types.WaitCount)*types.WaitInterval)
+	cmd.Kill()
+}
+
+func (s *Sidecar) spec() *rpc.ProcessSidecarSpec {
+	return &rpc.ProcessSidecarSpec{
+		Name:   s.Name,
+		Binary: s.Binary,
+		Args:   s.Args,
+	}
+}
+
+func (s *Sidecar) status() *rpc.ProcessSidecarStatus {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return &rpc.ProcessSidecarStatus{
+		Name:     s.Name,
+		State:    string(s.State),
+		ErrorMsg: s.ErrorMsg,
+	}
+}
+
+// startSidecars starts the sidecars once the process is running.
+func (p *Process) startSidecars() {
+	for _, s := range p.Sidecars {
+		s.start(p.Name, p.publishUpdate)
+	}
+	if len(p.Sidecars) != 0 {
+		p.publishUpdate()
+	}
+}
+
+// stopSidecars stops the sidecars concurrently and waits for them to exit.
+func (p *Process) stopSidecars() {
+	wg := &sync.WaitGroup{}
+	for _, s := range p.Sidecars {
+		wg.Add(1)
+		go func(s *Sidecar) {
+			defer wg.Done()
+			s.stop(p.Name)
+		}(s)
+	}
+	wg.Wait()
+}
+
+func (p *Process) sidecarSpecs() []*rpc.ProcessSidecarSpec {
+	specs := []*rpc.ProcessSidecarSpec{}
+	for _, s := range p.Sidecars {
+		specs = append(specs, s.spec())
+	}
+	return specs
+}
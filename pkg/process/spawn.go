@@ -0,0 +1,64 @@
+package process
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+// SpawnCommand is the hidden command of the instance manager the processes with restricted privileges are spawned
+// by. It restricts the privileges of itself and then executes the binary of the process, so that the restrictions
+// are in place before the binary runs.
+const SpawnCommand = "process-spawn"
+
+// getSpawnArgs returns the arguments of SpawnCommand executing the binary with the privileges. The mounts made by
+// the binary are kept in its own mount namespace if privateMounts is set, for which the caller must start
+// SpawnCommand in a new mount namespace.
+func getSpawnArgs(privileges *rpc.ProcessPrivileges, privateMounts bool, binary string, binaryArgs []string) []string {
+	args := []string{SpawnCommand}
+	for _, capability := range privileges.Capabilities {
+		args = append(args, "--capability", capability)
+	}
+	if privileges.NoNewPrivileges {
+		args = append(args, "--no-new-privileges")
+	}
+	if privateMounts {
+		args = append(args, "--private-mounts")
+	}
+	args = append(args, "--", binary)
+	return append(args, binaryArgs...)
+}
+
+// SpawnProcess restricts the privileges of the calling process and then executes the binary with the args in place
+// of it, so it only returns on failure. It is the action of SpawnCommand.
+func SpawnProcess(binary string, args, capabilityNames []string, noNewPrivileges, privateMounts bool) error {
+	capabilities, err := util.ParseCapabilities(capabilityNames)
+	if err != nil {
+		return err
+	}
+	binary, err = exec.LookPath(binary)
+	if err != nil {
+		return err
+	}
+
+	// The privileges are restricted for the thread executing the binary only, and so is it never unlocked
+	runtime.LockOSThread()
+	if privateMounts {
+		// The mounts are shared with the parent namespace if the root is a shared mount, so make them private
+		// before the capability to do so is dropped
+		if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+			return errors.Wrap(err, "failed to make the mounts private")
+		}
+	}
+	if err := util.RestrictPrivileges(capabilities, noNewPrivileges); err != nil {
+		return err
+	}
+	return errors.Wrapf(syscall.Exec(binary, append([]string{binary}, args...), os.Environ()), "failed to execute %v", binary)
+}
@@ -4,6 +4,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/api"
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 	"github.com/longhorn/longhorn-instance-manager/pkg/meta"
 )
@@ -20,5 +21,7 @@ func (pm *Manager) VersionGet(ctx context.Context, empty *emptypb.Empty) (*rpc.V
 
 		InstanceManagerProxyAPIVersion:    int64(v.InstanceManagerProxyAPIVersion),
 		InstanceManagerProxyAPIMinVersion: int64(v.InstanceManagerProxyAPIMinVersion),
+
+		Topology: api.TopologyToRPC(v.Topology),
 	}, nil
 }
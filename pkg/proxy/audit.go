@@ -0,0 +1,134 @@
+package proxy
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	// maxAuditRecordsPerVolume bounds the audit history kept in memory for each volume. The audit records are
+	// logged as well, so the older history can be recovered from the logs.
+	maxAuditRecordsPerVolume = 256
+
+	auditOperationVolumeSnapshot      = "VolumeSnapshot"
+	auditOperationSnapshotRevert      = "SnapshotRevert"
+	auditOperationSnapshotPurge       = "SnapshotPurge"
+	auditOperationSnapshotClone       = "SnapshotClone"
+	auditOperationSnapshotRemove      = "SnapshotRemove"
+	auditOperationSnapshotHash        = "SnapshotHash"
+	auditOperationSnapshotBackup      = "SnapshotBackup"
+	auditOperationBackupRestore       = "BackupRestore"
+	auditOperationBackupRestoreFinish = "BackupRestoreFinish"
+	// auditOperationSnapshotRetention is the removal of a snapshot by the retention policy of the volume
+	auditOperationSnapshotRetention = "SnapshotRetention"
+)
+
+// auditLog keeps the audit records of the data operations proxied to the engines, keyed by the volume name.
+type auditLog struct {
+	lock    *sync.RWMutex
+	records map[string][]*rpc.AuditRecord
+}
+
+func newAuditLog() *auditLog {
+	return &auditLog{
+		lock:    &sync.RWMutex{},
+		records: map[string][]*rpc.AuditRecord{},
+	}
+}
+
+// record records the completion of the operation on the engine of the request, tagged with the caller identity.
+func (a *auditLog) record(ctx context.Context, operation string, req *rpc.ProxyEngineRequest, details map[string]string, err error) {
+	identity, authenticated := getCallerIdentity(ctx)
+	record := &rpc.AuditRecord{
+		Timestamp:     time.Now().UTC().Format(time.RFC3339Nano),
+		Operation:     operation,
+		VolumeName:    req.VolumeName,
+		EngineName:    req.EngineName,
+		EngineAddress: req.Address,
+		DataEngine:    req.DataEngine,
+		Identity:      identity,
+		Authenticated: authenticated,
+		Details:       details,
+	}
+	if err != nil {
+		record.ErrorMsg = err.Error()
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"audit":         true,
+		"operation":     operation,
+		"volumeName":    req.VolumeName,
+		"engineName":    req.EngineName,
+		"serviceURL":    req.Address,
+		"dataEngine":    req.DataEngine,
+		"identity":      identity,
+		"authenticated": authenticated,
+		"details":       details,
+		"error":         record.ErrorMsg,
+	}).Info("Audited proxy data operation")
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	records := append(a.records[req.VolumeName], record)
+	if len(records) > maxAuditRecordsPerVolume {
+		records = records[len(records)-maxAuditRecordsPerVolume:]
+	}
+	a.records[req.VolumeName] = records
+}
+
+// list returns the audit records of the volume, or of all volumes if volumeName is empty, in chronological order.
+func (a *auditLog) list(volumeName string) []*rpc.AuditRecord {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	records := []*rpc.AuditRecord{}
+	if volumeName != "" {
+		return append(records, a.records[volumeName]...)
+	}
+	for _, volumeRecords := range a.records {
+		records = append(records, volumeRecords...)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		ti, _ := time.Parse(time.RFC3339Nano, records[i].Timestamp)
+		tj, _ := time.Parse(time.RFC3339Nano, records[j].Timestamp)
+		return ti.Before(tj)
+	})
+	return records
+}
+
+// getCallerIdentity returns the common name of the verified client certificate of the caller, or the peer address
+// if the caller is not authenticated by a client certificate.
+func getCallerIdentity(ctx context.Context) (identity string, authenticated bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown", false
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		for _, chain := range tlsInfo.State.VerifiedChains {
+			if len(chain) != 0 && chain[0].Subject.CommonName != "" {
+				return chain[0].Subject.CommonName, true
+			}
+		}
+	}
+	if p.Addr == nil {
+		return "unknown", false
+	}
+	return p.Addr.String(), false
+}
+
+func (p *Proxy) AuditRecordList(ctx context.Context, req *rpc.AuditRecordListRequest) (resp *rpc.AuditRecordListResponse, err error) {
+	logrus.WithFields(logrus.Fields{"volumeName": req.VolumeName}).Trace("Listing audit records")
+
+	return &rpc.AuditRecordListResponse{
+		Records: p.audit.list(req.VolumeName),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	backupstore "github.com/longhorn/backupstore"
+	btypes "github.com/longhorn/backupstore/types"
 	butil "github.com/longhorn/backupstore/util"
 	eclient "github.com/longhorn/longhorn-engine/pkg/controller/client"
 	rclient "github.com/longhorn/longhorn-engine/pkg/replica/client"
@@ -43,6 +45,21 @@ func (p *Proxy) SnapshotBackup(ctx context.Context, req *rpc.EngineSnapshotBacku
 	})
 	log.Infof("Backing up snapshot %v to backup %v", req.SnapshotName, req.BackupName)
 
+	defer func() {
+		details := map[string]string{
+			"snapshot":     req.SnapshotName,
+			"backup":       req.BackupName,
+			"backupTarget": req.BackupTarget,
+		}
+		if resp != nil {
+			details["backupID"] = resp.BackupId
+			if resp.Queued {
+				details["queuePosition"] = strconv.Itoa(int(resp.QueuePosition))
+			}
+		}
+		p.audit.record(ctx, auditOperationSnapshotBackup, req.ProxyEngineRequest, details, err)
+	}()
+
 	if err := setEnv(req.Envs); err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to set envs").Error())
 	}
@@ -58,7 +75,20 @@ func (p *Proxy) SnapshotBackup(ctx context.Context, req *rpc.EngineSnapshotBacku
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
-	return ops.SnapshotBackup(ctx, req, credential, labels)
+
+	endpoint := getBackupTargetEndpoint(req.BackupTarget, credential)
+	if position := p.backupScheduler.schedule(endpoint, req.BackupName, req.ProxyEngineRequest.VolumeName); position > 0 {
+		log.Infof("Queued backup %v at position %v for the limit of backup target endpoint %v", req.BackupName, position, endpoint)
+		return &rpc.EngineSnapshotBackupProxyResponse{
+			Queued:        true,
+			QueuePosition: int32(position),
+		}, nil
+	}
+	resp, err = ops.SnapshotBackup(ctx, req, credential, labels)
+	if err != nil {
+		p.backupScheduler.finish(req.BackupName)
+	}
+	return resp, err
 }
 
 func (ops V1DataEngineProxyOps) SnapshotBackup(ctx context.Context, req *rpc.EngineSnapshotBackupRequest, credential map[string]string, labels []string) (resp *rpc.EngineSnapshotBackupProxyResponse, err error) {
@@ -140,7 +170,18 @@ func (p *Proxy) SnapshotBackupStatus(ctx context.Context, req *rpc.EngineSnapsho
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
-	return ops.SnapshotBackupStatus(ctx, req)
+	resp, err = ops.SnapshotBackupStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch btypes.ProgressState(resp.State) {
+	case btypes.ProgressStateComplete, btypes.ProgressStateError, btypes.ProgressStateCanceled:
+		p.backupScheduler.finish(req.BackupName)
+	default:
+		p.backupScheduler.touch(req.BackupName)
+	}
+	return resp, nil
 }
 
 func (ops V1DataEngineProxyOps) SnapshotBackupStatus(ctx context.Context, req *rpc.EngineSnapshotBackupStatusRequest) (resp *rpc.EngineSnapshotBackupStatusProxyResponse, err error) {
@@ -251,6 +292,13 @@ func (p *Proxy) BackupRestore(ctx context.Context, req *rpc.EngineBackupRestoreR
 	})
 	log.Infof("Restoring backup %v to %v", req.Url, req.VolumeName)
 
+	defer func() {
+		p.audit.record(ctx, auditOperationBackupRestore, req.ProxyEngineRequest, map[string]string{
+			"backupURL":    req.Url,
+			"backupTarget": req.Target,
+		}, err)
+	}()
+
 	if err := setEnv(req.Envs); err != nil {
 		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to set envs").Error())
 	}
@@ -318,6 +366,10 @@ func (p *Proxy) BackupRestoreFinish(ctx context.Context, req *rpc.EngineBackupRe
 	})
 	log.Info("Finishing backup restoration")
 
+	defer func() {
+		p.audit.record(ctx, auditOperationBackupRestoreFinish, req.ProxyEngineRequest, nil, err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
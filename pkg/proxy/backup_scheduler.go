@@ -0,0 +1,344 @@
+package proxy
+
+import (
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	btypes "github.com/longhorn/backupstore/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	backupSchedulerInterval = time.Second
+	// backupSchedulerStaleTimeout releases the slot of an active backup whose status is not polled for this long,
+	// since the completion of a backup is only learnt from the status polling. A queued backup not scheduled again
+	// for this long is dropped from the queue as well.
+	backupSchedulerStaleTimeout = 5 * time.Minute
+)
+
+// BackupTargetLimit limits the backups to the same backup target endpoint. Zero means unlimited. The bandwidth of
+// the backups is not limited, since the backup data goes from the replicas to the backup target directly rather than
+// through the proxy.
+type BackupTargetLimit struct {
+	MaxConcurrentBackups int
+	MaxBackupsPerMinute  int
+}
+
+func (l BackupTargetLimit) toRPC() *rpc.BackupTargetLimit {
+	return &rpc.BackupTargetLimit{
+		MaxConcurrentBackups: int32(l.MaxConcurrentBackups),
+		MaxBackupsPerMinute:  int32(l.MaxBackupsPerMinute),
+	}
+}
+
+type scheduledBackup struct {
+	backupName string
+	volumeName string
+	since      time.Time
+	// lastSeen is when the status of the active backup is polled, or the queued backup is scheduled, last time
+	lastSeen time.Time
+}
+
+type backupTargetQueue struct {
+	active map[string]*scheduledBackup
+	queued []*scheduledBackup
+	// starts are the start times of the backups within the last minute
+	starts []time.Time
+}
+
+// backupScheduler queues the backups to the same backup target endpoint so that the concurrent backups and the
+// backups started per minute are within the limit of the endpoint, e.g. to avoid S3 throttling when lots of
+// backups are created at once. The callers are never blocked: a queued backup is reported back as such, and is
+// started once it is scheduled again after the dispatch has taken it out of the queue.
+type backupScheduler struct {
+	lock         *sync.Mutex
+	defaultLimit BackupTargetLimit
+	limits       map[string]BackupTargetLimit
+	queues       map[string]*backupTargetQueue
+	// endpoints maps the names of the active backups to their endpoints
+	endpoints map[string]string
+}
+
+func newBackupScheduler(defaultLimit BackupTargetLimit) *backupScheduler {
+	return &backupScheduler{
+		lock:         &sync.Mutex{},
+		defaultLimit: defaultLimit,
+		limits:       map[string]BackupTargetLimit{},
+		queues:       map[string]*backupTargetQueue{},
+		endpoints:    map[string]string{},
+	}
+}
+
+// getBackupTargetEndpoint returns the endpoint the backups to the backup target are sent to.
+func getBackupTargetEndpoint(backupTarget string, credential map[string]string) string {
+	u, err := url.Parse(backupTarget)
+	if err != nil {
+		return backupTarget
+	}
+	if u.Scheme == "s3" && credential[btypes.AWSEndPoint] != "" {
+		return credential[btypes.AWSEndPoint]
+	}
+	// The host of an S3 backup target URL s3://<bucket>@<region>/ is the region
+	return u.Scheme + "://" + u.Host
+}
+
+func (s *backupScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(backupSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.lock.Lock()
+			for endpoint := range s.queues {
+				s.dispatch(endpoint)
+			}
+			s.lock.Unlock()
+		}
+	}
+}
+
+func (s *backupScheduler) getLimit(endpoint string) BackupTargetLimit {
+	if limit, exists := s.limits[endpoint]; exists {
+		return limit
+	}
+	return s.defaultLimit
+}
+
+// dispatch expires the stale active and queued backups of the endpoint, and takes the queued backups within the limit
+// out of the queue as active backups. The caller must hold the lock.
+func (s *backupScheduler) dispatch(endpoint string) {
+	q := s.queues[endpoint]
+	limit := s.getLimit(endpoint)
+	now := time.Now()
+
+	for name, backup := range q.active {
+		if now.Sub(backup.lastSeen) > backupSchedulerStaleTimeout {
+			logrus.Warnf("Releasing the backup scheduler slot of backup %v to %v since its status is not polled for %v",
+				name, endpoint, backupSchedulerStaleTimeout)
+			delete(q.active, name)
+			delete(s.endpoints, name)
+		}
+	}
+	queued := q.queued[:0]
+	for _, backup := range q.queued {
+		if now.Sub(backup.lastSeen) > backupSchedulerStaleTimeout {
+			logrus.Warnf("Dropping queued backup %v to %v since it is not scheduled again for %v",
+				backup.backupName, endpoint, backupSchedulerStaleTimeout)
+			continue
+		}
+		queued = append(queued, backup)
+	}
+	q.queued = queued
+	starts := q.starts[:0]
+	for _, start := range q.starts {
+		if now.Sub(start) < time.Minute {
+			starts = append(starts, start)
+		}
+	}
+	q.starts = starts
+
+	for len(q.queued) != 0 {
+		if limit.MaxConcurrentBackups > 0 && len(q.active) >= limit.MaxConcurrentBackups {
+			break
+		}
+		if limit.MaxBackupsPerMinute > 0 && len(q.starts) >= limit.MaxBackupsPerMinute {
+			break
+		}
+		backup := q.queued[0]
+		q.queued = q.queued[1:]
+		s.activate(endpoint, backup, now)
+	}
+
+	if len(q.active) == 0 && len(q.queued) == 0 && len(q.starts) == 0 {
+		delete(s.queues, endpoint)
+	}
+}
+
+func (s *backupScheduler) activate(endpoint string, backup *scheduledBackup, now time.Time) {
+	q := s.queues[endpoint]
+	backup.since = now
+	backup.lastSeen = now
+	q.active[backup.backupName] = backup
+	q.starts = append(q.starts, now)
+	s.endpoints[backup.backupName] = endpoint
+}
+
+// schedule starts the backup if it is within the limit of the endpoint, or queues it otherwise. It returns the
+// position of the backup in the queue starting from 1, or 0 if the backup is started, in which case the backup must be
+// released by finish if it fails to start. A backup already started is started again, since it is retried by the
+// caller.
+func (s *backupScheduler) schedule(endpoint, backupName, volumeName string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	q, exists := s.queues[endpoint]
+	if !exists {
+		q = &backupTargetQueue{
+			active: map[string]*scheduledBackup{},
+		}
+		s.queues[endpoint] = q
+	}
+	now := time.Now()
+	if backup, exists := q.active[backupName]; exists {
+		backup.lastSeen = now
+		return 0
+	}
+	if position := getBackupQueuePosition(q, backupName); position > 0 {
+		q.queued[position-1].lastSeen = now
+	} else {
+		q.queued = append(q.queued, &scheduledBackup{
+			backupName: backupName,
+			volumeName: volumeName,
+			since:      now,
+			lastSeen:   now,
+		})
+	}
+	s.dispatch(endpoint)
+
+	if _, exists := q.active[backupName]; exists {
+		return 0
+	}
+	return getBackupQueuePosition(q, backupName)
+}
+
+func getBackupQueuePosition(q *backupTargetQueue, backupName string) int {
+	for i, backup := range q.queued {
+		if backup.backupName == backupName {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// touch records that the active backup is still in progress.
+func (s *backupScheduler) touch(backupName string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	endpoint, exists := s.endpoints[backupName]
+	if !exists {
+		return
+	}
+	if backup, exists := s.queues[endpoint].active[backupName]; exists {
+		backup.lastSeen = time.Now()
+	}
+}
+
+// finish releases the slot of the active backup and starts the next queued backups.
+func (s *backupScheduler) finish(backupName string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	endpoint, exists := s.endpoints[backupName]
+	if !exists {
+		return
+	}
+	delete(s.endpoints, backupName)
+	delete(s.queues[endpoint].active, backupName)
+	s.dispatch(endpoint)
+}
+
+func (s *backupScheduler) setLimit(endpoint string, limit *BackupTargetLimit) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if limit == nil {
+		delete(s.limits, endpoint)
+	} else {
+		s.limits[endpoint] = *limit
+	}
+	if _, exists := s.queues[endpoint]; exists {
+		s.dispatch(endpoint)
+	}
+}
+
+func (s *backupScheduler) getStatus(endpoint string) []*rpc.BackupTargetSchedulerStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	endpoints := map[string]struct{}{}
+	for e := range s.queues {
+		endpoints[e] = struct{}{}
+	}
+	for e := range s.limits {
+		endpoints[e] = struct{}{}
+	}
+
+	statuses := []*rpc.BackupTargetSchedulerStatus{}
+	for e := range endpoints {
+		if endpoint != "" && e != endpoint {
+			continue
+		}
+		status := &rpc.BackupTargetSchedulerStatus{
+			Endpoint:      e,
+			Limit:         s.getLimit(e).toRPC(),
+			ActiveBackups: []*rpc.ScheduledBackup{},
+			QueuedBackups: []*rpc.ScheduledBackup{},
+		}
+		if q, exists := s.queues[e]; exists {
+			active := []*scheduledBackup{}
+			for _, backup := range q.active {
+				active = append(active, backup)
+			}
+			sort.Slice(active, func(i, j int) bool { return active[i].since.Before(active[j].since) })
+			for _, backup := range active {
+				status.ActiveBackups = append(status.ActiveBackups, backup.toRPC())
+			}
+			for _, backup := range q.queued {
+				status.QueuedBackups = append(status.QueuedBackups, backup.toRPC())
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Endpoint < statuses[j].Endpoint })
+	return statuses
+}
+
+func (b *scheduledBackup) toRPC() *rpc.ScheduledBackup {
+	return &rpc.ScheduledBackup{
+		BackupName: b.backupName,
+		VolumeName: b.volumeName,
+		Since:      b.since.UTC().Format(time.RFC3339),
+	}
+}
+
+func (p *Proxy) BackupSchedulerStatusGet(ctx context.Context, req *rpc.BackupSchedulerStatusGetRequest) (resp *rpc.BackupSchedulerStatusGetResponse, err error) {
+	logrus.WithFields(logrus.Fields{"endpoint": req.Endpoint}).Trace("Getting backup scheduler status")
+
+	return &rpc.BackupSchedulerStatusGetResponse{
+		Targets: p.backupScheduler.getStatus(req.Endpoint),
+	}, nil
+}
+
+func (p *Proxy) BackupTargetLimitSet(ctx context.Context, req *rpc.BackupTargetLimitSetRequest) (resp *emptypb.Empty, err error) {
+	if req.Endpoint == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument endpoint")
+	}
+
+	var limit *BackupTargetLimit
+	if req.Limit != nil {
+		if req.Limit.MaxConcurrentBackups < 0 || req.Limit.MaxBackupsPerMinute < 0 {
+			return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid backup target limit %+v", req.Limit)
+		}
+		limit = &BackupTargetLimit{
+			MaxConcurrentBackups: int(req.Limit.MaxConcurrentBackups),
+			MaxBackupsPerMinute:  int(req.Limit.MaxBackupsPerMinute),
+		}
+	}
+	logrus.WithFields(logrus.Fields{"endpoint": req.Endpoint}).Infof("Setting backup target limit to %+v", limit)
+
+	p.backupScheduler.setLimit(req.Endpoint, limit)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,130 @@
+package proxy
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	btypes "github.com/longhorn/backupstore/types"
+)
+
+func getScheduledBackupNames(backups []*scheduledBackup) []string {
+	names := []string{}
+	for _, backup := range backups {
+		names = append(names, backup.backupName)
+	}
+	return names
+}
+
+func (s *TestSuite) TestGetBackupTargetEndpoint(c *C) {
+	testCases := []struct {
+		backupTarget string
+		credential   map[string]string
+		endpoint     string
+	}{
+		{"s3://backups@us-east-1/", nil, "s3://us-east-1"},
+		{"s3://backups@us-east-1/", map[string]string{btypes.AWSEndPoint: "https://minio:9000"}, "https://minio:9000"},
+		{"nfs://longhorn-nfs:/opt/backupstore", map[string]string{btypes.AWSEndPoint: "https://minio:9000"}, "nfs://longhorn-nfs:"},
+		{"cifs://longhorn-cifs/backupstore", nil, "cifs://longhorn-cifs"},
+	}
+	for i, testCase := range testCases {
+		c.Assert(getBackupTargetEndpoint(testCase.backupTarget, testCase.credential), Equals, testCase.endpoint,
+			Commentf("test case %v: backup target %v", i, testCase.backupTarget))
+	}
+}
+
+func (s *TestSuite) TestBackupSchedulerSchedule(c *C) {
+	endpoint := "s3://us-east-1"
+	scheduler := newBackupScheduler(BackupTargetLimit{MaxConcurrentBackups: 2})
+
+	// The backups beyond the limit are queued rather than blocked
+	c.Assert(scheduler.schedule(endpoint, "backup-0", "vol-0"), Equals, 0)
+	c.Assert(scheduler.schedule(endpoint, "backup-1", "vol-1"), Equals, 0)
+	c.Assert(scheduler.schedule(endpoint, "backup-2", "vol-2"), Equals, 1)
+	c.Assert(scheduler.schedule(endpoint, "backup-3", "vol-3"), Equals, 2)
+	c.Assert(scheduler.schedule("s3://eu-west-1", "backup-4", "vol-4"), Equals, 0)
+
+	// Retrying keeps the position of the queued backup, and starts the active backup again
+	c.Assert(scheduler.schedule(endpoint, "backup-3", "vol-3"), Equals, 2)
+	c.Assert(scheduler.schedule(endpoint, "backup-0", "vol-0"), Equals, 0)
+	c.Assert(getScheduledBackupNames(scheduler.queues[endpoint].queued), DeepEquals, []string{"backup-2", "backup-3"})
+
+	// The next queued backup is taken out of the queue once a slot is released, and is started when retried
+	scheduler.finish("backup-0")
+	scheduler.finish("backup-unknown")
+	c.Assert(getScheduledBackupNames(scheduler.queues[endpoint].queued), DeepEquals, []string{"backup-3"})
+	c.Assert(scheduler.schedule(endpoint, "backup-3", "vol-3"), Equals, 1)
+	c.Assert(scheduler.schedule(endpoint, "backup-2", "vol-2"), Equals, 0)
+
+	statuses := scheduler.getStatus(endpoint)
+	c.Assert(statuses, HasLen, 1)
+	c.Assert(statuses[0].Limit.MaxConcurrentBackups, Equals, int32(2))
+	c.Assert(statuses[0].ActiveBackups, HasLen, 2)
+	c.Assert(statuses[0].QueuedBackups, HasLen, 1)
+	c.Assert(statuses[0].QueuedBackups[0].BackupName, Equals, "backup-3")
+	c.Assert(scheduler.getStatus(""), HasLen, 2)
+
+	// Raising the limit of the endpoint dispatches the queue right away
+	scheduler.setLimit(endpoint, &BackupTargetLimit{MaxConcurrentBackups: 3})
+	c.Assert(scheduler.queues[endpoint].queued, HasLen, 0)
+	c.Assert(scheduler.queues[endpoint].active, HasLen, 3)
+
+	for _, name := range []string{"backup-1", "backup-2", "backup-3", "backup-4"} {
+		scheduler.finish(name)
+	}
+	c.Assert(scheduler.endpoints, HasLen, 0)
+	// The queue of the endpoint is kept for the starts within the last minute
+	c.Assert(scheduler.queues[endpoint].active, HasLen, 0)
+	c.Assert(scheduler.queues[endpoint].starts, HasLen, 4)
+}
+
+func (s *TestSuite) TestBackupSchedulerDispatch(c *C) {
+	endpoint := "s3://us-east-1"
+	scheduler := newBackupScheduler(BackupTargetLimit{MaxBackupsPerMinute: 2})
+
+	c.Assert(scheduler.schedule(endpoint, "backup-0", "vol-0"), Equals, 0)
+	c.Assert(scheduler.schedule(endpoint, "backup-1", "vol-1"), Equals, 0)
+	c.Assert(scheduler.schedule(endpoint, "backup-2", "vol-2"), Equals, 1)
+
+	// The per minute limit holds after the backups are done
+	scheduler.finish("backup-0")
+	scheduler.finish("backup-1")
+	c.Assert(scheduler.schedule(endpoint, "backup-2", "vol-2"), Equals, 1)
+
+	// The starts older than a minute no longer count
+	q := scheduler.queues[endpoint]
+	for i := range q.starts {
+		q.starts[i] = q.starts[i].Add(-time.Minute)
+	}
+	scheduler.lock.Lock()
+	scheduler.dispatch(endpoint)
+	scheduler.lock.Unlock()
+	c.Assert(q.active, HasLen, 1)
+	c.Assert(q.active["backup-2"], NotNil)
+
+	// The stale active and queued backups are dropped
+	c.Assert(scheduler.schedule(endpoint, "backup-3", "vol-3"), Equals, 0)
+	c.Assert(scheduler.schedule(endpoint, "backup-4", "vol-4"), Equals, 1)
+	stale := time.Now().Add(-backupSchedulerStaleTimeout - time.Second)
+	q.active["backup-2"].lastSeen = stale
+	q.queued[0].lastSeen = stale
+	scheduler.touch("backup-3")
+	scheduler.lock.Lock()
+	scheduler.dispatch(endpoint)
+	scheduler.lock.Unlock()
+	c.Assert(q.active, HasLen, 1)
+	c.Assert(q.active["backup-3"], NotNil)
+	c.Assert(q.queued, HasLen, 0)
+	_, exists := scheduler.endpoints["backup-2"]
+	c.Assert(exists, Equals, false)
+
+	// The queue of the endpoint is removed once nothing is left
+	scheduler.finish("backup-3")
+	for i := range q.starts {
+		q.starts[i] = q.starts[i].Add(-time.Minute)
+	}
+	scheduler.lock.Lock()
+	scheduler.dispatch(endpoint)
+	scheduler.lock.Unlock()
+	c.Assert(scheduler.queues, HasLen, 0)
+}
@@ -0,0 +1,213 @@
+package proxy
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	commonTypes "github.com/longhorn/go-common-libs/types"
+	helperutil "github.com/longhorn/go-spdk-helper/pkg/util"
+	eclient "github.com/longhorn/longhorn-engine/pkg/controller/client"
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+)
+
+const (
+	iscsiTargetNamePrefix = "iqn.2019-10.io.longhorn:"
+
+	iscsiadmBinary = "iscsiadm"
+	tgtadmBinary   = "tgtadm"
+
+	frontendStatsCommandTimeout = 10 * time.Second
+)
+
+func (p *Proxy) VolumeFrontendStatsGet(ctx context.Context, req *rpc.ProxyEngineRequest) (resp *rpc.EngineVolumeFrontendStatsGetProxyResponse, err error) {
+	log := logrus.WithFields(logrus.Fields{
+		"serviceURL": req.Address,
+		"engineName": req.EngineName,
+		"volumeName": req.VolumeName,
+		"dataEngine": req.DataEngine,
+	})
+	log.Trace("Getting volume frontend stats")
+
+	ops, ok := p.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	return ops.VolumeFrontendStatsGet(ctx, req)
+}
+
+func (ops V1DataEngineProxyOps) VolumeFrontendStatsGet(ctx context.Context, req *rpc.ProxyEngineRequest) (resp *rpc.EngineVolumeFrontendStatsGetProxyResponse, err error) {
+	c, err := eclient.NewControllerClient(req.Address, req.VolumeName, req.EngineName)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	volume, err := c.VolumeGet()
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &rpc.EngineVolumeFrontendStatsGetProxyResponse{
+		Frontend: volume.Frontend,
+		Endpoint: volume.Endpoint,
+	}
+	if volume.Frontend != etypes.EngineFrontendBlockDev && volume.Frontend != etypes.EngineFrontendISCSI {
+		return resp, nil
+	}
+
+	resp.TargetName = iscsiTargetNamePrefix + volume.Name
+
+	// tgtd runs inside the instance manager container
+	output, err := util.ExecuteWithContext(ctx, frontendStatsCommandTimeout, tgtadmBinary, "--lld", "iscsi", "--mode", "target", "--op", "show")
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrap(err, "failed to get tgt targets").Error())
+	}
+	resp.TargetConnections = parseTgtTargetConnections(output, resp.TargetName)
+
+	// The initiator sessions only exist on this node for the blockdev frontend, which logs in from the host
+	if volume.Frontend == etypes.EngineFrontendBlockDev {
+		sessions, err := getISCSISessionStats(ctx, resp.TargetName)
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to get iSCSI session stats of target %v", resp.TargetName).Error())
+		}
+		resp.Sessions = sessions
+	}
+
+	return resp, nil
+}
+
+func (ops V2DataEngineProxyOps) VolumeFrontendStatsGet(ctx context.Context, req *rpc.ProxyEngineRequest) (resp *rpc.EngineVolumeFrontendStatsGetProxyResponse, err error) {
+	// The v2 data engine exposes volumes via NVMe-oF rather than tgt
+	return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "not implemented")
+}
+
+// parseTgtTargetConnections counts the iSCSI connections of the target in the output of
+// "tgtadm --lld iscsi --mode target --op show".
+func parseTgtTargetConnections(output, targetName string) int32 {
+	connections := int32(0)
+	inTarget := false
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "Target ") {
+			// e.g. "Target 1: iqn.2019-10.io.longhorn:vol"
+			fields := strings.Fields(line)
+			inTarget = fields[len(fields)-1] == targetName
+			continue
+		}
+		if inTarget && strings.HasPrefix(line, "Connection:") {
+			connections++
+		}
+	}
+	return connections
+}
+
+// getISCSISessionStats gets the stats by iscsiadm in the host namespaces. The namespace executor cannot take a context,
+// so the commands are bounded by the deadline of the context instead.
+func getISCSISessionStats(ctx context.Context, targetName string) ([]*rpc.ISCSISessionStats, error) {
+	executor, err := helperutil.NewExecutor(commonTypes.ProcDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	output, err := executor.Execute(iscsiadmBinary, []string{"-m", "session"}, util.CommandTimeout(ctx, iscsiadmBinary, frontendStatsCommandTimeout))
+	if err != nil {
+		// iscsiadm exits with 21 if there is no active session
+		if strings.Contains(err.Error(), "No active sessions") {
+			return []*rpc.ISCSISessionStats{}, nil
+		}
+		return nil, err
+	}
+
+	sessions := []*rpc.ISCSISessionStats{}
+	for _, session := range parseISCSISessions(output) {
+		if session.TargetName != targetName {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stats, err := executor.Execute(iscsiadmBinary, []string{"-m", "session", "-r", strconv.Itoa(int(session.SessionId)), "-s"}, util.CommandTimeout(ctx, iscsiadmBinary, frontendStatsCommandTimeout))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get stats of iSCSI session %v", session.SessionId)
+		}
+		parseISCSISessionStats(stats, session)
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// parseISCSISessions parses the output of "iscsiadm -m session", e.g.
+// "tcp: [1] 10.42.0.1:3260,1 iqn.2019-10.io.longhorn:vol (non-flash)".
+func parseISCSISessions(output string) []*rpc.ISCSISessionStats {
+	sessions := []*rpc.ISCSISessionStats{}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		id, err := strconv.Atoi(strings.Trim(fields[1], "[]"))
+		if err != nil {
+			continue
+		}
+		sessions = append(sessions, &rpc.ISCSISessionStats{
+			SessionId:  int32(id),
+			Portal:     strings.Split(fields[2], ",")[0],
+			TargetName: fields[3],
+		})
+	}
+	return sessions
+}
+
+// parseISCSISessionStats fills in the counters from the output of "iscsiadm -m session -r <sid> -s", which lists
+// the iSCSI SNMP and the transport specific extended stats as "<name>: <value>" lines.
+func parseISCSISessionStats(output string, session *rpc.ISCSISessionStats) {
+	counters := map[string]*int64{
+		"txdata_octets": &session.TxDataOctets,
+		"rxdata_octets": &session.RxDataOctets,
+		"scsicmd_pdus":  &session.ScsiCommandPdus,
+		"scsirsp_pdus":  &session.ScsiResponsePdus,
+		"dataout_pdus":  &session.DataOutPdus,
+		"datain_pdus":   &session.DataInPdus,
+		"tmfcmd_pdus":   &session.TaskManagementCommandPdus,
+		"tmfrsp_pdus":   &session.TaskManagementResponsePdus,
+		"digest_err":    &session.DigestErrors,
+		"timeout_err":   &session.TimeoutErrors,
+		"eh_abort_cnt":  &session.AbortCount,
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		parts := strings.SplitN(strings.TrimSpace(scanner.Text()), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		counter, ok := counters[parts[0]]
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil {
+			continue
+		}
+		*counter = value
+	}
+}
@@ -0,0 +1,147 @@
+package proxy
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct{}
+
+var _ = Suite(&TestSuite{})
+
+const testTgtTargetShowOutput = `Target 1: iqn.2019-10.io.longhorn:vol
+    System information:
+        Driver: iscsi
+        State: ready
+    I_T nexus information:
+        I_T nexus: 1
+            Initiator: iqn.1993-08.org.debian:01:node-1 alias: node-1
+            Connection: 0
+                IP Address: 10.42.0.1
+        I_T nexus: 2
+            Initiator: iqn.1993-08.org.debian:01:node-2 alias: node-2
+            Connection: 0
+                IP Address: 10.42.0.2
+    LUN information:
+        LUN: 0
+            Type: controller
+        LUN: 1
+            Type: disk
+            Backing store path: /var/run/longhorn-vol.sock
+    Account information:
+    ACL information:
+        ALL
+Target 2: iqn.2019-10.io.longhorn:vol-2
+    System information:
+        Driver: iscsi
+        State: ready
+    I_T nexus information:
+        I_T nexus: 3
+            Initiator: iqn.1993-08.org.debian:01:node-1 alias: node-1
+            Connection: 0
+                IP Address: 10.42.0.1
+    LUN information:
+        LUN: 0
+            Type: controller
+`
+
+const testISCSISessionStatsOutput = `Stats for session [sid: 2, target: iqn.2019-10.io.longhorn:vol, portal: 10.42.0.1,3260]
+iSCSI SNMP:
+	txdata_octets: 7340032
+	rxdata_octets: 1048576
+	noptx_pdus: 0
+	scsicmd_pdus: 300
+	tmfcmd_pdus: 1
+	login_pdus: 0
+	text_pdus: 0
+	dataout_pdus: 112
+	logout_pdus: 0
+	snack_pdus: 0
+	noprx_pdus: 0
+	scsirsp_pdus: 299
+	tmfrsp_pdus: 1
+	textrsp_pdus: 0
+	datain_pdus: 16
+	logoutrsp_pdus: 0
+	r2t_pdus: 56
+	async_pdus: 0
+	rjt_pdus: 0
+	digest_err: 0
+	timeout_err: 3
+iSCSI Extended Stats:
+	tx_sendpage_failures: 0
+	rx_discontiguous_hdr: 0
+	eh_abort_cnt: 2
+`
+
+func (s *TestSuite) TestParseTgtTargetConnections(c *C) {
+	testCases := []struct {
+		output      string
+		targetName  string
+		connections int32
+	}{
+		{testTgtTargetShowOutput, "iqn.2019-10.io.longhorn:vol", 2},
+		{testTgtTargetShowOutput, "iqn.2019-10.io.longhorn:vol-2", 1},
+		{testTgtTargetShowOutput, "iqn.2019-10.io.longhorn:vo", 0},
+		{"", "iqn.2019-10.io.longhorn:vol", 0},
+	}
+	for i, testCase := range testCases {
+		c.Assert(parseTgtTargetConnections(testCase.output, testCase.targetName), Equals, testCase.connections,
+			Commentf("test case %v: target %v", i, testCase.targetName))
+	}
+}
+
+func (s *TestSuite) TestParseISCSISessions(c *C) {
+	output := "tcp: [1] 10.42.0.1:3260,1 iqn.2019-10.io.longhorn:vol-2 (non-flash)\n" +
+		"tcp: [2] 10.42.0.1:3260,1 iqn.2019-10.io.longhorn:vol (non-flash)\n" +
+		"tcp: [3] [fd00::1]:3260,1 iqn.2019-10.io.longhorn:vol (non-flash)\n" +
+		"tcp: [x] 10.42.0.1:3260,1 iqn.2019-10.io.longhorn:vol (non-flash)\n" +
+		"iscsiadm: No active sessions.\n"
+
+	sessions := parseISCSISessions(output)
+	c.Assert(sessions, HasLen, 3)
+	expected := []struct {
+		id         int32
+		portal     string
+		targetName string
+	}{
+		{1, "10.42.0.1:3260", "iqn.2019-10.io.longhorn:vol-2"},
+		{2, "10.42.0.1:3260", "iqn.2019-10.io.longhorn:vol"},
+		{3, "[fd00::1]:3260", "iqn.2019-10.io.longhorn:vol"},
+	}
+	for i, e := range expected {
+		comment := Commentf("session %v", i)
+		c.Assert(sessions[i].SessionId, Equals, e.id, comment)
+		c.Assert(sessions[i].Portal, Equals, e.portal, comment)
+		c.Assert(sessions[i].TargetName, Equals, e.targetName, comment)
+	}
+	c.Assert(parseISCSISessions(""), HasLen, 0)
+}
+
+func (s *TestSuite) TestParseISCSISessionStats(c *C) {
+	session := &rpc.ISCSISessionStats{SessionId: 2}
+	parseISCSISessionStats(testISCSISessionStatsOutput, session)
+	c.Assert(session.SessionId, Equals, int32(2))
+	c.Assert(session.TxDataOctets, Equals, int64(7340032))
+	c.Assert(session.RxDataOctets, Equals, int64(1048576))
+	c.Assert(session.ScsiCommandPdus, Equals, int64(300))
+	c.Assert(session.ScsiResponsePdus, Equals, int64(299))
+	c.Assert(session.DataOutPdus, Equals, int64(112))
+	c.Assert(session.DataInPdus, Equals, int64(16))
+	c.Assert(session.TaskManagementCommandPdus, Equals, int64(1))
+	c.Assert(session.TaskManagementResponsePdus, Equals, int64(1))
+	c.Assert(session.DigestErrors, Equals, int64(0))
+	c.Assert(session.TimeoutErrors, Equals, int64(3))
+	c.Assert(session.AbortCount, Equals, int64(2))
+
+	// The malformed counters are left as they are
+	session = &rpc.ISCSISessionStats{TxDataOctets: 5}
+	parseISCSISessionStats("txdata_octets: n/a\nrxdata_octets 10\n", session)
+	c.Assert(session.TxDataOctets, Equals, int64(5))
+	c.Assert(session.RxDataOctets, Equals, int64(0))
+}
@@ -8,11 +8,15 @@ import (
 	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/chaos"
 	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
 
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
@@ -23,6 +27,7 @@ type ProxyOps interface {
 	VolumeFrontendStart(context.Context, *rpc.EngineVolumeFrontendStartRequest) (*emptypb.Empty, error)
 	VolumeFrontendShutdown(context.Context, *rpc.ProxyEngineRequest) (*emptypb.Empty, error)
 	VolumeUnmapMarkSnapChainRemovedSet(context.Context, *rpc.EngineVolumeUnmapMarkSnapChainRemovedSetRequest) (*emptypb.Empty, error)
+	VolumeFrontendStatsGet(context.Context, *rpc.ProxyEngineRequest) (*rpc.EngineVolumeFrontendStatsGetProxyResponse, error)
 
 	ReplicaAdd(context.Context, *rpc.EngineReplicaAddRequest) (*emptypb.Empty, error)
 	ReplicaList(context.Context, *rpc.ProxyEngineRequest) (*rpc.EngineReplicaListProxyResponse, error)
@@ -60,25 +65,71 @@ type Proxy struct {
 	shutdownCh    chan error
 	HealthChecker HealthChecker
 	ops           map[rpc.DataEngine]ProxyOps
+
+	replicaModes *replicaModeTracker
+	audit        *auditLog
+	// snapshotRetention has the retention policies of the volumes enforced after each snapshot
+	snapshotRetention *snapshotRetention
+	// backupScheduler limits the backups to the same backup target endpoint
+	backupScheduler *backupScheduler
+	// operations is the operation history of the instances shared with the instance service
+	operations *util.OperationHistory
+	// volumeOperations fences the conflicting operations on the same volume
+	volumeOperations *volumeOperationFence
+
+	// ProcessManagerServiceAddress is the process manager the v1 replicas on the node are put into the rebuild mode
+	// by while taking part in a rebuild. The replicas are not put into the rebuild mode if it is empty.
+	ProcessManagerServiceAddress string
 }
 
-func NewProxy(ctx context.Context, logsDir, diskServiceAddress, spdkServiceAddress string) (*Proxy, error) {
+func NewProxy(ctx context.Context, logsDir, diskServiceAddress, spdkServiceAddress string, operations *util.OperationHistory,
+	backupTargetLimit BackupTargetLimit) (*Proxy, error) {
 	ops := map[rpc.DataEngine]ProxyOps{
 		rpc.DataEngine_DATA_ENGINE_V1: V1DataEngineProxyOps{},
 		rpc.DataEngine_DATA_ENGINE_V2: V2DataEngineProxyOps{},
 	}
+	replicaModes, err := newReplicaModeTracker()
+	if err != nil {
+		return nil, err
+	}
+	snapshotRetention, err := newSnapshotRetention()
+	if err != nil {
+		return nil, err
+	}
 	p := &Proxy{
 		ctx:           ctx,
 		logsDir:       logsDir,
 		HealthChecker: &GRPCHealthChecker{},
 		ops:           ops,
+		replicaModes:  replicaModes,
+		audit:         newAuditLog(),
+		operations:    operations,
+
+		volumeOperations:  newVolumeOperationFence(),
+		backupScheduler:   newBackupScheduler(backupTargetLimit),
+		snapshotRetention: snapshotRetention,
 	}
 
 	go p.startMonitoring()
+	go p.backupScheduler.run(ctx)
+	go p.replicaModes.run(ctx, p.listReplicasForModes)
 
 	return p, nil
 }
 
+// listReplicasForModes lists the replicas of the engine polled by the replica mode tracker.
+func (p *Proxy) listReplicasForModes(ctx context.Context, req *rpc.ProxyEngineRequest) ([]*eptypes.ControllerReplica, error) {
+	ops, ok := p.ops[req.DataEngine]
+	if !ok {
+		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
+	}
+	resp, err := ops.ReplicaList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ReplicaList.Replicas, nil
+}
+
 func (p *Proxy) startMonitoring() {
 	done := false
 	for {
@@ -124,6 +175,10 @@ func (p *Proxy) ServerVersionGet(ctx context.Context, req *rpc.ProxyEngineReques
 }
 
 func getSPDKClientFromEngineAddress(address string) (*spdkclient.SPDKClient, error) {
+	if err := chaos.CheckSPDKAvailable(); err != nil {
+		return nil, err
+	}
+
 	host, _, err := net.SplitHostPort(address)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,118 @@
+package proxy
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	eclient "github.com/longhorn/longhorn-engine/pkg/controller/client"
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/client"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+// enterRebuildMode puts the replicas on this node taking part in the rebuild of a v1 engine, i.e. the replica being
+// added and the RW replicas it may be rebuilt from, into the rebuild mode of the process manager, in which they are
+// limited by the rebuild resource limits of the node. The returned function has them leave the rebuild mode once the
+// rebuild is done. The replicas on the other nodes are left as they are, and so are the v2 replicas, since they are
+// all served by spdk_tgt.
+func (p *Proxy) enterRebuildMode(req *rpc.EngineReplicaAddRequest) func() {
+	if p.ProcessManagerServiceAddress == "" || req.ProxyEngineRequest.DataEngine != rpc.DataEngine_DATA_ENGINE_V1 {
+		return func() {}
+	}
+	log := logrus.WithFields(logrus.Fields{
+		"engineName":  req.ProxyEngineRequest.EngineName,
+		"replicaName": req.ReplicaName,
+	})
+
+	addresses := []string{req.ReplicaAddress}
+	c, err := eclient.NewControllerClient(req.ProxyEngineRequest.Address, req.ProxyEngineRequest.VolumeName,
+		req.ProxyEngineRequest.EngineName)
+	if err != nil {
+		log.WithError(err).Warn("Failed to create controller client to find the replicas to limit for rebuilding")
+		return func() {}
+	}
+	replicas, err := c.ReplicaList()
+	c.Close()
+	if err != nil {
+		log.WithError(err).Warn("Failed to list the replicas to limit for rebuilding")
+		return func() {}
+	}
+	for _, r := range replicas {
+		if r.Mode == etypes.RW {
+			addresses = append(addresses, r.Address)
+		}
+	}
+
+	pmClient, err := client.NewProcessManagerClient("tcp://"+p.ProcessManagerServiceAddress, nil)
+	if err != nil {
+		log.WithError(err).Warn("Failed to create process manager client to limit the replicas for rebuilding")
+		return func() {}
+	}
+	processes, err := pmClient.ProcessList()
+	if err != nil {
+		log.WithError(err).Warn("Failed to list the processes to limit for rebuilding")
+		pmClient.Close()
+		return func() {}
+	}
+
+	entered := []string{}
+	for _, name := range getLocalReplicaProcesses(processes, addresses) {
+		if _, err := pmClient.ProcessRebuildModeSet(name, true); err != nil {
+			log.WithError(err).Warnf("Failed to limit replica process %v for rebuilding", name)
+			continue
+		}
+		entered = append(entered, name)
+	}
+
+	return func() {
+		defer pmClient.Close()
+		for _, name := range entered {
+			if _, err := pmClient.ProcessRebuildModeSet(name, false); err != nil {
+				log.WithError(err).Warnf("Failed to relax replica process %v after rebuilding", name)
+			}
+		}
+	}
+}
+
+// getLocalReplicaProcesses returns the processes serving the replica addresses on this node, which are the ones whose
+// first port is the port of an address with a local IP.
+func getLocalReplicaProcesses(processes map[string]*rpc.ProcessResponse, addresses []string) []string {
+	localIPs := map[string]bool{}
+	interfaceAddresses, err := net.InterfaceAddrs()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to list the local addresses")
+		return nil
+	}
+	for _, address := range interfaceAddresses {
+		if ipNet, ok := address.(*net.IPNet); ok {
+			localIPs[ipNet.IP.String()] = true
+		}
+	}
+
+	localPorts := map[int32]bool{}
+	for _, address := range addresses {
+		host, port, err := net.SplitHostPort(strings.TrimPrefix(address, "tcp://"))
+		if err != nil {
+			continue
+		}
+		if ip := net.ParseIP(host); ip == nil || !localIPs[ip.String()] {
+			continue
+		}
+		if p, err := strconv.Atoi(port); err == nil {
+			localPorts[int32(p)] = true
+		}
+	}
+
+	names := []string{}
+	for name, process := range processes {
+		if process.Status != nil && process.Status.PortStart != 0 && localPorts[process.Status.PortStart] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -15,6 +15,8 @@ import (
 	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
 	spdktypes "github.com/longhorn/longhorn-spdk-engine/pkg/types"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/util"
+
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
 
@@ -36,6 +38,15 @@ func (p *Proxy) ReplicaAdd(ctx context.Context, req *rpc.EngineReplicaAddRequest
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
+	release, err := p.volumeOperations.acquire(req.ProxyEngineRequest, volumeOperationRebuild)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	relax := p.enterRebuildMode(req)
+	defer relax()
+
 	return ops.ReplicaAdd(ctx, req)
 }
 
@@ -66,7 +77,12 @@ func (ops V2DataEngineProxyOps) ReplicaAdd(ctx context.Context, req *rpc.EngineR
 	}
 	defer c.Close()
 
-	replicaAddress := strings.TrimPrefix(req.ReplicaAddress, "tcp://")
+	// The SPDK engine connects to the replica by IP only, so a replica specified by name is resolved right before
+	// being added
+	replicaAddress, err := util.ResolveAddress(ctx, strings.TrimPrefix(req.ReplicaAddress, "tcp://"))
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Unavailable, err.Error())
+	}
 
 	err = c.EngineReplicaAdd(req.ProxyEngineRequest.EngineName, req.ReplicaName, replicaAddress)
 	if err != nil {
@@ -88,7 +104,13 @@ func (p *Proxy) ReplicaList(ctx context.Context, req *rpc.ProxyEngineRequest) (r
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.DataEngine)
 	}
-	return ops.ReplicaList(ctx, req)
+
+	resp, err = ops.ReplicaList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.replicaModes.observeReplicaList(req, resp.ReplicaList.Replicas)
+	return resp, nil
 }
 
 func (ops V1DataEngineProxyOps) ReplicaList(ctx context.Context, req *rpc.ProxyEngineRequest) (resp *rpc.EngineReplicaListProxyResponse, err error) {
@@ -264,7 +286,13 @@ func (p *Proxy) ReplicaRemove(ctx context.Context, req *rpc.EngineReplicaRemoveR
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
-	return ops.ReplicaRemove(ctx, req)
+
+	resp, err = ops.ReplicaRemove(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.replicaModes.observeReplicaRemoved(req.ProxyEngineRequest, req.ReplicaAddress)
+	return resp, nil
 }
 
 func (ops V1DataEngineProxyOps) ReplicaRemove(ctx context.Context, req *rpc.EngineReplicaRemoveRequest) (*emptypb.Empty, error) {
@@ -299,7 +327,12 @@ func (p *Proxy) ReplicaModeUpdate(ctx context.Context, req *rpc.EngineReplicaMod
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
 
-	return ops.ReplicaModeUpdate(ctx, req)
+	resp, err = ops.ReplicaModeUpdate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.replicaModes.observeReplicaMode(req.ProxyEngineRequest, req.ReplicaAddress, req.Mode)
+	return resp, nil
 }
 
 func (ops V1DataEngineProxyOps) ReplicaModeUpdate(ctx context.Context, req *rpc.EngineReplicaModeUpdateRequest) (resp *emptypb.Empty, err error) {
@@ -0,0 +1,306 @@
+package proxy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util/broadcaster"
+)
+
+const (
+	replicaModePollInterval = 30 * time.Second
+	replicaModePollTimeout  = 10 * time.Second
+	// replicaModePollFailureThreshold is the number of the polls in a row failing to reach an engine after which
+	// the engine is considered gone
+	replicaModePollFailureThreshold = 3
+)
+
+type replicaModeEntry struct {
+	address    string
+	volumeName string
+	dataEngine rpc.DataEngine
+	modes      map[string]string
+	// pollFailures is the number of the polls in a row failing to reach the engine
+	pollFailures int
+}
+
+// replicaModeListFunc lists the replicas of the engine of the request.
+type replicaModeListFunc func(ctx context.Context, req *rpc.ProxyEngineRequest) ([]*eptypes.ControllerReplica, error)
+
+// replicaModeTracker caches the replica modes of each engine observed by the proxy calls, and broadcasts a
+// ReplicaModeTransition whenever the mode of a replica changes. The known engines are also polled, so that the
+// transitions are observed without any proxy call, and the engines gone are dropped along with their replicas.
+type replicaModeTracker struct {
+	lock    *sync.RWMutex
+	engines map[string]*replicaModeEntry
+
+	broadcaster *broadcaster.Broadcaster
+	broadcastCh chan interface{}
+}
+
+func newReplicaModeTracker() (*replicaModeTracker, error) {
+	t := &replicaModeTracker{
+		lock:    &sync.RWMutex{},
+		engines: map[string]*replicaModeEntry{},
+
+		broadcaster: &broadcaster.Broadcaster{},
+		broadcastCh: make(chan interface{}),
+	}
+	// help to kickstart the broadcaster
+	c, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := t.Subscribe(c); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *replicaModeTracker) Subscribe(ctx context.Context) (<-chan interface{}, error) {
+	return t.broadcaster.Subscribe(ctx, func() (chan interface{}, error) {
+		return t.broadcastCh, nil
+	})
+}
+
+// run polls the replicas of the known engines until the context is done.
+func (t *replicaModeTracker) run(ctx context.Context, listReplicas replicaModeListFunc) {
+	ticker := time.NewTicker(replicaModePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.poll(ctx, listReplicas)
+		}
+	}
+}
+
+func (t *replicaModeTracker) poll(ctx context.Context, listReplicas replicaModeListFunc) {
+	for _, req := range t.getEngineRequests() {
+		pollCtx, cancel := context.WithTimeout(ctx, replicaModePollTimeout)
+		replicas, err := listReplicas(pollCtx, req)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			t.observePollFailure(req, err)
+			continue
+		}
+		t.observeReplicaList(req, replicas)
+	}
+}
+
+func (t *replicaModeTracker) getEngineRequests() []*rpc.ProxyEngineRequest {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	reqs := []*rpc.ProxyEngineRequest{}
+	for engineName, entry := range t.engines {
+		if entry.address == "" {
+			continue
+		}
+		reqs = append(reqs, &rpc.ProxyEngineRequest{
+			Address:    entry.address,
+			EngineName: engineName,
+			VolumeName: entry.volumeName,
+			DataEngine: entry.dataEngine,
+		})
+	}
+	return reqs
+}
+
+// observePollFailure drops the engine once it cannot be found, or cannot be reached by
+// replicaModePollFailureThreshold polls in a row.
+func (t *replicaModeTracker) observePollFailure(req *rpc.ProxyEngineRequest, err error) {
+	t.lock.Lock()
+	entry, exists := t.engines[req.EngineName]
+	if !exists || entry.address != req.Address {
+		t.lock.Unlock()
+		return
+	}
+	entry.pollFailures++
+	if grpcstatus.Code(err) != grpccodes.NotFound && entry.pollFailures < replicaModePollFailureThreshold {
+		t.lock.Unlock()
+		return
+	}
+
+	logrus.WithError(err).Infof("Dropping the replica modes of engine %v since it is gone", req.EngineName)
+	transitions := []*rpc.ReplicaModeTransition{}
+	for address := range entry.modes {
+		transitions = t.update(transitions, req.EngineName, entry, address, "")
+	}
+	delete(t.engines, req.EngineName)
+	t.lock.Unlock()
+
+	t.broadcast(transitions)
+}
+
+func (t *replicaModeTracker) getEntry(req *rpc.ProxyEngineRequest) *replicaModeEntry {
+	entry, exists := t.engines[req.EngineName]
+	if !exists {
+		entry = &replicaModeEntry{
+			modes: map[string]string{},
+		}
+		t.engines[req.EngineName] = entry
+	}
+	entry.address = req.Address
+	entry.volumeName = req.VolumeName
+	entry.dataEngine = req.DataEngine
+	entry.pollFailures = 0
+	return entry
+}
+
+// observeReplicaList records the full replica list of an engine. Replicas missing from the list are treated
+// as removed.
+func (t *replicaModeTracker) observeReplicaList(req *rpc.ProxyEngineRequest, replicas []*eptypes.ControllerReplica) {
+	t.lock.Lock()
+	entry := t.getEntry(req)
+	transitions := []*rpc.ReplicaModeTransition{}
+	observed := map[string]struct{}{}
+	for _, r := range replicas {
+		if r.Address == nil {
+			continue
+		}
+		observed[r.Address.Address] = struct{}{}
+		transitions = t.update(transitions, req.EngineName, entry, r.Address.Address, r.Mode.String())
+	}
+	for address := range entry.modes {
+		if _, exists := observed[address]; !exists {
+			transitions = t.update(transitions, req.EngineName, entry, address, "")
+		}
+	}
+	t.lock.Unlock()
+
+	t.broadcast(transitions)
+}
+
+func (t *replicaModeTracker) observeReplicaMode(req *rpc.ProxyEngineRequest, address string, mode eptypes.ReplicaMode) {
+	t.observeReplica(req, address, mode.String())
+}
+
+func (t *replicaModeTracker) observeReplicaRemoved(req *rpc.ProxyEngineRequest, address string) {
+	t.observeReplica(req, address, "")
+}
+
+func (t *replicaModeTracker) observeReplica(req *rpc.ProxyEngineRequest, address, mode string) {
+	t.lock.Lock()
+	transitions := []*rpc.ReplicaModeTransition{}
+	if req.EngineName != "" {
+		transitions = t.update(transitions, req.EngineName, t.getEntry(req), address, mode)
+	} else {
+		// Older callers don't specify the engine name, so update the engines already known to have the replica
+		for engineName, entry := range t.engines {
+			if _, exists := entry.modes[address]; exists {
+				transitions = t.update(transitions, engineName, entry, address, mode)
+			}
+		}
+	}
+	t.lock.Unlock()
+
+	t.broadcast(transitions)
+}
+
+// update must be called with the lock held. An empty mode means the replica is removed. The transition is appended
+// to the transitions, which are broadcast once the lock is released, so that a slow watcher cannot hold the lock.
+func (t *replicaModeTracker) update(transitions []*rpc.ReplicaModeTransition, engineName string, entry *replicaModeEntry, address, mode string) []*rpc.ReplicaModeTransition {
+	oldMode, exists := entry.modes[address]
+	if oldMode == mode && (exists || mode == "") {
+		return transitions
+	}
+	if mode == "" {
+		delete(entry.modes, address)
+	} else {
+		entry.modes[address] = mode
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"engineName":     engineName,
+		"replicaAddress": address,
+	}).Infof("Observed replica mode transition from %q to %q", oldMode, mode)
+
+	return append(transitions, &rpc.ReplicaModeTransition{
+		EngineName:     engineName,
+		VolumeName:     entry.volumeName,
+		DataEngine:     entry.dataEngine,
+		ReplicaAddress: address,
+		OldMode:        oldMode,
+		NewMode:        mode,
+		ObservedAt:     time.Now().UnixNano(),
+	})
+}
+
+func (t *replicaModeTracker) broadcast(transitions []*rpc.ReplicaModeTransition) {
+	for _, transition := range transitions {
+		t.broadcastCh <- interface{}(transition)
+	}
+}
+
+// list returns the cached replica modes as transitions from the unknown mode.
+func (t *replicaModeTracker) list() []*rpc.ReplicaModeTransition {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	transitions := []*rpc.ReplicaModeTransition{}
+	now := time.Now().UnixNano()
+	for engineName, entry := range t.engines {
+		for address, mode := range entry.modes {
+			transitions = append(transitions, &rpc.ReplicaModeTransition{
+				EngineName:     engineName,
+				VolumeName:     entry.volumeName,
+				DataEngine:     entry.dataEngine,
+				ReplicaAddress: address,
+				NewMode:        mode,
+				ObservedAt:     now,
+			})
+		}
+	}
+	return transitions
+}
+
+// ReplicaModeWatch streams the replica mode transitions observed by this proxy. The cached modes are sent first
+// so that a new watcher doesn't have to wait for the next transition to learn the current modes.
+func (p *Proxy) ReplicaModeWatch(req *emptypb.Empty, srv rpc.ProxyEngineService_ReplicaModeWatchServer) (err error) {
+	responseChan, err := p.replicaModes.Subscribe(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			logrus.WithError(err).Error("Replica mode watch errored out")
+		} else {
+			logrus.Info("Replica mode watch ended successfully")
+		}
+	}()
+	logrus.Info("Started new replica mode watch")
+
+	for _, transition := range p.replicaModes.list() {
+		if err := srv.Send(transition); err != nil {
+			return err
+		}
+	}
+
+	for resp := range responseChan {
+		transition, ok := resp.(*rpc.ReplicaModeTransition)
+		if !ok {
+			return fmt.Errorf("BUG: cannot get ReplicaModeTransition from channel")
+		}
+		if err := srv.Send(transition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
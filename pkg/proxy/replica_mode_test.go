@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func receiveReplicaModeTransitions(c *C, ch <-chan interface{}, count int) []string {
+	transitions := []string{}
+	for len(transitions) < count {
+		select {
+		case resp := <-ch:
+			transition := resp.(*rpc.ReplicaModeTransition)
+			transitions = append(transitions, transition.EngineName+" "+transition.ReplicaAddress+" "+transition.OldMode+"->"+transition.NewMode)
+		case <-time.After(5 * time.Second):
+			c.Fatalf("timed out waiting for the replica mode transitions, got %v", transitions)
+		}
+	}
+	return transitions
+}
+
+func (s *TestSuite) TestReplicaModeTrackerPoll(c *C) {
+	t, err := newReplicaModeTracker()
+	c.Assert(err, IsNil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := t.Subscribe(ctx)
+	c.Assert(err, IsNil)
+
+	newReplica := func(address string, mode eptypes.ReplicaMode) *eptypes.ControllerReplica {
+		return &eptypes.ControllerReplica{Address: &eptypes.ReplicaAddress{Address: address}, Mode: mode}
+	}
+	req0 := &rpc.ProxyEngineRequest{Address: "10.0.0.1:10000", EngineName: "vol-e-0", VolumeName: "vol"}
+	req1 := &rpc.ProxyEngineRequest{Address: "10.0.0.1:10010", EngineName: "other-e-0", VolumeName: "other"}
+
+	t.observeReplicaList(req0, []*eptypes.ControllerReplica{newReplica("tcp://r0", eptypes.ReplicaMode_RW)})
+	t.observeReplicaList(req1, []*eptypes.ControllerReplica{newReplica("tcp://r1", eptypes.ReplicaMode_RW)})
+	c.Assert(receiveReplicaModeTransitions(c, ch, 2), DeepEquals, []string{
+		"vol-e-0 tcp://r0 ->RW",
+		"other-e-0 tcp://r1 ->RW",
+	})
+
+	// The transitions are observed by the polls, and the engines gone are dropped with their replicas
+	replicas := map[string][]*eptypes.ControllerReplica{
+		"vol-e-0": {newReplica("tcp://r0", eptypes.ReplicaMode_ERR)},
+	}
+	listReplicas := func(ctx context.Context, req *rpc.ProxyEngineRequest) ([]*eptypes.ControllerReplica, error) {
+		if req.EngineName == "other-e-0" {
+			return nil, grpcstatus.Error(grpccodes.NotFound, "engine not found")
+		}
+		if list, exists := replicas[req.EngineName]; exists {
+			return list, nil
+		}
+		return nil, grpcstatus.Error(grpccodes.Unavailable, "connection refused")
+	}
+	t.poll(ctx, listReplicas)
+	transitions := receiveReplicaModeTransitions(c, ch, 2)
+	c.Assert(map[string]bool{transitions[0]: true, transitions[1]: true}, DeepEquals, map[string]bool{
+		"vol-e-0 tcp://r0 RW->ERR": true,
+		"other-e-0 tcp://r1 RW->":  true,
+	})
+	c.Assert(t.getEngineRequests(), HasLen, 1)
+
+	// An engine unreachable is only dropped after the polls in a row fail
+	delete(replicas, "vol-e-0")
+	for i := 0; i < replicaModePollFailureThreshold-1; i++ {
+		t.poll(ctx, listReplicas)
+	}
+	c.Assert(t.getEngineRequests(), HasLen, 1)
+	t.poll(ctx, listReplicas)
+	c.Assert(receiveReplicaModeTransitions(c, ch, 1), DeepEquals, []string{"vol-e-0 tcp://r0 ERR->"})
+	c.Assert(t.getEngineRequests(), HasLen, 0)
+	c.Assert(t.list(), HasLen, 0)
+}
@@ -2,6 +2,8 @@ package proxy
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -12,6 +14,7 @@ import (
 
 	eclient "github.com/longhorn/longhorn-engine/pkg/controller/client"
 	esync "github.com/longhorn/longhorn-engine/pkg/sync"
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
 	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
 	"github.com/longhorn/longhorn-instance-manager/pkg/util"
 
@@ -27,11 +30,37 @@ func (p *Proxy) VolumeSnapshot(ctx context.Context, req *rpc.EngineVolumeSnapsho
 	})
 	log.Infof("Snapshotting volume: snapshot %v", req.SnapshotVolume.Name)
 
+	defer func() {
+		details := map[string]string{"snapshot": req.SnapshotVolume.Name}
+		if resp != nil && resp.Snapshot != nil {
+			details["snapshot"] = resp.Snapshot.Name
+		}
+		p.audit.record(ctx, auditOperationVolumeSnapshot, req.ProxyEngineRequest, details, err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
-	return ops.VolumeSnapshot(ctx, req)
+	release, err := p.volumeOperations.acquire(req.ProxyEngineRequest, volumeOperationSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	policy := p.snapshotRetention.get(req.ProxyEngineRequest.VolumeName)
+	if policy == nil {
+		return ops.VolumeSnapshot(ctx, req)
+	}
+	if req.SnapshotVolume.Name == "" {
+		req.SnapshotVolume.Name = getSnapshotName(policy, time.Now())
+	}
+	resp, err = ops.VolumeSnapshot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.enforceSnapshotRetention(ctx, ops, req.ProxyEngineRequest, policy, resp.Snapshot.GetName())
+	return resp, nil
 }
 
 func (ops V1DataEngineProxyOps) VolumeSnapshot(ctx context.Context, req *rpc.EngineVolumeSnapshotRequest) (resp *rpc.EngineVolumeSnapshotProxyResponse, err error) {
@@ -172,6 +201,15 @@ func (p *Proxy) SnapshotClone(ctx context.Context, req *rpc.EngineSnapshotCloneR
 	})
 	log.Infof("Cloning snapshot from %v to %v", req.FromEngineAddress, req.ProxyEngineRequest.Address)
 
+	defer func() {
+		p.audit.record(ctx, auditOperationSnapshotClone, req.ProxyEngineRequest, map[string]string{
+			"snapshot":          req.SnapshotName,
+			"fromVolumeName":    req.FromVolumeName,
+			"fromEngineName":    req.FromEngineName,
+			"fromEngineAddress": req.FromEngineAddress,
+		}, err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
@@ -265,12 +303,22 @@ func (p *Proxy) SnapshotRevert(ctx context.Context, req *rpc.EngineSnapshotRever
 		"volumeName": req.ProxyEngineRequest.VolumeName,
 		"dataEngine": req.ProxyEngineRequest.DataEngine,
 	})
-	log.Infof("Reverting snapshot %v", req.Name)
+	log.Infof("Reverting snapshot %v with frontend quiesced %v", req.Name, req.FrontendQuiesced)
+
+	defer func() {
+		p.audit.record(ctx, auditOperationSnapshotRevert, req.ProxyEngineRequest, map[string]string{"snapshot": req.Name, "frontendQuiesced": strconv.FormatBool(req.FrontendQuiesced)}, err)
+	}()
 
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
+	release, err := p.volumeOperations.acquire(req.ProxyEngineRequest, volumeOperationSnapshotRevert)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	return ops.SnapshotRevert(ctx, req)
 }
 
@@ -282,6 +330,17 @@ func (ops V1DataEngineProxyOps) SnapshotRevert(ctx context.Context, req *rpc.Eng
 	}
 	defer c.Close()
 
+	if !req.FrontendQuiesced {
+		volume, err := c.VolumeGet()
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to verify the frontend of volume %v before reverting", req.ProxyEngineRequest.VolumeName).Error())
+		}
+		if volume.Frontend != "" && volume.FrontendState == string(etypes.StateUp) {
+			return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot revert volume %v to snapshot %v since its %v frontend is up at %v, shut down the frontend or confirm the I/O is quiesced first",
+				req.ProxyEngineRequest.VolumeName, req.Name, volume.Frontend, volume.Endpoint)
+		}
+	}
+
 	if err := c.VolumeRevert(req.Name); err != nil {
 		return nil, err
 	}
@@ -296,6 +355,17 @@ func (ops V2DataEngineProxyOps) SnapshotRevert(ctx context.Context, req *rpc.Eng
 	}
 	defer c.Close()
 
+	if !req.FrontendQuiesced {
+		engine, err := c.EngineGet(req.ProxyEngineRequest.EngineName)
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, errors.Wrapf(err, "failed to verify the frontend of engine %v before reverting", req.ProxyEngineRequest.EngineName).Error())
+		}
+		if engine.Endpoint != "" {
+			return nil, grpcstatus.Errorf(grpccodes.FailedPrecondition, "cannot revert engine %v to snapshot %v since its %v frontend is up at %v, shut down the frontend or confirm the I/O is quiesced first",
+				req.ProxyEngineRequest.EngineName, req.Name, engine.Frontend, engine.Endpoint)
+		}
+	}
+
 	err = c.EngineSnapshotRevert(req.ProxyEngineRequest.EngineName, req.Name)
 	if err != nil {
 		return nil, grpcstatus.Errorf(grpccodes.Internal, errors.Wrapf(err, "failed to create snapshot %v", req.Name).Error())
@@ -313,10 +383,20 @@ func (p *Proxy) SnapshotPurge(ctx context.Context, req *rpc.EngineSnapshotPurgeR
 	})
 	log.Info("Purging snapshots")
 
+	defer func() {
+		p.audit.record(ctx, auditOperationSnapshotPurge, req.ProxyEngineRequest, nil, err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
+	release, err := p.volumeOperations.acquire(req.ProxyEngineRequest, volumeOperationSnapshotPurge)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	return ops.SnapshotPurge(ctx, req)
 }
 
@@ -397,6 +477,10 @@ func (p *Proxy) SnapshotRemove(ctx context.Context, req *rpc.EngineSnapshotRemov
 	})
 	log.Infof("Removing snapshots %v", req.Names)
 
+	defer func() {
+		p.audit.record(ctx, auditOperationSnapshotRemove, req.ProxyEngineRequest, map[string]string{"snapshots": strings.Join(req.Names, ",")}, err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
@@ -448,6 +532,10 @@ func (p *Proxy) SnapshotHash(ctx context.Context, req *rpc.EngineSnapshotHashReq
 	})
 	log.Infof("Hashing snapshot %v with rehash %v", req.SnapshotName, req.Rehash)
 
+	defer func() {
+		p.audit.record(ctx, auditOperationSnapshotHash, req.ProxyEngineRequest, map[string]string{"snapshot": req.SnapshotName}, err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
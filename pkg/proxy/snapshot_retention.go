@@ -0,0 +1,287 @@
+package proxy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+	"github.com/longhorn/longhorn-instance-manager/pkg/util/broadcaster"
+)
+
+const (
+	snapshotRetentionReasonMaxCount = "max-count"
+	snapshotRetentionReasonMaxAge   = "max-age"
+
+	// snapshotRetentionNameTimeFormat is the creation time the snapshots are named by after the prefix of the
+	// policy, which sorts the same as the time and is a valid snapshot name
+	snapshotRetentionNameTimeFormat = "20060102-150405.000"
+)
+
+var snapshotNamePrefixRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// snapshotRetention keeps the snapshot retention policies attached to the volumes, and broadcasts a
+// SnapshotRetentionEvent for each snapshot removed by the policies.
+type snapshotRetention struct {
+	lock     *sync.RWMutex
+	policies map[string]*rpc.SnapshotRetentionPolicy
+
+	broadcaster *broadcaster.Broadcaster
+	broadcastCh chan interface{}
+}
+
+func newSnapshotRetention() (*snapshotRetention, error) {
+	r := &snapshotRetention{
+		lock:     &sync.RWMutex{},
+		policies: map[string]*rpc.SnapshotRetentionPolicy{},
+
+		broadcaster: &broadcaster.Broadcaster{},
+		broadcastCh: make(chan interface{}),
+	}
+	// help to kickstart the broadcaster
+	c, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := r.Subscribe(c); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *snapshotRetention) Subscribe(ctx context.Context) (<-chan interface{}, error) {
+	return r.broadcaster.Subscribe(ctx, func() (chan interface{}, error) {
+		return r.broadcastCh, nil
+	})
+}
+
+func (r *snapshotRetention) get(volumeName string) *rpc.SnapshotRetentionPolicy {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.policies[volumeName]
+}
+
+// set attaches the policy to the volume, or detaches the policy of the volume if the policy has no limit.
+func (r *snapshotRetention) set(volumeName string, policy *rpc.SnapshotRetentionPolicy) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if policy.GetMaxCount() == 0 && policy.GetMaxAgeSeconds() == 0 {
+		delete(r.policies, volumeName)
+		return
+	}
+	r.policies[volumeName] = proto.Clone(policy).(*rpc.SnapshotRetentionPolicy)
+}
+
+func validateSnapshotRetentionPolicy(policy *rpc.SnapshotRetentionPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MaxCount < 0 {
+		return fmt.Errorf("invalid max count %v", policy.MaxCount)
+	}
+	if policy.MaxAgeSeconds < 0 {
+		return fmt.Errorf("invalid max age %vs", policy.MaxAgeSeconds)
+	}
+	if policy.MaxCount == 0 && policy.MaxAgeSeconds == 0 {
+		return nil
+	}
+	// The policy counts and removes the snapshots of its name prefix only, so that the user created snapshots
+	// named otherwise are never removed by it
+	if policy.NamePrefix == "" {
+		return fmt.Errorf("missing snapshot name prefix")
+	}
+	if !snapshotNamePrefixRegex.MatchString(policy.NamePrefix) {
+		return fmt.Errorf("invalid snapshot name prefix %v", policy.NamePrefix)
+	}
+	return nil
+}
+
+// getSnapshotName returns the name of the snapshot created under the policy without a name, which is empty for the
+// engine to name it if there is no policy.
+func getSnapshotName(policy *rpc.SnapshotRetentionPolicy, now time.Time) string {
+	if policy.GetNamePrefix() == "" {
+		return ""
+	}
+	return policy.NamePrefix + now.UTC().Format(snapshotRetentionNameTimeFormat)
+}
+
+type retainedSnapshot struct {
+	name    string
+	created time.Time
+}
+
+// getSnapshotsToRemove returns the snapshots beyond the limits of the policy from the oldest, along with the reason
+// for each. Only the user created snapshots with the name prefix of the policy are counted, and the snapshot just
+// created is always kept. The snapshots without a valid creation time are left alone.
+func getSnapshotsToRemove(policy *rpc.SnapshotRetentionPolicy, disks map[string]*rpc.EngineSnapshotDiskInfo,
+	created string, now time.Time) ([]string, map[string]string) {
+	snapshots := []retainedSnapshot{}
+	for name, disk := range disks {
+		if name == etypes.VolumeHeadName || name == created || disk.Removed || !disk.UserCreated {
+			continue
+		}
+		if !strings.HasPrefix(name, policy.NamePrefix) {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, disk.Created)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, retainedSnapshot{name: name, created: createdAt})
+	}
+	// From the newest
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].created.Equal(snapshots[j].created) {
+			return snapshots[i].name > snapshots[j].name
+		}
+		return snapshots[i].created.After(snapshots[j].created)
+	})
+
+	// The snapshot just created counts towards the max count if the policy applies to it
+	kept := 0
+	if strings.HasPrefix(created, policy.NamePrefix) {
+		kept = 1
+	}
+	reasons := map[string]string{}
+	for i, snapshot := range snapshots {
+		switch {
+		case policy.MaxCount > 0 && i+kept >= int(policy.MaxCount):
+			reasons[snapshot.name] = snapshotRetentionReasonMaxCount
+		case policy.MaxAgeSeconds > 0 && now.Sub(snapshot.created) > time.Duration(policy.MaxAgeSeconds)*time.Second:
+			reasons[snapshot.name] = snapshotRetentionReasonMaxAge
+		}
+	}
+	names := []string{}
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if _, ok := reasons[snapshots[i].name]; ok {
+			names = append(names, snapshots[i].name)
+		}
+	}
+	return names, reasons
+}
+
+// enforceSnapshotRetention removes the snapshots of the volume beyond the limits of the policy after the snapshot
+// is created. The caller must hold the snapshot operation of the volume. The failures are reported by the events
+// rather than failing the snapshot creation.
+func (p *Proxy) enforceSnapshotRetention(ctx context.Context, ops ProxyOps, req *rpc.ProxyEngineRequest,
+	policy *rpc.SnapshotRetentionPolicy, created string) {
+	log := logrus.WithFields(logrus.Fields{
+		"serviceURL": req.Address,
+		"engineName": req.EngineName,
+		"volumeName": req.VolumeName,
+		"dataEngine": req.DataEngine,
+	})
+
+	list, err := ops.SnapshotList(ctx, req)
+	if err != nil {
+		log.WithError(err).Warn("Failed to list snapshots to enforce the snapshot retention policy")
+		return
+	}
+	names, reasons := getSnapshotsToRemove(policy, list.Disks, created, time.Now())
+	if len(names) == 0 {
+		return
+	}
+	log.Infof("Removing snapshots %v by the snapshot retention policy", names)
+
+	removed := 0
+	for _, name := range names {
+		_, err := ops.SnapshotRemove(ctx, &rpc.EngineSnapshotRemoveRequest{
+			ProxyEngineRequest: req,
+			Names:              []string{name},
+		})
+		p.audit.record(ctx, auditOperationSnapshotRetention, req, map[string]string{
+			"snapshot": name,
+			"reason":   reasons[name],
+		}, err)
+
+		event := &rpc.SnapshotRetentionEvent{
+			VolumeName:   req.VolumeName,
+			EngineName:   req.EngineName,
+			DataEngine:   req.DataEngine,
+			SnapshotName: name,
+			Reason:       reasons[name],
+			ObservedAt:   time.Now().UnixNano(),
+		}
+		if err != nil {
+			log.WithError(err).Warnf("Failed to remove snapshot %v by the snapshot retention policy", name)
+			event.ErrorMsg = err.Error()
+		} else {
+			removed++
+		}
+		p.snapshotRetention.broadcastCh <- interface{}(event)
+	}
+
+	// The removed v1 snapshots are only marked as removed until purged
+	if removed > 0 && req.DataEngine == rpc.DataEngine_DATA_ENGINE_V1 {
+		if _, err := ops.SnapshotPurge(ctx, &rpc.EngineSnapshotPurgeRequest{
+			ProxyEngineRequest: req,
+			SkipIfInProgress:   true,
+		}); err != nil {
+			log.WithError(err).Warn("Failed to purge the snapshots removed by the snapshot retention policy")
+		}
+	}
+}
+
+// VolumeSnapshotRetentionPolicySet attaches the snapshot retention policy to the volume, which is enforced after
+// each snapshot of the volume created through this proxy. The policies are kept in memory only, so they are lost
+// when the instance manager restarts and must be set again by the caller.
+func (p *Proxy) VolumeSnapshotRetentionPolicySet(ctx context.Context, req *rpc.EngineVolumeSnapshotRetentionPolicySetRequest) (resp *emptypb.Empty, err error) {
+	log := logrus.WithFields(logrus.Fields{
+		"serviceURL": req.ProxyEngineRequest.GetAddress(),
+		"engineName": req.ProxyEngineRequest.GetEngineName(),
+		"volumeName": req.ProxyEngineRequest.GetVolumeName(),
+		"dataEngine": req.ProxyEngineRequest.GetDataEngine(),
+	})
+	log.Infof("Setting snapshot retention policy to %+v", req.Policy)
+
+	if req.ProxyEngineRequest.GetVolumeName() == "" {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "missing required argument volume name")
+	}
+	if err := validateSnapshotRetentionPolicy(req.Policy); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, errors.Wrap(err, "invalid snapshot retention policy").Error())
+	}
+
+	p.snapshotRetention.set(req.ProxyEngineRequest.VolumeName, req.Policy)
+	return &emptypb.Empty{}, nil
+}
+
+// SnapshotRetentionEventWatch streams the removals of the snapshots by the retention policies.
+func (p *Proxy) SnapshotRetentionEventWatch(req *emptypb.Empty, srv rpc.ProxyEngineService_SnapshotRetentionEventWatchServer) (err error) {
+	responseChan, err := p.snapshotRetention.Subscribe(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			logrus.WithError(err).Error("Snapshot retention event watch errored out")
+		} else {
+			logrus.Info("Snapshot retention event watch ended successfully")
+		}
+	}()
+	logrus.Info("Started new snapshot retention event watch")
+
+	for resp := range responseChan {
+		event, ok := resp.(*rpc.SnapshotRetentionEvent)
+		if !ok {
+			return fmt.Errorf("BUG: cannot get SnapshotRetentionEvent from channel")
+		}
+		if err := srv.Send(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package proxy
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestValidateSnapshotRetentionPolicy(c *C) {
+	testCases := []struct {
+		comment string
+		policy  *rpc.SnapshotRetentionPolicy
+		valid   bool
+	}{
+		{"detached", nil, true},
+		{"no limit", &rpc.SnapshotRetentionPolicy{}, true},
+		{"max count", &rpc.SnapshotRetentionPolicy{MaxCount: 3, NamePrefix: "hourly-"}, true},
+		{"max age", &rpc.SnapshotRetentionPolicy{MaxAgeSeconds: 3600, NamePrefix: "hourly-"}, true},
+		{"negative max count", &rpc.SnapshotRetentionPolicy{MaxCount: -1, NamePrefix: "hourly-"}, false},
+		{"negative max age", &rpc.SnapshotRetentionPolicy{MaxAgeSeconds: -1, NamePrefix: "hourly-"}, false},
+		{"max count without name prefix", &rpc.SnapshotRetentionPolicy{MaxCount: 3}, false},
+		{"max age without name prefix", &rpc.SnapshotRetentionPolicy{MaxAgeSeconds: 3600}, false},
+		{"invalid name prefix", &rpc.SnapshotRetentionPolicy{MaxCount: 3, NamePrefix: "-hourly/"}, false},
+	}
+	for i, testCase := range testCases {
+		err := validateSnapshotRetentionPolicy(testCase.policy)
+		c.Assert(err == nil, Equals, testCase.valid, Commentf("test case %v: %v: %v", i, testCase.comment, err))
+	}
+}
+
+func (s *TestSuite) TestGetSnapshotsToRemove(c *C) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newDisk := func(age time.Duration) *rpc.EngineSnapshotDiskInfo {
+		return &rpc.EngineSnapshotDiskInfo{
+			Created:     now.Add(-age).Format(time.RFC3339),
+			UserCreated: true,
+		}
+	}
+	disks := func(entries map[string]*rpc.EngineSnapshotDiskInfo) map[string]*rpc.EngineSnapshotDiskInfo {
+		entries[etypes.VolumeHeadName] = &rpc.EngineSnapshotDiskInfo{Created: now.Format(time.RFC3339), UserCreated: true}
+		return entries
+	}
+
+	testCases := []struct {
+		comment string
+		policy  *rpc.SnapshotRetentionPolicy
+		disks   map[string]*rpc.EngineSnapshotDiskInfo
+		created string
+		names   []string
+		reasons map[string]string
+	}{
+		{
+			"max count counts the snapshot just created",
+			&rpc.SnapshotRetentionPolicy{MaxCount: 2, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"h-0": newDisk(0),
+				"h-1": newDisk(time.Hour),
+				"h-2": newDisk(2 * time.Hour),
+				"h-3": newDisk(3 * time.Hour),
+			}),
+			"h-0",
+			[]string{"h-3", "h-2"},
+			map[string]string{"h-3": snapshotRetentionReasonMaxCount, "h-2": snapshotRetentionReasonMaxCount},
+		},
+		{
+			"max count does not count the snapshot just created out of the prefix",
+			&rpc.SnapshotRetentionPolicy{MaxCount: 2, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"manual":  newDisk(0),
+				"h-1":     newDisk(time.Hour),
+				"h-2":     newDisk(2 * time.Hour),
+				"h-3":     newDisk(3 * time.Hour),
+				"other-4": newDisk(4 * time.Hour),
+			}),
+			"manual",
+			[]string{"h-3"},
+			map[string]string{"h-3": snapshotRetentionReasonMaxCount},
+		},
+		{
+			"max age",
+			&rpc.SnapshotRetentionPolicy{MaxAgeSeconds: 5400, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"h-0":     newDisk(0),
+				"h-1":     newDisk(time.Hour),
+				"h-2":     newDisk(2 * time.Hour),
+				"h-3":     newDisk(3 * time.Hour),
+				"other-3": newDisk(3 * time.Hour),
+			}),
+			"h-0",
+			[]string{"h-3", "h-2"},
+			map[string]string{"h-3": snapshotRetentionReasonMaxAge, "h-2": snapshotRetentionReasonMaxAge},
+		},
+		{
+			"max count takes precedence over max age",
+			&rpc.SnapshotRetentionPolicy{MaxCount: 3, MaxAgeSeconds: 5400, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"h-0": newDisk(0),
+				"h-1": newDisk(time.Hour),
+				"h-2": newDisk(2 * time.Hour),
+				"h-3": newDisk(3 * time.Hour),
+			}),
+			"h-0",
+			[]string{"h-3", "h-2"},
+			map[string]string{"h-3": snapshotRetentionReasonMaxCount, "h-2": snapshotRetentionReasonMaxAge},
+		},
+		{
+			"the snapshot just created is kept",
+			&rpc.SnapshotRetentionPolicy{MaxAgeSeconds: 60, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"h-0": newDisk(time.Hour),
+			}),
+			"h-0",
+			[]string{},
+			map[string]string{},
+		},
+		{
+			"removed and system snapshots are left alone",
+			&rpc.SnapshotRetentionPolicy{MaxCount: 1, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"h-0": newDisk(0),
+				"h-1": {Created: now.Add(-time.Hour).Format(time.RFC3339), UserCreated: true, Removed: true},
+				"h-2": {Created: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+				"h-3": newDisk(3 * time.Hour),
+			}),
+			"h-0",
+			[]string{"h-3"},
+			map[string]string{"h-3": snapshotRetentionReasonMaxCount},
+		},
+		{
+			"snapshots with a bad creation time are left alone",
+			&rpc.SnapshotRetentionPolicy{MaxCount: 1, NamePrefix: "h-"},
+			disks(map[string]*rpc.EngineSnapshotDiskInfo{
+				"h-0": newDisk(0),
+				"h-1": {Created: "yesterday", UserCreated: true},
+				"h-2": {UserCreated: true},
+				"h-3": newDisk(3 * time.Hour),
+			}),
+			"h-0",
+			[]string{"h-3"},
+			map[string]string{"h-3": snapshotRetentionReasonMaxCount},
+		},
+	}
+	for i, testCase := range testCases {
+		names, reasons := getSnapshotsToRemove(testCase.policy, testCase.disks, testCase.created, now)
+		comment := Commentf("test case %v: %v", i, testCase.comment)
+		c.Assert(names, DeepEquals, testCase.names, comment)
+		c.Assert(reasons, DeepEquals, testCase.reasons, comment)
+	}
+}
@@ -1,6 +1,8 @@
 package proxy
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -11,6 +13,8 @@ import (
 	eclient "github.com/longhorn/longhorn-engine/pkg/controller/client"
 	eptypes "github.com/longhorn/longhorn-engine/proto/ptypes"
 
+	"github.com/longhorn/longhorn-instance-manager/pkg/types"
+
 	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
 )
 
@@ -97,10 +101,20 @@ func (p *Proxy) VolumeExpand(ctx context.Context, req *rpc.EngineVolumeExpandReq
 	})
 	log.Infof("Expanding volume to size %v", req.Expand.Size)
 
+	defer func() {
+		p.operations.Record(req.ProxyEngineRequest.EngineName, types.InstanceOperationExpand, fmt.Sprintf("size=%v", req.Expand.Size), err)
+	}()
+
 	ops, ok := p.ops[req.ProxyEngineRequest.DataEngine]
 	if !ok {
 		return nil, grpcstatus.Errorf(grpccodes.Unimplemented, "unsupported data engine %v", req.ProxyEngineRequest.DataEngine)
 	}
+	release, err := p.volumeOperations.acquire(req.ProxyEngineRequest, volumeOperationExpand)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	return ops.VolumeExpand(ctx, req)
 }
 
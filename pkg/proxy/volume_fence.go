@@ -0,0 +1,148 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+const (
+	volumeOperationExpand         = "expand"
+	volumeOperationSnapshot       = "snapshot"
+	volumeOperationRebuild        = "rebuild"
+	volumeOperationSnapshotRevert = "snapshot-revert"
+	volumeOperationSnapshotPurge  = "snapshot-purge"
+
+	// VolumeOperationConflictReason is the reason of the ErrorInfo details of the Aborted error returned for an
+	// operation conflicting with the one in flight on the volume. The metadata tell the operation in flight.
+	VolumeOperationConflictReason = "VOLUME_OPERATION_CONFLICT"
+)
+
+// volumeOperationConflicts lists the operations that cannot run on a volume along with each operation, e.g. a
+// snapshot taken during an expansion would have the old size on some replicas and the new one on the others. The
+// list is symmetric. The operations not listed, e.g. the concurrent snapshots or rebuilds, are serialized by the
+// engine itself. A snapshot is allowed during a rebuild, since the engine takes the snapshots on the rebuilding
+// replica as well and a rebuild of a large volume would otherwise block the scheduled snapshots for hours.
+var volumeOperationConflicts = map[string]map[string]bool{
+	volumeOperationExpand: {
+		volumeOperationExpand:         true,
+		volumeOperationSnapshot:       true,
+		volumeOperationRebuild:        true,
+		volumeOperationSnapshotRevert: true,
+		volumeOperationSnapshotPurge:  true,
+	},
+	volumeOperationSnapshot: {
+		volumeOperationExpand:         true,
+		volumeOperationSnapshotRevert: true,
+	},
+	volumeOperationRebuild: {
+		volumeOperationExpand:         true,
+		volumeOperationSnapshotRevert: true,
+		volumeOperationSnapshotPurge:  true,
+	},
+	volumeOperationSnapshotRevert: {
+		volumeOperationExpand:         true,
+		volumeOperationSnapshot:       true,
+		volumeOperationRebuild:        true,
+		volumeOperationSnapshotRevert: true,
+		volumeOperationSnapshotPurge:  true,
+	},
+	volumeOperationSnapshotPurge: {
+		volumeOperationExpand:         true,
+		volumeOperationRebuild:        true,
+		volumeOperationSnapshotRevert: true,
+		volumeOperationSnapshotPurge:  true,
+	},
+}
+
+type volumeOperation struct {
+	operation  string
+	engineName string
+	since      time.Time
+}
+
+// volumeOperationFence keeps the operations in flight on each volume through the proxy, and rejects the ones
+// conflicting with them rather than letting them interleave. An operation is in flight until its call returns, so
+// the background part of an operation, e.g. the purge after SnapshotPurge returns, is not fenced.
+type volumeOperationFence struct {
+	lock       *sync.Mutex
+	operations map[string][]*volumeOperation
+}
+
+func newVolumeOperationFence() *volumeOperationFence {
+	return &volumeOperationFence{
+		lock:       &sync.Mutex{},
+		operations: map[string][]*volumeOperation{},
+	}
+}
+
+// acquire records the operation in flight on the volume, and returns the func to release it once done. It fails with
+// Aborted if the operation conflicts with one in flight.
+func (f *volumeOperationFence) acquire(req *rpc.ProxyEngineRequest, operation string) (func(), error) {
+	volumeName := req.VolumeName
+	if volumeName == "" {
+		volumeName = req.EngineName
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, inFlight := range f.operations[volumeName] {
+		if volumeOperationConflicts[operation][inFlight.operation] {
+			return nil, newVolumeOperationConflictError(volumeName, operation, inFlight)
+		}
+	}
+
+	o := &volumeOperation{
+		operation:  operation,
+		engineName: req.EngineName,
+		since:      time.Now(),
+	}
+	f.operations[volumeName] = append(f.operations[volumeName], o)
+
+	return func() {
+		f.release(volumeName, o)
+	}, nil
+}
+
+func (f *volumeOperationFence) release(volumeName string, o *volumeOperation) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	operations := f.operations[volumeName]
+	for i := range operations {
+		if operations[i] == o {
+			operations = append(operations[:i], operations[i+1:]...)
+			break
+		}
+	}
+	if len(operations) == 0 {
+		delete(f.operations, volumeName)
+		return
+	}
+	f.operations[volumeName] = operations
+}
+
+func newVolumeOperationConflictError(volumeName, operation string, inFlight *volumeOperation) error {
+	since := inFlight.since.UTC().Format(time.RFC3339)
+	st := grpcstatus.Newf(grpccodes.Aborted, "cannot %v volume %v since it conflicts with the %v in flight by engine %v since %v",
+		operation, volumeName, inFlight.operation, inFlight.engineName, since)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: VolumeOperationConflictReason,
+		Metadata: map[string]string{
+			"volume":     volumeName,
+			"operation":  inFlight.operation,
+			"engineName": inFlight.engineName,
+			"since":      since,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
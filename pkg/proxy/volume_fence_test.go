@@ -0,0 +1,108 @@
+package proxy
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+
+	rpc "github.com/longhorn/longhorn-instance-manager/pkg/imrpc"
+)
+
+func (s *TestSuite) TestVolumeOperationConflicts(c *C) {
+	operations := []string{
+		volumeOperationExpand,
+		volumeOperationSnapshot,
+		volumeOperationRebuild,
+		volumeOperationSnapshotRevert,
+		volumeOperationSnapshotPurge,
+	}
+	testCases := []struct {
+		inFlight  string
+		operation string
+		conflict  bool
+	}{
+		{volumeOperationExpand, volumeOperationExpand, true},
+		{volumeOperationExpand, volumeOperationSnapshot, true},
+		{volumeOperationExpand, volumeOperationRebuild, true},
+		{volumeOperationExpand, volumeOperationSnapshotRevert, true},
+		{volumeOperationExpand, volumeOperationSnapshotPurge, true},
+		{volumeOperationSnapshot, volumeOperationSnapshot, false},
+		{volumeOperationSnapshot, volumeOperationRebuild, false},
+		{volumeOperationSnapshot, volumeOperationSnapshotRevert, true},
+		{volumeOperationSnapshot, volumeOperationSnapshotPurge, false},
+		{volumeOperationRebuild, volumeOperationRebuild, false},
+		{volumeOperationRebuild, volumeOperationSnapshotRevert, true},
+		{volumeOperationRebuild, volumeOperationSnapshotPurge, true},
+		{volumeOperationSnapshotRevert, volumeOperationSnapshotRevert, true},
+		{volumeOperationSnapshotRevert, volumeOperationSnapshotPurge, true},
+		{volumeOperationSnapshotPurge, volumeOperationSnapshotPurge, true},
+	}
+	c.Assert(testCases, HasLen, len(operations)*(len(operations)+1)/2)
+
+	for i, testCase := range testCases {
+		// The table is symmetric, so both orders are checked against the same expectation
+		for _, pair := range [][2]string{{testCase.inFlight, testCase.operation}, {testCase.operation, testCase.inFlight}} {
+			comment := Commentf("test case %v: %v in flight, %v requested", i, pair[0], pair[1])
+			f := newVolumeOperationFence()
+			req := &rpc.ProxyEngineRequest{EngineName: "vol-e-0", VolumeName: "vol"}
+
+			release, err := f.acquire(req, pair[0])
+			c.Assert(err, IsNil, comment)
+			_, err = f.acquire(req, pair[1])
+			if !testCase.conflict {
+				c.Assert(err, IsNil, comment)
+				continue
+			}
+			c.Assert(grpcstatus.Code(err), Equals, grpccodes.Aborted, comment)
+
+			// The operation passes once the conflicting one is released
+			release()
+			anotherRelease, err := f.acquire(req, pair[1])
+			c.Assert(err, IsNil, comment)
+			anotherRelease()
+			c.Assert(f.operations, HasLen, 0, comment)
+		}
+	}
+}
+
+func (s *TestSuite) TestVolumeOperationFence(c *C) {
+	f := newVolumeOperationFence()
+	req := &rpc.ProxyEngineRequest{EngineName: "vol-e-0", VolumeName: "vol"}
+
+	releaseRebuild, err := f.acquire(req, volumeOperationRebuild)
+	c.Assert(err, IsNil)
+	releaseSnapshot, err := f.acquire(req, volumeOperationSnapshot)
+	c.Assert(err, IsNil)
+
+	// The conflict tells the operation in flight
+	_, err = f.acquire(&rpc.ProxyEngineRequest{EngineName: "vol-e-1", VolumeName: "vol"}, volumeOperationExpand)
+	st := grpcstatus.Convert(err)
+	c.Assert(st.Code(), Equals, grpccodes.Aborted)
+	c.Assert(st.Details(), HasLen, 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	c.Assert(ok, Equals, true)
+	c.Assert(info.Reason, Equals, VolumeOperationConflictReason)
+	c.Assert(info.Metadata["volume"], Equals, "vol")
+	c.Assert(info.Metadata["operation"], Equals, volumeOperationRebuild)
+	c.Assert(info.Metadata["engineName"], Equals, "vol-e-0")
+
+	// The operations on other volumes, or on an engine without the volume name, are not fenced
+	release, err := f.acquire(&rpc.ProxyEngineRequest{EngineName: "other-e-0", VolumeName: "other"}, volumeOperationExpand)
+	c.Assert(err, IsNil)
+	release()
+	release, err = f.acquire(&rpc.ProxyEngineRequest{EngineName: "standalone-e-0"}, volumeOperationExpand)
+	c.Assert(err, IsNil)
+	release()
+
+	// The expansion is still fenced by the snapshot after the rebuild is done
+	releaseRebuild()
+	_, err = f.acquire(req, volumeOperationExpand)
+	c.Assert(grpcstatus.Code(err), Equals, grpccodes.Aborted)
+	releaseSnapshot()
+	release, err = f.acquire(req, volumeOperationExpand)
+	c.Assert(err, IsNil)
+	release()
+	c.Assert(f.operations, HasLen, 0)
+}
@@ -12,6 +12,8 @@ const (
 	ProcessStateStopped  = "stopped"
 	ProcessStateStopping = "stopping"
 	ProcessStateError    = "error"
+	// ProcessStateSuspended is the state of a process stopped by SIGSTOP until it is resumed
+	ProcessStateSuspended = "suspended"
 
 	DiskGrpcService           = "disk gRPC server"
 	SpdkGrpcService           = "spdk gRPC server"
@@ -43,8 +45,106 @@ const (
 	InstanceTypeReplica = "replica"
 )
 
+const (
+	InstancePortProtocolGRPC    = "grpc"
+	InstancePortProtocolTCP     = "tcp"
+	InstancePortProtocolHTTP    = "http"
+	InstancePortProtocolNVMeTCP = "nvme-tcp"
+
+	// InstancePortPurposeControl is the gRPC service of a v1 engine or replica
+	InstancePortPurposeControl = "control"
+	// InstancePortPurposeData is the data path of a v1 replica, or the NVMe-oF target of the head of a v2 replica
+	InstancePortPurposeData = "data"
+	// InstancePortPurposeSyncAgent is the sync agent service of a v1 replica
+	InstancePortPurposeSyncAgent = "sync-agent"
+	// InstancePortPurposeSyncTransfer is the file transfers of the sync agent of a v1 replica
+	InstancePortPurposeSyncTransfer = "sync-transfer"
+	// InstancePortPurposeRebuild is the NVMe-oF targets of the lvols of a v2 replica exposed for rebuilding
+	InstancePortPurposeRebuild = "rebuild"
+	// InstancePortPurposeFrontend is the NVMe-oF target of the frontend of a v2 engine
+	InstancePortPurposeFrontend = "frontend"
+)
+
+const (
+	// InstanceHealthProbeGRPC is the gRPC health check of the service of a v1 instance
+	InstanceHealthProbeGRPC = "grpc-health"
+	// InstanceHealthProbeSPDKBdev is getting the bdev of a v2 instance from spdk_tgt
+	InstanceHealthProbeSPDKBdev = "spdk-bdev"
+	// InstanceHealthProbeIO is reading a block of the frontend device of a v2 engine
+	InstanceHealthProbeIO = "io"
+)
+
+const (
+	InstanceRestartPolicyNever     = "never"
+	InstanceRestartPolicyOnFailure = "on-failure"
+	InstanceRestartPolicyAlways    = "always"
+)
+
+const (
+	InstanceOperationCreate  = "create"
+	InstanceOperationDelete  = "delete"
+	InstanceOperationReplace = "replace"
+	InstanceOperationExpand  = "expand"
+	InstanceOperationSuspend = "suspend"
+	InstanceOperationResume  = "resume"
+	InstanceOperationRestart = "restart"
+	InstanceOperationConvert = "convert"
+
+	// MaxInstanceOperations is the number of the recent operations kept for each instance
+	MaxInstanceOperations = 32
+)
+
+const (
+	InstanceWatchEventTypeAdded    = "added"
+	InstanceWatchEventTypeModified = "modified"
+	InstanceWatchEventTypeDeleted  = "deleted"
+	InstanceWatchEventTypeResync   = "resync"
+)
+
+const (
+	SpdkOrphanActionAdopt   = "adopt"
+	SpdkOrphanActionCleanup = "cleanup"
+	SpdkOrphanActionSkip    = "skip"
+
+	SpdkOrphanKindLvol           = "lvol"
+	SpdkOrphanKindRaidBdev       = "raid_bdev"
+	SpdkOrphanKindNvmeController = "nvme_controller"
+	SpdkOrphanKindNvmfSubsystem  = "nvmf_subsystem"
+)
+
+const (
+	StateImportKindDisk     = "disk"
+	StateImportKindInstance = "instance"
+
+	StateImportResultCreated = "created"
+	StateImportResultExists  = "exists"
+	StateImportResultValid   = "valid"
+	StateImportResultFailed  = "failed"
+)
+
+const (
+	EngineMigrationPhasePending    = "pending"
+	EngineMigrationPhaseCopying    = "copying"
+	EngineMigrationPhaseValidating = "validating"
+	EngineMigrationPhaseCompleted  = "completed"
+	EngineMigrationPhaseFailed     = "failed"
+
+	EngineMigrationValidationPassed = "passed"
+	EngineMigrationValidationFailed = "failed"
+)
+
 const (
 	GlobalMountPathPattern = "/host/var/lib/kubelet/plugins/kubernetes.io/csi/driver.longhorn.io/*/globalmount"
 
 	EngineConditionFilesystemReadOnly = "FilesystemReadOnly"
+	// EngineConditionTargetSwitchedOver tells that the frontend of a v2 engine is switched over to the target of
+	// another engine, and EngineConditionTargetDeleted that the target of the engine itself is deleted
+	EngineConditionTargetSwitchedOver = "TargetSwitchedOver"
+	EngineConditionTargetDeleted      = "TargetDeleted"
+
+	ProcessConditionLogFlooding = "LogFlooding"
+	// ProcessConditionRebuildThrottled is set while the process is limited by the rebuild resource limits
+	ProcessConditionRebuildThrottled = "RebuildThrottled"
+	// ProcessConditionResourceLimited is set once the process is placed in the cgroup with its CPU and memory limits
+	ProcessConditionResourceLimited = "ResourceLimited"
 )
@@ -0,0 +1,145 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// numaNodeDirectory has the CPUs of the NUMA nodes in node<N>/cpulist
+var numaNodeDirectory = "/sys/devices/system/node"
+
+// ParseCPUList parses the Linux list format of CPUs or NUMA nodes, e.g. "0-3,8,10-11", into the sorted numbers. An
+// empty list is nil.
+func ParseCPUList(list string) ([]int, error) {
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return nil, nil
+	}
+
+	set := map[int]struct{}{}
+	for _, item := range strings.Split(list, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(item), "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil || first < 0 {
+			return nil, fmt.Errorf("invalid item %q of list %q", item, list)
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil || last < first {
+				return nil, fmt.Errorf("invalid range %q of list %q", item, list)
+			}
+		}
+		for i := first; i <= last; i++ {
+			set[i] = struct{}{}
+		}
+	}
+
+	numbers := make([]int, 0, len(set))
+	for i := range set {
+		numbers = append(numbers, i)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+// ValidateCPUAffinity validates the CPU set and the NUMA nodes a process is pinned to, both in the list format.
+func ValidateCPUAffinity(cpuSet, numaNode string) error {
+	if _, err := ParseCPUList(cpuSet); err != nil {
+		return errors.Wrap(err, "invalid CPU set")
+	}
+	if _, err := ParseCPUList(numaNode); err != nil {
+		return errors.Wrap(err, "invalid NUMA node")
+	}
+	return nil
+}
+
+// GetCPUAffinity returns the CPUs a process is pinned to, which are the ones of the CPU set that are on the NUMA
+// nodes. Either can be empty for any. The CPUs are nil if neither is specified.
+func GetCPUAffinity(cpuSet, numaNode string) ([]int, error) {
+	cpus, err := ParseCPUList(cpuSet)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid CPU set")
+	}
+	nodes, err := ParseCPUList(numaNode)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid NUMA node")
+	}
+	if len(nodes) == 0 {
+		return cpus, nil
+	}
+
+	nodeCPUs := map[int]struct{}{}
+	for _, node := range nodes {
+		path := filepath.Join(numaNodeDirectory, fmt.Sprintf("node%d", node), "cpulist")
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the CPUs of NUMA node %v", node)
+		}
+		list, err := ParseCPUList(string(content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid CPUs of NUMA node %v", node)
+		}
+		for _, cpu := range list {
+			nodeCPUs[cpu] = struct{}{}
+		}
+	}
+
+	affinity := []int{}
+	if len(cpus) == 0 {
+		for cpu := range nodeCPUs {
+			affinity = append(affinity, cpu)
+		}
+		sort.Ints(affinity)
+	} else {
+		for _, cpu := range cpus {
+			if _, ok := nodeCPUs[cpu]; ok {
+				affinity = append(affinity, cpu)
+			}
+		}
+	}
+	if len(affinity) == 0 {
+		return nil, fmt.Errorf("no CPU of set %q is on NUMA node %v", cpuSet, numaNode)
+	}
+	return affinity, nil
+}
+
+// SetProcessTreeAffinity pins the process along with its descendants to the CPUs. The affinity is set for every
+// thread, since it is per thread on Linux. The threads started afterwards inherit it from the ones starting them.
+func SetProcessTreeAffinity(pid int, cpus []int) error {
+	set := &unix.CPUSet{}
+	for _, cpu := range cpus {
+		set.Set(cpu)
+	}
+
+	pids, err := GetProcessTree(pid)
+	if err != nil {
+		return err
+	}
+	for _, pid := range pids {
+		tasks, err := os.ReadDir(filepath.Join(procDirectory, strconv.Itoa(pid), "task"))
+		if err != nil {
+			// The descendant may exit in the meantime
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to list the threads of process %v", pid)
+		}
+		for _, task := range tasks {
+			tid, err := strconv.Atoi(task.Name())
+			if err != nil {
+				continue
+			}
+			if err := unix.SchedSetaffinity(tid, set); err != nil && err != unix.ESRCH {
+				return errors.Wrapf(err, "failed to set the CPU affinity of thread %v of process %v", tid, pid)
+			}
+		}
+	}
+	return nil
+}
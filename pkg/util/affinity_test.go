@@ -0,0 +1,91 @@
+package util
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestParseCPUList(c *C) {
+	cpus, err := ParseCPUList("")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, IsNil)
+
+	cpus, err = ParseCPUList("0-3,8, 10-11\n")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, DeepEquals, []int{0, 1, 2, 3, 8, 10, 11})
+
+	cpus, err = ParseCPUList("2,1-2")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, DeepEquals, []int{1, 2})
+
+	for _, list := range []string{"a", "3-1", "-1", "1,", "1-2-3"} {
+		_, err := ParseCPUList(list)
+		c.Assert(err, NotNil, Commentf("list %q", list))
+	}
+	c.Assert(ValidateCPUAffinity("0-1", "0"), IsNil)
+	c.Assert(ValidateCPUAffinity("0-1", "x"), NotNil)
+}
+
+func (s *TestSuite) TestGetCPUAffinity(c *C) {
+	dir := c.MkDir()
+	defer func(directory string) {
+		numaNodeDirectory = directory
+	}(numaNodeDirectory)
+	numaNodeDirectory = dir
+
+	for node, cpulist := range map[string]string{"node0": "0-3\n", "node1": "4-7\n"} {
+		c.Assert(os.MkdirAll(filepath.Join(dir, node), 0755), IsNil)
+		c.Assert(os.WriteFile(filepath.Join(dir, node, "cpulist"), []byte(cpulist), 0644), IsNil)
+	}
+
+	cpus, err := GetCPUAffinity("", "")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, IsNil)
+
+	cpus, err = GetCPUAffinity("1,5", "")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, DeepEquals, []int{1, 5})
+
+	cpus, err = GetCPUAffinity("", "1")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, DeepEquals, []int{4, 5, 6, 7})
+
+	cpus, err = GetCPUAffinity("2-5", "1")
+	c.Assert(err, IsNil)
+	c.Assert(cpus, DeepEquals, []int{4, 5})
+
+	_, err = GetCPUAffinity("0-1", "1")
+	c.Assert(err, NotNil)
+	_, err = GetCPUAffinity("", "2")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestSetProcessTreeAffinity(c *C) {
+	allowed := &unix.CPUSet{}
+	c.Assert(unix.SchedGetaffinity(0, allowed), IsNil)
+	cpu := -1
+	for i := 0; i < len(allowed)*64; i++ {
+		if allowed.IsSet(i) {
+			cpu = i
+			break
+		}
+	}
+	c.Assert(cpu, Not(Equals), -1)
+
+	cmd := exec.Command("sleep", "30")
+	c.Assert(cmd.Start(), IsNil)
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	c.Assert(SetProcessTreeAffinity(cmd.Process.Pid, []int{cpu}), IsNil)
+	set := &unix.CPUSet{}
+	c.Assert(unix.SchedGetaffinity(cmd.Process.Pid, set), IsNil)
+	c.Assert(set.Count(), Equals, 1)
+	c.Assert(set.IsSet(cpu), Equals, true)
+}
@@ -84,3 +84,69 @@ func (b *Bitmap) ReleaseRange(start, end int32) error {
 	b.data.AddRange(uint64(bStart), uint64(bEnd)+1)
 	return nil
 }
+
+// AllocateSpecificRange marks the range [start, end] as allocated regardless of its current state.
+func (b *Bitmap) AllocateSpecificRange(start, end int32) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	bStart := start - b.base
+	bEnd := end - b.base
+	if bStart < 0 || bEnd >= b.size || bStart > bEnd {
+		return fmt.Errorf("exceed range: %v-%v (%v-%v)", start, end, bStart, bEnd)
+	}
+	b.data.RemoveRange(uint64(bStart), uint64(bEnd)+1)
+	return nil
+}
+
+// IsAllocated returns false for the ports out of the bitmap range.
+func (b *Bitmap) IsAllocated(port int32) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	bPort := port - b.base
+	if bPort < 0 || bPort >= b.size {
+		return false
+	}
+	return !b.data.Contains(uint32(bPort))
+}
+
+// AllocatedPorts returns the allocated ports in ascending order.
+func (b *Bitmap) AllocatedPorts() []int32 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	ports := []int32{}
+	for i := int32(0); i < b.size; i++ {
+		if !b.data.Contains(uint32(i)) {
+			ports = append(ports, b.base+i)
+		}
+	}
+	return ports
+}
+
+// HasRange returns true if count contiguous ports can be allocated, without allocating them.
+func (b *Bitmap) HasRange(count int32) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if count <= 0 {
+		return false
+	}
+	run := int32(0)
+	last := int64(-2)
+	i := b.data.Iterator()
+	for i.HasNext() {
+		next := int64(i.Next())
+		if next == last+1 {
+			run++
+		} else {
+			run = 1
+		}
+		if run >= count {
+			return true
+		}
+		last = next
+	}
+	return false
+}
@@ -52,3 +52,40 @@ func (s *TestSuite) TestBitmap(c *C) {
 	c.Assert(start, Equals, int32(120))
 	c.Assert(end, Equals, int32(120))
 }
+
+func (s *TestSuite) TestBitmapSpecificRange(c *C) {
+	bm := NewBitmap(100, 200)
+
+	err := bm.AllocateSpecificRange(100, 102)
+	c.Assert(err, IsNil)
+	c.Assert(bm.IsAllocated(100), Equals, true)
+	c.Assert(bm.IsAllocated(102), Equals, true)
+	c.Assert(bm.IsAllocated(103), Equals, false)
+	c.Assert(bm.IsAllocated(300), Equals, false)
+	c.Assert(bm.AllocatedPorts(), DeepEquals, []int32{100, 101, 102})
+
+	err = bm.AllocateSpecificRange(190, 201)
+	c.Assert(err, NotNil)
+
+	start, end, err := bm.AllocateRange(10)
+	c.Assert(err, IsNil)
+	c.Assert(start, Equals, int32(103))
+	c.Assert(end, Equals, int32(112))
+
+	err = bm.ReleaseRange(100, 102)
+	c.Assert(err, IsNil)
+	c.Assert(bm.AllocatedPorts(), HasLen, 10)
+}
+
+func (s *TestSuite) TestBitmapHasRange(c *C) {
+	bm := NewBitmap(100, 109)
+	c.Assert(bm.HasRange(10), Equals, true)
+	c.Assert(bm.HasRange(11), Equals, false)
+	c.Assert(bm.HasRange(0), Equals, false)
+
+	err := bm.AllocateSpecificRange(104, 105)
+	c.Assert(err, IsNil)
+	c.Assert(bm.HasRange(4), Equals, true)
+	c.Assert(bm.HasRange(5), Equals, false)
+	c.Assert(bm.AllocatedPorts(), HasLen, 2)
+}
@@ -0,0 +1,133 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// maxCapability bounds the capabilities dropped from the bounding set, which is beyond the last capability known
+// to the instance manager so that the ones of a newer kernel are dropped as well
+const maxCapability = 63
+
+var capabilities = map[string]int{
+	"CAP_CHOWN":              unix.CAP_CHOWN,
+	"CAP_DAC_OVERRIDE":       unix.CAP_DAC_OVERRIDE,
+	"CAP_DAC_READ_SEARCH":    unix.CAP_DAC_READ_SEARCH,
+	"CAP_FOWNER":             unix.CAP_FOWNER,
+	"CAP_FSETID":             unix.CAP_FSETID,
+	"CAP_KILL":               unix.CAP_KILL,
+	"CAP_SETGID":             unix.CAP_SETGID,
+	"CAP_SETUID":             unix.CAP_SETUID,
+	"CAP_SETPCAP":            unix.CAP_SETPCAP,
+	"CAP_LINUX_IMMUTABLE":    unix.CAP_LINUX_IMMUTABLE,
+	"CAP_NET_BIND_SERVICE":   unix.CAP_NET_BIND_SERVICE,
+	"CAP_NET_BROADCAST":      unix.CAP_NET_BROADCAST,
+	"CAP_NET_ADMIN":          unix.CAP_NET_ADMIN,
+	"CAP_NET_RAW":            unix.CAP_NET_RAW,
+	"CAP_IPC_LOCK":           unix.CAP_IPC_LOCK,
+	"CAP_IPC_OWNER":          unix.CAP_IPC_OWNER,
+	"CAP_SYS_MODULE":         unix.CAP_SYS_MODULE,
+	"CAP_SYS_RAWIO":          unix.CAP_SYS_RAWIO,
+	"CAP_SYS_CHROOT":         unix.CAP_SYS_CHROOT,
+	"CAP_SYS_PTRACE":         unix.CAP_SYS_PTRACE,
+	"CAP_SYS_PACCT":          unix.CAP_SYS_PACCT,
+	"CAP_SYS_ADMIN":          unix.CAP_SYS_ADMIN,
+	"CAP_SYS_BOOT":           unix.CAP_SYS_BOOT,
+	"CAP_SYS_NICE":           unix.CAP_SYS_NICE,
+	"CAP_SYS_RESOURCE":       unix.CAP_SYS_RESOURCE,
+	"CAP_SYS_TIME":           unix.CAP_SYS_TIME,
+	"CAP_SYS_TTY_CONFIG":     unix.CAP_SYS_TTY_CONFIG,
+	"CAP_MKNOD":              unix.CAP_MKNOD,
+	"CAP_LEASE":              unix.CAP_LEASE,
+	"CAP_AUDIT_WRITE":        unix.CAP_AUDIT_WRITE,
+	"CAP_AUDIT_CONTROL":      unix.CAP_AUDIT_CONTROL,
+	"CAP_SETFCAP":            unix.CAP_SETFCAP,
+	"CAP_MAC_OVERRIDE":       unix.CAP_MAC_OVERRIDE,
+	"CAP_MAC_ADMIN":          unix.CAP_MAC_ADMIN,
+	"CAP_SYSLOG":             unix.CAP_SYSLOG,
+	"CAP_WAKE_ALARM":         unix.CAP_WAKE_ALARM,
+	"CAP_BLOCK_SUSPEND":      unix.CAP_BLOCK_SUSPEND,
+	"CAP_AUDIT_READ":         unix.CAP_AUDIT_READ,
+	"CAP_PERFMON":            unix.CAP_PERFMON,
+	"CAP_BPF":                unix.CAP_BPF,
+	"CAP_CHECKPOINT_RESTORE": unix.CAP_CHECKPOINT_RESTORE,
+}
+
+// ParseCapabilities returns the sorted numbers of the capabilities of the names, e.g. CAP_SYS_ADMIN. The names are
+// case insensitive and the CAP_ prefix is optional.
+func ParseCapabilities(names []string) ([]int, error) {
+	set := map[int]struct{}{}
+	for _, name := range names {
+		normalized := strings.ToUpper(strings.TrimSpace(name))
+		if !strings.HasPrefix(normalized, "CAP_") {
+			normalized = "CAP_" + normalized
+		}
+		capability, ok := capabilities[normalized]
+		if !ok {
+			return nil, fmt.Errorf("unknown capability %v", name)
+		}
+		set[capability] = struct{}{}
+	}
+
+	numbers := make([]int, 0, len(set))
+	for capability := range set {
+		numbers = append(numbers, capability)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+// RestrictPrivileges drops all the capabilities but the ones to keep from the bounding, permitted, effective and
+// inheritable sets, and optionally sets no_new_privs, before a binary is executed. A root process gets the bounding
+// and inheritable sets as its permitted set on exec, so the binary is left with the kept capabilities only.
+//
+// The capabilities and no_new_privs are per thread, so the caller must lock the OS thread and exec from it.
+func RestrictPrivileges(keep []int, noNewPrivileges bool) error {
+	kept := map[int]bool{}
+	for _, capability := range keep {
+		kept[capability] = true
+	}
+
+	// Dropping from the bounding set requires CAP_SETPCAP, so do it before dropping from the effective set
+	for capability := 0; capability <= maxCapability; capability++ {
+		if kept[capability] {
+			continue
+		}
+		if err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(capability), 0, 0, 0); err != nil {
+			// The capability is unknown to the kernel
+			if err == unix.EINVAL {
+				continue
+			}
+			return errors.Wrapf(err, "failed to drop capability %v from the bounding set", capability)
+		}
+	}
+
+	header := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+	data := [2]unix.CapUserData{}
+	if err := unix.Capget(&header, &data[0]); err != nil {
+		return errors.Wrap(err, "failed to get the capabilities")
+	}
+	masks := [2]uint32{}
+	for capability := range kept {
+		masks[capability/32] |= 1 << (uint(capability) % 32)
+	}
+	for i := range data {
+		data[i].Permitted &= masks[i]
+		data[i].Effective &= masks[i]
+		data[i].Inheritable = data[i].Permitted
+	}
+	if err := unix.Capset(&header, &data[0]); err != nil {
+		return errors.Wrap(err, "failed to set the capabilities")
+	}
+
+	if noNewPrivileges {
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			return errors.Wrap(err, "failed to set no_new_privs")
+		}
+	}
+	return nil
+}
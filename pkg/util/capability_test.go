@@ -0,0 +1,58 @@
+package util
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestParseCapabilities(c *C) {
+	capabilities, err := ParseCapabilities(nil)
+	c.Assert(err, IsNil)
+	c.Assert(capabilities, HasLen, 0)
+
+	capabilities, err = ParseCapabilities([]string{"CAP_SYS_ADMIN", "net_admin", "cap_sys_admin"})
+	c.Assert(err, IsNil)
+	c.Assert(capabilities, DeepEquals, []int{unix.CAP_NET_ADMIN, unix.CAP_SYS_ADMIN})
+
+	_, err = ParseCapabilities([]string{"CAP_EVERYTHING"})
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestRestrictPrivileges(c *C) {
+	if os.Geteuid() != 0 {
+		c.Skip("requires root")
+	}
+
+	type result struct {
+		status string
+		err    error
+	}
+	resultCh := make(chan result)
+	go func() {
+		// The thread is terminated along with the goroutine rather than reused with the privileges restricted
+		runtime.LockOSThread()
+		if err := RestrictPrivileges([]int{unix.CAP_CHOWN}, true); err != nil {
+			resultCh <- result{err: err}
+			return
+		}
+		content, err := os.ReadFile("/proc/thread-self/status")
+		resultCh <- result{status: string(content), err: err}
+	}()
+	r := <-resultCh
+	c.Assert(r.err, IsNil)
+
+	fields := map[string]string{}
+	for _, line := range strings.Split(r.status, "\n") {
+		if parts := strings.SplitN(line, ":", 2); len(parts) == 2 {
+			fields[parts[0]] = strings.TrimSpace(parts[1])
+		}
+	}
+	c.Assert(fields["CapBnd"], Equals, "0000000000000001")
+	c.Assert(fields["CapEff"], Equals, "0000000000000001")
+	c.Assert(fields["CapInh"], Equals, "0000000000000001")
+	c.Assert(fields["NoNewPrivs"], Equals, "1")
+}
@@ -0,0 +1,325 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// cgroupHomeName is the leaf cgroup the processes of the instance manager are moved into, since the cgroup v2
+	// controllers can be enabled for the child cgroups only if the parent has no processes of its own
+	cgroupHomeName = "instance-manager"
+
+	cgroupCPUPeriod = 100000
+)
+
+// cgroupDirectory is the cgroup v2 hierarchy the instance manager is in, which is the one of its container.
+var cgroupDirectory = "/sys/fs/cgroup"
+
+var cgroupDelegation = struct {
+	lock    *sync.Mutex
+	enabled bool
+	// memory is whether the memory controller is enabled along with the CPU and IO ones, which it is if available
+	memory bool
+}{
+	lock: &sync.Mutex{},
+}
+
+// CgroupLimits are the CPU, memory and IO limits of a cgroup. A limit is disabled if 0.
+type CgroupLimits struct {
+	// CPUMaxMillicores is the CPU time the processes of the cgroup can use in thousandths of one CPU
+	CPUMaxMillicores int64
+	// MemoryMaxBytes is the memory the processes of the cgroup can use before being reclaimed or OOM killed
+	MemoryMaxBytes int64
+	// IOReadBPS and IOWriteBPS are the bandwidth of the block device the processes of the cgroup can use in bytes
+	// per second
+	IOReadBPS  int64
+	IOWriteBPS int64
+}
+
+func (l *CgroupLimits) Enabled() bool {
+	return l != nil && (l.CPUMaxMillicores > 0 || l.MemoryMaxBytes > 0 || l.IOReadBPS > 0 || l.IOWriteBPS > 0)
+}
+
+// Merge returns the limits with the tighter of each limit of both, e.g. for a process with limits of its own entering
+// the rebuild mode. Either may be nil.
+func (l *CgroupLimits) Merge(other *CgroupLimits) *CgroupLimits {
+	tighter := func(a, b int64) int64 {
+		if a <= 0 || (b > 0 && b < a) {
+			return b
+		}
+		return a
+	}
+	merged := &CgroupLimits{}
+	for _, limits := range []*CgroupLimits{l, other} {
+		if limits == nil {
+			continue
+		}
+		merged.CPUMaxMillicores = tighter(merged.CPUMaxMillicores, limits.CPUMaxMillicores)
+		merged.MemoryMaxBytes = tighter(merged.MemoryMaxBytes, limits.MemoryMaxBytes)
+		merged.IOReadBPS = tighter(merged.IOReadBPS, limits.IOReadBPS)
+		merged.IOWriteBPS = tighter(merged.IOWriteBPS, limits.IOWriteBPS)
+	}
+	return merged
+}
+
+// formatCPUMax returns the content of cpu.max for the millicores, e.g. "50000 100000" for 500.
+func formatCPUMax(millicores int64) string {
+	if millicores <= 0 {
+		return fmt.Sprintf("max %d", cgroupCPUPeriod)
+	}
+	return fmt.Sprintf("%d %d", cgroupCPUPeriod*millicores/1000, cgroupCPUPeriod)
+}
+
+// formatMemoryMax returns the content of memory.max for the bytes, e.g. "1073741824" for 1 GiB.
+func formatMemoryMax(bytes int64) string {
+	if bytes <= 0 {
+		return "max"
+	}
+	return strconv.FormatInt(bytes, 10)
+}
+
+// formatIOMax returns the line of io.max limiting the device, e.g. "8:0 rbps=1048576 wbps=max".
+func formatIOMax(major, minor uint32, readBPS, writeBPS int64) string {
+	limit := func(bps int64) string {
+		if bps <= 0 {
+			return "max"
+		}
+		return strconv.FormatInt(bps, 10)
+	}
+	return fmt.Sprintf("%d:%d rbps=%s wbps=%s", major, minor, limit(readBPS), limit(writeBPS))
+}
+
+// enableCgroupDelegation moves the processes of the instance manager into the home cgroup and enables the CPU and IO
+// controllers, along with the memory one if available, for the child cgroups. The processes started afterwards are in the home cgroup, since they inherit it
+// from the instance manager.
+func enableCgroupDelegation() error {
+	cgroupDelegation.lock.Lock()
+	defer cgroupDelegation.lock.Unlock()
+
+	if cgroupDelegation.enabled {
+		return nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(cgroupDirectory, "cgroup.controllers"))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the cgroup v2 controllers of %v", cgroupDirectory)
+	}
+	controllers := strings.Fields(string(content))
+	for _, controller := range []string{"cpu", "io"} {
+		if !contains(controllers, controller) {
+			return fmt.Errorf("cgroup controller %v is not available in %v", controller, cgroupDirectory)
+		}
+	}
+
+	home := filepath.Join(cgroupDirectory, cgroupHomeName)
+	if err := os.MkdirAll(home, 0755); err != nil {
+		return errors.Wrapf(err, "failed to create cgroup %v", home)
+	}
+	if err := moveCgroupProcesses(cgroupDirectory, home); err != nil {
+		return err
+	}
+	subtreeControl := "+cpu +io"
+	memory := contains(controllers, "memory")
+	if memory {
+		subtreeControl += " +memory"
+	}
+	if err := os.WriteFile(filepath.Join(cgroupDirectory, "cgroup.subtree_control"), []byte(subtreeControl), 0644); err != nil {
+		return errors.Wrapf(err, "failed to enable the cgroup controllers of %v", cgroupDirectory)
+	}
+
+	cgroupDelegation.enabled = true
+	cgroupDelegation.memory = memory
+	return nil
+}
+
+// moveCgroupProcesses moves the processes of the cgroup into the other one. A process exiting in the meantime is
+// skipped.
+func moveCgroupProcesses(from, to string) error {
+	pids, err := getCgroupProcesses(from)
+	if err != nil {
+		return err
+	}
+	return moveToCgroup(to, pids)
+}
+
+func getCgroupProcesses(dir string) ([]int, error) {
+	content, err := os.ReadFile(filepath.Join(dir, "cgroup.procs"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the processes of cgroup %v", dir)
+	}
+	pids := []int{}
+	for _, field := range strings.Fields(string(content)) {
+		pid, err := strconv.Atoi(field)
+		if err != nil {
+			continue
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
+func moveToCgroup(dir string, pids []int) error {
+	procsPath := filepath.Join(dir, "cgroup.procs")
+	for _, pid := range pids {
+		if err := os.WriteFile(procsPath, []byte(strconv.Itoa(pid)), 0644); err != nil {
+			if errors.Is(err, unix.ESRCH) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to move process %v into cgroup %v", pid, dir)
+		}
+	}
+	return nil
+}
+
+// LimitProcessTree moves the process along with its descendants into the cgroup of the name, which is created with
+// the limits. The IO limits apply to the block device of the path, and are skipped if the path is not on one.
+func LimitProcessTree(name string, pid int, limits *CgroupLimits, path string) error {
+	if err := enableCgroupDelegation(); err != nil {
+		return err
+	}
+
+	cgroupDelegation.lock.Lock()
+	memory := cgroupDelegation.memory
+	cgroupDelegation.lock.Unlock()
+	if limits.MemoryMaxBytes > 0 && !memory {
+		return fmt.Errorf("cgroup controller memory is not available in %v", cgroupDirectory)
+	}
+
+	dir := filepath.Join(cgroupDirectory, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrapf(err, "failed to create cgroup %v", dir)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(formatCPUMax(limits.CPUMaxMillicores)), 0644); err != nil {
+		return errors.Wrapf(err, "failed to set the CPU limit of cgroup %v", dir)
+	}
+	if memory {
+		if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(formatMemoryMax(limits.MemoryMaxBytes)), 0644); err != nil {
+			return errors.Wrapf(err, "failed to set the memory limit of cgroup %v", dir)
+		}
+	}
+	if (limits.IOReadBPS > 0 || limits.IOWriteBPS > 0) && path != "" {
+		major, minor, err := GetBlockDeviceNumber(path)
+		if err != nil {
+			return err
+		}
+		if major != 0 {
+			if err := os.WriteFile(filepath.Join(dir, "io.max"), []byte(formatIOMax(major, minor, limits.IOReadBPS, limits.IOWriteBPS)), 0644); err != nil {
+				return errors.Wrapf(err, "failed to set the IO limit of cgroup %v", dir)
+			}
+		}
+	}
+
+	pids, err := GetProcessTree(pid)
+	if err != nil {
+		return err
+	}
+	return moveToCgroup(dir, pids)
+}
+
+// UnlimitCgroup moves the processes of the cgroup of the name back into the home cgroup and removes the cgroup. It
+// is a no-op if the cgroup does not exist.
+func UnlimitCgroup(name string) error {
+	return UnlimitCgroupTo(name, "")
+}
+
+// UnlimitCgroupTo is UnlimitCgroup moving the processes into the cgroup of the other name instead, e.g. the one with
+// the limits of the process itself. The processes are moved into the home cgroup if the other one does not exist.
+func UnlimitCgroupTo(name, to string) error {
+	dir := filepath.Join(cgroupDirectory, name)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	toDir := filepath.Join(cgroupDirectory, cgroupHomeName)
+	if to != "" {
+		if _, err := os.Stat(filepath.Join(cgroupDirectory, to)); err == nil {
+			toDir = filepath.Join(cgroupDirectory, to)
+		}
+	}
+	if err := moveCgroupProcesses(dir, toDir); err != nil {
+		return err
+	}
+	if err := unix.Rmdir(dir); err != nil && !errors.Is(err, unix.ENOENT) {
+		return errors.Wrapf(err, "failed to remove cgroup %v", dir)
+	}
+	return nil
+}
+
+// GetProcessTree returns the process and its descendants found by the parent pids in /proc.
+func GetProcessTree(pid int) ([]int, error) {
+	entries, err := os.ReadDir(procDirectory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", procDirectory)
+	}
+	children := map[int][]int{}
+	for _, entry := range entries {
+		child, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// The process may exit in the meantime, so ignore the failures
+		content, err := os.ReadFile(filepath.Join(procDirectory, entry.Name(), "stat"))
+		if err != nil {
+			continue
+		}
+		stat, err := parseProcStat(string(content))
+		if err != nil {
+			continue
+		}
+		children[stat.ppid] = append(children[stat.ppid], child)
+	}
+
+	pids := []int{pid}
+	for i := 0; i < len(pids); i++ {
+		pids = append(pids, children[pids[i]]...)
+	}
+	return pids, nil
+}
+
+// GetBlockDeviceNumber returns the major and minor numbers of the whole block device the path is on, which the IO
+// limits of cgroup v2 apply to rather than the partitions. The major number is 0 if the path is not on a block
+// device, e.g. on tmpfs or overlayfs.
+func GetBlockDeviceNumber(path string) (uint32, uint32, error) {
+	stat := &unix.Stat_t{}
+	if err := unix.Stat(path, stat); err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to stat %v", path)
+	}
+	major, minor := unix.Major(uint64(stat.Dev)), unix.Minor(uint64(stat.Dev))
+	if major == 0 {
+		return 0, 0, nil
+	}
+
+	sysDevice := fmt.Sprintf("/sys/dev/block/%d:%d", major, minor)
+	if _, err := os.Stat(filepath.Join(sysDevice, "partition")); err != nil {
+		return major, minor, nil
+	}
+	// The device of the partition links to the directory of the partition within the one of the disk
+	resolved, err := filepath.EvalSymlinks(sysDevice)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to resolve %v", sysDevice)
+	}
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(resolved), "dev"))
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to find the disk of partition %d:%d", major, minor)
+	}
+	if _, err := fmt.Sscanf(strings.TrimSpace(string(content)), "%d:%d", &major, &minor); err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid device number of the disk of partition %d:%d", major, minor)
+	}
+	return major, minor, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package util
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestFormatCgroupLimits(c *C) {
+	c.Assert(formatCPUMax(0), Equals, "max 100000")
+	c.Assert(formatCPUMax(500), Equals, "50000 100000")
+	c.Assert(formatCPUMax(2000), Equals, "200000 100000")
+	c.Assert(formatCPUMax(250), Equals, "25000 100000")
+
+	c.Assert(formatMemoryMax(0), Equals, "max")
+	c.Assert(formatMemoryMax(1<<30), Equals, "1073741824")
+
+	c.Assert(formatIOMax(8, 0, 1<<20, 0), Equals, "8:0 rbps=1048576 wbps=max")
+	c.Assert(formatIOMax(253, 1, 0, 2<<20), Equals, "253:1 rbps=max wbps=2097152")
+
+	c.Assert((*CgroupLimits)(nil).Enabled(), Equals, false)
+	c.Assert((&CgroupLimits{}).Enabled(), Equals, false)
+	c.Assert((&CgroupLimits{IOWriteBPS: 1}).Enabled(), Equals, true)
+	c.Assert((&CgroupLimits{MemoryMaxBytes: 1}).Enabled(), Equals, true)
+}
+
+func (s *TestSuite) TestMergeCgroupLimits(c *C) {
+	rebuild := &CgroupLimits{CPUMaxMillicores: 500, IOWriteBPS: 1 << 20}
+	process := &CgroupLimits{CPUMaxMillicores: 250, MemoryMaxBytes: 1 << 30}
+
+	c.Assert(*rebuild.Merge(process), Equals, CgroupLimits{CPUMaxMillicores: 250, MemoryMaxBytes: 1 << 30, IOWriteBPS: 1 << 20})
+	c.Assert(*process.Merge(rebuild), Equals, *rebuild.Merge(process))
+	c.Assert(*rebuild.Merge(nil), Equals, *rebuild)
+	c.Assert(*(*CgroupLimits)(nil).Merge(nil), Equals, CgroupLimits{})
+}
+
+func (s *TestSuite) TestGetProcessTree(c *C) {
+	cmd := exec.Command("sleep", "30")
+	c.Assert(cmd.Start(), IsNil)
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	pids, err := GetProcessTree(os.Getpid())
+	c.Assert(err, IsNil)
+	c.Assert(pids[0], Equals, os.Getpid())
+	found := false
+	for _, pid := range pids {
+		if pid == cmd.Process.Pid {
+			found = true
+		}
+	}
+	c.Assert(found, Equals, true)
+}
+
+func (s *TestSuite) TestLimitProcessTree(c *C) {
+	dir := c.MkDir()
+	defer func(directory string) {
+		cgroupDirectory = directory
+		cgroupDelegation.enabled = false
+		cgroupDelegation.memory = false
+	}(cgroupDirectory)
+	cgroupDirectory = dir
+
+	// The controllers are required
+	c.Assert(os.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte("cpuset memory pids"), 0644), IsNil)
+	c.Assert(LimitProcessTree("rebuild-r-1", os.Getpid(), &CgroupLimits{CPUMaxMillicores: 500}, ""), NotNil)
+
+	c.Assert(os.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte("cpuset cpu io memory pids"), 0644), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "cgroup.procs"), []byte("1\n"), 0644), IsNil)
+	c.Assert(LimitProcessTree("rebuild-r-1", os.Getpid(), &CgroupLimits{CPUMaxMillicores: 500}, ""), IsNil)
+
+	content, err := os.ReadFile(filepath.Join(dir, "cgroup.subtree_control"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "+cpu +io +memory")
+	content, err = os.ReadFile(filepath.Join(dir, cgroupHomeName, "cgroup.procs"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "1")
+	content, err = os.ReadFile(filepath.Join(dir, "rebuild-r-1", "cpu.max"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "50000 100000")
+	// The pids of the tree are written one by one, so the file is left with the last one
+	_, err = os.Stat(filepath.Join(dir, "rebuild-r-1", "cgroup.procs"))
+	c.Assert(err, IsNil)
+
+	content, err = os.ReadFile(filepath.Join(dir, "rebuild-r-1", "memory.max"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "max")
+
+	c.Assert(UnlimitCgroup("rebuild-missing"), IsNil)
+}
+
+func (s *TestSuite) TestLimitProcessTreeWithoutMemoryController(c *C) {
+	dir := c.MkDir()
+	defer func(directory string) {
+		cgroupDirectory = directory
+		cgroupDelegation.enabled = false
+		cgroupDelegation.memory = false
+	}(cgroupDirectory)
+	cgroupDirectory = dir
+
+	c.Assert(os.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte("cpu io pids"), 0644), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "cgroup.procs"), []byte(""), 0644), IsNil)
+	c.Assert(LimitProcessTree("process-1", os.Getpid(), &CgroupLimits{MemoryMaxBytes: 1 << 30}, ""), NotNil)
+	c.Assert(LimitProcessTree("process-1", os.Getpid(), &CgroupLimits{CPUMaxMillicores: 100}, ""), IsNil)
+
+	content, err := os.ReadFile(filepath.Join(dir, "cgroup.subtree_control"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "+cpu +io")
+	_, err = os.Stat(filepath.Join(dir, "process-1", "memory.max"))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	commandTimeoutsLock = &sync.RWMutex{}
+	// commandTimeouts overrides the default timeouts of the commands by the base name of the binary
+	commandTimeouts = map[string]time.Duration{}
+)
+
+// ParseCommandTimeouts parses the per-binary command timeouts in the form of BINARY=DURATION, e.g. nvme=30s.
+func ParseCommandTimeouts(values []string) (map[string]time.Duration, error) {
+	timeouts := map[string]time.Duration{}
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid command timeout %v, expected BINARY=DURATION", value)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration of command timeout %v: %v", value, err)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("invalid command timeout %v, the duration must be positive", value)
+		}
+		timeouts[filepath.Base(strings.TrimSpace(parts[0]))] = timeout
+	}
+	return timeouts, nil
+}
+
+// SetCommandTimeouts overrides the default timeouts of the commands run by the binaries.
+func SetCommandTimeouts(timeouts map[string]time.Duration) {
+	commandTimeoutsLock.Lock()
+	defer commandTimeoutsLock.Unlock()
+
+	commandTimeouts = map[string]time.Duration{}
+	for binary, timeout := range timeouts {
+		commandTimeouts[filepath.Base(binary)] = timeout
+	}
+}
+
+// CommandTimeout returns the timeout of a command run by the binary on behalf of the context. It is the configured
+// timeout of the binary, or the given default one, capped by the deadline of the context.
+func CommandTimeout(ctx context.Context, binary string, defaultTimeout time.Duration) time.Duration {
+	commandTimeoutsLock.RLock()
+	timeout, ok := commandTimeouts[filepath.Base(binary)]
+	commandTimeoutsLock.RUnlock()
+	if !ok {
+		timeout = defaultTimeout
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	return timeout
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestParseCommandTimeouts(c *C) {
+	timeouts, err := ParseCommandTimeouts([]string{"nvme=30s", "/usr/sbin/blockdev = 1m"})
+	c.Assert(err, IsNil)
+	c.Assert(timeouts, DeepEquals, map[string]time.Duration{
+		"nvme":     30 * time.Second,
+		"blockdev": time.Minute,
+	})
+
+	for _, value := range []string{"nvme", "=30s", "nvme=abc", "nvme=0s"} {
+		_, err := ParseCommandTimeouts([]string{value})
+		c.Assert(err, NotNil, Commentf("value %v", value))
+	}
+}
+
+func (s *TestSuite) TestCommandTimeout(c *C) {
+	SetCommandTimeouts(map[string]time.Duration{"nvme": 30 * time.Second})
+	defer SetCommandTimeouts(nil)
+
+	c.Assert(CommandTimeout(context.Background(), "/usr/sbin/nvme", time.Minute), Equals, 30*time.Second)
+	c.Assert(CommandTimeout(context.Background(), "lsblk", time.Minute), Equals, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	c.Assert(CommandTimeout(ctx, "nvme", time.Minute) <= time.Second, Equals, true)
+}
+
+func (s *TestSuite) TestExecuteWithContextCanceled(c *C) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := ExecuteWithContext(ctx, time.Minute, "sleep", "10")
+	c.Assert(err, NotNil)
+	c.Assert(time.Since(start) < 5*time.Second, Equals, true)
+}
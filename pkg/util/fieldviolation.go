@@ -0,0 +1,54 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// FieldViolations collects the invalid fields of a request. They are returned as the BadRequest details of an
+// InvalidArgument error, so that the caller can tell the fields apart rather than parsing the message.
+type FieldViolations []*errdetails.BadRequest_FieldViolation
+
+func (v *FieldViolations) Add(field, format string, args ...interface{}) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Err returns the InvalidArgument error of the violations, or nil if there is none.
+func (v FieldViolations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	messages := []string{}
+	for _, violation := range v {
+		messages = append(messages, fmt.Sprintf("%v: %v", violation.Field, violation.Description))
+	}
+	st := grpcstatus.Newf(grpccodes.InvalidArgument, "invalid fields: %v", strings.Join(messages, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// GetFieldViolations returns the field violations carried by the error, or nil if it carries none.
+func GetFieldViolations(err error) FieldViolations {
+	st, ok := grpcstatus.FromError(err)
+	if !ok {
+		return nil
+	}
+	var violations FieldViolations
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = append(violations, badRequest.FieldViolations...)
+		}
+	}
+	return violations
+}
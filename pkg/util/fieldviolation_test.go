@@ -0,0 +1,29 @@
+package util
+
+import (
+	"fmt"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestFieldViolations(c *C) {
+	violations := FieldViolations{}
+	c.Assert(violations.Err(), IsNil)
+
+	violations.Add("spec.name", "is required")
+	violations.Add("spec.port_count", "%v ports are not available", 10)
+	err := violations.Err()
+	c.Assert(grpcstatus.Code(err), Equals, grpccodes.InvalidArgument)
+
+	got := GetFieldViolations(err)
+	c.Assert(got, HasLen, 2)
+	c.Assert(got[0].Field, Equals, "spec.name")
+	c.Assert(got[1].Field, Equals, "spec.port_count")
+	c.Assert(got[1].Description, Equals, "10 ports are not available")
+
+	c.Assert(GetFieldViolations(fmt.Errorf("not a status")), IsNil)
+	c.Assert(GetFieldViolations(grpcstatus.Error(grpccodes.InvalidArgument, "no details")), IsNil)
+}
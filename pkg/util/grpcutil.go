@@ -15,6 +15,8 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
+
+	"github.com/longhorn/longhorn-instance-manager/pkg/metrics"
 )
 
 func unixDialer(ctx context.Context, addr string) (net.Conn, error) {
@@ -69,10 +71,77 @@ func NewServer(endpoint string, tlsConfig *tls.Config, opts ...grpc.ServerOption
 	if tlsConfig != nil {
 		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
+	opts = append(opts, metrics.ServerOptions()...)
 
 	return grpc.NewServer(opts...), listener, nil
 }
 
+const (
+	DefaultGRPCKeepaliveMinTime = 10 * time.Second
+	DefaultGRPCKeepaliveTime    = 30 * time.Second
+	DefaultGRPCKeepaliveTimeout = 10 * time.Second
+
+	DefaultGRPCMaxConnectionAgeGrace = 30 * time.Second
+)
+
+// GRPCKeepaliveConfig is the keepalive of the gRPC servers. The server pings the idle connections to detect the dead
+// peers, e.g. a crashed longhorn-manager whose half-open connections would be kept otherwise, and may close the
+// connections at a maximum age so that the long-lived ones, e.g. of the watch streams, are recycled predictably.
+type GRPCKeepaliveConfig struct {
+	// MinTime is the minimum interval the clients may ping at. The connection of a client pinging more often is
+	// closed with GOAWAY
+	MinTime time.Duration
+	// Time is the idle time after which the server pings the client, and Timeout is the time the client has to
+	// respond in before the connection is closed
+	Time    time.Duration
+	Timeout time.Duration
+	// MaxConnectionAge is the age after which a connection is closed gracefully with GOAWAY, so that the client
+	// reconnects for the new calls. The calls in flight are cancelled after MaxConnectionAgeGrace, which is forever
+	// if 0. Connections are never closed for their age if MaxConnectionAge is 0
+	MaxConnectionAge      time.Duration
+	MaxConnectionAgeGrace time.Duration
+}
+
+func (c *GRPCKeepaliveConfig) Validate() error {
+	for name, d := range map[string]time.Duration{
+		"keepalive min time":       c.MinTime,
+		"keepalive time":           c.Time,
+		"keepalive timeout":        c.Timeout,
+		"max connection age":       c.MaxConnectionAge,
+		"max connection age grace": c.MaxConnectionAgeGrace,
+	} {
+		if d < 0 {
+			return fmt.Errorf("invalid gRPC %v %v", name, d)
+		}
+	}
+	// gRPC raises the shorter ones to a second silently
+	if c.Time > 0 && c.Time < time.Second {
+		return fmt.Errorf("gRPC keepalive time %v is shorter than 1s", c.Time)
+	}
+	if c.Timeout > 0 && c.Timeout < time.Second {
+		return fmt.Errorf("gRPC keepalive timeout %v is shorter than 1s", c.Timeout)
+	}
+	return nil
+}
+
+func (c *GRPCKeepaliveConfig) ServerOptions() []grpc.ServerOption {
+	params := keepalive.ServerParameters{
+		Time:    c.Time,
+		Timeout: c.Timeout,
+	}
+	if c.MaxConnectionAge > 0 {
+		params.MaxConnectionAge = c.MaxConnectionAge
+		params.MaxConnectionAgeGrace = c.MaxConnectionAgeGrace
+	}
+	return []grpc.ServerOption{
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             c.MinTime,
+			PermitWithoutStream: true,
+		}),
+		grpc.KeepaliveParams(params),
+	}
+}
+
 // ServerTLS prepares the TLS configuration needed for a server with given
 // encoded certficate and private key.
 func ServerTLS(caCert, cert, key []byte, peerName string) (*tls.Config, error) {
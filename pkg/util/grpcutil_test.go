@@ -2,6 +2,7 @@ package util
 
 import (
 	"testing"
+	"time"
 )
 
 func Test_parseEndpoint(t *testing.T) {
@@ -36,3 +37,29 @@ func Test_parseEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCKeepaliveConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  GRPCKeepaliveConfig
+		wantErr bool
+	}{
+		{name: "testDefaults", config: GRPCKeepaliveConfig{MinTime: DefaultGRPCKeepaliveMinTime, Time: DefaultGRPCKeepaliveTime, Timeout: DefaultGRPCKeepaliveTimeout, MaxConnectionAgeGrace: DefaultGRPCMaxConnectionAgeGrace}, wantErr: false},
+		{name: "testGRPCDefaults", config: GRPCKeepaliveConfig{}, wantErr: false},
+		{name: "testMaxConnectionAge", config: GRPCKeepaliveConfig{MaxConnectionAge: time.Hour}, wantErr: false},
+		{name: "testNegativeMaxConnectionAge", config: GRPCKeepaliveConfig{MaxConnectionAge: -time.Hour}, wantErr: true},
+		{name: "testSubsecondTime", config: GRPCKeepaliveConfig{Time: 500 * time.Millisecond}, wantErr: true},
+		{name: "testSubsecondTimeout", config: GRPCKeepaliveConfig{Timeout: 500 * time.Millisecond}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(tt.config.ServerOptions()) != 2 {
+				t.Errorf("ServerOptions() got %v options, want 2", len(tt.config.ServerOptions()))
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// maxOperationHistoryNames bounds the number of names whose history is kept, so that the history of the
+	// long gone instances is dropped eventually.
+	maxOperationHistoryNames = 4096
+)
+
+type OperationRecord struct {
+	Operation string
+	Time      time.Time
+	Details   string
+	// Error is empty if the operation succeeded
+	Error string
+}
+
+// OperationHistory keeps the last operations applied to each name, e.g. an instance, for debugging. A nil
+// OperationHistory records nothing.
+type OperationHistory struct {
+	lock       *sync.RWMutex
+	maxRecords int
+	records    map[string][]*OperationRecord
+}
+
+func NewOperationHistory(maxRecords int) *OperationHistory {
+	return &OperationHistory{
+		lock:       &sync.RWMutex{},
+		maxRecords: maxRecords,
+		records:    map[string][]*OperationRecord{},
+	}
+}
+
+// Record records the operation applied to the name with its outcome.
+func (h *OperationHistory) Record(name, operation, details string, err error) {
+	if h == nil || name == "" {
+		return
+	}
+
+	record := &OperationRecord{
+		Operation: operation,
+		Time:      time.Now().UTC(),
+		Details:   details,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	records, exists := h.records[name]
+	if !exists && len(h.records) >= maxOperationHistoryNames {
+		h.evictOldest()
+	}
+	records = append(records, record)
+	if len(records) > h.maxRecords {
+		records = records[len(records)-h.maxRecords:]
+	}
+	h.records[name] = records
+}
+
+// evictOldest drops the history of the name whose last operation is the oldest.
+func (h *OperationHistory) evictOldest() {
+	oldestName := ""
+	var oldestTime time.Time
+	for name, records := range h.records {
+		lastTime := records[len(records)-1].Time
+		if oldestName == "" || lastTime.Before(oldestTime) {
+			oldestName, oldestTime = name, lastTime
+		}
+	}
+	delete(h.records, oldestName)
+}
+
+// Get returns the operations applied to the name in chronological order.
+func (h *OperationHistory) Get(name string) []*OperationRecord {
+	if h == nil {
+		return nil
+	}
+
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	records := []*OperationRecord{}
+	for _, record := range h.records[name] {
+		r := *record
+		records = append(records, &r)
+	}
+	return records
+}
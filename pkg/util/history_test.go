@@ -0,0 +1,43 @@
+package util
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestOperationHistory(c *C) {
+	h := NewOperationHistory(3)
+
+	h.Record("test-volume-r-0", "create", "", nil)
+	h.Record("test-volume-r-0", "replace", "", fmt.Errorf("failed to replace"))
+	h.Record("test-volume-e-0", "expand", "size=2147483648", nil)
+
+	records := h.Get("test-volume-r-0")
+	c.Assert(records, HasLen, 2)
+	c.Assert(records[0].Operation, Equals, "create")
+	c.Assert(records[0].Error, Equals, "")
+	c.Assert(records[1].Operation, Equals, "replace")
+	c.Assert(records[1].Error, Equals, "failed to replace")
+	c.Assert(records[1].Time.Before(records[0].Time), Equals, false)
+
+	records = h.Get("test-volume-e-0")
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].Details, Equals, "size=2147483648")
+
+	// only the last records are kept
+	for i := 0; i < 5; i++ {
+		h.Record("test-volume-r-0", fmt.Sprintf("op-%d", i), "", nil)
+	}
+	records = h.Get("test-volume-r-0")
+	c.Assert(records, HasLen, 3)
+	c.Assert(records[0].Operation, Equals, "op-2")
+	c.Assert(records[2].Operation, Equals, "op-4")
+
+	c.Assert(h.Get("nonexistent"), HasLen, 0)
+
+	// a nil history records nothing
+	var nilHistory *OperationHistory
+	nilHistory.Record("test-volume-r-0", "create", "", nil)
+	c.Assert(nilHistory.Get("test-volume-r-0"), HasLen, 0)
+}
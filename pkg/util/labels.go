@@ -0,0 +1,139 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	maxLabelKeyLength   = 63
+	maxLabelValueLength = 253
+)
+
+type labelRequirementOperator string
+
+const (
+	labelOperatorEquals       = labelRequirementOperator("=")
+	labelOperatorNotEquals    = labelRequirementOperator("!=")
+	labelOperatorExists       = labelRequirementOperator("exists")
+	labelOperatorDoesNotExist = labelRequirementOperator("!")
+)
+
+type labelRequirement struct {
+	key      string
+	operator labelRequirementOperator
+	value    string
+}
+
+// LabelSelector selects the labels satisfying all its requirements. An empty selector selects everything.
+type LabelSelector []labelRequirement
+
+// ParseLabelSelector parses the comma-separated requirements of a selector in the Kubernetes equality-based
+// syntax, i.e. key=value, key==value, key!=value, key for the existence of the key and !key for the absence of it,
+// e.g. "longhornvolume=vol-1,tier!=cold".
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	requirements := LabelSelector{}
+	if strings.TrimSpace(selector) == "" {
+		return requirements, nil
+	}
+
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		requirement := labelRequirement{}
+		switch {
+		case strings.Contains(term, "!="):
+			parts := strings.SplitN(term, "!=", 2)
+			requirement = labelRequirement{key: parts[0], operator: labelOperatorNotEquals, value: parts[1]}
+		case strings.Contains(term, "=="):
+			parts := strings.SplitN(term, "==", 2)
+			requirement = labelRequirement{key: parts[0], operator: labelOperatorEquals, value: parts[1]}
+		case strings.Contains(term, "="):
+			parts := strings.SplitN(term, "=", 2)
+			requirement = labelRequirement{key: parts[0], operator: labelOperatorEquals, value: parts[1]}
+		case strings.HasPrefix(term, "!"):
+			requirement = labelRequirement{key: strings.TrimPrefix(term, "!"), operator: labelOperatorDoesNotExist}
+		default:
+			requirement = labelRequirement{key: term, operator: labelOperatorExists}
+		}
+
+		requirement.key = strings.TrimSpace(requirement.key)
+		requirement.value = strings.TrimSpace(requirement.value)
+		if err := validateLabelKey(requirement.key); err != nil {
+			return nil, fmt.Errorf("invalid requirement %q of label selector: %v", term, err)
+		}
+		if err := validateLabelValue(requirement.value); err != nil {
+			return nil, fmt.Errorf("invalid requirement %q of label selector: %v", term, err)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// Matches returns true if the labels satisfy all the requirements of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		value, exists := labels[r.key]
+		switch r.operator {
+		case labelOperatorEquals:
+			if !exists || value != r.value {
+				return false
+			}
+		case labelOperatorNotEquals:
+			if exists && value == r.value {
+				return false
+			}
+		case labelOperatorExists:
+			if !exists {
+				return false
+			}
+		case labelOperatorDoesNotExist:
+			if exists {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ValidateLabels validates the labels of an instance, whose keys and values must be selectable by a selector.
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return fmt.Errorf("invalid label %v: %v", key, err)
+		}
+		if err := validateLabelValue(value); err != nil {
+			return fmt.Errorf("invalid value of label %v: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("empty key")
+	}
+	if len(key) > maxLabelKeyLength {
+		return fmt.Errorf("key is longer than %v characters", maxLabelKeyLength)
+	}
+	return validateLabelCharacters(key)
+}
+
+func validateLabelValue(value string) error {
+	if len(value) > maxLabelValueLength {
+		return fmt.Errorf("value is longer than %v characters", maxLabelValueLength)
+	}
+	return validateLabelCharacters(value)
+}
+
+// validateLabelCharacters allows the alphanumeric characters, '-', '_', '.' and '/', so that a label never
+// contains the separators of the selector syntax.
+func validateLabelCharacters(s string) error {
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.', r == '/':
+		default:
+			return fmt.Errorf("invalid character %q", r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestLabelSelector(c *C) {
+	labels := map[string]string{
+		"longhornvolume": "vol-1",
+		"tier":           "hot",
+	}
+
+	for selector, expected := range map[string]bool{
+		"":                                  true,
+		"longhornvolume=vol-1":              true,
+		"longhornvolume==vol-1":             true,
+		"longhornvolume=vol-2":              false,
+		"tier!=cold":                        true,
+		"tier!=hot":                         false,
+		"tenant!=a":                         true,
+		"tier":                              true,
+		"tenant":                            false,
+		"!tenant":                           true,
+		"!tier":                             false,
+		"longhornvolume=vol-1, tier=hot":    true,
+		"longhornvolume=vol-1,tier=cold":    false,
+		"longhornvolume=vol-1,!tenant,tier": true,
+	} {
+		parsed, err := ParseLabelSelector(selector)
+		c.Assert(err, IsNil, Commentf("selector %v", selector))
+		c.Assert(parsed.Matches(labels), Equals, expected, Commentf("selector %v", selector))
+	}
+
+	for _, selector := range []string{"=vol-1", "a=b,", "a=b c", "!", "a=(b)"} {
+		_, err := ParseLabelSelector(selector)
+		c.Assert(err, NotNil, Commentf("selector %v", selector))
+	}
+}
+
+func (s *TestSuite) TestValidateLabels(c *C) {
+	c.Assert(ValidateLabels(nil), IsNil)
+	c.Assert(ValidateLabels(map[string]string{"longhornvolume": "vol-1", "longhorn.io/tier": ""}), IsNil)
+	c.Assert(ValidateLabels(map[string]string{"": "a"}), NotNil)
+	c.Assert(ValidateLabels(map[string]string{"a": "b,c"}), NotNil)
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// LatencySummary is the distribution of the latencies of an operation.
+type LatencySummary struct {
+	Count  int           `json:"count"`
+	Errors int           `json:"errors"`
+	Min    time.Duration `json:"min"`
+	Mean   time.Duration `json:"mean"`
+	P50    time.Duration `json:"p50"`
+	P90    time.Duration `json:"p90"`
+	P99    time.Duration `json:"p99"`
+	Max    time.Duration `json:"max"`
+}
+
+// SummarizeLatencies returns the distribution of the latencies of the succeeded operations. The percentiles are
+// the nearest-rank ones.
+func SummarizeLatencies(latencies []time.Duration, errors int) LatencySummary {
+	summary := LatencySummary{
+		Count:  len(latencies),
+		Errors: errors,
+	}
+	if len(latencies) == 0 {
+		return summary
+	}
+
+	sorted := append([]time.Duration{}, latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, latency := range sorted {
+		total += latency
+	}
+	summary.Min = sorted[0]
+	summary.Max = sorted[len(sorted)-1]
+	summary.Mean = total / time.Duration(len(sorted))
+	summary.P50 = getPercentile(sorted, 50)
+	summary.P90 = getPercentile(sorted, 90)
+	summary.P99 = getPercentile(sorted, 99)
+	return summary
+}
+
+func getPercentile(sorted []time.Duration, percentile float64) time.Duration {
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,30 @@
+package util
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestSummarizeLatencies(c *C) {
+	summary := SummarizeLatencies(nil, 2)
+	c.Assert(summary, Equals, LatencySummary{Errors: 2})
+
+	latencies := []time.Duration{}
+	// Shuffled 1ms to 100ms
+	for i := 0; i < 100; i++ {
+		latencies = append(latencies, time.Duration((i*37)%100+1)*time.Millisecond)
+	}
+	summary = SummarizeLatencies(latencies, 0)
+	c.Assert(summary.Count, Equals, 100)
+	c.Assert(summary.Min, Equals, time.Millisecond)
+	c.Assert(summary.Max, Equals, 100*time.Millisecond)
+	c.Assert(summary.Mean, Equals, 50500*time.Microsecond)
+	c.Assert(summary.P50, Equals, 50*time.Millisecond)
+	c.Assert(summary.P90, Equals, 90*time.Millisecond)
+	c.Assert(summary.P99, Equals, 99*time.Millisecond)
+
+	summary = SummarizeLatencies([]time.Duration{time.Second}, 0)
+	c.Assert(summary.P50, Equals, time.Second)
+	c.Assert(summary.P99, Equals, time.Second)
+}
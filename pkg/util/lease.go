@@ -0,0 +1,264 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	leaseFileSuffix = ".lease"
+)
+
+// LeaseStateSchema is the schema of the lease files. Version 1 only stamps the schema version into the lease
+// file of version 0, so the instance managers predating the versioning can still read it.
+var LeaseStateSchema = &StateSchema{
+	Kind:           "lease",
+	CurrentVersion: 1,
+	Upgrades: []StateMigration{
+		func(state map[string]interface{}) error { return nil },
+	},
+	Downgrades: []StateMigration{
+		func(state map[string]interface{}) error { return nil },
+	},
+}
+
+// LeaseHolder describes the instance manager holding a lease. It is stored in the lease file so that a second
+// claimer can report who owns the resource.
+type LeaseHolder struct {
+	Resource   string `json:"resource"`
+	Hostname   string `json:"hostname"`
+	PID        int    `json:"pid"`
+	Owner      string `json:"owner"`
+	AcquiredAt string `json:"acquiredAt"`
+}
+
+// LeaseManager claims resources like disks and replica data directories via flock(2) on lease files under dir.
+// The kernel releases the locks once the holding process exits, so a crashed instance manager never leaves a
+// stale claim behind, while a second instance manager sharing the same host directory fails to claim a
+// resource that is still managed.
+// A nil LeaseManager never claims anything.
+type LeaseManager struct {
+	dir string
+
+	lock   *sync.Mutex
+	leases map[string]*lease
+}
+
+type lease struct {
+	file   *os.File
+	holder LeaseHolder
+}
+
+func NewLeaseManager(dir string) (*LeaseManager, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create lease directory %v", dir)
+	}
+	return &LeaseManager{
+		dir: dir,
+
+		lock:   &sync.Mutex{},
+		leases: map[string]*lease{},
+	}, nil
+}
+
+func (lm *LeaseManager) leaseFilePath(resource string) string {
+	sum := sha256.Sum256([]byte(resource))
+	return filepath.Join(lm.dir, hex.EncodeToString(sum[:])+leaseFileSuffix)
+}
+
+// Acquire claims the resource on behalf of owner. Acquiring a resource already held by the same owner is a no-op.
+func (lm *LeaseManager) Acquire(resource, owner string) error {
+	if lm == nil {
+		return nil
+	}
+
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+
+	if l, exists := lm.leases[resource]; exists {
+		if l.holder.Owner == owner {
+			return nil
+		}
+		return fmt.Errorf("resource %v is already claimed by %v of this instance manager", resource, l.holder.Owner)
+	}
+
+	path := lm.leaseFilePath(resource)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open lease file %v for resource %v", path, resource)
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		defer file.Close()
+		if err != syscall.EWOULDBLOCK {
+			return errors.Wrapf(err, "failed to lock lease file %v for resource %v", path, resource)
+		}
+		holder := &LeaseHolder{}
+		if content, readErr := os.ReadFile(path); readErr == nil && LeaseStateSchema.Unmarshal(content, holder) == nil {
+			return fmt.Errorf("resource %v is already claimed by %v (pid %v) on host %v since %v",
+				resource, holder.Owner, holder.PID, holder.Hostname, holder.AcquiredAt)
+		}
+		return fmt.Errorf("resource %v is already claimed by another instance manager", resource)
+	}
+
+	hostname, _ := os.Hostname()
+	holder := LeaseHolder{
+		Resource:   resource,
+		Hostname:   hostname,
+		PID:        os.Getpid(),
+		Owner:      owner,
+		AcquiredAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := writeLeaseHolder(file, holder); err != nil {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		file.Close()
+		return errors.Wrapf(err, "failed to record holder in lease file %v for resource %v", path, resource)
+	}
+
+	lm.leases[resource] = &lease{
+		file:   file,
+		holder: holder,
+	}
+	return nil
+}
+
+// Release gives up the claim of owner on the resource. Releasing a resource held by another owner is a no-op.
+func (lm *LeaseManager) Release(resource, owner string) error {
+	if lm == nil {
+		return nil
+	}
+
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+
+	l, exists := lm.leases[resource]
+	if !exists || l.holder.Owner != owner {
+		return nil
+	}
+	delete(lm.leases, resource)
+
+	// Truncate the holder info before unlocking, the file itself is kept to avoid racing with a new claimer
+	// that already opened it.
+	if err := l.file.Truncate(0); err != nil {
+		l.file.Close()
+		return errors.Wrapf(err, "failed to clear lease file for resource %v", resource)
+	}
+	if err := syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN); err != nil {
+		l.file.Close()
+		return errors.Wrapf(err, "failed to unlock lease file for resource %v", resource)
+	}
+	return l.file.Close()
+}
+
+func writeLeaseHolder(file *os.File, holder LeaseHolder) error {
+	content, err := LeaseStateSchema.Marshal(holder)
+	if err != nil {
+		return err
+	}
+	if err := file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := file.WriteAt(content, 0); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// LeaseFileStatus is the result of verifying a lease file.
+type LeaseFileStatus struct {
+	Path    string
+	Version int
+	// Empty is true if the lease file is released
+	Empty bool
+	// Held is true if the lease is held by a running instance manager, whose lease file is never migrated
+	Held     bool
+	Migrated bool
+	Error    string
+}
+
+// VerifyLeaseFiles verifies the lease files under dir can be migrated to the target version, and migrates the
+// ones not held by any instance manager if migrate is true, e.g. before rolling back to an older instance manager.
+func VerifyLeaseFiles(dir string, targetVersion int, migrate bool) ([]*LeaseFileStatus, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+leaseFileSuffix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list lease files in %v", dir)
+	}
+
+	statuses := []*LeaseFileStatus{}
+	for _, path := range paths {
+		statuses = append(statuses, verifyLeaseFile(path, targetVersion, migrate))
+	}
+	return statuses, nil
+}
+
+func verifyLeaseFile(path string, targetVersion int, migrate bool) *LeaseFileStatus {
+	status := &LeaseFileStatus{
+		Path: path,
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	defer file.Close()
+
+	// Lock the file so that no instance manager claims the lease during the migration
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		if err != syscall.EWOULDBLOCK {
+			status.Error = err.Error()
+			return status
+		}
+		status.Held = true
+	} else {
+		defer syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	if len(content) == 0 {
+		status.Empty = true
+		return status
+	}
+
+	migrated, version, err := LeaseStateSchema.Migrate(content, targetVersion)
+	status.Version = version
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	if err := json.Unmarshal(migrated, &LeaseHolder{}); err != nil {
+		status.Error = errors.Wrap(err, "failed to decode migrated lease").Error()
+		return status
+	}
+	if !migrate || status.Held || version == targetVersion {
+		return status
+	}
+
+	if err := file.Truncate(0); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	if _, err := file.WriteAt(migrated, 0); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	if err := file.Sync(); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Migrated = true
+	return status
+}
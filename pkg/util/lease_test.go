@@ -0,0 +1,46 @@
+package util
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestLeaseManager(c *C) {
+	dir := c.MkDir()
+
+	lm, err := NewLeaseManager(dir)
+	c.Assert(err, IsNil)
+	anotherLM, err := NewLeaseManager(dir)
+	c.Assert(err, IsNil)
+
+	resource := "replica-data-directory:/var/lib/longhorn/replicas/test-volume-abcd"
+
+	err = lm.Acquire(resource, "test-volume-r-0")
+	c.Assert(err, IsNil)
+	// acquiring again by the same owner is a no-op
+	err = lm.Acquire(resource, "test-volume-r-0")
+	c.Assert(err, IsNil)
+	err = lm.Acquire(resource, "test-volume-r-1")
+	c.Assert(err, ErrorMatches, ".*already claimed by test-volume-r-0.*")
+
+	// the lease file lock conflicts with another lease manager, as if it belongs to another instance manager
+	err = anotherLM.Acquire(resource, "test-volume-r-0")
+	c.Assert(err, ErrorMatches, ".*already claimed by test-volume-r-0 \\(pid [0-9]+\\).*")
+
+	// releasing by another owner doesn't affect the lease
+	err = lm.Release(resource, "test-volume-r-1")
+	c.Assert(err, IsNil)
+	err = anotherLM.Acquire(resource, "test-volume-r-0")
+	c.Assert(err, NotNil)
+
+	err = lm.Release(resource, "test-volume-r-0")
+	c.Assert(err, IsNil)
+	err = anotherLM.Acquire(resource, "test-volume-r-0")
+	c.Assert(err, IsNil)
+	err = anotherLM.Release(resource, "test-volume-r-0")
+	c.Assert(err, IsNil)
+
+	// a nil lease manager never claims anything
+	var nilLM *LeaseManager
+	c.Assert(nilLM.Acquire(resource, "test-volume-r-0"), IsNil)
+	c.Assert(nilLM.Release(resource, "test-volume-r-0"), IsNil)
+}
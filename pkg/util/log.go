@@ -3,18 +3,29 @@ package util
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
 const (
 	LogComponentField = "component"
+
+	// maxLogLineSize is the size a partial line is stored at without waiting for its newline
+	maxLogLineSize = 64 << 10
+	// logTailSize is the size of the file tail read for resuming the sequence
+	logTailSize = 2 * maxLogLineSize
 )
 
 type LonghornFormatter struct {
@@ -25,10 +36,37 @@ type LonghornFormatter struct {
 	logFiles []*os.File
 }
 
+// LonghornWriter stores the output of a process in the log file, stamping each line with a sequence number and
+// the wall-clock time, e.g. "42 2024-01-02T03:04:05.123456789Z <line>". The sequence keeps increasing across the
+// writers and the rotated files of the same log file, so the lines can be exactly ordered and fetched since a
+// sequence.
 type LonghornWriter struct {
 	file *os.File
 	name string
 	path string
+	size int64
+
+	lock     *sync.Mutex
+	sequence uint64
+	partial  []byte
+	flood    *logFloodDetector
+	rotation *LogRotationConfig
+	// handedOff is set once the log file is handed off to the writer of the next instance manager
+	handedOff bool
+}
+
+// LogHandoff is the state of a writer handed off to the writer of the same log file in the next instance manager, so
+// that the sequence continues and the partial line is completed rather than split.
+type LogHandoff struct {
+	Sequence uint64 `json:"sequence"`
+	Partial  []byte `json:"partial,omitempty"`
+}
+
+// LogLine is a line of the log file. Sequence is 0 for the lines stored before the stamping was introduced.
+type LogLine struct {
+	Sequence uint64
+	Time     time.Time
+	Line     string
 }
 
 func NewLonghornWriter(name string, logsDir string) (*LonghornWriter, error) {
@@ -41,13 +79,88 @@ func NewLonghornWriter(name string, logsDir string) (*LonghornWriter, error) {
 	if err != nil {
 		return nil, err
 	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	sequence, err := getLastLogSequence(logPath)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if sequence == 0 {
+		// The log file is just rotated
+		if sequence, err = getLastRotatedLogSequence(logPath); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
 	return &LonghornWriter{
 		file: file,
 		name: name,
 		path: logPath,
+		size: info.Size(),
+
+		lock:     &sync.Mutex{},
+		sequence: sequence,
 	}, nil
 }
 
+// getLastLogSequence returns the sequence of the last stamped line in the tail of the log file.
+func getLastLogSequence(logPath string) (uint64, error) {
+	file, err := os.Open(logPath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	offset := info.Size() - logTailSize
+	if offset < 0 {
+		offset = 0
+	}
+	tail := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(tail, offset); err != nil && err != io.EOF {
+		return 0, err
+	}
+
+	lines := strings.Split(string(tail), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := ParseLogLine(lines[i]); line.Sequence > 0 {
+			return line.Sequence, nil
+		}
+	}
+	return 0, nil
+}
+
+func formatLogLine(sequence uint64, t time.Time, line []byte) []byte {
+	return []byte(fmt.Sprintf("%d %s %s\n", sequence, t.UTC().Format(time.RFC3339Nano), line))
+}
+
+// ParseLogLine parses a line of the log file. The unstamped lines are returned as is with sequence 0.
+func ParseLogLine(text string) *LogLine {
+	parts := strings.SplitN(text, " ", 3)
+	if len(parts) == 3 {
+		sequence, err := strconv.ParseUint(parts[0], 10, 64)
+		if err == nil && sequence > 0 {
+			if t, err := time.Parse(time.RFC3339Nano, parts[1]); err == nil {
+				return &LogLine{
+					Sequence: sequence,
+					Time:     t,
+					Line:     parts[2],
+				}
+			}
+		}
+	}
+	return &LogLine{
+		Line: text,
+	}
+}
+
 func SetUpLogger(logsDir string) error {
 	if err := os.MkdirAll(logsDir, 0755); err != nil {
 		return err
@@ -101,45 +214,253 @@ func (l LonghornFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 	return logMsg.Bytes(), nil
 }
 
-func (l LonghornWriter) Close() error {
+// SetFloodProtection enables the detection, and optionally the throttling, of the log floods.
+func (l *LonghornWriter) SetFloodProtection(config *LogFloodConfig) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.flood = newLogFloodDetector(config)
+}
+
+// SetRotation enables the rotation of the log file, whose rotated files are compressed in the background.
+func (l *LonghornWriter) SetRotation(config *LogRotationConfig) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if config == nil || config.MaxSize <= 0 {
+		l.rotation = nil
+		return
+	}
+	l.rotation = config
+}
+
+// IsFlooding returns true if the lines are logged above the rate of the flood protection for the sustained period.
+func (l *LonghornWriter) IsFlooding() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.flood == nil {
+		return false
+	}
+	now := time.Now()
+	stamped := &bytes.Buffer{}
+	l.rollFloodWindow(now, stamped)
+	if stamped.Len() != 0 {
+		n, err := l.file.Write(stamped.Bytes())
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to store the dropped lines of log %v", l.path)
+		}
+		l.size += int64(n)
+	}
+	return l.flood.flooding
+}
+
+// rollFloodWindow closes the flood window if it is over, and stamps a line of the number of the lines dropped in
+// it. The caller must hold the lock.
+func (l *LonghornWriter) rollFloodWindow(now time.Time, stamped *bytes.Buffer) {
+	if l.flood == nil {
+		return
+	}
+	if dropped := l.flood.roll(now); dropped > 0 {
+		l.sequence++
+		stamped.Write(formatLogLine(l.sequence, now, formatLogFloodDroppedLine(dropped)))
+	}
+}
+
+// storeLine stamps the line unless it is dropped by the flood protection. The caller must hold the lock.
+func (l *LonghornWriter) storeLine(stamped *bytes.Buffer, now time.Time, line []byte) {
+	if l.flood != nil && !l.flood.admit() {
+		return
+	}
+	l.sequence++
+	stamped.Write(formatLogLine(l.sequence, now, line))
+}
+
+func (l *LonghornWriter) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.handedOff {
+		return nil
+	}
+	if len(l.partial) != 0 {
+		l.sequence++
+		if _, err := l.file.Write(formatLogLine(l.sequence, time.Now(), l.partial)); err != nil {
+			logrus.WithError(err).Warnf("Failed to store the last partial line of log %v", l.path)
+		}
+		l.partial = nil
+	}
 	if err := l.file.Close(); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (l LonghornWriter) StreamLog(done chan struct{}) (chan string, error) {
+// HandOff closes the writer without storing the partial line, and returns the state for the writer of the next
+// instance manager to take over the log file with.
+func (l *LonghornWriter) HandOff() (*LogHandoff, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.handedOff {
+		return nil, fmt.Errorf("log %v is handed off already", l.path)
+	}
+	handoff := &LogHandoff{
+		Sequence: l.sequence,
+		Partial:  l.partial,
+	}
+	l.partial = nil
+	l.handedOff = true
+	if err := l.file.Close(); err != nil {
+		return nil, err
+	}
+	return handoff, nil
+}
+
+// TakeOver continues the log file from the state handed off by the writer of the previous instance manager. It must
+// be called before anything is written.
+func (l *LonghornWriter) TakeOver(handoff *LogHandoff) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if handoff.Sequence > l.sequence {
+		l.sequence = handoff.Sequence
+	}
+	l.partial = append(append([]byte{}, handoff.Partial...), l.partial...)
+}
+
+// rotate moves the log file aside as a rotated file named after the last sequence, and continues with a new log
+// file. The caller must hold the lock.
+func (l *LonghornWriter) rotate() error {
+	rotatedPath := getRotatedLogPath(l.path, l.sequence)
+	if err := os.Rename(l.path, rotatedPath); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		if err := os.Rename(rotatedPath, l.path); err != nil {
+			logrus.WithError(err).Warnf("Failed to restore rotated log file %v", rotatedPath)
+		}
+		return err
+	}
+	if err := l.file.Close(); err != nil {
+		logrus.WithError(err).Warnf("Failed to close rotated log file %v", rotatedPath)
+	}
+	l.file = file
+	l.size = 0
+
+	go finishRotation(l.path, rotatedPath, *l.rotation)
+	return nil
+}
+
+// StreamLog streams the lines of the log file with a sequence larger than sinceSequence, or all lines if
+// sinceSequence is 0, until the end of the file is reached or the context is done. The lines of the rotated files
+// are streamed first, decompressed.
+func (l *LonghornWriter) StreamLog(ctx context.Context, sinceSequence uint64) (<-chan *LogLine, error) {
+	// Hold the lock so that no rotation happens in between the listing of the rotated files and the opening of the
+	// log file
+	l.lock.Lock()
+	rotatedFiles, err := listRotatedLogFiles(l.path)
+	if err != nil {
+		l.lock.Unlock()
+		return nil, err
+	}
 	file, err := os.OpenFile(l.path, os.O_RDONLY, 0644)
+	l.lock.Unlock()
 	if err != nil {
 		return nil, err
 	}
-	logChan := make(chan string)
-	scanner := bufio.NewScanner(file)
+
+	// Buffered so that the lines read ahead can be sent in batches
+	logChan := make(chan *LogLine, MaxLogFrameLines)
 	go func() {
-		for scanner.Scan() {
-			select {
-			case <-done:
-				close(logChan)
+		defer file.Close()
+		defer close(logChan)
+
+		for _, f := range rotatedFiles {
+			if sinceSequence > 0 && f.lastSequence <= sinceSequence {
+				continue
+			}
+			reader, closer, err := f.open()
+			if err != nil {
+				// The file may be removed as one of the oldest ones
+				logrus.WithError(err).Warnf("Failed to open rotated log file %v", f.path)
+				continue
+			}
+			done := l.scanLog(ctx, reader, sinceSequence, logChan)
+			closer.Close()
+			if done {
 				return
-			default:
-				logChan <- scanner.Text()
 			}
 		}
-		close(logChan)
-		file.Close()
+		l.scanLog(ctx, file, sinceSequence, logChan)
 	}()
 	return logChan, nil
 }
 
-func (l LonghornWriter) Write(input []byte) (int, error) {
-	msg := string(input)
-	logrus.WithField(LogComponentField, l.name).Println(msg)
-	outLen, err := l.file.Write(input)
+// scanLog sends the lines with a sequence larger than sinceSequence, and returns true if the context is done.
+func (l *LonghornWriter) scanLog(ctx context.Context, reader io.Reader, sinceSequence uint64, logChan chan<- *LogLine) bool {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), 2*logTailSize)
+	for scanner.Scan() {
+		line := ParseLogLine(scanner.Text())
+		if sinceSequence > 0 && line.Sequence <= sinceSequence {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return true
+		case logChan <- line:
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		logrus.WithError(err).Warnf("Failed to stream log %v", l.path)
+	}
+	return false
+}
+
+func (l *LonghornWriter) Write(input []byte) (int, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	stamped := &bytes.Buffer{}
+	l.rollFloodWindow(now, stamped)
+	// The sample of a throttled flood is stored in the log file only
+	if l.flood == nil || !l.flood.flooding || !l.flood.config.Throttle {
+		logrus.WithField(LogComponentField, l.name).Println(string(input))
+	}
+
+	data := append(l.partial, input...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		l.storeLine(stamped, now, data[:i])
+		data = data[i+1:]
+	}
+	if len(data) >= maxLogLineSize {
+		l.storeLine(stamped, now, data)
+		data = nil
+	}
+	l.partial = append([]byte{}, data...)
+
+	if stamped.Len() == 0 {
+		return len(input), nil
+	}
+	n, err := l.file.Write(stamped.Bytes())
+	l.size += int64(n)
 	if err != nil {
 		return 0, err
 	}
 	if err := l.file.Sync(); err != nil {
 		return 0, err
 	}
-	return outLen, nil
+	if l.rotation != nil && l.size >= l.rotation.MaxSize {
+		if err := l.rotate(); err != nil {
+			logrus.WithError(err).Warnf("Failed to rotate log %v", l.path)
+		}
+	}
+	return len(input), nil
 }
@@ -0,0 +1,163 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func collectLogLines(c *C, w *LonghornWriter, sinceSequence uint64) []*LogLine {
+	logChan, err := w.StreamLog(context.Background(), sinceSequence)
+	c.Assert(err, IsNil)
+
+	lines := []*LogLine{}
+	for line := range logChan {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (s *TestSuite) TestLonghornWriter(c *C) {
+	dir := c.MkDir()
+
+	// a line stored before the stamping was introduced
+	err := os.WriteFile(filepath.Join(dir, "test-process.log"), []byte("legacy line\n"), 0644)
+	c.Assert(err, IsNil)
+
+	w, err := NewLonghornWriter("test-process", dir)
+	c.Assert(err, IsNil)
+
+	_, err = w.Write([]byte("first line\nsecond "))
+	c.Assert(err, IsNil)
+	_, err = w.Write([]byte("line\nthird line\n"))
+	c.Assert(err, IsNil)
+
+	lines := collectLogLines(c, w, 0)
+	c.Assert(lines, HasLen, 4)
+	c.Assert(lines[0].Sequence, Equals, uint64(0))
+	c.Assert(lines[0].Line, Equals, "legacy line")
+	for i, expected := range []string{"first line", "second line", "third line"} {
+		c.Assert(lines[i+1].Sequence, Equals, uint64(i+1))
+		c.Assert(lines[i+1].Line, Equals, expected)
+		c.Assert(lines[i+1].Time.IsZero(), Equals, false)
+	}
+
+	lines = collectLogLines(c, w, 2)
+	c.Assert(lines, HasLen, 1)
+	c.Assert(lines[0].Line, Equals, "third line")
+
+	// the partial line is stored on close
+	_, err = w.Write([]byte("partial line"))
+	c.Assert(err, IsNil)
+	err = w.Close()
+	c.Assert(err, IsNil)
+
+	// the sequence is resumed from the log file
+	w, err = NewLonghornWriter("test-process", dir)
+	c.Assert(err, IsNil)
+	defer w.Close()
+	_, err = w.Write([]byte("fifth line\n"))
+	c.Assert(err, IsNil)
+
+	lines = collectLogLines(c, w, 3)
+	c.Assert(lines, HasLen, 2)
+	c.Assert(lines[0].Sequence, Equals, uint64(4))
+	c.Assert(lines[0].Line, Equals, "partial line")
+	c.Assert(lines[1].Sequence, Equals, uint64(5))
+	c.Assert(lines[1].Line, Equals, "fifth line")
+}
+
+func (s *TestSuite) TestLonghornWriterHandOff(c *C) {
+	dir := c.MkDir()
+
+	w, err := NewLonghornWriter("test-process", dir)
+	c.Assert(err, IsNil)
+	_, err = w.Write([]byte("first line\nsecond "))
+	c.Assert(err, IsNil)
+
+	handoff, err := w.HandOff()
+	c.Assert(err, IsNil)
+	c.Assert(handoff.Sequence, Equals, uint64(1))
+	c.Assert(string(handoff.Partial), Equals, "second ")
+	_, err = w.HandOff()
+	c.Assert(err, NotNil)
+	// the partial line is not stored on close once handed off
+	c.Assert(w.Close(), IsNil)
+
+	// the writer of the next instance manager completes the partial line
+	w, err = NewLonghornWriter("test-process", dir)
+	c.Assert(err, IsNil)
+	defer w.Close()
+	w.TakeOver(handoff)
+	_, err = w.Write([]byte("line\nthird line\n"))
+	c.Assert(err, IsNil)
+
+	lines := collectLogLines(c, w, 0)
+	c.Assert(lines, HasLen, 3)
+	for i, expected := range []string{"first line", "second line", "third line"} {
+		c.Assert(lines[i].Sequence, Equals, uint64(i+1))
+		c.Assert(lines[i].Line, Equals, expected)
+	}
+}
+
+func (s *TestSuite) TestLonghornWriterRotation(c *C) {
+	for _, compression := range []string{LogCompressionGzip, LogCompressionLZ4, LogCompressionNone} {
+		dir := c.MkDir()
+		w, err := NewLonghornWriter("test-process", dir)
+		c.Assert(err, IsNil)
+		// rotated after every second line
+		w.SetRotation(&LogRotationConfig{MaxSize: 60, MaxFiles: 2, Compression: compression})
+
+		for i := 1; i <= 8; i++ {
+			_, err = w.Write([]byte(fmt.Sprintf("line %d\n", i)))
+			c.Assert(err, IsNil)
+		}
+
+		// the oldest rotated files are removed once the rotated ones are compressed
+		logPath := filepath.Join(dir, "test-process.log")
+		var files []*rotatedLogFile
+		for i := 0; i < 100; i++ {
+			files, err = listRotatedLogFiles(logPath)
+			c.Assert(err, IsNil)
+			if len(files) == 2 && (compression == LogCompressionNone || (files[0].codec != nil && files[1].codec != nil)) {
+				break
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		c.Assert(files, HasLen, 2, Commentf("compression %v", compression))
+		c.Assert(files[0].lastSequence, Equals, uint64(6))
+		c.Assert(files[1].lastSequence, Equals, uint64(8))
+
+		// the rotated files are decompressed transparently
+		lines := collectLogLines(c, w, 0)
+		c.Assert(lines, HasLen, 4, Commentf("compression %v", compression))
+		for i, line := range lines {
+			c.Assert(line.Sequence, Equals, uint64(i+5))
+			c.Assert(line.Line, Equals, fmt.Sprintf("line %d", i+5))
+		}
+		lines = collectLogLines(c, w, 7)
+		c.Assert(lines, HasLen, 1)
+		c.Assert(lines[0].Line, Equals, "line 8")
+
+		// the sequence is resumed from the rotated files
+		err = w.Close()
+		c.Assert(err, IsNil)
+		w, err = NewLonghornWriter("test-process", dir)
+		c.Assert(err, IsNil)
+		_, err = w.Write([]byte("line 9\n"))
+		c.Assert(err, IsNil)
+		lines = collectLogLines(c, w, 8)
+		c.Assert(lines, HasLen, 1)
+		c.Assert(lines[0].Sequence, Equals, uint64(9))
+		c.Assert(w.Close(), IsNil)
+
+		c.Assert(RemoveLogFiles(dir, "test-process"), IsNil)
+		remaining, err := filepath.Glob(filepath.Join(dir, "*"))
+		c.Assert(err, IsNil)
+		c.Assert(remaining, HasLen, 0)
+	}
+}
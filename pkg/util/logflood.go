@@ -0,0 +1,87 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultLogFloodMaxLinesPerSecond    = 1000
+	DefaultLogFloodSustainedPeriod      = 30 * time.Second
+	DefaultLogFloodSampleLinesPerSecond = 10
+
+	logFloodWindow = time.Second
+)
+
+// LogFloodConfig configures the detection of a process logging above the rate for a sustained period, which fills
+// up the logs directory and burns the CPU of the instance manager.
+type LogFloodConfig struct {
+	// MaxLinesPerSecond is the rate a flood is detected above. The detection is disabled if 0
+	MaxLinesPerSecond int
+	SustainedPeriod   time.Duration
+	// Throttle keeps only the first SampleLinesPerSecond lines of each second in the log during a flood, as a
+	// sample for diagnosis, and drops the rest
+	Throttle             bool
+	SampleLinesPerSecond int
+}
+
+// logFloodDetector counts the lines of a log in one-second windows. The caller must serialize the calls.
+type logFloodDetector struct {
+	config *LogFloodConfig
+
+	windowStart   time.Time
+	windowLines   int
+	windowDropped int
+	// overSince is the start of the consecutive windows above the rate, or zero
+	overSince time.Time
+	flooding  bool
+}
+
+func newLogFloodDetector(config *LogFloodConfig) *logFloodDetector {
+	if config == nil || config.MaxLinesPerSecond <= 0 {
+		return nil
+	}
+	return &logFloodDetector{
+		config: config,
+	}
+}
+
+// roll closes the current window if it is over, and returns the number of the lines dropped in it.
+func (d *logFloodDetector) roll(now time.Time) int {
+	if now.Sub(d.windowStart) < logFloodWindow {
+		return 0
+	}
+
+	dropped := d.windowDropped
+	if d.windowLines > d.config.MaxLinesPerSecond && now.Sub(d.windowStart) < 2*logFloodWindow {
+		if d.overSince.IsZero() {
+			d.overSince = d.windowStart
+		}
+		if now.Sub(d.overSince) >= d.config.SustainedPeriod {
+			d.flooding = true
+		}
+	} else {
+		// The window is below the rate, or there are idle windows since
+		d.overSince = time.Time{}
+		d.flooding = false
+	}
+
+	d.windowStart = now
+	d.windowLines = 0
+	d.windowDropped = 0
+	return dropped
+}
+
+// admit counts a line, and returns whether the line should be stored.
+func (d *logFloodDetector) admit() bool {
+	d.windowLines++
+	if d.flooding && d.config.Throttle && d.windowLines > d.config.SampleLinesPerSecond {
+		d.windowDropped++
+		return false
+	}
+	return true
+}
+
+func formatLogFloodDroppedLine(dropped int) []byte {
+	return []byte(fmt.Sprintf("[log flood protection dropped %d lines]", dropped))
+}
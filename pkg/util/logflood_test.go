@@ -0,0 +1,48 @@
+package util
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestLogFloodDetector(c *C) {
+	c.Assert(newLogFloodDetector(&LogFloodConfig{}), IsNil)
+
+	d := newLogFloodDetector(&LogFloodConfig{
+		MaxLinesPerSecond:    10,
+		SustainedPeriod:      3 * time.Second,
+		Throttle:             true,
+		SampleLinesPerSecond: 2,
+	})
+	now := time.Now()
+	c.Assert(d.roll(now), Equals, 0)
+
+	logSecond := func() int {
+		admitted := 0
+		for i := 0; i < 20; i++ {
+			if d.admit() {
+				admitted++
+			}
+		}
+		now = now.Add(time.Second)
+		return admitted
+	}
+
+	// nothing is dropped before the rate is exceeded for the sustained period
+	for i := 0; i < 3; i++ {
+		c.Assert(logSecond(), Equals, 20)
+		c.Assert(d.roll(now), Equals, 0)
+	}
+	c.Assert(d.flooding, Equals, true)
+
+	c.Assert(logSecond(), Equals, 2)
+	c.Assert(d.roll(now), Equals, 18)
+	c.Assert(d.flooding, Equals, true)
+
+	// an idle gap ends the flood
+	now = now.Add(2 * time.Second)
+	c.Assert(d.roll(now), Equals, 0)
+	c.Assert(d.flooding, Equals, false)
+	c.Assert(logSecond(), Equals, 20)
+}
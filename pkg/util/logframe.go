@@ -0,0 +1,123 @@
+package util
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxLogFrameLines and MaxLogFrameSize bound a log frame well below the gRPC message size limit
+	MaxLogFrameLines = 1024
+	MaxLogFrameSize  = 1 << 20
+)
+
+// EncodeLogFrame encodes the log lines into a frame, so that a batch of lines is sent in one message rather than
+// one message per line. Each line is encoded as the uvarint sequence, the varint unix nano timestamp, and the
+// uvarint length followed by the line itself. The frame is gzip compressed as a whole if compressed is true.
+func EncodeLogFrame(lines []*LogLine, compressed bool) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var w io.Writer = buf
+	var zw *gzip.Writer
+	if compressed {
+		zw, _ = gzip.NewWriterLevel(buf, gzip.BestSpeed)
+		w = zw
+	}
+
+	header := make([]byte, 3*binary.MaxVarintLen64)
+	for _, line := range lines {
+		var timestamp int64
+		if !line.Time.IsZero() {
+			timestamp = line.Time.UnixNano()
+		}
+		n := binary.PutUvarint(header, line.Sequence)
+		n += binary.PutVarint(header[n:], timestamp)
+		n += binary.PutUvarint(header[n:], uint64(len(line.Line)))
+		if _, err := w.Write(header[:n]); err != nil {
+			return nil, errors.Wrap(err, "failed to encode log frame")
+		}
+		if _, err := io.WriteString(w, line.Line); err != nil {
+			return nil, errors.Wrap(err, "failed to encode log frame")
+		}
+	}
+
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to compress log frame")
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeLogFrame decodes the log lines of a frame encoded by EncodeLogFrame.
+func DecodeLogFrame(frame []byte, compressed bool) ([]*LogLine, error) {
+	if compressed {
+		zr, err := gzip.NewReader(bytes.NewReader(frame))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress log frame")
+		}
+		defer zr.Close()
+		if frame, err = io.ReadAll(zr); err != nil {
+			return nil, errors.Wrap(err, "failed to decompress log frame")
+		}
+	}
+
+	lines := []*LogLine{}
+	for len(frame) > 0 {
+		sequence, n := binary.Uvarint(frame)
+		if n <= 0 {
+			return nil, errors.New("invalid sequence in log frame")
+		}
+		frame = frame[n:]
+		timestamp, n := binary.Varint(frame)
+		if n <= 0 {
+			return nil, errors.New("invalid timestamp in log frame")
+		}
+		frame = frame[n:]
+		length, n := binary.Uvarint(frame)
+		if n <= 0 || length > uint64(len(frame)-n) {
+			return nil, errors.New("invalid line length in log frame")
+		}
+		frame = frame[n:]
+
+		line := &LogLine{
+			Sequence: sequence,
+			Line:     string(frame[:length]),
+		}
+		if timestamp != 0 {
+			line.Time = time.Unix(0, timestamp).UTC()
+		}
+		lines = append(lines, line)
+		frame = frame[length:]
+	}
+	return lines, nil
+}
+
+// NextLogBatch waits for the next log line, then takes the lines already available without waiting, up to
+// MaxLogFrameLines and MaxLogFrameSize. A tailing client gets the lines as soon as they are stored, while a client
+// catching up gets them in large batches. It returns false once the channel is closed and drained.
+func NextLogBatch(logChan <-chan *LogLine) ([]*LogLine, bool) {
+	line, ok := <-logChan
+	if !ok {
+		return nil, false
+	}
+	batch := []*LogLine{line}
+	size := len(line.Line)
+	for len(batch) < MaxLogFrameLines && size < MaxLogFrameSize {
+		select {
+		case line, ok := <-logChan:
+			if !ok {
+				return batch, true
+			}
+			batch = append(batch, line)
+			size += len(line.Line)
+		default:
+			return batch, true
+		}
+	}
+	return batch, true
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestLogFrame(c *C) {
+	now := time.Now().UTC()
+	lines := []*LogLine{
+		{Sequence: 1, Time: now, Line: "first"},
+		{Sequence: 2, Time: now.Add(time.Millisecond), Line: ""},
+		{Sequence: 0, Line: "legacy line without sequence"},
+		{Sequence: 1 << 40, Time: now, Line: strings.Repeat("x", 1000)},
+	}
+
+	for _, compressed := range []bool{false, true} {
+		frame, err := EncodeLogFrame(lines, compressed)
+		c.Assert(err, IsNil)
+		decoded, err := DecodeLogFrame(frame, compressed)
+		c.Assert(err, IsNil)
+		c.Assert(decoded, HasLen, len(lines))
+		for i, line := range decoded {
+			c.Assert(line.Sequence, Equals, lines[i].Sequence)
+			c.Assert(line.Line, Equals, lines[i].Line)
+			c.Assert(line.Time.Equal(lines[i].Time), Equals, true)
+		}
+	}
+
+	frame, err := EncodeLogFrame(lines, false)
+	c.Assert(err, IsNil)
+	_, err = DecodeLogFrame(frame[:len(frame)-1], false)
+	c.Assert(err, NotNil)
+	_, err = DecodeLogFrame(frame, true)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestNextLogBatch(c *C) {
+	logChan := make(chan *LogLine, MaxLogFrameLines+10)
+	for i := 1; i <= MaxLogFrameLines+10; i++ {
+		logChan <- &LogLine{Sequence: uint64(i), Line: "line"}
+	}
+	close(logChan)
+
+	batch, ok := NextLogBatch(logChan)
+	c.Assert(ok, Equals, true)
+	c.Assert(batch, HasLen, MaxLogFrameLines)
+	c.Assert(batch[0].Sequence, Equals, uint64(1))
+
+	batch, ok = NextLogBatch(logChan)
+	c.Assert(ok, Equals, true)
+	c.Assert(batch, HasLen, 10)
+	c.Assert(batch[9].Sequence, Equals, uint64(MaxLogFrameLines+10))
+
+	_, ok = NextLogBatch(logChan)
+	c.Assert(ok, Equals, false)
+}
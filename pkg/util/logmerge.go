@@ -0,0 +1,95 @@
+package util
+
+import (
+	"container/heap"
+	"context"
+	"time"
+)
+
+// MergedLogLine is a line of one of the logs merged by MergeLogs, along with the name of its log.
+type MergedLogLine struct {
+	*LogLine
+	Name string
+}
+
+type mergedLogHead struct {
+	name string
+	line *LogLine
+	// time orders the line, which is the one of the previous line of the log for the lines without a timestamp
+	time   time.Time
+	source <-chan *LogLine
+}
+
+type mergedLogHeap []*mergedLogHead
+
+func (h mergedLogHeap) Len() int { return len(h) }
+
+func (h mergedLogHeap) Less(i, j int) bool {
+	if h[i].time.Equal(h[j].time) {
+		return h[i].name < h[j].name
+	}
+	return h[i].time.Before(h[j].time)
+}
+
+func (h mergedLogHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *mergedLogHeap) Push(x interface{}) { *h = append(*h, x.(*mergedLogHead)) }
+
+func (h *mergedLogHeap) Pop() interface{} {
+	old := *h
+	head := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return head
+}
+
+// MergeLogs interleaves the lines of the logs by time until all the logs end or the context is done. Each log is
+// expected in time order, as the logs of the processes are stored. A line without a timestamp is kept right after the
+// previous line of its log, and the lines of the same time are ordered by the names of their logs.
+//
+// A line is only sent once all the logs have a line pending or have ended, so a stalled log holds the merged one back.
+func MergeLogs(ctx context.Context, logs map[string]<-chan *LogLine) <-chan *MergedLogLine {
+	mergedChan := make(chan *MergedLogLine, MaxLogFrameLines)
+	go func() {
+		defer close(mergedChan)
+
+		next := func(head *mergedLogHead) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case line, ok := <-head.source:
+				if !ok {
+					return false
+				}
+				head.line = line
+				if !line.Time.IsZero() {
+					head.time = line.Time
+				}
+				return true
+			}
+		}
+
+		heads := &mergedLogHeap{}
+		for name, source := range logs {
+			head := &mergedLogHead{name: name, source: source}
+			if next(head) {
+				heads.Push(head)
+			}
+		}
+		heap.Init(heads)
+
+		for heads.Len() > 0 {
+			head := (*heads)[0]
+			select {
+			case <-ctx.Done():
+				return
+			case mergedChan <- &MergedLogLine{LogLine: head.line, Name: head.name}:
+			}
+			if next(head) {
+				heap.Fix(heads, 0)
+			} else {
+				heap.Pop(heads)
+			}
+		}
+	}()
+	return mergedChan
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestMergeLogs(c *C) {
+	now := time.Now()
+	newLog := func(lines ...*LogLine) <-chan *LogLine {
+		logChan := make(chan *LogLine, len(lines))
+		for _, line := range lines {
+			logChan <- line
+		}
+		close(logChan)
+		return logChan
+	}
+
+	merged := MergeLogs(context.Background(), map[string]<-chan *LogLine{
+		"vol-e-0": newLog(
+			&LogLine{Sequence: 1, Time: now.Add(1 * time.Second), Line: "engine 1"},
+			&LogLine{Sequence: 2, Line: "engine 2 without timestamp"},
+			&LogLine{Sequence: 3, Time: now.Add(4 * time.Second), Line: "engine 3"},
+		),
+		"vol-r-0": newLog(
+			&LogLine{Sequence: 1, Time: now, Line: "replica 1"},
+			&LogLine{Sequence: 2, Time: now.Add(2 * time.Second), Line: "replica 2"},
+			&LogLine{Sequence: 3, Time: now.Add(4 * time.Second), Line: "replica 3"},
+		),
+		"vol-r-1": newLog(),
+	})
+
+	lines := []string{}
+	for line := range merged {
+		lines = append(lines, line.Name+": "+line.Line)
+	}
+	c.Assert(lines, DeepEquals, []string{
+		"vol-r-0: replica 1",
+		"vol-e-0: engine 1",
+		"vol-e-0: engine 2 without timestamp",
+		"vol-r-0: replica 2",
+		"vol-e-0: engine 3",
+		"vol-r-0: replica 3",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pending := make(chan *LogLine)
+	merged = MergeLogs(ctx, map[string]<-chan *LogLine{"vol-e-0": pending})
+	cancel()
+	_, ok := <-merged
+	c.Assert(ok, Equals, false)
+}
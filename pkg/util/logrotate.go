@@ -0,0 +1,294 @@
+package util
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	LogCompressionNone = "none"
+	LogCompressionGzip = "gzip"
+	LogCompressionLZ4  = "lz4"
+
+	// The log files are neither rotated, removed nor compressed by default
+	DefaultLogRotationMaxSize     = 0
+	DefaultLogRotationMaxFiles    = 0
+	DefaultLogRotationCompression = LogCompressionNone
+
+	// logCompressingSuffix marks a rotated log file being compressed, which is not listed until it is complete
+	logCompressingSuffix = ".tmp"
+)
+
+// LogRotationConfig configures the rotation of the log files, which keeps the verbose processes of the long-lived
+// nodes from filling up the logs directory.
+type LogRotationConfig struct {
+	// MaxSize is the size the log file is rotated at. The rotation is disabled if 0
+	MaxSize int64
+	// MaxFiles is the number of the rotated files kept per log, the oldest ones are removed first. All are kept if 0
+	MaxFiles int
+	// Compression is the method the rotated files are compressed with
+	Compression string
+}
+
+type logCodec struct {
+	extension string
+	newWriter func(w io.Writer) (io.WriteCloser, error)
+	newReader func(r io.Reader) (io.Reader, error)
+}
+
+// logCodecs are the methods of compressing the rotated log files. The method of a file is told by its extension,
+// so the files compressed before a change of the method are still readable.
+var logCodecs = map[string]*logCodec{
+	LogCompressionGzip: {
+		extension: ".gz",
+		newWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, gzip.BestSpeed)
+		},
+		newReader: func(r io.Reader) (io.Reader, error) {
+			return gzip.NewReader(r)
+		},
+	},
+	LogCompressionLZ4: {
+		extension: ".lz4",
+		newWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return lz4.NewWriter(w), nil
+		},
+		newReader: func(r io.Reader) (io.Reader, error) {
+			return lz4.NewReader(r), nil
+		},
+	},
+}
+
+func ValidateLogCompression(compression string) error {
+	if compression == "" || compression == LogCompressionNone {
+		return nil
+	}
+	if _, exists := logCodecs[compression]; !exists {
+		return fmt.Errorf("unsupported log compression %v", compression)
+	}
+	return nil
+}
+
+// rotatedLogFile is a rotated log file named after the log file and the sequence of its last line, e.g.
+// "engine-1.log.4242.gz", so that the files are ordered by name and skipped by the sequence without being read.
+type rotatedLogFile struct {
+	path         string
+	lastSequence uint64
+	codec        *logCodec
+}
+
+func getRotatedLogPath(logPath string, lastSequence uint64) string {
+	return fmt.Sprintf("%s.%d", logPath, lastSequence)
+}
+
+// listRotatedLogFiles returns the rotated files of the log file ordered from the oldest. A file both compressed and
+// not yet removed uncompressed is listed once as compressed.
+func listRotatedLogFiles(logPath string) ([]*rotatedLogFile, error) {
+	paths, err := filepath.Glob(logPath + ".*")
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[uint64]*rotatedLogFile{}
+	for _, path := range paths {
+		suffix := strings.TrimPrefix(path, logPath+".")
+		var codec *logCodec
+		for _, c := range logCodecs {
+			if strings.HasSuffix(suffix, c.extension) {
+				codec = c
+				suffix = strings.TrimSuffix(suffix, c.extension)
+				break
+			}
+		}
+		lastSequence, err := strconv.ParseUint(suffix, 10, 64)
+		if err != nil {
+			continue
+		}
+		if existing, exists := files[lastSequence]; exists && existing.codec != nil {
+			continue
+		}
+		files[lastSequence] = &rotatedLogFile{
+			path:         path,
+			lastSequence: lastSequence,
+			codec:        codec,
+		}
+	}
+
+	ret := []*rotatedLogFile{}
+	for _, f := range files {
+		ret = append(ret, f)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].lastSequence < ret[j].lastSequence })
+	return ret, nil
+}
+
+// getLastRotatedLogSequence returns the sequence of the last line of the newest rotated file, or 0 if there is none.
+func getLastRotatedLogSequence(logPath string) (uint64, error) {
+	files, err := listRotatedLogFiles(logPath)
+	if err != nil {
+		return 0, err
+	}
+	if len(files) == 0 {
+		return 0, nil
+	}
+	return files[len(files)-1].lastSequence, nil
+}
+
+// open opens the rotated file decompressed. The uncompressed file may be replaced by the compressed one since it is
+// listed, so the compressed one is tried as well.
+func (f *rotatedLogFile) open() (io.Reader, io.Closer, error) {
+	candidates := []*rotatedLogFile{f}
+	if f.codec == nil {
+		for _, c := range logCodecs {
+			candidates = append(candidates, &rotatedLogFile{
+				path:         f.path + c.extension,
+				lastSequence: f.lastSequence,
+				codec:        c,
+			})
+		}
+	}
+
+	for _, candidate := range candidates {
+		file, err := os.Open(candidate.path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, nil, err
+		}
+		if candidate.codec == nil {
+			return file, file, nil
+		}
+		reader, err := candidate.codec.newReader(bufio.NewReader(file))
+		if err != nil {
+			file.Close()
+			return nil, nil, errors.Wrapf(err, "failed to decompress rotated log file %v", candidate.path)
+		}
+		return reader, file, nil
+	}
+	return nil, nil, os.ErrNotExist
+}
+
+// compressRotatedLog compresses the rotated log file, and removes the uncompressed one once the compressed one is
+// complete.
+func compressRotatedLog(path, compression string) (err error) {
+	codec, exists := logCodecs[compression]
+	if !exists {
+		return nil
+	}
+	compressedPath := path + codec.extension
+
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(compressedPath+logCompressingSuffix, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		dst.Close()
+		if err != nil {
+			os.Remove(compressedPath + logCompressingSuffix)
+		}
+	}()
+
+	zw, err := codec.newWriter(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(zw, src); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	if err := dst.Sync(); err != nil {
+		return err
+	}
+	if err := os.Rename(compressedPath+logCompressingSuffix, compressedPath); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// pruneRotatedLogs removes the oldest rotated files beyond the limit.
+func pruneRotatedLogs(logPath string, maxFiles int) error {
+	if maxFiles <= 0 {
+		return nil
+	}
+	files, err := listRotatedLogFiles(logPath)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(files)-maxFiles; i++ {
+		if err := files[i].remove(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remove removes the rotated file in all its forms, i.e. uncompressed, compressed and being compressed.
+func (f *rotatedLogFile) remove() error {
+	base := strings.TrimSuffix(f.path, f.codec.getExtension())
+	paths := []string{base}
+	for _, c := range logCodecs {
+		paths = append(paths, base+c.extension, base+c.extension+logCompressingSuffix)
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to remove rotated log file %v", path)
+		}
+	}
+	return nil
+}
+
+// finishRotation compresses the rotated log file and prunes the oldest ones, which is done in the background so that
+// the process writing the log is not blocked.
+func finishRotation(logPath, rotatedPath string, config LogRotationConfig) {
+	if err := compressRotatedLog(rotatedPath, config.Compression); err != nil {
+		logrus.WithError(err).Warnf("Failed to compress rotated log file %v", rotatedPath)
+	}
+	if err := pruneRotatedLogs(logPath, config.MaxFiles); err != nil {
+		logrus.WithError(err).Warnf("Failed to remove the oldest rotated files of log %v", logPath)
+	}
+}
+
+// RemoveLogFiles removes the log file of the name in the logs directory along with its rotated files.
+func RemoveLogFiles(logsDir, name string) error {
+	logPath := filepath.Join(logsDir, name+".log")
+	files, err := listRotatedLogFiles(logPath)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := f.remove(); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(logPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove log file %v", logPath)
+	}
+	return nil
+}
+
+func (c *logCodec) getExtension() string {
+	if c == nil {
+		return ""
+	}
+	return c.extension
+}
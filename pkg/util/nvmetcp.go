@@ -0,0 +1,176 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	commonnet "github.com/longhorn/go-common-libs/net"
+)
+
+const (
+	spdkSockImplPosix = "posix"
+	// spdkPlacementIDNAPI makes SPDK assign the connections to the poll groups by the NAPI ID, i.e. the NIC receive
+	// queue, the connections arrive on
+	spdkPlacementIDNAPI = 1
+
+	// nvmeTCPSocketConfigTimeout is the time to wait for spdk_tgt to take the socket options at startup, since
+	// spdk_tgt is started alongside rather than by the instance manager
+	nvmeTCPSocketConfigTimeout       = 60 * time.Second
+	nvmeTCPSocketConfigRetryInterval = time.Second
+)
+
+// busyPollSysctlPaths are the node-global sysctls of the busy polling of the socket reads. They are not namespaced,
+// so they are left to the node rather than set by the instance manager.
+var busyPollSysctlPaths = []string{"/proc/sys/net/core/busy_read", "/proc/sys/net/core/busy_poll"}
+
+// NvmeTCPSocketConfig configures the sockets of the NVMe-oF TCP traffic served by spdk_tgt, so that the storage
+// traffic can be isolated from the pod traffic sharing the node.
+type NvmeTCPSocketConfig struct {
+	// Interface is the NIC the NVMe-oF TCP targets of the v2 instances listen on in addition to the pod IP. SPDK
+	// cannot set SO_BINDTODEVICE on its sockets, so the targets listen on the IPv4 address of the NIC instead. The
+	// storage network interface takes precedence if it exists.
+	Interface string
+	// DiskInterfaces overrides Interface for the replicas on the disks, by the disk names.
+	DiskInterfaces map[string]string
+	// BusyPollUsec is the time in microseconds the node is expected to busy poll the NIC receive queue for the
+	// socket reads. The busy polling is node-global, so it is only checked against the node. Not checked if 0.
+	BusyPollUsec int
+	// NAPIPlacement groups the connections by the NIC receive queue they arrive on, so that each SPDK poll group
+	// polls the connections of its own queues.
+	NAPIPlacement bool
+}
+
+// ParseNvmeTCPDiskInterfaces parses the per-disk NVMe-oF TCP interfaces in the form of DISK=INTERFACE, e.g.
+// disk-1=eth1.
+func ParseNvmeTCPDiskInterfaces(values []string) (map[string]string, error) {
+	interfaces := map[string]string{}
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("invalid NVMe-oF TCP disk interface %v, expected DISK=INTERFACE", value)
+		}
+		interfaces[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return interfaces, nil
+}
+
+// GetInterface returns the NIC the NVMe-oF TCP target of an instance on the disk listens on, which is the global
+// one for the instances not on a disk, i.e. the engines. Empty if none is configured.
+func (c *NvmeTCPSocketConfig) GetInterface(diskName string) string {
+	if c == nil {
+		return ""
+	}
+	if iface, ok := c.DiskInterfaces[diskName]; ok && diskName != "" {
+		return iface
+	}
+	return c.Interface
+}
+
+// GetNvmeTCPInterfaceIP returns the IPv4 address of the NIC for the NVMe-oF TCP targets to listen on, or an empty
+// string if no NIC is given or the storage network interface exists, which the pod IP is already on.
+func GetNvmeTCPInterfaceIP(iface string) (string, error) {
+	if iface == "" {
+		return "", nil
+	}
+	if _, err := commonnet.GetLocalIPv4fromInterface(commonnet.StorageNetworkInterface); err == nil {
+		return "", nil
+	}
+	ip, err := commonnet.GetLocalIPv4fromInterface(iface)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get IP of NVMe-oF TCP interface %v", iface)
+	}
+	return ip, nil
+}
+
+// ApplyNvmeTCPSocketConfig applies the config to spdk_tgt before any NVMe-oF TCP target is created, since the SPDK
+// socket options only apply to the connections accepted afterwards. It waits for spdk_tgt to be ready, so it must
+// be called before the v2 instances can be created.
+func ApplyNvmeTCPSocketConfig(ctx context.Context, config *NvmeTCPSocketConfig) error {
+	if config.BusyPollUsec < 0 {
+		return errors.Errorf("invalid NVMe-oF TCP busy poll time %v", config.BusyPollUsec)
+	}
+
+	interfaces := map[string]bool{}
+	if config.Interface != "" {
+		interfaces[config.Interface] = true
+	}
+	for _, iface := range config.DiskInterfaces {
+		interfaces[iface] = true
+	}
+	for iface := range interfaces {
+		ip, err := GetNvmeTCPInterfaceIP(iface)
+		if err != nil {
+			return err
+		}
+		if ip == "" {
+			logrus.Warnf("Ignoring NVMe-oF TCP interface %v since storage network interface %v exists",
+				iface, commonnet.StorageNetworkInterface)
+			continue
+		}
+		logrus.Infof("NVMe-oF TCP targets listen on interface %v with IP %v as well", iface, ip)
+	}
+
+	if config.BusyPollUsec > 0 {
+		if err := checkBusyPoll(config.BusyPollUsec, busyPollSysctlPaths); err != nil {
+			logrus.WithError(err).Warn("The node is not set up for the NVMe-oF TCP busy polling")
+		}
+	}
+
+	if config.NAPIPlacement {
+		if err := sendSPDKCommandWithRetry(ctx, "sock_impl_set_options", map[string]interface{}{
+			"impl_name":           spdkSockImplPosix,
+			"enable_placement_id": spdkPlacementIDNAPI,
+		}, nvmeTCPSocketConfigTimeout); err != nil {
+			return errors.Wrap(err, "failed to enable NAPI placement for SPDK sockets")
+		}
+		logrus.Info("Enabled NAPI placement for SPDK sockets")
+	}
+
+	return nil
+}
+
+// checkBusyPoll returns an error if any of the busy poll sysctls of the node is lower than busyPollUsec.
+func checkBusyPoll(busyPollUsec int, sysctlPaths []string) error {
+	for _, path := range sysctlPaths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %v", path)
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(string(content)))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %v", path)
+		}
+		if value < busyPollUsec {
+			return fmt.Errorf("%v is %v rather than at least %v", path, value, busyPollUsec)
+		}
+	}
+	return nil
+}
+
+// sendSPDKCommandWithRetry sends the command to spdk_tgt, retrying until spdk_tgt takes it or the timeout passes.
+func sendSPDKCommandWithRetry(ctx context.Context, method string, params interface{}, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		_, err := SendSPDKCommand(ctx, method, params)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.Wrapf(err, "spdk_tgt did not take %v in %v", method, timeout)
+		}
+		logrus.WithError(err).Debugf("Retrying sending %v to spdk_tgt", method)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(nvmeTCPSocketConfigRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestParseNvmeTCPDiskInterfaces(c *C) {
+	interfaces, err := ParseNvmeTCPDiskInterfaces([]string{"disk-1=eth1", " disk-2 = eth2 "})
+	c.Assert(err, IsNil)
+	c.Assert(interfaces, DeepEquals, map[string]string{"disk-1": "eth1", "disk-2": "eth2"})
+
+	for _, value := range []string{"disk-1", "=eth1", "disk-1=", " = "} {
+		_, err := ParseNvmeTCPDiskInterfaces([]string{value})
+		c.Assert(err, ErrorMatches, "invalid NVMe-oF TCP disk interface.*", Commentf("value %q", value))
+	}
+}
+
+func (s *TestSuite) TestNvmeTCPSocketConfigGetInterface(c *C) {
+	config := &NvmeTCPSocketConfig{
+		Interface:      "eth1",
+		DiskInterfaces: map[string]string{"disk-1": "eth2"},
+	}
+	c.Assert(config.GetInterface("disk-1"), Equals, "eth2")
+	c.Assert(config.GetInterface("disk-2"), Equals, "eth1")
+	// The engines are not on a disk
+	c.Assert(config.GetInterface(""), Equals, "eth1")
+
+	c.Assert((&NvmeTCPSocketConfig{DiskInterfaces: map[string]string{"disk-1": "eth2"}}).GetInterface("disk-2"), Equals, "")
+	c.Assert((*NvmeTCPSocketConfig)(nil).GetInterface("disk-1"), Equals, "")
+
+	ip, err := GetNvmeTCPInterfaceIP("")
+	c.Assert(err, IsNil)
+	c.Assert(ip, Equals, "")
+}
+
+func (s *TestSuite) TestCheckBusyPoll(c *C) {
+	dir := c.MkDir()
+	busyRead := filepath.Join(dir, "busy_read")
+	busyPoll := filepath.Join(dir, "busy_poll")
+	paths := []string{busyRead, busyPoll}
+
+	c.Assert(os.WriteFile(busyRead, []byte("50\n"), 0644), IsNil)
+	c.Assert(os.WriteFile(busyPoll, []byte("50\n"), 0644), IsNil)
+	c.Assert(checkBusyPoll(50, paths), IsNil)
+	c.Assert(checkBusyPoll(20, paths), IsNil)
+	c.Assert(checkBusyPoll(100, paths), ErrorMatches, ".*busy_read is 50 rather than at least 100")
+
+	c.Assert(os.WriteFile(busyPoll, []byte("0\n"), 0644), IsNil)
+	c.Assert(checkBusyPoll(50, paths), ErrorMatches, ".*busy_poll is 0 rather than at least 50")
+
+	c.Assert(os.WriteFile(busyPoll, []byte("fast\n"), 0644), IsNil)
+	c.Assert(checkBusyPoll(50, paths), ErrorMatches, "failed to parse .*busy_poll.*")
+
+	c.Assert(checkBusyPoll(50, []string{filepath.Join(dir, "missing")}), ErrorMatches, "failed to read .*missing.*")
+}
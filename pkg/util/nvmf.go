@@ -0,0 +1,494 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	commonns "github.com/longhorn/go-common-libs/ns"
+	commontypes "github.com/longhorn/go-common-libs/types"
+	helpernvme "github.com/longhorn/go-spdk-helper/pkg/nvme"
+	spdkhelpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+)
+
+const (
+	// NvmfInitiatorNvmeCLI manages the NVMe-oF connections of the host by the nvme command
+	NvmfInitiatorNvmeCLI = "nvme-cli"
+	// NvmfInitiatorNative manages the NVMe-oF connections of the host in process by the fabrics device and sysfs of
+	// the kernel, falling back to the nvme command if it fails
+	NvmfInitiatorNative = "native"
+
+	nvmfFabricsDevice       = "/dev/nvme-fabrics"
+	nvmfControllerDirectory = "/sys/class/nvme"
+	nvmfSubsystemDirectory  = "/sys/class/nvme-subsystem"
+	nvmfHostNQNPath         = "/etc/nvme/hostnqn"
+	nvmfHostIDPath          = "/etc/nvme/hostid"
+	nvmfProductUUIDPath     = "/sys/class/dmi/id/product_uuid"
+
+	nvmfDiscoveryNQN = "nqn.2014-08.org.nvmexpress.discovery"
+
+	// nvmeIoctlAdminCmd is NVME_IOCTL_ADMIN_CMD of linux/nvme_ioctl.h, i.e. _IOWR('N', 0x41, struct nvme_admin_cmd)
+	nvmeIoctlAdminCmd = 0xC0484E41
+	// nvmeAdminGetLogPage is the opcode of the Get Log Page admin command
+	nvmeAdminGetLogPage = 0x02
+	// nvmeLogDiscovery is the identifier of the discovery log page
+	nvmeLogDiscovery = 0x70
+
+	// The discovery log page is a 1024 byte header followed by the 1024 byte entries. The offsets are of the
+	// number of records in the header, and of the transport service ID, subsystem NQN and transport address in
+	// an entry
+	nvmfDiscoveryLogEntrySize     = 1024
+	nvmfDiscoveryLogNumRecOffset  = 8
+	nvmfDiscoveryLogTrsvcIDOffset = 32
+	nvmfDiscoveryLogTrsvcIDSize   = 32
+	nvmfDiscoveryLogSubNQNOffset  = 256
+	nvmfDiscoveryLogTraddrOffset  = 512
+	nvmfDiscoveryLogStringSize    = 256
+	nvmfDiscoveryMaxRecords       = 256
+
+	nvmfConnectResultSize = 256
+)
+
+var (
+	nvmfInitiatorLock = &sync.RWMutex{}
+	nvmfInitiator     = NvmfInitiatorNvmeCLI
+
+	nvmfConnectResultRegex = regexp.MustCompile(`instance=(\d+)`)
+	nvmfControllerRegex    = regexp.MustCompile(`^nvme\d+$`)
+)
+
+// nvmePassthruCmd is struct nvme_passthru_cmd of linux/nvme_ioctl.h
+type nvmePassthruCmd struct {
+	Opcode      uint8
+	Flags       uint8
+	Rsvd1       uint16
+	Nsid        uint32
+	Cdw2        uint32
+	Cdw3        uint32
+	Metadata    uint64
+	Addr        uint64
+	MetadataLen uint32
+	DataLen     uint32
+	Cdw10       uint32
+	Cdw11       uint32
+	Cdw12       uint32
+	Cdw13       uint32
+	Cdw14       uint32
+	Cdw15       uint32
+	TimeoutMs   uint32
+	Result      uint32
+}
+
+// NvmfConnectOptions are the options of an NVMe-oF TCP connection written to the fabrics device of the kernel.
+type NvmfConnectOptions struct {
+	Address   string
+	ServiceID string
+	NQN       string
+	HostNQN   string
+	HostID    string
+}
+
+func (o *NvmfConnectOptions) String() string {
+	options := []string{
+		"nqn=" + o.NQN,
+		"transport=" + helpernvme.DefaultTransportType,
+		"traddr=" + o.Address,
+		"trsvcid=" + o.ServiceID,
+	}
+	if o.HostNQN != "" {
+		options = append(options, "hostnqn="+o.HostNQN)
+	}
+	if o.HostID != "" {
+		options = append(options, "hostid="+o.HostID)
+	}
+	return strings.Join(options, ",")
+}
+
+// ValidateNvmfInitiator validates the NVMe-oF initiator given by flag.
+func ValidateNvmfInitiator(initiator string) error {
+	switch initiator {
+	case NvmfInitiatorNvmeCLI, NvmfInitiatorNative:
+		return nil
+	default:
+		return fmt.Errorf("unknown NVMe-oF initiator %v", initiator)
+	}
+}
+
+// SetNvmfInitiator sets how the NVMe-oF connections of the host are managed.
+func SetNvmfInitiator(initiator string) {
+	nvmfInitiatorLock.Lock()
+	defer nvmfInitiatorLock.Unlock()
+
+	nvmfInitiator = initiator
+}
+
+func isNvmfInitiatorNative() bool {
+	nvmfInitiatorLock.RLock()
+	defer nvmfInitiatorLock.RUnlock()
+
+	return nvmfInitiator == NvmfInitiatorNative
+}
+
+// NvmfDiscoverTarget returns the NQN of the subsystem listening on the address.
+func NvmfDiscoverTarget(ip, port string, executor *commonns.Executor) (string, error) {
+	if isNvmfInitiatorNative() {
+		nqn, err := runInHostNamespaces(func() (interface{}, error) {
+			return nvmfDiscoverTarget(ip, port)
+		})
+		if err == nil {
+			return nqn.(string), nil
+		}
+		logrus.WithError(err).Warnf("Failed to discover NVMe-oF target %v:%v natively, falling back to nvme-cli", ip, port)
+	}
+	return helpernvme.DiscoverTarget(ip, port, executor)
+}
+
+// NvmfConnectTarget connects the host to the subsystem, and returns the name of the controller.
+func NvmfConnectTarget(ip, port, nqn string, executor *commonns.Executor) (string, error) {
+	if isNvmfInitiatorNative() {
+		controller, err := runInHostNamespaces(func() (interface{}, error) {
+			return nvmfConnectTarget(ip, port, nqn)
+		})
+		if err == nil {
+			return controller.(string), nil
+		}
+		logrus.WithError(err).Warnf("Failed to connect NVMe-oF target %v natively, falling back to nvme-cli", nqn)
+	}
+	return helpernvme.ConnectTarget(ip, port, nqn, executor)
+}
+
+// NvmfDisconnectTarget disconnects all the controllers of the host from the subsystem.
+func NvmfDisconnectTarget(nqn string, executor *commonns.Executor) error {
+	if isNvmfInitiatorNative() {
+		_, err := runInHostNamespaces(func() (interface{}, error) {
+			return true, nvmfDisconnectTarget(nqn)
+		})
+		if err == nil {
+			return nil
+		}
+		logrus.WithError(err).Warnf("Failed to disconnect NVMe-oF target %v natively, falling back to nvme-cli", nqn)
+	}
+	return helpernvme.DisconnectTarget(nqn, executor)
+}
+
+// NvmfGetSubsystems returns the NVMe subsystems of the host.
+func NvmfGetSubsystems(executor *commonns.Executor) ([]helpernvme.Subsystem, error) {
+	if isNvmfInitiatorNative() {
+		subsystems, err := runInHostNamespaces(func() (interface{}, error) {
+			return nvmfGetSubsystems()
+		})
+		if err == nil {
+			return subsystems.([]helpernvme.Subsystem), nil
+		}
+		logrus.WithError(err).Warn("Failed to get NVMe subsystems natively, falling back to nvme-cli")
+	}
+	return helpernvme.GetSubsystems(executor)
+}
+
+// runInHostNamespaces runs the function in the mount and network namespaces of the host, where the nvme command
+// would run, so that the fabrics device, sysfs and the host identity are the ones of the host and the connections
+// are made from its network.
+func runInHostNamespaces(fn func() (interface{}, error)) (interface{}, error) {
+	joiner, err := commonns.NewJoiner(commontypes.ProcDirectory, spdkhelpertypes.ExecuteTimeout)
+	if err != nil {
+		return nil, err
+	}
+	result, err := joiner.Run(fn)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.New("function was stopped before returning a result")
+	}
+	return result, nil
+}
+
+func getNvmfHostIdentity() (hostNQN, hostID string, err error) {
+	content, err := os.ReadFile(nvmfHostNQNPath)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to read host NQN")
+	}
+	hostNQN = strings.TrimSpace(string(content))
+	if hostNQN == "" {
+		return "", "", errors.Errorf("empty host NQN in %v", nvmfHostNQNPath)
+	}
+
+	for _, path := range []string{nvmfHostIDPath, nvmfProductUUIDPath} {
+		if content, err := os.ReadFile(path); err == nil {
+			return hostNQN, strings.TrimSpace(string(content)), nil
+		}
+	}
+	// The kernel generates the host ID if it is not given
+	return hostNQN, "", nil
+}
+
+func nvmfDiscoverTarget(ip, port string) (string, error) {
+	controller, err := nvmfConnect(ip, port, nvmfDiscoveryNQN)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := nvmfDeleteController(controller); err != nil {
+			logrus.WithError(err).Warnf("Failed to delete NVMe-oF discovery controller %v", controller)
+		}
+	}()
+
+	entries, err := nvmfGetDiscoveryLog(controller)
+	if err != nil {
+		return "", err
+	}
+	// The discovery controller reports all the subsystems of the target rather than only the one on the port
+	for _, entry := range entries {
+		if entry.TrsvcID == port {
+			return entry.Subnqn, nil
+		}
+	}
+	return "", fmt.Errorf("found empty subnqn after NVMe-oF discovery for %s:%s", ip, port)
+}
+
+func nvmfConnectTarget(ip, port, nqn string) (string, error) {
+	// Connecting to a subsystem already connected fails, so the existing controller is returned instead
+	subsystems, err := nvmfGetSubsystems()
+	if err != nil {
+		return "", err
+	}
+	for _, subsystem := range subsystems {
+		if subsystem.NQN != nqn {
+			continue
+		}
+		for _, path := range subsystem.Paths {
+			if address, service := parseNvmfControllerAddress(path.Address); address == ip && service == port {
+				return path.Name, nil
+			}
+		}
+	}
+	return nvmfConnect(ip, port, nqn)
+}
+
+// nvmfConnect writes the connection options to the fabrics device, which connects a new controller synchronously
+// and answers with its instance number.
+func nvmfConnect(ip, port, nqn string) (string, error) {
+	hostNQN, hostID, err := getNvmfHostIdentity()
+	if err != nil {
+		return "", err
+	}
+	options := &NvmfConnectOptions{
+		Address:   ip,
+		ServiceID: port,
+		NQN:       nqn,
+		HostNQN:   hostNQN,
+		HostID:    hostID,
+	}
+
+	f, err := os.OpenFile(nvmfFabricsDevice, os.O_RDWR, 0)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open %v", nvmfFabricsDevice)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(options.String()); err != nil {
+		return "", errors.Wrapf(err, "failed to connect NVMe-oF target %v at %v:%v", nqn, ip, port)
+	}
+	result := make([]byte, nvmfConnectResultSize)
+	n, err := f.Read(result)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read the controller connected to NVMe-oF target %v", nqn)
+	}
+	return parseNvmfConnectResult(string(result[:n]))
+}
+
+// parseNvmfConnectResult parses the answer of the fabrics device, e.g. instance=3,cntlid=1, into the name of the
+// controller.
+func parseNvmfConnectResult(result string) (string, error) {
+	matches := nvmfConnectResultRegex.FindStringSubmatch(result)
+	if len(matches) < 2 {
+		return "", fmt.Errorf("invalid NVMe-oF connect result %q", strings.TrimSpace(result))
+	}
+	return "nvme" + matches[1], nil
+}
+
+func nvmfDisconnectTarget(nqn string) error {
+	subsystems, err := nvmfGetSubsystems()
+	if err != nil {
+		return err
+	}
+	for _, subsystem := range subsystems {
+		if subsystem.NQN != nqn {
+			continue
+		}
+		for _, path := range subsystem.Paths {
+			if err := nvmfDeleteController(path.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func nvmfDeleteController(controller string) error {
+	path := filepath.Join(nvmfControllerDirectory, controller, "delete_controller")
+	if err := os.WriteFile(path, []byte("1"), 0200); err != nil {
+		return errors.Wrapf(err, "failed to delete NVMe controller %v", controller)
+	}
+	return nil
+}
+
+func nvmfGetSubsystems() ([]helpernvme.Subsystem, error) {
+	subsystemDirs, err := os.ReadDir(nvmfSubsystemDirectory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []helpernvme.Subsystem{}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read %v", nvmfSubsystemDirectory)
+	}
+
+	subsystems := []helpernvme.Subsystem{}
+	for _, subsystemDir := range subsystemDirs {
+		dir := filepath.Join(nvmfSubsystemDirectory, subsystemDir.Name())
+		nqn, err := readSysfsAttribute(dir, "subsysnqn")
+		if err != nil {
+			return nil, err
+		}
+		subsystem := helpernvme.Subsystem{
+			Name: subsystemDir.Name(),
+			NQN:  nqn,
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %v", dir)
+		}
+		for _, entry := range entries {
+			if !nvmfControllerRegex.MatchString(entry.Name()) {
+				continue
+			}
+			controllerDir := filepath.Join(nvmfControllerDirectory, entry.Name())
+			// The controller may be deleted in the meantime, so the attributes are read on a best effort basis
+			transport, _ := readSysfsAttribute(controllerDir, "transport")
+			address, _ := readSysfsAttribute(controllerDir, "address")
+			state, _ := readSysfsAttribute(controllerDir, "state")
+			subsystem.Paths = append(subsystem.Paths, helpernvme.Path{
+				Name:      entry.Name(),
+				Transport: transport,
+				Address:   address,
+				State:     state,
+			})
+		}
+		subsystems = append(subsystems, subsystem)
+	}
+	return subsystems, nil
+}
+
+func readSysfsAttribute(dir, name string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read %v of %v", name, dir)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// parseNvmfControllerAddress parses the address of a controller in sysfs, e.g.
+// traddr=10.42.2.20,trsvcid=20001,src_addr=10.42.2.21, into the transport address and service ID.
+func parseNvmfControllerAddress(address string) (string, string) {
+	traddr, trsvcid := "", ""
+	for _, field := range strings.Split(address, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(field), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "traddr":
+			traddr = value
+		case "trsvcid":
+			trsvcid = value
+		}
+	}
+	return traddr, trsvcid
+}
+
+// nvmfGetDiscoveryLog reads the discovery log page of the discovery controller. The header is read first for the
+// number of records, then the whole page.
+func nvmfGetDiscoveryLog(controller string) ([]helpernvme.DiscoveryPageEntry, error) {
+	f, err := os.OpenFile(filepath.Join("/dev", controller), os.O_RDWR, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open NVMe controller %v", controller)
+	}
+	defer f.Close()
+
+	header := make([]byte, nvmfDiscoveryLogEntrySize)
+	if err := nvmeGetLogPage(f, nvmeLogDiscovery, header); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the discovery log header of NVMe controller %v", controller)
+	}
+	numRec := binary.LittleEndian.Uint64(header[nvmfDiscoveryLogNumRecOffset:])
+	if numRec == 0 {
+		return []helpernvme.DiscoveryPageEntry{}, nil
+	}
+	if numRec > nvmfDiscoveryMaxRecords {
+		numRec = nvmfDiscoveryMaxRecords
+	}
+
+	page := make([]byte, nvmfDiscoveryLogEntrySize*(numRec+1))
+	if err := nvmeGetLogPage(f, nvmeLogDiscovery, page); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the discovery log of NVMe controller %v", controller)
+	}
+	return parseNvmfDiscoveryLog(page)
+}
+
+func nvmeGetLogPage(f *os.File, logID uint32, buf []byte) error {
+	numDwords := uint32(len(buf)/4 - 1)
+	cmd := &nvmePassthruCmd{
+		Opcode:  nvmeAdminGetLogPage,
+		Addr:    uint64(uintptr(unsafe.Pointer(&buf[0]))),
+		DataLen: uint32(len(buf)),
+		Cdw10:   logID | (numDwords&0xffff)<<16,
+		Cdw11:   numDwords >> 16,
+	}
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(cmd)))
+	runtime.KeepAlive(buf)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// parseNvmfDiscoveryLog parses the entries of the discovery log page, whose string fields are padded by spaces or
+// zeros.
+func parseNvmfDiscoveryLog(page []byte) ([]helpernvme.DiscoveryPageEntry, error) {
+	if len(page) < nvmfDiscoveryLogEntrySize {
+		return nil, fmt.Errorf("invalid discovery log page size %v", len(page))
+	}
+	numRec := binary.LittleEndian.Uint64(page[nvmfDiscoveryLogNumRecOffset:])
+	if available := uint64(len(page)/nvmfDiscoveryLogEntrySize - 1); numRec > available {
+		numRec = available
+	}
+
+	entries := []helpernvme.DiscoveryPageEntry{}
+	for i := uint64(1); i <= numRec; i++ {
+		entry := page[i*nvmfDiscoveryLogEntrySize : (i+1)*nvmfDiscoveryLogEntrySize]
+		entries = append(entries, helpernvme.DiscoveryPageEntry{
+			PortID:  binary.LittleEndian.Uint16(entry[4:]),
+			TrsvcID: trimNvmfString(entry[nvmfDiscoveryLogTrsvcIDOffset : nvmfDiscoveryLogTrsvcIDOffset+nvmfDiscoveryLogTrsvcIDSize]),
+			Subnqn:  trimNvmfString(entry[nvmfDiscoveryLogSubNQNOffset : nvmfDiscoveryLogSubNQNOffset+nvmfDiscoveryLogStringSize]),
+			Traddr:  trimNvmfString(entry[nvmfDiscoveryLogTraddrOffset : nvmfDiscoveryLogTraddrOffset+nvmfDiscoveryLogStringSize]),
+		})
+	}
+	return entries, nil
+}
+
+func trimNvmfString(field []byte) string {
+	if i := bytes.IndexByte(field, 0); i >= 0 {
+		field = field[:i]
+	}
+	return strings.TrimSpace(string(field))
+}
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"encoding/binary"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestNvmfConnectOptions(c *C) {
+	options := &NvmfConnectOptions{
+		Address:   "10.42.2.20",
+		ServiceID: "20001",
+		NQN:       "nqn.2023-01.io.longhorn.spdk:pvc-1-e-0",
+		HostNQN:   "nqn.2014-08.org.nvmexpress:uuid:1",
+	}
+	c.Assert(options.String(), Equals,
+		"nqn=nqn.2023-01.io.longhorn.spdk:pvc-1-e-0,transport=tcp,traddr=10.42.2.20,trsvcid=20001,hostnqn=nqn.2014-08.org.nvmexpress:uuid:1")
+}
+
+func (s *TestSuite) TestParseNvmfConnectResult(c *C) {
+	controller, err := parseNvmfConnectResult("instance=3,cntlid=1\n")
+	c.Assert(err, IsNil)
+	c.Assert(controller, Equals, "nvme3")
+
+	_, err = parseNvmfConnectResult("cntlid=1")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestParseNvmfControllerAddress(c *C) {
+	traddr, trsvcid := parseNvmfControllerAddress("traddr=10.42.2.20,trsvcid=20001,src_addr=10.42.2.21")
+	c.Assert(traddr, Equals, "10.42.2.20")
+	c.Assert(trsvcid, Equals, "20001")
+}
+
+func (s *TestSuite) TestParseNvmfDiscoveryLog(c *C) {
+	page := make([]byte, nvmfDiscoveryLogEntrySize*3)
+	binary.LittleEndian.PutUint64(page[nvmfDiscoveryLogNumRecOffset:], 2)
+	for i, record := range []struct {
+		trsvcid string
+		subnqn  string
+	}{
+		{"20001  ", "nqn.2023-01.io.longhorn.spdk:pvc-1-r-0"},
+		{"20011", "nqn.2023-01.io.longhorn.spdk:pvc-1-e-0"},
+	} {
+		entry := page[(i+1)*nvmfDiscoveryLogEntrySize:]
+		copy(entry[nvmfDiscoveryLogTrsvcIDOffset:], record.trsvcid)
+		copy(entry[nvmfDiscoveryLogSubNQNOffset:], record.subnqn)
+		copy(entry[nvmfDiscoveryLogTraddrOffset:], "10.42.2.20      ")
+	}
+
+	entries, err := parseNvmfDiscoveryLog(page)
+	c.Assert(err, IsNil)
+	c.Assert(entries, HasLen, 2)
+	c.Assert(entries[0].TrsvcID, Equals, "20001")
+	c.Assert(entries[0].Subnqn, Equals, "nqn.2023-01.io.longhorn.spdk:pvc-1-r-0")
+	c.Assert(entries[1].TrsvcID, Equals, "20011")
+	c.Assert(entries[1].Traddr, Equals, "10.42.2.20")
+
+	// The records beyond the page are ignored
+	binary.LittleEndian.PutUint64(page[nvmfDiscoveryLogNumRecOffset:], 5)
+	entries, err = parseNvmfDiscoveryLog(page)
+	c.Assert(err, IsNil)
+	c.Assert(entries, HasLen, 2)
+
+	_, err = parseNvmfDiscoveryLog(page[:16])
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestValidateNvmfInitiator(c *C) {
+	c.Assert(ValidateNvmfInitiator(NvmfInitiatorNvmeCLI), IsNil)
+	c.Assert(ValidateNvmfInitiator(NvmfInitiatorNative), IsNil)
+	c.Assert(ValidateNvmfInitiator("ioctl"), NotNil)
+}
@@ -0,0 +1,182 @@
+package util
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	icmpEchoHeaderSize = 8
+
+	icmpv4EchoRequest = 8
+	icmpv4EchoReply   = 0
+	icmpv6EchoRequest = 128
+	icmpv6EchoReply   = 129
+
+	ipv4HeaderSize = 20
+	ipv6HeaderSize = 40
+
+	maxIPPacketSize = 65535
+)
+
+// ErrPacketTooLarge is returned by Ping if the packet exceeds the MTU of the local interface with the fragmentation
+// prohibited.
+var ErrPacketTooLarge = errors.New("packet exceeds the local MTU")
+
+// GetPingPayloadSize returns the ICMP echo payload size filling a packet of the MTU to the IP.
+func GetPingPayloadSize(ip net.IP, mtu int) int {
+	// The MTU of the loopback interface exceeds the maximum IP packet size
+	if mtu > maxIPPacketSize {
+		mtu = maxIPPacketSize
+	}
+	if ip.To4() != nil {
+		return mtu - ipv4HeaderSize - icmpEchoHeaderSize
+	}
+	return mtu - ipv6HeaderSize - icmpEchoHeaderSize
+}
+
+// Ping sends an ICMP echo request with the payload size to the IP, with the fragmentation prohibited, and returns
+// the round trip time. A packet larger than the MTU of any link of the path is either dropped, which times out, or
+// rejected by ErrPacketTooLarge if it exceeds the local MTU. It requires CAP_NET_RAW.
+func Ping(ip net.IP, payloadSize int, timeout time.Duration) (time.Duration, error) {
+	family, proto, requestType, replyType := unix.AF_INET, unix.IPPROTO_ICMP, icmpv4EchoRequest, icmpv4EchoReply
+	if ip.To4() == nil {
+		family, proto, requestType, replyType = unix.AF_INET6, unix.IPPROTO_ICMPV6, icmpv6EchoRequest, icmpv6EchoReply
+	}
+
+	fd, err := unix.Socket(family, unix.SOCK_RAW|unix.SOCK_CLOEXEC, proto)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to open ICMP socket")
+	}
+	defer unix.Close(fd)
+
+	var sa unix.Sockaddr
+	if family == unix.AF_INET {
+		addr := &unix.SockaddrInet4{}
+		copy(addr.Addr[:], ip.To4())
+		sa = addr
+		err = unix.SetsockoptInt(fd, unix.IPPROTO_IP, unix.IP_MTU_DISCOVER, unix.IP_PMTUDISC_DO)
+	} else {
+		addr := &unix.SockaddrInet6{}
+		copy(addr.Addr[:], ip.To16())
+		sa = addr
+		err = unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_MTU_DISCOVER, unix.IPV6_PMTUDISC_DO)
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prohibit fragmentation")
+	}
+
+	id := uint16(rand.Intn(1 << 16))
+	seq := uint16(rand.Intn(1 << 16))
+	packet := make([]byte, icmpEchoHeaderSize+payloadSize)
+	packet[0] = byte(requestType)
+	binary.BigEndian.PutUint16(packet[4:], id)
+	binary.BigEndian.PutUint16(packet[6:], seq)
+	if family == unix.AF_INET {
+		// The checksum of ICMPv6 is filled in by the kernel
+		binary.BigEndian.PutUint16(packet[2:], icmpChecksum(packet))
+	}
+
+	start := time.Now()
+	deadline := start.Add(timeout)
+	if err := unix.Sendto(fd, packet, 0, sa); err != nil {
+		if err == unix.EMSGSIZE {
+			return 0, ErrPacketTooLarge
+		}
+		return 0, errors.Wrapf(err, "failed to send ICMP echo request to %v", ip)
+	}
+
+	buf := make([]byte, len(packet)+ipv6HeaderSize+64)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return 0, fmt.Errorf("timed out waiting for ICMP echo reply from %v", ip)
+		}
+		tv := unix.NsecToTimeval(remaining.Nanoseconds())
+		if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+			return 0, errors.Wrap(err, "failed to set ICMP socket timeout")
+		}
+
+		n, from, err := unix.Recvfrom(fd, buf, 0)
+		if err == unix.EAGAIN || err == unix.EINTR {
+			continue
+		} else if err != nil {
+			return 0, errors.Wrapf(err, "failed to receive ICMP echo reply from %v", ip)
+		}
+
+		reply := buf[:n]
+		if family == unix.AF_INET {
+			// The raw IPv4 socket receives the IP header as well
+			if n < 1 || n < int(reply[0]&0x0f)*4 {
+				continue
+			}
+			reply = reply[int(reply[0]&0x0f)*4:]
+		}
+		if len(reply) < icmpEchoHeaderSize || int(reply[0]) != replyType ||
+			binary.BigEndian.Uint16(reply[4:]) != id || binary.BigEndian.Uint16(reply[6:]) != seq {
+			continue
+		}
+		if !isSockaddrIP(from, ip) {
+			continue
+		}
+		return time.Since(start), nil
+	}
+}
+
+func isSockaddrIP(sa unix.Sockaddr, ip net.IP) bool {
+	switch addr := sa.(type) {
+	case *unix.SockaddrInet4:
+		return net.IP(addr.Addr[:]).Equal(ip)
+	case *unix.SockaddrInet6:
+		return net.IP(addr.Addr[:]).Equal(ip)
+	}
+	return false
+}
+
+func icmpChecksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
+
+// GetRouteInterface returns the local interface the traffic to the IP is routed through.
+func GetRouteInterface(ip net.IP) (*net.Interface, error) {
+	// Connecting a UDP socket only looks up the route without sending anything
+	conn, err := net.Dial("udp", net.JoinHostPort(ip.String(), "9"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find the route to %v", ip)
+	}
+	localIP := conn.LocalAddr().(*net.UDPAddr).IP
+	conn.Close()
+
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list interfaces")
+	}
+	for i := range ifaces {
+		addrs, err := ifaces[i].Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.Equal(localIP) {
+				return &ifaces[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("cannot find the interface of local IP %v", localIP)
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"net"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestPing(c *C) {
+	ip := net.ParseIP("127.0.0.1")
+
+	iface, err := GetRouteInterface(ip)
+	c.Assert(err, IsNil)
+	c.Assert(iface.Flags&net.FlagLoopback, Not(Equals), net.Flags(0))
+
+	if _, err := Ping(ip, 56, time.Second); err != nil {
+		c.Skip("ICMP is not permitted: " + err.Error())
+	}
+
+	_, err = Ping(ip, GetPingPayloadSize(ip, iface.MTU), time.Second)
+	c.Assert(err, IsNil)
+
+	_, err = Ping(ip, GetPingPayloadSize(ip, iface.MTU)+1, time.Second)
+	c.Assert(err, Equals, ErrPacketTooLarge)
+}
+
+func (s *TestSuite) TestICMPChecksum(c *C) {
+	packet := []byte{8, 0, 0, 0, 0x12, 0x34, 0x00, 0x01, 'a', 'b', 'c'}
+	sum := icmpChecksum(packet)
+	packet[2], packet[3] = byte(sum>>8), byte(sum)
+	c.Assert(icmpChecksum(packet), Equals, uint16(0))
+}
@@ -0,0 +1,111 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// PriorityClassCritical is for the instances the volumes cannot survive without, e.g. the engines
+	PriorityClassCritical = "critical"
+	PriorityClassHigh     = "high"
+	// PriorityClassNormal leaves the process as it is started, i.e. with the OOM score adjustment and nice value
+	// inherited from the instance manager
+	PriorityClassNormal = "normal"
+)
+
+// ProcessPriority is the OOM score adjustment and nice value a process of a priority class runs with.
+type ProcessPriority struct {
+	OOMScoreAdj int
+	Nice        int
+}
+
+var processPriorities = map[string]ProcessPriority{
+	PriorityClassCritical: {OOMScoreAdj: -900, Nice: -10},
+	PriorityClassHigh:     {OOMScoreAdj: -500, Nice: -5},
+}
+
+var priorityClassRanks = map[string]int{
+	"":                    0,
+	PriorityClassNormal:   0,
+	PriorityClassHigh:     1,
+	PriorityClassCritical: 2,
+}
+
+func ValidatePriorityClass(class string) error {
+	if _, ok := priorityClassRanks[class]; !ok {
+		return fmt.Errorf("unknown priority class %v", class)
+	}
+	return nil
+}
+
+// PriorityClassToProcessPriority returns the priority the processes of the class run with, or false if the class
+// leaves them as they are.
+func PriorityClassToProcessPriority(class string) (ProcessPriority, bool) {
+	priority, ok := processPriorities[class]
+	return priority, ok
+}
+
+// PriorityClassRank orders the priority classes from normal to critical. An empty class ranks as normal.
+func PriorityClassRank(class string) int {
+	return priorityClassRanks[class]
+}
+
+// SetProcessPriorityClass sets the OOM score adjustment and the nice value of the class for the process. The nice
+// value is set for every thread of the process, since it is per thread on Linux. The threads started afterwards
+// inherit it from the ones starting them.
+func SetProcessPriorityClass(pid int, class string) error {
+	priority, ok := PriorityClassToProcessPriority(class)
+	if !ok {
+		return nil
+	}
+	return SetProcessPriority(pid, priority)
+}
+
+func SetProcessPriority(pid int, priority ProcessPriority) error {
+	oomScoreAdjPath := filepath.Join(procDirectory, strconv.Itoa(pid), "oom_score_adj")
+	if err := os.WriteFile(oomScoreAdjPath, []byte(strconv.Itoa(priority.OOMScoreAdj)), 0644); err != nil {
+		return errors.Wrapf(err, "failed to set the OOM score adjustment of process %v", pid)
+	}
+
+	tasks, err := os.ReadDir(filepath.Join(procDirectory, strconv.Itoa(pid), "task"))
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the threads of process %v", pid)
+	}
+	for _, task := range tasks {
+		tid, err := strconv.Atoi(task.Name())
+		if err != nil {
+			continue
+		}
+		// The thread may exit in the meantime
+		if err := unix.Setpriority(unix.PRIO_PROCESS, tid, priority.Nice); err != nil && err != unix.ESRCH {
+			return errors.Wrapf(err, "failed to set the nice value of thread %v of process %v", tid, pid)
+		}
+	}
+	return nil
+}
+
+// GetProcessPriority returns the OOM score adjustment and the nice value of the main thread of the process.
+func GetProcessPriority(pid int) (ProcessPriority, error) {
+	oomScoreAdjPath := filepath.Join(procDirectory, strconv.Itoa(pid), "oom_score_adj")
+	content, err := os.ReadFile(oomScoreAdjPath)
+	if err != nil {
+		return ProcessPriority{}, errors.Wrapf(err, "failed to get the OOM score adjustment of process %v", pid)
+	}
+	oomScoreAdj, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return ProcessPriority{}, errors.Wrapf(err, "invalid OOM score adjustment of process %v", pid)
+	}
+	// The getpriority syscall returns 20 - nice, which unix.Getpriority does not convert back
+	prio, err := unix.Getpriority(unix.PRIO_PROCESS, pid)
+	if err != nil {
+		return ProcessPriority{}, errors.Wrapf(err, "failed to get the nice value of process %v", pid)
+	}
+	return ProcessPriority{OOMScoreAdj: oomScoreAdj, Nice: 20 - prio}, nil
+}
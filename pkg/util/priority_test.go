@@ -0,0 +1,30 @@
+package util
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestValidatePriorityClass(c *C) {
+	for _, class := range []string{"", PriorityClassNormal, PriorityClassHigh, PriorityClassCritical} {
+		c.Assert(ValidatePriorityClass(class), IsNil)
+	}
+	c.Assert(ValidatePriorityClass("low"), NotNil)
+}
+
+func (s *TestSuite) TestPriorityClassToProcessPriority(c *C) {
+	_, ok := PriorityClassToProcessPriority("")
+	c.Assert(ok, Equals, false)
+	_, ok = PriorityClassToProcessPriority(PriorityClassNormal)
+	c.Assert(ok, Equals, false)
+
+	high, ok := PriorityClassToProcessPriority(PriorityClassHigh)
+	c.Assert(ok, Equals, true)
+	critical, ok := PriorityClassToProcessPriority(PriorityClassCritical)
+	c.Assert(ok, Equals, true)
+	c.Assert(critical.OOMScoreAdj < high.OOMScoreAdj, Equals, true)
+	c.Assert(critical.Nice < high.Nice, Equals, true)
+
+	c.Assert(PriorityClassRank(""), Equals, PriorityClassRank(PriorityClassNormal))
+	c.Assert(PriorityClassRank(PriorityClassHigh) > PriorityClassRank(PriorityClassNormal), Equals, true)
+	c.Assert(PriorityClassRank(PriorityClassCritical) > PriorityClassRank(PriorityClassHigh), Equals, true)
+}
@@ -0,0 +1,152 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	procDirectory = "/proc"
+
+	tcpStateListen = "0A"
+)
+
+func getSocketInodes(pid int) (map[string]struct{}, error) {
+	fdDir := filepath.Join(procDirectory, strconv.Itoa(pid), "fd")
+	entries, err := os.ReadDir(fdDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", fdDir)
+	}
+
+	inodes := map[string]struct{}{}
+	for _, entry := range entries {
+		// The fd may be closed in the meantime, so ignore the failures
+		link, err := os.Readlink(filepath.Join(fdDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		// e.g. "socket:[123456]"
+		if strings.HasPrefix(link, "socket:[") && strings.HasSuffix(link, "]") {
+			inodes[strings.TrimSuffix(strings.TrimPrefix(link, "socket:["), "]")] = struct{}{}
+		}
+	}
+	return inodes, nil
+}
+
+// ListeningSockets are the TCP sockets listening in the network namespace along with the processes holding them.
+type ListeningSockets struct {
+	// Pids are the processes holding the sockets listening on each port
+	Pids map[int32][]int
+	// Parents are the parent pids of the processes, so that a socket held by a child can be traced to the ancestor
+	Parents map[int]int
+}
+
+// GetListeningSockets returns the TCP sockets listening on the ports in the range in the network namespace, found by
+// matching the LISTEN entries of /proc/net/tcp and /proc/net/tcp6 against the socket inodes of /proc/<pid>/fd of all
+// the processes. A socket whose holder cannot be found, e.g. since the process exits in the meantime, is returned
+// without any pid.
+func GetListeningSockets(portMin, portMax int32) (*ListeningSockets, error) {
+	sockets := &ListeningSockets{
+		Pids:    map[int32][]int{},
+		Parents: map[int]int{},
+	}
+
+	inodePorts := map[string]int32{}
+	for _, table := range []string{"tcp", "tcp6"} {
+		path := filepath.Join(procDirectory, "net", table)
+		tableSockets, err := parseListeningSockets(path)
+		if err != nil {
+			if os.IsNotExist(errors.Cause(err)) {
+				continue
+			}
+			return nil, err
+		}
+		for inode, port := range tableSockets {
+			if port < portMin || port > portMax {
+				continue
+			}
+			inodePorts[inode] = port
+			if _, exists := sockets.Pids[port]; !exists {
+				sockets.Pids[port] = []int{}
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(procDirectory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", procDirectory)
+	}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// The process may exit in the meantime, so ignore the failures
+		stat, err := readProcStat(pid)
+		if err != nil {
+			continue
+		}
+		sockets.Parents[pid] = stat.ppid
+		if len(inodePorts) == 0 {
+			continue
+		}
+		inodes, err := getSocketInodes(pid)
+		if err != nil {
+			continue
+		}
+		ports := map[int32]struct{}{}
+		for inode := range inodes {
+			if port, exists := inodePorts[inode]; exists {
+				ports[port] = struct{}{}
+			}
+		}
+		for port := range ports {
+			sockets.Pids[port] = append(sockets.Pids[port], pid)
+		}
+	}
+	for _, pids := range sockets.Pids {
+		sort.Ints(pids)
+	}
+	return sockets, nil
+}
+
+// parseListeningSockets parses the listening sockets of a /proc/net/tcp table into their ports by the inodes, e.g.
+// "0: 00000000:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000 0 0 123456 1 ...".
+func parseListeningSockets(path string) (map[string]int32, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %v", path)
+	}
+	defer f.Close()
+
+	sockets := map[string]int32{}
+	scanner := bufio.NewScanner(f)
+	// Skip the header
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[3] != tcpStateListen {
+			continue
+		}
+		localAddress := strings.Split(fields[1], ":")
+		if len(localAddress) != 2 {
+			continue
+		}
+		port, err := strconv.ParseInt(localAddress[1], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse local address %v in %v", fields[1], path)
+		}
+		sockets[fields[9]] = int32(port)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", path)
+	}
+	return sockets, nil
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestParseListeningSockets(c *C) {
+	path := filepath.Join(c.MkDir(), "tcp")
+	content := "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n" +
+		"   0: 00000000:2710 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 123456 1 0000000000000000 100 0 0 10 0\n" +
+		"   1: 0100007F:2711 0100007F:9C40 01 00000000:00000000 00:00000000 00000000     0        0 123457 1 0000000000000000 20 4 30 10 -1\n" +
+		"   2: 0100007F:2712 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 123458 1 0000000000000000 100 0 0 10 0\n"
+	c.Assert(os.WriteFile(path, []byte(content), 0644), IsNil)
+
+	sockets, err := parseListeningSockets(path)
+	c.Assert(err, IsNil)
+	c.Assert(sockets, DeepEquals, map[string]int32{
+		"123456": 10000,
+		"123458": 10002,
+	})
+
+	_, err = parseListeningSockets(filepath.Join(c.MkDir(), "missing"))
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestGetListeningSockets(c *C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer l.Close()
+	port := int32(l.Addr().(*net.TCPAddr).Port)
+
+	sockets, err := GetListeningSockets(port, port)
+	c.Assert(err, IsNil)
+	c.Assert(sockets.Pids, DeepEquals, map[int32][]int{port: {os.Getpid()}})
+	c.Assert(sockets.Parents[os.Getpid()], Equals, os.Getppid())
+}
@@ -0,0 +1,181 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// procClockTicks is USER_HZ, the unit of the times in /proc/<pid>/stat, which is 100 on all the supported
+	// architectures
+	procClockTicks = 100
+)
+
+// ProcessResourceUsage is the resource usage of a process gathered from /proc.
+type ProcessResourceUsage struct {
+	CPUTime  time.Duration
+	RSSBytes uint64
+	OpenFDs  int
+	Uptime   time.Duration
+}
+
+// GetProcessResourceUsage returns the resource usage of the process from /proc/<pid>/stat and /proc/<pid>/fd.
+func GetProcessResourceUsage(pid int) (*ProcessResourceUsage, error) {
+	stat, err := readProcStat(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	uptimePath := filepath.Join(procDirectory, "uptime")
+	content, err := os.ReadFile(uptimePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", uptimePath)
+	}
+	systemUptime, err := parseProcUptime(string(content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %v", uptimePath)
+	}
+
+	fdDir := filepath.Join(procDirectory, strconv.Itoa(pid), "fd")
+	fds, err := os.ReadDir(fdDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", fdDir)
+	}
+
+	usage := &ProcessResourceUsage{
+		CPUTime:  ticksToDuration(stat.utime + stat.stime),
+		RSSBytes: stat.rssPages * uint64(os.Getpagesize()),
+		OpenFDs:  len(fds),
+	}
+	if startTime := ticksToDuration(stat.startTime); systemUptime > startTime {
+		usage.Uptime = systemUptime - startTime
+	}
+	return usage, nil
+}
+
+// FindProcessByName returns the pid of the first process whose command name is name, or 0 if there is none.
+func FindProcessByName(name string) (int, error) {
+	entries, err := os.ReadDir(procDirectory)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read %v", procDirectory)
+	}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// The process may exit in the meantime, so ignore the failures
+		comm, err := os.ReadFile(filepath.Join(procDirectory, entry.Name(), "comm"))
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(string(comm)) == name {
+			return pid, nil
+		}
+	}
+	return 0, nil
+}
+
+// GetProcessStartTime returns the start time of the process in clock ticks since the boot, which tells the process
+// apart from a later one reusing its pid.
+func GetProcessStartTime(pid int) (uint64, error) {
+	stat, err := readProcStat(pid)
+	if err != nil {
+		return 0, err
+	}
+	return stat.startTime, nil
+}
+
+// IsProcessRunning returns true if the process of the pid started at the start time is still running, rather than
+// exited, left as a zombie or replaced by a later process reusing the pid.
+func IsProcessRunning(pid int, startTime uint64) bool {
+	stat, err := readProcStat(pid)
+	if err != nil {
+		return false
+	}
+	return stat.startTime == startTime && stat.state != "Z" && stat.state != "X"
+}
+
+func readProcStat(pid int) (*procStat, error) {
+	statPath := filepath.Join(procDirectory, strconv.Itoa(pid), "stat")
+	content, err := os.ReadFile(statPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", statPath)
+	}
+	stat, err := parseProcStat(string(content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %v", statPath)
+	}
+	return stat, nil
+}
+
+type procStat struct {
+	state     string
+	ppid      int
+	utime     uint64
+	stime     uint64
+	startTime uint64
+	rssPages  uint64
+}
+
+// parseProcStat parses /proc/<pid>/stat, e.g. "1234 (longhorn) S 1 1234 1234 0 -1 4194560 ...". The command name
+// may contain spaces and parentheses, so the fields are counted from the last closing parenthesis.
+func parseProcStat(content string) (*procStat, error) {
+	end := strings.LastIndex(content, ")")
+	if end < 0 {
+		return nil, fmt.Errorf("missing command name in %q", content)
+	}
+	// fields[0] is the state, the 3rd field of the stat
+	fields := strings.Fields(content[end+1:])
+	if len(fields) < 22 {
+		return nil, fmt.Errorf("expected at least 24 fields rather than %v", len(fields)+2)
+	}
+
+	stat := &procStat{
+		state: fields[0],
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid field 4")
+	}
+	stat.ppid = ppid
+	for _, field := range []struct {
+		index int
+		value *uint64
+	}{
+		{11, &stat.utime},
+		{12, &stat.stime},
+		{19, &stat.startTime},
+		{21, &stat.rssPages},
+	} {
+		value, err := strconv.ParseUint(fields[field.index], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid field %v", field.index+3)
+		}
+		*field.value = value
+	}
+	return stat, nil
+}
+
+// parseProcUptime parses /proc/uptime, e.g. "350735.47 234388.90".
+func parseProcUptime(content string) (time.Duration, error) {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty uptime")
+	}
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func ticksToDuration(ticks uint64) time.Duration {
+	return time.Duration(ticks) * time.Second / procClockTicks
+}
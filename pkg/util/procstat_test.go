@@ -0,0 +1,54 @@
+package util
+
+import (
+	"os"
+	"os/exec"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestParseProcStat(c *C) {
+	content := "1234 (longhorn (v1) x) S 1 1234 1234 0 -1 4194560 1520 0 0 0 250 130 0 0 20 0 12 0 5000 745172992 3072 18446744073709551615 1 1 0 0 0 0 0 0 0 0 0 0 17 3 0 0 0 0 0"
+	stat, err := parseProcStat(content)
+	c.Assert(err, IsNil)
+	c.Assert(stat.state, Equals, "S")
+	c.Assert(stat.ppid, Equals, 1)
+	c.Assert(stat.utime, Equals, uint64(250))
+	c.Assert(stat.stime, Equals, uint64(130))
+	c.Assert(stat.startTime, Equals, uint64(5000))
+	c.Assert(stat.rssPages, Equals, uint64(3072))
+	c.Assert(ticksToDuration(stat.utime+stat.stime), Equals, 3800*time.Millisecond)
+
+	_, err = parseProcStat("1234 (longhorn) S 1 2 3")
+	c.Assert(err, NotNil)
+	_, err = parseProcStat("1234 longhorn")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestParseProcUptime(c *C) {
+	uptime, err := parseProcUptime("350735.47 234388.90\n")
+	c.Assert(err, IsNil)
+	c.Assert(uptime, Equals, 350735470*time.Millisecond)
+
+	_, err = parseProcUptime("")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestGetProcessResourceUsage(c *C) {
+	usage, err := GetProcessResourceUsage(os.Getpid())
+	c.Assert(err, IsNil)
+	c.Assert(usage.RSSBytes > 0, Equals, true)
+	c.Assert(usage.OpenFDs > 0, Equals, true)
+}
+
+func (s *TestSuite) TestIsProcessRunning(c *C) {
+	startTime, err := GetProcessStartTime(os.Getpid())
+	c.Assert(err, IsNil)
+	c.Assert(IsProcessRunning(os.Getpid(), startTime), Equals, true)
+	c.Assert(IsProcessRunning(os.Getpid(), startTime+1), Equals, false)
+
+	cmd := exec.Command("true")
+	c.Assert(cmd.Run(), IsNil)
+	c.Assert(IsProcessRunning(cmd.Process.Pid, 0), Equals, false)
+}
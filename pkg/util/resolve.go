@@ -0,0 +1,113 @@
+package util
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	addressResolveTimeout    = 5 * time.Second
+	addressResolveRetryCount = 3
+	addressResolveRetryDelay = time.Second
+)
+
+// ResolveAddress resolves the host of the address <host>:<port> to an IP, preferring IPv4, since the SPDK NVMe/TCP
+// transport connects to IPs only. An address with an IP is returned as it is. Failed lookups are retried, so that a
+// service name whose endpoint is being rescheduled has a chance to be resolved.
+func ResolveAddress(ctx context.Context, address string) (string, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid address %v", address)
+	}
+	if net.ParseIP(host) != nil {
+		return address, nil
+	}
+
+	for i := 0; ; i++ {
+		ip, err := lookupHost(ctx, host)
+		if err == nil {
+			return net.JoinHostPort(ip, port), nil
+		}
+		if i >= addressResolveRetryCount-1 {
+			return "", errors.Wrapf(err, "failed to resolve address %v", address)
+		}
+		select {
+		case <-ctx.Done():
+			return "", errors.Wrapf(ctx.Err(), "failed to resolve address %v", address)
+		case <-time.After(addressResolveRetryDelay):
+		}
+	}
+}
+
+func lookupHost(ctx context.Context, host string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, addressResolveTimeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	if len(addrs) == 0 {
+		return "", errors.Errorf("no address found for host %v", host)
+	}
+	// The order of the addresses is not stable, so sort them to resolve a host with multiple records consistently
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil && ip.To4() != nil {
+			return addr, nil
+		}
+	}
+	return addrs[0], nil
+}
+
+// ResolveAddressMap resolves the addresses of the map concurrently, e.g. the replica address map of an engine.
+func ResolveAddressMap(ctx context.Context, addressMap map[string]string) (map[string]string, error) {
+	lock := &sync.Mutex{}
+	resolved := map[string]string{}
+	errs := map[string]error{}
+
+	wg := &sync.WaitGroup{}
+	for name, address := range addressMap {
+		wg.Add(1)
+		go func(name, address string) {
+			defer wg.Done()
+
+			ret, err := ResolveAddress(ctx, address)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs[name] = err
+				return
+			}
+			resolved[name] = ret
+		}(name, address)
+	}
+	wg.Wait()
+
+	if len(errs) != 0 {
+		names := make([]string, 0, len(errs))
+		for name := range errs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, errors.Wrapf(errs[names[0]], "failed to resolve the address of %v", names[0])
+	}
+	return resolved, nil
+}
+
+// IsAddressMapResolved returns true if all addresses of the map are IPs.
+func IsAddressMapResolved(addressMap map[string]string) bool {
+	for _, address := range addressMap {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil || net.ParseIP(host) == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"context"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestResolveAddress(c *C) {
+	address, err := ResolveAddress(context.Background(), "10.42.0.5:20001")
+	c.Assert(err, IsNil)
+	c.Assert(address, Equals, "10.42.0.5:20001")
+
+	address, err = ResolveAddress(context.Background(), "[fd00::5]:20001")
+	c.Assert(err, IsNil)
+	c.Assert(address, Equals, "[fd00::5]:20001")
+
+	address, err = ResolveAddress(context.Background(), "localhost:20001")
+	c.Assert(err, IsNil)
+	c.Assert(address == "127.0.0.1:20001" || address == "[::1]:20001", Equals, true, Commentf("address %v", address))
+
+	_, err = ResolveAddress(context.Background(), "10.42.0.5")
+	c.Assert(err, NotNil)
+
+	addressMap := map[string]string{
+		"r1": "10.42.0.5:20001",
+		"r2": "localhost:20002",
+	}
+	c.Assert(IsAddressMapResolved(addressMap), Equals, false)
+	resolved, err := ResolveAddressMap(context.Background(), addressMap)
+	c.Assert(err, IsNil)
+	c.Assert(resolved, HasLen, 2)
+	c.Assert(resolved["r1"], Equals, "10.42.0.5:20001")
+	c.Assert(IsAddressMapResolved(resolved), Equals, true)
+
+	_, err = ResolveAddressMap(context.Background(), map[string]string{"r1": "10.42.0.5"})
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,159 @@
+package util
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// sockDiagByFamily is SOCK_DIAG_BY_FAMILY of linux/sock_diag.h
+	sockDiagByFamily = 20
+	// inetDiagInfo is INET_DIAG_INFO of linux/inet_diag.h, whose payload is a struct tcp_info
+	inetDiagInfo = 2
+
+	// The sizes of struct inet_diag_req_v2 and struct inet_diag_msg
+	sizeofInetDiagReqV2 = 56
+	sizeofInetDiagMsg   = 72
+
+	// The offsets of tcpi_bytes_acked and tcpi_bytes_received in struct tcp_info
+	tcpInfoBytesAckedOffset    = 120
+	tcpInfoBytesReceivedOffset = 128
+
+	// tcpStateListenBit is the TCP_LISTEN bit of the state filter
+	tcpStateListenBit = 1 << 10
+	tcpStatesAll      = 0xfff
+
+	sockDiagReceiveBufferSize = 64 << 10
+)
+
+// TCPSocketTraffic is the traffic of a TCP socket since it was opened.
+type TCPSocketTraffic struct {
+	// Cookie identifies the socket for its lifetime
+	Cookie        uint64
+	LocalPort     int32
+	RemotePort    int32
+	BytesSent     uint64
+	BytesReceived uint64
+}
+
+// GetTCPSocketTraffic returns the traffic of the connected TCP sockets in the network namespace, queried via the
+// sock_diag netlink interface.
+func GetTCPSocketTraffic() ([]*TCPSocketTraffic, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_SOCK_DIAG)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open sock_diag netlink socket")
+	}
+	defer unix.Close(fd)
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return nil, errors.Wrap(err, "failed to bind sock_diag netlink socket")
+	}
+
+	sockets := []*TCPSocketTraffic{}
+	for seq, family := range []uint8{unix.AF_INET, unix.AF_INET6} {
+		familySockets, err := dumpTCPSockets(fd, uint32(seq+1), family)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to dump TCP sockets of address family %v", family)
+		}
+		sockets = append(sockets, familySockets...)
+	}
+	return sockets, nil
+}
+
+func dumpTCPSockets(fd int, seq uint32, family uint8) ([]*TCPSocketTraffic, error) {
+	req := make([]byte, unix.SizeofNlMsghdr+sizeofInetDiagReqV2)
+	binary.NativeEndian.PutUint32(req[0:4], uint32(len(req)))
+	binary.NativeEndian.PutUint16(req[4:6], sockDiagByFamily)
+	binary.NativeEndian.PutUint16(req[6:8], unix.NLM_F_REQUEST|unix.NLM_F_DUMP)
+	binary.NativeEndian.PutUint32(req[8:12], seq)
+	diagReq := req[unix.SizeofNlMsghdr:]
+	diagReq[0] = family
+	diagReq[1] = unix.IPPROTO_TCP
+	diagReq[2] = 1 << (inetDiagInfo - 1)
+	// The listening sockets don't carry any traffic
+	binary.NativeEndian.PutUint32(diagReq[4:8], tcpStatesAll&^tcpStateListenBit)
+
+	if err := unix.Sendto(fd, req, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return nil, err
+	}
+
+	sockets := []*TCPSocketTraffic{}
+	buf := make([]byte, sockDiagReceiveBufferSize)
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		data := buf[:n]
+		for len(data) >= unix.SizeofNlMsghdr {
+			msgLen := int(binary.NativeEndian.Uint32(data[0:4]))
+			msgType := binary.NativeEndian.Uint16(data[4:6])
+			msgSeq := binary.NativeEndian.Uint32(data[8:12])
+			if msgLen < unix.SizeofNlMsghdr || msgLen > len(data) {
+				return nil, fmt.Errorf("invalid netlink message length %v", msgLen)
+			}
+			payload := data[unix.SizeofNlMsghdr:msgLen]
+			data = data[min(nlmsgAlign(msgLen), len(data)):]
+
+			if msgSeq != seq {
+				continue
+			}
+			switch msgType {
+			case unix.NLMSG_DONE:
+				return sockets, nil
+			case unix.NLMSG_ERROR:
+				if len(payload) >= 4 {
+					if errno := int32(binary.NativeEndian.Uint32(payload[0:4])); errno != 0 {
+						return nil, unix.Errno(-errno)
+					}
+				}
+				return sockets, nil
+			case sockDiagByFamily:
+				if socket := parseInetDiagMsg(payload); socket != nil {
+					sockets = append(sockets, socket)
+				}
+			}
+		}
+	}
+}
+
+// parseInetDiagMsg parses a struct inet_diag_msg followed by its attributes. It returns nil if there is no
+// tcp_info attribute with the byte counters, which requires Linux 4.2 or later.
+func parseInetDiagMsg(payload []byte) *TCPSocketTraffic {
+	if len(payload) < sizeofInetDiagMsg {
+		return nil
+	}
+	// The ports of struct inet_diag_sockid are in network byte order
+	socket := &TCPSocketTraffic{
+		LocalPort:  int32(binary.BigEndian.Uint16(payload[4:6])),
+		RemotePort: int32(binary.BigEndian.Uint16(payload[6:8])),
+		Cookie:     uint64(binary.NativeEndian.Uint32(payload[44:48])) | uint64(binary.NativeEndian.Uint32(payload[48:52]))<<32,
+	}
+
+	attrs := payload[sizeofInetDiagMsg:]
+	for len(attrs) >= unix.SizeofRtAttr {
+		attrLen := int(binary.NativeEndian.Uint16(attrs[0:2]))
+		attrType := binary.NativeEndian.Uint16(attrs[2:4])
+		if attrLen < unix.SizeofRtAttr || attrLen > len(attrs) {
+			return nil
+		}
+		value := attrs[unix.SizeofRtAttr:attrLen]
+		attrs = attrs[min(nlmsgAlign(attrLen), len(attrs)):]
+
+		if attrType != inetDiagInfo || len(value) < tcpInfoBytesReceivedOffset+8 {
+			continue
+		}
+		socket.BytesSent = binary.NativeEndian.Uint64(value[tcpInfoBytesAckedOffset:])
+		socket.BytesReceived = binary.NativeEndian.Uint64(value[tcpInfoBytesReceivedOffset:])
+		return socket
+	}
+	return nil
+}
+
+func nlmsgAlign(length int) int {
+	return (length + unix.NLMSG_ALIGNTO - 1) &^ (unix.NLMSG_ALIGNTO - 1)
+}
@@ -0,0 +1,108 @@
+package util
+
+import (
+	"encoding/binary"
+
+	. "gopkg.in/check.v1"
+)
+
+// newInetDiagMsg returns the fixture of a struct inet_diag_msg of the ports and the cookie, laid out as in
+// linux/inet_diag.h, followed by the attributes.
+func newInetDiagMsg(localPort, remotePort uint16, cookie uint64, attrs ...[]byte) []byte {
+	msg := make([]byte, 72)
+	msg[0] = 2 // AF_INET
+	msg[1] = 1 // TCP_ESTABLISHED
+	binary.BigEndian.PutUint16(msg[4:6], localPort)
+	binary.BigEndian.PutUint16(msg[6:8], remotePort)
+	binary.NativeEndian.PutUint32(msg[44:48], uint32(cookie))
+	binary.NativeEndian.PutUint32(msg[48:52], uint32(cookie>>32))
+	for _, attr := range attrs {
+		msg = append(msg, attr...)
+	}
+	return msg
+}
+
+// newRtAttr returns the fixture of a struct rtattr with the value, padded to the alignment. The length in the header
+// is overridden if attrLen is not 0.
+func newRtAttr(attrType uint16, value []byte, attrLen int) []byte {
+	if attrLen == 0 {
+		attrLen = 4 + len(value)
+	}
+	attr := make([]byte, 4, nlmsgAlign(4+len(value)))
+	binary.NativeEndian.PutUint16(attr[0:2], uint16(attrLen))
+	binary.NativeEndian.PutUint16(attr[2:4], attrType)
+	attr = append(attr, value...)
+	return attr[:cap(attr)]
+}
+
+// newTCPInfo returns the fixture of a struct tcp_info of the size with tcpi_bytes_acked and tcpi_bytes_received set,
+// if the size covers them.
+func newTCPInfo(size int, bytesAcked, bytesReceived uint64) []byte {
+	info := make([]byte, size)
+	if size >= 136 {
+		binary.NativeEndian.PutUint64(info[120:128], bytesAcked)
+		binary.NativeEndian.PutUint64(info[128:136], bytesReceived)
+	}
+	return info
+}
+
+func (s *TestSuite) TestParseInetDiagMsg(c *C) {
+	// INET_DIAG_MEMINFO, which is not the tcp_info
+	memInfo := newRtAttr(1, make([]byte, 16), 0)
+	tcpInfo := newRtAttr(inetDiagInfo, newTCPInfo(232, 4096, 8192), 0)
+
+	testCases := []struct {
+		comment string
+		payload []byte
+		traffic *TCPSocketTraffic
+	}{
+		{
+			"tcp_info of a recent kernel",
+			newInetDiagMsg(10000, 45678, 0x1122334455667788, tcpInfo),
+			&TCPSocketTraffic{Cookie: 0x1122334455667788, LocalPort: 10000, RemotePort: 45678, BytesSent: 4096, BytesReceived: 8192},
+		},
+		{
+			"tcp_info after another attribute",
+			newInetDiagMsg(10001, 3260, 1, memInfo, tcpInfo),
+			&TCPSocketTraffic{Cookie: 1, LocalPort: 10001, RemotePort: 3260, BytesSent: 4096, BytesReceived: 8192},
+		},
+		{
+			"tcp_info with an unaligned length",
+			newInetDiagMsg(10002, 3260, 2, newRtAttr(inetDiagInfo, newTCPInfo(137, 1, 2), 0)),
+			&TCPSocketTraffic{Cookie: 2, LocalPort: 10002, RemotePort: 3260, BytesSent: 1, BytesReceived: 2},
+		},
+		{
+			"missing INET_DIAG_INFO",
+			newInetDiagMsg(10000, 45678, 1, memInfo),
+			nil,
+		},
+		{
+			"no attribute",
+			newInetDiagMsg(10000, 45678, 1),
+			nil,
+		},
+		{
+			"tcp_info without the byte counters of a kernel older than 4.2",
+			newInetDiagMsg(10000, 45678, 1, newRtAttr(inetDiagInfo, newTCPInfo(104, 0, 0), 0)),
+			nil,
+		},
+		{
+			"truncated attribute",
+			newInetDiagMsg(10000, 45678, 1, newRtAttr(inetDiagInfo, newTCPInfo(136, 1, 2), 4+232)),
+			nil,
+		},
+		{
+			"attribute shorter than its header",
+			newInetDiagMsg(10000, 45678, 1, newRtAttr(inetDiagInfo, make([]byte, 4), 2), tcpInfo),
+			nil,
+		},
+		{
+			"truncated inet_diag_msg",
+			newInetDiagMsg(10000, 45678, 1)[:71],
+			nil,
+		},
+	}
+	for i, testCase := range testCases {
+		c.Assert(parseInetDiagMsg(testCase.payload), DeepEquals, testCase.traffic, Commentf("test case %v: %v", i, testCase.comment))
+	}
+}
@@ -0,0 +1,114 @@
+package util
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// SourceFilter rejects the gRPC requests not from the allowed source CIDRs, as a defense in depth for the clusters
+// without the NetworkPolicy support on the storage network. The requests from the loopback addresses or via Unix
+// domain sockets are always allowed, since they come from within the instance manager pod.
+type SourceFilter struct {
+	cidrs []*net.IPNet
+}
+
+// NewSourceFilter returns nil, which allows all requests, if no CIDR is specified.
+func NewSourceFilter(cidrs []string) (*SourceFilter, error) {
+	f := &SourceFilter{}
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if !strings.Contains(cidr, "/") {
+			// A single IP
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid source CIDR %v", cidr)
+		}
+		f.cidrs = append(f.cidrs, ipNet)
+	}
+	if len(f.cidrs) == 0 {
+		return nil, nil
+	}
+	return f, nil
+}
+
+// ServerOptions returns the options enforcing the filter on a gRPC server.
+func (f *SourceFilter) ServerOptions() []grpc.ServerOption {
+	if f == nil {
+		return nil
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(f.unaryServerInterceptor),
+		grpc.ChainStreamInterceptor(f.streamServerInterceptor),
+	}
+}
+
+func (f *SourceFilter) unaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := f.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (f *SourceFilter) streamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := f.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (f *SourceFilter) check(ctx context.Context, fullMethod string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "cannot get the source address of the request")
+	}
+	if !f.isAllowed(p.Addr) {
+		logrus.Warnf("Rejected request %v from %v not in the allowed source CIDRs", fullMethod, p.Addr)
+		return status.Errorf(codes.PermissionDenied, "source address %v is not allowed", p.Addr)
+	}
+	return nil
+}
+
+func (f *SourceFilter) isAllowed(addr net.Addr) bool {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.UnixAddr:
+		return true
+	case *net.TCPAddr:
+		ip = a.IP
+	default:
+		host, _, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			return false
+		}
+		if ip = net.ParseIP(host); ip == nil {
+			return false
+		}
+	}
+
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, cidr := range f.cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
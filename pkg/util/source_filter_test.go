@@ -0,0 +1,36 @@
+package util
+
+import (
+	"net"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestSourceFilter(c *C) {
+	f, err := NewSourceFilter(nil)
+	c.Assert(err, IsNil)
+	c.Assert(f, IsNil)
+	c.Assert(f.ServerOptions(), IsNil)
+
+	_, err = NewSourceFilter([]string{"10.0.0.0/33"})
+	c.Assert(err, NotNil)
+
+	f, err = NewSourceFilter([]string{"10.42.0.0/16", " 192.168.1.10 ", "fd00::/8"})
+	c.Assert(err, IsNil)
+	c.Assert(f, NotNil)
+
+	for addr, allowed := range map[net.Addr]bool{
+		&net.TCPAddr{IP: net.ParseIP("10.42.3.4"), Port: 8500}:    true,
+		&net.TCPAddr{IP: net.ParseIP("10.43.3.4"), Port: 8500}:    false,
+		&net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 8500}: true,
+		&net.TCPAddr{IP: net.ParseIP("192.168.1.11"), Port: 8500}: false,
+		&net.TCPAddr{IP: net.ParseIP("fd00::1"), Port: 8500}:      true,
+		&net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8500}:    true,
+		&net.TCPAddr{IP: net.ParseIP("::1"), Port: 8500}:          true,
+		&net.UnixAddr{Name: "/tmp/test.sock", Net: "unix"}:        true,
+		&net.UDPAddr{IP: net.ParseIP("10.42.3.4"), Port: 8500}:    true,
+		&net.UDPAddr{IP: net.ParseIP("172.16.0.1"), Port: 8500}:   false,
+	} {
+		c.Assert(f.isAllowed(addr), Equals, allowed, Commentf("address %v", addr))
+	}
+}
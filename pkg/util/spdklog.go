@@ -0,0 +1,88 @@
+package util
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SPDKTgtLogName = "spdk_tgt"
+
+	spdkLogTimeLayout = "2006-01-02 15:04:05.000000"
+)
+
+// ParseSPDKLogLine parses a line of the spdk_tgt log, which is prefixed by a local timestamp in brackets, e.g.
+// "[2024-01-10 09:53:44.123456] bdev_raid.c:3041:raid_bdev_configure: *NOTICE*: ...". Since the log is not stamped
+// by the instance manager, the line number is used as the sequence.
+func ParseSPDKLogLine(number uint64, text string) *LogLine {
+	line := &LogLine{
+		Sequence: number,
+		Line:     text,
+	}
+	if strings.HasPrefix(text, "[") {
+		if end := strings.Index(text, "]"); end > 0 {
+			if t, err := time.ParseInLocation(spdkLogTimeLayout, text[1:end], time.Local); err == nil {
+				line.Time = t
+			}
+		}
+	}
+	return line
+}
+
+// StreamSPDKLog streams the lines of the spdk_tgt log mentioning any of the names, e.g. the bdevs of an instance,
+// with a line number larger than sinceSequence, until the end of the file is reached or the
+// context is done.
+func StreamSPDKLog(ctx context.Context, path string, names []string, sinceSequence uint64) (<-chan *LogLine, error) {
+	pattern, err := getSPDKLogNamePattern(names)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	logChan := make(chan *LogLine, MaxLogFrameLines)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), 2*logTailSize)
+	go func() {
+		defer file.Close()
+		defer close(logChan)
+
+		var number uint64
+		for scanner.Scan() {
+			number++
+			if number <= sinceSequence {
+				continue
+			}
+			text := scanner.Text()
+			if !pattern.MatchString(text) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case logChan <- ParseSPDKLogLine(number, text):
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			logrus.WithError(err).Warnf("Failed to stream SPDK log %v", path)
+		}
+	}()
+	return logChan, nil
+}
+
+// getSPDKLogNamePattern matches the whole names only, so that e.g. the log of replica "vol-r-1" is not mixed with
+// the one of "vol-r-10".
+func getSPDKLogNamePattern(names []string) (*regexp.Regexp, error) {
+	quoted := []string{}
+	for _, name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+	return regexp.Compile(`(^|[^\w-])(` + strings.Join(quoted, "|") + `)($|[^\w-])`)
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestStreamSPDKLog(c *C) {
+	path := filepath.Join(c.MkDir(), SPDKTgtLogName+".log")
+	content := "[2024-01-10 09:53:44.123456] bdev_raid.c:3041:raid_bdev_configure: *NOTICE*: raid bdev vol-e-0 is created\n" +
+		"[2024-01-10 09:53:45.000000] lvol.c:100:lvol_open: *NOTICE*: lvol lvs/vol-r-10 opened\n" +
+		"unstamped line of vol-e-0\n" +
+		"[2024-01-10 09:53:46.000000] bdev_raid.c:3100:raid_bdev_remove: *ERROR*: vol-e-0: failed\n"
+	c.Assert(os.WriteFile(path, []byte(content), 0644), IsNil)
+
+	logChan, err := StreamSPDKLog(context.Background(), path, []string{"vol-e-0", "vol-r-1"}, 0)
+	c.Assert(err, IsNil)
+	lines := []*LogLine{}
+	for line := range logChan {
+		lines = append(lines, line)
+	}
+	c.Assert(lines, HasLen, 3)
+	c.Assert(lines[0].Sequence, Equals, uint64(1))
+	c.Assert(lines[0].Time.Equal(time.Date(2024, 1, 10, 9, 53, 44, 123456000, time.Local)), Equals, true)
+	c.Assert(lines[1].Sequence, Equals, uint64(3))
+	c.Assert(lines[1].Time.IsZero(), Equals, true)
+	c.Assert(lines[2].Sequence, Equals, uint64(4))
+
+	logChan, err = StreamSPDKLog(context.Background(), path, []string{"vol-e-0"}, 3)
+	c.Assert(err, IsNil)
+	lines = []*LogLine{}
+	for line := range logChan {
+		lines = append(lines, line)
+	}
+	c.Assert(lines, HasLen, 1)
+	c.Assert(lines[0].Sequence, Equals, uint64(4))
+}
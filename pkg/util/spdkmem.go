@@ -0,0 +1,281 @@
+package util
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/longhorn/go-spdk-helper/pkg/jsonrpc"
+	spdkhelpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
+)
+
+const (
+	hugepagesDirectory = "/sys/kernel/mm/hugepages"
+)
+
+// DPDKMemoryHeap is the malloc heap stats of DPDK.
+type DPDKMemoryHeap struct {
+	ID               int
+	Name             string
+	HeapSize         uint64
+	FreeSize         uint64
+	AllocSize        uint64
+	GreatestFreeSize uint64
+	AllocCount       uint64
+	FreeCount        uint64
+}
+
+// Fragmentation returns the ratio of the free memory of the heap that cannot be allocated in one piece.
+func (h *DPDKMemoryHeap) Fragmentation() float64 {
+	if h.FreeSize == 0 {
+		return 0
+	}
+	return 1 - float64(h.GreatestFreeSize)/float64(h.FreeSize)
+}
+
+// DPDKMempool is the usage of a DPDK mempool, e.g. the bdev IO or the NVMe-oF TCP request pool.
+type DPDKMempool struct {
+	Name        string
+	Size        uint64
+	ElementSize uint64
+	// Available is the number of the free elements in the common pool and the per-core caches
+	Available uint64
+}
+
+func (p *DPDKMempool) InUse() uint64 {
+	if p.Available > p.Size {
+		return 0
+	}
+	return p.Size - p.Available
+}
+
+type DPDKMemoryStats struct {
+	Heaps    []*DPDKMemoryHeap
+	Mempools []*DPDKMempool
+}
+
+// SPDKIobufPoolStats counts the buffer allocations of a module from an iobuf pool. Retry counts the allocations
+// that had to wait since the pool was exhausted.
+type SPDKIobufPoolStats struct {
+	Cache uint64 `json:"cache"`
+	Main  uint64 `json:"main"`
+	Retry uint64 `json:"retry"`
+}
+
+type SPDKIobufStats struct {
+	Module    string             `json:"module"`
+	SmallPool SPDKIobufPoolStats `json:"small_pool"`
+	LargePool SPDKIobufPoolStats `json:"large_pool"`
+}
+
+type HugepagesStats struct {
+	PageSize uint64
+	Total    uint64
+	Free     uint64
+	Reserved uint64
+	Surplus  uint64
+}
+
+// SendSPDKCommand sends the JSON-RPC command to spdk_tgt, for the commands not exposed by the SPDK client.
+func SendSPDKCommand(ctx context.Context, method string, params interface{}) ([]byte, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, spdkhelpertypes.DefaultJSONServerNetwork, spdkhelpertypes.DefaultUnixDomainSocketPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to spdk_tgt")
+	}
+	defer conn.Close()
+
+	resp, err := jsonrpc.NewClient(ctx, conn).SendCommand(method, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send SPDK command %v", method)
+	}
+	return resp, nil
+}
+
+// GetDPDKMemoryStats makes spdk_tgt dump the DPDK memory stats to a file and parses it.
+func GetDPDKMemoryStats(ctx context.Context) (*DPDKMemoryStats, error) {
+	resp, err := SendSPDKCommand(ctx, "env_dpdk_get_mem_stats", nil)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Filename string `json:"filename"`
+	}{}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode DPDK memory stats result")
+	}
+
+	f, err := os.Open(result.Filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open DPDK memory stats dump")
+	}
+	defer f.Close()
+
+	return parseDPDKMemoryStats(f)
+}
+
+// parseDPDKMemoryStats parses the heap stats dumped by rte_malloc_dump_stats and the mempools dumped by
+// rte_mempool_list_dump. The other sections of the dump, which start with a "DPDK" header line, are skipped.
+func parseDPDKMemoryStats(r io.Reader) (*DPDKMemoryStats, error) {
+	stats := &DPDKMemoryStats{
+		Heaps:    []*DPDKMemoryHeap{},
+		Mempools: []*DPDKMempool{},
+	}
+
+	var heap *DPDKMemoryHeap
+	var mempool *DPDKMempool
+	var cacheCount, commonPoolCount uint64
+	finishMempool := func() {
+		if mempool != nil {
+			mempool.Available = cacheCount + commonPoolCount
+			stats.Mempools = append(stats.Mempools, mempool)
+		}
+		mempool, cacheCount, commonPoolCount = nil, 0, 0
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "Heap id:") {
+			finishMempool()
+			id, err := strconv.Atoi(strings.TrimPrefix(line, "Heap id:"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid heap line %v", line)
+			}
+			heap = &DPDKMemoryHeap{ID: id}
+			stats.Heaps = append(stats.Heaps, heap)
+			continue
+		}
+		if strings.HasPrefix(line, "mempool <") {
+			finishMempool()
+			heap = nil
+			name := strings.TrimPrefix(line, "mempool <")
+			if i := strings.Index(name, ">"); i >= 0 {
+				name = name[:i]
+			}
+			mempool = &DPDKMempool{Name: name}
+			continue
+		}
+
+		if strings.HasPrefix(line, "DPDK ") {
+			// The header of the next section written by SPDK
+			finishMempool()
+			heap = nil
+			continue
+		}
+
+		sep := ":"
+		if mempool != nil {
+			sep = "="
+		}
+		key, value, found := strings.Cut(line, sep)
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSuffix(strings.TrimSpace(value), ",")
+
+		if heap != nil {
+			if key == "Heap name" {
+				heap.Name = value
+				continue
+			}
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "Heap_size":
+				heap.HeapSize = n
+			case "Free_size":
+				heap.FreeSize = n
+			case "Alloc_size":
+				heap.AllocSize = n
+			case "Greatest_free_size":
+				heap.GreatestFreeSize = n
+			case "Alloc_count":
+				heap.AllocCount = n
+			case "Free_count":
+				heap.FreeCount = n
+			}
+			continue
+		}
+		if mempool != nil {
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "size":
+				mempool.Size = n
+			case "elt_size":
+				mempool.ElementSize = n
+			case "total_cache_count":
+				cacheCount = n
+			case "common_pool_count":
+				commonPoolCount = n
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read DPDK memory stats dump")
+	}
+	finishMempool()
+
+	return stats, nil
+}
+
+func GetSPDKIobufStats(ctx context.Context) ([]*SPDKIobufStats, error) {
+	resp, err := SendSPDKCommand(ctx, "iobuf_get_stats", nil)
+	if err != nil {
+		return nil, err
+	}
+	stats := []*SPDKIobufStats{}
+	if err := json.Unmarshal(resp, &stats); err != nil {
+		return nil, errors.Wrap(err, "failed to decode iobuf stats")
+	}
+	return stats, nil
+}
+
+// GetHugepagesStats returns the hugepages of each page size of the node.
+func GetHugepagesStats() ([]*HugepagesStats, error) {
+	entries, err := os.ReadDir(hugepagesDirectory)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list hugepages")
+	}
+
+	stats := []*HugepagesStats{}
+	for _, entry := range entries {
+		// The directories are named hugepages-<size>kB
+		size, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(entry.Name(), "hugepages-"), "kB"), 10, 64)
+		if err != nil {
+			continue
+		}
+		s := &HugepagesStats{PageSize: size * 1024}
+		for file, value := range map[string]*uint64{
+			"nr_hugepages":      &s.Total,
+			"free_hugepages":    &s.Free,
+			"resv_hugepages":    &s.Reserved,
+			"surplus_hugepages": &s.Surplus,
+		} {
+			content, err := os.ReadFile(filepath.Join(hugepagesDirectory, entry.Name(), file))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read hugepages %v", file)
+			}
+			if *value, err = strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64); err != nil {
+				return nil, errors.Wrapf(err, "invalid hugepages %v", file)
+			}
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
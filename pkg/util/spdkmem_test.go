@@ -0,0 +1,67 @@
+package util
+
+import (
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+const testDPDKMemoryStatsDump = `DPDK memory size 2048 MiB
+DPDK memory heaps.
+Heap id:0
+	Heap name: socket_0
+	Heap_size:2147483648,
+	Free_size:2000000000,
+	Alloc_size:147483648,
+	Greatest_free_size:500000000,
+	Alloc_count:217,
+	Free_count:4,
+DPDK mempools.
+mempool <bdev_io_1234>@0x2000003fe100
+  flags=10
+  socket_id=-1
+  pool=0x200000300000
+  nb_mem_chunks=1
+  size=65535
+  populated_size=65535
+  header_size=64
+  elt_size=248
+  ops_name: <ring_mp_mc>
+  avg bytes/object=312.019379
+  internal cache infos:
+    cache_size=256
+    cache_count[0]=30
+    total_cache_count=30
+  common_pool_count=65000
+  no statistics available
+mempool <nvmf_tcp_req>@0x2000003fe200
+  size=1024
+  elt_size=512
+  common_pool_count=0
+DPDK memzones.
+Zone 0: name:<rte_eth_dev_data>, len:0x35840, virt:0x200000100000, socket_id:0, flags:0
+`
+
+func (s *TestSuite) TestParseDPDKMemoryStats(c *C) {
+	stats, err := parseDPDKMemoryStats(strings.NewReader(testDPDKMemoryStatsDump))
+	c.Assert(err, IsNil)
+
+	c.Assert(stats.Heaps, HasLen, 1)
+	heap := stats.Heaps[0]
+	c.Assert(heap.ID, Equals, 0)
+	c.Assert(heap.Name, Equals, "socket_0")
+	c.Assert(heap.HeapSize, Equals, uint64(2147483648))
+	c.Assert(heap.FreeSize, Equals, uint64(2000000000))
+	c.Assert(heap.GreatestFreeSize, Equals, uint64(500000000))
+	c.Assert(heap.AllocCount, Equals, uint64(217))
+	c.Assert(heap.Fragmentation(), Equals, 0.75)
+
+	c.Assert(stats.Mempools, HasLen, 2)
+	c.Assert(stats.Mempools[0].Name, Equals, "bdev_io_1234")
+	c.Assert(stats.Mempools[0].Size, Equals, uint64(65535))
+	c.Assert(stats.Mempools[0].ElementSize, Equals, uint64(248))
+	c.Assert(stats.Mempools[0].Available, Equals, uint64(65030))
+	c.Assert(stats.Mempools[0].InUse(), Equals, uint64(505))
+	c.Assert(stats.Mempools[1].Name, Equals, "nvmf_tcp_req")
+	c.Assert(stats.Mempools[1].InUse(), Equals, uint64(1024))
+}
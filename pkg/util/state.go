@@ -0,0 +1,115 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// StateVersionKey is the key of the schema version in a persistent state file. A state file without it is of
+	// version 0, i.e. written before the state is versioned.
+	StateVersionKey = "stateVersion"
+)
+
+// StateMigration migrates a decoded state file between two adjacent schema versions in place.
+type StateMigration func(state map[string]interface{}) error
+
+// StateSchema describes the schema versions of a kind of persistent state file and the migrations between them,
+// so that a state file written by a newer instance manager can be migrated back before a rollback and vice versa.
+// A new schema version should only add optional fields, so that the older instance managers can still read it.
+type StateSchema struct {
+	Kind           string
+	CurrentVersion int
+	// Upgrades[i] migrates the state of version i to version i+1
+	Upgrades []StateMigration
+	// Downgrades[i] migrates the state of version i+1 to version i
+	Downgrades []StateMigration
+}
+
+// GetStateVersion returns the schema version of the state file content.
+func GetStateVersion(content []byte) (int, error) {
+	state := map[string]interface{}{}
+	if err := json.Unmarshal(content, &state); err != nil {
+		return 0, errors.Wrap(err, "failed to decode state")
+	}
+	return getStateVersion(state)
+}
+
+func getStateVersion(state map[string]interface{}) (int, error) {
+	value, exists := state[StateVersionKey]
+	if !exists {
+		return 0, nil
+	}
+	version, ok := value.(float64)
+	if !ok || version < 0 || version != float64(int(version)) {
+		return 0, fmt.Errorf("invalid state version %v", value)
+	}
+	return int(version), nil
+}
+
+// Migrate migrates the state file content to the target version. It returns the migrated content and the
+// version of the original content.
+func (s *StateSchema) Migrate(content []byte, targetVersion int) ([]byte, int, error) {
+	if targetVersion < 0 || targetVersion > s.CurrentVersion {
+		return nil, 0, fmt.Errorf("unsupported %v state version %v, the supported versions are 0 to %v", s.Kind, targetVersion, s.CurrentVersion)
+	}
+
+	state := map[string]interface{}{}
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to decode %v state", s.Kind)
+	}
+	version, err := getStateVersion(state)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to get %v state version", s.Kind)
+	}
+	if version > s.CurrentVersion {
+		return nil, version, fmt.Errorf("%v state version %v is newer than the supported version %v", s.Kind, version, s.CurrentVersion)
+	}
+
+	for v := version; v < targetVersion; v++ {
+		if err := s.Upgrades[v](state); err != nil {
+			return nil, version, errors.Wrapf(err, "failed to upgrade %v state from version %v to %v", s.Kind, v, v+1)
+		}
+	}
+	for v := version; v > targetVersion; v-- {
+		if err := s.Downgrades[v-1](state); err != nil {
+			return nil, version, errors.Wrapf(err, "failed to downgrade %v state from version %v to %v", s.Kind, v, v-1)
+		}
+	}
+
+	if targetVersion == 0 {
+		delete(state, StateVersionKey)
+	} else {
+		state[StateVersionKey] = targetVersion
+	}
+	migrated, err := json.Marshal(state)
+	if err != nil {
+		return nil, version, errors.Wrapf(err, "failed to encode %v state", s.Kind)
+	}
+	return migrated, version, nil
+}
+
+// Unmarshal decodes the state file content of any supported version into obj.
+func (s *StateSchema) Unmarshal(content []byte, obj interface{}) error {
+	migrated, _, err := s.Migrate(content, s.CurrentVersion)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(migrated, obj)
+}
+
+// Marshal encodes obj as the state file content of the current version.
+func (s *StateSchema) Marshal(obj interface{}) ([]byte, error) {
+	content, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	state := map[string]interface{}{}
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, err
+	}
+	state[StateVersionKey] = s.CurrentVersion
+	return json.Marshal(state)
+}
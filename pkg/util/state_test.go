@@ -0,0 +1,135 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestStateSchema(c *C) {
+	// version 1 renames "addr" to "address", version 2 adds the optional "port"
+	schema := &StateSchema{
+		Kind:           "test",
+		CurrentVersion: 2,
+		Upgrades: []StateMigration{
+			func(state map[string]interface{}) error {
+				state["address"] = state["addr"]
+				delete(state, "addr")
+				return nil
+			},
+			func(state map[string]interface{}) error { return nil },
+		},
+		Downgrades: []StateMigration{
+			func(state map[string]interface{}) error {
+				state["addr"] = state["address"]
+				delete(state, "address")
+				return nil
+			},
+			func(state map[string]interface{}) error {
+				delete(state, "port")
+				return nil
+			},
+		},
+	}
+	type testState struct {
+		Address string `json:"address"`
+		Port    int    `json:"port"`
+	}
+
+	obj := &testState{}
+	err := schema.Unmarshal([]byte(`{"addr":"10.0.0.1"}`), obj)
+	c.Assert(err, IsNil)
+	c.Assert(obj.Address, Equals, "10.0.0.1")
+
+	content, err := schema.Marshal(&testState{Address: "10.0.0.2", Port: 10000})
+	c.Assert(err, IsNil)
+	version, err := GetStateVersion(content)
+	c.Assert(err, IsNil)
+	c.Assert(version, Equals, 2)
+
+	migrated, version, err := schema.Migrate(content, 0)
+	c.Assert(err, IsNil)
+	c.Assert(version, Equals, 2)
+	c.Assert(string(migrated), Equals, `{"addr":"10.0.0.2"}`)
+
+	migrated, _, err = schema.Migrate(migrated, 2)
+	c.Assert(err, IsNil)
+	obj = &testState{}
+	err = schema.Unmarshal(migrated, obj)
+	c.Assert(err, IsNil)
+	c.Assert(obj.Address, Equals, "10.0.0.2")
+	c.Assert(obj.Port, Equals, 0)
+
+	_, _, err = schema.Migrate([]byte(`{"stateVersion":3}`), 2)
+	c.Assert(err, ErrorMatches, ".*newer than the supported version 2.*")
+	_, _, err = schema.Migrate(content, 3)
+	c.Assert(err, ErrorMatches, "unsupported test state version 3.*")
+	_, _, err = schema.Migrate([]byte(`{"stateVersion":"1"}`), 2)
+	c.Assert(err, ErrorMatches, ".*invalid state version.*")
+}
+
+func (s *TestSuite) TestVerifyLeaseFiles(c *C) {
+	dir := c.MkDir()
+
+	lm, err := NewLeaseManager(dir)
+	c.Assert(err, IsNil)
+	err = lm.Acquire("disk:held", "disk-held")
+	c.Assert(err, IsNil)
+	err = lm.Acquire("disk:released", "disk-released")
+	c.Assert(err, IsNil)
+	err = lm.Release("disk:released", "disk-released")
+	c.Assert(err, IsNil)
+
+	// a lease file written before the state is versioned
+	unversionedPath := filepath.Join(dir, "unversioned"+leaseFileSuffix)
+	err = os.WriteFile(unversionedPath, []byte(`{"resource":"disk:unversioned","owner":"disk-unversioned"}`), 0644)
+	c.Assert(err, IsNil)
+	corruptedPath := filepath.Join(dir, "corrupted"+leaseFileSuffix)
+	err = os.WriteFile(corruptedPath, []byte(`{"resource":`), 0644)
+	c.Assert(err, IsNil)
+
+	statuses, err := VerifyLeaseFiles(dir, LeaseStateSchema.CurrentVersion, true)
+	c.Assert(err, IsNil)
+	c.Assert(statuses, HasLen, 4)
+	byPath := map[string]*LeaseFileStatus{}
+	for _, status := range statuses {
+		byPath[status.Path] = status
+	}
+
+	held := byPath[lm.leaseFilePath("disk:held")]
+	c.Assert(held, NotNil)
+	c.Assert(held.Held, Equals, true)
+	c.Assert(held.Version, Equals, LeaseStateSchema.CurrentVersion)
+	c.Assert(held.Error, Equals, "")
+	c.Assert(byPath[lm.leaseFilePath("disk:released")].Empty, Equals, true)
+	c.Assert(byPath[corruptedPath].Error, Not(Equals), "")
+
+	unversioned := byPath[unversionedPath]
+	c.Assert(unversioned.Version, Equals, 0)
+	c.Assert(unversioned.Migrated, Equals, true)
+	content, err := os.ReadFile(unversionedPath)
+	c.Assert(err, IsNil)
+	version, err := GetStateVersion(content)
+	c.Assert(err, IsNil)
+	c.Assert(version, Equals, LeaseStateSchema.CurrentVersion)
+
+	// roll the unversioned lease file back, while the held one is left intact
+	statuses, err = VerifyLeaseFiles(dir, 0, true)
+	c.Assert(err, IsNil)
+	for _, status := range statuses {
+		c.Assert(status.Migrated, Equals, status.Path == unversionedPath, Commentf("lease file %v", status.Path))
+	}
+	content, err = os.ReadFile(unversionedPath)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, `{"owner":"disk-unversioned","resource":"disk:unversioned"}`)
+	content, err = os.ReadFile(lm.leaseFilePath("disk:held"))
+	c.Assert(err, IsNil)
+	version, err = GetStateVersion(content)
+	c.Assert(err, IsNil)
+	c.Assert(version, Equals, LeaseStateSchema.CurrentVersion, Commentf("%s", fmt.Sprint(string(content))))
+
+	err = lm.Release("disk:held", "disk-held")
+	c.Assert(err, IsNil)
+}
@@ -0,0 +1,288 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	taskFileSuffix = ".task"
+
+	taskQueuePollInterval = time.Second
+	taskRetryBaseDelay    = 10 * time.Second
+	taskRetryMaxDelay     = 10 * time.Minute
+)
+
+// TaskStateSchema is the schema of the task files of the deferred task queue.
+var TaskStateSchema = &StateSchema{
+	Kind:           "task",
+	CurrentVersion: 0,
+}
+
+// Task is a deferred cleanup, e.g. deleting a replica after its grace period, to be done by the handler of its type.
+type Task struct {
+	ID            string            `json:"id"`
+	Type          string            `json:"type"`
+	Args          map[string]string `json:"args"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	NextAttemptAt time.Time         `json:"nextAttemptAt"`
+	Attempts      int               `json:"attempts"`
+	LastError     string            `json:"lastError"`
+}
+
+// TaskHandler does the task with its arguments. A failed task is retried with backoff, so the handler should be
+// idempotent, and treat the resource already cleaned up as done.
+type TaskHandler func(args map[string]string) error
+
+// TaskQueue runs the deferred cleanups which must not be lost on restart. Each task is stored in its own file
+// under dir until it is done, and reloaded by the next instance manager. The tasks are only kept in memory if dir
+// is empty. A failed task is retried with exponential backoff until it succeeds or is canceled.
+type TaskQueue struct {
+	dir string
+
+	lock     *sync.Mutex
+	tasks    map[string]*Task
+	handlers map[string]TaskHandler
+	running  map[string]struct{}
+}
+
+func NewTaskQueue(dir string) (*TaskQueue, error) {
+	q := &TaskQueue{
+		dir: dir,
+
+		lock:     &sync.Mutex{},
+		tasks:    map[string]*Task{},
+		handlers: map[string]TaskHandler{},
+		running:  map[string]struct{}{},
+	}
+	if dir == "" {
+		return q, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create task queue directory %v", dir)
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+taskFileSuffix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list task files in %v", dir)
+	}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read task file %v", path)
+		}
+		task := &Task{}
+		if err := TaskStateSchema.Unmarshal(content, task); err != nil {
+			// Keep the file for inspection rather than failing all the other tasks
+			logrus.WithError(err).Errorf("Skipped invalid task file %v", path)
+			continue
+		}
+		q.tasks[task.ID] = task
+	}
+	if len(q.tasks) != 0 {
+		logrus.Infof("Loaded %v pending tasks from %v", len(q.tasks), dir)
+	}
+	return q, nil
+}
+
+// RegisterHandler sets the handler of the tasks of the type. The tasks without a handler stay pending.
+func (q *TaskQueue) RegisterHandler(taskType string, handler TaskHandler) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.handlers[taskType] = handler
+}
+
+// Enqueue adds a task to be done after the delay, and returns the task ID.
+func (q *TaskQueue) Enqueue(taskType string, args map[string]string, delay time.Duration) (string, error) {
+	now := time.Now().UTC()
+	task := &Task{
+		ID:            taskType + "-" + UUID()[:8],
+		Type:          taskType,
+		Args:          args,
+		CreatedAt:     now,
+		NextAttemptAt: now.Add(delay),
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if err := q.persist(task); err != nil {
+		return "", err
+	}
+	q.tasks[task.ID] = task
+	return task.ID, nil
+}
+
+// Cancel removes the pending task. Canceling a task already done is a no-op.
+func (q *TaskQueue) Cancel(id string) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if _, exists := q.tasks[id]; !exists {
+		return nil
+	}
+	if err := q.remove(id); err != nil {
+		return err
+	}
+	delete(q.tasks, id)
+	return nil
+}
+
+// List returns the pending tasks, the earliest due first.
+func (q *TaskQueue) List() []*Task {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	tasks := []*Task{}
+	for _, task := range q.tasks {
+		t := *task
+		t.Args = map[string]string{}
+		for k, v := range task.Args {
+			t.Args[k] = v
+		}
+		tasks = append(tasks, &t)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].NextAttemptAt.Equal(tasks[j].NextAttemptAt) {
+			return tasks[i].ID < tasks[j].ID
+		}
+		return tasks[i].NextAttemptAt.Before(tasks[j].NextAttemptAt)
+	})
+	return tasks
+}
+
+// Start runs the due tasks until the context is done.
+func (q *TaskQueue) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(taskQueuePollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				logrus.Info("Stopped running deferred tasks due to the context done")
+				return
+			case <-ticker.C:
+				q.runDueTasks(time.Now())
+			}
+		}
+	}()
+}
+
+func (q *TaskQueue) runDueTasks(now time.Time) {
+	q.lock.Lock()
+	due := []*Task{}
+	for _, task := range q.tasks {
+		if _, running := q.running[task.ID]; running || task.NextAttemptAt.After(now) {
+			continue
+		}
+		if _, exists := q.handlers[task.Type]; !exists {
+			continue
+		}
+		q.running[task.ID] = struct{}{}
+		due = append(due, task)
+	}
+	q.lock.Unlock()
+
+	for _, task := range due {
+		q.runTask(task, now)
+	}
+}
+
+func (q *TaskQueue) runTask(task *Task, now time.Time) {
+	q.lock.Lock()
+	handler := q.handlers[task.Type]
+	args := map[string]string{}
+	for k, v := range task.Args {
+		args[k] = v
+	}
+	q.lock.Unlock()
+
+	err := handler(args)
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	delete(q.running, task.ID)
+	if _, exists := q.tasks[task.ID]; !exists {
+		// Canceled while running
+		return
+	}
+
+	if err == nil {
+		logrus.Infof("Finished task %v", task.ID)
+		if removeErr := q.remove(task.ID); removeErr != nil {
+			// The task may be done again after restart, which the handler tolerates
+			logrus.WithError(removeErr).Warnf("Failed to remove the file of finished task %v", task.ID)
+		}
+		delete(q.tasks, task.ID)
+		return
+	}
+
+	task.Attempts++
+	task.LastError = err.Error()
+	task.NextAttemptAt = now.UTC().Add(getTaskRetryDelay(task.Attempts))
+	logrus.WithError(err).Warnf("Failed task %v for %v times, will retry at %v", task.ID, task.Attempts, task.NextAttemptAt)
+	if persistErr := q.persist(task); persistErr != nil {
+		logrus.WithError(persistErr).Warnf("Failed to update the file of task %v", task.ID)
+	}
+}
+
+func getTaskRetryDelay(attempts int) time.Duration {
+	delay := taskRetryBaseDelay
+	for i := 1; i < attempts && delay < taskRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > taskRetryMaxDelay {
+		delay = taskRetryMaxDelay
+	}
+	return delay
+}
+
+func (q *TaskQueue) taskFilePath(id string) string {
+	return filepath.Join(q.dir, id+taskFileSuffix)
+}
+
+// persist writes the task file atomically, so that a crash never leaves a partial task behind.
+func (q *TaskQueue) persist(task *Task) error {
+	if q.dir == "" {
+		return nil
+	}
+	if strings.ContainsAny(task.ID, `/\`) {
+		return fmt.Errorf("invalid task ID %v", task.ID)
+	}
+
+	content, err := TaskStateSchema.Marshal(task)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode task %v", task.ID)
+	}
+	path := q.taskFilePath(task.ID)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write task file %v", tmpPath)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrapf(err, "failed to rename task file %v", tmpPath)
+	}
+	return nil
+}
+
+func (q *TaskQueue) remove(id string) error {
+	if q.dir == "" {
+		return nil
+	}
+	if err := os.Remove(q.taskFilePath(id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove task file of %v", id)
+	}
+	return nil
+}
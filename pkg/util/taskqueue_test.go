@@ -0,0 +1,71 @@
+package util
+
+import (
+	"fmt"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestTaskQueue(c *C) {
+	dir := c.MkDir()
+
+	q, err := NewTaskQueue(dir)
+	c.Assert(err, IsNil)
+
+	failures := 1
+	done := []string{}
+	handler := func(args map[string]string) error {
+		if failures > 0 {
+			failures--
+			return fmt.Errorf("injected failure")
+		}
+		done = append(done, args["name"])
+		return nil
+	}
+
+	id, err := q.Enqueue("test-cleanup", map[string]string{"name": "test-volume-r-0"}, time.Hour)
+	c.Assert(err, IsNil)
+	canceledID, err := q.Enqueue("test-cleanup", map[string]string{"name": "test-volume-r-1"}, 0)
+	c.Assert(err, IsNil)
+	c.Assert(q.Cancel(canceledID), IsNil)
+	c.Assert(q.List(), HasLen, 1)
+
+	// the pending task survives the restart
+	q, err = NewTaskQueue(dir)
+	c.Assert(err, IsNil)
+	q.RegisterHandler("test-cleanup", handler)
+	tasks := q.List()
+	c.Assert(tasks, HasLen, 1)
+	c.Assert(tasks[0].ID, Equals, id)
+	c.Assert(tasks[0].Args["name"], Equals, "test-volume-r-0")
+
+	// not due yet
+	q.runDueTasks(time.Now())
+	c.Assert(done, HasLen, 0)
+	c.Assert(q.List()[0].Attempts, Equals, 0)
+
+	// the failed task is retried with backoff
+	q.runDueTasks(time.Now().Add(time.Hour))
+	tasks = q.List()
+	c.Assert(tasks, HasLen, 1)
+	c.Assert(tasks[0].Attempts, Equals, 1)
+	c.Assert(tasks[0].LastError, Equals, "injected failure")
+	q.runDueTasks(time.Now().Add(time.Hour))
+	c.Assert(done, HasLen, 0)
+
+	q.runDueTasks(time.Now().Add(time.Hour + taskRetryBaseDelay))
+	c.Assert(done, DeepEquals, []string{"test-volume-r-0"})
+	c.Assert(q.List(), HasLen, 0)
+
+	q, err = NewTaskQueue(dir)
+	c.Assert(err, IsNil)
+	c.Assert(q.List(), HasLen, 0)
+}
+
+func (s *TestSuite) TestTaskRetryDelay(c *C) {
+	c.Assert(getTaskRetryDelay(1), Equals, taskRetryBaseDelay)
+	c.Assert(getTaskRetryDelay(2), Equals, 2*taskRetryBaseDelay)
+	c.Assert(getTaskRetryDelay(3), Equals, 4*taskRetryBaseDelay)
+	c.Assert(getTaskRetryDelay(100), Equals, taskRetryMaxDelay)
+}
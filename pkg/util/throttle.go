@@ -0,0 +1,53 @@
+package util
+
+import (
+	"context"
+	"time"
+)
+
+// BandwidthThrottle paces a sequence of I/Os to a rate in bytes per second, e.g. a background scan of a device
+// which must not starve the foreground I/O.
+type BandwidthThrottle struct {
+	bytesPerSecond int64
+
+	start time.Time
+	bytes int64
+}
+
+// NewBandwidthThrottle returns a throttle capping the rate to bytesPerSecond. The rate is unlimited if 0.
+func NewBandwidthThrottle(bytesPerSecond int64) *BandwidthThrottle {
+	return &BandwidthThrottle{
+		bytesPerSecond: bytesPerSecond,
+		start:          time.Now(),
+	}
+}
+
+// getDelay returns how long to wait until the bytes done since start fit in the rate.
+func (t *BandwidthThrottle) getDelay(elapsed time.Duration) time.Duration {
+	if t.bytesPerSecond <= 0 {
+		return 0
+	}
+	expected := time.Duration(float64(t.bytes) / float64(t.bytesPerSecond) * float64(time.Second))
+	if expected <= elapsed {
+		return 0
+	}
+	return expected - elapsed
+}
+
+// Wait accounts the bytes just done and blocks until they fit in the rate, or the context is done.
+func (t *BandwidthThrottle) Wait(ctx context.Context, bytes int64) error {
+	t.bytes += bytes
+	delay := t.getDelay(time.Since(t.start))
+	if delay == 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
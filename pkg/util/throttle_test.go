@@ -0,0 +1,30 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestBandwidthThrottleDelay(c *C) {
+	t := NewBandwidthThrottle(100 << 20)
+	t.bytes = 50 << 20
+	c.Assert(t.getDelay(0), Equals, 500*time.Millisecond)
+	c.Assert(t.getDelay(200*time.Millisecond), Equals, 300*time.Millisecond)
+	c.Assert(t.getDelay(time.Second), Equals, time.Duration(0))
+
+	unlimited := NewBandwidthThrottle(0)
+	unlimited.bytes = 1 << 40
+	c.Assert(unlimited.getDelay(0), Equals, time.Duration(0))
+}
+
+func (s *TestSuite) TestBandwidthThrottleWaitCanceled(c *C) {
+	t := NewBandwidthThrottle(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	c.Assert(t.Wait(ctx, 1<<20), Equals, context.Canceled)
+	c.Assert(time.Since(start) < time.Second, Equals, true)
+}
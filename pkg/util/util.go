@@ -2,6 +2,7 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -14,7 +15,6 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"k8s.io/mount-utils"
 
 	spdkhelpertypes "github.com/longhorn/go-spdk-helper/pkg/types"
@@ -33,33 +33,35 @@ func Execute(binary string, args ...string) (string, error) {
 }
 
 func ExecuteWithTimeout(timeout time.Duration, binary string, args ...string) (string, error) {
-	var err error
-	cmd := exec.Command(binary, args...)
-	done := make(chan struct{})
+	return ExecuteWithContext(context.Background(), timeout, binary, args...)
+}
+
+// ExecuteWithContext runs the command on behalf of the context, so that the command is killed once the context is
+// canceled or expires, e.g. together with the RPC it serves. The timeout is the default one of the binary, which
+// can be overridden by SetCommandTimeouts.
+func ExecuteWithContext(ctx context.Context, timeout time.Duration, binary string, args ...string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", errors.Wrapf(err, "cannot execute: %v %v", binary, args)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, CommandTimeout(ctx, binary, timeout))
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, binary, args...)
 
 	var output, stderr bytes.Buffer
 	cmd.Stdout = &output
 	cmd.Stderr = &stderr
 
-	go func() {
-		err = cmd.Run()
-		done <- struct{}{}
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(timeout):
-		if cmd.Process != nil {
-			if err := cmd.Process.Kill(); err != nil {
-				logrus.WithError(err).Warnf("Problem killing process pid=%v", cmd.Process.Pid)
-			}
-
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", errors.Wrapf(ctx.Err(), "timeout executing: %v %v, output %s, stderr %s",
+				binary, args, output.String(), stderr.String())
+		}
+		if ctx.Err() != nil {
+			return "", errors.Wrapf(ctx.Err(), "canceled executing: %v %v, output %s, stderr %s",
+				binary, args, output.String(), stderr.String())
 		}
-		return "", errors.Wrapf(err, "timeout executing: %v %v, output %s, stderr %s",
-			binary, args, output.String(), stderr.String())
-	}
-
-	if err != nil {
 		return "", errors.Wrapf(err, "failed to execute: %v %v, output %s, stderr %s",
 			binary, args, output.String(), stderr.String())
 	}